@@ -1,17 +1,23 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"silan-backend/internal/config"
 	"silan-backend/internal/handler"
+	"silan-backend/internal/migrate"
 	"silan-backend/internal/svc"
+	"silan-backend/internal/validation"
 
 	"github.com/zeromicro/go-zero/core/conf"
 	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
 var (
@@ -26,11 +32,18 @@ var (
 	serverHost     = flag.String("host", "", "server host")
 	serverPort     = flag.Int("port", 0, "server port")
 	googleClientID = flag.String("google-client-id", "", "Google OAuth client ID (optional)")
+	migrateLegacy  = flag.Bool("migrate-blog-comments", false, "copy legacy blog_comments rows into comments, verify and exit")
+	migrateFPs     = flag.Bool("migrate-fingerprints", false, "hash the raw browser fingerprints of likes, views and comments and exit")
+	migrateRaw     = flag.Bool("migrate-raw-tables", false, "copy the rows of raw tables replaced by ent fields into those fields and exit")
 )
 
 func main() {
 	flag.Parse()
 
+	// Store and emit UTC regardless of the host timezone; ent fills its
+	// created_at/updated_at defaults from time.Now
+	time.Local = time.UTC
+
 	var c config.Config
 
 	// Load config from file if it exists
@@ -91,10 +104,34 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Request structs are checked against their validate tags in httpx.Parse
+	httpx.SetValidator(validation.Validator{})
+	httpx.SetErrorHandlerCtx(validation.ErrorHandler)
+
 	server := rest.MustNewServer(c.RestConf)
 	defer server.Stop()
 
 	ctx := svc.NewServiceContext(c)
+	if *migrateLegacy {
+		migrateBlogComments(ctx)
+		return
+	}
+	if *migrateFPs {
+		migrateFingerprints(ctx)
+		return
+	}
+	if *migrateRaw {
+		migrateRawTables(ctx)
+		return
+	}
+	ctx.Outbox.Start()
+	defer ctx.Outbox.Stop()
+	ctx.Scheduler.Start()
+	defer ctx.Scheduler.Stop()
+	// Every request is written to request_logs for the analytics reports
+	server.Use(ctx.Analytics)
+	// API keys are optional on every route; keyed requests are metered
+	server.Use(ctx.ApiKey)
 	handler.RegisterHandlers(server, ctx)
 
 	// Add global OPTIONS handler for CORS
@@ -102,6 +139,17 @@ func main() {
 		Method: http.MethodOptions,
 		Path:   "/*",
 		Handler: func(w http.ResponseWriter, r *http.Request) {
+			// Embeds are read from any site, see EmbedMiddleware and
+			// WidgetMiddleware
+			if strings.HasPrefix(r.URL.Path, "/api/v1/embed/") || strings.HasPrefix(r.URL.Path, "/embed/") {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+				w.Header().Set("Access-Control-Allow-Methods", "GET, OPTIONS")
+				w.Header().Set("Access-Control-Allow-Headers", "Accept, X-API-Key")
+				w.Header().Set("Access-Control-Max-Age", "86400")
+				w.WriteHeader(http.StatusNoContent)
+				return
+			}
+
 			// Set CORS headers manually
 			origin := r.Header.Get("Origin")
 			allowedOrigins := []string{
@@ -137,8 +185,8 @@ func main() {
 				w.Header().Set("Access-Control-Allow-Origin", "*")
 			}
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, Origin, X-Requested-With")
-			w.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Type")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, Origin, X-Requested-With, X-API-Key, X-Signature, X-Signature-Timestamp")
+			w.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Type, X-Experiment-Variant, X-Experiments, X-Quota-Daily-Limit, X-Quota-Daily-Remaining, X-Quota-Monthly-Limit, X-Quota-Monthly-Remaining, Retry-After")
 			w.Header().Set("Access-Control-Allow-Credentials", "true")
 			w.Header().Set("Access-Control-Max-Age", "86400")
 
@@ -183,3 +231,45 @@ func maskPassword(connectionString string) string {
 	}
 	return "***"
 }
+
+// migrateBlogComments runs the one-shot blog_comments backfill.
+func migrateBlogComments(ctx *svc.ServiceContext) {
+	if !ctx.Legacy.Enabled() {
+		fmt.Println("No legacy blog_comments table found, nothing to migrate")
+		return
+	}
+
+	report, err := ctx.Legacy.Backfill(context.Background())
+	fmt.Printf("blog_comments: %d rows, %d copied now, %d present in comments\n",
+		report.Legacy, report.Copied, report.Migrated)
+	if err != nil {
+		fmt.Printf("Migration failed: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println("Migration verified; blog_comments can be dropped")
+}
+
+// migrateFingerprints runs the one-shot hashing of raw fingerprints.
+func migrateFingerprints(ctx *svc.ServiceContext) {
+	bg := context.Background()
+	report, err := migrate.Fingerprints(bg, ctx.RawDB, ctx.Config.Database.Driver, func(fp string) string {
+		return ctx.Fingerprints.Hash(bg, fp)
+	})
+	fmt.Printf("Hashed fingerprints: %d comment likes, %d project likes, %d project views, %d comments\n",
+		report.CommentLikes, report.ProjectLikes, report.ProjectViews, report.Comments)
+	if err != nil {
+		fmt.Printf("Migration failed: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// migrateRawTables runs the one-shot copy of retired raw tables.
+func migrateRawTables(ctx *svc.ServiceContext) {
+	report, err := migrate.RawTables(context.Background(), ctx.RawDB, ctx.Config.Database.Driver)
+	fmt.Printf("Copied raw tables: %d comment tombstones, %d comment languages, %d identity profiles\n",
+		report.CommentTombstones, report.CommentLanguages, report.IdentityProfiles)
+	if err != nil {
+		fmt.Printf("Migration failed: %v\n", err)
+		os.Exit(1)
+	}
+}
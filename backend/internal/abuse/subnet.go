@@ -0,0 +1,101 @@
+// Package abuse holds in-process guards against automated engagement.
+package abuse
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// Subnet returns the network an address belongs to for rate limiting: the
+// /24 for IPv4 and the /64 for IPv6, the blocks a single client can usually
+// rotate through freely. Unparsable input is returned unchanged.
+func Subnet(ip string) string {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return ip
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return (&net.IPNet{IP: v4.Mask(net.CIDRMask(24, 32)), Mask: net.CIDRMask(24, 32)}).String()
+	}
+	return (&net.IPNet{IP: parsed.Mask(net.CIDRMask(64, 128)), Mask: net.CIDRMask(64, 128)}).String()
+}
+
+// Decision is the outcome of SubnetLimiter.Allow.
+type Decision struct {
+	Allowed bool
+	// Flagged is set on the first rejected attempt of a window, so callers
+	// can report a subnet once rather than on every request.
+	Flagged      bool
+	Subnet       string
+	Count        int
+	Fingerprints int
+}
+
+type window struct {
+	start        time.Time
+	count        int
+	fingerprints map[string]struct{}
+	flagged      bool
+}
+
+// SubnetLimiter caps attempts per subnet in fixed windows. State is kept in
+// memory, which is enough for the single instance this site runs on.
+type SubnetLimiter struct {
+	mu      sync.Mutex
+	limit   int
+	period  time.Duration
+	windows map[string]*window
+}
+
+// NewSubnetLimiter allows limit attempts per subnet and period. A limit of
+// 0 disables the cap.
+func NewSubnetLimiter(limit int, period time.Duration) *SubnetLimiter {
+	return &SubnetLimiter{limit: limit, period: period, windows: map[string]*window{}}
+}
+
+// sweepSize is the number of tracked subnets above which expired windows are
+// dropped.
+const sweepSize = 10000
+
+// Allow records an attempt from ip with the given browser fingerprint.
+func (l *SubnetLimiter) Allow(ip, fingerprint string) Decision {
+	subnet := Subnet(ip)
+	if l.limit <= 0 || subnet == "" {
+		return Decision{Allowed: true, Subnet: subnet}
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if len(l.windows) > sweepSize {
+		for key, w := range l.windows {
+			if now.Sub(w.start) >= l.period {
+				delete(l.windows, key)
+			}
+		}
+	}
+
+	w, ok := l.windows[subnet]
+	if !ok || now.Sub(w.start) >= l.period {
+		w = &window{start: now, fingerprints: map[string]struct{}{}}
+		l.windows[subnet] = w
+	}
+	w.count++
+	if fingerprint != "" {
+		w.fingerprints[fingerprint] = struct{}{}
+	}
+
+	d := Decision{
+		Allowed:      w.count <= l.limit,
+		Subnet:       subnet,
+		Count:        w.count,
+		Fingerprints: len(w.fingerprints),
+	}
+	if !d.Allowed && !w.flagged {
+		w.flagged = true
+		d.Flagged = true
+	}
+	return d
+}
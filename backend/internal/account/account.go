@@ -0,0 +1,47 @@
+// Package account links the user identities of one person, such as a Google
+// and a GitHub sign-in with the same verified email, to a primary identity.
+// Comments, likes and sessions use the primary identity, so they follow one
+// canonical user whichever provider the visitor signs in with.
+package account
+
+import (
+	"context"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/useridentity"
+)
+
+// Store reads the links, kept as ent IdentityLinks. Identities without a
+// link are their own primary. Links are written in the transaction that
+// moves the linked identity's data, see svc.CanonicalIdentity.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Primary returns the primary identity of identityID.
+func (s *Store) Primary(ctx context.Context, identityID string) (string, error) {
+	link, err := s.client.IdentityLink.Query().
+		Where(identitylink.IdentityIDEQ(identityID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return identityID, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return link.PrimaryID, nil
+}
+
+// Linked returns the identities linked to primaryID, leaving out primaryID
+// itself.
+func (s *Store) Linked(ctx context.Context, primaryID string) ([]string, error) {
+	return s.client.UserIdentity.Query().
+		Where(useridentity.IDEQ(primaryID)).
+		QueryLinkedIdentities().
+		IDs(ctx)
+}
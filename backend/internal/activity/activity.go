@@ -0,0 +1,246 @@
+// Package activity keeps a log of normalized domain events in the raw
+// events table. Entries are written at write time, in the transaction of
+// the change they describe, so the public activity feed and the owner's
+// notifications are read from one table instead of being pieced together
+// from the content tables.
+package activity
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// Event types of the log.
+const (
+	PostPublished       = "post_published"
+	PostUpdated         = "post_updated"
+	ProjectPublished    = "project_published"
+	ProjectUpdated      = "project_updated"
+	IdeaPublished       = "idea_published"
+	IdeaUpdated         = "idea_updated"
+	IdeaStatusChanged   = "idea_status_changed"
+	SiteUpdatePublished = "site_update_published"
+	CommentCreated      = "comment_created"
+)
+
+// summaryLength caps the summary of an entry, in characters.
+const summaryLength = 200
+
+// Event is an entry of the log. Subject is what the event is about; Parent
+// is the entry a comment was made on. Public entries are listed in the
+// public feed, the others are only shown to the owner, such as comments held
+// for moderation and edits of drafts.
+type Event struct {
+	ID          string
+	Type        string
+	SubjectType string
+	SubjectID   string
+	ParentType  string
+	ParentID    string
+	Title       string
+	Summary     string
+	Actor       string
+	Public      bool
+	CreatedAt   time.Time
+}
+
+// Query selects entries to list. Entries are returned newest first; Before
+// and Since are exclusive bounds on the creation time.
+type Query struct {
+	Types      []string
+	PublicOnly bool
+	Before     time.Time
+	Since      time.Time
+	Limit      int
+}
+
+// Store reads and writes the raw events table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Record logs the entry matching an outbox event through ex, which should be
+// the transaction of the write. Events the log has no entry for, such as
+// likes and bulk content changes, are ignored. Deletions hide the entries of
+// the deleted subject, and of comments on it, from the public feed, and
+// blank the author and text of deleted comments; approving a comment makes
+// its entry public and editing it updates the summary.
+func (s *Store) Record(ctx context.Context, ex outbox.Execer, eventType string, data any) error {
+	switch ev := data.(type) {
+	case outbox.ContentEvent:
+		if eventType == outbox.EventContentDeleted {
+			return s.hide(ctx, ex, ev.ID)
+		}
+		if e, ok := contentEntry(eventType, ev); ok {
+			return s.insert(ctx, ex, e)
+		}
+	case outbox.CommentEvent:
+		switch eventType {
+		case outbox.EventCommentCreated:
+			return s.insert(ctx, ex, Event{
+				Type:        CommentCreated,
+				SubjectType: "comment",
+				SubjectID:   ev.ID,
+				ParentType:  ev.EntityType,
+				ParentID:    ev.EntityID,
+				Summary:     ev.Content,
+				Actor:       ev.AuthorName,
+				Public:      ev.Approved,
+			})
+		case outbox.EventCommentApproved:
+			_, err := ex.ExecContext(ctx, s.rebind(
+				`UPDATE events SET public = ? WHERE subject_type = 'comment' AND subject_id = ?`), true, ev.ID)
+			return err
+		case outbox.EventCommentEdited:
+			_, err := ex.ExecContext(ctx, s.rebind(
+				`UPDATE events SET summary = ? WHERE subject_type = 'comment' AND subject_id = ?`),
+				clip(ev.Content, summaryLength), ev.ID)
+			return err
+		case outbox.EventCommentDeleted:
+			// What the author took back leaves the log too
+			if _, err := ex.ExecContext(ctx, s.rebind(
+				`UPDATE events SET summary = '', actor = '' WHERE subject_type = 'comment' AND subject_id = ?`), ev.ID); err != nil {
+				return err
+			}
+			return s.hide(ctx, ex, ev.ID)
+		}
+	}
+	return nil
+}
+
+// contentEntry returns the entry of a content event. Only upserts of single
+// entries carry a title; bulk changes have no entry.
+func contentEntry(eventType string, ev outbox.ContentEvent) (Event, bool) {
+	if ev.ID == "" || ev.Title == "" {
+		return Event{}, false
+	}
+	e := Event{SubjectType: ev.Type, SubjectID: ev.ID, Title: ev.Title, Public: ev.Public}
+	published := eventType == outbox.EventContentPublished
+	switch ev.Type {
+	case "blog":
+		e.Type = PostUpdated
+		if published {
+			e.Type = PostPublished
+		}
+	case "project":
+		e.Type = ProjectUpdated
+		if published {
+			e.Type = ProjectPublished
+		}
+	case "idea":
+		switch {
+		case published:
+			e.Type = IdeaPublished
+		case ev.PreviousStatus != "" && ev.PreviousStatus != ev.Status:
+			e.Type = IdeaStatusChanged
+			e.Summary = ev.PreviousStatus + " → " + ev.Status
+		default:
+			e.Type = IdeaUpdated
+		}
+	case "site_update":
+		if !published {
+			return Event{}, false
+		}
+		e.Type = SiteUpdatePublished
+	default:
+		return Event{}, false
+	}
+	return e, true
+}
+
+func (s *Store) insert(ctx context.Context, ex outbox.Execer, e Event) error {
+	_, err := ex.ExecContext(ctx, s.rebind(
+		`INSERT INTO events (id, event_type, subject_type, subject_id, parent_type, parent_id, title, summary, actor, public, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		uuid.New().String(), e.Type, e.SubjectType, e.SubjectID, e.ParentType, e.ParentID,
+		clip(e.Title, 255), clip(e.Summary, summaryLength), clip(e.Actor, 255), e.Public, time.Now().UTC(),
+	)
+	return err
+}
+
+// hide takes the entries of id, and of comments on it, off the public feed.
+func (s *Store) hide(ctx context.Context, ex outbox.Execer, id string) error {
+	if id == "" {
+		return nil
+	}
+	_, err := ex.ExecContext(ctx, s.rebind(
+		`UPDATE events SET public = ? WHERE subject_id = ? OR parent_id = ?`), false, id, id)
+	return err
+}
+
+// List returns the entries matching q, newest first.
+func (s *Store) List(ctx context.Context, q Query) ([]Event, error) {
+	var where []string
+	var args []any
+	if q.PublicOnly {
+		where = append(where, `public = ?`)
+		args = append(args, true)
+	}
+	if len(q.Types) > 0 {
+		where = append(where, `event_type IN (?`+strings.Repeat(`, ?`, len(q.Types)-1)+`)`)
+		for _, t := range q.Types {
+			args = append(args, t)
+		}
+	}
+	if !q.Before.IsZero() {
+		where = append(where, `created_at < ?`)
+		args = append(args, q.Before.UTC())
+	}
+	if !q.Since.IsZero() {
+		where = append(where, `created_at > ?`)
+		args = append(args, q.Since.UTC())
+	}
+	query := `SELECT id, event_type, subject_type, subject_id, parent_type, parent_id, title, summary, actor, public, created_at
+		FROM events`
+	if len(where) > 0 {
+		query += ` WHERE ` + strings.Join(where, ` AND `)
+	}
+	query += ` ORDER BY created_at DESC, id DESC LIMIT ` + strconv.Itoa(q.Limit)
+	return s.query(ctx, query, args...)
+}
+
+func (s *Store) query(ctx context.Context, query string, args ...any) ([]Event, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []Event
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.ID, &e.Type, &e.SubjectType, &e.SubjectID, &e.ParentType, &e.ParentID,
+			&e.Title, &e.Summary, &e.Actor, &e.Public, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, e)
+	}
+	return list, rows.Err()
+}
+
+// clip shortens s to at most n characters.
+func clip(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return strings.TrimSpace(string(r[:n-1])) + "…"
+}
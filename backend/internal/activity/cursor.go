@@ -0,0 +1,73 @@
+package activity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cursor is a position in the log, after the entry it was taken from.
+// Entries are ordered by creation time and then by ID, so entries created
+// in the same instant aren't skipped or read twice.
+type Cursor struct {
+	CreatedAt time.Time
+	EventID   string
+}
+
+// At returns the cursor after e.
+func At(e Event) Cursor {
+	return Cursor{CreatedAt: e.CreatedAt, EventID: e.ID}
+}
+
+// Cursor returns the position a consumer of the log has read up to, and
+// false when it hasn't stored one yet.
+func (s *Store) Cursor(ctx context.Context, consumer string) (Cursor, bool, error) {
+	var c Cursor
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT created_at, event_id FROM activity_cursors WHERE consumer = ?`), consumer,
+	).Scan(&c.CreatedAt, &c.EventID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Cursor{}, false, nil
+	}
+	if err != nil {
+		return Cursor{}, false, err
+	}
+	return c, true, nil
+}
+
+// SetCursor stores the position a consumer has read up to.
+func (s *Store) SetCursor(ctx context.Context, consumer string, c Cursor) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE activity_cursors SET created_at = ?, event_id = ? WHERE consumer = ?`),
+		c.CreatedAt.UTC(), c.EventID, consumer)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO activity_cursors (consumer, created_at, event_id) VALUES (?, ?, ?)`),
+		consumer, c.CreatedAt.UTC(), c.EventID)
+	return err
+}
+
+// ListAfter returns up to limit entries of types after c and created
+// before until, oldest first.
+func (s *Store) ListAfter(ctx context.Context, types []string, c Cursor, until time.Time, limit int) ([]Event, error) {
+	where := []string{`(created_at > ? OR (created_at = ? AND id > ?))`, `created_at < ?`}
+	args := []any{c.CreatedAt.UTC(), c.CreatedAt.UTC(), c.EventID, until.UTC()}
+	if len(types) > 0 {
+		where = append(where, `event_type IN (?`+strings.Repeat(`, ?`, len(types)-1)+`)`)
+		for _, t := range types {
+			args = append(args, t)
+		}
+	}
+	query := `SELECT id, event_type, subject_type, subject_id, parent_type, parent_id, title, summary, actor, public, created_at
+		FROM events WHERE ` + strings.Join(where, ` AND `) +
+		` ORDER BY created_at ASC, id ASC LIMIT ` + strconv.Itoa(limit)
+	return s.query(ctx, query, args...)
+}
@@ -0,0 +1,220 @@
+// Package ama keeps the owner's "ask me anything" sessions. Visitors ask
+// questions as comments on the AMA while it is open; the owner marks the
+// replies that answer them. Once closed the thread is read-only.
+package ama
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown AMAs.
+var ErrNotFound = errors.New("AMA not found")
+
+// EntityType is the comment entity type of AMA questions and answers.
+const EntityType = "ama"
+
+// Statuses of an AMA, from its window.
+const (
+	StatusUpcoming = "upcoming"
+	StatusOpen     = "open"
+	StatusClosed   = "closed"
+)
+
+// AMA is a time-boxed question thread.
+type AMA struct {
+	ID          string
+	Title       string
+	Description string
+	OpensAt     time.Time
+	ClosesAt    time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Status returns whether the AMA takes questions at now.
+func (a *AMA) Status(now time.Time) string {
+	switch {
+	case now.Before(a.OpensAt):
+		return StatusUpcoming
+	case now.Before(a.ClosesAt):
+		return StatusOpen
+	}
+	return StatusClosed
+}
+
+// Data converts the AMA to its API representation at now.
+func (a *AMA) Data(now time.Time, questions int) types.AmaData {
+	return types.AmaData{
+		ID:            a.ID,
+		Title:         a.Title,
+		Description:   a.Description,
+		OpensAt:       utils.FormatTime(a.OpensAt),
+		ClosesAt:      utils.FormatTime(a.ClosesAt),
+		Status:        a.Status(now),
+		QuestionCount: questions,
+	}
+}
+
+// Store persists AMAs in the raw amas table and the marked answers in
+// ama_answers.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+const amaColumns = `id, title, description, opens_at, closes_at, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAMA(row scanner) (*AMA, error) {
+	var (
+		a           AMA
+		description sql.NullString
+	)
+	if err := row.Scan(&a.ID, &a.Title, &description, &a.OpensAt, &a.ClosesAt, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		return nil, err
+	}
+	a.Description = description.String
+	return &a, nil
+}
+
+// List returns all AMAs, the latest to open first.
+func (s *Store) List(ctx context.Context) ([]*AMA, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+amaColumns+` FROM amas ORDER BY opens_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*AMA
+	for rows.Next() {
+		a, err := scanAMA(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, a)
+	}
+	return list, rows.Err()
+}
+
+// Get returns an AMA by ID.
+func (s *Store) Get(ctx context.Context, id string) (*AMA, error) {
+	a, err := scanAMA(s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT `+amaColumns+` FROM amas WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return a, err
+}
+
+// Create stores a new AMA and fills in its ID and timestamps.
+func (s *Store) Create(ctx context.Context, a *AMA) error {
+	now := time.Now().UTC()
+	a.ID = uuid.New().String()
+	a.CreatedAt = now
+	a.UpdatedAt = now
+	_, err := s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO amas (id, title, description, opens_at, closes_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		a.ID, a.Title, a.Description, a.OpensAt, a.ClosesAt, a.CreatedAt, a.UpdatedAt,
+	)
+	return err
+}
+
+// Update saves all editable fields of an AMA.
+func (s *Store) Update(ctx context.Context, a *AMA) error {
+	a.UpdatedAt = time.Now().UTC()
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE amas SET title = ?, description = ?, opens_at = ?, closes_at = ?, updated_at = ? WHERE id = ?`),
+		a.Title, a.Description, a.OpensAt, a.ClosesAt, a.UpdatedAt, a.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes an AMA and its marked answers. Its comments are left to
+// the caller.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	if _, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM ama_answers WHERE ama_id = ?`), id); err != nil {
+		return err
+	}
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM amas WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// MarkAnswer marks the reply answerID as an answer to questionID.
+func (s *Store) MarkAnswer(ctx context.Context, amaID, questionID, answerID string) error {
+	var exists int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM ama_answers WHERE answer_id = ?`), answerID).Scan(&exists)
+	if err != nil || exists > 0 {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO ama_answers (answer_id, ama_id, question_id, marked_at) VALUES (?, ?, ?, ?)`),
+		answerID, amaID, questionID, time.Now().UTC(),
+	)
+	return err
+}
+
+// UnmarkAnswer takes back the answer mark of a reply. It reports whether
+// the reply was marked.
+func (s *Store) UnmarkAnswer(ctx context.Context, amaID, answerID string) (bool, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`DELETE FROM ama_answers WHERE ama_id = ? AND answer_id = ?`), amaID, answerID)
+	if err != nil {
+		return false, err
+	}
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+// Answers returns the marked answers of an AMA by question ID, in the
+// order they were marked.
+func (s *Store) Answers(ctx context.Context, amaID string) (map[string][]string, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT question_id, answer_id FROM ama_answers WHERE ama_id = ? ORDER BY marked_at`), amaID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	answers := make(map[string][]string)
+	for rows.Next() {
+		var questionID, answerID string
+		if err := rows.Scan(&questionID, &answerID); err != nil {
+			return nil, err
+		}
+		answers[questionID] = append(answers[questionID], answerID)
+	}
+	return answers, rows.Err()
+}
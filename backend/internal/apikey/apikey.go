@@ -0,0 +1,328 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	entapikey "silan-backend/internal/ent/apikey"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned when a key does not exist or has been revoked.
+var ErrNotFound = errors.New("api key not found")
+
+// ScopeAdmin lets a key call the admin API (content sync, admin operations)
+// in place of the admin token.
+const ScopeAdmin = "admin"
+
+// Read scopes let a key call the embed endpoint of one public resource,
+// named after the resource: read:projects for /api/v1/embed/projects.
+const (
+	ScopeReadProjects = "read:projects"
+	ScopeReadPosts    = "read:posts"
+)
+
+// ValidScope reports whether scope can be granted to a key.
+func ValidScope(scope string) bool {
+	switch scope {
+	case ScopeAdmin, ScopeReadProjects, ScopeReadPosts:
+		return true
+	}
+	return false
+}
+
+// Publishable reports whether scopes only grant read access. Such keys are
+// meant to be embedded in other sites: they start with "pk_" instead of
+// "sk_" and may be passed in the api_key query parameter.
+func Publishable(scopes []string) bool {
+	for _, scope := range scopes {
+		if !strings.HasPrefix(scope, "read:") {
+			return false
+		}
+	}
+	return len(scopes) > 0
+}
+
+// Key is an API key record. The plaintext key is never stored; only its
+// SHA-256 hash and a short prefix used to recognise it in listings.
+type Key struct {
+	ID           string
+	Name         string
+	Prefix       string
+	DailyQuota   int
+	MonthlyQuota int
+	Scopes       []string
+	CreatedAt    time.Time
+	LastUsedAt   *time.Time
+	RevokedAt    *time.Time
+}
+
+// DailyUsage is the request count of a key for one UTC day (YYYY-MM-DD).
+type DailyUsage struct {
+	Day   string
+	Count int
+}
+
+// Store persists API keys in the ent api_keys table and their usage
+// counters in the raw api_key_usage table.
+type Store struct {
+	db     *sql.DB
+	driver string
+	client *ent.Client
+}
+
+func NewStore(db *sql.DB, driver string, client *ent.Client) *Store {
+	return &Store{db: db, driver: driver, client: client}
+}
+
+// Generate creates a new random plaintext key, publishable ones with the
+// "pk_" prefix.
+func Generate(publishable bool) (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	if publishable {
+		return "pk_" + hex.EncodeToString(buf), nil
+	}
+	return "sk_" + hex.EncodeToString(buf), nil
+}
+
+// Hash returns the hex SHA-256 digest stored for a plaintext key.
+func Hash(plain string) string {
+	sum := sha256.Sum256([]byte(plain))
+	return hex.EncodeToString(sum[:])
+}
+
+func prefixOf(plain string) string {
+	if len(plain) > 11 {
+		return plain[:11]
+	}
+	return plain
+}
+
+// HasScope reports whether the key was granted scope.
+func (k *Key) HasScope(scope string) bool {
+	return slices.Contains(k.Scopes, scope)
+}
+
+// Publishable reports whether the key only grants read access.
+func (k *Key) Publishable() bool {
+	return Publishable(k.Scopes)
+}
+
+// Create stores a new key and returns it together with its plaintext value,
+// which is only available at creation time.
+func (s *Store) Create(ctx context.Context, name string, dailyQuota, monthlyQuota int, scopes []string) (*Key, string, error) {
+	plain, err := Generate(Publishable(scopes))
+	if err != nil {
+		return nil, "", err
+	}
+	row, err := s.client.ApiKey.Create().
+		SetID(uuid.New().String()).
+		SetName(name).
+		SetKeyPrefix(prefixOf(plain)).
+		SetKeyHash(Hash(plain)).
+		SetDailyQuota(dailyQuota).
+		SetMonthlyQuota(monthlyQuota).
+		SetScopes(scopes).
+		SetCreatedAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return keyFrom(row), plain, nil
+}
+
+func keyFrom(row *ent.ApiKey) *Key {
+	scopes := slices.Clone(row.Scopes)
+	slices.Sort(scopes)
+	return &Key{
+		ID:           row.ID,
+		Name:         row.Name,
+		Prefix:       row.KeyPrefix,
+		DailyQuota:   row.DailyQuota,
+		MonthlyQuota: row.MonthlyQuota,
+		Scopes:       scopes,
+		CreatedAt:    row.CreatedAt,
+		LastUsedAt:   row.LastUsedAt,
+		RevokedAt:    row.RevokedAt,
+	}
+}
+
+// Lookup resolves an active key from its plaintext value.
+func (s *Store) Lookup(ctx context.Context, plain string) (*Key, error) {
+	row, err := s.client.ApiKey.Query().
+		Where(entapikey.KeyHash(Hash(plain)), entapikey.RevokedAtIsNil()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return keyFrom(row), nil
+}
+
+// Get returns a key (revoked or not) by ID.
+func (s *Store) Get(ctx context.Context, id string) (*Key, error) {
+	row, err := s.client.ApiKey.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return keyFrom(row), nil
+}
+
+// List returns all keys, newest first.
+func (s *Store) List(ctx context.Context) ([]*Key, error) {
+	rows, err := s.client.ApiKey.Query().
+		Order(ent.Desc(entapikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*Key, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, keyFrom(row))
+	}
+	return keys, nil
+}
+
+// UpdateQuota changes the daily and monthly limits of a key. Zero means unlimited.
+func (s *Store) UpdateQuota(ctx context.Context, id string, dailyQuota, monthlyQuota int) error {
+	err := s.client.ApiKey.UpdateOneID(id).
+		SetDailyQuota(dailyQuota).
+		SetMonthlyQuota(monthlyQuota).
+		Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Counts returns the number of requests made with the key today and in the
+// current calendar month (both UTC).
+func (s *Store) Counts(ctx context.Context, id string, now time.Time) (day, month int, err error) {
+	now = now.UTC()
+	today := now.Format("2006-01-02")
+	monthStart := now.Format("2006-01") + "-01"
+
+	var daySum, monthSum sql.NullInt64
+	err = s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT
+			SUM(CASE WHEN day = ? THEN request_count ELSE 0 END),
+			SUM(request_count)
+		FROM api_key_usage WHERE key_id = ? AND day >= ?`),
+		today, id, monthStart,
+	).Scan(&daySum, &monthSum)
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(daySum.Int64), int(monthSum.Int64), nil
+}
+
+// Increment counts one request against the key for the current UTC day.
+func (s *Store) Increment(ctx context.Context, id string, now time.Time) error {
+	now = now.UTC()
+	today := now.Format("2006-01-02")
+
+	var query string
+	if s.driver == "mysql" {
+		query = `INSERT INTO api_key_usage (key_id, day, request_count) VALUES (?, ?, 1)
+			ON DUPLICATE KEY UPDATE request_count = request_count + 1`
+	} else {
+		query = `INSERT INTO api_key_usage (key_id, day, request_count) VALUES (?, ?, 1)
+			ON CONFLICT (key_id, day) DO UPDATE SET request_count = api_key_usage.request_count + 1`
+	}
+	if _, err := s.db.ExecContext(ctx, utils.Rebind(s.driver, query), id, today); err != nil {
+		return err
+	}
+
+	return s.client.ApiKey.UpdateOneID(id).SetLastUsedAt(now).Exec(ctx)
+}
+
+// Usage returns the daily request counts of a key since the given day.
+func (s *Store) Usage(ctx context.Context, id string, since time.Time) ([]DailyUsage, error) {
+	rows, err := s.db.QueryContext(ctx, utils.Rebind(s.driver,
+		`SELECT day, request_count FROM api_key_usage WHERE key_id = ? AND day >= ? ORDER BY day`),
+		id, since.UTC().Format("2006-01-02"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var usage []DailyUsage
+	for rows.Next() {
+		var u DailyUsage
+		if err := rows.Scan(&u.Day, &u.Count); err != nil {
+			return nil, err
+		}
+		usage = append(usage, u)
+	}
+	return usage, rows.Err()
+}
+
+type ctxKey struct{}
+
+// WithKey attaches the authenticated key to the request context.
+func WithKey(ctx context.Context, key *Key) context.Context {
+	return context.WithValue(ctx, ctxKey{}, key)
+}
+
+// FromContext returns the key that authenticated the request, if any.
+func FromContext(ctx context.Context) (*Key, bool) {
+	key, ok := ctx.Value(ctxKey{}).(*Key)
+	return key, ok && key != nil
+}
+
+// Report summarises a key's usage against its quotas.
+type Report struct {
+	Key       *Key
+	UsedToday int
+	UsedMonth int
+	Daily     []DailyUsage
+}
+
+// Report builds a usage report covering the last `days` days.
+func (s *Store) Report(ctx context.Context, key *Key, days int) (*Report, error) {
+	if days <= 0 {
+		days = 30
+	}
+	now := time.Now()
+	day, month, err := s.Counts(ctx, key.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	daily, err := s.Usage(ctx, key.ID, now.AddDate(0, 0, -days+1))
+	if err != nil {
+		return nil, err
+	}
+	return &Report{Key: key, UsedToday: day, UsedMonth: month, Daily: daily}, nil
+}
+
+// Remaining returns how many requests are left today and this month.
+// Unlimited quotas are reported as -1.
+func (r *Report) Remaining() (day, month int) {
+	day, month = -1, -1
+	if r.Key.DailyQuota > 0 {
+		day = max(r.Key.DailyQuota-r.UsedToday, 0)
+	}
+	if r.Key.MonthlyQuota > 0 {
+		month = max(r.Key.MonthlyQuota-r.UsedMonth, 0)
+	}
+	return day, month
+}
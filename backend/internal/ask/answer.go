@@ -0,0 +1,29 @@
+package ask
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/llm"
+)
+
+const systemPrompt = `You answer visitors' questions about Silan's personal website using only the numbered sources provided.
+Cite the sources you use inline as [1], [2] and so on. If the sources do not answer the question, say so briefly instead of guessing.
+Answer in the language of the question.`
+
+// Answer asks the model to answer question from the given chunks. The
+// chunks are numbered from 1 in the order given, matching the citations.
+func Answer(ctx context.Context, client *llm.Client, question string, chunks []*Chunk) (string, error) {
+	var prompt strings.Builder
+	for i, c := range chunks {
+		fmt.Fprintf(&prompt, "[%d] %s (%s)\n%s\n\n", i+1, c.Title, c.Type, c.Text)
+	}
+	fmt.Fprintf(&prompt, "Question: %s", question)
+
+	answer, err := client.Complete(ctx, systemPrompt, prompt.String())
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(answer), nil
+}
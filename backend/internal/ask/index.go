@@ -0,0 +1,286 @@
+// Package ask answers visitor questions about the site. Published posts,
+// project details and public ideas are split into chunks and kept in an
+// in-memory BM25 keyword index; the best chunks are handed to the language
+// model as numbered sources it has to cite.
+package ask
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/outbox"
+)
+
+// chunkChars is the size chunks are built up to from whole paragraphs.
+const chunkChars = 1200
+
+// BM25 parameters.
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// Chunk is a passage of one piece of content.
+type Chunk struct {
+	Type  string
+	ID    string
+	Title string
+	URL   string
+	Text  string
+
+	terms  map[string]int
+	length int
+}
+
+// Snippet returns the start of the chunk, cut to at most n characters on a
+// word boundary where possible.
+func (c *Chunk) Snippet(n int) string {
+	text := strings.Join(strings.Fields(c.Text), " ")
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	cut := string([]rune(text)[:n])
+	if i := strings.LastIndexByte(cut, ' '); i > len(cut)/2 {
+		cut = cut[:i]
+	}
+	return cut + "…"
+}
+
+// Index holds the chunks of all public content. It is built on first use and
+// dropped whenever content changes.
+type Index struct {
+	client  *ent.Client
+	siteURL string
+
+	// build serializes builds; mu guards the index fields below
+	build  sync.Mutex
+	mu     sync.RWMutex
+	built  bool
+	chunks []*Chunk
+	df     map[string]int
+	avgLen float64
+}
+
+func NewIndex(client *ent.Client, siteURL string) *Index {
+	return &Index{client: client, siteURL: siteURL}
+}
+
+// Handle is an outbox handler that drops the index on content events so
+// the next question rebuilds it.
+func (x *Index) Handle(_ context.Context, ev outbox.Event) error {
+	if outbox.IsContentEvent(ev.Type) {
+		x.mu.Lock()
+		x.built = false
+		x.mu.Unlock()
+	}
+	return nil
+}
+
+// Search returns up to limit chunks ranked by BM25 score for query. Chunks
+// sharing no term with the query are never returned.
+func (x *Index) Search(ctx context.Context, query string, limit int) ([]*Chunk, error) {
+	if err := x.ensure(ctx); err != nil {
+		return nil, err
+	}
+
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	type scored struct {
+		chunk *Chunk
+		score float64
+	}
+	var results []scored
+	terms := Tokenize(query)
+	n := float64(len(x.chunks))
+	for _, c := range x.chunks {
+		score := 0.0
+		for _, t := range terms {
+			tf := float64(c.terms[t])
+			if tf == 0 {
+				continue
+			}
+			df := float64(x.df[t])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*float64(c.length)/x.avgLen))
+		}
+		if score > 0 {
+			results = append(results, scored{c, score})
+		}
+	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i].score > results[j].score })
+	if len(results) > limit {
+		results = results[:limit]
+	}
+	out := make([]*Chunk, len(results))
+	for i, r := range results {
+		out[i] = r.chunk
+	}
+	return out, nil
+}
+
+func (x *Index) ensure(ctx context.Context) error {
+	x.mu.RLock()
+	built := x.built
+	x.mu.RUnlock()
+	if built {
+		return nil
+	}
+
+	x.build.Lock()
+	defer x.build.Unlock()
+	x.mu.RLock()
+	built = x.built
+	x.mu.RUnlock()
+	if built {
+		return nil
+	}
+
+	chunks, err := x.load(ctx)
+	if err != nil {
+		return err
+	}
+	df := map[string]int{}
+	total := 0
+	for _, c := range chunks {
+		for t := range c.terms {
+			df[t]++
+		}
+		total += c.length
+	}
+	avgLen := 1.0
+	if len(chunks) > 0 && total > 0 {
+		avgLen = float64(total) / float64(len(chunks))
+	}
+
+	x.mu.Lock()
+	x.chunks, x.df, x.avgLen, x.built = chunks, df, avgLen, true
+	x.mu.Unlock()
+	return nil
+}
+
+// load reads the public content and splits it into chunks.
+func (x *Index) load(ctx context.Context) ([]*Chunk, error) {
+	var chunks []*Chunk
+	add := func(kind, id, title, path string, parts ...string) {
+		for _, text := range split(strings.Join(parts, "\n\n")) {
+			c := &Chunk{Type: kind, ID: id, Title: title, URL: x.siteURL + path, Text: text, terms: map[string]int{}}
+			for _, t := range Tokenize(title + " " + text) {
+				c.terms[t]++
+				c.length++
+			}
+			chunks = append(chunks, c)
+		}
+	}
+
+	posts, err := x.client.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		add("blog", p.ID.String(), p.Title, "/blog/"+p.ID.String(), p.Excerpt, p.Content)
+	}
+
+	projects, err := x.client.Project.Query().
+		Where(project.IsPublic(true)).
+		WithDetails().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		parts := []string{p.Description}
+		if d := p.Edges.Details; d != nil {
+			parts = append(parts, d.ProjectDetails, d.QuickStart)
+		}
+		add("project", p.ID.String(), p.Title, "/projects/"+p.ID.String(), parts...)
+	}
+
+	ideas, err := x.client.Idea.Query().
+		Where(idea.IsPublic(true)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ideas {
+		add("idea", i.ID.String(), i.Title, "/ideas/"+i.ID.String(), i.Abstract, i.Description)
+	}
+	return chunks, nil
+}
+
+// split groups the paragraphs of text into chunks of about chunkChars.
+// Paragraphs longer than that become chunks of their own.
+func split(text string) []string {
+	var (
+		chunks []string
+		cur    strings.Builder
+	)
+	for _, para := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		para = strings.TrimSpace(para)
+		if para == "" {
+			continue
+		}
+		if cur.Len() > 0 && utf8.RuneCountInString(cur.String())+utf8.RuneCountInString(para) > chunkChars {
+			chunks = append(chunks, cur.String())
+			cur.Reset()
+		}
+		if cur.Len() > 0 {
+			cur.WriteString("\n\n")
+		}
+		cur.WriteString(para)
+	}
+	if cur.Len() > 0 {
+		chunks = append(chunks, cur.String())
+	}
+	return chunks
+}
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "do": true, "does": true, "for": true, "from": true,
+	"how": true, "i": true, "in": true, "is": true, "it": true, "of": true,
+	"on": true, "or": true, "that": true, "the": true, "this": true, "to": true,
+	"was": true, "what": true, "when": true, "where": true, "which": true,
+	"who": true, "why": true, "with": true, "you": true, "your": true,
+}
+
+// Tokenize lowercases s and splits it into words, leaving out stop words.
+// Han characters have no spaces between words, so each one is a token of
+// its own.
+func Tokenize(s string) []string {
+	var (
+		tokens []string
+		word   strings.Builder
+	)
+	flush := func() {
+		if w := word.String(); w != "" && !stopWords[w] {
+			tokens = append(tokens, w)
+		}
+		word.Reset()
+	}
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			flush()
+			tokens = append(tokens, string(r))
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word.WriteRune(r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return tokens
+}
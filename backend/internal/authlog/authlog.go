@@ -0,0 +1,172 @@
+// Package authlog records sign-in activity: every identity verification,
+// login, session refresh and logout, whether it succeeded or not, with the
+// provider, network address and user agent it came from. Failed attempts
+// against the site owner's identity are the main thing to look for.
+package authlog
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/authevent"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// Event types.
+const (
+	EventVerify  = "verify"
+	EventLogin   = "login"
+	EventRefresh = "refresh"
+	EventLogout  = "logout"
+)
+
+// Outcomes of an event.
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// maxReason caps the stored failure reason.
+const maxReason = 255
+
+// Event is one authentication attempt. IdentityID and Email are empty when
+// the attempt failed before the user was known.
+type Event struct {
+	ID         string
+	Type       string
+	Provider   string
+	IdentityID string
+	Email      string
+	IP         string
+	UserAgent  string
+	Outcome    string
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// Data converts the event to its API representation.
+func (e *Event) Data() types.AuthEventData {
+	return types.AuthEventData{
+		ID:         e.ID,
+		Type:       e.Type,
+		Provider:   e.Provider,
+		IdentityID: e.IdentityID,
+		Email:      e.Email,
+		IP:         e.IP,
+		UserAgent:  e.UserAgent,
+		Outcome:    e.Outcome,
+		Reason:     e.Reason,
+		CreatedAt:  utils.FormatTime(e.CreatedAt),
+	}
+}
+
+// Filter narrows a listing. Empty fields match everything; IdentityIDs and
+// Emails match events of any of the given identities or addresses.
+type Filter struct {
+	Type        string
+	Outcome     string
+	IP          string
+	IdentityIDs []string
+	Emails      []string
+	Since       time.Time
+	Limit       int
+}
+
+// Store keeps events in the ent auth_events table.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Record stores e, filling in its ID and time.
+func (s *Store) Record(ctx context.Context, e *Event) error {
+	e.ID = uuid.New().String()
+	e.CreatedAt = time.Now().UTC()
+	e.Email = strings.ToLower(strings.TrimSpace(e.Email))
+	if len(e.Reason) > maxReason {
+		e.Reason = e.Reason[:maxReason]
+	}
+	if len(e.UserAgent) > 500 {
+		e.UserAgent = e.UserAgent[:500]
+	}
+	return s.client.AuthEvent.Create().
+		SetID(e.ID).
+		SetType(e.Type).
+		SetProvider(e.Provider).
+		SetIdentityID(e.IdentityID).
+		SetEmail(e.Email).
+		SetIP(e.IP).
+		SetUserAgent(e.UserAgent).
+		SetOutcome(e.Outcome).
+		SetReason(e.Reason).
+		SetCreatedAt(e.CreatedAt).
+		Exec(ctx)
+}
+
+// List returns the events matching f, newest first.
+func (s *Store) List(ctx context.Context, f Filter) ([]*Event, error) {
+	query := s.client.AuthEvent.Query()
+	if f.Type != "" {
+		query = query.Where(authevent.Type(f.Type))
+	}
+	if f.Outcome != "" {
+		query = query.Where(authevent.Outcome(f.Outcome))
+	}
+	if f.IP != "" {
+		query = query.Where(authevent.IP(f.IP))
+	}
+	if !f.Since.IsZero() {
+		query = query.Where(authevent.CreatedAtGTE(f.Since))
+	}
+	var who []predicate.AuthEvent
+	if len(f.IdentityIDs) > 0 {
+		who = append(who, authevent.IdentityIDIn(f.IdentityIDs...))
+	}
+	for _, email := range f.Emails {
+		who = append(who, authevent.Email(strings.ToLower(email)))
+	}
+	if len(who) > 0 {
+		query = query.Where(authevent.Or(who...))
+	}
+	rows, err := query.
+		Order(ent.Desc(authevent.FieldCreatedAt)).
+		Limit(f.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*Event, 0, len(rows))
+	for _, row := range rows {
+		events = append(events, &Event{
+			ID:         row.ID,
+			Type:       row.Type,
+			Provider:   row.Provider,
+			IdentityID: row.IdentityID,
+			Email:      row.Email,
+			IP:         row.IP,
+			UserAgent:  row.UserAgent,
+			Outcome:    row.Outcome,
+			Reason:     row.Reason,
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	return events, nil
+}
+
+// Purge deletes events recorded before cutoff.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	n, err := s.client.AuthEvent.Delete().
+		Where(authevent.CreatedAtLT(cutoff)).
+		Exec(ctx)
+	return int64(n), err
+}
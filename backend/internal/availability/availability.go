@@ -0,0 +1,196 @@
+// Package availability keeps the owner's work availability and the office
+// hour slots visitors can book, shown on the contact page.
+package availability
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown office hour slots.
+var ErrNotFound = errors.New("office hour slot not found")
+
+// Statuses lists the accepted availability statuses.
+const (
+	StatusOpenToWork   = "open_to_work"
+	StatusOpenToCollab = "open_to_collab"
+	StatusBusy         = "busy"
+	StatusUnavailable  = "unavailable"
+)
+
+// Status is the owner's current availability. BusyUntil is only kept for
+// the busy status.
+type Status struct {
+	Status    string
+	Message   string
+	BusyUntil *time.Time
+	UpdatedAt time.Time
+}
+
+// Effective returns the status shown at now: a busy status whose end date
+// has passed reads as open to work again.
+func (s *Status) Effective(now time.Time) string {
+	if s.Status == StatusBusy && s.BusyUntil != nil && !now.Before(*s.BusyUntil) {
+		return StatusOpenToWork
+	}
+	return s.Status
+}
+
+// Slot is a bookable office hour. BookingURL is where visitors reserve it,
+// such as a scheduling page or a mailto link.
+type Slot struct {
+	ID         string
+	Title      string
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Location   string
+	BookingURL string
+	CreatedAt  time.Time
+}
+
+// Store persists the status in the raw availability table (a single row)
+// and the slots in office_hour_slots.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// statusID is the key of the only availability row.
+const statusID = "owner"
+
+// Status returns the current availability. Until the owner sets one the
+// site reports being open to collaboration.
+func (s *Store) Status(ctx context.Context) (*Status, error) {
+	var (
+		st        Status
+		busyUntil sql.NullTime
+	)
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT status, message, busy_until, updated_at FROM availability WHERE id = ?`), statusID,
+	).Scan(&st.Status, &st.Message, &busyUntil, &st.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &Status{Status: StatusOpenToCollab}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if busyUntil.Valid {
+		st.BusyUntil = &busyUntil.Time
+	}
+	return &st, nil
+}
+
+// SetStatus replaces the current availability.
+func (s *Store) SetStatus(ctx context.Context, st *Status) error {
+	st.UpdatedAt = time.Now().UTC()
+	if st.Status != StatusBusy {
+		st.BusyUntil = nil
+	}
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE availability SET status = ?, message = ?, busy_until = ?, updated_at = ? WHERE id = ?`),
+		st.Status, st.Message, st.BusyUntil, st.UpdatedAt, statusID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO availability (id, status, message, busy_until, updated_at) VALUES (?, ?, ?, ?, ?)`),
+		statusID, st.Status, st.Message, st.BusyUntil, st.UpdatedAt,
+	)
+	return err
+}
+
+const slotColumns = `id, title, starts_at, ends_at, location, booking_url, created_at`
+
+// Upcoming returns the slots that haven't ended at now, soonest first.
+func (s *Store) Upcoming(ctx context.Context, now time.Time) ([]*Slot, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT `+slotColumns+` FROM office_hour_slots WHERE ends_at > ? ORDER BY starts_at`), now.UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var slots []*Slot
+	for rows.Next() {
+		var sl Slot
+		if err := rows.Scan(&sl.ID, &sl.Title, &sl.StartsAt, &sl.EndsAt, &sl.Location, &sl.BookingURL, &sl.CreatedAt); err != nil {
+			return nil, err
+		}
+		slots = append(slots, &sl)
+	}
+	return slots, rows.Err()
+}
+
+// CreateSlot stores sl, filling in its ID and creation time.
+func (s *Store) CreateSlot(ctx context.Context, sl *Slot) error {
+	sl.ID = uuid.New().String()
+	sl.CreatedAt = time.Now().UTC()
+	_, err := s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO office_hour_slots (`+slotColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		sl.ID, sl.Title, sl.StartsAt.UTC(), sl.EndsAt.UTC(), sl.Location, sl.BookingURL, sl.CreatedAt,
+	)
+	return err
+}
+
+// DeleteSlot removes a slot.
+func (s *Store) DeleteSlot(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM office_hour_slots WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Data converts the status and the upcoming slots for responses.
+func Data(st *Status, slots []*Slot, now time.Time) types.AvailabilityData {
+	data := types.AvailabilityData{
+		Status:  st.Effective(now),
+		Message: st.Message,
+		Slots:   make([]types.OfficeHourSlotData, 0, len(slots)),
+	}
+	if data.Status == StatusBusy && st.BusyUntil != nil {
+		data.BusyUntil = utils.FormatTime(*st.BusyUntil)
+	}
+	if !st.UpdatedAt.IsZero() {
+		data.UpdatedAt = utils.FormatTime(st.UpdatedAt)
+	}
+	for _, sl := range slots {
+		data.Slots = append(data.Slots, sl.Data())
+	}
+	return data
+}
+
+// Data converts the slot to its API representation.
+func (sl *Slot) Data() types.OfficeHourSlotData {
+	return types.OfficeHourSlotData{
+		ID:         sl.ID,
+		Title:      sl.Title,
+		StartsAt:   utils.FormatTime(sl.StartsAt),
+		EndsAt:     utils.FormatTime(sl.EndsAt),
+		Location:   sl.Location,
+		BookingURL: sl.BookingURL,
+	}
+}
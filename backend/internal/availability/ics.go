@@ -0,0 +1,28 @@
+package availability
+
+import (
+	"time"
+
+	"silan-backend/internal/ical"
+)
+
+// ICS renders slots as an iCalendar feed. host makes the event UIDs
+// globally unique.
+func ICS(slots []*Slot, host string, now time.Time) []byte {
+	events := make([]ical.Event, 0, len(slots))
+	for _, sl := range slots {
+		ev := ical.Event{
+			UID:      sl.ID,
+			Start:    sl.StartsAt,
+			End:      sl.EndsAt,
+			Summary:  sl.Title,
+			Location: sl.Location,
+			URL:      sl.BookingURL,
+		}
+		if sl.BookingURL != "" {
+			ev.Description = "Book this slot at " + sl.BookingURL
+		}
+		events = append(events, ev)
+	}
+	return ical.Calendar("Office hours", host, events, now)
+}
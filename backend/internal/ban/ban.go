@@ -0,0 +1,213 @@
+// Package ban keeps the visitors who may no longer comment, like or vote.
+// A ban matches a signed-in identity, an email address, a browser
+// fingerprint or an IP address; IP bans may also name a whole network in
+// CIDR notation. Fingerprint bans store the fingerprint hashed, and stop
+// matching once the salt they were hashed with is no longer kept.
+package ban
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	entban "silan-backend/internal/ent/ban"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown bans.
+var ErrNotFound = errors.New("ban not found")
+
+// Kinds of ban, named after what they match.
+const (
+	KindIdentity    = "identity"
+	KindEmail       = "email"
+	KindFingerprint = "fingerprint"
+	KindIP          = "ip"
+)
+
+// Ban blocks one actor. ExpiresAt is nil for permanent bans.
+type Ban struct {
+	ID        string
+	Kind      string
+	Value     string
+	Reason    string
+	CreatedAt time.Time
+	ExpiresAt *time.Time
+}
+
+// Actor is who is about to write: whatever of it is known is checked.
+// FingerprintHashes are Fingerprint hashed with every salt still kept; the
+// raw Fingerprint still matches bans from before hashing was introduced.
+type Actor struct {
+	IdentityID        string
+	Email             string
+	Fingerprint       string
+	FingerprintHashes []string
+	IP                string
+}
+
+// Data converts the ban to its API representation.
+func (b *Ban) Data() types.BanData {
+	data := types.BanData{
+		ID:        b.ID,
+		Kind:      b.Kind,
+		Value:     b.Value,
+		Reason:    b.Reason,
+		CreatedAt: utils.FormatTime(b.CreatedAt),
+	}
+	if b.ExpiresAt != nil {
+		data.ExpiresAt = utils.FormatTime(*b.ExpiresAt)
+	}
+	return data
+}
+
+// Normalize returns value in the form bans of kind are stored and matched
+// in, or an error when it can't be matched.
+func Normalize(kind, value string) (string, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("value is required")
+	}
+	switch kind {
+	case KindEmail:
+		return strings.ToLower(value), nil
+	case KindIP:
+		if ip := net.ParseIP(value); ip != nil {
+			return ip.String(), nil
+		}
+		if _, network, err := net.ParseCIDR(value); err == nil {
+			return network.String(), nil
+		}
+		return "", fmt.Errorf("value must be an IP address or CIDR network")
+	}
+	return value, nil
+}
+
+// Store persists bans in the ent bans table, one row per kind and value.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+func fromEnt(e *ent.Ban) *Ban {
+	return &Ban{
+		ID:        e.ID,
+		Kind:      e.Kind,
+		Value:     e.Value,
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+		ExpiresAt: e.ExpiresAt,
+	}
+}
+
+// Save stores b. Banning a value that is already banned replaces the
+// reason and expiry of the existing ban, whose ID is kept.
+func (s *Store) Save(ctx context.Context, b *Ban) error {
+	b.CreatedAt = time.Now().UTC()
+	existing, err := s.client.Ban.Query().
+		Where(entban.Kind(b.Kind), entban.Value(b.Value)).
+		Only(ctx)
+	if err == nil {
+		b.ID = existing.ID
+		update := existing.Update().
+			SetReason(b.Reason).
+			SetCreatedAt(b.CreatedAt)
+		if b.ExpiresAt != nil {
+			update.SetExpiresAt(*b.ExpiresAt)
+		} else {
+			update.ClearExpiresAt()
+		}
+		return update.Exec(ctx)
+	}
+	if !ent.IsNotFound(err) {
+		return err
+	}
+
+	b.ID = uuid.New().String()
+	return s.client.Ban.Create().
+		SetID(b.ID).
+		SetKind(b.Kind).
+		SetValue(b.Value).
+		SetReason(b.Reason).
+		SetCreatedAt(b.CreatedAt).
+		SetNillableExpiresAt(b.ExpiresAt).
+		Exec(ctx)
+}
+
+// List returns every ban, including expired ones, newest first.
+func (s *Store) List(ctx context.Context) ([]*Ban, error) {
+	rows, err := s.client.Ban.Query().
+		Order(ent.Desc(entban.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bans := make([]*Ban, 0, len(rows))
+	for _, e := range rows {
+		bans = append(bans, fromEnt(e))
+	}
+	return bans, nil
+}
+
+// Delete lifts a ban.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	err := s.client.Ban.DeleteOneID(id).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Match returns an active ban matching a, or nil when there is none.
+func (s *Store) Match(ctx context.Context, a Actor) (*Ban, error) {
+	matches := []predicate.Ban{entban.Kind(KindIP)}
+	for kind, value := range map[string]string{
+		KindIdentity:    strings.TrimSpace(a.IdentityID),
+		KindEmail:       strings.ToLower(strings.TrimSpace(a.Email)),
+		KindFingerprint: strings.TrimSpace(a.Fingerprint),
+	} {
+		if value != "" {
+			matches = append(matches, entban.And(entban.Kind(kind), entban.Value(value)))
+		}
+	}
+	for _, hash := range a.FingerprintHashes {
+		matches = append(matches, entban.And(entban.Kind(KindFingerprint), entban.Value(hash)))
+	}
+	rows, err := s.client.Ban.Query().
+		Where(
+			entban.Or(entban.ExpiresAtIsNil(), entban.ExpiresAtGT(time.Now().UTC())),
+			entban.Or(matches...),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(a.IP))
+	for _, e := range rows {
+		if e.Kind != KindIP || (ip != nil && matchIP(e.Value, ip)) {
+			return fromEnt(e), nil
+		}
+	}
+	return nil, nil
+}
+
+// matchIP reports whether ip is the address or lies in the network value.
+func matchIP(value string, ip net.IP) bool {
+	if _, network, err := net.ParseCIDR(value); err == nil {
+		return network.Contains(ip)
+	}
+	banned := net.ParseIP(value)
+	return banned != nil && banned.Equal(ip)
+}
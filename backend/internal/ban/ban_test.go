@@ -0,0 +1,96 @@
+package ban
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"silan-backend/internal/ent"
+
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	db, err := sql.Open("sqlite3", t.TempDir()+"/test.db?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return NewStore(client)
+}
+
+func TestMatch(t *testing.T) {
+	s := newTestStore(t)
+	ctx := context.Background()
+	past := time.Now().Add(-time.Hour)
+	for _, b := range []*Ban{
+		{Kind: KindEmail, Value: "spam@example.com"},
+		{Kind: KindIP, Value: "10.0.0.0/8"},
+		{Kind: KindFingerprint, Value: "h1:abc"},
+		{Kind: KindIdentity, Value: "u_expired", ExpiresAt: &past},
+	} {
+		if err := s.Save(ctx, b); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name   string
+		actor  Actor
+		banned bool
+	}{
+		{"email", Actor{Email: " Spam@Example.com "}, true},
+		{"ip in network", Actor{IP: "10.1.2.3"}, true},
+		{"fingerprint hash", Actor{FingerprintHashes: []string{"h1:old", "h1:abc"}}, true},
+		{"expired ban", Actor{IdentityID: "u_expired"}, false},
+		{"other visitor", Actor{Email: "a@example.com", IP: "192.168.0.1", IdentityID: "u_1"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := s.Match(ctx, tt.actor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (b != nil) != tt.banned {
+				t.Fatalf("got %+v, want banned %v", b, tt.banned)
+			}
+		})
+	}
+}
+
+func TestSaveReplacesBan(t *testing.T) {
+	s := newTestStore(t)
+	ctx := context.Background()
+	soon := time.Now().Add(time.Hour)
+
+	first := &Ban{Kind: KindEmail, Value: "spam@example.com", Reason: "spam", ExpiresAt: &soon}
+	if err := s.Save(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	again := &Ban{Kind: KindEmail, Value: "spam@example.com", Reason: "more spam"}
+	if err := s.Save(ctx, again); err != nil {
+		t.Fatal(err)
+	}
+	bans, err := s.List(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bans) != 1 || bans[0].ID != first.ID || again.ID != first.ID || bans[0].Reason != "more spam" || bans[0].ExpiresAt != nil {
+		t.Fatalf("got %+v", bans)
+	}
+
+	if err := s.Delete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Delete(ctx, first.ID); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("deleting twice: got %v, want ErrNotFound", err)
+	}
+}
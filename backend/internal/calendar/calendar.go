@@ -0,0 +1,164 @@
+// Package calendar keeps dated entries (talks, milestones, deadlines) for
+// the public calendar feed.
+package calendar
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"silan-backend/internal/ical"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown entries.
+var ErrNotFound = errors.New("calendar entry not found")
+
+// Kinds of entry.
+const (
+	KindTalk      = "talk"
+	KindMilestone = "milestone"
+	KindDeadline  = "deadline"
+)
+
+// Entry is a dated item of the calendar. EntityType and EntityID optionally
+// tie it to a project or idea; it is hidden while that content isn't
+// public. EndsAt is nil for entries without a duration.
+type Entry struct {
+	ID          string
+	Kind        string
+	Title       string
+	Description string
+	Location    string
+	URL         string
+	StartsAt    time.Time
+	EndsAt      *time.Time
+	AllDay      bool
+	EntityType  string
+	EntityID    string
+	CreatedAt   time.Time
+}
+
+// Data converts the entry to its API representation.
+func (e *Entry) Data() types.CalendarEntryData {
+	data := types.CalendarEntryData{
+		ID:          e.ID,
+		Kind:        e.Kind,
+		Title:       e.Title,
+		Description: e.Description,
+		Location:    e.Location,
+		URL:         e.URL,
+		StartsAt:    utils.FormatTime(e.StartsAt),
+		AllDay:      e.AllDay,
+		EntityType:  e.EntityType,
+		EntityID:    e.EntityID,
+		CreatedAt:   utils.FormatTime(e.CreatedAt),
+	}
+	if e.EndsAt != nil {
+		data.EndsAt = utils.FormatTime(*e.EndsAt)
+	}
+	return data
+}
+
+// Store persists entries in the raw calendar_entries table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+const entryColumns = `id, kind, title, description, location, url, starts_at, ends_at, all_day, entity_type, entity_id, created_at`
+
+// Since returns the entries starting at or after since, in date order.
+// A zero since returns every entry.
+func (s *Store) Since(ctx context.Context, since time.Time) ([]*Entry, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT `+entryColumns+` FROM calendar_entries WHERE starts_at >= ? ORDER BY starts_at`), since.UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*Entry
+	for rows.Next() {
+		var (
+			e    Entry
+			ends sql.NullTime
+		)
+		if err := rows.Scan(&e.ID, &e.Kind, &e.Title, &e.Description, &e.Location, &e.URL,
+			&e.StartsAt, &ends, &e.AllDay, &e.EntityType, &e.EntityID, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		if ends.Valid {
+			e.EndsAt = &ends.Time
+		}
+		entries = append(entries, &e)
+	}
+	return entries, rows.Err()
+}
+
+// Create stores e, filling in its ID and creation time.
+func (s *Store) Create(ctx context.Context, e *Entry) error {
+	e.ID = uuid.New().String()
+	e.CreatedAt = time.Now().UTC()
+	var ends any
+	if e.EndsAt != nil {
+		ends = e.EndsAt.UTC()
+	}
+	_, err := s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO calendar_entries (`+entryColumns+`) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		e.ID, e.Kind, e.Title, e.Description, e.Location, e.URL,
+		e.StartsAt.UTC(), ends, e.AllDay, e.EntityType, e.EntityID, e.CreatedAt,
+	)
+	return err
+}
+
+// Delete removes an entry.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM calendar_entries WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Event converts the entry for the iCalendar feed. pageURL is used as the
+// link when the entry has none of its own.
+func (e *Entry) Event(pageURL string) ical.Event {
+	ev := ical.Event{
+		UID:         e.ID,
+		Start:       e.StartsAt,
+		AllDay:      e.AllDay,
+		Summary:     e.Title,
+		Description: e.Description,
+		Location:    e.Location,
+		URL:         e.URL,
+		Categories:  []string{e.Kind},
+	}
+	if ev.URL == "" {
+		ev.URL = pageURL
+	}
+	if e.EndsAt != nil {
+		ev.End = *e.EndsAt
+		// Entries store the last day; iCalendar ends are exclusive
+		if e.AllDay {
+			ev.End = ev.End.AddDate(0, 0, 1)
+		}
+	}
+	return ev
+}
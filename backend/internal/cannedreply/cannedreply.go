@@ -0,0 +1,154 @@
+// Package cannedreply keeps the reusable responses the owner picks from when
+// replying to comments from the dashboard.
+package cannedreply
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown canned responses.
+var ErrNotFound = errors.New("canned response not found")
+
+// NamePlaceholder in a response is replaced with the name of the commenter
+// being replied to.
+const NamePlaceholder = "{name}"
+
+// Response is a reusable reply text.
+type Response struct {
+	ID        string
+	Title     string
+	Content   string
+	UseCount  int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Data converts the response to its API representation.
+func (r *Response) Data() types.CannedResponseData {
+	return types.CannedResponseData{
+		ID:        r.ID,
+		Title:     r.Title,
+		Content:   r.Content,
+		UseCount:  r.UseCount,
+		CreatedAt: utils.FormatTime(r.CreatedAt),
+		UpdatedAt: utils.FormatTime(r.UpdatedAt),
+	}
+}
+
+// Render returns the response text addressed to name.
+func (r *Response) Render(name string) string {
+	return strings.ReplaceAll(r.Content, NamePlaceholder, name)
+}
+
+// Store persists canned responses in the raw canned_responses table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+const responseColumns = `id, title, content, use_count, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanResponse(row scanner) (*Response, error) {
+	var r Response
+	if err := row.Scan(&r.ID, &r.Title, &r.Content, &r.UseCount, &r.CreatedAt, &r.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// List returns all canned responses, the most used first.
+func (s *Store) List(ctx context.Context) ([]*Response, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+responseColumns+` FROM canned_responses ORDER BY use_count DESC, title`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Response
+	for rows.Next() {
+		r, err := scanResponse(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, r)
+	}
+	return list, rows.Err()
+}
+
+// Get returns a canned response by ID.
+func (s *Store) Get(ctx context.Context, id string) (*Response, error) {
+	r, err := scanResponse(s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT `+responseColumns+` FROM canned_responses WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return r, err
+}
+
+// Create stores a new canned response and fills in its ID and timestamps.
+func (s *Store) Create(ctx context.Context, r *Response) error {
+	now := time.Now().UTC()
+	r.ID = uuid.New().String()
+	r.UseCount = 0
+	r.CreatedAt = now
+	r.UpdatedAt = now
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO canned_responses (id, title, content, use_count, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)`),
+		r.ID, r.Title, r.Content, r.UseCount, r.CreatedAt, r.UpdatedAt,
+	)
+	return err
+}
+
+// Update saves the title and content of a canned response.
+func (s *Store) Update(ctx context.Context, r *Response) error {
+	r.UpdatedAt = time.Now().UTC()
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE canned_responses SET title = ?, content = ?, updated_at = ? WHERE id = ?`),
+		r.Title, r.Content, r.UpdatedAt, r.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// MarkUsed counts one more reply sent with the canned response.
+func (s *Store) MarkUsed(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE canned_responses SET use_count = use_count + 1 WHERE id = ?`), id)
+	return err
+}
+
+// Delete removes a canned response.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM canned_responses WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+// Package commentexport renders the discussion under a post as a threaded
+// transcript, either Markdown for reading and quoting or JSON for
+// archiving.
+package commentexport
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Thread is the full discussion of one post.
+type Thread struct {
+	PostID     string     `json:"post_id"`
+	Title      string     `json:"title"`
+	URL        string     `json:"url"`
+	ExportedAt time.Time  `json:"exported_at"`
+	Total      int        `json:"total"`
+	Comments   []*Comment `json:"comments"`
+}
+
+// Comment is one comment with its replies, oldest first. Deleted comments
+// that had replies are kept with placeholder author and content.
+type Comment struct {
+	ID         string     `json:"id"`
+	AuthorName string     `json:"author_name"`
+	IsAuthor   bool       `json:"is_author,omitempty"`
+	Content    string     `json:"content"`
+	CreatedAt  time.Time  `json:"created_at"`
+	EditedAt   *time.Time `json:"edited_at,omitempty"`
+	Deleted    bool       `json:"deleted,omitempty"`
+	Replies    []*Comment `json:"replies"`
+}
+
+// Encode renders t in format, md or json.
+func Encode(format string, t *Thread) ([]byte, error) {
+	switch format {
+	case "md":
+		return Markdown(t), nil
+	case "json":
+		return json.MarshalIndent(t, "", "  ")
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+// Markdown renders t as nested list items, one per comment, with the
+// comment text as an indented paragraph below its author line.
+func Markdown(t *Thread) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Comments on %q\n\n", t.Title)
+	fmt.Fprintf(&b, "%s\n\n", t.URL)
+	fmt.Fprintf(&b, "%d comments, exported %s.\n\n", t.Total, formatTime(t.ExportedAt))
+	for _, c := range t.Comments {
+		writeComment(&b, c, 0)
+	}
+	return []byte(b.String())
+}
+
+func writeComment(b *strings.Builder, c *Comment, depth int) {
+	indent := strings.Repeat("  ", depth)
+	author := c.AuthorName
+	if c.IsAuthor {
+		author += " (author)"
+	}
+	fmt.Fprintf(b, "%s- **%s** · %s", indent, author, formatTime(c.CreatedAt))
+	if c.EditedAt != nil {
+		b.WriteString(" · edited")
+	}
+	b.WriteString("\n\n")
+	for _, line := range strings.Split(strings.TrimSpace(c.Content), "\n") {
+		if line = strings.TrimRight(line, " \t\r"); line == "" {
+			b.WriteString("\n")
+			continue
+		}
+		fmt.Fprintf(b, "%s  %s\n", indent, line)
+	}
+	b.WriteString("\n")
+	for _, r := range c.Replies {
+		writeComment(b, r, depth+1)
+	}
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format("2006-01-02 15:04 UTC")
+}
@@ -0,0 +1,76 @@
+// Package commentlang detects the language of comments, which is kept in
+// their language field so bilingual pages can show their English and
+// Chinese discussions apart.
+package commentlang
+
+import "strings"
+
+// Undetermined is the language of comments too short, or too mixed, to
+// tell; it is the BCP 47 code for an undetermined language.
+const Undetermined = "und"
+
+// minLetters is the fewest letters a comment needs for its language to be
+// detected.
+const minLetters = 2
+
+// Detect returns the language of text from the scripts it is written in:
+// zh for Han characters, ja once there is kana, ko for Hangul, ru for
+// Cyrillic and en for Latin letters. The site is written in English and
+// Chinese, so Latin text is taken to be English. A CJK character stands for
+// about a word, so it counts as much as several Latin letters; a Chinese
+// comment naming a few English libraries is still Chinese. Links are
+// skipped.
+func Detect(text string) string {
+	var han, kana, hangul, cyrillic, latin int
+	for _, word := range strings.Fields(text) {
+		if strings.Contains(word, "://") {
+			continue
+		}
+		for _, r := range word {
+			switch {
+			case r >= 0x3040 && r <= 0x30ff, r >= 0x31f0 && r <= 0x31ff:
+				kana++
+			case r >= 0x4e00 && r <= 0x9fff, r >= 0x3400 && r <= 0x4dbf, r >= 0xf900 && r <= 0xfaff:
+				han++
+			case r >= 0xac00 && r <= 0xd7af, r >= 0x1100 && r <= 0x11ff, r >= 0x3130 && r <= 0x318f:
+				hangul++
+			case r >= 0x0400 && r <= 0x04ff:
+				cyrillic++
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= 0x00c0 && r <= 0x024f && r != 0x00d7 && r != 0x00f7:
+				latin++
+			}
+		}
+	}
+	if han+kana+hangul+cyrillic+latin < minLetters {
+		return Undetermined
+	}
+
+	// Japanese is written in kana and kanji alike
+	hanLang := "zh"
+	if kana > 0 {
+		han, hanLang = han+kana, "ja"
+	}
+	const cjkWeight = 3
+	scores := []struct {
+		lang  string
+		score int
+	}{
+		{hanLang, han * cjkWeight},
+		{"ko", hangul * cjkWeight},
+		{"ru", cyrillic},
+		{"en", latin},
+	}
+	best, bestScore, tie := Undetermined, 0, false
+	for _, s := range scores {
+		switch {
+		case s.score > bestScore:
+			best, bestScore, tie = s.lang, s.score, false
+		case s.score == bestScore && s.score > 0:
+			tie = true
+		}
+	}
+	if tie {
+		return Undetermined
+	}
+	return best
+}
@@ -0,0 +1,272 @@
+// Package commentnotify emails new comments to the site owner. Comments
+// are read from the activity log and sent in batches, one email per batch
+// interval, so a burst of comments doesn't flood the owner's inbox. Held
+// comments come with signed links that approve or reject them once the
+// action is confirmed.
+package commentnotify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/activity"
+	"silan-backend/internal/ama"
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/mail"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// pageSize is how many log entries are read at a time.
+const pageSize = 500
+
+// cursorName is the notifier's cursor in the activity log.
+const cursorName = "comment_notify"
+
+// settleDelay keeps the newest entries in the log for the next run. An
+// entry is timed when its transaction writes it, so one committed a moment
+// later than another can still be timed before it.
+const settleDelay = time.Minute
+
+// Options configure a Notifier.
+type Options struct {
+	// To are the owner's addresses
+	To []string
+	// SiteURL links to the commented pages, APIURL to the moderation links
+	SiteURL string
+	APIURL  string
+	// Secret signs the moderation links, which are left out while it is
+	// empty; they work for LinkTTL
+	Secret  string
+	LinkTTL time.Duration
+	// MaxPerEmail caps the comments written out in one email; the rest are
+	// only counted
+	MaxPerEmail int
+	// IsOwner reports comments of the owner, which aren't sent
+	IsOwner func(c *ent.Comment) bool
+}
+
+// Notifier sends the owner's comment emails.
+type Notifier struct {
+	log    *activity.Store
+	client *ent.Client
+	amas   *ama.Store
+	mailer *mail.Sender
+	opts   Options
+}
+
+func NewNotifier(log *activity.Store, client *ent.Client, amas *ama.Store, mailer *mail.Sender, opts Options) *Notifier {
+	if opts.MaxPerEmail <= 0 {
+		opts.MaxPerEmail = 20
+	}
+	return &Notifier{log: log, client: client, amas: amas, mailer: mailer, opts: opts}
+}
+
+// Run emails the comments logged since the previous batch, read oldest
+// first from the notifier's cursor in the activity log. The first run only
+// places the cursor at the end of the log so the comments from before
+// notifications were turned on aren't sent; so does a run while mail is
+// off. Send failures are logged; the batch isn't retried, since the
+// addresses that did get it would get it twice.
+func (n *Notifier) Run(ctx context.Context, _ time.Time) error {
+	cursor, ok, err := n.log.Cursor(ctx, cursorName)
+	if err != nil {
+		return err
+	}
+	if !ok || !n.mailer.Enabled() || len(n.opts.To) == 0 {
+		return n.skipToEnd(ctx)
+	}
+
+	until := time.Now().Add(-settleDelay)
+	var comments []*ent.Comment
+	next := cursor
+	for {
+		entries, err := n.log.ListAfter(ctx, []string{activity.CommentCreated}, next, until, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range entries {
+			c, err := n.comment(ctx, e)
+			if err != nil {
+				return err
+			}
+			if c != nil {
+				comments = append(comments, c)
+			}
+		}
+		if len(entries) > 0 {
+			next = activity.At(entries[len(entries)-1])
+		}
+		if len(entries) < pageSize {
+			break
+		}
+	}
+	if next == cursor {
+		return nil
+	}
+	if len(comments) > 0 {
+		n.send(ctx, comments)
+	}
+	return n.log.SetCursor(ctx, cursorName, next)
+}
+
+// skipToEnd places the cursor after the newest entry of the log, or at the
+// current time while the log has none.
+func (n *Notifier) skipToEnd(ctx context.Context) error {
+	latest, err := n.log.List(ctx, activity.Query{Types: []string{activity.CommentCreated}, Limit: 1})
+	if err != nil {
+		return err
+	}
+	end := activity.Cursor{CreatedAt: time.Now().Add(-settleDelay)}
+	if len(latest) > 0 {
+		end = activity.At(latest[0])
+	}
+	return n.log.SetCursor(ctx, cursorName, end)
+}
+
+// comment returns the comment of a log entry, or nil when it shouldn't be
+// sent.
+func (n *Notifier) comment(ctx context.Context, e activity.Event) (*ent.Comment, error) {
+	id, err := uuid.Parse(e.SubjectID)
+	if err != nil {
+		return nil, nil
+	}
+	c, err := n.client.Comment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		// Deleted or rejected in the meantime
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if n.opts.IsOwner != nil && n.opts.IsOwner(c) {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// send mails comments to the owner's addresses.
+func (n *Notifier) send(ctx context.Context, comments []*ent.Comment) {
+	msg := n.message(ctx, comments)
+	for _, to := range n.opts.To {
+		msg.To = to
+		if err := n.mailer.Send(msg); err != nil {
+			logx.WithContext(ctx).Errorf("Failed to send comment notification to %s: %v", to, err)
+		}
+	}
+	logx.WithContext(ctx).Infof("Sent notification of %d new comment(s) to the owner", len(comments))
+}
+
+// message writes the email for comments.
+func (n *Notifier) message(ctx context.Context, comments []*ent.Comment) mail.Message {
+	titles := n.titles(ctx, comments)
+	held := 0
+	for _, c := range comments {
+		if !c.IsApproved {
+			held++
+		}
+	}
+
+	var subject string
+	if len(comments) == 1 {
+		c := comments[0]
+		subject = fmt.Sprintf("New comment from %s on %s", c.AuthorName, titleOf(titles, c))
+	} else {
+		subject = fmt.Sprintf("%d new comments", len(comments))
+	}
+	switch {
+	case held == 1 && len(comments) == 1:
+		subject += " (awaiting approval)"
+	case held > 0:
+		subject += fmt.Sprintf(" (%d awaiting approval)", held)
+	}
+
+	var b strings.Builder
+	expires := time.Now().Add(n.opts.LinkTTL)
+	for i, c := range comments {
+		if i > 0 {
+			b.WriteString("\n----\n\n")
+		}
+		if i == n.opts.MaxPerEmail {
+			fmt.Fprintf(&b, "…and %d more.\n", len(comments)-i)
+			break
+		}
+		author := c.AuthorName
+		if c.AuthorEmail != "" {
+			author = fmt.Sprintf("%s <%s>", c.AuthorName, c.AuthorEmail)
+		}
+		fmt.Fprintf(&b, "%s commented on %s", author, titleOf(titles, c))
+		if !c.IsApproved {
+			b.WriteString(", awaiting approval")
+		}
+		fmt.Fprintf(&b, ":\n\n%s\n\nView: %s\n", c.Content,
+			commentsub.PageURL(n.opts.SiteURL, c.EntityType, c.EntityID.String()))
+		if !c.IsApproved && n.opts.Secret != "" {
+			fmt.Fprintf(&b, "Approve: %s\nReject: %s\n",
+				n.linkURL(ActionApprove, c.ID.String(), expires), n.linkURL(ActionReject, c.ID.String(), expires))
+		}
+	}
+	return mail.Message{Subject: subject, Body: b.String()}
+}
+
+func (n *Notifier) linkURL(action, commentID string, expires time.Time) string {
+	token := SignLink([]byte(n.opts.Secret), action, commentID, expires)
+	return n.opts.APIURL + linkPath(action) + "?token=" + token
+}
+
+// linkPath is the path of the moderation links taking action.
+func linkPath(action string) string {
+	return "/api/v1/moderation/" + action
+}
+
+// titles returns the titles of the posts, ideas, projects and AMAs the
+// comments are on, by entity ID. Lookup failures are logged and leave the
+// titles out.
+func (n *Notifier) titles(ctx context.Context, comments []*ent.Comment) map[uuid.UUID]string {
+	titles := make(map[uuid.UUID]string)
+	for _, c := range comments {
+		if _, ok := titles[c.EntityID]; ok {
+			continue
+		}
+		var title string
+		var err error
+		switch {
+		case c.EntityType == "blog":
+			var p *ent.BlogPost
+			if p, err = n.client.BlogPost.Get(ctx, c.EntityID); err == nil {
+				title = p.Title
+			}
+		case strings.HasPrefix(c.EntityType, "idea"):
+			var i *ent.Idea
+			if i, err = n.client.Idea.Get(ctx, c.EntityID); err == nil {
+				title = i.Title
+			}
+		case strings.HasPrefix(c.EntityType, "project"):
+			var p *ent.Project
+			if p, err = n.client.Project.Get(ctx, c.EntityID); err == nil {
+				title = p.Title
+			}
+		case c.EntityType == ama.EntityType:
+			var a *ama.AMA
+			if a, err = n.amas.Get(ctx, c.EntityID.String()); err == nil {
+				title = a.Title
+			}
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to look up the title of %s %s: %v", c.EntityType, c.EntityID, err)
+		}
+		titles[c.EntityID] = title
+	}
+	return titles
+}
+
+func titleOf(titles map[uuid.UUID]string, c *ent.Comment) string {
+	if t := titles[c.EntityID]; t != "" {
+		return fmt.Sprintf("%q", t)
+	}
+	return "a " + c.EntityType
+}
@@ -0,0 +1,57 @@
+package commentnotify
+
+import (
+	"bytes"
+	"html/template"
+
+	"silan-backend/internal/ent"
+)
+
+// PageContentType is the content type of ConfirmPage.
+const PageContentType = "text/html; charset=utf-8"
+
+// confirmPage asks before a moderation link takes action. Mail scanners and
+// link previews open links, so opening one only shows this page; the action
+// is taken when the form is posted back with the token.
+var confirmPage = template.Must(template.New("confirm").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>{{.Verb}} comment</title>
+</head>
+<body style="font-family: sans-serif; max-width: 40em; margin: 2em auto; padding: 0 1em">
+{{if .Comment}}
+<h1>{{.Verb}} this comment?</h1>
+<p><strong>{{.Comment.AuthorName}}</strong> wrote on {{.Comment.CreatedAt.Format "2 Jan 2006 15:04 MST"}}:</p>
+<blockquote style="white-space: pre-wrap">{{.Comment.Content}}</blockquote>
+<form method="post" action="{{.Action}}">
+<input type="hidden" name="token" value="{{.Token}}">
+<button type="submit">{{.Verb}}</button>
+</form>
+{{else}}
+<h1>Nothing to do</h1>
+<p>This comment has already been approved, rejected or deleted.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// ConfirmPage returns the page asking to take action on c with a link
+// token, which posts back to the path of the link. A nil c was moderated in
+// the meantime.
+func ConfirmPage(action string, c *ent.Comment, token string) ([]byte, error) {
+	verb := "Approve"
+	if action == ActionReject {
+		verb = "Reject"
+	}
+	var b bytes.Buffer
+	err := confirmPage.Execute(&b, map[string]any{
+		"Verb":    verb,
+		"Action":  linkPath(action),
+		"Comment": c,
+		"Token":   token,
+	})
+	return b.Bytes(), err
+}
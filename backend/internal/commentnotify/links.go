@@ -0,0 +1,73 @@
+package commentnotify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Moderation actions of the links in notification emails.
+const (
+	ActionApprove = "approve"
+	ActionReject  = "reject"
+)
+
+var (
+	// ErrInvalidLink is returned for malformed or tampered link tokens.
+	ErrInvalidLink = errors.New("invalid moderation link")
+	// ErrExpiredLink is returned for link tokens past their expiry.
+	ErrExpiredLink = errors.New("moderation link has expired")
+)
+
+// SignLink returns the token of a link taking action on a comment until
+// expiresAt. Anyone holding the token can read its claims; only the
+// signature is secret.
+func SignLink(secret []byte, action, commentID string, expiresAt time.Time) string {
+	payload := action + ":" + commentID + ":" + strconv.FormatInt(expiresAt.Unix(), 10)
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(payload)) + "." + enc.EncodeToString(sign(secret, payload))
+}
+
+// VerifyLink checks the signature and expiry of a link token and returns
+// the comment ID it was signed for, if it was signed for action.
+func VerifyLink(secret []byte, token, action string, now time.Time) (string, error) {
+	if len(secret) == 0 {
+		return "", ErrInvalidLink
+	}
+	enc := base64.RawURLEncoding
+	encPayload, encSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return "", ErrInvalidLink
+	}
+	payload, err := enc.DecodeString(encPayload)
+	if err != nil {
+		return "", ErrInvalidLink
+	}
+	sig, err := enc.DecodeString(encSig)
+	if err != nil || !hmac.Equal(sig, sign(secret, string(payload))) {
+		return "", ErrInvalidLink
+	}
+
+	parts := strings.Split(string(payload), ":")
+	if len(parts) != 3 || parts[0] != action {
+		return "", ErrInvalidLink
+	}
+	exp, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return "", ErrInvalidLink
+	}
+	if !now.Before(time.Unix(exp, 0)) {
+		return "", ErrExpiredLink
+	}
+	return parts[1], nil
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
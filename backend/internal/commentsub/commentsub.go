@@ -0,0 +1,157 @@
+// Package commentsub lets commenters without an account follow a comment
+// thread, or all comments on a page, by email. A subscription only receives reply notifications after
+// its address has been confirmed through the link sent when subscribing;
+// every email carries a link that unsubscribes with the same token. Page
+// subscriptions can get their comments in a periodic digest instead, queued
+// in the raw comment_digest_queue table until it is sent.
+package commentsub
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown or already removed tokens.
+var ErrNotFound = errors.New("subscription not found")
+
+// Subscription follows the thread started by the root comment ThreadID.
+// Page subscriptions have the ID of the commented entity as ThreadID and
+// follow every comment on it.
+type Subscription struct {
+	ID         string
+	ThreadID   string
+	EntityType string
+	EntityID   string
+	Email      string
+	Token      string
+	Verified   bool
+	CreatedAt  time.Time
+}
+
+// Page reports whether sub follows the whole page rather than a thread.
+func (sub *Subscription) Page() bool {
+	return sub.ThreadID == sub.EntityID
+}
+
+// Store keeps subscriptions in the raw comment_subscriptions table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Subscribe adds email to the thread, or to the page when threadID is
+// entityID. An existing subscription for the same
+// address is returned as is, with created set to false.
+func (s *Store) Subscribe(ctx context.Context, threadID, entityType, entityID, email string) (sub *Subscription, created bool, err error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	sub = &Subscription{}
+	err = s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT id, thread_id, entity_type, entity_id, email, token, verified, created_at
+		FROM comment_subscriptions WHERE thread_id = ? AND email = ?`), threadID, email,
+	).Scan(&sub.ID, &sub.ThreadID, &sub.EntityType, &sub.EntityID, &sub.Email, &sub.Token, &sub.Verified, &sub.CreatedAt)
+	if err == nil {
+		return sub, false, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, false, err
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, false, err
+	}
+	sub = &Subscription{
+		ID:         uuid.New().String(),
+		ThreadID:   threadID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		Email:      email,
+		Token:      token,
+		CreatedAt:  time.Now().UTC(),
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO comment_subscriptions (id, thread_id, entity_type, entity_id, email, token, verified, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		sub.ID, sub.ThreadID, sub.EntityType, sub.EntityID, sub.Email, sub.Token, false, sub.CreatedAt,
+	)
+	if err != nil {
+		return nil, false, err
+	}
+	return sub, true, nil
+}
+
+// Verify confirms the address of the subscription holding token.
+func (s *Store) Verify(ctx context.Context, token string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE comment_subscriptions SET verified = ?, verified_at = ? WHERE token = ?`),
+		true, time.Now().UTC(), token,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Unsubscribe removes the subscription holding token.
+func (s *Store) Unsubscribe(ctx context.Context, token string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM comment_subscriptions WHERE token = ?`), token)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Verified lists the confirmed subscriptions of a thread, or of a page when
+// given the entity ID.
+func (s *Store) Verified(ctx context.Context, threadID string) ([]*Subscription, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT id, thread_id, entity_type, entity_id, email, token, verified, created_at
+		FROM comment_subscriptions WHERE thread_id = ? AND verified = ?`), threadID, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Subscription
+	for rows.Next() {
+		sub := &Subscription{}
+		if err := rows.Scan(&sub.ID, &sub.ThreadID, &sub.EntityType, &sub.EntityID, &sub.Email, &sub.Token, &sub.Verified, &sub.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, sub)
+	}
+	return list, rows.Err()
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,79 @@
+package commentsub
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// queuedRows caps the queued comments read per digest run; the rest wait
+// for the next one.
+const queuedRows = 1000
+
+// Enqueue queues commentID for the next digest of the subscription subID.
+// Queueing a comment twice, as a replayed event does, queues it once.
+func (s *Store) Enqueue(ctx context.Context, subID, commentID string) error {
+	var query string
+	if s.driver == "mysql" {
+		query = `INSERT IGNORE INTO comment_digest_queue (subscription_id, comment_id, queued_at) VALUES (?, ?, ?)`
+	} else {
+		query = `INSERT INTO comment_digest_queue (subscription_id, comment_id, queued_at) VALUES (?, ?, ?)
+			ON CONFLICT (subscription_id, comment_id) DO NOTHING`
+	}
+	_, err := s.db.ExecContext(ctx, s.rebind(query), subID, commentID, time.Now().UTC())
+	return err
+}
+
+// Digest is the comments queued for one subscription, oldest first.
+type Digest struct {
+	Subscription *Subscription
+	CommentIDs   []string
+}
+
+// Queued returns the queued digests of confirmed subscriptions. Comments
+// queued for subscriptions that have since been removed are dropped.
+func (s *Store) Queued(ctx context.Context) ([]*Digest, error) {
+	if _, err := s.db.ExecContext(ctx,
+		`DELETE FROM comment_digest_queue WHERE subscription_id NOT IN (SELECT id FROM comment_subscriptions)`); err != nil {
+		return nil, err
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT s.id, s.thread_id, s.entity_type, s.entity_id, s.email, s.token, s.verified, s.created_at, q.comment_id
+		FROM comment_digest_queue q JOIN comment_subscriptions s ON s.id = q.subscription_id
+		WHERE s.verified = ?
+		ORDER BY s.id, q.queued_at, q.comment_id
+		LIMIT `+strconv.Itoa(queuedRows)), true,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Digest
+	for rows.Next() {
+		sub := &Subscription{}
+		var commentID string
+		if err := rows.Scan(&sub.ID, &sub.ThreadID, &sub.EntityType, &sub.EntityID, &sub.Email, &sub.Token, &sub.Verified, &sub.CreatedAt, &commentID); err != nil {
+			return nil, err
+		}
+		if len(list) == 0 || list[len(list)-1].Subscription.ID != sub.ID {
+			list = append(list, &Digest{Subscription: sub})
+		}
+		d := list[len(list)-1]
+		d.CommentIDs = append(d.CommentIDs, commentID)
+	}
+	return list, rows.Err()
+}
+
+// Dequeue removes the comments of a digest once it has been sent.
+func (s *Store) Dequeue(ctx context.Context, d *Digest) error {
+	for _, id := range d.CommentIDs {
+		if _, err := s.db.ExecContext(ctx, s.rebind(
+			`DELETE FROM comment_digest_queue WHERE subscription_id = ? AND comment_id = ?`),
+			d.Subscription.ID, id,
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package commentsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/mail"
+	"silan-backend/internal/outbox"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxThreadDepth stops walking parent links on malformed data.
+const maxThreadDepth = 32
+
+// Notifier sends the confirmation and reply emails of subscriptions.
+type Notifier struct {
+	store  *Store
+	client *ent.Client
+	mailer *mail.Sender
+	// siteURL links to the commented page, apiURL to the token endpoints
+	siteURL string
+	apiURL  string
+	// digest queues the comments for page subscriptions, which are sent
+	// together by SendDigests
+	digest bool
+}
+
+func NewNotifier(store *Store, client *ent.Client, mailer *mail.Sender, siteURL, apiURL string, digest bool) *Notifier {
+	return &Notifier{store: store, client: client, mailer: mailer, siteURL: siteURL, apiURL: apiURL, digest: digest}
+}
+
+// ThreadRoot returns the top-level comment of the thread c belongs to.
+func (n *Notifier) ThreadRoot(ctx context.Context, c *ent.Comment) (uuid.UUID, error) {
+	for depth := 0; c.ParentID != uuid.Nil; depth++ {
+		if depth == maxThreadDepth {
+			return uuid.Nil, fmt.Errorf("comment %s: thread too deep", c.ID)
+		}
+		parent, err := n.client.Comment.Get(ctx, c.ParentID)
+		if err != nil {
+			return uuid.Nil, err
+		}
+		c = parent
+	}
+	return c.ID, nil
+}
+
+// Subscribe follows the thread of c with email and sends the confirmation
+// link for new subscriptions.
+func (n *Notifier) Subscribe(ctx context.Context, c *ent.Comment, email string) error {
+	if !n.mailer.Enabled() {
+		return mail.ErrDisabled
+	}
+	root, err := n.ThreadRoot(ctx, c)
+	if err != nil {
+		return err
+	}
+	return n.subscribe(ctx, root.String(), c, email)
+}
+
+// SubscribePage follows every comment on the page c is on with email and
+// sends the confirmation link for new subscriptions.
+func (n *Notifier) SubscribePage(ctx context.Context, c *ent.Comment, email string) error {
+	if !n.mailer.Enabled() {
+		return mail.ErrDisabled
+	}
+	return n.subscribe(ctx, c.EntityID.String(), c, email)
+}
+
+func (n *Notifier) subscribe(ctx context.Context, threadID string, c *ent.Comment, email string) error {
+	sub, created, err := n.store.Subscribe(ctx, threadID, c.EntityType, c.EntityID.String(), email)
+	if err != nil || !created {
+		return err
+	}
+
+	what := "replies to your comment"
+	if sub.Page() {
+		what = "new comments"
+	}
+	return n.mailer.Send(mail.Message{
+		To:      sub.Email,
+		Subject: "Confirm comment notifications",
+		Body: fmt.Sprintf("You asked to be notified of %s at\n%s\n\n"+
+			"Confirm by opening this link:\n%s\n\n"+
+			"If this wasn't you, ignore this email and nothing will be sent.\n",
+			what, n.pageURL(sub), n.tokenURL("verify", sub.Token)),
+	})
+}
+
+// Handle is an outbox handler that emails the confirmed subscribers of a
+// thread when a reply is posted to it, and those of the page for every new
+// comment, or queues the comment for their digest; held comments are
+// announced once approved. The author of the comment is skipped, and an
+// address following both the thread and the page gets one email. Send and
+// queue failures are only logged: returning an error would replay the
+// event to every subscriber and handler.
+func (n *Notifier) Handle(ctx context.Context, ev outbox.Event) error {
+	if ev.Type != outbox.EventCommentCreated && ev.Type != outbox.EventCommentApproved {
+		return nil
+	}
+	if !n.mailer.Enabled() {
+		return nil
+	}
+	var payload outbox.CommentEvent
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return nil
+	}
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil
+	}
+
+	c, err := n.client.Comment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	// Held comments are announced once they are approved
+	if !c.IsApproved {
+		return nil
+	}
+
+	var subs []*Subscription
+	if c.ParentID != uuid.Nil {
+		root, err := n.ThreadRoot(ctx, c)
+		if err != nil {
+			return err
+		}
+		if subs, err = n.store.Verified(ctx, root.String()); err != nil {
+			return err
+		}
+	}
+	pageSubs, err := n.store.Verified(ctx, c.EntityID.String())
+	if err != nil {
+		return err
+	}
+	subs = append(subs, pageSubs...)
+
+	sent := map[string]bool{strings.ToLower(c.AuthorEmail): true}
+	for _, sub := range subs {
+		if sent[strings.ToLower(sub.Email)] {
+			continue
+		}
+		sent[strings.ToLower(sub.Email)] = true
+
+		if sub.Page() && n.digest {
+			if err := n.store.Enqueue(ctx, sub.ID, c.ID.String()); err != nil {
+				logx.WithContext(ctx).Errorf("Failed to queue comment %s for a digest: %v", c.ID, err)
+			}
+			continue
+		}
+		subject := fmt.Sprintf("%s replied to a comment you follow", c.AuthorName)
+		if sub.Page() {
+			subject = fmt.Sprintf("%s commented on a page you follow", c.AuthorName)
+		}
+		err := n.mailer.Send(mail.Message{
+			To:      sub.Email,
+			Subject: subject,
+			Body: fmt.Sprintf("%s wrote:\n\n%s\n\nRead the conversation at\n%s\n\n"+
+				"Stop these emails:\n%s\n",
+				c.AuthorName, c.Content, n.pageURL(sub), n.tokenURL("unsubscribe", sub.Token)),
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to send comment notification for comment %s: %v", c.ID, err)
+		}
+	}
+	return nil
+}
+
+// SendDigests emails each page subscription the comments queued for it
+// since its last digest. It is run by the scheduler; comments stay queued
+// until their digest has been sent, so a run that fails or is missed
+// while the server is down sends them on the next one.
+func (n *Notifier) SendDigests(ctx context.Context, _ time.Time) error {
+	if !n.mailer.Enabled() {
+		return nil
+	}
+	digests, err := n.store.Queued(ctx)
+	if err != nil {
+		return err
+	}
+	for _, d := range digests {
+		var comments []*ent.Comment
+		for _, id := range d.CommentIDs {
+			cid, err := uuid.Parse(id)
+			if err != nil {
+				continue
+			}
+			c, err := n.client.Comment.Get(ctx, cid)
+			if ent.IsNotFound(err) {
+				// Deleted or rejected since it was queued
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			comments = append(comments, c)
+		}
+		if len(comments) > 0 {
+			if err := n.mailer.Send(n.digestMessage(d.Subscription, comments)); err != nil {
+				// Kept queued for the next run
+				logx.WithContext(ctx).Errorf("Failed to send a comment digest for subscription %s: %v", d.Subscription.ID, err)
+				continue
+			}
+		}
+		if err := n.store.Dequeue(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) digestMessage(sub *Subscription, comments []*ent.Comment) mail.Message {
+	subject := fmt.Sprintf("%s commented on a page you follow", comments[0].AuthorName)
+	if len(comments) > 1 {
+		subject = fmt.Sprintf("%d new comments on a page you follow", len(comments))
+	}
+	var b strings.Builder
+	for _, c := range comments {
+		fmt.Fprintf(&b, "%s wrote:\n\n%s\n\n----\n\n", c.AuthorName, c.Content)
+	}
+	fmt.Fprintf(&b, "Read the conversation at\n%s\n\nStop these emails:\n%s\n",
+		n.pageURL(sub), n.tokenURL("unsubscribe", sub.Token))
+	return mail.Message{To: sub.Email, Subject: subject, Body: b.String()}
+}
+
+// pageURL links to the commented page of sub.
+func (n *Notifier) pageURL(sub *Subscription) string {
+	return PageURL(n.siteURL, sub.EntityType, sub.EntityID)
+}
+
+// PageURL links to the page a comment on entityType/entityID appears on.
+// Idea and project comments use entity types such as idea_general, so only
+// the prefix is compared.
+func PageURL(siteURL, entityType, entityID string) string {
+	switch {
+	case entityType == "blog":
+		return siteURL + "/blog/" + entityID
+	case strings.HasPrefix(entityType, "idea"):
+		return siteURL + "/ideas/" + entityID
+	case strings.HasPrefix(entityType, "project"):
+		return siteURL + "/projects/" + entityID
+	}
+	return siteURL
+}
+
+func (n *Notifier) tokenURL(action, token string) string {
+	return n.apiURL + "/api/v1/comment-subscriptions/" + action + "?token=" + token
+}
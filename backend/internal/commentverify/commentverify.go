@@ -0,0 +1,139 @@
+// Package commentverify lets anonymous commenters confirm the email address
+// they commented with. A request mails a six-digit code for one comment;
+// entering it before it expires marks the comment's address verified, which
+// is stored on the comment and shown as a badge. Codes are only stored as SHA-256 hashes and a request
+// stops accepting codes after a few wrong guesses.
+package commentverify
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalid is returned for wrong, used or expired codes.
+var ErrInvalid = errors.New("invalid or expired verification code")
+
+// maxAttempts is how many wrong codes a request accepts before a new code
+// has to be requested.
+const maxAttempts = 5
+
+// Store keeps verification requests in the raw comment_verifications table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Create stores a request to verify email for commentID, valid for ttl, and
+// returns its code and expiry.
+func (s *Store) Create(ctx context.Context, commentID, email string, ttl time.Duration) (code string, expiresAt time.Time, err error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	code = fmt.Sprintf("%06d", n.Int64())
+
+	id := uuid.New().String()
+	now := time.Now().UTC()
+	expiresAt = now.Add(ttl)
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO comment_verifications (id, comment_id, email, code_hash, attempts, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		id, commentID, strings.ToLower(strings.TrimSpace(email)), hash(id+":"+code), 0, now, expiresAt,
+	)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return code, expiresAt, nil
+}
+
+// CountSince returns how many codes were requested for commentID since t.
+func (s *Store) CountSince(ctx context.Context, commentID string, t time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM comment_verifications WHERE comment_id = ? AND created_at > ?`),
+		commentID, t,
+	).Scan(&n)
+	return n, err
+}
+
+// Confirm checks code against the latest open request of commentID and
+// marks the request verified. A wrong code counts against the request.
+func (s *Store) Confirm(ctx context.Context, commentID, code string) error {
+	var id, codeHash string
+	var attempts int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT id, code_hash, attempts FROM comment_verifications
+		WHERE comment_id = ? AND verified_at IS NULL AND expires_at > ?
+		ORDER BY created_at DESC LIMIT 1`),
+		commentID, time.Now().UTC(),
+	).Scan(&id, &codeHash, &attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrInvalid
+	}
+	if err != nil {
+		return err
+	}
+	if attempts >= maxAttempts {
+		return ErrInvalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash(id+":"+strings.TrimSpace(code))), []byte(codeHash)) != 1 {
+		_, err := s.db.ExecContext(ctx, s.rebind(
+			`UPDATE comment_verifications SET attempts = attempts + 1 WHERE id = ?`), id,
+		)
+		if err != nil {
+			return err
+		}
+		return ErrInvalid
+	}
+
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE comment_verifications SET verified_at = ? WHERE id = ? AND verified_at IS NULL`),
+		time.Now().UTC(), id,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrInvalid
+	}
+	return err
+}
+
+// Purge deletes requests that expired before cutoff, confirmed or not; the
+// badge is kept on the comment.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`DELETE FROM comment_verifications WHERE expires_at < ?`), cutoff,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -2,14 +2,36 @@ package config
 
 import (
 	"os"
+	"strings"
 
 	"github.com/zeromicro/go-zero/rest"
 )
 
 type Config struct {
 	rest.RestConf
-	Database DatabaseConfig `json:"database"`
-	Auth     AuthConfig     `json:"auth"`
+	Database     DatabaseConfig     `json:"database"`
+	Auth         AuthConfig         `json:"auth"`
+	Admin        AdminConfig        `json:"admin,optional"`
+	Signing      SigningConfig      `json:"signing,optional"`
+	Experiments  []ExperimentConfig `json:"experiments,optional"`
+	Site         SiteConfig         `json:"site,optional"`
+	Abuse        AbuseConfig        `json:"abuse,optional"`
+	Feeds        FeedsConfig        `json:"feeds,optional"`
+	Revalidate   RevalidateConfig   `json:"revalidate,optional"`
+	Publishing   PublishingConfig   `json:"publishing,optional"`
+	Preview      PreviewConfig      `json:"preview,optional"`
+	LLM          LLMConfig          `json:"llm,optional"`
+	Ask          AskConfig          `json:"ask,optional"`
+	Owner        OwnerConfig        `json:"owner,optional"`
+	Mail         MailConfig         `json:"mail,optional"`
+	Moderation   ModerationConfig   `json:"moderation,optional"`
+	Captcha      CaptchaConfig      `json:"captcha,optional"`
+	Aggregates   AggregatesConfig   `json:"aggregates,optional"`
+	Trash        TrashConfig        `json:"trash,optional"`
+	Media        MediaConfig        `json:"media,optional"`
+	Fingerprints FingerprintConfig  `json:"fingerprints,optional"`
+	LinkCheck    LinkCheckConfig    `json:"link_check,optional"`
+	Webhooks     []WebhookConfig    `json:"webhooks,optional"`
 }
 
 type DatabaseConfig struct {
@@ -26,6 +48,364 @@ type DatabaseConfig struct {
 // AuthConfig holds authentication-related settings
 type AuthConfig struct {
 	GoogleClientID string `json:"google_client_id,env=GOOGLE_CLIENT_ID"`
+	// SessionSecret signs the session tokens returned by GoogleVerify;
+	// sessions are disabled while it is empty
+	SessionSecret     string `json:"session_secret,optional,env=SESSION_SECRET"`
+	SessionTTLMinutes int    `json:"session_ttl_minutes,default=60"`
+	// RefreshTTLDays is how long a session can go unused before its refresh
+	// token expires; every refresh extends it
+	RefreshTTLDays int `json:"refresh_ttl_days,default=30"`
+	// EmailCodeTTLMinutes is how long an email sign-in code and its magic
+	// link stay valid; email sign-in needs Mail to be configured
+	EmailCodeTTLMinutes int `json:"email_code_ttl_minutes,default=15"`
+	// EmailCodesPerSubnetHour caps sign-in emails per /24 IPv4 or /64 IPv6
+	// network per hour; 0 disables the cap
+	EmailCodesPerSubnetHour int `json:"email_codes_per_subnet_hour,default=10"`
+	// WeChat website login (WeChat Open Platform) and QQ Connect app
+	// credentials; each provider is disabled while its credentials are empty
+	WeChatAppID     string `json:"wechat_app_id,optional"`
+	WeChatAppSecret string `json:"wechat_app_secret,optional,env=WECHAT_APP_SECRET"`
+	QQAppID         string `json:"qq_app_id,optional"`
+	QQAppKey        string `json:"qq_app_key,optional,env=QQ_APP_KEY"`
+	// EventRetentionDays is how long sign-in, refresh and logout events are
+	// kept in the authentication log; 0 keeps them forever
+	EventRetentionDays int `json:"event_retention_days,default=180"`
+}
+
+// AdminConfig holds settings for the owner-only admin API
+type AdminConfig struct {
+	// Token is the bearer token required by /api/v1/admin routes. The admin
+	// API is disabled while it is empty.
+	Token string `json:"token,optional,env=ADMIN_TOKEN"`
+	// APIKeyHashes are hex SHA-256 digests of keys that may call the admin
+	// API with X-API-Key, for automation clients such as the silan CLI.
+	// Keys stored in the database need the admin scope instead.
+	APIKeyHashes []string `json:"api_key_hashes,optional"`
+}
+
+// SigningConfig controls HMAC verification of admin API requests (content
+// sync, automation) on top of token/API-key auth
+type SigningConfig struct {
+	// Secret verifies signatures; admin requests are rejected without one
+	// unless Optional is set
+	Secret string `json:"secret,optional,env=SIGNING_SECRET"`
+	// Optional accepts unsigned admin requests; signed ones are still
+	// verified
+	Optional         bool `json:"optional,optional"`
+	ToleranceSeconds int  `json:"tolerance_seconds,default=300"`
+}
+
+// SiteConfig describes the public website served by the frontend
+type SiteConfig struct {
+	// BaseURL is the frontend origin used to build links to posts/projects
+	BaseURL string `json:"base_url,default=https://silan.tech"`
+	// ShortLinkBase is the public origin of this API as seen by visitors,
+	// used to print full short links (e.g. https://api.silan.tech)
+	ShortLinkBase string            `json:"short_link_base,optional"`
+	Robots        RobotsConfig      `json:"robots,optional"`
+	SecurityTxt   SecurityTxtConfig `json:"security_txt,optional"`
+}
+
+// RobotsConfig renders /robots.txt, which always points at the sitemap
+type RobotsConfig struct {
+	// Disallow lists the paths no crawler should visit
+	Disallow []string `json:"disallow,optional"`
+	// BlockAICrawlers keeps the crawlers that collect language model
+	// training data off the whole site; AICrawlers replaces the built-in
+	// list of their user agents
+	BlockAICrawlers bool     `json:"block_ai_crawlers,optional"`
+	AICrawlers      []string `json:"ai_crawlers,optional"`
+}
+
+// SecurityTxtConfig renders /.well-known/security.txt (RFC 9116); it is
+// not served while Contacts is empty
+type SecurityTxtConfig struct {
+	// Contacts are mailto:, https: or tel: URIs, in order of preference
+	Contacts []string `json:"contacts,optional"`
+	// ExpiresDays is how far ahead the Expires field is set
+	ExpiresDays        int    `json:"expires_days,default=365"`
+	Encryption         string `json:"encryption,optional"`
+	Acknowledgments    string `json:"acknowledgments,optional"`
+	Policy             string `json:"policy,optional"`
+	Hiring             string `json:"hiring,optional"`
+	PreferredLanguages string `json:"preferred_languages,default=en"`
+}
+
+// OwnerConfig identifies the site owner's comments so they can be shown
+// with an author badge
+type OwnerConfig struct {
+	// IdentityIDs are the owner's user_identities IDs
+	IdentityIDs []string `json:"identity_ids,optional"`
+	// Emails are the owner's addresses, where new comments are mailed.
+	// Comments posted by a verified identity with one of them get the
+	// badge; a typed address alone doesn't
+	Emails []string `json:"emails,optional"`
+}
+
+// ModerationConfig holds the comment moderation rules
+type ModerationConfig struct {
+	// HoldFirstComment lists the content kinds (blog, idea, project) where
+	// the first comment of a new author waits for approval
+	HoldFirstComment []string `json:"hold_first_comment,optional"`
+	// EditWindowMinutes is how long authors can edit a comment after
+	// posting it; 0 turns editing off
+	EditWindowMinutes int `json:"edit_window_minutes,default=15"`
+	// DuplicateWindowMinutes is how long a resubmitted identical comment
+	// from the same author returns the stored one instead of a repeat; 0
+	// turns the check off
+	DuplicateWindowMinutes int `json:"duplicate_window_minutes,default=10"`
+	// MaxReplyDepth is how many levels replies nest below a top-level
+	// comment; deeper replies are attached one level up and listed flat. 0
+	// turns the limit off
+	MaxReplyDepth int                 `json:"max_reply_depth,default=5"`
+	Spam          SpamConfig          `json:"spam,optional"`
+	Filter        WordFilterConfig    `json:"filter,optional"`
+	Notify        CommentNotifyConfig `json:"notify,optional"`
+}
+
+// CommentNotifyConfig emails new comments to the addresses in Owner.Emails,
+// batched so a burst of comments makes one email; it needs Mail to be
+// configured
+type CommentNotifyConfig struct {
+	Enabled bool `json:"enabled,optional"`
+	// BatchMinutes is how often the comments posted since the last email
+	// are sent
+	BatchMinutes int `json:"batch_minutes,default=10"`
+	// MaxPerEmail caps the comments written out in one email; the rest are
+	// only counted
+	MaxPerEmail int `json:"max_per_email,default=20"`
+	// LinkSecret signs the links that approve or reject held comments from
+	// the email; the links are left out while it is empty
+	LinkSecret   string `json:"link_secret,optional,env=MODERATION_LINK_SECRET"`
+	LinkTTLHours int    `json:"link_ttl_hours,default=72"`
+}
+
+// WordFilterConfig checks new and edited comments against Words, matched
+// as whole words, and Patterns (regular expressions), both ignoring case.
+// Action says what a match does: reject the comment, mask the matched
+// text with asterisks, or hold the comment for approval
+type WordFilterConfig struct {
+	Action   string   `json:"action,default=hold,options=reject|mask|hold"`
+	Words    []string `json:"words,optional"`
+	Patterns []string `json:"patterns,optional"`
+}
+
+// SpamConfig scores every new comment; comments scoring HoldScore or more
+// (0 to 1) wait for approval
+type SpamConfig struct {
+	// Provider is heuristic (local rules), akismet, or none to disable
+	// scoring
+	Provider  string  `json:"provider,default=heuristic,options=heuristic|akismet|none"`
+	HoldScore float64 `json:"hold_score,default=0.5"`
+	// AkismetEndpoint may point at any Akismet-compatible service
+	AkismetKey      string `json:"akismet_key,optional,env=AKISMET_KEY"`
+	AkismetEndpoint string `json:"akismet_endpoint,optional"`
+	TimeoutSeconds  int    `json:"timeout_seconds,default=10"`
+	// MaxLinks, VelocityLimit comments per VelocityMinutes and Blocklist
+	// terms tune the heuristic provider
+	MaxLinks        int      `json:"max_links,default=2"`
+	VelocityLimit   int      `json:"velocity_limit,default=5"`
+	VelocityMinutes int      `json:"velocity_minutes,default=10"`
+	Blocklist       []string `json:"blocklist,optional"`
+}
+
+// CaptchaConfig protects anonymous comments and inquiries with a captcha;
+// it is disabled while Secret is empty
+type CaptchaConfig struct {
+	// Provider is turnstile (Cloudflare) or hcaptcha
+	Provider       string `json:"provider,default=turnstile,options=turnstile|hcaptcha"`
+	Secret         string `json:"secret,optional,env=CAPTCHA_SECRET"`
+	TimeoutSeconds int    `json:"timeout_seconds,default=10"`
+}
+
+// AggregatesConfig controls the stale-while-revalidate cache in front of
+// the tag cloud, content statistics, content graph and admin dashboard
+type AggregatesConfig struct {
+	// RefreshSeconds is how old a cached result gets before it is
+	// recomputed in the background; 0 computes every request
+	RefreshSeconds int `json:"refresh_seconds,default=300"`
+	// MaxEntries caps the cached results across all query parameters
+	MaxEntries int `json:"max_entries,default=256"`
+}
+
+// FingerprintConfig controls how browser fingerprints are hashed before
+// likes, views and comments store them
+type FingerprintConfig struct {
+	// Secret keys the hashes together with the salts kept in the database
+	Secret string `json:"secret,optional,env=FINGERPRINT_SECRET"`
+	// RotateDays starts a new salt this often; 0 keeps the first one
+	RotateDays int `json:"rotate_days,default=90"`
+	// KeepSalts is how many salts, the current one included, are still
+	// matched against; rows hashed with older salts can no longer be linked
+	// to a browser
+	KeepSalts int `json:"keep_salts,default=2"`
+}
+
+// WebhookConfig is an endpoint that receives signed domain events, in
+// addition to the webhooks registered through the admin API
+type WebhookConfig struct {
+	URL string `json:"url"`
+	// Secret signs the deliveries like those of registered webhooks;
+	// unsigned while empty
+	Secret string `json:"secret,optional"`
+	// Events are the event types sent, e.g. "comment.*"; all while empty
+	Events []string `json:"events,optional"`
+}
+
+// LinkCheckConfig controls the periodic check of outbound links in
+// published blog posts
+type LinkCheckConfig struct {
+	// IntervalHours is how often each link is checked again; 0 disables
+	// the checks
+	IntervalHours int `json:"interval_hours,default=24"`
+	// BatchSize caps the links checked per hourly run
+	BatchSize      int `json:"batch_size,default=50"`
+	TimeoutSeconds int `json:"timeout_seconds,default=10"`
+}
+
+// TrashConfig controls the admin recycle bin
+type TrashConfig struct {
+	// RetentionDays is how long deleted entries can be restored before the
+	// purge job removes them for good; 0 keeps them until removed by hand
+	RetentionDays int `json:"retention_days,default=30"`
+}
+
+// MediaConfig controls the checks run on uploaded media
+type MediaConfig struct {
+	// ClamAVAddress is the host:port of a clamd daemon that scans every
+	// upload; virus scanning is skipped while it is empty
+	ClamAVAddress      string `json:"clamav_address,optional"`
+	ScanTimeoutSeconds int    `json:"scan_timeout_seconds,default=30"`
+}
+
+// MailConfig is the SMTP server used for notification emails such as
+// comment reply notifications; email is disabled while Host is empty
+type MailConfig struct {
+	Host     string `json:"host,optional"`
+	Port     int    `json:"port,default=587"`
+	Username string `json:"username,optional"`
+	Password string `json:"password,optional,env=SMTP_PASSWORD"`
+	// From is the sender address, e.g. "Silan <noreply@silan.tech>"
+	From string `json:"from,optional"`
+	// DigestHours sends the followers of a page one digest of its new
+	// comments every DigestHours instead of an email per comment; 0 sends
+	// every comment right away. Replies to a followed thread are always
+	// sent right away
+	DigestHours int `json:"digest_hours,optional"`
+}
+
+// AbuseConfig limits engagement from anonymous visitors
+type AbuseConfig struct {
+	// LikesPerSubnetHour caps likes per /24 IPv4 or /64 IPv6 network per
+	// hour; 0 disables the cap
+	LikesPerSubnetHour int `json:"likes_per_subnet_hour,default=60"`
+	// InquiriesPerSubnetHour caps project inquiries the same way
+	InquiriesPerSubnetHour int `json:"inquiries_per_subnet_hour,default=5"`
+	// ReportsPerSubnetHour caps content reports the same way
+	ReportsPerSubnetHour int `json:"reports_per_subnet_hour,default=10"`
+	// CommentsPerFingerprint and CommentsPerIP cap the comments and AMA
+	// questions per browser and per IP address within CommentWindowMinutes;
+	// 0 disables either cap
+	CommentsPerFingerprint int `json:"comments_per_fingerprint,default=5"`
+	CommentsPerIP          int `json:"comments_per_ip,default=3"`
+	CommentWindowMinutes   int `json:"comment_window_minutes,default=10"`
+}
+
+// FeedsConfig controls the cached sitemap and RSS feeds
+type FeedsConfig struct {
+	// PurgeURL receives a POST with the paths of the rebuilt feeds so a CDN
+	// cache can be purged; nothing is sent while it is empty
+	PurgeURL   string `json:"purge_url,optional"`
+	PurgeToken string `json:"purge_token,optional,env=FEEDS_PURGE_TOKEN"`
+}
+
+// RevalidateConfig asks the frontend to regenerate its statically rendered
+// pages when content changes
+type RevalidateConfig struct {
+	// URL receives a POST with the changed content and the paths of its
+	// pages, e.g. a Next.js revalidate route or a build hook; nothing is
+	// sent while it is empty
+	URL    string `json:"url,optional"`
+	Secret string `json:"secret,optional,env=REVALIDATE_SECRET"`
+	// Paths replaces the page paths of a content type (blog, project, idea,
+	// site_update); {id} and {slug} stand for the changed content
+	Paths          []RevalidatePaths `json:"paths,optional"`
+	TimeoutSeconds int               `json:"timeout_seconds,default=10"`
+}
+
+// RevalidatePaths are the page paths of one content type
+type RevalidatePaths struct {
+	Type  string   `json:"type"`
+	Paths []string `json:"paths"`
+}
+
+// PublishingConfig blocks publishing, e.g. during exams or travel, so
+// automation can't publish by accident; drafts can still be saved. Blocked
+// content upserts fail and scheduled posts wait until publishing is allowed
+type PublishingConfig struct {
+	// Freeze blocks publishing until it is turned off; FreezeFrom and
+	// FreezeUntil (RFC 3339, either may be empty) freeze a period instead
+	Freeze       bool   `json:"freeze,optional"`
+	FreezeFrom   string `json:"freeze_from,optional"`
+	FreezeUntil  string `json:"freeze_until,optional"`
+	FreezeReason string `json:"freeze_reason,optional"`
+	// Windows limit publishing to weekly periods in Timezone; publishing
+	// is allowed at any time while there are none
+	Windows  []PublishWindowConfig `json:"windows,optional"`
+	Timezone string                `json:"timezone,default=UTC"`
+}
+
+// PublishWindowConfig is a weekly period publishing is allowed in. Days
+// are weekday names (every day while empty); Start and End are HH:MM and a
+// window ending before it starts runs past midnight
+type PublishWindowConfig struct {
+	Days  []string `json:"days,optional"`
+	Start string   `json:"start"`
+	End   string   `json:"end"`
+}
+
+// PreviewConfig controls signed preview links for unpublished content
+type PreviewConfig struct {
+	// Secret signs preview tokens; previews are disabled while it is empty
+	Secret     string `json:"secret,optional,env=PREVIEW_SECRET"`
+	TTLMinutes int    `json:"ttl_minutes,default=4320"`
+}
+
+// LLMConfig selects the language model used to draft summaries and
+// translations in the admin API
+type LLMConfig struct {
+	// Provider is openai (any OpenAI-compatible API) or anthropic
+	Provider string `json:"provider,default=openai,options=openai|anthropic"`
+	// BaseURL overrides the provider's public API, e.g. for a local server
+	BaseURL string `json:"base_url,optional"`
+	// Model enables drafting; it is disabled while empty
+	Model          string `json:"model,optional"`
+	APIKey         string `json:"api_key,optional,env=LLM_API_KEY"`
+	TimeoutSeconds int    `json:"timeout_seconds,default=60"`
+}
+
+// AskConfig controls the "ask my site" question answering, which uses the
+// LLM settings above
+type AskConfig struct {
+	// QuestionsPerSubnetHour caps questions per /24 IPv4 or /64 IPv6 network
+	// per hour; 0 disables the cap
+	QuestionsPerSubnetHour int `json:"questions_per_subnet_hour,default=20"`
+	// Sources is the number of content chunks given to the model
+	Sources int `json:"sources,default=5"`
+}
+
+// ExperimentConfig describes an A/B experiment and its variants
+type ExperimentConfig struct {
+	Name     string              `json:"name"`
+	Active   bool                `json:"active,default=true"`
+	Variants []ExperimentVariant `json:"variants"`
+}
+
+// ExperimentVariant is a single arm of an experiment. Weight controls the
+// share of traffic assigned to it relative to the other variants.
+type ExperimentVariant struct {
+	Name   string `json:"name"`
+	Weight int    `json:"weight,default=1"`
 }
 
 // LoadConfigFromEnv loads configuration from environment variables
@@ -61,6 +441,51 @@ func (c *Config) LoadConfigFromEnv() {
 		c.Auth.GoogleClientID = googleID
 	}
 
+	if sessionSecret := os.Getenv("SESSION_SECRET"); sessionSecret != "" {
+		c.Auth.SessionSecret = sessionSecret
+	}
+	if wechatSecret := os.Getenv("WECHAT_APP_SECRET"); wechatSecret != "" {
+		c.Auth.WeChatAppSecret = wechatSecret
+	}
+	if qqKey := os.Getenv("QQ_APP_KEY"); qqKey != "" {
+		c.Auth.QQAppKey = qqKey
+	}
+
+	// Admin configuration from env
+	if adminToken := os.Getenv("ADMIN_TOKEN"); adminToken != "" {
+		c.Admin.Token = adminToken
+	}
+	if keyHashes := os.Getenv("ADMIN_API_KEY_HASHES"); keyHashes != "" {
+		c.Admin.APIKeyHashes = strings.Split(keyHashes, ",")
+	}
+	if signingSecret := os.Getenv("SIGNING_SECRET"); signingSecret != "" {
+		c.Signing.Secret = signingSecret
+	}
+	if purgeToken := os.Getenv("FEEDS_PURGE_TOKEN"); purgeToken != "" {
+		c.Feeds.PurgeToken = purgeToken
+	}
+	if previewSecret := os.Getenv("PREVIEW_SECRET"); previewSecret != "" {
+		c.Preview.Secret = previewSecret
+	}
+	if smtpPassword := os.Getenv("SMTP_PASSWORD"); smtpPassword != "" {
+		c.Mail.Password = smtpPassword
+	}
+	if llmKey := os.Getenv("LLM_API_KEY"); llmKey != "" {
+		c.LLM.APIKey = llmKey
+	}
+	if captchaSecret := os.Getenv("CAPTCHA_SECRET"); captchaSecret != "" {
+		c.Captcha.Secret = captchaSecret
+	}
+	if akismetKey := os.Getenv("AKISMET_KEY"); akismetKey != "" {
+		c.Moderation.Spam.AkismetKey = akismetKey
+	}
+	if fingerprintSecret := os.Getenv("FINGERPRINT_SECRET"); fingerprintSecret != "" {
+		c.Fingerprints.Secret = fingerprintSecret
+	}
+	if linkSecret := os.Getenv("MODERATION_LINK_SECRET"); linkSecret != "" {
+		c.Moderation.Notify.LinkSecret = linkSecret
+	}
+
 	// Auto-generate connection string if individual components are provided
 	if c.Database.Source == "" && c.Database.Host != "" {
 		c.Database.Source = c.buildConnectionString()
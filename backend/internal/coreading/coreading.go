@@ -0,0 +1,82 @@
+// Package coreading counts the distinct readers of each blog post per day,
+// for the "N people read this today" line on posts. Readers are kept as
+// salted fingerprint hashes, and only for a few days.
+package coreading
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+)
+
+// Store records readers in the raw daily_readers table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// day returns the UTC calendar day of t that readers are counted under.
+func day(t time.Time) string {
+	return t.UTC().Format("2006-01-02")
+}
+
+// Record counts a browser as a reader of postID on the day of now; further
+// reads that day are not counted again. readers are its fingerprint hashed
+// with every salt still kept, current first, the one stored.
+func (s *Store) Record(ctx context.Context, postID string, readers []string, now time.Time) error {
+	if len(readers) == 0 {
+		return nil
+	}
+	d := day(now)
+	args := []any{postID, d}
+	for _, r := range readers {
+		args = append(args, r)
+	}
+	var seen int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM daily_readers WHERE post_id = ? AND day = ? AND reader_hash IN (?`+strings.Repeat(`, ?`, len(readers)-1)+`)`),
+		args...,
+	).Scan(&seen)
+	if err != nil || seen > 0 {
+		return err
+	}
+
+	var query string
+	if s.driver == "mysql" {
+		query = `INSERT IGNORE INTO daily_readers (post_id, day, reader_hash) VALUES (?, ?, ?)`
+	} else {
+		query = `INSERT INTO daily_readers (post_id, day, reader_hash) VALUES (?, ?, ?)
+			ON CONFLICT (post_id, day, reader_hash) DO NOTHING`
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(query), postID, d, readers[0])
+	return err
+}
+
+// Today returns the number of distinct readers of postID on the day of now.
+func (s *Store) Today(ctx context.Context, postID string, now time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM daily_readers WHERE post_id = ? AND day = ?`), postID, day(now),
+	).Scan(&n)
+	return n, err
+}
+
+// Purge deletes the readers of days before cutoff.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM daily_readers WHERE day < ?`), day(cutoff))
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,120 @@
+// Package drafts stores machine-generated summaries, excerpts and
+// translations as drafts next to the content they describe. Drafts are
+// edited and copied over by hand; they never replace the content itself.
+package drafts
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"silan-backend/internal/utils"
+)
+
+// Draft fields.
+const (
+	FieldSummary = "summary"
+	FieldExcerpt = "excerpt"
+)
+
+// ErrNotFound is returned when updating a draft that was never generated.
+var ErrNotFound = errors.New("draft not found")
+
+// Draft is one generated text for a piece of content. ContentType is blog,
+// project or idea.
+type Draft struct {
+	ContentType string
+	ContentID   string
+	Field       string
+	Language    string
+	Text        string
+	Model       string
+	Edited      bool
+	UpdatedAt   time.Time
+}
+
+// Store keeps drafts in the raw content_drafts table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Save stores freshly generated drafts, replacing earlier ones for the same
+// field and language.
+func (s *Store) Save(ctx context.Context, list []*Draft) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	for _, d := range list {
+		d.Edited = false
+		d.UpdatedAt = now
+		if _, err := tx.ExecContext(ctx, s.rebind(
+			`DELETE FROM content_drafts WHERE content_type = ? AND content_id = ? AND field = ? AND language = ?`),
+			d.ContentType, d.ContentID, d.Field, d.Language,
+		); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, s.rebind(
+			`INSERT INTO content_drafts (content_type, content_id, field, language, text, model, edited, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+			d.ContentType, d.ContentID, d.Field, d.Language, d.Text, d.Model, false, now,
+		); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Update replaces the text of an existing draft and marks it as edited.
+func (s *Store) Update(ctx context.Context, d *Draft) error {
+	d.Edited = true
+	d.UpdatedAt = time.Now().UTC()
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE content_drafts SET text = ?, edited = ?, updated_at = ?
+		WHERE content_type = ? AND content_id = ? AND field = ? AND language = ?`),
+		d.Text, true, d.UpdatedAt, d.ContentType, d.ContentID, d.Field, d.Language,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return err
+}
+
+// List returns the drafts of a piece of content.
+func (s *Store) List(ctx context.Context, contentType, contentID string) ([]*Draft, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT content_type, content_id, field, language, text, model, edited, updated_at
+		FROM content_drafts WHERE content_type = ? AND content_id = ?
+		ORDER BY field, language`), contentType, contentID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Draft
+	for rows.Next() {
+		d := &Draft{}
+		if err := rows.Scan(&d.ContentType, &d.ContentID, &d.Field, &d.Language, &d.Text, &d.Model, &d.Edited, &d.UpdatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, d)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,100 @@
+package drafts
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"silan-backend/internal/llm"
+)
+
+// maxSourceChars bounds the text sent to the model.
+const maxSourceChars = 24000
+
+const systemPrompt = `You write summaries for a personal website of blog posts, projects and research ideas.
+Reply with a single JSON object and nothing else.`
+
+// Source is the content drafts are generated from.
+type Source struct {
+	ContentType string
+	ContentID   string
+	Title       string
+	Body        string
+}
+
+// replyKey maps a key of the model's JSON reply to a draft field.
+type replyKey struct {
+	key, field, lang string
+}
+
+// Generate asks the model for a summary and an excerpt of src, and their
+// Chinese translations when translate is set. The drafts are returned
+// unsaved.
+func Generate(ctx context.Context, client *llm.Client, src Source, translate bool) ([]*Draft, error) {
+	body := src.Body
+	if utf8.RuneCountInString(body) > maxSourceChars {
+		body = string([]rune(body)[:maxSourceChars])
+	}
+
+	keys := `"summary" (3-5 sentences) and "excerpt" (one sentence, at most 160 characters)`
+	if translate {
+		keys += `, plus "summary_zh" and "excerpt_zh" with Simplified Chinese translations of both`
+	}
+	prompt := fmt.Sprintf("Write a JSON object with the keys %s for this %s.\n\nTitle: %s\n\n%s",
+		keys, src.ContentType, src.Title, body)
+
+	reply, err := client.Complete(ctx, systemPrompt, prompt)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]string
+	if err := json.Unmarshal([]byte(stripFence(reply)), &out); err != nil {
+		return nil, fmt.Errorf("llm reply is not the requested JSON: %w", err)
+	}
+
+	fields := []replyKey{
+		{"summary", FieldSummary, "en"},
+		{"excerpt", FieldExcerpt, "en"},
+	}
+	if translate {
+		fields = append(fields,
+			replyKey{"summary_zh", FieldSummary, "zh"},
+			replyKey{"excerpt_zh", FieldExcerpt, "zh"},
+		)
+	}
+
+	list := make([]*Draft, 0, len(fields))
+	for _, f := range fields {
+		text := strings.TrimSpace(out[f.key])
+		if text == "" {
+			continue
+		}
+		list = append(list, &Draft{
+			ContentType: src.ContentType,
+			ContentID:   src.ContentID,
+			Field:       f.field,
+			Language:    f.lang,
+			Text:        text,
+			Model:       client.Model(),
+		})
+	}
+	if len(list) == 0 {
+		return nil, fmt.Errorf("llm reply contained no drafts")
+	}
+	return list, nil
+}
+
+// stripFence removes a Markdown code fence models sometimes wrap JSON in.
+func stripFence(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "```") {
+		return s
+	}
+	s = strings.TrimPrefix(s, "```")
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[i+1:]
+	}
+	return strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "```"))
+}
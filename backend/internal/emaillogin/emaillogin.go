@@ -0,0 +1,190 @@
+// Package emaillogin signs in visitors without a Google account by email.
+// A login request mails a six-digit code together with a magic link; either
+// one redeems the request once, before it expires. Codes and link tokens
+// are only stored as SHA-256 hashes, and a request stops accepting codes
+// after a few wrong guesses.
+package emaillogin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalid is returned for wrong, used or expired codes and links.
+var ErrInvalid = errors.New("invalid or expired login code")
+
+// maxAttempts is how many wrong codes a request accepts before it has to
+// be requested again.
+const maxAttempts = 5
+
+// Login is a pending email sign-in.
+type Login struct {
+	ID        string
+	Email     string
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+// Store keeps login requests in the raw email_logins table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Normalize returns the form email addresses are stored and matched in.
+func Normalize(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Create stores a login request for email valid for ttl and returns it with
+// its code and magic link token.
+func (s *Store) Create(ctx context.Context, email, ip string, ttl time.Duration) (login *Login, code, token string, err error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return nil, "", "", err
+	}
+	code = fmt.Sprintf("%06d", n.Int64())
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(b)
+
+	now := time.Now().UTC()
+	login = &Login{
+		ID:        uuid.New().String(),
+		Email:     Normalize(email),
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO email_logins (id, email, code_hash, token_hash, attempts, ip, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		login.ID, login.Email, hash(login.ID+":"+code), hash(token), 0, ip, login.CreatedAt, login.ExpiresAt,
+	)
+	if err != nil {
+		return nil, "", "", err
+	}
+	return login, code, token, nil
+}
+
+// CountSince returns how many login requests email made since t.
+func (s *Store) CountSince(ctx context.Context, email string, t time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM email_logins WHERE email = ? AND created_at > ?`),
+		Normalize(email), t,
+	).Scan(&n)
+	return n, err
+}
+
+// RedeemCode checks code against the latest open request of email and
+// marks the request used. A wrong code counts against the request.
+func (s *Store) RedeemCode(ctx context.Context, email, code string) (string, error) {
+	email = Normalize(email)
+	var id, codeHash string
+	var attempts int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT id, code_hash, attempts FROM email_logins
+		WHERE email = ? AND used_at IS NULL AND expires_at > ?
+		ORDER BY created_at DESC LIMIT 1`),
+		email, time.Now().UTC(),
+	).Scan(&id, &codeHash, &attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrInvalid
+	}
+	if err != nil {
+		return "", err
+	}
+	if attempts >= maxAttempts {
+		return "", ErrInvalid
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hash(id+":"+strings.TrimSpace(code))), []byte(codeHash)) != 1 {
+		if _, err := s.db.ExecContext(ctx, s.rebind(
+			`UPDATE email_logins SET attempts = attempts + 1 WHERE id = ?`), id,
+		); err != nil {
+			return "", err
+		}
+		return "", ErrInvalid
+	}
+	if err := s.markUsed(ctx, id); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// RedeemToken marks the open request holding a magic link token used and
+// returns its email.
+func (s *Store) RedeemToken(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", ErrInvalid
+	}
+	var id, email string
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT id, email FROM email_logins
+		WHERE token_hash = ? AND used_at IS NULL AND expires_at > ?`),
+		hash(token), time.Now().UTC(),
+	).Scan(&id, &email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", ErrInvalid
+	}
+	if err != nil {
+		return "", err
+	}
+	if err := s.markUsed(ctx, id); err != nil {
+		return "", err
+	}
+	return email, nil
+}
+
+// Purge deletes requests that expired before cutoff.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM email_logins WHERE expires_at < ?`), cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// markUsed closes a request; a concurrent redeem of the same request loses.
+func (s *Store) markUsed(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE email_logins SET used_at = ? WHERE id = ? AND used_at IS NULL`),
+		time.Now().UTC(), id,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrInvalid
+	}
+	return err
+}
+
+func hash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/apikey"
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ApiKeyUpdate is the builder for updating ApiKey entities.
+type ApiKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ApiKeyMutation
+}
+
+// Where appends a list predicates to the ApiKeyUpdate builder.
+func (aku *ApiKeyUpdate) Where(ps ...predicate.ApiKey) *ApiKeyUpdate {
+	aku.mutation.Where(ps...)
+	return aku
+}
+
+// SetName sets the "name" field.
+func (aku *ApiKeyUpdate) SetName(s string) *ApiKeyUpdate {
+	aku.mutation.SetName(s)
+	return aku
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableName(s *string) *ApiKeyUpdate {
+	if s != nil {
+		aku.SetName(*s)
+	}
+	return aku
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (aku *ApiKeyUpdate) SetKeyPrefix(s string) *ApiKeyUpdate {
+	aku.mutation.SetKeyPrefix(s)
+	return aku
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableKeyPrefix(s *string) *ApiKeyUpdate {
+	if s != nil {
+		aku.SetKeyPrefix(*s)
+	}
+	return aku
+}
+
+// SetDailyQuota sets the "daily_quota" field.
+func (aku *ApiKeyUpdate) SetDailyQuota(i int) *ApiKeyUpdate {
+	aku.mutation.ResetDailyQuota()
+	aku.mutation.SetDailyQuota(i)
+	return aku
+}
+
+// SetNillableDailyQuota sets the "daily_quota" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableDailyQuota(i *int) *ApiKeyUpdate {
+	if i != nil {
+		aku.SetDailyQuota(*i)
+	}
+	return aku
+}
+
+// AddDailyQuota adds i to the "daily_quota" field.
+func (aku *ApiKeyUpdate) AddDailyQuota(i int) *ApiKeyUpdate {
+	aku.mutation.AddDailyQuota(i)
+	return aku
+}
+
+// SetMonthlyQuota sets the "monthly_quota" field.
+func (aku *ApiKeyUpdate) SetMonthlyQuota(i int) *ApiKeyUpdate {
+	aku.mutation.ResetMonthlyQuota()
+	aku.mutation.SetMonthlyQuota(i)
+	return aku
+}
+
+// SetNillableMonthlyQuota sets the "monthly_quota" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableMonthlyQuota(i *int) *ApiKeyUpdate {
+	if i != nil {
+		aku.SetMonthlyQuota(*i)
+	}
+	return aku
+}
+
+// AddMonthlyQuota adds i to the "monthly_quota" field.
+func (aku *ApiKeyUpdate) AddMonthlyQuota(i int) *ApiKeyUpdate {
+	aku.mutation.AddMonthlyQuota(i)
+	return aku
+}
+
+// SetScopes sets the "scopes" field.
+func (aku *ApiKeyUpdate) SetScopes(s []string) *ApiKeyUpdate {
+	aku.mutation.SetScopes(s)
+	return aku
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (aku *ApiKeyUpdate) AppendScopes(s []string) *ApiKeyUpdate {
+	aku.mutation.AppendScopes(s)
+	return aku
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (aku *ApiKeyUpdate) ClearScopes() *ApiKeyUpdate {
+	aku.mutation.ClearScopes()
+	return aku
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (aku *ApiKeyUpdate) SetLastUsedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetLastUsedAt(t)
+	return aku
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableLastUsedAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetLastUsedAt(*t)
+	}
+	return aku
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (aku *ApiKeyUpdate) ClearLastUsedAt() *ApiKeyUpdate {
+	aku.mutation.ClearLastUsedAt()
+	return aku
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (aku *ApiKeyUpdate) SetRevokedAt(t time.Time) *ApiKeyUpdate {
+	aku.mutation.SetRevokedAt(t)
+	return aku
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (aku *ApiKeyUpdate) SetNillableRevokedAt(t *time.Time) *ApiKeyUpdate {
+	if t != nil {
+		aku.SetRevokedAt(*t)
+	}
+	return aku
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (aku *ApiKeyUpdate) ClearRevokedAt() *ApiKeyUpdate {
+	aku.mutation.ClearRevokedAt()
+	return aku
+}
+
+// Mutation returns the ApiKeyMutation object of the builder.
+func (aku *ApiKeyUpdate) Mutation() *ApiKeyMutation {
+	return aku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aku *ApiKeyUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aku.sqlSave, aku.mutation, aku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aku *ApiKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := aku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aku *ApiKeyUpdate) Exec(ctx context.Context) error {
+	_, err := aku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aku *ApiKeyUpdate) ExecX(ctx context.Context) {
+	if err := aku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aku *ApiKeyUpdate) check() error {
+	if v, ok := aku.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ApiKey.name": %w`, err)}
+		}
+	}
+	if v, ok := aku.mutation.KeyPrefix(); ok {
+		if err := apikey.KeyPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "key_prefix", err: fmt.Errorf(`ent: validator failed for field "ApiKey.key_prefix": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aku *ApiKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aku.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString))
+	if ps := aku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aku.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.KeyPrefix(); ok {
+		_spec.SetField(apikey.FieldKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := aku.mutation.DailyQuota(); ok {
+		_spec.SetField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := aku.mutation.AddedDailyQuota(); ok {
+		_spec.AddField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := aku.mutation.MonthlyQuota(); ok {
+		_spec.SetField(apikey.FieldMonthlyQuota, field.TypeInt, value)
+	}
+	if value, ok := aku.mutation.AddedMonthlyQuota(); ok {
+		_spec.AddField(apikey.FieldMonthlyQuota, field.TypeInt, value)
+	}
+	if value, ok := aku.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := aku.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
+	}
+	if aku.mutation.ScopesCleared() {
+		_spec.ClearField(apikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := aku.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if aku.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := aku.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if aku.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aku.mutation.done = true
+	return n, nil
+}
+
+// ApiKeyUpdateOne is the builder for updating a single ApiKey entity.
+type ApiKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ApiKeyMutation
+}
+
+// SetName sets the "name" field.
+func (akuo *ApiKeyUpdateOne) SetName(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetName(s)
+	return akuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableName(s *string) *ApiKeyUpdateOne {
+	if s != nil {
+		akuo.SetName(*s)
+	}
+	return akuo
+}
+
+// SetKeyPrefix sets the "key_prefix" field.
+func (akuo *ApiKeyUpdateOne) SetKeyPrefix(s string) *ApiKeyUpdateOne {
+	akuo.mutation.SetKeyPrefix(s)
+	return akuo
+}
+
+// SetNillableKeyPrefix sets the "key_prefix" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableKeyPrefix(s *string) *ApiKeyUpdateOne {
+	if s != nil {
+		akuo.SetKeyPrefix(*s)
+	}
+	return akuo
+}
+
+// SetDailyQuota sets the "daily_quota" field.
+func (akuo *ApiKeyUpdateOne) SetDailyQuota(i int) *ApiKeyUpdateOne {
+	akuo.mutation.ResetDailyQuota()
+	akuo.mutation.SetDailyQuota(i)
+	return akuo
+}
+
+// SetNillableDailyQuota sets the "daily_quota" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableDailyQuota(i *int) *ApiKeyUpdateOne {
+	if i != nil {
+		akuo.SetDailyQuota(*i)
+	}
+	return akuo
+}
+
+// AddDailyQuota adds i to the "daily_quota" field.
+func (akuo *ApiKeyUpdateOne) AddDailyQuota(i int) *ApiKeyUpdateOne {
+	akuo.mutation.AddDailyQuota(i)
+	return akuo
+}
+
+// SetMonthlyQuota sets the "monthly_quota" field.
+func (akuo *ApiKeyUpdateOne) SetMonthlyQuota(i int) *ApiKeyUpdateOne {
+	akuo.mutation.ResetMonthlyQuota()
+	akuo.mutation.SetMonthlyQuota(i)
+	return akuo
+}
+
+// SetNillableMonthlyQuota sets the "monthly_quota" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableMonthlyQuota(i *int) *ApiKeyUpdateOne {
+	if i != nil {
+		akuo.SetMonthlyQuota(*i)
+	}
+	return akuo
+}
+
+// AddMonthlyQuota adds i to the "monthly_quota" field.
+func (akuo *ApiKeyUpdateOne) AddMonthlyQuota(i int) *ApiKeyUpdateOne {
+	akuo.mutation.AddMonthlyQuota(i)
+	return akuo
+}
+
+// SetScopes sets the "scopes" field.
+func (akuo *ApiKeyUpdateOne) SetScopes(s []string) *ApiKeyUpdateOne {
+	akuo.mutation.SetScopes(s)
+	return akuo
+}
+
+// AppendScopes appends s to the "scopes" field.
+func (akuo *ApiKeyUpdateOne) AppendScopes(s []string) *ApiKeyUpdateOne {
+	akuo.mutation.AppendScopes(s)
+	return akuo
+}
+
+// ClearScopes clears the value of the "scopes" field.
+func (akuo *ApiKeyUpdateOne) ClearScopes() *ApiKeyUpdateOne {
+	akuo.mutation.ClearScopes()
+	return akuo
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (akuo *ApiKeyUpdateOne) SetLastUsedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetLastUsedAt(t)
+	return akuo
+}
+
+// SetNillableLastUsedAt sets the "last_used_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableLastUsedAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetLastUsedAt(*t)
+	}
+	return akuo
+}
+
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (akuo *ApiKeyUpdateOne) ClearLastUsedAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearLastUsedAt()
+	return akuo
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (akuo *ApiKeyUpdateOne) SetRevokedAt(t time.Time) *ApiKeyUpdateOne {
+	akuo.mutation.SetRevokedAt(t)
+	return akuo
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (akuo *ApiKeyUpdateOne) SetNillableRevokedAt(t *time.Time) *ApiKeyUpdateOne {
+	if t != nil {
+		akuo.SetRevokedAt(*t)
+	}
+	return akuo
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (akuo *ApiKeyUpdateOne) ClearRevokedAt() *ApiKeyUpdateOne {
+	akuo.mutation.ClearRevokedAt()
+	return akuo
+}
+
+// Mutation returns the ApiKeyMutation object of the builder.
+func (akuo *ApiKeyUpdateOne) Mutation() *ApiKeyMutation {
+	return akuo.mutation
+}
+
+// Where appends a list predicates to the ApiKeyUpdate builder.
+func (akuo *ApiKeyUpdateOne) Where(ps ...predicate.ApiKey) *ApiKeyUpdateOne {
+	akuo.mutation.Where(ps...)
+	return akuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (akuo *ApiKeyUpdateOne) Select(field string, fields ...string) *ApiKeyUpdateOne {
+	akuo.fields = append([]string{field}, fields...)
+	return akuo
+}
+
+// Save executes the query and returns the updated ApiKey entity.
+func (akuo *ApiKeyUpdateOne) Save(ctx context.Context) (*ApiKey, error) {
+	return withHooks(ctx, akuo.sqlSave, akuo.mutation, akuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (akuo *ApiKeyUpdateOne) SaveX(ctx context.Context) *ApiKey {
+	node, err := akuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (akuo *ApiKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := akuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (akuo *ApiKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := akuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (akuo *ApiKeyUpdateOne) check() error {
+	if v, ok := akuo.mutation.Name(); ok {
+		if err := apikey.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "ApiKey.name": %w`, err)}
+		}
+	}
+	if v, ok := akuo.mutation.KeyPrefix(); ok {
+		if err := apikey.KeyPrefixValidator(v); err != nil {
+			return &ValidationError{Name: "key_prefix", err: fmt.Errorf(`ent: validator failed for field "ApiKey.key_prefix": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (akuo *ApiKeyUpdateOne) sqlSave(ctx context.Context) (_node *ApiKey, err error) {
+	if err := akuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(apikey.Table, apikey.Columns, sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeString))
+	id, ok := akuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ApiKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := akuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, apikey.FieldID)
+		for _, f := range fields {
+			if !apikey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != apikey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := akuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := akuo.mutation.Name(); ok {
+		_spec.SetField(apikey.FieldName, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.KeyPrefix(); ok {
+		_spec.SetField(apikey.FieldKeyPrefix, field.TypeString, value)
+	}
+	if value, ok := akuo.mutation.DailyQuota(); ok {
+		_spec.SetField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := akuo.mutation.AddedDailyQuota(); ok {
+		_spec.AddField(apikey.FieldDailyQuota, field.TypeInt, value)
+	}
+	if value, ok := akuo.mutation.MonthlyQuota(); ok {
+		_spec.SetField(apikey.FieldMonthlyQuota, field.TypeInt, value)
+	}
+	if value, ok := akuo.mutation.AddedMonthlyQuota(); ok {
+		_spec.AddField(apikey.FieldMonthlyQuota, field.TypeInt, value)
+	}
+	if value, ok := akuo.mutation.Scopes(); ok {
+		_spec.SetField(apikey.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := akuo.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, apikey.FieldScopes, value)
+		})
+	}
+	if akuo.mutation.ScopesCleared() {
+		_spec.ClearField(apikey.FieldScopes, field.TypeJSON)
+	}
+	if value, ok := akuo.mutation.LastUsedAt(); ok {
+		_spec.SetField(apikey.FieldLastUsedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.LastUsedAtCleared() {
+		_spec.ClearField(apikey.FieldLastUsedAt, field.TypeTime)
+	}
+	if value, ok := akuo.mutation.RevokedAt(); ok {
+		_spec.SetField(apikey.FieldRevokedAt, field.TypeTime, value)
+	}
+	if akuo.mutation.RevokedAtCleared() {
+		_spec.ClearField(apikey.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &ApiKey{config: akuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, akuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{apikey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	akuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,434 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/authevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthEventCreate is the builder for creating a AuthEvent entity.
+type AuthEventCreate struct {
+	config
+	mutation *AuthEventMutation
+	hooks    []Hook
+}
+
+// SetType sets the "type" field.
+func (aec *AuthEventCreate) SetType(s string) *AuthEventCreate {
+	aec.mutation.SetType(s)
+	return aec
+}
+
+// SetProvider sets the "provider" field.
+func (aec *AuthEventCreate) SetProvider(s string) *AuthEventCreate {
+	aec.mutation.SetProvider(s)
+	return aec
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableProvider(s *string) *AuthEventCreate {
+	if s != nil {
+		aec.SetProvider(*s)
+	}
+	return aec
+}
+
+// SetIdentityID sets the "identity_id" field.
+func (aec *AuthEventCreate) SetIdentityID(s string) *AuthEventCreate {
+	aec.mutation.SetIdentityID(s)
+	return aec
+}
+
+// SetNillableIdentityID sets the "identity_id" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableIdentityID(s *string) *AuthEventCreate {
+	if s != nil {
+		aec.SetIdentityID(*s)
+	}
+	return aec
+}
+
+// SetEmail sets the "email" field.
+func (aec *AuthEventCreate) SetEmail(s string) *AuthEventCreate {
+	aec.mutation.SetEmail(s)
+	return aec
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableEmail(s *string) *AuthEventCreate {
+	if s != nil {
+		aec.SetEmail(*s)
+	}
+	return aec
+}
+
+// SetIP sets the "ip" field.
+func (aec *AuthEventCreate) SetIP(s string) *AuthEventCreate {
+	aec.mutation.SetIP(s)
+	return aec
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableIP(s *string) *AuthEventCreate {
+	if s != nil {
+		aec.SetIP(*s)
+	}
+	return aec
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aec *AuthEventCreate) SetUserAgent(s string) *AuthEventCreate {
+	aec.mutation.SetUserAgent(s)
+	return aec
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableUserAgent(s *string) *AuthEventCreate {
+	if s != nil {
+		aec.SetUserAgent(*s)
+	}
+	return aec
+}
+
+// SetOutcome sets the "outcome" field.
+func (aec *AuthEventCreate) SetOutcome(s string) *AuthEventCreate {
+	aec.mutation.SetOutcome(s)
+	return aec
+}
+
+// SetReason sets the "reason" field.
+func (aec *AuthEventCreate) SetReason(s string) *AuthEventCreate {
+	aec.mutation.SetReason(s)
+	return aec
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableReason(s *string) *AuthEventCreate {
+	if s != nil {
+		aec.SetReason(*s)
+	}
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *AuthEventCreate) SetCreatedAt(t time.Time) *AuthEventCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *AuthEventCreate) SetNillableCreatedAt(t *time.Time) *AuthEventCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetID sets the "id" field.
+func (aec *AuthEventCreate) SetID(s string) *AuthEventCreate {
+	aec.mutation.SetID(s)
+	return aec
+}
+
+// Mutation returns the AuthEventMutation object of the builder.
+func (aec *AuthEventCreate) Mutation() *AuthEventMutation {
+	return aec.mutation
+}
+
+// Save creates the AuthEvent in the database.
+func (aec *AuthEventCreate) Save(ctx context.Context) (*AuthEvent, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *AuthEventCreate) SaveX(ctx context.Context) *AuthEvent {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *AuthEventCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *AuthEventCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *AuthEventCreate) defaults() {
+	if _, ok := aec.mutation.Provider(); !ok {
+		v := authevent.DefaultProvider
+		aec.mutation.SetProvider(v)
+	}
+	if _, ok := aec.mutation.IdentityID(); !ok {
+		v := authevent.DefaultIdentityID
+		aec.mutation.SetIdentityID(v)
+	}
+	if _, ok := aec.mutation.Email(); !ok {
+		v := authevent.DefaultEmail
+		aec.mutation.SetEmail(v)
+	}
+	if _, ok := aec.mutation.IP(); !ok {
+		v := authevent.DefaultIP
+		aec.mutation.SetIP(v)
+	}
+	if _, ok := aec.mutation.UserAgent(); !ok {
+		v := authevent.DefaultUserAgent
+		aec.mutation.SetUserAgent(v)
+	}
+	if _, ok := aec.mutation.Reason(); !ok {
+		v := authevent.DefaultReason
+		aec.mutation.SetReason(v)
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := authevent.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *AuthEventCreate) check() error {
+	if _, ok := aec.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "AuthEvent.type"`)}
+	}
+	if v, ok := aec.mutation.GetType(); ok {
+		if err := authevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.type": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "AuthEvent.provider"`)}
+	}
+	if v, ok := aec.mutation.Provider(); ok {
+		if err := authevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.provider": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.IdentityID(); !ok {
+		return &ValidationError{Name: "identity_id", err: errors.New(`ent: missing required field "AuthEvent.identity_id"`)}
+	}
+	if v, ok := aec.mutation.IdentityID(); ok {
+		if err := authevent.IdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "identity_id", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.identity_id": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "AuthEvent.email"`)}
+	}
+	if v, ok := aec.mutation.Email(); ok {
+		if err := authevent.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.email": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "AuthEvent.ip"`)}
+	}
+	if v, ok := aec.mutation.IP(); ok {
+		if err := authevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.ip": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.UserAgent(); !ok {
+		return &ValidationError{Name: "user_agent", err: errors.New(`ent: missing required field "AuthEvent.user_agent"`)}
+	}
+	if v, ok := aec.mutation.UserAgent(); ok {
+		if err := authevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.user_agent": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Outcome(); !ok {
+		return &ValidationError{Name: "outcome", err: errors.New(`ent: missing required field "AuthEvent.outcome"`)}
+	}
+	if v, ok := aec.mutation.Outcome(); ok {
+		if err := authevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.outcome": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "AuthEvent.reason"`)}
+	}
+	if v, ok := aec.mutation.Reason(); ok {
+		if err := authevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.reason": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "AuthEvent.created_at"`)}
+	}
+	if v, ok := aec.mutation.ID(); ok {
+		if err := authevent.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aec *AuthEventCreate) sqlSave(ctx context.Context) (*AuthEvent, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected AuthEvent.ID type: %T", _spec.ID.Value)
+		}
+	}
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *AuthEventCreate) createSpec() (*AuthEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuthEvent{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(authevent.Table, sqlgraph.NewFieldSpec(authevent.FieldID, field.TypeString))
+	)
+	if id, ok := aec.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := aec.mutation.GetType(); ok {
+		_spec.SetField(authevent.FieldType, field.TypeString, value)
+		_node.Type = value
+	}
+	if value, ok := aec.mutation.Provider(); ok {
+		_spec.SetField(authevent.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := aec.mutation.IdentityID(); ok {
+		_spec.SetField(authevent.FieldIdentityID, field.TypeString, value)
+		_node.IdentityID = value
+	}
+	if value, ok := aec.mutation.Email(); ok {
+		_spec.SetField(authevent.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := aec.mutation.IP(); ok {
+		_spec.SetField(authevent.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := aec.mutation.UserAgent(); ok {
+		_spec.SetField(authevent.FieldUserAgent, field.TypeString, value)
+		_node.UserAgent = value
+	}
+	if value, ok := aec.mutation.Outcome(); ok {
+		_spec.SetField(authevent.FieldOutcome, field.TypeString, value)
+		_node.Outcome = value
+	}
+	if value, ok := aec.mutation.Reason(); ok {
+		_spec.SetField(authevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(authevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// AuthEventCreateBulk is the builder for creating many AuthEvent entities in bulk.
+type AuthEventCreateBulk struct {
+	config
+	err      error
+	builders []*AuthEventCreate
+}
+
+// Save creates the AuthEvent entities in the database.
+func (aecb *AuthEventCreateBulk) Save(ctx context.Context) ([]*AuthEvent, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*AuthEvent, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuthEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *AuthEventCreateBulk) SaveX(ctx context.Context) []*AuthEvent {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *AuthEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *AuthEventCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,543 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/authevent"
+	"silan-backend/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// AuthEventUpdate is the builder for updating AuthEvent entities.
+type AuthEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuthEventMutation
+}
+
+// Where appends a list predicates to the AuthEventUpdate builder.
+func (aeu *AuthEventUpdate) Where(ps ...predicate.AuthEvent) *AuthEventUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetType sets the "type" field.
+func (aeu *AuthEventUpdate) SetType(s string) *AuthEventUpdate {
+	aeu.mutation.SetType(s)
+	return aeu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableType(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetType(*s)
+	}
+	return aeu
+}
+
+// SetProvider sets the "provider" field.
+func (aeu *AuthEventUpdate) SetProvider(s string) *AuthEventUpdate {
+	aeu.mutation.SetProvider(s)
+	return aeu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableProvider(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetProvider(*s)
+	}
+	return aeu
+}
+
+// SetIdentityID sets the "identity_id" field.
+func (aeu *AuthEventUpdate) SetIdentityID(s string) *AuthEventUpdate {
+	aeu.mutation.SetIdentityID(s)
+	return aeu
+}
+
+// SetNillableIdentityID sets the "identity_id" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableIdentityID(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetIdentityID(*s)
+	}
+	return aeu
+}
+
+// SetEmail sets the "email" field.
+func (aeu *AuthEventUpdate) SetEmail(s string) *AuthEventUpdate {
+	aeu.mutation.SetEmail(s)
+	return aeu
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableEmail(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetEmail(*s)
+	}
+	return aeu
+}
+
+// SetIP sets the "ip" field.
+func (aeu *AuthEventUpdate) SetIP(s string) *AuthEventUpdate {
+	aeu.mutation.SetIP(s)
+	return aeu
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableIP(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetIP(*s)
+	}
+	return aeu
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aeu *AuthEventUpdate) SetUserAgent(s string) *AuthEventUpdate {
+	aeu.mutation.SetUserAgent(s)
+	return aeu
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableUserAgent(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetUserAgent(*s)
+	}
+	return aeu
+}
+
+// SetOutcome sets the "outcome" field.
+func (aeu *AuthEventUpdate) SetOutcome(s string) *AuthEventUpdate {
+	aeu.mutation.SetOutcome(s)
+	return aeu
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableOutcome(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetOutcome(*s)
+	}
+	return aeu
+}
+
+// SetReason sets the "reason" field.
+func (aeu *AuthEventUpdate) SetReason(s string) *AuthEventUpdate {
+	aeu.mutation.SetReason(s)
+	return aeu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (aeu *AuthEventUpdate) SetNillableReason(s *string) *AuthEventUpdate {
+	if s != nil {
+		aeu.SetReason(*s)
+	}
+	return aeu
+}
+
+// Mutation returns the AuthEventMutation object of the builder.
+func (aeu *AuthEventUpdate) Mutation() *AuthEventMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *AuthEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *AuthEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *AuthEventUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *AuthEventUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *AuthEventUpdate) check() error {
+	if v, ok := aeu.mutation.GetType(); ok {
+		if err := authevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.Provider(); ok {
+		if err := authevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.IdentityID(); ok {
+		if err := authevent.IdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "identity_id", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.identity_id": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.Email(); ok {
+		if err := authevent.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.email": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.IP(); ok {
+		if err := authevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.ip": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.UserAgent(); ok {
+		if err := authevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.Outcome(); ok {
+		if err := authevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.outcome": %w`, err)}
+		}
+	}
+	if v, ok := aeu.mutation.Reason(); ok {
+		if err := authevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aeu *AuthEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authevent.Table, authevent.Columns, sqlgraph.NewFieldSpec(authevent.FieldID, field.TypeString))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.GetType(); ok {
+		_spec.SetField(authevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Provider(); ok {
+		_spec.SetField(authevent.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.IdentityID(); ok {
+		_spec.SetField(authevent.FieldIdentityID, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Email(); ok {
+		_spec.SetField(authevent.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.IP(); ok {
+		_spec.SetField(authevent.FieldIP, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.UserAgent(); ok {
+		_spec.SetField(authevent.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Outcome(); ok {
+		_spec.SetField(authevent.FieldOutcome, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Reason(); ok {
+		_spec.SetField(authevent.FieldReason, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// AuthEventUpdateOne is the builder for updating a single AuthEvent entity.
+type AuthEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuthEventMutation
+}
+
+// SetType sets the "type" field.
+func (aeuo *AuthEventUpdateOne) SetType(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetType(s)
+	return aeuo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableType(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetType(*s)
+	}
+	return aeuo
+}
+
+// SetProvider sets the "provider" field.
+func (aeuo *AuthEventUpdateOne) SetProvider(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetProvider(s)
+	return aeuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableProvider(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetProvider(*s)
+	}
+	return aeuo
+}
+
+// SetIdentityID sets the "identity_id" field.
+func (aeuo *AuthEventUpdateOne) SetIdentityID(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetIdentityID(s)
+	return aeuo
+}
+
+// SetNillableIdentityID sets the "identity_id" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableIdentityID(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetIdentityID(*s)
+	}
+	return aeuo
+}
+
+// SetEmail sets the "email" field.
+func (aeuo *AuthEventUpdateOne) SetEmail(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetEmail(s)
+	return aeuo
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableEmail(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetEmail(*s)
+	}
+	return aeuo
+}
+
+// SetIP sets the "ip" field.
+func (aeuo *AuthEventUpdateOne) SetIP(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetIP(s)
+	return aeuo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableIP(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetIP(*s)
+	}
+	return aeuo
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (aeuo *AuthEventUpdateOne) SetUserAgent(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetUserAgent(s)
+	return aeuo
+}
+
+// SetNillableUserAgent sets the "user_agent" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableUserAgent(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetUserAgent(*s)
+	}
+	return aeuo
+}
+
+// SetOutcome sets the "outcome" field.
+func (aeuo *AuthEventUpdateOne) SetOutcome(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetOutcome(s)
+	return aeuo
+}
+
+// SetNillableOutcome sets the "outcome" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableOutcome(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetOutcome(*s)
+	}
+	return aeuo
+}
+
+// SetReason sets the "reason" field.
+func (aeuo *AuthEventUpdateOne) SetReason(s string) *AuthEventUpdateOne {
+	aeuo.mutation.SetReason(s)
+	return aeuo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (aeuo *AuthEventUpdateOne) SetNillableReason(s *string) *AuthEventUpdateOne {
+	if s != nil {
+		aeuo.SetReason(*s)
+	}
+	return aeuo
+}
+
+// Mutation returns the AuthEventMutation object of the builder.
+func (aeuo *AuthEventUpdateOne) Mutation() *AuthEventMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the AuthEventUpdate builder.
+func (aeuo *AuthEventUpdateOne) Where(ps ...predicate.AuthEvent) *AuthEventUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *AuthEventUpdateOne) Select(field string, fields ...string) *AuthEventUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated AuthEvent entity.
+func (aeuo *AuthEventUpdateOne) Save(ctx context.Context) (*AuthEvent, error) {
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *AuthEventUpdateOne) SaveX(ctx context.Context) *AuthEvent {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *AuthEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *AuthEventUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *AuthEventUpdateOne) check() error {
+	if v, ok := aeuo.mutation.GetType(); ok {
+		if err := authevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.Provider(); ok {
+		if err := authevent.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.provider": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.IdentityID(); ok {
+		if err := authevent.IdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "identity_id", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.identity_id": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.Email(); ok {
+		if err := authevent.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.email": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.IP(); ok {
+		if err := authevent.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.ip": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.UserAgent(); ok {
+		if err := authevent.UserAgentValidator(v); err != nil {
+			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.user_agent": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.Outcome(); ok {
+		if err := authevent.OutcomeValidator(v); err != nil {
+			return &ValidationError{Name: "outcome", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.outcome": %w`, err)}
+		}
+	}
+	if v, ok := aeuo.mutation.Reason(); ok {
+		if err := authevent.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "AuthEvent.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (aeuo *AuthEventUpdateOne) sqlSave(ctx context.Context) (_node *AuthEvent, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(authevent.Table, authevent.Columns, sqlgraph.NewFieldSpec(authevent.FieldID, field.TypeString))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AuthEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, authevent.FieldID)
+		for _, f := range fields {
+			if !authevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != authevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.GetType(); ok {
+		_spec.SetField(authevent.FieldType, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Provider(); ok {
+		_spec.SetField(authevent.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.IdentityID(); ok {
+		_spec.SetField(authevent.FieldIdentityID, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Email(); ok {
+		_spec.SetField(authevent.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.IP(); ok {
+		_spec.SetField(authevent.FieldIP, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.UserAgent(); ok {
+		_spec.SetField(authevent.FieldUserAgent, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Outcome(); ok {
+		_spec.SetField(authevent.FieldOutcome, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Reason(); ok {
+		_spec.SetField(authevent.FieldReason, field.TypeString, value)
+	}
+	_node = &AuthEvent{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{authevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
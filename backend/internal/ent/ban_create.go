@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/ban"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BanCreate is the builder for creating a Ban entity.
+type BanCreate struct {
+	config
+	mutation *BanMutation
+	hooks    []Hook
+}
+
+// SetKind sets the "kind" field.
+func (bc *BanCreate) SetKind(s string) *BanCreate {
+	bc.mutation.SetKind(s)
+	return bc
+}
+
+// SetValue sets the "value" field.
+func (bc *BanCreate) SetValue(s string) *BanCreate {
+	bc.mutation.SetValue(s)
+	return bc
+}
+
+// SetReason sets the "reason" field.
+func (bc *BanCreate) SetReason(s string) *BanCreate {
+	bc.mutation.SetReason(s)
+	return bc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (bc *BanCreate) SetNillableReason(s *string) *BanCreate {
+	if s != nil {
+		bc.SetReason(*s)
+	}
+	return bc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bc *BanCreate) SetCreatedAt(t time.Time) *BanCreate {
+	bc.mutation.SetCreatedAt(t)
+	return bc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bc *BanCreate) SetNillableCreatedAt(t *time.Time) *BanCreate {
+	if t != nil {
+		bc.SetCreatedAt(*t)
+	}
+	return bc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (bc *BanCreate) SetExpiresAt(t time.Time) *BanCreate {
+	bc.mutation.SetExpiresAt(t)
+	return bc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (bc *BanCreate) SetNillableExpiresAt(t *time.Time) *BanCreate {
+	if t != nil {
+		bc.SetExpiresAt(*t)
+	}
+	return bc
+}
+
+// SetID sets the "id" field.
+func (bc *BanCreate) SetID(s string) *BanCreate {
+	bc.mutation.SetID(s)
+	return bc
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (bc *BanCreate) Mutation() *BanMutation {
+	return bc.mutation
+}
+
+// Save creates the Ban in the database.
+func (bc *BanCreate) Save(ctx context.Context) (*Ban, error) {
+	bc.defaults()
+	return withHooks(ctx, bc.sqlSave, bc.mutation, bc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (bc *BanCreate) SaveX(ctx context.Context) *Ban {
+	v, err := bc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bc *BanCreate) Exec(ctx context.Context) error {
+	_, err := bc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bc *BanCreate) ExecX(ctx context.Context) {
+	if err := bc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (bc *BanCreate) defaults() {
+	if _, ok := bc.mutation.Reason(); !ok {
+		v := ban.DefaultReason
+		bc.mutation.SetReason(v)
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		v := ban.DefaultCreatedAt()
+		bc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bc *BanCreate) check() error {
+	if _, ok := bc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Ban.kind"`)}
+	}
+	if v, ok := bc.mutation.Kind(); ok {
+		if err := ban.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Ban.kind": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "Ban.value"`)}
+	}
+	if v, ok := bc.mutation.Value(); ok {
+		if err := ban.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Ban.value": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "Ban.reason"`)}
+	}
+	if v, ok := bc.mutation.Reason(); ok {
+		if err := ban.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Ban.reason": %w`, err)}
+		}
+	}
+	if _, ok := bc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Ban.created_at"`)}
+	}
+	if v, ok := bc.mutation.ID(); ok {
+		if err := ban.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Ban.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bc *BanCreate) sqlSave(ctx context.Context) (*Ban, error) {
+	if err := bc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := bc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, bc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Ban.ID type: %T", _spec.ID.Value)
+		}
+	}
+	bc.mutation.id = &_node.ID
+	bc.mutation.done = true
+	return _node, nil
+}
+
+func (bc *BanCreate) createSpec() (*Ban, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Ban{config: bc.config}
+		_spec = sqlgraph.NewCreateSpec(ban.Table, sqlgraph.NewFieldSpec(ban.FieldID, field.TypeString))
+	)
+	if id, ok := bc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := bc.mutation.Kind(); ok {
+		_spec.SetField(ban.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := bc.mutation.Value(); ok {
+		_spec.SetField(ban.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := bc.mutation.Reason(); ok {
+		_spec.SetField(ban.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := bc.mutation.CreatedAt(); ok {
+		_spec.SetField(ban.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := bc.mutation.ExpiresAt(); ok {
+		_spec.SetField(ban.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	return _node, _spec
+}
+
+// BanCreateBulk is the builder for creating many Ban entities in bulk.
+type BanCreateBulk struct {
+	config
+	err      error
+	builders []*BanCreate
+}
+
+// Save creates the Ban entities in the database.
+func (bcb *BanCreateBulk) Save(ctx context.Context) ([]*Ban, error) {
+	if bcb.err != nil {
+		return nil, bcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(bcb.builders))
+	nodes := make([]*Ban, len(bcb.builders))
+	mutators := make([]Mutator, len(bcb.builders))
+	for i := range bcb.builders {
+		func(i int, root context.Context) {
+			builder := bcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BanMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, bcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, bcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, bcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bcb *BanCreateBulk) SaveX(ctx context.Context) []*Ban {
+	v, err := bcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (bcb *BanCreateBulk) Exec(ctx context.Context) error {
+	_, err := bcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bcb *BanCreateBulk) ExecX(ctx context.Context) {
+	if err := bcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
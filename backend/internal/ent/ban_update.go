@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/ban"
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// BanUpdate is the builder for updating Ban entities.
+type BanUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// Where appends a list predicates to the BanUpdate builder.
+func (bu *BanUpdate) Where(ps ...predicate.Ban) *BanUpdate {
+	bu.mutation.Where(ps...)
+	return bu
+}
+
+// SetKind sets the "kind" field.
+func (bu *BanUpdate) SetKind(s string) *BanUpdate {
+	bu.mutation.SetKind(s)
+	return bu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableKind(s *string) *BanUpdate {
+	if s != nil {
+		bu.SetKind(*s)
+	}
+	return bu
+}
+
+// SetValue sets the "value" field.
+func (bu *BanUpdate) SetValue(s string) *BanUpdate {
+	bu.mutation.SetValue(s)
+	return bu
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableValue(s *string) *BanUpdate {
+	if s != nil {
+		bu.SetValue(*s)
+	}
+	return bu
+}
+
+// SetReason sets the "reason" field.
+func (bu *BanUpdate) SetReason(s string) *BanUpdate {
+	bu.mutation.SetReason(s)
+	return bu
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableReason(s *string) *BanUpdate {
+	if s != nil {
+		bu.SetReason(*s)
+	}
+	return bu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (bu *BanUpdate) SetCreatedAt(t time.Time) *BanUpdate {
+	bu.mutation.SetCreatedAt(t)
+	return bu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableCreatedAt(t *time.Time) *BanUpdate {
+	if t != nil {
+		bu.SetCreatedAt(*t)
+	}
+	return bu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (bu *BanUpdate) SetExpiresAt(t time.Time) *BanUpdate {
+	bu.mutation.SetExpiresAt(t)
+	return bu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (bu *BanUpdate) SetNillableExpiresAt(t *time.Time) *BanUpdate {
+	if t != nil {
+		bu.SetExpiresAt(*t)
+	}
+	return bu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (bu *BanUpdate) ClearExpiresAt() *BanUpdate {
+	bu.mutation.ClearExpiresAt()
+	return bu
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (bu *BanUpdate) Mutation() *BanMutation {
+	return bu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (bu *BanUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, bu.sqlSave, bu.mutation, bu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (bu *BanUpdate) SaveX(ctx context.Context) int {
+	affected, err := bu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (bu *BanUpdate) Exec(ctx context.Context) error {
+	_, err := bu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (bu *BanUpdate) ExecX(ctx context.Context) {
+	if err := bu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (bu *BanUpdate) check() error {
+	if v, ok := bu.mutation.Kind(); ok {
+		if err := ban.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Ban.kind": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Value(); ok {
+		if err := ban.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Ban.value": %w`, err)}
+		}
+	}
+	if v, ok := bu.mutation.Reason(); ok {
+		if err := ban.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Ban.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (bu *BanUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := bu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ban.Table, ban.Columns, sqlgraph.NewFieldSpec(ban.FieldID, field.TypeString))
+	if ps := bu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := bu.mutation.Kind(); ok {
+		_spec.SetField(ban.FieldKind, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Value(); ok {
+		_spec.SetField(ban.FieldValue, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.Reason(); ok {
+		_spec.SetField(ban.FieldReason, field.TypeString, value)
+	}
+	if value, ok := bu.mutation.CreatedAt(); ok {
+		_spec.SetField(ban.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := bu.mutation.ExpiresAt(); ok {
+		_spec.SetField(ban.FieldExpiresAt, field.TypeTime, value)
+	}
+	if bu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(ban.FieldExpiresAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, bu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	bu.mutation.done = true
+	return n, nil
+}
+
+// BanUpdateOne is the builder for updating a single Ban entity.
+type BanUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BanMutation
+}
+
+// SetKind sets the "kind" field.
+func (buo *BanUpdateOne) SetKind(s string) *BanUpdateOne {
+	buo.mutation.SetKind(s)
+	return buo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableKind(s *string) *BanUpdateOne {
+	if s != nil {
+		buo.SetKind(*s)
+	}
+	return buo
+}
+
+// SetValue sets the "value" field.
+func (buo *BanUpdateOne) SetValue(s string) *BanUpdateOne {
+	buo.mutation.SetValue(s)
+	return buo
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableValue(s *string) *BanUpdateOne {
+	if s != nil {
+		buo.SetValue(*s)
+	}
+	return buo
+}
+
+// SetReason sets the "reason" field.
+func (buo *BanUpdateOne) SetReason(s string) *BanUpdateOne {
+	buo.mutation.SetReason(s)
+	return buo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableReason(s *string) *BanUpdateOne {
+	if s != nil {
+		buo.SetReason(*s)
+	}
+	return buo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (buo *BanUpdateOne) SetCreatedAt(t time.Time) *BanUpdateOne {
+	buo.mutation.SetCreatedAt(t)
+	return buo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableCreatedAt(t *time.Time) *BanUpdateOne {
+	if t != nil {
+		buo.SetCreatedAt(*t)
+	}
+	return buo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (buo *BanUpdateOne) SetExpiresAt(t time.Time) *BanUpdateOne {
+	buo.mutation.SetExpiresAt(t)
+	return buo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (buo *BanUpdateOne) SetNillableExpiresAt(t *time.Time) *BanUpdateOne {
+	if t != nil {
+		buo.SetExpiresAt(*t)
+	}
+	return buo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (buo *BanUpdateOne) ClearExpiresAt() *BanUpdateOne {
+	buo.mutation.ClearExpiresAt()
+	return buo
+}
+
+// Mutation returns the BanMutation object of the builder.
+func (buo *BanUpdateOne) Mutation() *BanMutation {
+	return buo.mutation
+}
+
+// Where appends a list predicates to the BanUpdate builder.
+func (buo *BanUpdateOne) Where(ps ...predicate.Ban) *BanUpdateOne {
+	buo.mutation.Where(ps...)
+	return buo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (buo *BanUpdateOne) Select(field string, fields ...string) *BanUpdateOne {
+	buo.fields = append([]string{field}, fields...)
+	return buo
+}
+
+// Save executes the query and returns the updated Ban entity.
+func (buo *BanUpdateOne) Save(ctx context.Context) (*Ban, error) {
+	return withHooks(ctx, buo.sqlSave, buo.mutation, buo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (buo *BanUpdateOne) SaveX(ctx context.Context) *Ban {
+	node, err := buo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (buo *BanUpdateOne) Exec(ctx context.Context) error {
+	_, err := buo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (buo *BanUpdateOne) ExecX(ctx context.Context) {
+	if err := buo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (buo *BanUpdateOne) check() error {
+	if v, ok := buo.mutation.Kind(); ok {
+		if err := ban.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Ban.kind": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Value(); ok {
+		if err := ban.ValueValidator(v); err != nil {
+			return &ValidationError{Name: "value", err: fmt.Errorf(`ent: validator failed for field "Ban.value": %w`, err)}
+		}
+	}
+	if v, ok := buo.mutation.Reason(); ok {
+		if err := ban.ReasonValidator(v); err != nil {
+			return &ValidationError{Name: "reason", err: fmt.Errorf(`ent: validator failed for field "Ban.reason": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (buo *BanUpdateOne) sqlSave(ctx context.Context) (_node *Ban, err error) {
+	if err := buo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ban.Table, ban.Columns, sqlgraph.NewFieldSpec(ban.FieldID, field.TypeString))
+	id, ok := buo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Ban.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := buo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ban.FieldID)
+		for _, f := range fields {
+			if !ban.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ban.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := buo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := buo.mutation.Kind(); ok {
+		_spec.SetField(ban.FieldKind, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Value(); ok {
+		_spec.SetField(ban.FieldValue, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.Reason(); ok {
+		_spec.SetField(ban.FieldReason, field.TypeString, value)
+	}
+	if value, ok := buo.mutation.CreatedAt(); ok {
+		_spec.SetField(ban.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := buo.mutation.ExpiresAt(); ok {
+		_spec.SetField(ban.FieldExpiresAt, field.TypeTime, value)
+	}
+	if buo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(ban.FieldExpiresAt, field.TypeTime)
+	}
+	_node = &Ban{config: buo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, buo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ban.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	buo.mutation.done = true
+	return _node, nil
+}
@@ -11,8 +11,11 @@ import (
 
 	"silan-backend/internal/ent/migrate"
 
+	"silan-backend/internal/ent/apikey"
+	"silan-backend/internal/ent/authevent"
 	"silan-backend/internal/ent/award"
 	"silan-backend/internal/ent/awardtranslation"
+	"silan-backend/internal/ent/ban"
 	"silan-backend/internal/ent/blogcategory"
 	"silan-backend/internal/ent/blogcategorytranslation"
 	"silan-backend/internal/ent/blogpost"
@@ -23,6 +26,7 @@ import (
 	"silan-backend/internal/ent/blogtag"
 	"silan-backend/internal/ent/comment"
 	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/commentmention"
 	"silan-backend/internal/ent/education"
 	"silan-backend/internal/ent/educationdetail"
 	"silan-backend/internal/ent/educationdetailtranslation"
@@ -32,6 +36,7 @@ import (
 	"silan-backend/internal/ent/ideadetailtranslation"
 	"silan-backend/internal/ent/ideatag"
 	"silan-backend/internal/ent/ideatranslation"
+	"silan-backend/internal/ent/identitylink"
 	"silan-backend/internal/ent/language"
 	"silan-backend/internal/ent/personalinfo"
 	"silan-backend/internal/ent/personalinfotranslation"
@@ -48,13 +53,17 @@ import (
 	"silan-backend/internal/ent/publication"
 	"silan-backend/internal/ent/publicationauthor"
 	"silan-backend/internal/ent/publicationtranslation"
+	"silan-backend/internal/ent/reaction"
 	"silan-backend/internal/ent/recentupdate"
 	"silan-backend/internal/ent/recentupdatetranslation"
 	"silan-backend/internal/ent/researchproject"
 	"silan-backend/internal/ent/researchprojectdetail"
 	"silan-backend/internal/ent/researchprojectdetailtranslation"
 	"silan-backend/internal/ent/researchprojecttranslation"
+	"silan-backend/internal/ent/session"
+	"silan-backend/internal/ent/signaturenonce"
 	"silan-backend/internal/ent/sociallink"
+	"silan-backend/internal/ent/spamscore"
 	"silan-backend/internal/ent/user"
 	"silan-backend/internal/ent/useridentity"
 	"silan-backend/internal/ent/workexperience"
@@ -74,10 +83,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// AuthEvent is the client for interacting with the AuthEvent builders.
+	AuthEvent *AuthEventClient
 	// Award is the client for interacting with the Award builders.
 	Award *AwardClient
 	// AwardTranslation is the client for interacting with the AwardTranslation builders.
 	AwardTranslation *AwardTranslationClient
+	// Ban is the client for interacting with the Ban builders.
+	Ban *BanClient
 	// BlogCategory is the client for interacting with the BlogCategory builders.
 	BlogCategory *BlogCategoryClient
 	// BlogCategoryTranslation is the client for interacting with the BlogCategoryTranslation builders.
@@ -98,6 +113,8 @@ type Client struct {
 	Comment *CommentClient
 	// CommentLike is the client for interacting with the CommentLike builders.
 	CommentLike *CommentLikeClient
+	// CommentMention is the client for interacting with the CommentMention builders.
+	CommentMention *CommentMentionClient
 	// Education is the client for interacting with the Education builders.
 	Education *EducationClient
 	// EducationDetail is the client for interacting with the EducationDetail builders.
@@ -116,6 +133,8 @@ type Client struct {
 	IdeaTag *IdeaTagClient
 	// IdeaTranslation is the client for interacting with the IdeaTranslation builders.
 	IdeaTranslation *IdeaTranslationClient
+	// IdentityLink is the client for interacting with the IdentityLink builders.
+	IdentityLink *IdentityLinkClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
 	// PersonalInfo is the client for interacting with the PersonalInfo builders.
@@ -148,6 +167,8 @@ type Client struct {
 	PublicationAuthor *PublicationAuthorClient
 	// PublicationTranslation is the client for interacting with the PublicationTranslation builders.
 	PublicationTranslation *PublicationTranslationClient
+	// Reaction is the client for interacting with the Reaction builders.
+	Reaction *ReactionClient
 	// RecentUpdate is the client for interacting with the RecentUpdate builders.
 	RecentUpdate *RecentUpdateClient
 	// RecentUpdateTranslation is the client for interacting with the RecentUpdateTranslation builders.
@@ -160,8 +181,14 @@ type Client struct {
 	ResearchProjectDetailTranslation *ResearchProjectDetailTranslationClient
 	// ResearchProjectTranslation is the client for interacting with the ResearchProjectTranslation builders.
 	ResearchProjectTranslation *ResearchProjectTranslationClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SignatureNonce is the client for interacting with the SignatureNonce builders.
+	SignatureNonce *SignatureNonceClient
 	// SocialLink is the client for interacting with the SocialLink builders.
 	SocialLink *SocialLinkClient
+	// SpamScore is the client for interacting with the SpamScore builders.
+	SpamScore *SpamScoreClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserIdentity is the client for interacting with the UserIdentity builders.
@@ -185,8 +212,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.ApiKey = NewApiKeyClient(c.config)
+	c.AuthEvent = NewAuthEventClient(c.config)
 	c.Award = NewAwardClient(c.config)
 	c.AwardTranslation = NewAwardTranslationClient(c.config)
+	c.Ban = NewBanClient(c.config)
 	c.BlogCategory = NewBlogCategoryClient(c.config)
 	c.BlogCategoryTranslation = NewBlogCategoryTranslationClient(c.config)
 	c.BlogPost = NewBlogPostClient(c.config)
@@ -197,6 +227,7 @@ func (c *Client) init() {
 	c.BlogTag = NewBlogTagClient(c.config)
 	c.Comment = NewCommentClient(c.config)
 	c.CommentLike = NewCommentLikeClient(c.config)
+	c.CommentMention = NewCommentMentionClient(c.config)
 	c.Education = NewEducationClient(c.config)
 	c.EducationDetail = NewEducationDetailClient(c.config)
 	c.EducationDetailTranslation = NewEducationDetailTranslationClient(c.config)
@@ -206,6 +237,7 @@ func (c *Client) init() {
 	c.IdeaDetailTranslation = NewIdeaDetailTranslationClient(c.config)
 	c.IdeaTag = NewIdeaTagClient(c.config)
 	c.IdeaTranslation = NewIdeaTranslationClient(c.config)
+	c.IdentityLink = NewIdentityLinkClient(c.config)
 	c.Language = NewLanguageClient(c.config)
 	c.PersonalInfo = NewPersonalInfoClient(c.config)
 	c.PersonalInfoTranslation = NewPersonalInfoTranslationClient(c.config)
@@ -222,13 +254,17 @@ func (c *Client) init() {
 	c.Publication = NewPublicationClient(c.config)
 	c.PublicationAuthor = NewPublicationAuthorClient(c.config)
 	c.PublicationTranslation = NewPublicationTranslationClient(c.config)
+	c.Reaction = NewReactionClient(c.config)
 	c.RecentUpdate = NewRecentUpdateClient(c.config)
 	c.RecentUpdateTranslation = NewRecentUpdateTranslationClient(c.config)
 	c.ResearchProject = NewResearchProjectClient(c.config)
 	c.ResearchProjectDetail = NewResearchProjectDetailClient(c.config)
 	c.ResearchProjectDetailTranslation = NewResearchProjectDetailTranslationClient(c.config)
 	c.ResearchProjectTranslation = NewResearchProjectTranslationClient(c.config)
+	c.Session = NewSessionClient(c.config)
+	c.SignatureNonce = NewSignatureNonceClient(c.config)
 	c.SocialLink = NewSocialLinkClient(c.config)
+	c.SpamScore = NewSpamScoreClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserIdentity = NewUserIdentityClient(c.config)
 	c.WorkExperience = NewWorkExperienceClient(c.config)
@@ -327,8 +363,11 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                              ctx,
 		config:                           cfg,
+		ApiKey:                           NewApiKeyClient(cfg),
+		AuthEvent:                        NewAuthEventClient(cfg),
 		Award:                            NewAwardClient(cfg),
 		AwardTranslation:                 NewAwardTranslationClient(cfg),
+		Ban:                              NewBanClient(cfg),
 		BlogCategory:                     NewBlogCategoryClient(cfg),
 		BlogCategoryTranslation:          NewBlogCategoryTranslationClient(cfg),
 		BlogPost:                         NewBlogPostClient(cfg),
@@ -339,6 +378,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		BlogTag:                          NewBlogTagClient(cfg),
 		Comment:                          NewCommentClient(cfg),
 		CommentLike:                      NewCommentLikeClient(cfg),
+		CommentMention:                   NewCommentMentionClient(cfg),
 		Education:                        NewEducationClient(cfg),
 		EducationDetail:                  NewEducationDetailClient(cfg),
 		EducationDetailTranslation:       NewEducationDetailTranslationClient(cfg),
@@ -348,6 +388,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		IdeaDetailTranslation:            NewIdeaDetailTranslationClient(cfg),
 		IdeaTag:                          NewIdeaTagClient(cfg),
 		IdeaTranslation:                  NewIdeaTranslationClient(cfg),
+		IdentityLink:                     NewIdentityLinkClient(cfg),
 		Language:                         NewLanguageClient(cfg),
 		PersonalInfo:                     NewPersonalInfoClient(cfg),
 		PersonalInfoTranslation:          NewPersonalInfoTranslationClient(cfg),
@@ -364,13 +405,17 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Publication:                      NewPublicationClient(cfg),
 		PublicationAuthor:                NewPublicationAuthorClient(cfg),
 		PublicationTranslation:           NewPublicationTranslationClient(cfg),
+		Reaction:                         NewReactionClient(cfg),
 		RecentUpdate:                     NewRecentUpdateClient(cfg),
 		RecentUpdateTranslation:          NewRecentUpdateTranslationClient(cfg),
 		ResearchProject:                  NewResearchProjectClient(cfg),
 		ResearchProjectDetail:            NewResearchProjectDetailClient(cfg),
 		ResearchProjectDetailTranslation: NewResearchProjectDetailTranslationClient(cfg),
 		ResearchProjectTranslation:       NewResearchProjectTranslationClient(cfg),
+		Session:                          NewSessionClient(cfg),
+		SignatureNonce:                   NewSignatureNonceClient(cfg),
 		SocialLink:                       NewSocialLinkClient(cfg),
+		SpamScore:                        NewSpamScoreClient(cfg),
 		User:                             NewUserClient(cfg),
 		UserIdentity:                     NewUserIdentityClient(cfg),
 		WorkExperience:                   NewWorkExperienceClient(cfg),
@@ -396,8 +441,11 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                              ctx,
 		config:                           cfg,
+		ApiKey:                           NewApiKeyClient(cfg),
+		AuthEvent:                        NewAuthEventClient(cfg),
 		Award:                            NewAwardClient(cfg),
 		AwardTranslation:                 NewAwardTranslationClient(cfg),
+		Ban:                              NewBanClient(cfg),
 		BlogCategory:                     NewBlogCategoryClient(cfg),
 		BlogCategoryTranslation:          NewBlogCategoryTranslationClient(cfg),
 		BlogPost:                         NewBlogPostClient(cfg),
@@ -408,6 +456,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		BlogTag:                          NewBlogTagClient(cfg),
 		Comment:                          NewCommentClient(cfg),
 		CommentLike:                      NewCommentLikeClient(cfg),
+		CommentMention:                   NewCommentMentionClient(cfg),
 		Education:                        NewEducationClient(cfg),
 		EducationDetail:                  NewEducationDetailClient(cfg),
 		EducationDetailTranslation:       NewEducationDetailTranslationClient(cfg),
@@ -417,6 +466,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		IdeaDetailTranslation:            NewIdeaDetailTranslationClient(cfg),
 		IdeaTag:                          NewIdeaTagClient(cfg),
 		IdeaTranslation:                  NewIdeaTranslationClient(cfg),
+		IdentityLink:                     NewIdentityLinkClient(cfg),
 		Language:                         NewLanguageClient(cfg),
 		PersonalInfo:                     NewPersonalInfoClient(cfg),
 		PersonalInfoTranslation:          NewPersonalInfoTranslationClient(cfg),
@@ -433,13 +483,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Publication:                      NewPublicationClient(cfg),
 		PublicationAuthor:                NewPublicationAuthorClient(cfg),
 		PublicationTranslation:           NewPublicationTranslationClient(cfg),
+		Reaction:                         NewReactionClient(cfg),
 		RecentUpdate:                     NewRecentUpdateClient(cfg),
 		RecentUpdateTranslation:          NewRecentUpdateTranslationClient(cfg),
 		ResearchProject:                  NewResearchProjectClient(cfg),
 		ResearchProjectDetail:            NewResearchProjectDetailClient(cfg),
 		ResearchProjectDetailTranslation: NewResearchProjectDetailTranslationClient(cfg),
 		ResearchProjectTranslation:       NewResearchProjectTranslationClient(cfg),
+		Session:                          NewSessionClient(cfg),
+		SignatureNonce:                   NewSignatureNonceClient(cfg),
 		SocialLink:                       NewSocialLinkClient(cfg),
+		SpamScore:                        NewSpamScoreClient(cfg),
 		User:                             NewUserClient(cfg),
 		UserIdentity:                     NewUserIdentityClient(cfg),
 		WorkExperience:                   NewWorkExperienceClient(cfg),
@@ -452,7 +506,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Award.
+//		ApiKey.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -475,20 +529,22 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Award, c.AwardTranslation, c.BlogCategory, c.BlogCategoryTranslation,
-		c.BlogPost, c.BlogPostTag, c.BlogPostTranslation, c.BlogSeries,
-		c.BlogSeriesTranslation, c.BlogTag, c.Comment, c.CommentLike, c.Education,
-		c.EducationDetail, c.EducationDetailTranslation, c.EducationTranslation,
-		c.Idea, c.IdeaDetail, c.IdeaDetailTranslation, c.IdeaTag, c.IdeaTranslation,
-		c.Language, c.PersonalInfo, c.PersonalInfoTranslation, c.Project,
-		c.ProjectDetail, c.ProjectDetailTranslation, c.ProjectImage,
-		c.ProjectImageTranslation, c.ProjectLike, c.ProjectRelationship,
-		c.ProjectTechnology, c.ProjectTranslation, c.ProjectView, c.Publication,
-		c.PublicationAuthor, c.PublicationTranslation, c.RecentUpdate,
+		c.ApiKey, c.AuthEvent, c.Award, c.AwardTranslation, c.Ban, c.BlogCategory,
+		c.BlogCategoryTranslation, c.BlogPost, c.BlogPostTag, c.BlogPostTranslation,
+		c.BlogSeries, c.BlogSeriesTranslation, c.BlogTag, c.Comment, c.CommentLike,
+		c.CommentMention, c.Education, c.EducationDetail, c.EducationDetailTranslation,
+		c.EducationTranslation, c.Idea, c.IdeaDetail, c.IdeaDetailTranslation,
+		c.IdeaTag, c.IdeaTranslation, c.IdentityLink, c.Language, c.PersonalInfo,
+		c.PersonalInfoTranslation, c.Project, c.ProjectDetail,
+		c.ProjectDetailTranslation, c.ProjectImage, c.ProjectImageTranslation,
+		c.ProjectLike, c.ProjectRelationship, c.ProjectTechnology,
+		c.ProjectTranslation, c.ProjectView, c.Publication, c.PublicationAuthor,
+		c.PublicationTranslation, c.Reaction, c.RecentUpdate,
 		c.RecentUpdateTranslation, c.ResearchProject, c.ResearchProjectDetail,
-		c.ResearchProjectDetailTranslation, c.ResearchProjectTranslation, c.SocialLink,
-		c.User, c.UserIdentity, c.WorkExperience, c.WorkExperienceDetail,
-		c.WorkExperienceDetailTranslation, c.WorkExperienceTranslation,
+		c.ResearchProjectDetailTranslation, c.ResearchProjectTranslation, c.Session,
+		c.SignatureNonce, c.SocialLink, c.SpamScore, c.User, c.UserIdentity,
+		c.WorkExperience, c.WorkExperienceDetail, c.WorkExperienceDetailTranslation,
+		c.WorkExperienceTranslation,
 	} {
 		n.Use(hooks...)
 	}
@@ -498,20 +554,22 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Award, c.AwardTranslation, c.BlogCategory, c.BlogCategoryTranslation,
-		c.BlogPost, c.BlogPostTag, c.BlogPostTranslation, c.BlogSeries,
-		c.BlogSeriesTranslation, c.BlogTag, c.Comment, c.CommentLike, c.Education,
-		c.EducationDetail, c.EducationDetailTranslation, c.EducationTranslation,
-		c.Idea, c.IdeaDetail, c.IdeaDetailTranslation, c.IdeaTag, c.IdeaTranslation,
-		c.Language, c.PersonalInfo, c.PersonalInfoTranslation, c.Project,
-		c.ProjectDetail, c.ProjectDetailTranslation, c.ProjectImage,
-		c.ProjectImageTranslation, c.ProjectLike, c.ProjectRelationship,
-		c.ProjectTechnology, c.ProjectTranslation, c.ProjectView, c.Publication,
-		c.PublicationAuthor, c.PublicationTranslation, c.RecentUpdate,
+		c.ApiKey, c.AuthEvent, c.Award, c.AwardTranslation, c.Ban, c.BlogCategory,
+		c.BlogCategoryTranslation, c.BlogPost, c.BlogPostTag, c.BlogPostTranslation,
+		c.BlogSeries, c.BlogSeriesTranslation, c.BlogTag, c.Comment, c.CommentLike,
+		c.CommentMention, c.Education, c.EducationDetail, c.EducationDetailTranslation,
+		c.EducationTranslation, c.Idea, c.IdeaDetail, c.IdeaDetailTranslation,
+		c.IdeaTag, c.IdeaTranslation, c.IdentityLink, c.Language, c.PersonalInfo,
+		c.PersonalInfoTranslation, c.Project, c.ProjectDetail,
+		c.ProjectDetailTranslation, c.ProjectImage, c.ProjectImageTranslation,
+		c.ProjectLike, c.ProjectRelationship, c.ProjectTechnology,
+		c.ProjectTranslation, c.ProjectView, c.Publication, c.PublicationAuthor,
+		c.PublicationTranslation, c.Reaction, c.RecentUpdate,
 		c.RecentUpdateTranslation, c.ResearchProject, c.ResearchProjectDetail,
-		c.ResearchProjectDetailTranslation, c.ResearchProjectTranslation, c.SocialLink,
-		c.User, c.UserIdentity, c.WorkExperience, c.WorkExperienceDetail,
-		c.WorkExperienceDetailTranslation, c.WorkExperienceTranslation,
+		c.ResearchProjectDetailTranslation, c.ResearchProjectTranslation, c.Session,
+		c.SignatureNonce, c.SocialLink, c.SpamScore, c.User, c.UserIdentity,
+		c.WorkExperience, c.WorkExperienceDetail, c.WorkExperienceDetailTranslation,
+		c.WorkExperienceTranslation,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -520,10 +578,16 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *ApiKeyMutation:
+		return c.ApiKey.mutate(ctx, m)
+	case *AuthEventMutation:
+		return c.AuthEvent.mutate(ctx, m)
 	case *AwardMutation:
 		return c.Award.mutate(ctx, m)
 	case *AwardTranslationMutation:
 		return c.AwardTranslation.mutate(ctx, m)
+	case *BanMutation:
+		return c.Ban.mutate(ctx, m)
 	case *BlogCategoryMutation:
 		return c.BlogCategory.mutate(ctx, m)
 	case *BlogCategoryTranslationMutation:
@@ -544,6 +608,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Comment.mutate(ctx, m)
 	case *CommentLikeMutation:
 		return c.CommentLike.mutate(ctx, m)
+	case *CommentMentionMutation:
+		return c.CommentMention.mutate(ctx, m)
 	case *EducationMutation:
 		return c.Education.mutate(ctx, m)
 	case *EducationDetailMutation:
@@ -562,6 +628,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.IdeaTag.mutate(ctx, m)
 	case *IdeaTranslationMutation:
 		return c.IdeaTranslation.mutate(ctx, m)
+	case *IdentityLinkMutation:
+		return c.IdentityLink.mutate(ctx, m)
 	case *LanguageMutation:
 		return c.Language.mutate(ctx, m)
 	case *PersonalInfoMutation:
@@ -594,6 +662,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.PublicationAuthor.mutate(ctx, m)
 	case *PublicationTranslationMutation:
 		return c.PublicationTranslation.mutate(ctx, m)
+	case *ReactionMutation:
+		return c.Reaction.mutate(ctx, m)
 	case *RecentUpdateMutation:
 		return c.RecentUpdate.mutate(ctx, m)
 	case *RecentUpdateTranslationMutation:
@@ -606,8 +676,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ResearchProjectDetailTranslation.mutate(ctx, m)
 	case *ResearchProjectTranslationMutation:
 		return c.ResearchProjectTranslation.mutate(ctx, m)
+	case *SessionMutation:
+		return c.Session.mutate(ctx, m)
+	case *SignatureNonceMutation:
+		return c.SignatureNonce.mutate(ctx, m)
 	case *SocialLinkMutation:
 		return c.SocialLink.mutate(ctx, m)
+	case *SpamScoreMutation:
+		return c.SpamScore.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserIdentityMutation:
@@ -625,6 +701,272 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// ApiKeyClient is a client for the ApiKey schema.
+type ApiKeyClient struct {
+	config
+}
+
+// NewApiKeyClient returns a client for the ApiKey from the given config.
+func NewApiKeyClient(c config) *ApiKeyClient {
+	return &ApiKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `apikey.Hooks(f(g(h())))`.
+func (c *ApiKeyClient) Use(hooks ...Hook) {
+	c.hooks.ApiKey = append(c.hooks.ApiKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `apikey.Intercept(f(g(h())))`.
+func (c *ApiKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ApiKey = append(c.inters.ApiKey, interceptors...)
+}
+
+// Create returns a builder for creating a ApiKey entity.
+func (c *ApiKeyClient) Create() *ApiKeyCreate {
+	mutation := newApiKeyMutation(c.config, OpCreate)
+	return &ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ApiKey entities.
+func (c *ApiKeyClient) CreateBulk(builders ...*ApiKeyCreate) *ApiKeyCreateBulk {
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ApiKeyClient) MapCreateBulk(slice any, setFunc func(*ApiKeyCreate, int)) *ApiKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ApiKeyCreateBulk{err: fmt.Errorf("calling to ApiKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ApiKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ApiKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ApiKey.
+func (c *ApiKeyClient) Update() *ApiKeyUpdate {
+	mutation := newApiKeyMutation(c.config, OpUpdate)
+	return &ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ApiKeyClient) UpdateOne(ak *ApiKey) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKey(ak))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ApiKeyClient) UpdateOneID(id string) *ApiKeyUpdateOne {
+	mutation := newApiKeyMutation(c.config, OpUpdateOne, withApiKeyID(id))
+	return &ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ApiKey.
+func (c *ApiKeyClient) Delete() *ApiKeyDelete {
+	mutation := newApiKeyMutation(c.config, OpDelete)
+	return &ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ApiKeyClient) DeleteOne(ak *ApiKey) *ApiKeyDeleteOne {
+	return c.DeleteOneID(ak.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ApiKeyClient) DeleteOneID(id string) *ApiKeyDeleteOne {
+	builder := c.Delete().Where(apikey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ApiKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for ApiKey.
+func (c *ApiKeyClient) Query() *ApiKeyQuery {
+	return &ApiKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeApiKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ApiKey entity by its id.
+func (c *ApiKeyClient) Get(ctx context.Context, id string) (*ApiKey, error) {
+	return c.Query().Where(apikey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ApiKeyClient) GetX(ctx context.Context, id string) *ApiKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ApiKeyClient) Hooks() []Hook {
+	return c.hooks.ApiKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *ApiKeyClient) Interceptors() []Interceptor {
+	return c.inters.ApiKey
+}
+
+func (c *ApiKeyClient) mutate(ctx context.Context, m *ApiKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ApiKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ApiKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ApiKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ApiKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ApiKey mutation op: %q", m.Op())
+	}
+}
+
+// AuthEventClient is a client for the AuthEvent schema.
+type AuthEventClient struct {
+	config
+}
+
+// NewAuthEventClient returns a client for the AuthEvent from the given config.
+func NewAuthEventClient(c config) *AuthEventClient {
+	return &AuthEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `authevent.Hooks(f(g(h())))`.
+func (c *AuthEventClient) Use(hooks ...Hook) {
+	c.hooks.AuthEvent = append(c.hooks.AuthEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `authevent.Intercept(f(g(h())))`.
+func (c *AuthEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuthEvent = append(c.inters.AuthEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuthEvent entity.
+func (c *AuthEventClient) Create() *AuthEventCreate {
+	mutation := newAuthEventMutation(c.config, OpCreate)
+	return &AuthEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuthEvent entities.
+func (c *AuthEventClient) CreateBulk(builders ...*AuthEventCreate) *AuthEventCreateBulk {
+	return &AuthEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuthEventClient) MapCreateBulk(slice any, setFunc func(*AuthEventCreate, int)) *AuthEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuthEventCreateBulk{err: fmt.Errorf("calling to AuthEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuthEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuthEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuthEvent.
+func (c *AuthEventClient) Update() *AuthEventUpdate {
+	mutation := newAuthEventMutation(c.config, OpUpdate)
+	return &AuthEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuthEventClient) UpdateOne(ae *AuthEvent) *AuthEventUpdateOne {
+	mutation := newAuthEventMutation(c.config, OpUpdateOne, withAuthEvent(ae))
+	return &AuthEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuthEventClient) UpdateOneID(id string) *AuthEventUpdateOne {
+	mutation := newAuthEventMutation(c.config, OpUpdateOne, withAuthEventID(id))
+	return &AuthEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuthEvent.
+func (c *AuthEventClient) Delete() *AuthEventDelete {
+	mutation := newAuthEventMutation(c.config, OpDelete)
+	return &AuthEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuthEventClient) DeleteOne(ae *AuthEvent) *AuthEventDeleteOne {
+	return c.DeleteOneID(ae.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuthEventClient) DeleteOneID(id string) *AuthEventDeleteOne {
+	builder := c.Delete().Where(authevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuthEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuthEvent.
+func (c *AuthEventClient) Query() *AuthEventQuery {
+	return &AuthEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuthEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuthEvent entity by its id.
+func (c *AuthEventClient) Get(ctx context.Context, id string) (*AuthEvent, error) {
+	return c.Query().Where(authevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuthEventClient) GetX(ctx context.Context, id string) *AuthEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuthEventClient) Hooks() []Hook {
+	return c.hooks.AuthEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuthEventClient) Interceptors() []Interceptor {
+	return c.inters.AuthEvent
+}
+
+func (c *AuthEventClient) mutate(ctx context.Context, m *AuthEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuthEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuthEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuthEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuthEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuthEvent mutation op: %q", m.Op())
+	}
+}
+
 // AwardClient is a client for the Award schema.
 type AwardClient struct {
 	config
@@ -955,6 +1297,139 @@ func (c *AwardTranslationClient) mutate(ctx context.Context, m *AwardTranslation
 	}
 }
 
+// BanClient is a client for the Ban schema.
+type BanClient struct {
+	config
+}
+
+// NewBanClient returns a client for the Ban from the given config.
+func NewBanClient(c config) *BanClient {
+	return &BanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ban.Hooks(f(g(h())))`.
+func (c *BanClient) Use(hooks ...Hook) {
+	c.hooks.Ban = append(c.hooks.Ban, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ban.Intercept(f(g(h())))`.
+func (c *BanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Ban = append(c.inters.Ban, interceptors...)
+}
+
+// Create returns a builder for creating a Ban entity.
+func (c *BanClient) Create() *BanCreate {
+	mutation := newBanMutation(c.config, OpCreate)
+	return &BanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Ban entities.
+func (c *BanClient) CreateBulk(builders ...*BanCreate) *BanCreateBulk {
+	return &BanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BanClient) MapCreateBulk(slice any, setFunc func(*BanCreate, int)) *BanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BanCreateBulk{err: fmt.Errorf("calling to BanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BanCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Ban.
+func (c *BanClient) Update() *BanUpdate {
+	mutation := newBanMutation(c.config, OpUpdate)
+	return &BanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BanClient) UpdateOne(b *Ban) *BanUpdateOne {
+	mutation := newBanMutation(c.config, OpUpdateOne, withBan(b))
+	return &BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BanClient) UpdateOneID(id string) *BanUpdateOne {
+	mutation := newBanMutation(c.config, OpUpdateOne, withBanID(id))
+	return &BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Ban.
+func (c *BanClient) Delete() *BanDelete {
+	mutation := newBanMutation(c.config, OpDelete)
+	return &BanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BanClient) DeleteOne(b *Ban) *BanDeleteOne {
+	return c.DeleteOneID(b.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BanClient) DeleteOneID(id string) *BanDeleteOne {
+	builder := c.Delete().Where(ban.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BanDeleteOne{builder}
+}
+
+// Query returns a query builder for Ban.
+func (c *BanClient) Query() *BanQuery {
+	return &BanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBan},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Ban entity by its id.
+func (c *BanClient) Get(ctx context.Context, id string) (*Ban, error) {
+	return c.Query().Where(ban.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BanClient) GetX(ctx context.Context, id string) *Ban {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BanClient) Hooks() []Hook {
+	return c.hooks.Ban
+}
+
+// Interceptors returns the client interceptors.
+func (c *BanClient) Interceptors() []Interceptor {
+	return c.inters.Ban
+}
+
+func (c *BanClient) mutate(ctx context.Context, m *BanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Ban mutation op: %q", m.Op())
+	}
+}
+
 // BlogCategoryClient is a client for the BlogCategory schema.
 type BlogCategoryClient struct {
 	config
@@ -2652,74 +3127,207 @@ func (c *CommentLikeClient) mutate(ctx context.Context, m *CommentLikeMutation)
 	}
 }
 
-// EducationClient is a client for the Education schema.
-type EducationClient struct {
+// CommentMentionClient is a client for the CommentMention schema.
+type CommentMentionClient struct {
 	config
 }
 
-// NewEducationClient returns a client for the Education from the given config.
-func NewEducationClient(c config) *EducationClient {
-	return &EducationClient{config: c}
+// NewCommentMentionClient returns a client for the CommentMention from the given config.
+func NewCommentMentionClient(c config) *CommentMentionClient {
+	return &CommentMentionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `education.Hooks(f(g(h())))`.
-func (c *EducationClient) Use(hooks ...Hook) {
-	c.hooks.Education = append(c.hooks.Education, hooks...)
+// A call to `Use(f, g, h)` equals to `commentmention.Hooks(f(g(h())))`.
+func (c *CommentMentionClient) Use(hooks ...Hook) {
+	c.hooks.CommentMention = append(c.hooks.CommentMention, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `education.Intercept(f(g(h())))`.
-func (c *EducationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Education = append(c.inters.Education, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `commentmention.Intercept(f(g(h())))`.
+func (c *CommentMentionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CommentMention = append(c.inters.CommentMention, interceptors...)
 }
 
-// Create returns a builder for creating a Education entity.
-func (c *EducationClient) Create() *EducationCreate {
-	mutation := newEducationMutation(c.config, OpCreate)
-	return &EducationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a CommentMention entity.
+func (c *CommentMentionClient) Create() *CommentMentionCreate {
+	mutation := newCommentMentionMutation(c.config, OpCreate)
+	return &CommentMentionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Education entities.
-func (c *EducationClient) CreateBulk(builders ...*EducationCreate) *EducationCreateBulk {
-	return &EducationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of CommentMention entities.
+func (c *CommentMentionClient) CreateBulk(builders ...*CommentMentionCreate) *CommentMentionCreateBulk {
+	return &CommentMentionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EducationClient) MapCreateBulk(slice any, setFunc func(*EducationCreate, int)) *EducationCreateBulk {
+func (c *CommentMentionClient) MapCreateBulk(slice any, setFunc func(*CommentMentionCreate, int)) *CommentMentionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EducationCreateBulk{err: fmt.Errorf("calling to EducationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &CommentMentionCreateBulk{err: fmt.Errorf("calling to CommentMentionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EducationCreate, rv.Len())
+	builders := make([]*CommentMentionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EducationCreateBulk{config: c.config, builders: builders}
+	return &CommentMentionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Education.
-func (c *EducationClient) Update() *EducationUpdate {
-	mutation := newEducationMutation(c.config, OpUpdate)
-	return &EducationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for CommentMention.
+func (c *CommentMentionClient) Update() *CommentMentionUpdate {
+	mutation := newCommentMentionMutation(c.config, OpUpdate)
+	return &CommentMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EducationClient) UpdateOne(e *Education) *EducationUpdateOne {
-	mutation := newEducationMutation(c.config, OpUpdateOne, withEducation(e))
-	return &EducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentMentionClient) UpdateOne(cm *CommentMention) *CommentMentionUpdateOne {
+	mutation := newCommentMentionMutation(c.config, OpUpdateOne, withCommentMention(cm))
+	return &CommentMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EducationClient) UpdateOneID(id uuid.UUID) *EducationUpdateOne {
-	mutation := newEducationMutation(c.config, OpUpdateOne, withEducationID(id))
-	return &EducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *CommentMentionClient) UpdateOneID(id string) *CommentMentionUpdateOne {
+	mutation := newCommentMentionMutation(c.config, OpUpdateOne, withCommentMentionID(id))
+	return &CommentMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Education.
-func (c *EducationClient) Delete() *EducationDelete {
+// Delete returns a delete builder for CommentMention.
+func (c *CommentMentionClient) Delete() *CommentMentionDelete {
+	mutation := newCommentMentionMutation(c.config, OpDelete)
+	return &CommentMentionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CommentMentionClient) DeleteOne(cm *CommentMention) *CommentMentionDeleteOne {
+	return c.DeleteOneID(cm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CommentMentionClient) DeleteOneID(id string) *CommentMentionDeleteOne {
+	builder := c.Delete().Where(commentmention.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CommentMentionDeleteOne{builder}
+}
+
+// Query returns a query builder for CommentMention.
+func (c *CommentMentionClient) Query() *CommentMentionQuery {
+	return &CommentMentionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCommentMention},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CommentMention entity by its id.
+func (c *CommentMentionClient) Get(ctx context.Context, id string) (*CommentMention, error) {
+	return c.Query().Where(commentmention.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CommentMentionClient) GetX(ctx context.Context, id string) *CommentMention {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CommentMentionClient) Hooks() []Hook {
+	return c.hooks.CommentMention
+}
+
+// Interceptors returns the client interceptors.
+func (c *CommentMentionClient) Interceptors() []Interceptor {
+	return c.inters.CommentMention
+}
+
+func (c *CommentMentionClient) mutate(ctx context.Context, m *CommentMentionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CommentMentionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CommentMentionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CommentMentionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CommentMentionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown CommentMention mutation op: %q", m.Op())
+	}
+}
+
+// EducationClient is a client for the Education schema.
+type EducationClient struct {
+	config
+}
+
+// NewEducationClient returns a client for the Education from the given config.
+func NewEducationClient(c config) *EducationClient {
+	return &EducationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `education.Hooks(f(g(h())))`.
+func (c *EducationClient) Use(hooks ...Hook) {
+	c.hooks.Education = append(c.hooks.Education, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `education.Intercept(f(g(h())))`.
+func (c *EducationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Education = append(c.inters.Education, interceptors...)
+}
+
+// Create returns a builder for creating a Education entity.
+func (c *EducationClient) Create() *EducationCreate {
+	mutation := newEducationMutation(c.config, OpCreate)
+	return &EducationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Education entities.
+func (c *EducationClient) CreateBulk(builders ...*EducationCreate) *EducationCreateBulk {
+	return &EducationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EducationClient) MapCreateBulk(slice any, setFunc func(*EducationCreate, int)) *EducationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EducationCreateBulk{err: fmt.Errorf("calling to EducationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EducationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EducationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Education.
+func (c *EducationClient) Update() *EducationUpdate {
+	mutation := newEducationMutation(c.config, OpUpdate)
+	return &EducationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EducationClient) UpdateOne(e *Education) *EducationUpdateOne {
+	mutation := newEducationMutation(c.config, OpUpdateOne, withEducation(e))
+	return &EducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EducationClient) UpdateOneID(id uuid.UUID) *EducationUpdateOne {
+	mutation := newEducationMutation(c.config, OpUpdateOne, withEducationID(id))
+	return &EducationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Education.
+func (c *EducationClient) Delete() *EducationDelete {
 	mutation := newEducationMutation(c.config, OpDelete)
 	return &EducationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
@@ -4201,6 +4809,122 @@ func (c *IdeaTranslationClient) mutate(ctx context.Context, m *IdeaTranslationMu
 	}
 }
 
+// IdentityLinkClient is a client for the IdentityLink schema.
+type IdentityLinkClient struct {
+	config
+}
+
+// NewIdentityLinkClient returns a client for the IdentityLink from the given config.
+func NewIdentityLinkClient(c config) *IdentityLinkClient {
+	return &IdentityLinkClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `identitylink.Hooks(f(g(h())))`.
+func (c *IdentityLinkClient) Use(hooks ...Hook) {
+	c.hooks.IdentityLink = append(c.hooks.IdentityLink, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `identitylink.Intercept(f(g(h())))`.
+func (c *IdentityLinkClient) Intercept(interceptors ...Interceptor) {
+	c.inters.IdentityLink = append(c.inters.IdentityLink, interceptors...)
+}
+
+// Create returns a builder for creating a IdentityLink entity.
+func (c *IdentityLinkClient) Create() *IdentityLinkCreate {
+	mutation := newIdentityLinkMutation(c.config, OpCreate)
+	return &IdentityLinkCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of IdentityLink entities.
+func (c *IdentityLinkClient) CreateBulk(builders ...*IdentityLinkCreate) *IdentityLinkCreateBulk {
+	return &IdentityLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *IdentityLinkClient) MapCreateBulk(slice any, setFunc func(*IdentityLinkCreate, int)) *IdentityLinkCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &IdentityLinkCreateBulk{err: fmt.Errorf("calling to IdentityLinkClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*IdentityLinkCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &IdentityLinkCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for IdentityLink.
+func (c *IdentityLinkClient) Update() *IdentityLinkUpdate {
+	mutation := newIdentityLinkMutation(c.config, OpUpdate)
+	return &IdentityLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *IdentityLinkClient) UpdateOne(il *IdentityLink) *IdentityLinkUpdateOne {
+	mutation := newIdentityLinkMutation(c.config, OpUpdateOne)
+	mutation.identity = &il.IdentityID
+	mutation.primary = &il.PrimaryID
+	return &IdentityLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for IdentityLink.
+func (c *IdentityLinkClient) Delete() *IdentityLinkDelete {
+	mutation := newIdentityLinkMutation(c.config, OpDelete)
+	return &IdentityLinkDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Query returns a query builder for IdentityLink.
+func (c *IdentityLinkClient) Query() *IdentityLinkQuery {
+	return &IdentityLinkQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeIdentityLink},
+		inters: c.Interceptors(),
+	}
+}
+
+// QueryIdentity queries the identity edge of a IdentityLink.
+func (c *IdentityLinkClient) QueryIdentity(il *IdentityLink) *UserIdentityQuery {
+	return c.Query().
+		Where(identitylink.IdentityID(il.IdentityID), identitylink.PrimaryID(il.PrimaryID)).
+		QueryIdentity()
+}
+
+// QueryPrimary queries the primary edge of a IdentityLink.
+func (c *IdentityLinkClient) QueryPrimary(il *IdentityLink) *UserIdentityQuery {
+	return c.Query().
+		Where(identitylink.IdentityID(il.IdentityID), identitylink.PrimaryID(il.PrimaryID)).
+		QueryPrimary()
+}
+
+// Hooks returns the client hooks.
+func (c *IdentityLinkClient) Hooks() []Hook {
+	return c.hooks.IdentityLink
+}
+
+// Interceptors returns the client interceptors.
+func (c *IdentityLinkClient) Interceptors() []Interceptor {
+	return c.inters.IdentityLink
+}
+
+func (c *IdentityLinkClient) mutate(ctx context.Context, m *IdentityLinkMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&IdentityLinkCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&IdentityLinkUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&IdentityLinkUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&IdentityLinkDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown IdentityLink mutation op: %q", m.Op())
+	}
+}
+
 // LanguageClient is a client for the Language schema.
 type LanguageClient struct {
 	config
@@ -7209,6 +7933,139 @@ func (c *PublicationTranslationClient) mutate(ctx context.Context, m *Publicatio
 	}
 }
 
+// ReactionClient is a client for the Reaction schema.
+type ReactionClient struct {
+	config
+}
+
+// NewReactionClient returns a client for the Reaction from the given config.
+func NewReactionClient(c config) *ReactionClient {
+	return &ReactionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `reaction.Hooks(f(g(h())))`.
+func (c *ReactionClient) Use(hooks ...Hook) {
+	c.hooks.Reaction = append(c.hooks.Reaction, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `reaction.Intercept(f(g(h())))`.
+func (c *ReactionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Reaction = append(c.inters.Reaction, interceptors...)
+}
+
+// Create returns a builder for creating a Reaction entity.
+func (c *ReactionClient) Create() *ReactionCreate {
+	mutation := newReactionMutation(c.config, OpCreate)
+	return &ReactionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Reaction entities.
+func (c *ReactionClient) CreateBulk(builders ...*ReactionCreate) *ReactionCreateBulk {
+	return &ReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ReactionClient) MapCreateBulk(slice any, setFunc func(*ReactionCreate, int)) *ReactionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ReactionCreateBulk{err: fmt.Errorf("calling to ReactionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ReactionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ReactionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Reaction.
+func (c *ReactionClient) Update() *ReactionUpdate {
+	mutation := newReactionMutation(c.config, OpUpdate)
+	return &ReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ReactionClient) UpdateOne(r *Reaction) *ReactionUpdateOne {
+	mutation := newReactionMutation(c.config, OpUpdateOne, withReaction(r))
+	return &ReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ReactionClient) UpdateOneID(id string) *ReactionUpdateOne {
+	mutation := newReactionMutation(c.config, OpUpdateOne, withReactionID(id))
+	return &ReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Reaction.
+func (c *ReactionClient) Delete() *ReactionDelete {
+	mutation := newReactionMutation(c.config, OpDelete)
+	return &ReactionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ReactionClient) DeleteOne(r *Reaction) *ReactionDeleteOne {
+	return c.DeleteOneID(r.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ReactionClient) DeleteOneID(id string) *ReactionDeleteOne {
+	builder := c.Delete().Where(reaction.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ReactionDeleteOne{builder}
+}
+
+// Query returns a query builder for Reaction.
+func (c *ReactionClient) Query() *ReactionQuery {
+	return &ReactionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeReaction},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Reaction entity by its id.
+func (c *ReactionClient) Get(ctx context.Context, id string) (*Reaction, error) {
+	return c.Query().Where(reaction.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ReactionClient) GetX(ctx context.Context, id string) *Reaction {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ReactionClient) Hooks() []Hook {
+	return c.hooks.Reaction
+}
+
+// Interceptors returns the client interceptors.
+func (c *ReactionClient) Interceptors() []Interceptor {
+	return c.inters.Reaction
+}
+
+func (c *ReactionClient) mutate(ctx context.Context, m *ReactionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ReactionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ReactionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ReactionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ReactionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Reaction mutation op: %q", m.Op())
+	}
+}
+
 // RecentUpdateClient is a client for the RecentUpdate schema.
 type RecentUpdateClient struct {
 	config
@@ -8215,6 +9072,272 @@ func (c *ResearchProjectTranslationClient) mutate(ctx context.Context, m *Resear
 	}
 }
 
+// SessionClient is a client for the Session schema.
+type SessionClient struct {
+	config
+}
+
+// NewSessionClient returns a client for the Session from the given config.
+func NewSessionClient(c config) *SessionClient {
+	return &SessionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `session.Hooks(f(g(h())))`.
+func (c *SessionClient) Use(hooks ...Hook) {
+	c.hooks.Session = append(c.hooks.Session, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `session.Intercept(f(g(h())))`.
+func (c *SessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Session = append(c.inters.Session, interceptors...)
+}
+
+// Create returns a builder for creating a Session entity.
+func (c *SessionClient) Create() *SessionCreate {
+	mutation := newSessionMutation(c.config, OpCreate)
+	return &SessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Session entities.
+func (c *SessionClient) CreateBulk(builders ...*SessionCreate) *SessionCreateBulk {
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SessionClient) MapCreateBulk(slice any, setFunc func(*SessionCreate, int)) *SessionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SessionCreateBulk{err: fmt.Errorf("calling to SessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SessionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SessionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Session.
+func (c *SessionClient) Update() *SessionUpdate {
+	mutation := newSessionMutation(c.config, OpUpdate)
+	return &SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SessionClient) UpdateOne(s *Session) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSession(s))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SessionClient) UpdateOneID(id string) *SessionUpdateOne {
+	mutation := newSessionMutation(c.config, OpUpdateOne, withSessionID(id))
+	return &SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Session.
+func (c *SessionClient) Delete() *SessionDelete {
+	mutation := newSessionMutation(c.config, OpDelete)
+	return &SessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SessionClient) DeleteOne(s *Session) *SessionDeleteOne {
+	return c.DeleteOneID(s.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SessionClient) DeleteOneID(id string) *SessionDeleteOne {
+	builder := c.Delete().Where(session.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SessionDeleteOne{builder}
+}
+
+// Query returns a query builder for Session.
+func (c *SessionClient) Query() *SessionQuery {
+	return &SessionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSession},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Session entity by its id.
+func (c *SessionClient) Get(ctx context.Context, id string) (*Session, error) {
+	return c.Query().Where(session.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SessionClient) GetX(ctx context.Context, id string) *Session {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SessionClient) Hooks() []Hook {
+	return c.hooks.Session
+}
+
+// Interceptors returns the client interceptors.
+func (c *SessionClient) Interceptors() []Interceptor {
+	return c.inters.Session
+}
+
+func (c *SessionClient) mutate(ctx context.Context, m *SessionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Session mutation op: %q", m.Op())
+	}
+}
+
+// SignatureNonceClient is a client for the SignatureNonce schema.
+type SignatureNonceClient struct {
+	config
+}
+
+// NewSignatureNonceClient returns a client for the SignatureNonce from the given config.
+func NewSignatureNonceClient(c config) *SignatureNonceClient {
+	return &SignatureNonceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signaturenonce.Hooks(f(g(h())))`.
+func (c *SignatureNonceClient) Use(hooks ...Hook) {
+	c.hooks.SignatureNonce = append(c.hooks.SignatureNonce, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signaturenonce.Intercept(f(g(h())))`.
+func (c *SignatureNonceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SignatureNonce = append(c.inters.SignatureNonce, interceptors...)
+}
+
+// Create returns a builder for creating a SignatureNonce entity.
+func (c *SignatureNonceClient) Create() *SignatureNonceCreate {
+	mutation := newSignatureNonceMutation(c.config, OpCreate)
+	return &SignatureNonceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SignatureNonce entities.
+func (c *SignatureNonceClient) CreateBulk(builders ...*SignatureNonceCreate) *SignatureNonceCreateBulk {
+	return &SignatureNonceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SignatureNonceClient) MapCreateBulk(slice any, setFunc func(*SignatureNonceCreate, int)) *SignatureNonceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SignatureNonceCreateBulk{err: fmt.Errorf("calling to SignatureNonceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SignatureNonceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SignatureNonceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SignatureNonce.
+func (c *SignatureNonceClient) Update() *SignatureNonceUpdate {
+	mutation := newSignatureNonceMutation(c.config, OpUpdate)
+	return &SignatureNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SignatureNonceClient) UpdateOne(sn *SignatureNonce) *SignatureNonceUpdateOne {
+	mutation := newSignatureNonceMutation(c.config, OpUpdateOne, withSignatureNonce(sn))
+	return &SignatureNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SignatureNonceClient) UpdateOneID(id string) *SignatureNonceUpdateOne {
+	mutation := newSignatureNonceMutation(c.config, OpUpdateOne, withSignatureNonceID(id))
+	return &SignatureNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SignatureNonce.
+func (c *SignatureNonceClient) Delete() *SignatureNonceDelete {
+	mutation := newSignatureNonceMutation(c.config, OpDelete)
+	return &SignatureNonceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SignatureNonceClient) DeleteOne(sn *SignatureNonce) *SignatureNonceDeleteOne {
+	return c.DeleteOneID(sn.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SignatureNonceClient) DeleteOneID(id string) *SignatureNonceDeleteOne {
+	builder := c.Delete().Where(signaturenonce.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SignatureNonceDeleteOne{builder}
+}
+
+// Query returns a query builder for SignatureNonce.
+func (c *SignatureNonceClient) Query() *SignatureNonceQuery {
+	return &SignatureNonceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSignatureNonce},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SignatureNonce entity by its id.
+func (c *SignatureNonceClient) Get(ctx context.Context, id string) (*SignatureNonce, error) {
+	return c.Query().Where(signaturenonce.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SignatureNonceClient) GetX(ctx context.Context, id string) *SignatureNonce {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SignatureNonceClient) Hooks() []Hook {
+	return c.hooks.SignatureNonce
+}
+
+// Interceptors returns the client interceptors.
+func (c *SignatureNonceClient) Interceptors() []Interceptor {
+	return c.inters.SignatureNonce
+}
+
+func (c *SignatureNonceClient) mutate(ctx context.Context, m *SignatureNonceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SignatureNonceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SignatureNonceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SignatureNonceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SignatureNonceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SignatureNonce mutation op: %q", m.Op())
+	}
+}
+
 // SocialLinkClient is a client for the SocialLink schema.
 type SocialLinkClient struct {
 	config
@@ -8364,6 +9487,139 @@ func (c *SocialLinkClient) mutate(ctx context.Context, m *SocialLinkMutation) (V
 	}
 }
 
+// SpamScoreClient is a client for the SpamScore schema.
+type SpamScoreClient struct {
+	config
+}
+
+// NewSpamScoreClient returns a client for the SpamScore from the given config.
+func NewSpamScoreClient(c config) *SpamScoreClient {
+	return &SpamScoreClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `spamscore.Hooks(f(g(h())))`.
+func (c *SpamScoreClient) Use(hooks ...Hook) {
+	c.hooks.SpamScore = append(c.hooks.SpamScore, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `spamscore.Intercept(f(g(h())))`.
+func (c *SpamScoreClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SpamScore = append(c.inters.SpamScore, interceptors...)
+}
+
+// Create returns a builder for creating a SpamScore entity.
+func (c *SpamScoreClient) Create() *SpamScoreCreate {
+	mutation := newSpamScoreMutation(c.config, OpCreate)
+	return &SpamScoreCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SpamScore entities.
+func (c *SpamScoreClient) CreateBulk(builders ...*SpamScoreCreate) *SpamScoreCreateBulk {
+	return &SpamScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SpamScoreClient) MapCreateBulk(slice any, setFunc func(*SpamScoreCreate, int)) *SpamScoreCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SpamScoreCreateBulk{err: fmt.Errorf("calling to SpamScoreClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SpamScoreCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SpamScoreCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SpamScore.
+func (c *SpamScoreClient) Update() *SpamScoreUpdate {
+	mutation := newSpamScoreMutation(c.config, OpUpdate)
+	return &SpamScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SpamScoreClient) UpdateOne(ss *SpamScore) *SpamScoreUpdateOne {
+	mutation := newSpamScoreMutation(c.config, OpUpdateOne, withSpamScore(ss))
+	return &SpamScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SpamScoreClient) UpdateOneID(id string) *SpamScoreUpdateOne {
+	mutation := newSpamScoreMutation(c.config, OpUpdateOne, withSpamScoreID(id))
+	return &SpamScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SpamScore.
+func (c *SpamScoreClient) Delete() *SpamScoreDelete {
+	mutation := newSpamScoreMutation(c.config, OpDelete)
+	return &SpamScoreDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SpamScoreClient) DeleteOne(ss *SpamScore) *SpamScoreDeleteOne {
+	return c.DeleteOneID(ss.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SpamScoreClient) DeleteOneID(id string) *SpamScoreDeleteOne {
+	builder := c.Delete().Where(spamscore.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SpamScoreDeleteOne{builder}
+}
+
+// Query returns a query builder for SpamScore.
+func (c *SpamScoreClient) Query() *SpamScoreQuery {
+	return &SpamScoreQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSpamScore},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SpamScore entity by its id.
+func (c *SpamScoreClient) Get(ctx context.Context, id string) (*SpamScore, error) {
+	return c.Query().Where(spamscore.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SpamScoreClient) GetX(ctx context.Context, id string) *SpamScore {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SpamScoreClient) Hooks() []Hook {
+	return c.hooks.SpamScore
+}
+
+// Interceptors returns the client interceptors.
+func (c *SpamScoreClient) Interceptors() []Interceptor {
+	return c.inters.SpamScore
+}
+
+func (c *SpamScoreClient) mutate(ctx context.Context, m *SpamScoreMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SpamScoreCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SpamScoreUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SpamScoreUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SpamScoreDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SpamScore mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -8765,6 +10021,54 @@ func (c *UserIdentityClient) GetX(ctx context.Context, id string) *UserIdentity
 	return obj
 }
 
+// QueryPrimaries queries the primaries edge of a UserIdentity.
+func (c *UserIdentityClient) QueryPrimaries(ui *UserIdentity) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ui.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, useridentity.PrimariesTable, useridentity.PrimariesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ui.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinkedIdentities queries the linked_identities edge of a UserIdentity.
+func (c *UserIdentityClient) QueryLinkedIdentities(ui *UserIdentity) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ui.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, useridentity.LinkedIdentitiesTable, useridentity.LinkedIdentitiesPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(ui.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLinks queries the links edge of a UserIdentity.
+func (c *UserIdentityClient) QueryLinks(ui *UserIdentity) *IdentityLinkQuery {
+	query := (&IdentityLinkClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ui.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, id),
+			sqlgraph.To(identitylink.Table, identitylink.IdentityColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, useridentity.LinksTable, useridentity.LinksColumn),
+		)
+		fromV = sqlgraph.Neighbors(ui.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserIdentityClient) Hooks() []Hook {
 	return c.hooks.UserIdentity
@@ -9469,31 +10773,35 @@ func (c *WorkExperienceTranslationClient) mutate(ctx context.Context, m *WorkExp
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Award, AwardTranslation, BlogCategory, BlogCategoryTranslation, BlogPost,
-		BlogPostTag, BlogPostTranslation, BlogSeries, BlogSeriesTranslation, BlogTag,
-		Comment, CommentLike, Education, EducationDetail, EducationDetailTranslation,
+		ApiKey, AuthEvent, Award, AwardTranslation, Ban, BlogCategory,
+		BlogCategoryTranslation, BlogPost, BlogPostTag, BlogPostTranslation,
+		BlogSeries, BlogSeriesTranslation, BlogTag, Comment, CommentLike,
+		CommentMention, Education, EducationDetail, EducationDetailTranslation,
 		EducationTranslation, Idea, IdeaDetail, IdeaDetailTranslation, IdeaTag,
-		IdeaTranslation, Language, PersonalInfo, PersonalInfoTranslation, Project,
-		ProjectDetail, ProjectDetailTranslation, ProjectImage, ProjectImageTranslation,
-		ProjectLike, ProjectRelationship, ProjectTechnology, ProjectTranslation,
-		ProjectView, Publication, PublicationAuthor, PublicationTranslation,
-		RecentUpdate, RecentUpdateTranslation, ResearchProject, ResearchProjectDetail,
-		ResearchProjectDetailTranslation, ResearchProjectTranslation, SocialLink, User,
-		UserIdentity, WorkExperience, WorkExperienceDetail,
+		IdeaTranslation, IdentityLink, Language, PersonalInfo, PersonalInfoTranslation,
+		Project, ProjectDetail, ProjectDetailTranslation, ProjectImage,
+		ProjectImageTranslation, ProjectLike, ProjectRelationship, ProjectTechnology,
+		ProjectTranslation, ProjectView, Publication, PublicationAuthor,
+		PublicationTranslation, Reaction, RecentUpdate, RecentUpdateTranslation,
+		ResearchProject, ResearchProjectDetail, ResearchProjectDetailTranslation,
+		ResearchProjectTranslation, Session, SignatureNonce, SocialLink, SpamScore,
+		User, UserIdentity, WorkExperience, WorkExperienceDetail,
 		WorkExperienceDetailTranslation, WorkExperienceTranslation []ent.Hook
 	}
 	inters struct {
-		Award, AwardTranslation, BlogCategory, BlogCategoryTranslation, BlogPost,
-		BlogPostTag, BlogPostTranslation, BlogSeries, BlogSeriesTranslation, BlogTag,
-		Comment, CommentLike, Education, EducationDetail, EducationDetailTranslation,
+		ApiKey, AuthEvent, Award, AwardTranslation, Ban, BlogCategory,
+		BlogCategoryTranslation, BlogPost, BlogPostTag, BlogPostTranslation,
+		BlogSeries, BlogSeriesTranslation, BlogTag, Comment, CommentLike,
+		CommentMention, Education, EducationDetail, EducationDetailTranslation,
 		EducationTranslation, Idea, IdeaDetail, IdeaDetailTranslation, IdeaTag,
-		IdeaTranslation, Language, PersonalInfo, PersonalInfoTranslation, Project,
-		ProjectDetail, ProjectDetailTranslation, ProjectImage, ProjectImageTranslation,
-		ProjectLike, ProjectRelationship, ProjectTechnology, ProjectTranslation,
-		ProjectView, Publication, PublicationAuthor, PublicationTranslation,
-		RecentUpdate, RecentUpdateTranslation, ResearchProject, ResearchProjectDetail,
-		ResearchProjectDetailTranslation, ResearchProjectTranslation, SocialLink, User,
-		UserIdentity, WorkExperience, WorkExperienceDetail,
+		IdeaTranslation, IdentityLink, Language, PersonalInfo, PersonalInfoTranslation,
+		Project, ProjectDetail, ProjectDetailTranslation, ProjectImage,
+		ProjectImageTranslation, ProjectLike, ProjectRelationship, ProjectTechnology,
+		ProjectTranslation, ProjectView, Publication, PublicationAuthor,
+		PublicationTranslation, Reaction, RecentUpdate, RecentUpdateTranslation,
+		ResearchProject, ResearchProjectDetail, ResearchProjectDetailTranslation,
+		ResearchProjectTranslation, Session, SignatureNonce, SocialLink, SpamScore,
+		User, UserIdentity, WorkExperience, WorkExperienceDetail,
 		WorkExperienceDetailTranslation, WorkExperienceTranslation []ent.Interceptor
 	}
 )
@@ -27,7 +27,7 @@ type Comment struct {
 	ParentID uuid.UUID `json:"parent_id,omitempty"`
 	// AuthorName holds the value of the "author_name" field.
 	AuthorName string `json:"author_name,omitempty"`
-	// AuthorEmail holds the value of the "author_email" field.
+	// Blanked once the comment is deleted
 	AuthorEmail string `json:"author_email,omitempty"`
 	// AuthorWebsite holds the value of the "author_website" field.
 	AuthorWebsite string `json:"author_website,omitempty"`
@@ -49,6 +49,20 @@ type Comment struct {
 	UserIdentityID string `json:"user_identity_id,omitempty"`
 	// Number of likes for this comment
 	LikesCount int `json:"likes_count,omitempty"`
+	// Whether the author changed the content after posting
+	IsEdited bool `json:"is_edited,omitempty"`
+	// When the author last edited the content; updated_at also changes with likes and moderation
+	EditedAt *time.Time `json:"edited_at,omitempty"`
+	// EditCount holds the value of the "edit_count" field.
+	EditCount int `json:"edit_count,omitempty"`
+	// Whether the site owner wrote the comment, decided when it is created
+	IsAuthor bool `json:"is_author,omitempty"`
+	// Whether the author confirmed author_email with an emailed code
+	EmailVerified bool `json:"email_verified,omitempty"`
+	// Language detected from the content, see commentlang.Detect; empty for comments from before it was stored
+	Language string `json:"language,omitempty"`
+	// When the author deleted the comment; it stays, blanked, while others' replies need it
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -110,13 +124,13 @@ func (*Comment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case comment.FieldIsApproved:
+		case comment.FieldIsApproved, comment.FieldIsEdited, comment.FieldIsAuthor, comment.FieldEmailVerified:
 			values[i] = new(sql.NullBool)
-		case comment.FieldLikesCount:
+		case comment.FieldLikesCount, comment.FieldEditCount:
 			values[i] = new(sql.NullInt64)
-		case comment.FieldEntityType, comment.FieldAuthorName, comment.FieldAuthorEmail, comment.FieldAuthorWebsite, comment.FieldContent, comment.FieldType, comment.FieldReferrenceID, comment.FieldAttachmentID, comment.FieldIPAddress, comment.FieldUserAgent, comment.FieldUserIdentityID:
+		case comment.FieldEntityType, comment.FieldAuthorName, comment.FieldAuthorEmail, comment.FieldAuthorWebsite, comment.FieldContent, comment.FieldType, comment.FieldReferrenceID, comment.FieldAttachmentID, comment.FieldIPAddress, comment.FieldUserAgent, comment.FieldUserIdentityID, comment.FieldLanguage:
 			values[i] = new(sql.NullString)
-		case comment.FieldCreatedAt, comment.FieldUpdatedAt:
+		case comment.FieldEditedAt, comment.FieldDeletedAt, comment.FieldCreatedAt, comment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case comment.FieldID, comment.FieldEntityID, comment.FieldParentID:
 			values[i] = new(uuid.UUID)
@@ -235,6 +249,50 @@ func (c *Comment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				c.LikesCount = int(value.Int64)
 			}
+		case comment.FieldIsEdited:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_edited", values[i])
+			} else if value.Valid {
+				c.IsEdited = value.Bool
+			}
+		case comment.FieldEditedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field edited_at", values[i])
+			} else if value.Valid {
+				c.EditedAt = new(time.Time)
+				*c.EditedAt = value.Time
+			}
+		case comment.FieldEditCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field edit_count", values[i])
+			} else if value.Valid {
+				c.EditCount = int(value.Int64)
+			}
+		case comment.FieldIsAuthor:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_author", values[i])
+			} else if value.Valid {
+				c.IsAuthor = value.Bool
+			}
+		case comment.FieldEmailVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified", values[i])
+			} else if value.Valid {
+				c.EmailVerified = value.Bool
+			}
+		case comment.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				c.Language = value.String
+			}
+		case comment.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				c.DeletedAt = new(time.Time)
+				*c.DeletedAt = value.Time
+			}
 		case comment.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -357,6 +415,31 @@ func (c *Comment) String() string {
 	builder.WriteString("likes_count=")
 	builder.WriteString(fmt.Sprintf("%v", c.LikesCount))
 	builder.WriteString(", ")
+	builder.WriteString("is_edited=")
+	builder.WriteString(fmt.Sprintf("%v", c.IsEdited))
+	builder.WriteString(", ")
+	if v := c.EditedAt; v != nil {
+		builder.WriteString("edited_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("edit_count=")
+	builder.WriteString(fmt.Sprintf("%v", c.EditCount))
+	builder.WriteString(", ")
+	builder.WriteString("is_author=")
+	builder.WriteString(fmt.Sprintf("%v", c.IsAuthor))
+	builder.WriteString(", ")
+	builder.WriteString("email_verified=")
+	builder.WriteString(fmt.Sprintf("%v", c.EmailVerified))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(c.Language)
+	builder.WriteString(", ")
+	if v := c.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
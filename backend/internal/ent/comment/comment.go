@@ -45,6 +45,20 @@ const (
 	FieldUserIdentityID = "user_identity_id"
 	// FieldLikesCount holds the string denoting the likes_count field in the database.
 	FieldLikesCount = "likes_count"
+	// FieldIsEdited holds the string denoting the is_edited field in the database.
+	FieldIsEdited = "is_edited"
+	// FieldEditedAt holds the string denoting the edited_at field in the database.
+	FieldEditedAt = "edited_at"
+	// FieldEditCount holds the string denoting the edit_count field in the database.
+	FieldEditCount = "edit_count"
+	// FieldIsAuthor holds the string denoting the is_author field in the database.
+	FieldIsAuthor = "is_author"
+	// FieldEmailVerified holds the string denoting the email_verified field in the database.
+	FieldEmailVerified = "email_verified"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
+	FieldDeletedAt = "deleted_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -92,6 +106,13 @@ var Columns = []string{
 	FieldUserAgent,
 	FieldUserIdentityID,
 	FieldLikesCount,
+	FieldIsEdited,
+	FieldEditedAt,
+	FieldEditCount,
+	FieldIsAuthor,
+	FieldEmailVerified,
+	FieldLanguage,
+	FieldDeletedAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -141,6 +162,18 @@ var (
 	UserAgentValidator func(string) error
 	// DefaultLikesCount holds the default value on creation for the "likes_count" field.
 	DefaultLikesCount int
+	// DefaultIsEdited holds the default value on creation for the "is_edited" field.
+	DefaultIsEdited bool
+	// DefaultEditCount holds the default value on creation for the "edit_count" field.
+	DefaultEditCount int
+	// DefaultIsAuthor holds the default value on creation for the "is_author" field.
+	DefaultIsAuthor bool
+	// DefaultEmailVerified holds the default value on creation for the "email_verified" field.
+	DefaultEmailVerified bool
+	// DefaultLanguage holds the default value on creation for the "language" field.
+	DefaultLanguage string
+	// LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	LanguageValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -234,6 +267,41 @@ func ByLikesCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLikesCount, opts...).ToFunc()
 }
 
+// ByIsEdited orders the results by the is_edited field.
+func ByIsEdited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsEdited, opts...).ToFunc()
+}
+
+// ByEditedAt orders the results by the edited_at field.
+func ByEditedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditedAt, opts...).ToFunc()
+}
+
+// ByEditCount orders the results by the edit_count field.
+func ByEditCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEditCount, opts...).ToFunc()
+}
+
+// ByIsAuthor orders the results by the is_author field.
+func ByIsAuthor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsAuthor, opts...).ToFunc()
+}
+
+// ByEmailVerified orders the results by the email_verified field.
+func ByEmailVerified(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerified, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByDeletedAt orders the results by the deleted_at field.
+func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
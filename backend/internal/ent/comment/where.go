@@ -131,6 +131,41 @@ func LikesCount(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldLikesCount, v))
 }
 
+// IsEdited applies equality check predicate on the "is_edited" field. It's identical to IsEditedEQ.
+func IsEdited(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldIsEdited, v))
+}
+
+// EditedAt applies equality check predicate on the "edited_at" field. It's identical to EditedAtEQ.
+func EditedAt(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditCount applies equality check predicate on the "edit_count" field. It's identical to EditCountEQ.
+func EditCount(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEditCount, v))
+}
+
+// IsAuthor applies equality check predicate on the "is_author" field. It's identical to IsAuthorEQ.
+func IsAuthor(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldIsAuthor, v))
+}
+
+// EmailVerified applies equality check predicate on the "email_verified" field. It's identical to EmailVerifiedEQ.
+func EmailVerified(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEmailVerified, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldLanguage, v))
+}
+
+// DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
+func DeletedAt(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldCreatedAt, v))
@@ -1036,6 +1071,241 @@ func LikesCountLTE(v int) predicate.Comment {
 	return predicate.Comment(sql.FieldLTE(FieldLikesCount, v))
 }
 
+// IsEditedEQ applies the EQ predicate on the "is_edited" field.
+func IsEditedEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldIsEdited, v))
+}
+
+// IsEditedNEQ applies the NEQ predicate on the "is_edited" field.
+func IsEditedNEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldIsEdited, v))
+}
+
+// EditedAtEQ applies the EQ predicate on the "edited_at" field.
+func EditedAtEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEditedAt, v))
+}
+
+// EditedAtNEQ applies the NEQ predicate on the "edited_at" field.
+func EditedAtNEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldEditedAt, v))
+}
+
+// EditedAtIn applies the In predicate on the "edited_at" field.
+func EditedAtIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldEditedAt, vs...))
+}
+
+// EditedAtNotIn applies the NotIn predicate on the "edited_at" field.
+func EditedAtNotIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldEditedAt, vs...))
+}
+
+// EditedAtGT applies the GT predicate on the "edited_at" field.
+func EditedAtGT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldEditedAt, v))
+}
+
+// EditedAtGTE applies the GTE predicate on the "edited_at" field.
+func EditedAtGTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldEditedAt, v))
+}
+
+// EditedAtLT applies the LT predicate on the "edited_at" field.
+func EditedAtLT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldEditedAt, v))
+}
+
+// EditedAtLTE applies the LTE predicate on the "edited_at" field.
+func EditedAtLTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldEditedAt, v))
+}
+
+// EditedAtIsNil applies the IsNil predicate on the "edited_at" field.
+func EditedAtIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldEditedAt))
+}
+
+// EditedAtNotNil applies the NotNil predicate on the "edited_at" field.
+func EditedAtNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldEditedAt))
+}
+
+// EditCountEQ applies the EQ predicate on the "edit_count" field.
+func EditCountEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEditCount, v))
+}
+
+// EditCountNEQ applies the NEQ predicate on the "edit_count" field.
+func EditCountNEQ(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldEditCount, v))
+}
+
+// EditCountIn applies the In predicate on the "edit_count" field.
+func EditCountIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldEditCount, vs...))
+}
+
+// EditCountNotIn applies the NotIn predicate on the "edit_count" field.
+func EditCountNotIn(vs ...int) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldEditCount, vs...))
+}
+
+// EditCountGT applies the GT predicate on the "edit_count" field.
+func EditCountGT(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldEditCount, v))
+}
+
+// EditCountGTE applies the GTE predicate on the "edit_count" field.
+func EditCountGTE(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldEditCount, v))
+}
+
+// EditCountLT applies the LT predicate on the "edit_count" field.
+func EditCountLT(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldEditCount, v))
+}
+
+// EditCountLTE applies the LTE predicate on the "edit_count" field.
+func EditCountLTE(v int) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldEditCount, v))
+}
+
+// IsAuthorEQ applies the EQ predicate on the "is_author" field.
+func IsAuthorEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldIsAuthor, v))
+}
+
+// IsAuthorNEQ applies the NEQ predicate on the "is_author" field.
+func IsAuthorNEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldIsAuthor, v))
+}
+
+// EmailVerifiedEQ applies the EQ predicate on the "email_verified" field.
+func EmailVerifiedEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldEmailVerified, v))
+}
+
+// EmailVerifiedNEQ applies the NEQ predicate on the "email_verified" field.
+func EmailVerifiedNEQ(v bool) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldEmailVerified, v))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Comment {
+	return predicate.Comment(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
+func DeletedAtEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtNEQ applies the NEQ predicate on the "deleted_at" field.
+func DeletedAtNEQ(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNEQ(FieldDeletedAt, v))
+}
+
+// DeletedAtIn applies the In predicate on the "deleted_at" field.
+func DeletedAtIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtNotIn applies the NotIn predicate on the "deleted_at" field.
+func DeletedAtNotIn(vs ...time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldNotIn(FieldDeletedAt, vs...))
+}
+
+// DeletedAtGT applies the GT predicate on the "deleted_at" field.
+func DeletedAtGT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGT(FieldDeletedAt, v))
+}
+
+// DeletedAtGTE applies the GTE predicate on the "deleted_at" field.
+func DeletedAtGTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldGTE(FieldDeletedAt, v))
+}
+
+// DeletedAtLT applies the LT predicate on the "deleted_at" field.
+func DeletedAtLT(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLT(FieldDeletedAt, v))
+}
+
+// DeletedAtLTE applies the LTE predicate on the "deleted_at" field.
+func DeletedAtLTE(v time.Time) predicate.Comment {
+	return predicate.Comment(sql.FieldLTE(FieldDeletedAt, v))
+}
+
+// DeletedAtIsNil applies the IsNil predicate on the "deleted_at" field.
+func DeletedAtIsNil() predicate.Comment {
+	return predicate.Comment(sql.FieldIsNull(FieldDeletedAt))
+}
+
+// DeletedAtNotNil applies the NotNil predicate on the "deleted_at" field.
+func DeletedAtNotNil() predicate.Comment {
+	return predicate.Comment(sql.FieldNotNull(FieldDeletedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Comment {
 	return predicate.Comment(sql.FieldEQ(FieldCreatedAt, v))
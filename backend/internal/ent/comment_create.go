@@ -192,6 +192,104 @@ func (cc *CommentCreate) SetNillableLikesCount(i *int) *CommentCreate {
 	return cc
 }
 
+// SetIsEdited sets the "is_edited" field.
+func (cc *CommentCreate) SetIsEdited(b bool) *CommentCreate {
+	cc.mutation.SetIsEdited(b)
+	return cc
+}
+
+// SetNillableIsEdited sets the "is_edited" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableIsEdited(b *bool) *CommentCreate {
+	if b != nil {
+		cc.SetIsEdited(*b)
+	}
+	return cc
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cc *CommentCreate) SetEditedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetEditedAt(t)
+	return cc
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetEditedAt(*t)
+	}
+	return cc
+}
+
+// SetEditCount sets the "edit_count" field.
+func (cc *CommentCreate) SetEditCount(i int) *CommentCreate {
+	cc.mutation.SetEditCount(i)
+	return cc
+}
+
+// SetNillableEditCount sets the "edit_count" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEditCount(i *int) *CommentCreate {
+	if i != nil {
+		cc.SetEditCount(*i)
+	}
+	return cc
+}
+
+// SetIsAuthor sets the "is_author" field.
+func (cc *CommentCreate) SetIsAuthor(b bool) *CommentCreate {
+	cc.mutation.SetIsAuthor(b)
+	return cc
+}
+
+// SetNillableIsAuthor sets the "is_author" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableIsAuthor(b *bool) *CommentCreate {
+	if b != nil {
+		cc.SetIsAuthor(*b)
+	}
+	return cc
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (cc *CommentCreate) SetEmailVerified(b bool) *CommentCreate {
+	cc.mutation.SetEmailVerified(b)
+	return cc
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableEmailVerified(b *bool) *CommentCreate {
+	if b != nil {
+		cc.SetEmailVerified(*b)
+	}
+	return cc
+}
+
+// SetLanguage sets the "language" field.
+func (cc *CommentCreate) SetLanguage(s string) *CommentCreate {
+	cc.mutation.SetLanguage(s)
+	return cc
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableLanguage(s *string) *CommentCreate {
+	if s != nil {
+		cc.SetLanguage(*s)
+	}
+	return cc
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cc *CommentCreate) SetDeletedAt(t time.Time) *CommentCreate {
+	cc.mutation.SetDeletedAt(t)
+	return cc
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cc *CommentCreate) SetNillableDeletedAt(t *time.Time) *CommentCreate {
+	if t != nil {
+		cc.SetDeletedAt(*t)
+	}
+	return cc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (cc *CommentCreate) SetCreatedAt(t time.Time) *CommentCreate {
 	cc.mutation.SetCreatedAt(t)
@@ -306,6 +404,26 @@ func (cc *CommentCreate) defaults() {
 		v := comment.DefaultLikesCount
 		cc.mutation.SetLikesCount(v)
 	}
+	if _, ok := cc.mutation.IsEdited(); !ok {
+		v := comment.DefaultIsEdited
+		cc.mutation.SetIsEdited(v)
+	}
+	if _, ok := cc.mutation.EditCount(); !ok {
+		v := comment.DefaultEditCount
+		cc.mutation.SetEditCount(v)
+	}
+	if _, ok := cc.mutation.IsAuthor(); !ok {
+		v := comment.DefaultIsAuthor
+		cc.mutation.SetIsAuthor(v)
+	}
+	if _, ok := cc.mutation.EmailVerified(); !ok {
+		v := comment.DefaultEmailVerified
+		cc.mutation.SetEmailVerified(v)
+	}
+	if _, ok := cc.mutation.Language(); !ok {
+		v := comment.DefaultLanguage
+		cc.mutation.SetLanguage(v)
+	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		v := comment.DefaultCreatedAt()
 		cc.mutation.SetCreatedAt(v)
@@ -386,6 +504,26 @@ func (cc *CommentCreate) check() error {
 	if _, ok := cc.mutation.LikesCount(); !ok {
 		return &ValidationError{Name: "likes_count", err: errors.New(`ent: missing required field "Comment.likes_count"`)}
 	}
+	if _, ok := cc.mutation.IsEdited(); !ok {
+		return &ValidationError{Name: "is_edited", err: errors.New(`ent: missing required field "Comment.is_edited"`)}
+	}
+	if _, ok := cc.mutation.EditCount(); !ok {
+		return &ValidationError{Name: "edit_count", err: errors.New(`ent: missing required field "Comment.edit_count"`)}
+	}
+	if _, ok := cc.mutation.IsAuthor(); !ok {
+		return &ValidationError{Name: "is_author", err: errors.New(`ent: missing required field "Comment.is_author"`)}
+	}
+	if _, ok := cc.mutation.EmailVerified(); !ok {
+		return &ValidationError{Name: "email_verified", err: errors.New(`ent: missing required field "Comment.email_verified"`)}
+	}
+	if _, ok := cc.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "Comment.language"`)}
+	}
+	if v, ok := cc.mutation.Language(); ok {
+		if err := comment.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Comment.language": %w`, err)}
+		}
+	}
 	if _, ok := cc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Comment.created_at"`)}
 	}
@@ -479,6 +617,34 @@ func (cc *CommentCreate) createSpec() (*Comment, *sqlgraph.CreateSpec) {
 		_spec.SetField(comment.FieldLikesCount, field.TypeInt, value)
 		_node.LikesCount = value
 	}
+	if value, ok := cc.mutation.IsEdited(); ok {
+		_spec.SetField(comment.FieldIsEdited, field.TypeBool, value)
+		_node.IsEdited = value
+	}
+	if value, ok := cc.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+		_node.EditedAt = &value
+	}
+	if value, ok := cc.mutation.EditCount(); ok {
+		_spec.SetField(comment.FieldEditCount, field.TypeInt, value)
+		_node.EditCount = value
+	}
+	if value, ok := cc.mutation.IsAuthor(); ok {
+		_spec.SetField(comment.FieldIsAuthor, field.TypeBool, value)
+		_node.IsAuthor = value
+	}
+	if value, ok := cc.mutation.EmailVerified(); ok {
+		_spec.SetField(comment.FieldEmailVerified, field.TypeBool, value)
+		_node.EmailVerified = value
+	}
+	if value, ok := cc.mutation.Language(); ok {
+		_spec.SetField(comment.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := cc.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := cc.mutation.CreatedAt(); ok {
 		_spec.SetField(comment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
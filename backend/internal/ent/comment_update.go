@@ -289,6 +289,123 @@ func (cu *CommentUpdate) AddLikesCount(i int) *CommentUpdate {
 	return cu
 }
 
+// SetIsEdited sets the "is_edited" field.
+func (cu *CommentUpdate) SetIsEdited(b bool) *CommentUpdate {
+	cu.mutation.SetIsEdited(b)
+	return cu
+}
+
+// SetNillableIsEdited sets the "is_edited" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableIsEdited(b *bool) *CommentUpdate {
+	if b != nil {
+		cu.SetIsEdited(*b)
+	}
+	return cu
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cu *CommentUpdate) SetEditedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetEditedAt(t)
+	return cu
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetEditedAt(*t)
+	}
+	return cu
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cu *CommentUpdate) ClearEditedAt() *CommentUpdate {
+	cu.mutation.ClearEditedAt()
+	return cu
+}
+
+// SetEditCount sets the "edit_count" field.
+func (cu *CommentUpdate) SetEditCount(i int) *CommentUpdate {
+	cu.mutation.ResetEditCount()
+	cu.mutation.SetEditCount(i)
+	return cu
+}
+
+// SetNillableEditCount sets the "edit_count" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEditCount(i *int) *CommentUpdate {
+	if i != nil {
+		cu.SetEditCount(*i)
+	}
+	return cu
+}
+
+// AddEditCount adds i to the "edit_count" field.
+func (cu *CommentUpdate) AddEditCount(i int) *CommentUpdate {
+	cu.mutation.AddEditCount(i)
+	return cu
+}
+
+// SetIsAuthor sets the "is_author" field.
+func (cu *CommentUpdate) SetIsAuthor(b bool) *CommentUpdate {
+	cu.mutation.SetIsAuthor(b)
+	return cu
+}
+
+// SetNillableIsAuthor sets the "is_author" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableIsAuthor(b *bool) *CommentUpdate {
+	if b != nil {
+		cu.SetIsAuthor(*b)
+	}
+	return cu
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (cu *CommentUpdate) SetEmailVerified(b bool) *CommentUpdate {
+	cu.mutation.SetEmailVerified(b)
+	return cu
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableEmailVerified(b *bool) *CommentUpdate {
+	if b != nil {
+		cu.SetEmailVerified(*b)
+	}
+	return cu
+}
+
+// SetLanguage sets the "language" field.
+func (cu *CommentUpdate) SetLanguage(s string) *CommentUpdate {
+	cu.mutation.SetLanguage(s)
+	return cu
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableLanguage(s *string) *CommentUpdate {
+	if s != nil {
+		cu.SetLanguage(*s)
+	}
+	return cu
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cu *CommentUpdate) SetDeletedAt(t time.Time) *CommentUpdate {
+	cu.mutation.SetDeletedAt(t)
+	return cu
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cu *CommentUpdate) SetNillableDeletedAt(t *time.Time) *CommentUpdate {
+	if t != nil {
+		cu.SetDeletedAt(*t)
+	}
+	return cu
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cu *CommentUpdate) ClearDeletedAt() *CommentUpdate {
+	cu.mutation.ClearDeletedAt()
+	return cu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cu *CommentUpdate) SetUpdatedAt(t time.Time) *CommentUpdate {
 	cu.mutation.SetUpdatedAt(t)
@@ -436,6 +553,11 @@ func (cu *CommentUpdate) check() error {
 			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Comment.user_agent": %w`, err)}
 		}
 	}
+	if v, ok := cu.mutation.Language(); ok {
+		if err := comment.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Comment.language": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -508,6 +630,36 @@ func (cu *CommentUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := cu.mutation.AddedLikesCount(); ok {
 		_spec.AddField(comment.FieldLikesCount, field.TypeInt, value)
 	}
+	if value, ok := cu.mutation.IsEdited(); ok {
+		_spec.SetField(comment.FieldIsEdited, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cu.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := cu.mutation.EditCount(); ok {
+		_spec.SetField(comment.FieldEditCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.AddedEditCount(); ok {
+		_spec.AddField(comment.FieldEditCount, field.TypeInt, value)
+	}
+	if value, ok := cu.mutation.IsAuthor(); ok {
+		_spec.SetField(comment.FieldIsAuthor, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.EmailVerified(); ok {
+		_spec.SetField(comment.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := cu.mutation.Language(); ok {
+		_spec.SetField(comment.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cu.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cu.mutation.UpdatedAt(); ok {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -893,6 +1045,123 @@ func (cuo *CommentUpdateOne) AddLikesCount(i int) *CommentUpdateOne {
 	return cuo
 }
 
+// SetIsEdited sets the "is_edited" field.
+func (cuo *CommentUpdateOne) SetIsEdited(b bool) *CommentUpdateOne {
+	cuo.mutation.SetIsEdited(b)
+	return cuo
+}
+
+// SetNillableIsEdited sets the "is_edited" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableIsEdited(b *bool) *CommentUpdateOne {
+	if b != nil {
+		cuo.SetIsEdited(*b)
+	}
+	return cuo
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (cuo *CommentUpdateOne) SetEditedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetEditedAt(t)
+	return cuo
+}
+
+// SetNillableEditedAt sets the "edited_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetEditedAt(*t)
+	}
+	return cuo
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (cuo *CommentUpdateOne) ClearEditedAt() *CommentUpdateOne {
+	cuo.mutation.ClearEditedAt()
+	return cuo
+}
+
+// SetEditCount sets the "edit_count" field.
+func (cuo *CommentUpdateOne) SetEditCount(i int) *CommentUpdateOne {
+	cuo.mutation.ResetEditCount()
+	cuo.mutation.SetEditCount(i)
+	return cuo
+}
+
+// SetNillableEditCount sets the "edit_count" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEditCount(i *int) *CommentUpdateOne {
+	if i != nil {
+		cuo.SetEditCount(*i)
+	}
+	return cuo
+}
+
+// AddEditCount adds i to the "edit_count" field.
+func (cuo *CommentUpdateOne) AddEditCount(i int) *CommentUpdateOne {
+	cuo.mutation.AddEditCount(i)
+	return cuo
+}
+
+// SetIsAuthor sets the "is_author" field.
+func (cuo *CommentUpdateOne) SetIsAuthor(b bool) *CommentUpdateOne {
+	cuo.mutation.SetIsAuthor(b)
+	return cuo
+}
+
+// SetNillableIsAuthor sets the "is_author" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableIsAuthor(b *bool) *CommentUpdateOne {
+	if b != nil {
+		cuo.SetIsAuthor(*b)
+	}
+	return cuo
+}
+
+// SetEmailVerified sets the "email_verified" field.
+func (cuo *CommentUpdateOne) SetEmailVerified(b bool) *CommentUpdateOne {
+	cuo.mutation.SetEmailVerified(b)
+	return cuo
+}
+
+// SetNillableEmailVerified sets the "email_verified" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableEmailVerified(b *bool) *CommentUpdateOne {
+	if b != nil {
+		cuo.SetEmailVerified(*b)
+	}
+	return cuo
+}
+
+// SetLanguage sets the "language" field.
+func (cuo *CommentUpdateOne) SetLanguage(s string) *CommentUpdateOne {
+	cuo.mutation.SetLanguage(s)
+	return cuo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableLanguage(s *string) *CommentUpdateOne {
+	if s != nil {
+		cuo.SetLanguage(*s)
+	}
+	return cuo
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (cuo *CommentUpdateOne) SetDeletedAt(t time.Time) *CommentUpdateOne {
+	cuo.mutation.SetDeletedAt(t)
+	return cuo
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (cuo *CommentUpdateOne) SetNillableDeletedAt(t *time.Time) *CommentUpdateOne {
+	if t != nil {
+		cuo.SetDeletedAt(*t)
+	}
+	return cuo
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (cuo *CommentUpdateOne) ClearDeletedAt() *CommentUpdateOne {
+	cuo.mutation.ClearDeletedAt()
+	return cuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (cuo *CommentUpdateOne) SetUpdatedAt(t time.Time) *CommentUpdateOne {
 	cuo.mutation.SetUpdatedAt(t)
@@ -1053,6 +1322,11 @@ func (cuo *CommentUpdateOne) check() error {
 			return &ValidationError{Name: "user_agent", err: fmt.Errorf(`ent: validator failed for field "Comment.user_agent": %w`, err)}
 		}
 	}
+	if v, ok := cuo.mutation.Language(); ok {
+		if err := comment.LanguageValidator(v); err != nil {
+			return &ValidationError{Name: "language", err: fmt.Errorf(`ent: validator failed for field "Comment.language": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -1142,6 +1416,36 @@ func (cuo *CommentUpdateOne) sqlSave(ctx context.Context) (_node *Comment, err e
 	if value, ok := cuo.mutation.AddedLikesCount(); ok {
 		_spec.AddField(comment.FieldLikesCount, field.TypeInt, value)
 	}
+	if value, ok := cuo.mutation.IsEdited(); ok {
+		_spec.SetField(comment.FieldIsEdited, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.EditedAt(); ok {
+		_spec.SetField(comment.FieldEditedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.EditedAtCleared() {
+		_spec.ClearField(comment.FieldEditedAt, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.EditCount(); ok {
+		_spec.SetField(comment.FieldEditCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.AddedEditCount(); ok {
+		_spec.AddField(comment.FieldEditCount, field.TypeInt, value)
+	}
+	if value, ok := cuo.mutation.IsAuthor(); ok {
+		_spec.SetField(comment.FieldIsAuthor, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.EmailVerified(); ok {
+		_spec.SetField(comment.FieldEmailVerified, field.TypeBool, value)
+	}
+	if value, ok := cuo.mutation.Language(); ok {
+		_spec.SetField(comment.FieldLanguage, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.DeletedAt(); ok {
+		_spec.SetField(comment.FieldDeletedAt, field.TypeTime, value)
+	}
+	if cuo.mutation.DeletedAtCleared() {
+		_spec.ClearField(comment.FieldDeletedAt, field.TypeTime)
+	}
 	if value, ok := cuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(comment.FieldUpdatedAt, field.TypeTime, value)
 	}
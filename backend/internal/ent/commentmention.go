@@ -0,0 +1,151 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"silan-backend/internal/ent/commentmention"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// CommentMention is the model entity for the CommentMention schema.
+type CommentMention struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CommentID holds the value of the "comment_id" field.
+	CommentID string `json:"comment_id,omitempty"`
+	// UserIdentityID holds the value of the "user_identity_id" field.
+	UserIdentityID string `json:"user_identity_id,omitempty"`
+	// DisplayName holds the value of the "display_name" field.
+	DisplayName string `json:"display_name,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// NotifiedAt holds the value of the "notified_at" field.
+	NotifiedAt   *time.Time `json:"notified_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CommentMention) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case commentmention.FieldID, commentmention.FieldCommentID, commentmention.FieldUserIdentityID, commentmention.FieldDisplayName:
+			values[i] = new(sql.NullString)
+		case commentmention.FieldCreatedAt, commentmention.FieldNotifiedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CommentMention fields.
+func (cm *CommentMention) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case commentmention.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				cm.ID = value.String
+			}
+		case commentmention.FieldCommentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field comment_id", values[i])
+			} else if value.Valid {
+				cm.CommentID = value.String
+			}
+		case commentmention.FieldUserIdentityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_identity_id", values[i])
+			} else if value.Valid {
+				cm.UserIdentityID = value.String
+			}
+		case commentmention.FieldDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name", values[i])
+			} else if value.Valid {
+				cm.DisplayName = value.String
+			}
+		case commentmention.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				cm.CreatedAt = value.Time
+			}
+		case commentmention.FieldNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_at", values[i])
+			} else if value.Valid {
+				cm.NotifiedAt = new(time.Time)
+				*cm.NotifiedAt = value.Time
+			}
+		default:
+			cm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CommentMention.
+// This includes values selected through modifiers, order, etc.
+func (cm *CommentMention) Value(name string) (ent.Value, error) {
+	return cm.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this CommentMention.
+// Note that you need to call CommentMention.Unwrap() before calling this method if this CommentMention
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (cm *CommentMention) Update() *CommentMentionUpdateOne {
+	return NewCommentMentionClient(cm.config).UpdateOne(cm)
+}
+
+// Unwrap unwraps the CommentMention entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (cm *CommentMention) Unwrap() *CommentMention {
+	_tx, ok := cm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CommentMention is not a transactional entity")
+	}
+	cm.config.driver = _tx.drv
+	return cm
+}
+
+// String implements the fmt.Stringer.
+func (cm *CommentMention) String() string {
+	var builder strings.Builder
+	builder.WriteString("CommentMention(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", cm.ID))
+	builder.WriteString("comment_id=")
+	builder.WriteString(cm.CommentID)
+	builder.WriteString(", ")
+	builder.WriteString("user_identity_id=")
+	builder.WriteString(cm.UserIdentityID)
+	builder.WriteString(", ")
+	builder.WriteString("display_name=")
+	builder.WriteString(cm.DisplayName)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(cm.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := cm.NotifiedAt; v != nil {
+		builder.WriteString("notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CommentMentions is a parsable slice of CommentMention.
+type CommentMentions []*CommentMention
@@ -0,0 +1,390 @@
+// Code generated by ent, DO NOT EDIT.
+
+package commentmention
+
+import (
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContainsFold(FieldID, id))
+}
+
+// CommentID applies equality check predicate on the "comment_id" field. It's identical to CommentIDEQ.
+func CommentID(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldCommentID, v))
+}
+
+// UserIdentityID applies equality check predicate on the "user_identity_id" field. It's identical to UserIdentityIDEQ.
+func UserIdentityID(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldUserIdentityID, v))
+}
+
+// DisplayName applies equality check predicate on the "display_name" field. It's identical to DisplayNameEQ.
+func DisplayName(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// NotifiedAt applies equality check predicate on the "notified_at" field. It's identical to NotifiedAtEQ.
+func NotifiedAt(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldNotifiedAt, v))
+}
+
+// CommentIDEQ applies the EQ predicate on the "comment_id" field.
+func CommentIDEQ(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldCommentID, v))
+}
+
+// CommentIDNEQ applies the NEQ predicate on the "comment_id" field.
+func CommentIDNEQ(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNEQ(FieldCommentID, v))
+}
+
+// CommentIDIn applies the In predicate on the "comment_id" field.
+func CommentIDIn(vs ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIn(FieldCommentID, vs...))
+}
+
+// CommentIDNotIn applies the NotIn predicate on the "comment_id" field.
+func CommentIDNotIn(vs ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotIn(FieldCommentID, vs...))
+}
+
+// CommentIDGT applies the GT predicate on the "comment_id" field.
+func CommentIDGT(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGT(FieldCommentID, v))
+}
+
+// CommentIDGTE applies the GTE predicate on the "comment_id" field.
+func CommentIDGTE(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGTE(FieldCommentID, v))
+}
+
+// CommentIDLT applies the LT predicate on the "comment_id" field.
+func CommentIDLT(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLT(FieldCommentID, v))
+}
+
+// CommentIDLTE applies the LTE predicate on the "comment_id" field.
+func CommentIDLTE(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLTE(FieldCommentID, v))
+}
+
+// CommentIDContains applies the Contains predicate on the "comment_id" field.
+func CommentIDContains(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContains(FieldCommentID, v))
+}
+
+// CommentIDHasPrefix applies the HasPrefix predicate on the "comment_id" field.
+func CommentIDHasPrefix(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldHasPrefix(FieldCommentID, v))
+}
+
+// CommentIDHasSuffix applies the HasSuffix predicate on the "comment_id" field.
+func CommentIDHasSuffix(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldHasSuffix(FieldCommentID, v))
+}
+
+// CommentIDEqualFold applies the EqualFold predicate on the "comment_id" field.
+func CommentIDEqualFold(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEqualFold(FieldCommentID, v))
+}
+
+// CommentIDContainsFold applies the ContainsFold predicate on the "comment_id" field.
+func CommentIDContainsFold(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContainsFold(FieldCommentID, v))
+}
+
+// UserIdentityIDEQ applies the EQ predicate on the "user_identity_id" field.
+func UserIdentityIDEQ(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDNEQ applies the NEQ predicate on the "user_identity_id" field.
+func UserIdentityIDNEQ(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNEQ(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDIn applies the In predicate on the "user_identity_id" field.
+func UserIdentityIDIn(vs ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIn(FieldUserIdentityID, vs...))
+}
+
+// UserIdentityIDNotIn applies the NotIn predicate on the "user_identity_id" field.
+func UserIdentityIDNotIn(vs ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotIn(FieldUserIdentityID, vs...))
+}
+
+// UserIdentityIDGT applies the GT predicate on the "user_identity_id" field.
+func UserIdentityIDGT(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGT(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDGTE applies the GTE predicate on the "user_identity_id" field.
+func UserIdentityIDGTE(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGTE(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDLT applies the LT predicate on the "user_identity_id" field.
+func UserIdentityIDLT(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLT(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDLTE applies the LTE predicate on the "user_identity_id" field.
+func UserIdentityIDLTE(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLTE(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDContains applies the Contains predicate on the "user_identity_id" field.
+func UserIdentityIDContains(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContains(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDHasPrefix applies the HasPrefix predicate on the "user_identity_id" field.
+func UserIdentityIDHasPrefix(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldHasPrefix(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDHasSuffix applies the HasSuffix predicate on the "user_identity_id" field.
+func UserIdentityIDHasSuffix(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldHasSuffix(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDEqualFold applies the EqualFold predicate on the "user_identity_id" field.
+func UserIdentityIDEqualFold(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEqualFold(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDContainsFold applies the ContainsFold predicate on the "user_identity_id" field.
+func UserIdentityIDContainsFold(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContainsFold(FieldUserIdentityID, v))
+}
+
+// DisplayNameEQ applies the EQ predicate on the "display_name" field.
+func DisplayNameEQ(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldDisplayName, v))
+}
+
+// DisplayNameNEQ applies the NEQ predicate on the "display_name" field.
+func DisplayNameNEQ(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNEQ(FieldDisplayName, v))
+}
+
+// DisplayNameIn applies the In predicate on the "display_name" field.
+func DisplayNameIn(vs ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameNotIn applies the NotIn predicate on the "display_name" field.
+func DisplayNameNotIn(vs ...string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotIn(FieldDisplayName, vs...))
+}
+
+// DisplayNameGT applies the GT predicate on the "display_name" field.
+func DisplayNameGT(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGT(FieldDisplayName, v))
+}
+
+// DisplayNameGTE applies the GTE predicate on the "display_name" field.
+func DisplayNameGTE(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGTE(FieldDisplayName, v))
+}
+
+// DisplayNameLT applies the LT predicate on the "display_name" field.
+func DisplayNameLT(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLT(FieldDisplayName, v))
+}
+
+// DisplayNameLTE applies the LTE predicate on the "display_name" field.
+func DisplayNameLTE(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLTE(FieldDisplayName, v))
+}
+
+// DisplayNameContains applies the Contains predicate on the "display_name" field.
+func DisplayNameContains(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContains(FieldDisplayName, v))
+}
+
+// DisplayNameHasPrefix applies the HasPrefix predicate on the "display_name" field.
+func DisplayNameHasPrefix(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldHasPrefix(FieldDisplayName, v))
+}
+
+// DisplayNameHasSuffix applies the HasSuffix predicate on the "display_name" field.
+func DisplayNameHasSuffix(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldHasSuffix(FieldDisplayName, v))
+}
+
+// DisplayNameEqualFold applies the EqualFold predicate on the "display_name" field.
+func DisplayNameEqualFold(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEqualFold(FieldDisplayName, v))
+}
+
+// DisplayNameContainsFold applies the ContainsFold predicate on the "display_name" field.
+func DisplayNameContainsFold(v string) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldContainsFold(FieldDisplayName, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// NotifiedAtEQ applies the EQ predicate on the "notified_at" field.
+func NotifiedAtEQ(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldEQ(FieldNotifiedAt, v))
+}
+
+// NotifiedAtNEQ applies the NEQ predicate on the "notified_at" field.
+func NotifiedAtNEQ(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNEQ(FieldNotifiedAt, v))
+}
+
+// NotifiedAtIn applies the In predicate on the "notified_at" field.
+func NotifiedAtIn(vs ...time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIn(FieldNotifiedAt, vs...))
+}
+
+// NotifiedAtNotIn applies the NotIn predicate on the "notified_at" field.
+func NotifiedAtNotIn(vs ...time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotIn(FieldNotifiedAt, vs...))
+}
+
+// NotifiedAtGT applies the GT predicate on the "notified_at" field.
+func NotifiedAtGT(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGT(FieldNotifiedAt, v))
+}
+
+// NotifiedAtGTE applies the GTE predicate on the "notified_at" field.
+func NotifiedAtGTE(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldGTE(FieldNotifiedAt, v))
+}
+
+// NotifiedAtLT applies the LT predicate on the "notified_at" field.
+func NotifiedAtLT(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLT(FieldNotifiedAt, v))
+}
+
+// NotifiedAtLTE applies the LTE predicate on the "notified_at" field.
+func NotifiedAtLTE(v time.Time) predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldLTE(FieldNotifiedAt, v))
+}
+
+// NotifiedAtIsNil applies the IsNil predicate on the "notified_at" field.
+func NotifiedAtIsNil() predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldIsNull(FieldNotifiedAt))
+}
+
+// NotifiedAtNotNil applies the NotNil predicate on the "notified_at" field.
+func NotifiedAtNotNil() predicate.CommentMention {
+	return predicate.CommentMention(sql.FieldNotNull(FieldNotifiedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.CommentMention) predicate.CommentMention {
+	return predicate.CommentMention(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.CommentMention) predicate.CommentMention {
+	return predicate.CommentMention(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.CommentMention) predicate.CommentMention {
+	return predicate.CommentMention(sql.NotPredicates(p))
+}
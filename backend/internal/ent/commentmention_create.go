@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/commentmention"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentMentionCreate is the builder for creating a CommentMention entity.
+type CommentMentionCreate struct {
+	config
+	mutation *CommentMentionMutation
+	hooks    []Hook
+}
+
+// SetCommentID sets the "comment_id" field.
+func (cmc *CommentMentionCreate) SetCommentID(s string) *CommentMentionCreate {
+	cmc.mutation.SetCommentID(s)
+	return cmc
+}
+
+// SetUserIdentityID sets the "user_identity_id" field.
+func (cmc *CommentMentionCreate) SetUserIdentityID(s string) *CommentMentionCreate {
+	cmc.mutation.SetUserIdentityID(s)
+	return cmc
+}
+
+// SetDisplayName sets the "display_name" field.
+func (cmc *CommentMentionCreate) SetDisplayName(s string) *CommentMentionCreate {
+	cmc.mutation.SetDisplayName(s)
+	return cmc
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableDisplayName(s *string) *CommentMentionCreate {
+	if s != nil {
+		cmc.SetDisplayName(*s)
+	}
+	return cmc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cmc *CommentMentionCreate) SetCreatedAt(t time.Time) *CommentMentionCreate {
+	cmc.mutation.SetCreatedAt(t)
+	return cmc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableCreatedAt(t *time.Time) *CommentMentionCreate {
+	if t != nil {
+		cmc.SetCreatedAt(*t)
+	}
+	return cmc
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (cmc *CommentMentionCreate) SetNotifiedAt(t time.Time) *CommentMentionCreate {
+	cmc.mutation.SetNotifiedAt(t)
+	return cmc
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (cmc *CommentMentionCreate) SetNillableNotifiedAt(t *time.Time) *CommentMentionCreate {
+	if t != nil {
+		cmc.SetNotifiedAt(*t)
+	}
+	return cmc
+}
+
+// SetID sets the "id" field.
+func (cmc *CommentMentionCreate) SetID(s string) *CommentMentionCreate {
+	cmc.mutation.SetID(s)
+	return cmc
+}
+
+// Mutation returns the CommentMentionMutation object of the builder.
+func (cmc *CommentMentionCreate) Mutation() *CommentMentionMutation {
+	return cmc.mutation
+}
+
+// Save creates the CommentMention in the database.
+func (cmc *CommentMentionCreate) Save(ctx context.Context) (*CommentMention, error) {
+	cmc.defaults()
+	return withHooks(ctx, cmc.sqlSave, cmc.mutation, cmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cmc *CommentMentionCreate) SaveX(ctx context.Context) *CommentMention {
+	v, err := cmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmc *CommentMentionCreate) Exec(ctx context.Context) error {
+	_, err := cmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmc *CommentMentionCreate) ExecX(ctx context.Context) {
+	if err := cmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cmc *CommentMentionCreate) defaults() {
+	if _, ok := cmc.mutation.DisplayName(); !ok {
+		v := commentmention.DefaultDisplayName
+		cmc.mutation.SetDisplayName(v)
+	}
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		v := commentmention.DefaultCreatedAt()
+		cmc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmc *CommentMentionCreate) check() error {
+	if _, ok := cmc.mutation.CommentID(); !ok {
+		return &ValidationError{Name: "comment_id", err: errors.New(`ent: missing required field "CommentMention.comment_id"`)}
+	}
+	if v, ok := cmc.mutation.CommentID(); ok {
+		if err := commentmention.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.comment_id": %w`, err)}
+		}
+	}
+	if _, ok := cmc.mutation.UserIdentityID(); !ok {
+		return &ValidationError{Name: "user_identity_id", err: errors.New(`ent: missing required field "CommentMention.user_identity_id"`)}
+	}
+	if v, ok := cmc.mutation.UserIdentityID(); ok {
+		if err := commentmention.UserIdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_identity_id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.user_identity_id": %w`, err)}
+		}
+	}
+	if _, ok := cmc.mutation.DisplayName(); !ok {
+		return &ValidationError{Name: "display_name", err: errors.New(`ent: missing required field "CommentMention.display_name"`)}
+	}
+	if v, ok := cmc.mutation.DisplayName(); ok {
+		if err := commentmention.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "CommentMention.display_name": %w`, err)}
+		}
+	}
+	if _, ok := cmc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CommentMention.created_at"`)}
+	}
+	if v, ok := cmc.mutation.ID(); ok {
+		if err := commentmention.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cmc *CommentMentionCreate) sqlSave(ctx context.Context) (*CommentMention, error) {
+	if err := cmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected CommentMention.ID type: %T", _spec.ID.Value)
+		}
+	}
+	cmc.mutation.id = &_node.ID
+	cmc.mutation.done = true
+	return _node, nil
+}
+
+func (cmc *CommentMentionCreate) createSpec() (*CommentMention, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CommentMention{config: cmc.config}
+		_spec = sqlgraph.NewCreateSpec(commentmention.Table, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeString))
+	)
+	if id, ok := cmc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := cmc.mutation.CommentID(); ok {
+		_spec.SetField(commentmention.FieldCommentID, field.TypeString, value)
+		_node.CommentID = value
+	}
+	if value, ok := cmc.mutation.UserIdentityID(); ok {
+		_spec.SetField(commentmention.FieldUserIdentityID, field.TypeString, value)
+		_node.UserIdentityID = value
+	}
+	if value, ok := cmc.mutation.DisplayName(); ok {
+		_spec.SetField(commentmention.FieldDisplayName, field.TypeString, value)
+		_node.DisplayName = value
+	}
+	if value, ok := cmc.mutation.CreatedAt(); ok {
+		_spec.SetField(commentmention.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cmc.mutation.NotifiedAt(); ok {
+		_spec.SetField(commentmention.FieldNotifiedAt, field.TypeTime, value)
+		_node.NotifiedAt = &value
+	}
+	return _node, _spec
+}
+
+// CommentMentionCreateBulk is the builder for creating many CommentMention entities in bulk.
+type CommentMentionCreateBulk struct {
+	config
+	err      error
+	builders []*CommentMentionCreate
+}
+
+// Save creates the CommentMention entities in the database.
+func (cmcb *CommentMentionCreateBulk) Save(ctx context.Context) ([]*CommentMention, error) {
+	if cmcb.err != nil {
+		return nil, cmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(cmcb.builders))
+	nodes := make([]*CommentMention, len(cmcb.builders))
+	mutators := make([]Mutator, len(cmcb.builders))
+	for i := range cmcb.builders {
+		func(i int, root context.Context) {
+			builder := cmcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CommentMentionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, cmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, cmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, cmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmcb *CommentMentionCreateBulk) SaveX(ctx context.Context) []*CommentMention {
+	v, err := cmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cmcb *CommentMentionCreateBulk) Exec(ctx context.Context) error {
+	_, err := cmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmcb *CommentMentionCreateBulk) ExecX(ctx context.Context) {
+	if err := cmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
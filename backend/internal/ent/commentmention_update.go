@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/commentmention"
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// CommentMentionUpdate is the builder for updating CommentMention entities.
+type CommentMentionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CommentMentionMutation
+}
+
+// Where appends a list predicates to the CommentMentionUpdate builder.
+func (cmu *CommentMentionUpdate) Where(ps ...predicate.CommentMention) *CommentMentionUpdate {
+	cmu.mutation.Where(ps...)
+	return cmu
+}
+
+// SetCommentID sets the "comment_id" field.
+func (cmu *CommentMentionUpdate) SetCommentID(s string) *CommentMentionUpdate {
+	cmu.mutation.SetCommentID(s)
+	return cmu
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableCommentID(s *string) *CommentMentionUpdate {
+	if s != nil {
+		cmu.SetCommentID(*s)
+	}
+	return cmu
+}
+
+// SetUserIdentityID sets the "user_identity_id" field.
+func (cmu *CommentMentionUpdate) SetUserIdentityID(s string) *CommentMentionUpdate {
+	cmu.mutation.SetUserIdentityID(s)
+	return cmu
+}
+
+// SetNillableUserIdentityID sets the "user_identity_id" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableUserIdentityID(s *string) *CommentMentionUpdate {
+	if s != nil {
+		cmu.SetUserIdentityID(*s)
+	}
+	return cmu
+}
+
+// SetDisplayName sets the "display_name" field.
+func (cmu *CommentMentionUpdate) SetDisplayName(s string) *CommentMentionUpdate {
+	cmu.mutation.SetDisplayName(s)
+	return cmu
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableDisplayName(s *string) *CommentMentionUpdate {
+	if s != nil {
+		cmu.SetDisplayName(*s)
+	}
+	return cmu
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (cmu *CommentMentionUpdate) SetNotifiedAt(t time.Time) *CommentMentionUpdate {
+	cmu.mutation.SetNotifiedAt(t)
+	return cmu
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (cmu *CommentMentionUpdate) SetNillableNotifiedAt(t *time.Time) *CommentMentionUpdate {
+	if t != nil {
+		cmu.SetNotifiedAt(*t)
+	}
+	return cmu
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (cmu *CommentMentionUpdate) ClearNotifiedAt() *CommentMentionUpdate {
+	cmu.mutation.ClearNotifiedAt()
+	return cmu
+}
+
+// Mutation returns the CommentMentionMutation object of the builder.
+func (cmu *CommentMentionUpdate) Mutation() *CommentMentionMutation {
+	return cmu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cmu *CommentMentionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, cmu.sqlSave, cmu.mutation, cmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmu *CommentMentionUpdate) SaveX(ctx context.Context) int {
+	affected, err := cmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cmu *CommentMentionUpdate) Exec(ctx context.Context) error {
+	_, err := cmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmu *CommentMentionUpdate) ExecX(ctx context.Context) {
+	if err := cmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmu *CommentMentionUpdate) check() error {
+	if v, ok := cmu.mutation.CommentID(); ok {
+		if err := commentmention.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.comment_id": %w`, err)}
+		}
+	}
+	if v, ok := cmu.mutation.UserIdentityID(); ok {
+		if err := commentmention.UserIdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_identity_id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.user_identity_id": %w`, err)}
+		}
+	}
+	if v, ok := cmu.mutation.DisplayName(); ok {
+		if err := commentmention.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "CommentMention.display_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cmu *CommentMentionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentmention.Table, commentmention.Columns, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeString))
+	if ps := cmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmu.mutation.CommentID(); ok {
+		_spec.SetField(commentmention.FieldCommentID, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.UserIdentityID(); ok {
+		_spec.SetField(commentmention.FieldUserIdentityID, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.DisplayName(); ok {
+		_spec.SetField(commentmention.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := cmu.mutation.NotifiedAt(); ok {
+		_spec.SetField(commentmention.FieldNotifiedAt, field.TypeTime, value)
+	}
+	if cmu.mutation.NotifiedAtCleared() {
+		_spec.ClearField(commentmention.FieldNotifiedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentmention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cmu.mutation.done = true
+	return n, nil
+}
+
+// CommentMentionUpdateOne is the builder for updating a single CommentMention entity.
+type CommentMentionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CommentMentionMutation
+}
+
+// SetCommentID sets the "comment_id" field.
+func (cmuo *CommentMentionUpdateOne) SetCommentID(s string) *CommentMentionUpdateOne {
+	cmuo.mutation.SetCommentID(s)
+	return cmuo
+}
+
+// SetNillableCommentID sets the "comment_id" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableCommentID(s *string) *CommentMentionUpdateOne {
+	if s != nil {
+		cmuo.SetCommentID(*s)
+	}
+	return cmuo
+}
+
+// SetUserIdentityID sets the "user_identity_id" field.
+func (cmuo *CommentMentionUpdateOne) SetUserIdentityID(s string) *CommentMentionUpdateOne {
+	cmuo.mutation.SetUserIdentityID(s)
+	return cmuo
+}
+
+// SetNillableUserIdentityID sets the "user_identity_id" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableUserIdentityID(s *string) *CommentMentionUpdateOne {
+	if s != nil {
+		cmuo.SetUserIdentityID(*s)
+	}
+	return cmuo
+}
+
+// SetDisplayName sets the "display_name" field.
+func (cmuo *CommentMentionUpdateOne) SetDisplayName(s string) *CommentMentionUpdateOne {
+	cmuo.mutation.SetDisplayName(s)
+	return cmuo
+}
+
+// SetNillableDisplayName sets the "display_name" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableDisplayName(s *string) *CommentMentionUpdateOne {
+	if s != nil {
+		cmuo.SetDisplayName(*s)
+	}
+	return cmuo
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (cmuo *CommentMentionUpdateOne) SetNotifiedAt(t time.Time) *CommentMentionUpdateOne {
+	cmuo.mutation.SetNotifiedAt(t)
+	return cmuo
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (cmuo *CommentMentionUpdateOne) SetNillableNotifiedAt(t *time.Time) *CommentMentionUpdateOne {
+	if t != nil {
+		cmuo.SetNotifiedAt(*t)
+	}
+	return cmuo
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (cmuo *CommentMentionUpdateOne) ClearNotifiedAt() *CommentMentionUpdateOne {
+	cmuo.mutation.ClearNotifiedAt()
+	return cmuo
+}
+
+// Mutation returns the CommentMentionMutation object of the builder.
+func (cmuo *CommentMentionUpdateOne) Mutation() *CommentMentionMutation {
+	return cmuo.mutation
+}
+
+// Where appends a list predicates to the CommentMentionUpdate builder.
+func (cmuo *CommentMentionUpdateOne) Where(ps ...predicate.CommentMention) *CommentMentionUpdateOne {
+	cmuo.mutation.Where(ps...)
+	return cmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cmuo *CommentMentionUpdateOne) Select(field string, fields ...string) *CommentMentionUpdateOne {
+	cmuo.fields = append([]string{field}, fields...)
+	return cmuo
+}
+
+// Save executes the query and returns the updated CommentMention entity.
+func (cmuo *CommentMentionUpdateOne) Save(ctx context.Context) (*CommentMention, error) {
+	return withHooks(ctx, cmuo.sqlSave, cmuo.mutation, cmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cmuo *CommentMentionUpdateOne) SaveX(ctx context.Context) *CommentMention {
+	node, err := cmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cmuo *CommentMentionUpdateOne) Exec(ctx context.Context) error {
+	_, err := cmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cmuo *CommentMentionUpdateOne) ExecX(ctx context.Context) {
+	if err := cmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cmuo *CommentMentionUpdateOne) check() error {
+	if v, ok := cmuo.mutation.CommentID(); ok {
+		if err := commentmention.CommentIDValidator(v); err != nil {
+			return &ValidationError{Name: "comment_id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.comment_id": %w`, err)}
+		}
+	}
+	if v, ok := cmuo.mutation.UserIdentityID(); ok {
+		if err := commentmention.UserIdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_identity_id", err: fmt.Errorf(`ent: validator failed for field "CommentMention.user_identity_id": %w`, err)}
+		}
+	}
+	if v, ok := cmuo.mutation.DisplayName(); ok {
+		if err := commentmention.DisplayNameValidator(v); err != nil {
+			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "CommentMention.display_name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (cmuo *CommentMentionUpdateOne) sqlSave(ctx context.Context) (_node *CommentMention, err error) {
+	if err := cmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(commentmention.Table, commentmention.Columns, sqlgraph.NewFieldSpec(commentmention.FieldID, field.TypeString))
+	id, ok := cmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CommentMention.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, commentmention.FieldID)
+		for _, f := range fields {
+			if !commentmention.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != commentmention.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cmuo.mutation.CommentID(); ok {
+		_spec.SetField(commentmention.FieldCommentID, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.UserIdentityID(); ok {
+		_spec.SetField(commentmention.FieldUserIdentityID, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.DisplayName(); ok {
+		_spec.SetField(commentmention.FieldDisplayName, field.TypeString, value)
+	}
+	if value, ok := cmuo.mutation.NotifiedAt(); ok {
+		_spec.SetField(commentmention.FieldNotifiedAt, field.TypeTime, value)
+	}
+	if cmuo.mutation.NotifiedAtCleared() {
+		_spec.ClearField(commentmention.FieldNotifiedAt, field.TypeTime)
+	}
+	_node = &CommentMention{config: cmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{commentmention.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cmuo.mutation.done = true
+	return _node, nil
+}
@@ -7,8 +7,11 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"silan-backend/internal/ent/apikey"
+	"silan-backend/internal/ent/authevent"
 	"silan-backend/internal/ent/award"
 	"silan-backend/internal/ent/awardtranslation"
+	"silan-backend/internal/ent/ban"
 	"silan-backend/internal/ent/blogcategory"
 	"silan-backend/internal/ent/blogcategorytranslation"
 	"silan-backend/internal/ent/blogpost"
@@ -19,6 +22,7 @@ import (
 	"silan-backend/internal/ent/blogtag"
 	"silan-backend/internal/ent/comment"
 	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/commentmention"
 	"silan-backend/internal/ent/education"
 	"silan-backend/internal/ent/educationdetail"
 	"silan-backend/internal/ent/educationdetailtranslation"
@@ -28,6 +32,7 @@ import (
 	"silan-backend/internal/ent/ideadetailtranslation"
 	"silan-backend/internal/ent/ideatag"
 	"silan-backend/internal/ent/ideatranslation"
+	"silan-backend/internal/ent/identitylink"
 	"silan-backend/internal/ent/language"
 	"silan-backend/internal/ent/personalinfo"
 	"silan-backend/internal/ent/personalinfotranslation"
@@ -44,13 +49,17 @@ import (
 	"silan-backend/internal/ent/publication"
 	"silan-backend/internal/ent/publicationauthor"
 	"silan-backend/internal/ent/publicationtranslation"
+	"silan-backend/internal/ent/reaction"
 	"silan-backend/internal/ent/recentupdate"
 	"silan-backend/internal/ent/recentupdatetranslation"
 	"silan-backend/internal/ent/researchproject"
 	"silan-backend/internal/ent/researchprojectdetail"
 	"silan-backend/internal/ent/researchprojectdetailtranslation"
 	"silan-backend/internal/ent/researchprojecttranslation"
+	"silan-backend/internal/ent/session"
+	"silan-backend/internal/ent/signaturenonce"
 	"silan-backend/internal/ent/sociallink"
+	"silan-backend/internal/ent/spamscore"
 	"silan-backend/internal/ent/user"
 	"silan-backend/internal/ent/useridentity"
 	"silan-backend/internal/ent/workexperience"
@@ -122,8 +131,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			apikey.Table:                           apikey.ValidColumn,
+			authevent.Table:                        authevent.ValidColumn,
 			award.Table:                            award.ValidColumn,
 			awardtranslation.Table:                 awardtranslation.ValidColumn,
+			ban.Table:                              ban.ValidColumn,
 			blogcategory.Table:                     blogcategory.ValidColumn,
 			blogcategorytranslation.Table:          blogcategorytranslation.ValidColumn,
 			blogpost.Table:                         blogpost.ValidColumn,
@@ -134,6 +146,7 @@ func checkColumn(table, column string) error {
 			blogtag.Table:                          blogtag.ValidColumn,
 			comment.Table:                          comment.ValidColumn,
 			commentlike.Table:                      commentlike.ValidColumn,
+			commentmention.Table:                   commentmention.ValidColumn,
 			education.Table:                        education.ValidColumn,
 			educationdetail.Table:                  educationdetail.ValidColumn,
 			educationdetailtranslation.Table:       educationdetailtranslation.ValidColumn,
@@ -143,6 +156,7 @@ func checkColumn(table, column string) error {
 			ideadetailtranslation.Table:            ideadetailtranslation.ValidColumn,
 			ideatag.Table:                          ideatag.ValidColumn,
 			ideatranslation.Table:                  ideatranslation.ValidColumn,
+			identitylink.Table:                     identitylink.ValidColumn,
 			language.Table:                         language.ValidColumn,
 			personalinfo.Table:                     personalinfo.ValidColumn,
 			personalinfotranslation.Table:          personalinfotranslation.ValidColumn,
@@ -159,13 +173,17 @@ func checkColumn(table, column string) error {
 			publication.Table:                      publication.ValidColumn,
 			publicationauthor.Table:                publicationauthor.ValidColumn,
 			publicationtranslation.Table:           publicationtranslation.ValidColumn,
+			reaction.Table:                         reaction.ValidColumn,
 			recentupdate.Table:                     recentupdate.ValidColumn,
 			recentupdatetranslation.Table:          recentupdatetranslation.ValidColumn,
 			researchproject.Table:                  researchproject.ValidColumn,
 			researchprojectdetail.Table:            researchprojectdetail.ValidColumn,
 			researchprojectdetailtranslation.Table: researchprojectdetailtranslation.ValidColumn,
 			researchprojecttranslation.Table:       researchprojecttranslation.ValidColumn,
+			session.Table:                          session.ValidColumn,
+			signaturenonce.Table:                   signaturenonce.ValidColumn,
 			sociallink.Table:                       sociallink.ValidColumn,
+			spamscore.Table:                        spamscore.ValidColumn,
 			user.Table:                             user.ValidColumn,
 			useridentity.Table:                     useridentity.ValidColumn,
 			workexperience.Table:                   workexperience.ValidColumn,
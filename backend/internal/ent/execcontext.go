@@ -0,0 +1,43 @@
+package ent
+
+import (
+	"context"
+	stdsql "database/sql"
+	"fmt"
+)
+
+// ExecContext runs a raw statement on the underlying driver. Called on a Tx
+// it runs inside that transaction, which lets tables that are managed with
+// plain SQL (e.g. events_outbox) be written atomically with ent mutations.
+//
+// This mirrors what the "sql/execquery" codegen feature generates and lives
+// in its own file so regenerating the schema does not drop it.
+func (c config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	var drv any = c.driver
+	if tx, ok := drv.(*txDriver); ok {
+		drv = tx.tx
+	}
+	ex, ok := drv.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: driver %T does not support ExecContext", drv)
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext runs a raw query on the underlying driver, inside the
+// transaction when called on a Tx, like ExecContext.
+func (c config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	var drv any = c.driver
+	if tx, ok := drv.(*txDriver); ok {
+		drv = tx.tx
+	}
+	q, ok := drv.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("ent: driver %T does not support QueryContext", drv)
+	}
+	return q.QueryContext(ctx, query, args...)
+}
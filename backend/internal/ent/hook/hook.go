@@ -8,6 +8,30 @@ import (
 	"silan-backend/internal/ent"
 )
 
+// The ApiKeyFunc type is an adapter to allow the use of ordinary
+// function as ApiKey mutator.
+type ApiKeyFunc func(context.Context, *ent.ApiKeyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ApiKeyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ApiKeyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ApiKeyMutation", m)
+}
+
+// The AuthEventFunc type is an adapter to allow the use of ordinary
+// function as AuthEvent mutator.
+type AuthEventFunc func(context.Context, *ent.AuthEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuthEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.AuthEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AuthEventMutation", m)
+}
+
 // The AwardFunc type is an adapter to allow the use of ordinary
 // function as Award mutator.
 type AwardFunc func(context.Context, *ent.AwardMutation) (ent.Value, error)
@@ -32,6 +56,18 @@ func (f AwardTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.AwardTranslationMutation", m)
 }
 
+// The BanFunc type is an adapter to allow the use of ordinary
+// function as Ban mutator.
+type BanFunc func(context.Context, *ent.BanMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BanFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BanMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BanMutation", m)
+}
+
 // The BlogCategoryFunc type is an adapter to allow the use of ordinary
 // function as BlogCategory mutator.
 type BlogCategoryFunc func(context.Context, *ent.BlogCategoryMutation) (ent.Value, error)
@@ -152,6 +188,18 @@ func (f CommentLikeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentLikeMutation", m)
 }
 
+// The CommentMentionFunc type is an adapter to allow the use of ordinary
+// function as CommentMention mutator.
+type CommentMentionFunc func(context.Context, *ent.CommentMentionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CommentMentionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CommentMentionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CommentMentionMutation", m)
+}
+
 // The EducationFunc type is an adapter to allow the use of ordinary
 // function as Education mutator.
 type EducationFunc func(context.Context, *ent.EducationMutation) (ent.Value, error)
@@ -260,6 +308,18 @@ func (f IdeaTranslationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Va
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdeaTranslationMutation", m)
 }
 
+// The IdentityLinkFunc type is an adapter to allow the use of ordinary
+// function as IdentityLink mutator.
+type IdentityLinkFunc func(context.Context, *ent.IdentityLinkMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f IdentityLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.IdentityLinkMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.IdentityLinkMutation", m)
+}
+
 // The LanguageFunc type is an adapter to allow the use of ordinary
 // function as Language mutator.
 type LanguageFunc func(context.Context, *ent.LanguageMutation) (ent.Value, error)
@@ -452,6 +512,18 @@ func (f PublicationTranslationFunc) Mutate(ctx context.Context, m ent.Mutation)
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PublicationTranslationMutation", m)
 }
 
+// The ReactionFunc type is an adapter to allow the use of ordinary
+// function as Reaction mutator.
+type ReactionFunc func(context.Context, *ent.ReactionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ReactionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ReactionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ReactionMutation", m)
+}
+
 // The RecentUpdateFunc type is an adapter to allow the use of ordinary
 // function as RecentUpdate mutator.
 type RecentUpdateFunc func(context.Context, *ent.RecentUpdateMutation) (ent.Value, error)
@@ -524,6 +596,30 @@ func (f ResearchProjectTranslationFunc) Mutate(ctx context.Context, m ent.Mutati
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ResearchProjectTranslationMutation", m)
 }
 
+// The SessionFunc type is an adapter to allow the use of ordinary
+// function as Session mutator.
+type SessionFunc func(context.Context, *ent.SessionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SessionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SessionMutation", m)
+}
+
+// The SignatureNonceFunc type is an adapter to allow the use of ordinary
+// function as SignatureNonce mutator.
+type SignatureNonceFunc func(context.Context, *ent.SignatureNonceMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SignatureNonceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SignatureNonceMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SignatureNonceMutation", m)
+}
+
 // The SocialLinkFunc type is an adapter to allow the use of ordinary
 // function as SocialLink mutator.
 type SocialLinkFunc func(context.Context, *ent.SocialLinkMutation) (ent.Value, error)
@@ -536,6 +632,18 @@ func (f SocialLinkFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SocialLinkMutation", m)
 }
 
+// The SpamScoreFunc type is an adapter to allow the use of ordinary
+// function as SpamScore mutator.
+type SpamScoreFunc func(context.Context, *ent.SpamScoreMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SpamScoreFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SpamScoreMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SpamScoreMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/useridentity"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// IdentityLink is the model entity for the IdentityLink schema.
+type IdentityLink struct {
+	config `json:"-"`
+	// IdentityID holds the value of the "identity_id" field.
+	IdentityID string `json:"identity_id,omitempty"`
+	// PrimaryID holds the value of the "primary_id" field.
+	PrimaryID string `json:"primary_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the IdentityLinkQuery when eager-loading is set.
+	Edges        IdentityLinkEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// IdentityLinkEdges holds the relations/edges for other nodes in the graph.
+type IdentityLinkEdges struct {
+	// Identity holds the value of the identity edge.
+	Identity *UserIdentity `json:"identity,omitempty"`
+	// Primary holds the value of the primary edge.
+	Primary *UserIdentity `json:"primary,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// IdentityOrErr returns the Identity value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdentityLinkEdges) IdentityOrErr() (*UserIdentity, error) {
+	if e.Identity != nil {
+		return e.Identity, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: useridentity.Label}
+	}
+	return nil, &NotLoadedError{edge: "identity"}
+}
+
+// PrimaryOrErr returns the Primary value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e IdentityLinkEdges) PrimaryOrErr() (*UserIdentity, error) {
+	if e.Primary != nil {
+		return e.Primary, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: useridentity.Label}
+	}
+	return nil, &NotLoadedError{edge: "primary"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*IdentityLink) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case identitylink.FieldIdentityID, identitylink.FieldPrimaryID:
+			values[i] = new(sql.NullString)
+		case identitylink.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the IdentityLink fields.
+func (il *IdentityLink) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case identitylink.FieldIdentityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field identity_id", values[i])
+			} else if value.Valid {
+				il.IdentityID = value.String
+			}
+		case identitylink.FieldPrimaryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field primary_id", values[i])
+			} else if value.Valid {
+				il.PrimaryID = value.String
+			}
+		case identitylink.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				il.CreatedAt = value.Time
+			}
+		default:
+			il.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the IdentityLink.
+// This includes values selected through modifiers, order, etc.
+func (il *IdentityLink) Value(name string) (ent.Value, error) {
+	return il.selectValues.Get(name)
+}
+
+// QueryIdentity queries the "identity" edge of the IdentityLink entity.
+func (il *IdentityLink) QueryIdentity() *UserIdentityQuery {
+	return NewIdentityLinkClient(il.config).QueryIdentity(il)
+}
+
+// QueryPrimary queries the "primary" edge of the IdentityLink entity.
+func (il *IdentityLink) QueryPrimary() *UserIdentityQuery {
+	return NewIdentityLinkClient(il.config).QueryPrimary(il)
+}
+
+// Update returns a builder for updating this IdentityLink.
+// Note that you need to call IdentityLink.Unwrap() before calling this method if this IdentityLink
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (il *IdentityLink) Update() *IdentityLinkUpdateOne {
+	return NewIdentityLinkClient(il.config).UpdateOne(il)
+}
+
+// Unwrap unwraps the IdentityLink entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (il *IdentityLink) Unwrap() *IdentityLink {
+	_tx, ok := il.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: IdentityLink is not a transactional entity")
+	}
+	il.config.driver = _tx.drv
+	return il
+}
+
+// String implements the fmt.Stringer.
+func (il *IdentityLink) String() string {
+	var builder strings.Builder
+	builder.WriteString("IdentityLink(")
+	builder.WriteString("identity_id=")
+	builder.WriteString(il.IdentityID)
+	builder.WriteString(", ")
+	builder.WriteString("primary_id=")
+	builder.WriteString(il.PrimaryID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(il.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// IdentityLinks is a parsable slice of IdentityLink.
+type IdentityLinks []*IdentityLink
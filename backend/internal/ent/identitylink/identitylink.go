@@ -0,0 +1,115 @@
+// Code generated by ent, DO NOT EDIT.
+
+package identitylink
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the identitylink type in the database.
+	Label = "identity_link"
+	// FieldIdentityID holds the string denoting the identity_id field in the database.
+	FieldIdentityID = "identity_id"
+	// FieldPrimaryID holds the string denoting the primary_id field in the database.
+	FieldPrimaryID = "primary_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeIdentity holds the string denoting the identity edge name in mutations.
+	EdgeIdentity = "identity"
+	// EdgePrimary holds the string denoting the primary edge name in mutations.
+	EdgePrimary = "primary"
+	// UserIdentityFieldID holds the string denoting the ID field of the UserIdentity.
+	UserIdentityFieldID = "id"
+	// Table holds the table name of the identitylink in the database.
+	Table = "identity_links"
+	// IdentityTable is the table that holds the identity relation/edge.
+	IdentityTable = "identity_links"
+	// IdentityInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	IdentityInverseTable = "user_identities"
+	// IdentityColumn is the table column denoting the identity relation/edge.
+	IdentityColumn = "identity_id"
+	// PrimaryTable is the table that holds the primary relation/edge.
+	PrimaryTable = "identity_links"
+	// PrimaryInverseTable is the table name for the UserIdentity entity.
+	// It exists in this package in order to avoid circular dependency with the "useridentity" package.
+	PrimaryInverseTable = "user_identities"
+	// PrimaryColumn is the table column denoting the primary relation/edge.
+	PrimaryColumn = "primary_id"
+)
+
+// Columns holds all SQL columns for identitylink fields.
+var Columns = []string{
+	FieldIdentityID,
+	FieldPrimaryID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// IdentityIDValidator is a validator for the "identity_id" field. It is called by the builders before save.
+	IdentityIDValidator func(string) error
+	// PrimaryIDValidator is a validator for the "primary_id" field. It is called by the builders before save.
+	PrimaryIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the IdentityLink queries.
+type OrderOption func(*sql.Selector)
+
+// ByIdentityID orders the results by the identity_id field.
+func ByIdentityID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdentityID, opts...).ToFunc()
+}
+
+// ByPrimaryID orders the results by the primary_id field.
+func ByPrimaryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrimaryID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByIdentityField orders the results by identity field.
+func ByIdentityField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newIdentityStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPrimaryField orders the results by primary field.
+func ByPrimaryField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrimaryStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newIdentityStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, IdentityColumn),
+		sqlgraph.To(IdentityInverseTable, UserIdentityFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, IdentityTable, IdentityColumn),
+	)
+}
+func newPrimaryStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, PrimaryColumn),
+		sqlgraph.To(PrimaryInverseTable, UserIdentityFieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, PrimaryTable, PrimaryColumn),
+	)
+}
@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package identitylink
+
+import (
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// IdentityID applies equality check predicate on the "identity_id" field. It's identical to IdentityIDEQ.
+func IdentityID(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEQ(FieldIdentityID, v))
+}
+
+// PrimaryID applies equality check predicate on the "primary_id" field. It's identical to PrimaryIDEQ.
+func PrimaryID(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEQ(FieldPrimaryID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// IdentityIDEQ applies the EQ predicate on the "identity_id" field.
+func IdentityIDEQ(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEQ(FieldIdentityID, v))
+}
+
+// IdentityIDNEQ applies the NEQ predicate on the "identity_id" field.
+func IdentityIDNEQ(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldNEQ(FieldIdentityID, v))
+}
+
+// IdentityIDIn applies the In predicate on the "identity_id" field.
+func IdentityIDIn(vs ...string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldIn(FieldIdentityID, vs...))
+}
+
+// IdentityIDNotIn applies the NotIn predicate on the "identity_id" field.
+func IdentityIDNotIn(vs ...string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldNotIn(FieldIdentityID, vs...))
+}
+
+// IdentityIDGT applies the GT predicate on the "identity_id" field.
+func IdentityIDGT(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldGT(FieldIdentityID, v))
+}
+
+// IdentityIDGTE applies the GTE predicate on the "identity_id" field.
+func IdentityIDGTE(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldGTE(FieldIdentityID, v))
+}
+
+// IdentityIDLT applies the LT predicate on the "identity_id" field.
+func IdentityIDLT(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldLT(FieldIdentityID, v))
+}
+
+// IdentityIDLTE applies the LTE predicate on the "identity_id" field.
+func IdentityIDLTE(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldLTE(FieldIdentityID, v))
+}
+
+// IdentityIDContains applies the Contains predicate on the "identity_id" field.
+func IdentityIDContains(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldContains(FieldIdentityID, v))
+}
+
+// IdentityIDHasPrefix applies the HasPrefix predicate on the "identity_id" field.
+func IdentityIDHasPrefix(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldHasPrefix(FieldIdentityID, v))
+}
+
+// IdentityIDHasSuffix applies the HasSuffix predicate on the "identity_id" field.
+func IdentityIDHasSuffix(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldHasSuffix(FieldIdentityID, v))
+}
+
+// IdentityIDEqualFold applies the EqualFold predicate on the "identity_id" field.
+func IdentityIDEqualFold(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEqualFold(FieldIdentityID, v))
+}
+
+// IdentityIDContainsFold applies the ContainsFold predicate on the "identity_id" field.
+func IdentityIDContainsFold(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldContainsFold(FieldIdentityID, v))
+}
+
+// PrimaryIDEQ applies the EQ predicate on the "primary_id" field.
+func PrimaryIDEQ(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEQ(FieldPrimaryID, v))
+}
+
+// PrimaryIDNEQ applies the NEQ predicate on the "primary_id" field.
+func PrimaryIDNEQ(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldNEQ(FieldPrimaryID, v))
+}
+
+// PrimaryIDIn applies the In predicate on the "primary_id" field.
+func PrimaryIDIn(vs ...string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldIn(FieldPrimaryID, vs...))
+}
+
+// PrimaryIDNotIn applies the NotIn predicate on the "primary_id" field.
+func PrimaryIDNotIn(vs ...string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldNotIn(FieldPrimaryID, vs...))
+}
+
+// PrimaryIDGT applies the GT predicate on the "primary_id" field.
+func PrimaryIDGT(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldGT(FieldPrimaryID, v))
+}
+
+// PrimaryIDGTE applies the GTE predicate on the "primary_id" field.
+func PrimaryIDGTE(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldGTE(FieldPrimaryID, v))
+}
+
+// PrimaryIDLT applies the LT predicate on the "primary_id" field.
+func PrimaryIDLT(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldLT(FieldPrimaryID, v))
+}
+
+// PrimaryIDLTE applies the LTE predicate on the "primary_id" field.
+func PrimaryIDLTE(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldLTE(FieldPrimaryID, v))
+}
+
+// PrimaryIDContains applies the Contains predicate on the "primary_id" field.
+func PrimaryIDContains(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldContains(FieldPrimaryID, v))
+}
+
+// PrimaryIDHasPrefix applies the HasPrefix predicate on the "primary_id" field.
+func PrimaryIDHasPrefix(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldHasPrefix(FieldPrimaryID, v))
+}
+
+// PrimaryIDHasSuffix applies the HasSuffix predicate on the "primary_id" field.
+func PrimaryIDHasSuffix(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldHasSuffix(FieldPrimaryID, v))
+}
+
+// PrimaryIDEqualFold applies the EqualFold predicate on the "primary_id" field.
+func PrimaryIDEqualFold(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEqualFold(FieldPrimaryID, v))
+}
+
+// PrimaryIDContainsFold applies the ContainsFold predicate on the "primary_id" field.
+func PrimaryIDContainsFold(v string) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldContainsFold(FieldPrimaryID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasIdentity applies the HasEdge predicate on the "identity" edge.
+func HasIdentity() predicate.IdentityLink {
+	return predicate.IdentityLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, IdentityColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, IdentityTable, IdentityColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasIdentityWith applies the HasEdge predicate on the "identity" edge with a given conditions (other predicates).
+func HasIdentityWith(preds ...predicate.UserIdentity) predicate.IdentityLink {
+	return predicate.IdentityLink(func(s *sql.Selector) {
+		step := newIdentityStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPrimary applies the HasEdge predicate on the "primary" edge.
+func HasPrimary() predicate.IdentityLink {
+	return predicate.IdentityLink(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, PrimaryColumn),
+			sqlgraph.Edge(sqlgraph.M2O, false, PrimaryTable, PrimaryColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrimaryWith applies the HasEdge predicate on the "primary" edge with a given conditions (other predicates).
+func HasPrimaryWith(preds ...predicate.UserIdentity) predicate.IdentityLink {
+	return predicate.IdentityLink(func(s *sql.Selector) {
+		step := newPrimaryStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.IdentityLink) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.IdentityLink) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.IdentityLink) predicate.IdentityLink {
+	return predicate.IdentityLink(sql.NotPredicates(p))
+}
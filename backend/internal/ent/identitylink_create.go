@@ -0,0 +1,272 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/useridentity"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdentityLinkCreate is the builder for creating a IdentityLink entity.
+type IdentityLinkCreate struct {
+	config
+	mutation *IdentityLinkMutation
+	hooks    []Hook
+}
+
+// SetIdentityID sets the "identity_id" field.
+func (ilc *IdentityLinkCreate) SetIdentityID(s string) *IdentityLinkCreate {
+	ilc.mutation.SetIdentityID(s)
+	return ilc
+}
+
+// SetPrimaryID sets the "primary_id" field.
+func (ilc *IdentityLinkCreate) SetPrimaryID(s string) *IdentityLinkCreate {
+	ilc.mutation.SetPrimaryID(s)
+	return ilc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ilc *IdentityLinkCreate) SetCreatedAt(t time.Time) *IdentityLinkCreate {
+	ilc.mutation.SetCreatedAt(t)
+	return ilc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ilc *IdentityLinkCreate) SetNillableCreatedAt(t *time.Time) *IdentityLinkCreate {
+	if t != nil {
+		ilc.SetCreatedAt(*t)
+	}
+	return ilc
+}
+
+// SetIdentity sets the "identity" edge to the UserIdentity entity.
+func (ilc *IdentityLinkCreate) SetIdentity(u *UserIdentity) *IdentityLinkCreate {
+	return ilc.SetIdentityID(u.ID)
+}
+
+// SetPrimary sets the "primary" edge to the UserIdentity entity.
+func (ilc *IdentityLinkCreate) SetPrimary(u *UserIdentity) *IdentityLinkCreate {
+	return ilc.SetPrimaryID(u.ID)
+}
+
+// Mutation returns the IdentityLinkMutation object of the builder.
+func (ilc *IdentityLinkCreate) Mutation() *IdentityLinkMutation {
+	return ilc.mutation
+}
+
+// Save creates the IdentityLink in the database.
+func (ilc *IdentityLinkCreate) Save(ctx context.Context) (*IdentityLink, error) {
+	ilc.defaults()
+	return withHooks(ctx, ilc.sqlSave, ilc.mutation, ilc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ilc *IdentityLinkCreate) SaveX(ctx context.Context) *IdentityLink {
+	v, err := ilc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ilc *IdentityLinkCreate) Exec(ctx context.Context) error {
+	_, err := ilc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilc *IdentityLinkCreate) ExecX(ctx context.Context) {
+	if err := ilc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ilc *IdentityLinkCreate) defaults() {
+	if _, ok := ilc.mutation.CreatedAt(); !ok {
+		v := identitylink.DefaultCreatedAt()
+		ilc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilc *IdentityLinkCreate) check() error {
+	if _, ok := ilc.mutation.IdentityID(); !ok {
+		return &ValidationError{Name: "identity_id", err: errors.New(`ent: missing required field "IdentityLink.identity_id"`)}
+	}
+	if v, ok := ilc.mutation.IdentityID(); ok {
+		if err := identitylink.IdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "identity_id", err: fmt.Errorf(`ent: validator failed for field "IdentityLink.identity_id": %w`, err)}
+		}
+	}
+	if _, ok := ilc.mutation.PrimaryID(); !ok {
+		return &ValidationError{Name: "primary_id", err: errors.New(`ent: missing required field "IdentityLink.primary_id"`)}
+	}
+	if v, ok := ilc.mutation.PrimaryID(); ok {
+		if err := identitylink.PrimaryIDValidator(v); err != nil {
+			return &ValidationError{Name: "primary_id", err: fmt.Errorf(`ent: validator failed for field "IdentityLink.primary_id": %w`, err)}
+		}
+	}
+	if _, ok := ilc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "IdentityLink.created_at"`)}
+	}
+	if len(ilc.mutation.IdentityIDs()) == 0 {
+		return &ValidationError{Name: "identity", err: errors.New(`ent: missing required edge "IdentityLink.identity"`)}
+	}
+	if len(ilc.mutation.PrimaryIDs()) == 0 {
+		return &ValidationError{Name: "primary", err: errors.New(`ent: missing required edge "IdentityLink.primary"`)}
+	}
+	return nil
+}
+
+func (ilc *IdentityLinkCreate) sqlSave(ctx context.Context) (*IdentityLink, error) {
+	if err := ilc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ilc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ilc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	return _node, nil
+}
+
+func (ilc *IdentityLinkCreate) createSpec() (*IdentityLink, *sqlgraph.CreateSpec) {
+	var (
+		_node = &IdentityLink{config: ilc.config}
+		_spec = sqlgraph.NewCreateSpec(identitylink.Table, nil)
+	)
+	if value, ok := ilc.mutation.CreatedAt(); ok {
+		_spec.SetField(identitylink.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ilc.mutation.IdentityIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitylink.IdentityTable,
+			Columns: []string{identitylink.IdentityColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.IdentityID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ilc.mutation.PrimaryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   identitylink.PrimaryTable,
+			Columns: []string{identitylink.PrimaryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PrimaryID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// IdentityLinkCreateBulk is the builder for creating many IdentityLink entities in bulk.
+type IdentityLinkCreateBulk struct {
+	config
+	err      error
+	builders []*IdentityLinkCreate
+}
+
+// Save creates the IdentityLink entities in the database.
+func (ilcb *IdentityLinkCreateBulk) Save(ctx context.Context) ([]*IdentityLink, error) {
+	if ilcb.err != nil {
+		return nil, ilcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ilcb.builders))
+	nodes := make([]*IdentityLink, len(ilcb.builders))
+	mutators := make([]Mutator, len(ilcb.builders))
+	for i := range ilcb.builders {
+		func(i int, root context.Context) {
+			builder := ilcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*IdentityLinkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ilcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ilcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ilcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilcb *IdentityLinkCreateBulk) SaveX(ctx context.Context) []*IdentityLink {
+	v, err := ilcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ilcb *IdentityLinkCreateBulk) Exec(ctx context.Context) error {
+	_, err := ilcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilcb *IdentityLinkCreateBulk) ExecX(ctx context.Context) {
+	if err := ilcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,87 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// IdentityLinkDelete is the builder for deleting a IdentityLink entity.
+type IdentityLinkDelete struct {
+	config
+	hooks    []Hook
+	mutation *IdentityLinkMutation
+}
+
+// Where appends a list predicates to the IdentityLinkDelete builder.
+func (ild *IdentityLinkDelete) Where(ps ...predicate.IdentityLink) *IdentityLinkDelete {
+	ild.mutation.Where(ps...)
+	return ild
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ild *IdentityLinkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ild.sqlExec, ild.mutation, ild.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ild *IdentityLinkDelete) ExecX(ctx context.Context) int {
+	n, err := ild.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ild *IdentityLinkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(identitylink.Table, nil)
+	if ps := ild.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ild.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ild.mutation.done = true
+	return affected, err
+}
+
+// IdentityLinkDeleteOne is the builder for deleting a single IdentityLink entity.
+type IdentityLinkDeleteOne struct {
+	ild *IdentityLinkDelete
+}
+
+// Where appends a list predicates to the IdentityLinkDelete builder.
+func (ildo *IdentityLinkDeleteOne) Where(ps ...predicate.IdentityLink) *IdentityLinkDeleteOne {
+	ildo.ild.mutation.Where(ps...)
+	return ildo
+}
+
+// Exec executes the deletion query.
+func (ildo *IdentityLinkDeleteOne) Exec(ctx context.Context) error {
+	n, err := ildo.ild.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{identitylink.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ildo *IdentityLinkDeleteOne) ExecX(ctx context.Context) {
+	if err := ildo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
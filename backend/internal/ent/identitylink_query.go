@@ -0,0 +1,602 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/useridentity"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// IdentityLinkQuery is the builder for querying IdentityLink entities.
+type IdentityLinkQuery struct {
+	config
+	ctx          *QueryContext
+	order        []identitylink.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.IdentityLink
+	withIdentity *UserIdentityQuery
+	withPrimary  *UserIdentityQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the IdentityLinkQuery builder.
+func (ilq *IdentityLinkQuery) Where(ps ...predicate.IdentityLink) *IdentityLinkQuery {
+	ilq.predicates = append(ilq.predicates, ps...)
+	return ilq
+}
+
+// Limit the number of records to be returned by this query.
+func (ilq *IdentityLinkQuery) Limit(limit int) *IdentityLinkQuery {
+	ilq.ctx.Limit = &limit
+	return ilq
+}
+
+// Offset to start from.
+func (ilq *IdentityLinkQuery) Offset(offset int) *IdentityLinkQuery {
+	ilq.ctx.Offset = &offset
+	return ilq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ilq *IdentityLinkQuery) Unique(unique bool) *IdentityLinkQuery {
+	ilq.ctx.Unique = &unique
+	return ilq
+}
+
+// Order specifies how the records should be ordered.
+func (ilq *IdentityLinkQuery) Order(o ...identitylink.OrderOption) *IdentityLinkQuery {
+	ilq.order = append(ilq.order, o...)
+	return ilq
+}
+
+// QueryIdentity chains the current query on the "identity" edge.
+func (ilq *IdentityLinkQuery) QueryIdentity() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: ilq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ilq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ilq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitylink.Table, identitylink.IdentityColumn, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identitylink.IdentityTable, identitylink.IdentityColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ilq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPrimary chains the current query on the "primary" edge.
+func (ilq *IdentityLinkQuery) QueryPrimary() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: ilq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ilq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ilq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(identitylink.Table, identitylink.PrimaryColumn, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, identitylink.PrimaryTable, identitylink.PrimaryColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ilq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first IdentityLink entity from the query.
+// Returns a *NotFoundError when no IdentityLink was found.
+func (ilq *IdentityLinkQuery) First(ctx context.Context) (*IdentityLink, error) {
+	nodes, err := ilq.Limit(1).All(setContextOp(ctx, ilq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{identitylink.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ilq *IdentityLinkQuery) FirstX(ctx context.Context) *IdentityLink {
+	node, err := ilq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// Only returns a single IdentityLink entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one IdentityLink entity is found.
+// Returns a *NotFoundError when no IdentityLink entities are found.
+func (ilq *IdentityLinkQuery) Only(ctx context.Context) (*IdentityLink, error) {
+	nodes, err := ilq.Limit(2).All(setContextOp(ctx, ilq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{identitylink.Label}
+	default:
+		return nil, &NotSingularError{identitylink.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ilq *IdentityLinkQuery) OnlyX(ctx context.Context) *IdentityLink {
+	node, err := ilq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// All executes the query and returns a list of IdentityLinks.
+func (ilq *IdentityLinkQuery) All(ctx context.Context) ([]*IdentityLink, error) {
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryAll)
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*IdentityLink, *IdentityLinkQuery]()
+	return withInterceptors[[]*IdentityLink](ctx, ilq, qr, ilq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ilq *IdentityLinkQuery) AllX(ctx context.Context) []*IdentityLink {
+	nodes, err := ilq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// Count returns the count of the given query.
+func (ilq *IdentityLinkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryCount)
+	if err := ilq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ilq, querierCount[*IdentityLinkQuery](), ilq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ilq *IdentityLinkQuery) CountX(ctx context.Context) int {
+	count, err := ilq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ilq *IdentityLinkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ilq.ctx, ent.OpQueryExist)
+	switch _, err := ilq.First(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ilq *IdentityLinkQuery) ExistX(ctx context.Context) bool {
+	exist, err := ilq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the IdentityLinkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ilq *IdentityLinkQuery) Clone() *IdentityLinkQuery {
+	if ilq == nil {
+		return nil
+	}
+	return &IdentityLinkQuery{
+		config:       ilq.config,
+		ctx:          ilq.ctx.Clone(),
+		order:        append([]identitylink.OrderOption{}, ilq.order...),
+		inters:       append([]Interceptor{}, ilq.inters...),
+		predicates:   append([]predicate.IdentityLink{}, ilq.predicates...),
+		withIdentity: ilq.withIdentity.Clone(),
+		withPrimary:  ilq.withPrimary.Clone(),
+		// clone intermediate query.
+		sql:  ilq.sql.Clone(),
+		path: ilq.path,
+	}
+}
+
+// WithIdentity tells the query-builder to eager-load the nodes that are connected to
+// the "identity" edge. The optional arguments are used to configure the query builder of the edge.
+func (ilq *IdentityLinkQuery) WithIdentity(opts ...func(*UserIdentityQuery)) *IdentityLinkQuery {
+	query := (&UserIdentityClient{config: ilq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ilq.withIdentity = query
+	return ilq
+}
+
+// WithPrimary tells the query-builder to eager-load the nodes that are connected to
+// the "primary" edge. The optional arguments are used to configure the query builder of the edge.
+func (ilq *IdentityLinkQuery) WithPrimary(opts ...func(*UserIdentityQuery)) *IdentityLinkQuery {
+	query := (&UserIdentityClient{config: ilq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ilq.withPrimary = query
+	return ilq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		IdentityID string `json:"identity_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.IdentityLink.Query().
+//		GroupBy(identitylink.FieldIdentityID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ilq *IdentityLinkQuery) GroupBy(field string, fields ...string) *IdentityLinkGroupBy {
+	ilq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &IdentityLinkGroupBy{build: ilq}
+	grbuild.flds = &ilq.ctx.Fields
+	grbuild.label = identitylink.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		IdentityID string `json:"identity_id,omitempty"`
+//	}
+//
+//	client.IdentityLink.Query().
+//		Select(identitylink.FieldIdentityID).
+//		Scan(ctx, &v)
+func (ilq *IdentityLinkQuery) Select(fields ...string) *IdentityLinkSelect {
+	ilq.ctx.Fields = append(ilq.ctx.Fields, fields...)
+	sbuild := &IdentityLinkSelect{IdentityLinkQuery: ilq}
+	sbuild.label = identitylink.Label
+	sbuild.flds, sbuild.scan = &ilq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a IdentityLinkSelect configured with the given aggregations.
+func (ilq *IdentityLinkQuery) Aggregate(fns ...AggregateFunc) *IdentityLinkSelect {
+	return ilq.Select().Aggregate(fns...)
+}
+
+func (ilq *IdentityLinkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ilq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ilq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ilq.ctx.Fields {
+		if !identitylink.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ilq.path != nil {
+		prev, err := ilq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ilq.sql = prev
+	}
+	return nil
+}
+
+func (ilq *IdentityLinkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*IdentityLink, error) {
+	var (
+		nodes       = []*IdentityLink{}
+		_spec       = ilq.querySpec()
+		loadedTypes = [2]bool{
+			ilq.withIdentity != nil,
+			ilq.withPrimary != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*IdentityLink).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &IdentityLink{config: ilq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ilq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ilq.withIdentity; query != nil {
+		if err := ilq.loadIdentity(ctx, query, nodes, nil,
+			func(n *IdentityLink, e *UserIdentity) { n.Edges.Identity = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := ilq.withPrimary; query != nil {
+		if err := ilq.loadPrimary(ctx, query, nodes, nil,
+			func(n *IdentityLink, e *UserIdentity) { n.Edges.Primary = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ilq *IdentityLinkQuery) loadIdentity(ctx context.Context, query *UserIdentityQuery, nodes []*IdentityLink, init func(*IdentityLink), assign func(*IdentityLink, *UserIdentity)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*IdentityLink)
+	for i := range nodes {
+		fk := nodes[i].IdentityID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(useridentity.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "identity_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (ilq *IdentityLinkQuery) loadPrimary(ctx context.Context, query *UserIdentityQuery, nodes []*IdentityLink, init func(*IdentityLink), assign func(*IdentityLink, *UserIdentity)) error {
+	ids := make([]string, 0, len(nodes))
+	nodeids := make(map[string][]*IdentityLink)
+	for i := range nodes {
+		fk := nodes[i].PrimaryID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(useridentity.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "primary_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ilq *IdentityLinkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ilq.querySpec()
+	_spec.Unique = false
+	_spec.Node.Columns = nil
+	return sqlgraph.CountNodes(ctx, ilq.driver, _spec)
+}
+
+func (ilq *IdentityLinkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(identitylink.Table, identitylink.Columns, nil)
+	_spec.From = ilq.sql
+	if unique := ilq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ilq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ilq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		for i := range fields {
+			_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+		}
+		if ilq.withIdentity != nil {
+			_spec.Node.AddColumnOnce(identitylink.FieldIdentityID)
+		}
+		if ilq.withPrimary != nil {
+			_spec.Node.AddColumnOnce(identitylink.FieldPrimaryID)
+		}
+	}
+	if ps := ilq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ilq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ilq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ilq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ilq *IdentityLinkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ilq.driver.Dialect())
+	t1 := builder.Table(identitylink.Table)
+	columns := ilq.ctx.Fields
+	if len(columns) == 0 {
+		columns = identitylink.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ilq.sql != nil {
+		selector = ilq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ilq.ctx.Unique != nil && *ilq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ilq.predicates {
+		p(selector)
+	}
+	for _, p := range ilq.order {
+		p(selector)
+	}
+	if offset := ilq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ilq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// IdentityLinkGroupBy is the group-by builder for IdentityLink entities.
+type IdentityLinkGroupBy struct {
+	selector
+	build *IdentityLinkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ilgb *IdentityLinkGroupBy) Aggregate(fns ...AggregateFunc) *IdentityLinkGroupBy {
+	ilgb.fns = append(ilgb.fns, fns...)
+	return ilgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ilgb *IdentityLinkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ilgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ilgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IdentityLinkQuery, *IdentityLinkGroupBy](ctx, ilgb.build, ilgb, ilgb.build.inters, v)
+}
+
+func (ilgb *IdentityLinkGroupBy) sqlScan(ctx context.Context, root *IdentityLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ilgb.fns))
+	for _, fn := range ilgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ilgb.flds)+len(ilgb.fns))
+		for _, f := range *ilgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ilgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ilgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// IdentityLinkSelect is the builder for selecting fields of IdentityLink entities.
+type IdentityLinkSelect struct {
+	*IdentityLinkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ils *IdentityLinkSelect) Aggregate(fns ...AggregateFunc) *IdentityLinkSelect {
+	ils.fns = append(ils.fns, fns...)
+	return ils
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ils *IdentityLinkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ils.ctx, ent.OpQuerySelect)
+	if err := ils.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*IdentityLinkQuery, *IdentityLinkSelect](ctx, ils.IdentityLinkQuery, ils, ils.inters, v)
+}
+
+func (ils *IdentityLinkSelect) sqlScan(ctx context.Context, root *IdentityLinkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ils.fns))
+	for _, fn := range ils.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ils.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ils.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// IdentityLinkUpdate is the builder for updating IdentityLink entities.
+type IdentityLinkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *IdentityLinkMutation
+}
+
+// Where appends a list predicates to the IdentityLinkUpdate builder.
+func (ilu *IdentityLinkUpdate) Where(ps ...predicate.IdentityLink) *IdentityLinkUpdate {
+	ilu.mutation.Where(ps...)
+	return ilu
+}
+
+// Mutation returns the IdentityLinkMutation object of the builder.
+func (ilu *IdentityLinkUpdate) Mutation() *IdentityLinkMutation {
+	return ilu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ilu *IdentityLinkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ilu.sqlSave, ilu.mutation, ilu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ilu *IdentityLinkUpdate) SaveX(ctx context.Context) int {
+	affected, err := ilu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ilu *IdentityLinkUpdate) Exec(ctx context.Context) error {
+	_, err := ilu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ilu *IdentityLinkUpdate) ExecX(ctx context.Context) {
+	if err := ilu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ilu *IdentityLinkUpdate) check() error {
+	if ilu.mutation.IdentityCleared() && len(ilu.mutation.IdentityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IdentityLink.identity"`)
+	}
+	if ilu.mutation.PrimaryCleared() && len(ilu.mutation.PrimaryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IdentityLink.primary"`)
+	}
+	return nil
+}
+
+func (ilu *IdentityLinkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ilu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identitylink.Table, identitylink.Columns, sqlgraph.NewFieldSpec(identitylink.FieldIdentityID, field.TypeString), sqlgraph.NewFieldSpec(identitylink.FieldPrimaryID, field.TypeString))
+	if ps := ilu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ilu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identitylink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ilu.mutation.done = true
+	return n, nil
+}
+
+// IdentityLinkUpdateOne is the builder for updating a single IdentityLink entity.
+type IdentityLinkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *IdentityLinkMutation
+}
+
+// Mutation returns the IdentityLinkMutation object of the builder.
+func (iluo *IdentityLinkUpdateOne) Mutation() *IdentityLinkMutation {
+	return iluo.mutation
+}
+
+// Where appends a list predicates to the IdentityLinkUpdate builder.
+func (iluo *IdentityLinkUpdateOne) Where(ps ...predicate.IdentityLink) *IdentityLinkUpdateOne {
+	iluo.mutation.Where(ps...)
+	return iluo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iluo *IdentityLinkUpdateOne) Select(field string, fields ...string) *IdentityLinkUpdateOne {
+	iluo.fields = append([]string{field}, fields...)
+	return iluo
+}
+
+// Save executes the query and returns the updated IdentityLink entity.
+func (iluo *IdentityLinkUpdateOne) Save(ctx context.Context) (*IdentityLink, error) {
+	return withHooks(ctx, iluo.sqlSave, iluo.mutation, iluo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iluo *IdentityLinkUpdateOne) SaveX(ctx context.Context) *IdentityLink {
+	node, err := iluo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iluo *IdentityLinkUpdateOne) Exec(ctx context.Context) error {
+	_, err := iluo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iluo *IdentityLinkUpdateOne) ExecX(ctx context.Context) {
+	if err := iluo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iluo *IdentityLinkUpdateOne) check() error {
+	if iluo.mutation.IdentityCleared() && len(iluo.mutation.IdentityIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IdentityLink.identity"`)
+	}
+	if iluo.mutation.PrimaryCleared() && len(iluo.mutation.PrimaryIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "IdentityLink.primary"`)
+	}
+	return nil
+}
+
+func (iluo *IdentityLinkUpdateOne) sqlSave(ctx context.Context) (_node *IdentityLink, err error) {
+	if err := iluo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(identitylink.Table, identitylink.Columns, sqlgraph.NewFieldSpec(identitylink.FieldIdentityID, field.TypeString), sqlgraph.NewFieldSpec(identitylink.FieldPrimaryID, field.TypeString))
+	if id, ok := iluo.mutation.IdentityID(); !ok {
+		return nil, &ValidationError{Name: "identity_id", err: errors.New(`ent: missing "IdentityLink.identity_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[0].Value = id
+	}
+	if id, ok := iluo.mutation.PrimaryID(); !ok {
+		return nil, &ValidationError{Name: "primary_id", err: errors.New(`ent: missing "IdentityLink.primary_id" for update`)}
+	} else {
+		_spec.Node.CompositeID[1].Value = id
+	}
+	if fields := iluo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, len(fields))
+		for i, f := range fields {
+			if !identitylink.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			_spec.Node.Columns[i] = f
+		}
+	}
+	if ps := iluo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &IdentityLink{config: iluo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iluo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{identitylink.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iluo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,56 @@ import (
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "name", Type: field.TypeString, Size: 100},
+		{Name: "key_prefix", Type: field.TypeString, Size: 16},
+		{Name: "key_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "daily_quota", Type: field.TypeInt, Default: 0},
+		{Name: "monthly_quota", Type: field.TypeInt, Default: 0},
+		{Name: "scopes", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+	}
+	// AuthEventsColumns holds the columns for the "auth_events" table.
+	AuthEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "type", Type: field.TypeString, Size: 16},
+		{Name: "provider", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "identity_id", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "email", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 45, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Size: 500, Default: ""},
+		{Name: "outcome", Type: field.TypeString, Size: 16},
+		{Name: "reason", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuthEventsTable holds the schema information for the "auth_events" table.
+	AuthEventsTable = &schema.Table{
+		Name:       "auth_events",
+		Columns:    AuthEventsColumns,
+		PrimaryKey: []*schema.Column{AuthEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_auth_events_created",
+				Unique:  false,
+				Columns: []*schema.Column{AuthEventsColumns[9]},
+			},
+			{
+				Name:    "idx_auth_events_identity",
+				Unique:  false,
+				Columns: []*schema.Column{AuthEventsColumns[3], AuthEventsColumns[9]},
+			},
+		},
+	}
 	// AwardsColumns holds the columns for the "awards" table.
 	AwardsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -69,6 +119,28 @@ var (
 			},
 		},
 	}
+	// BansColumns holds the columns for the "bans" table.
+	BansColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "kind", Type: field.TypeString, Size: 16},
+		{Name: "value", Type: field.TypeString, Size: 255},
+		{Name: "reason", Type: field.TypeString, Size: 500, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BansTable holds the schema information for the "bans" table.
+	BansTable = &schema.Table{
+		Name:       "bans",
+		Columns:    BansColumns,
+		PrimaryKey: []*schema.Column{BansColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_bans_kind_value",
+				Unique:  true,
+				Columns: []*schema.Column{BansColumns[1], BansColumns[2]},
+			},
+		},
+	}
 	// BlogCategoriesColumns holds the columns for the "blog_categories" table.
 	BlogCategoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -304,6 +376,13 @@ var (
 		{Name: "ip_address", Type: field.TypeString, Nullable: true, Size: 45},
 		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 500},
 		{Name: "likes_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_edited", Type: field.TypeBool, Default: false},
+		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
+		{Name: "edit_count", Type: field.TypeInt, Default: 0},
+		{Name: "is_author", Type: field.TypeBool, Default: false},
+		{Name: "email_verified", Type: field.TypeBool, Default: false},
+		{Name: "language", Type: field.TypeString, Size: 8, Default: ""},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "blog_post_comments", Type: field.TypeUUID, Nullable: true},
@@ -319,25 +398,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "comments_blog_posts_comments",
-				Columns:    []*schema.Column{CommentsColumns[16]},
+				Columns:    []*schema.Column{CommentsColumns[23]},
 				RefColumns: []*schema.Column{BlogPostsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_comments_parent",
-				Columns:    []*schema.Column{CommentsColumns[17]},
+				Columns:    []*schema.Column{CommentsColumns[24]},
 				RefColumns: []*schema.Column{CommentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_user_identities_user_identity",
-				Columns:    []*schema.Column{CommentsColumns[18]},
+				Columns:    []*schema.Column{CommentsColumns[25]},
 				RefColumns: []*schema.Column{UserIdentitiesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "comments_ideas_comments",
-				Columns:    []*schema.Column{CommentsColumns[19]},
+				Columns:    []*schema.Column{CommentsColumns[26]},
 				RefColumns: []*schema.Column{IdeasColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -389,6 +468,33 @@ var (
 			},
 		},
 	}
+	// CommentMentionsColumns holds the columns for the "comment_mentions" table.
+	CommentMentionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "comment_id", Type: field.TypeString, Size: 36},
+		{Name: "user_identity_id", Type: field.TypeString, Size: 255},
+		{Name: "display_name", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "notified_at", Type: field.TypeTime, Nullable: true},
+	}
+	// CommentMentionsTable holds the schema information for the "comment_mentions" table.
+	CommentMentionsTable = &schema.Table{
+		Name:       "comment_mentions",
+		Columns:    CommentMentionsColumns,
+		PrimaryKey: []*schema.Column{CommentMentionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "uniq_comment_mentions",
+				Unique:  true,
+				Columns: []*schema.Column{CommentMentionsColumns[1], CommentMentionsColumns[2]},
+			},
+			{
+				Name:    "idx_comment_mentions_identity",
+				Unique:  false,
+				Columns: []*schema.Column{CommentMentionsColumns[2], CommentMentionsColumns[4]},
+			},
+		},
+	}
 	// EducationColumns holds the columns for the "education" table.
 	EducationColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -640,6 +746,44 @@ var (
 			},
 		},
 	}
+	// IdentityLinksColumns holds the columns for the "identity_links" table.
+	IdentityLinksColumns = []*schema.Column{
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "identity_id", Type: field.TypeString},
+		{Name: "primary_id", Type: field.TypeString},
+	}
+	// IdentityLinksTable holds the schema information for the "identity_links" table.
+	IdentityLinksTable = &schema.Table{
+		Name:       "identity_links",
+		Columns:    IdentityLinksColumns,
+		PrimaryKey: []*schema.Column{IdentityLinksColumns[1], IdentityLinksColumns[2]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "identity_links_user_identities_identity",
+				Columns:    []*schema.Column{IdentityLinksColumns[1]},
+				RefColumns: []*schema.Column{UserIdentitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "identity_links_user_identities_primary",
+				Columns:    []*schema.Column{IdentityLinksColumns[2]},
+				RefColumns: []*schema.Column{UserIdentitiesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_identity_links_identity",
+				Unique:  true,
+				Columns: []*schema.Column{IdentityLinksColumns[1]},
+			},
+			{
+				Name:    "idx_identity_links_primary",
+				Unique:  false,
+				Columns: []*schema.Column{IdentityLinksColumns[2]},
+			},
+		},
+	}
 	// LanguagesColumns holds the columns for the "languages" table.
 	LanguagesColumns = []*schema.Column{
 		{Name: "code", Type: field.TypeString, Unique: true, Size: 5},
@@ -1163,6 +1307,30 @@ var (
 			},
 		},
 	}
+	// ReactionsColumns holds the columns for the "reactions" table.
+	ReactionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "entity_type", Type: field.TypeString, Size: 16},
+		{Name: "entity_id", Type: field.TypeString, Size: 36},
+		{Name: "emoji", Type: field.TypeString, Size: 16},
+		{Name: "user_identity_id", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "fingerprint", Type: field.TypeString, Size: 255, Default: ""},
+		{Name: "ip", Type: field.TypeString, Size: 64, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ReactionsTable holds the schema information for the "reactions" table.
+	ReactionsTable = &schema.Table{
+		Name:       "reactions",
+		Columns:    ReactionsColumns,
+		PrimaryKey: []*schema.Column{ReactionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_reactions_entity",
+				Unique:  false,
+				Columns: []*schema.Column{ReactionsColumns[1], ReactionsColumns[2]},
+			},
+		},
+	}
 	// RecentUpdatesColumns holds the columns for the "recent_updates" table.
 	RecentUpdatesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1344,6 +1512,50 @@ var (
 			},
 		},
 	}
+	// SessionsColumns holds the columns for the "sessions" table.
+	SessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, Size: 36},
+		{Name: "identity_id", Type: field.TypeString, Size: 64},
+		{Name: "refresh_hash", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "method", Type: field.TypeString, Size: 32, Default: ""},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true, Size: 512},
+		{Name: "ip", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "last_used_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SessionsTable holds the schema information for the "sessions" table.
+	SessionsTable = &schema.Table{
+		Name:       "sessions",
+		Columns:    SessionsColumns,
+		PrimaryKey: []*schema.Column{SessionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_sessions_identity",
+				Unique:  false,
+				Columns: []*schema.Column{SessionsColumns[1]},
+			},
+		},
+	}
+	// SignatureNoncesColumns holds the columns for the "signature_nonces" table.
+	SignatureNoncesColumns = []*schema.Column{
+		{Name: "signature", Type: field.TypeString, Size: 128},
+		{Name: "expires_at", Type: field.TypeTime},
+	}
+	// SignatureNoncesTable holds the schema information for the "signature_nonces" table.
+	SignatureNoncesTable = &schema.Table{
+		Name:       "signature_nonces",
+		Columns:    SignatureNoncesColumns,
+		PrimaryKey: []*schema.Column{SignatureNoncesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "idx_signature_nonces_expires",
+				Unique:  false,
+				Columns: []*schema.Column{SignatureNoncesColumns[1]},
+			},
+		},
+	}
 	// SocialLinksColumns holds the columns for the "social_links" table.
 	SocialLinksColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1369,6 +1581,20 @@ var (
 			},
 		},
 	}
+	// CommentSpamScoresColumns holds the columns for the "comment_spam_scores" table.
+	CommentSpamScoresColumns = []*schema.Column{
+		{Name: "comment_id", Type: field.TypeString, Size: 36},
+		{Name: "provider", Type: field.TypeString, Size: 32},
+		{Name: "score", Type: field.TypeFloat64},
+		{Name: "reasons", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CommentSpamScoresTable holds the schema information for the "comment_spam_scores" table.
+	CommentSpamScoresTable = &schema.Table{
+		Name:       "comment_spam_scores",
+		Columns:    CommentSpamScoresColumns,
+		PrimaryKey: []*schema.Column{CommentSpamScoresColumns[0]},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -1400,6 +1626,9 @@ var (
 		{Name: "display_name", Type: field.TypeString, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
 		{Name: "verified", Type: field.TypeBool, Default: false},
+		{Name: "display_name_edited", Type: field.TypeBool, Default: false},
+		{Name: "avatar_url_edited", Type: field.TypeBool, Default: false},
+		{Name: "provider_display_name", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -1559,8 +1788,11 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AuthEventsTable,
 		AwardsTable,
 		AwardTranslationsTable,
+		BansTable,
 		BlogCategoriesTable,
 		BlogCategoryTranslationsTable,
 		BlogPostsTable,
@@ -1571,6 +1803,7 @@ var (
 		BlogTagsTable,
 		CommentsTable,
 		CommentLikesTable,
+		CommentMentionsTable,
 		EducationTable,
 		EducationDetailsTable,
 		EducationDetailTranslationsTable,
@@ -1580,6 +1813,7 @@ var (
 		IdeaDetailTranslationsTable,
 		IdeaTagsTable,
 		IdeaTranslationsTable,
+		IdentityLinksTable,
 		LanguagesTable,
 		PersonalInfoTable,
 		PersonalInfoTranslationsTable,
@@ -1596,13 +1830,17 @@ var (
 		PublicationsTable,
 		PublicationAuthorsTable,
 		PublicationTranslationsTable,
+		ReactionsTable,
 		RecentUpdatesTable,
 		RecentUpdateTranslationsTable,
 		ResearchProjectsTable,
 		ResearchProjectDetailsTable,
 		ResearchProjectDetailTranslationsTable,
 		ResearchProjectTranslationsTable,
+		SessionsTable,
+		SignatureNoncesTable,
 		SocialLinksTable,
+		CommentSpamScoresTable,
 		UsersTable,
 		UserIdentitiesTable,
 		WorkExperienceTable,
@@ -1614,6 +1852,12 @@ var (
 )
 
 func init() {
+	APIKeysTable.Annotation = &entsql.Annotation{
+		Table: "api_keys",
+	}
+	AuthEventsTable.Annotation = &entsql.Annotation{
+		Table: "auth_events",
+	}
 	AwardsTable.ForeignKeys[0].RefTable = UsersTable
 	AwardsTable.Annotation = &entsql.Annotation{
 		Table: "awards",
@@ -1623,6 +1867,9 @@ func init() {
 	AwardTranslationsTable.Annotation = &entsql.Annotation{
 		Table: "award_translations",
 	}
+	BansTable.Annotation = &entsql.Annotation{
+		Table: "bans",
+	}
 	BlogCategoriesTable.Annotation = &entsql.Annotation{
 		Table: "blog_categories",
 	}
@@ -1670,6 +1917,9 @@ func init() {
 	CommentLikesTable.Annotation = &entsql.Annotation{
 		Table: "comment_likes",
 	}
+	CommentMentionsTable.Annotation = &entsql.Annotation{
+		Table: "comment_mentions",
+	}
 	EducationTable.ForeignKeys[0].RefTable = UsersTable
 	EducationTable.Annotation = &entsql.Annotation{
 		Table: "education",
@@ -1709,6 +1959,11 @@ func init() {
 	IdeaTranslationsTable.Annotation = &entsql.Annotation{
 		Table: "idea_translations",
 	}
+	IdentityLinksTable.ForeignKeys[0].RefTable = UserIdentitiesTable
+	IdentityLinksTable.ForeignKeys[1].RefTable = UserIdentitiesTable
+	IdentityLinksTable.Annotation = &entsql.Annotation{
+		Table: "identity_links",
+	}
 	LanguagesTable.Annotation = &entsql.Annotation{
 		Table: "languages",
 	}
@@ -1780,6 +2035,9 @@ func init() {
 	PublicationTranslationsTable.Annotation = &entsql.Annotation{
 		Table: "publication_translations",
 	}
+	ReactionsTable.Annotation = &entsql.Annotation{
+		Table: "reactions",
+	}
 	RecentUpdatesTable.ForeignKeys[0].RefTable = UsersTable
 	RecentUpdatesTable.Annotation = &entsql.Annotation{
 		Table: "recent_updates",
@@ -1804,10 +2062,19 @@ func init() {
 	ResearchProjectTranslationsTable.Annotation = &entsql.Annotation{
 		Table: "research_project_translations",
 	}
+	SessionsTable.Annotation = &entsql.Annotation{
+		Table: "sessions",
+	}
+	SignatureNoncesTable.Annotation = &entsql.Annotation{
+		Table: "signature_nonces",
+	}
 	SocialLinksTable.ForeignKeys[0].RefTable = PersonalInfoTable
 	SocialLinksTable.Annotation = &entsql.Annotation{
 		Table: "social_links",
 	}
+	CommentSpamScoresTable.Annotation = &entsql.Annotation{
+		Table: "comment_spam_scores",
+	}
 	UsersTable.Annotation = &entsql.Annotation{
 		Table: "users",
 	}
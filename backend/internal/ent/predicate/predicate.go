@@ -6,12 +6,21 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// ApiKey is the predicate function for apikey builders.
+type ApiKey func(*sql.Selector)
+
+// AuthEvent is the predicate function for authevent builders.
+type AuthEvent func(*sql.Selector)
+
 // Award is the predicate function for award builders.
 type Award func(*sql.Selector)
 
 // AwardTranslation is the predicate function for awardtranslation builders.
 type AwardTranslation func(*sql.Selector)
 
+// Ban is the predicate function for ban builders.
+type Ban func(*sql.Selector)
+
 // BlogCategory is the predicate function for blogcategory builders.
 type BlogCategory func(*sql.Selector)
 
@@ -42,6 +51,9 @@ type Comment func(*sql.Selector)
 // CommentLike is the predicate function for commentlike builders.
 type CommentLike func(*sql.Selector)
 
+// CommentMention is the predicate function for commentmention builders.
+type CommentMention func(*sql.Selector)
+
 // Education is the predicate function for education builders.
 type Education func(*sql.Selector)
 
@@ -69,6 +81,9 @@ type IdeaTag func(*sql.Selector)
 // IdeaTranslation is the predicate function for ideatranslation builders.
 type IdeaTranslation func(*sql.Selector)
 
+// IdentityLink is the predicate function for identitylink builders.
+type IdentityLink func(*sql.Selector)
+
 // Language is the predicate function for language builders.
 type Language func(*sql.Selector)
 
@@ -117,6 +132,9 @@ type PublicationAuthor func(*sql.Selector)
 // PublicationTranslation is the predicate function for publicationtranslation builders.
 type PublicationTranslation func(*sql.Selector)
 
+// Reaction is the predicate function for reaction builders.
+type Reaction func(*sql.Selector)
+
 // RecentUpdate is the predicate function for recentupdate builders.
 type RecentUpdate func(*sql.Selector)
 
@@ -135,9 +153,18 @@ type ResearchProjectDetailTranslation func(*sql.Selector)
 // ResearchProjectTranslation is the predicate function for researchprojecttranslation builders.
 type ResearchProjectTranslation func(*sql.Selector)
 
+// Session is the predicate function for session builders.
+type Session func(*sql.Selector)
+
+// SignatureNonce is the predicate function for signaturenonce builders.
+type SignatureNonce func(*sql.Selector)
+
 // SocialLink is the predicate function for sociallink builders.
 type SocialLink func(*sql.Selector)
 
+// SpamScore is the predicate function for spamscore builders.
+type SpamScore func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
@@ -0,0 +1,170 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"silan-backend/internal/ent/reaction"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Reaction is the model entity for the Reaction schema.
+type Reaction struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// EntityType holds the value of the "entity_type" field.
+	EntityType string `json:"entity_type,omitempty"`
+	// EntityID holds the value of the "entity_id" field.
+	EntityID string `json:"entity_id,omitempty"`
+	// Emoji holds the value of the "emoji" field.
+	Emoji string `json:"emoji,omitempty"`
+	// UserIdentityID holds the value of the "user_identity_id" field.
+	UserIdentityID string `json:"user_identity_id,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// IP holds the value of the "ip" field.
+	IP string `json:"ip,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Reaction) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case reaction.FieldID, reaction.FieldEntityType, reaction.FieldEntityID, reaction.FieldEmoji, reaction.FieldUserIdentityID, reaction.FieldFingerprint, reaction.FieldIP:
+			values[i] = new(sql.NullString)
+		case reaction.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Reaction fields.
+func (r *Reaction) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case reaction.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				r.ID = value.String
+			}
+		case reaction.FieldEntityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_type", values[i])
+			} else if value.Valid {
+				r.EntityType = value.String
+			}
+		case reaction.FieldEntityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				r.EntityID = value.String
+			}
+		case reaction.FieldEmoji:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field emoji", values[i])
+			} else if value.Valid {
+				r.Emoji = value.String
+			}
+		case reaction.FieldUserIdentityID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field user_identity_id", values[i])
+			} else if value.Valid {
+				r.UserIdentityID = value.String
+			}
+		case reaction.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				r.Fingerprint = value.String
+			}
+		case reaction.FieldIP:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ip", values[i])
+			} else if value.Valid {
+				r.IP = value.String
+			}
+		case reaction.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				r.CreatedAt = value.Time
+			}
+		default:
+			r.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Reaction.
+// This includes values selected through modifiers, order, etc.
+func (r *Reaction) Value(name string) (ent.Value, error) {
+	return r.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Reaction.
+// Note that you need to call Reaction.Unwrap() before calling this method if this Reaction
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (r *Reaction) Update() *ReactionUpdateOne {
+	return NewReactionClient(r.config).UpdateOne(r)
+}
+
+// Unwrap unwraps the Reaction entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (r *Reaction) Unwrap() *Reaction {
+	_tx, ok := r.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Reaction is not a transactional entity")
+	}
+	r.config.driver = _tx.drv
+	return r
+}
+
+// String implements the fmt.Stringer.
+func (r *Reaction) String() string {
+	var builder strings.Builder
+	builder.WriteString("Reaction(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", r.ID))
+	builder.WriteString("entity_type=")
+	builder.WriteString(r.EntityType)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(r.EntityID)
+	builder.WriteString(", ")
+	builder.WriteString("emoji=")
+	builder.WriteString(r.Emoji)
+	builder.WriteString(", ")
+	builder.WriteString("user_identity_id=")
+	builder.WriteString(r.UserIdentityID)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(r.Fingerprint)
+	builder.WriteString(", ")
+	builder.WriteString("ip=")
+	builder.WriteString(r.IP)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(r.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Reactions is a parsable slice of Reaction.
+type Reactions []*Reaction
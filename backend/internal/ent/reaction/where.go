@@ -0,0 +1,545 @@
+// Code generated by ent, DO NOT EDIT.
+
+package reaction
+
+import (
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldID, id))
+}
+
+// EntityType applies equality check predicate on the "entity_type" field. It's identical to EntityTypeEQ.
+func EntityType(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityID applies equality check predicate on the "entity_id" field. It's identical to EntityIDEQ.
+func EntityID(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldEntityID, v))
+}
+
+// Emoji applies equality check predicate on the "emoji" field. It's identical to EmojiEQ.
+func Emoji(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldEmoji, v))
+}
+
+// UserIdentityID applies equality check predicate on the "user_identity_id" field. It's identical to UserIdentityIDEQ.
+func UserIdentityID(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldUserIdentityID, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// IP applies equality check predicate on the "ip" field. It's identical to IPEQ.
+func IP(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldIP, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EntityTypeEQ applies the EQ predicate on the "entity_type" field.
+func EntityTypeEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldEntityType, v))
+}
+
+// EntityTypeNEQ applies the NEQ predicate on the "entity_type" field.
+func EntityTypeNEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldEntityType, v))
+}
+
+// EntityTypeIn applies the In predicate on the "entity_type" field.
+func EntityTypeIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldEntityType, vs...))
+}
+
+// EntityTypeNotIn applies the NotIn predicate on the "entity_type" field.
+func EntityTypeNotIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldEntityType, vs...))
+}
+
+// EntityTypeGT applies the GT predicate on the "entity_type" field.
+func EntityTypeGT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldEntityType, v))
+}
+
+// EntityTypeGTE applies the GTE predicate on the "entity_type" field.
+func EntityTypeGTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldEntityType, v))
+}
+
+// EntityTypeLT applies the LT predicate on the "entity_type" field.
+func EntityTypeLT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldEntityType, v))
+}
+
+// EntityTypeLTE applies the LTE predicate on the "entity_type" field.
+func EntityTypeLTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldEntityType, v))
+}
+
+// EntityTypeContains applies the Contains predicate on the "entity_type" field.
+func EntityTypeContains(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContains(FieldEntityType, v))
+}
+
+// EntityTypeHasPrefix applies the HasPrefix predicate on the "entity_type" field.
+func EntityTypeHasPrefix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasPrefix(FieldEntityType, v))
+}
+
+// EntityTypeHasSuffix applies the HasSuffix predicate on the "entity_type" field.
+func EntityTypeHasSuffix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasSuffix(FieldEntityType, v))
+}
+
+// EntityTypeEqualFold applies the EqualFold predicate on the "entity_type" field.
+func EntityTypeEqualFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldEntityType, v))
+}
+
+// EntityTypeContainsFold applies the ContainsFold predicate on the "entity_type" field.
+func EntityTypeContainsFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldEntityType, v))
+}
+
+// EntityIDEQ applies the EQ predicate on the "entity_id" field.
+func EntityIDEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldEntityID, v))
+}
+
+// EntityIDNEQ applies the NEQ predicate on the "entity_id" field.
+func EntityIDNEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldEntityID, v))
+}
+
+// EntityIDIn applies the In predicate on the "entity_id" field.
+func EntityIDIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldEntityID, vs...))
+}
+
+// EntityIDNotIn applies the NotIn predicate on the "entity_id" field.
+func EntityIDNotIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldEntityID, vs...))
+}
+
+// EntityIDGT applies the GT predicate on the "entity_id" field.
+func EntityIDGT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldEntityID, v))
+}
+
+// EntityIDGTE applies the GTE predicate on the "entity_id" field.
+func EntityIDGTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldEntityID, v))
+}
+
+// EntityIDLT applies the LT predicate on the "entity_id" field.
+func EntityIDLT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldEntityID, v))
+}
+
+// EntityIDLTE applies the LTE predicate on the "entity_id" field.
+func EntityIDLTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldEntityID, v))
+}
+
+// EntityIDContains applies the Contains predicate on the "entity_id" field.
+func EntityIDContains(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContains(FieldEntityID, v))
+}
+
+// EntityIDHasPrefix applies the HasPrefix predicate on the "entity_id" field.
+func EntityIDHasPrefix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasPrefix(FieldEntityID, v))
+}
+
+// EntityIDHasSuffix applies the HasSuffix predicate on the "entity_id" field.
+func EntityIDHasSuffix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasSuffix(FieldEntityID, v))
+}
+
+// EntityIDEqualFold applies the EqualFold predicate on the "entity_id" field.
+func EntityIDEqualFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldEntityID, v))
+}
+
+// EntityIDContainsFold applies the ContainsFold predicate on the "entity_id" field.
+func EntityIDContainsFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldEntityID, v))
+}
+
+// EmojiEQ applies the EQ predicate on the "emoji" field.
+func EmojiEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldEmoji, v))
+}
+
+// EmojiNEQ applies the NEQ predicate on the "emoji" field.
+func EmojiNEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldEmoji, v))
+}
+
+// EmojiIn applies the In predicate on the "emoji" field.
+func EmojiIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldEmoji, vs...))
+}
+
+// EmojiNotIn applies the NotIn predicate on the "emoji" field.
+func EmojiNotIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldEmoji, vs...))
+}
+
+// EmojiGT applies the GT predicate on the "emoji" field.
+func EmojiGT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldEmoji, v))
+}
+
+// EmojiGTE applies the GTE predicate on the "emoji" field.
+func EmojiGTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldEmoji, v))
+}
+
+// EmojiLT applies the LT predicate on the "emoji" field.
+func EmojiLT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldEmoji, v))
+}
+
+// EmojiLTE applies the LTE predicate on the "emoji" field.
+func EmojiLTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldEmoji, v))
+}
+
+// EmojiContains applies the Contains predicate on the "emoji" field.
+func EmojiContains(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContains(FieldEmoji, v))
+}
+
+// EmojiHasPrefix applies the HasPrefix predicate on the "emoji" field.
+func EmojiHasPrefix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasPrefix(FieldEmoji, v))
+}
+
+// EmojiHasSuffix applies the HasSuffix predicate on the "emoji" field.
+func EmojiHasSuffix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasSuffix(FieldEmoji, v))
+}
+
+// EmojiEqualFold applies the EqualFold predicate on the "emoji" field.
+func EmojiEqualFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldEmoji, v))
+}
+
+// EmojiContainsFold applies the ContainsFold predicate on the "emoji" field.
+func EmojiContainsFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldEmoji, v))
+}
+
+// UserIdentityIDEQ applies the EQ predicate on the "user_identity_id" field.
+func UserIdentityIDEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDNEQ applies the NEQ predicate on the "user_identity_id" field.
+func UserIdentityIDNEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDIn applies the In predicate on the "user_identity_id" field.
+func UserIdentityIDIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldUserIdentityID, vs...))
+}
+
+// UserIdentityIDNotIn applies the NotIn predicate on the "user_identity_id" field.
+func UserIdentityIDNotIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldUserIdentityID, vs...))
+}
+
+// UserIdentityIDGT applies the GT predicate on the "user_identity_id" field.
+func UserIdentityIDGT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDGTE applies the GTE predicate on the "user_identity_id" field.
+func UserIdentityIDGTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDLT applies the LT predicate on the "user_identity_id" field.
+func UserIdentityIDLT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDLTE applies the LTE predicate on the "user_identity_id" field.
+func UserIdentityIDLTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDContains applies the Contains predicate on the "user_identity_id" field.
+func UserIdentityIDContains(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContains(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDHasPrefix applies the HasPrefix predicate on the "user_identity_id" field.
+func UserIdentityIDHasPrefix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasPrefix(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDHasSuffix applies the HasSuffix predicate on the "user_identity_id" field.
+func UserIdentityIDHasSuffix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasSuffix(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDEqualFold applies the EqualFold predicate on the "user_identity_id" field.
+func UserIdentityIDEqualFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldUserIdentityID, v))
+}
+
+// UserIdentityIDContainsFold applies the ContainsFold predicate on the "user_identity_id" field.
+func UserIdentityIDContainsFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldUserIdentityID, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintContains applies the Contains predicate on the "fingerprint" field.
+func FingerprintContains(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContains(FieldFingerprint, v))
+}
+
+// FingerprintHasPrefix applies the HasPrefix predicate on the "fingerprint" field.
+func FingerprintHasPrefix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasPrefix(FieldFingerprint, v))
+}
+
+// FingerprintHasSuffix applies the HasSuffix predicate on the "fingerprint" field.
+func FingerprintHasSuffix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasSuffix(FieldFingerprint, v))
+}
+
+// FingerprintEqualFold applies the EqualFold predicate on the "fingerprint" field.
+func FingerprintEqualFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldFingerprint, v))
+}
+
+// FingerprintContainsFold applies the ContainsFold predicate on the "fingerprint" field.
+func FingerprintContainsFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldFingerprint, v))
+}
+
+// IPEQ applies the EQ predicate on the "ip" field.
+func IPEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldIP, v))
+}
+
+// IPNEQ applies the NEQ predicate on the "ip" field.
+func IPNEQ(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldIP, v))
+}
+
+// IPIn applies the In predicate on the "ip" field.
+func IPIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldIP, vs...))
+}
+
+// IPNotIn applies the NotIn predicate on the "ip" field.
+func IPNotIn(vs ...string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldIP, vs...))
+}
+
+// IPGT applies the GT predicate on the "ip" field.
+func IPGT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldIP, v))
+}
+
+// IPGTE applies the GTE predicate on the "ip" field.
+func IPGTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldIP, v))
+}
+
+// IPLT applies the LT predicate on the "ip" field.
+func IPLT(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldIP, v))
+}
+
+// IPLTE applies the LTE predicate on the "ip" field.
+func IPLTE(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldIP, v))
+}
+
+// IPContains applies the Contains predicate on the "ip" field.
+func IPContains(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContains(FieldIP, v))
+}
+
+// IPHasPrefix applies the HasPrefix predicate on the "ip" field.
+func IPHasPrefix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasPrefix(FieldIP, v))
+}
+
+// IPHasSuffix applies the HasSuffix predicate on the "ip" field.
+func IPHasSuffix(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldHasSuffix(FieldIP, v))
+}
+
+// IPEqualFold applies the EqualFold predicate on the "ip" field.
+func IPEqualFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEqualFold(FieldIP, v))
+}
+
+// IPContainsFold applies the ContainsFold predicate on the "ip" field.
+func IPContainsFold(v string) predicate.Reaction {
+	return predicate.Reaction(sql.FieldContainsFold(FieldIP, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Reaction {
+	return predicate.Reaction(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Reaction) predicate.Reaction {
+	return predicate.Reaction(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Reaction) predicate.Reaction {
+	return predicate.Reaction(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Reaction) predicate.Reaction {
+	return predicate.Reaction(sql.NotPredicates(p))
+}
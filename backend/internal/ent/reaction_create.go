@@ -0,0 +1,362 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/reaction"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReactionCreate is the builder for creating a Reaction entity.
+type ReactionCreate struct {
+	config
+	mutation *ReactionMutation
+	hooks    []Hook
+}
+
+// SetEntityType sets the "entity_type" field.
+func (rc *ReactionCreate) SetEntityType(s string) *ReactionCreate {
+	rc.mutation.SetEntityType(s)
+	return rc
+}
+
+// SetEntityID sets the "entity_id" field.
+func (rc *ReactionCreate) SetEntityID(s string) *ReactionCreate {
+	rc.mutation.SetEntityID(s)
+	return rc
+}
+
+// SetEmoji sets the "emoji" field.
+func (rc *ReactionCreate) SetEmoji(s string) *ReactionCreate {
+	rc.mutation.SetEmoji(s)
+	return rc
+}
+
+// SetUserIdentityID sets the "user_identity_id" field.
+func (rc *ReactionCreate) SetUserIdentityID(s string) *ReactionCreate {
+	rc.mutation.SetUserIdentityID(s)
+	return rc
+}
+
+// SetNillableUserIdentityID sets the "user_identity_id" field if the given value is not nil.
+func (rc *ReactionCreate) SetNillableUserIdentityID(s *string) *ReactionCreate {
+	if s != nil {
+		rc.SetUserIdentityID(*s)
+	}
+	return rc
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (rc *ReactionCreate) SetFingerprint(s string) *ReactionCreate {
+	rc.mutation.SetFingerprint(s)
+	return rc
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (rc *ReactionCreate) SetNillableFingerprint(s *string) *ReactionCreate {
+	if s != nil {
+		rc.SetFingerprint(*s)
+	}
+	return rc
+}
+
+// SetIP sets the "ip" field.
+func (rc *ReactionCreate) SetIP(s string) *ReactionCreate {
+	rc.mutation.SetIP(s)
+	return rc
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (rc *ReactionCreate) SetNillableIP(s *string) *ReactionCreate {
+	if s != nil {
+		rc.SetIP(*s)
+	}
+	return rc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rc *ReactionCreate) SetCreatedAt(t time.Time) *ReactionCreate {
+	rc.mutation.SetCreatedAt(t)
+	return rc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rc *ReactionCreate) SetNillableCreatedAt(t *time.Time) *ReactionCreate {
+	if t != nil {
+		rc.SetCreatedAt(*t)
+	}
+	return rc
+}
+
+// SetID sets the "id" field.
+func (rc *ReactionCreate) SetID(s string) *ReactionCreate {
+	rc.mutation.SetID(s)
+	return rc
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (rc *ReactionCreate) Mutation() *ReactionMutation {
+	return rc.mutation
+}
+
+// Save creates the Reaction in the database.
+func (rc *ReactionCreate) Save(ctx context.Context) (*Reaction, error) {
+	rc.defaults()
+	return withHooks(ctx, rc.sqlSave, rc.mutation, rc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rc *ReactionCreate) SaveX(ctx context.Context) *Reaction {
+	v, err := rc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rc *ReactionCreate) Exec(ctx context.Context) error {
+	_, err := rc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rc *ReactionCreate) ExecX(ctx context.Context) {
+	if err := rc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rc *ReactionCreate) defaults() {
+	if _, ok := rc.mutation.UserIdentityID(); !ok {
+		v := reaction.DefaultUserIdentityID
+		rc.mutation.SetUserIdentityID(v)
+	}
+	if _, ok := rc.mutation.Fingerprint(); !ok {
+		v := reaction.DefaultFingerprint
+		rc.mutation.SetFingerprint(v)
+	}
+	if _, ok := rc.mutation.IP(); !ok {
+		v := reaction.DefaultIP
+		rc.mutation.SetIP(v)
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		v := reaction.DefaultCreatedAt()
+		rc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rc *ReactionCreate) check() error {
+	if _, ok := rc.mutation.EntityType(); !ok {
+		return &ValidationError{Name: "entity_type", err: errors.New(`ent: missing required field "Reaction.entity_type"`)}
+	}
+	if v, ok := rc.mutation.EntityType(); ok {
+		if err := reaction.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Reaction.entity_type": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "Reaction.entity_id"`)}
+	}
+	if v, ok := rc.mutation.EntityID(); ok {
+		if err := reaction.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "Reaction.entity_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Emoji(); !ok {
+		return &ValidationError{Name: "emoji", err: errors.New(`ent: missing required field "Reaction.emoji"`)}
+	}
+	if v, ok := rc.mutation.Emoji(); ok {
+		if err := reaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Reaction.emoji": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.UserIdentityID(); !ok {
+		return &ValidationError{Name: "user_identity_id", err: errors.New(`ent: missing required field "Reaction.user_identity_id"`)}
+	}
+	if v, ok := rc.mutation.UserIdentityID(); ok {
+		if err := reaction.UserIdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_identity_id", err: fmt.Errorf(`ent: validator failed for field "Reaction.user_identity_id": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.Fingerprint(); !ok {
+		return &ValidationError{Name: "fingerprint", err: errors.New(`ent: missing required field "Reaction.fingerprint"`)}
+	}
+	if v, ok := rc.mutation.Fingerprint(); ok {
+		if err := reaction.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "Reaction.fingerprint": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.IP(); !ok {
+		return &ValidationError{Name: "ip", err: errors.New(`ent: missing required field "Reaction.ip"`)}
+	}
+	if v, ok := rc.mutation.IP(); ok {
+		if err := reaction.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Reaction.ip": %w`, err)}
+		}
+	}
+	if _, ok := rc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Reaction.created_at"`)}
+	}
+	if v, ok := rc.mutation.ID(); ok {
+		if err := reaction.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Reaction.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (rc *ReactionCreate) sqlSave(ctx context.Context) (*Reaction, error) {
+	if err := rc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Reaction.ID type: %T", _spec.ID.Value)
+		}
+	}
+	rc.mutation.id = &_node.ID
+	rc.mutation.done = true
+	return _node, nil
+}
+
+func (rc *ReactionCreate) createSpec() (*Reaction, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Reaction{config: rc.config}
+		_spec = sqlgraph.NewCreateSpec(reaction.Table, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeString))
+	)
+	if id, ok := rc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := rc.mutation.EntityType(); ok {
+		_spec.SetField(reaction.FieldEntityType, field.TypeString, value)
+		_node.EntityType = value
+	}
+	if value, ok := rc.mutation.EntityID(); ok {
+		_spec.SetField(reaction.FieldEntityID, field.TypeString, value)
+		_node.EntityID = value
+	}
+	if value, ok := rc.mutation.Emoji(); ok {
+		_spec.SetField(reaction.FieldEmoji, field.TypeString, value)
+		_node.Emoji = value
+	}
+	if value, ok := rc.mutation.UserIdentityID(); ok {
+		_spec.SetField(reaction.FieldUserIdentityID, field.TypeString, value)
+		_node.UserIdentityID = value
+	}
+	if value, ok := rc.mutation.Fingerprint(); ok {
+		_spec.SetField(reaction.FieldFingerprint, field.TypeString, value)
+		_node.Fingerprint = value
+	}
+	if value, ok := rc.mutation.IP(); ok {
+		_spec.SetField(reaction.FieldIP, field.TypeString, value)
+		_node.IP = value
+	}
+	if value, ok := rc.mutation.CreatedAt(); ok {
+		_spec.SetField(reaction.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// ReactionCreateBulk is the builder for creating many Reaction entities in bulk.
+type ReactionCreateBulk struct {
+	config
+	err      error
+	builders []*ReactionCreate
+}
+
+// Save creates the Reaction entities in the database.
+func (rcb *ReactionCreateBulk) Save(ctx context.Context) ([]*Reaction, error) {
+	if rcb.err != nil {
+		return nil, rcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rcb.builders))
+	nodes := make([]*Reaction, len(rcb.builders))
+	mutators := make([]Mutator, len(rcb.builders))
+	for i := range rcb.builders {
+		func(i int, root context.Context) {
+			builder := rcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ReactionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rcb *ReactionCreateBulk) SaveX(ctx context.Context) []*Reaction {
+	v, err := rcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rcb *ReactionCreateBulk) Exec(ctx context.Context) error {
+	_, err := rcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rcb *ReactionCreateBulk) ExecX(ctx context.Context) {
+	if err := rcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
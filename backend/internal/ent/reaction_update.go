@@ -0,0 +1,455 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/reaction"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ReactionUpdate is the builder for updating Reaction entities.
+type ReactionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ReactionMutation
+}
+
+// Where appends a list predicates to the ReactionUpdate builder.
+func (ru *ReactionUpdate) Where(ps ...predicate.Reaction) *ReactionUpdate {
+	ru.mutation.Where(ps...)
+	return ru
+}
+
+// SetEntityType sets the "entity_type" field.
+func (ru *ReactionUpdate) SetEntityType(s string) *ReactionUpdate {
+	ru.mutation.SetEntityType(s)
+	return ru
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableEntityType(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetEntityType(*s)
+	}
+	return ru
+}
+
+// SetEntityID sets the "entity_id" field.
+func (ru *ReactionUpdate) SetEntityID(s string) *ReactionUpdate {
+	ru.mutation.SetEntityID(s)
+	return ru
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableEntityID(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetEntityID(*s)
+	}
+	return ru
+}
+
+// SetEmoji sets the "emoji" field.
+func (ru *ReactionUpdate) SetEmoji(s string) *ReactionUpdate {
+	ru.mutation.SetEmoji(s)
+	return ru
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableEmoji(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetEmoji(*s)
+	}
+	return ru
+}
+
+// SetUserIdentityID sets the "user_identity_id" field.
+func (ru *ReactionUpdate) SetUserIdentityID(s string) *ReactionUpdate {
+	ru.mutation.SetUserIdentityID(s)
+	return ru
+}
+
+// SetNillableUserIdentityID sets the "user_identity_id" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableUserIdentityID(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetUserIdentityID(*s)
+	}
+	return ru
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (ru *ReactionUpdate) SetFingerprint(s string) *ReactionUpdate {
+	ru.mutation.SetFingerprint(s)
+	return ru
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableFingerprint(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetFingerprint(*s)
+	}
+	return ru
+}
+
+// SetIP sets the "ip" field.
+func (ru *ReactionUpdate) SetIP(s string) *ReactionUpdate {
+	ru.mutation.SetIP(s)
+	return ru
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (ru *ReactionUpdate) SetNillableIP(s *string) *ReactionUpdate {
+	if s != nil {
+		ru.SetIP(*s)
+	}
+	return ru
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (ru *ReactionUpdate) Mutation() *ReactionMutation {
+	return ru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ru *ReactionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ru.sqlSave, ru.mutation, ru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ru *ReactionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ru *ReactionUpdate) Exec(ctx context.Context) error {
+	_, err := ru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ru *ReactionUpdate) ExecX(ctx context.Context) {
+	if err := ru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ru *ReactionUpdate) check() error {
+	if v, ok := ru.mutation.EntityType(); ok {
+		if err := reaction.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Reaction.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.EntityID(); ok {
+		if err := reaction.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "Reaction.entity_id": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Emoji(); ok {
+		if err := reaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Reaction.emoji": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.UserIdentityID(); ok {
+		if err := reaction.UserIdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_identity_id", err: fmt.Errorf(`ent: validator failed for field "Reaction.user_identity_id": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.Fingerprint(); ok {
+		if err := reaction.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "Reaction.fingerprint": %w`, err)}
+		}
+	}
+	if v, ok := ru.mutation.IP(); ok {
+		if err := reaction.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Reaction.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ru *ReactionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reaction.Table, reaction.Columns, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeString))
+	if ps := ru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ru.mutation.EntityType(); ok {
+		_spec.SetField(reaction.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.EntityID(); ok {
+		_spec.SetField(reaction.FieldEntityID, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Emoji(); ok {
+		_spec.SetField(reaction.FieldEmoji, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.UserIdentityID(); ok {
+		_spec.SetField(reaction.FieldUserIdentityID, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.Fingerprint(); ok {
+		_spec.SetField(reaction.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := ru.mutation.IP(); ok {
+		_spec.SetField(reaction.FieldIP, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ru.mutation.done = true
+	return n, nil
+}
+
+// ReactionUpdateOne is the builder for updating a single Reaction entity.
+type ReactionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ReactionMutation
+}
+
+// SetEntityType sets the "entity_type" field.
+func (ruo *ReactionUpdateOne) SetEntityType(s string) *ReactionUpdateOne {
+	ruo.mutation.SetEntityType(s)
+	return ruo
+}
+
+// SetNillableEntityType sets the "entity_type" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableEntityType(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetEntityType(*s)
+	}
+	return ruo
+}
+
+// SetEntityID sets the "entity_id" field.
+func (ruo *ReactionUpdateOne) SetEntityID(s string) *ReactionUpdateOne {
+	ruo.mutation.SetEntityID(s)
+	return ruo
+}
+
+// SetNillableEntityID sets the "entity_id" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableEntityID(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetEntityID(*s)
+	}
+	return ruo
+}
+
+// SetEmoji sets the "emoji" field.
+func (ruo *ReactionUpdateOne) SetEmoji(s string) *ReactionUpdateOne {
+	ruo.mutation.SetEmoji(s)
+	return ruo
+}
+
+// SetNillableEmoji sets the "emoji" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableEmoji(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetEmoji(*s)
+	}
+	return ruo
+}
+
+// SetUserIdentityID sets the "user_identity_id" field.
+func (ruo *ReactionUpdateOne) SetUserIdentityID(s string) *ReactionUpdateOne {
+	ruo.mutation.SetUserIdentityID(s)
+	return ruo
+}
+
+// SetNillableUserIdentityID sets the "user_identity_id" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableUserIdentityID(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetUserIdentityID(*s)
+	}
+	return ruo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (ruo *ReactionUpdateOne) SetFingerprint(s string) *ReactionUpdateOne {
+	ruo.mutation.SetFingerprint(s)
+	return ruo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableFingerprint(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetFingerprint(*s)
+	}
+	return ruo
+}
+
+// SetIP sets the "ip" field.
+func (ruo *ReactionUpdateOne) SetIP(s string) *ReactionUpdateOne {
+	ruo.mutation.SetIP(s)
+	return ruo
+}
+
+// SetNillableIP sets the "ip" field if the given value is not nil.
+func (ruo *ReactionUpdateOne) SetNillableIP(s *string) *ReactionUpdateOne {
+	if s != nil {
+		ruo.SetIP(*s)
+	}
+	return ruo
+}
+
+// Mutation returns the ReactionMutation object of the builder.
+func (ruo *ReactionUpdateOne) Mutation() *ReactionMutation {
+	return ruo.mutation
+}
+
+// Where appends a list predicates to the ReactionUpdate builder.
+func (ruo *ReactionUpdateOne) Where(ps ...predicate.Reaction) *ReactionUpdateOne {
+	ruo.mutation.Where(ps...)
+	return ruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ruo *ReactionUpdateOne) Select(field string, fields ...string) *ReactionUpdateOne {
+	ruo.fields = append([]string{field}, fields...)
+	return ruo
+}
+
+// Save executes the query and returns the updated Reaction entity.
+func (ruo *ReactionUpdateOne) Save(ctx context.Context) (*Reaction, error) {
+	return withHooks(ctx, ruo.sqlSave, ruo.mutation, ruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ruo *ReactionUpdateOne) SaveX(ctx context.Context) *Reaction {
+	node, err := ruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ruo *ReactionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ruo *ReactionUpdateOne) ExecX(ctx context.Context) {
+	if err := ruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ruo *ReactionUpdateOne) check() error {
+	if v, ok := ruo.mutation.EntityType(); ok {
+		if err := reaction.EntityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "entity_type", err: fmt.Errorf(`ent: validator failed for field "Reaction.entity_type": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.EntityID(); ok {
+		if err := reaction.EntityIDValidator(v); err != nil {
+			return &ValidationError{Name: "entity_id", err: fmt.Errorf(`ent: validator failed for field "Reaction.entity_id": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Emoji(); ok {
+		if err := reaction.EmojiValidator(v); err != nil {
+			return &ValidationError{Name: "emoji", err: fmt.Errorf(`ent: validator failed for field "Reaction.emoji": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.UserIdentityID(); ok {
+		if err := reaction.UserIdentityIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_identity_id", err: fmt.Errorf(`ent: validator failed for field "Reaction.user_identity_id": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.Fingerprint(); ok {
+		if err := reaction.FingerprintValidator(v); err != nil {
+			return &ValidationError{Name: "fingerprint", err: fmt.Errorf(`ent: validator failed for field "Reaction.fingerprint": %w`, err)}
+		}
+	}
+	if v, ok := ruo.mutation.IP(); ok {
+		if err := reaction.IPValidator(v); err != nil {
+			return &ValidationError{Name: "ip", err: fmt.Errorf(`ent: validator failed for field "Reaction.ip": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ruo *ReactionUpdateOne) sqlSave(ctx context.Context) (_node *Reaction, err error) {
+	if err := ruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(reaction.Table, reaction.Columns, sqlgraph.NewFieldSpec(reaction.FieldID, field.TypeString))
+	id, ok := ruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Reaction.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, reaction.FieldID)
+		for _, f := range fields {
+			if !reaction.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != reaction.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ruo.mutation.EntityType(); ok {
+		_spec.SetField(reaction.FieldEntityType, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.EntityID(); ok {
+		_spec.SetField(reaction.FieldEntityID, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Emoji(); ok {
+		_spec.SetField(reaction.FieldEmoji, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.UserIdentityID(); ok {
+		_spec.SetField(reaction.FieldUserIdentityID, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.Fingerprint(); ok {
+		_spec.SetField(reaction.FieldFingerprint, field.TypeString, value)
+	}
+	if value, ok := ruo.mutation.IP(); ok {
+		_spec.SetField(reaction.FieldIP, field.TypeString, value)
+	}
+	_node = &Reaction{config: ruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{reaction.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ruo.mutation.done = true
+	return _node, nil
+}
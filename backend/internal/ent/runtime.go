@@ -3,8 +3,11 @@
 package ent
 
 import (
+	"silan-backend/internal/ent/apikey"
+	"silan-backend/internal/ent/authevent"
 	"silan-backend/internal/ent/award"
 	"silan-backend/internal/ent/awardtranslation"
+	"silan-backend/internal/ent/ban"
 	"silan-backend/internal/ent/blogcategory"
 	"silan-backend/internal/ent/blogcategorytranslation"
 	"silan-backend/internal/ent/blogpost"
@@ -15,6 +18,7 @@ import (
 	"silan-backend/internal/ent/blogtag"
 	"silan-backend/internal/ent/comment"
 	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/commentmention"
 	"silan-backend/internal/ent/education"
 	"silan-backend/internal/ent/educationdetail"
 	"silan-backend/internal/ent/educationdetailtranslation"
@@ -24,6 +28,7 @@ import (
 	"silan-backend/internal/ent/ideadetailtranslation"
 	"silan-backend/internal/ent/ideatag"
 	"silan-backend/internal/ent/ideatranslation"
+	"silan-backend/internal/ent/identitylink"
 	"silan-backend/internal/ent/language"
 	"silan-backend/internal/ent/personalinfo"
 	"silan-backend/internal/ent/personalinfotranslation"
@@ -40,6 +45,7 @@ import (
 	"silan-backend/internal/ent/publication"
 	"silan-backend/internal/ent/publicationauthor"
 	"silan-backend/internal/ent/publicationtranslation"
+	"silan-backend/internal/ent/reaction"
 	"silan-backend/internal/ent/recentupdate"
 	"silan-backend/internal/ent/recentupdatetranslation"
 	"silan-backend/internal/ent/researchproject"
@@ -47,7 +53,10 @@ import (
 	"silan-backend/internal/ent/researchprojectdetailtranslation"
 	"silan-backend/internal/ent/researchprojecttranslation"
 	"silan-backend/internal/ent/schema"
+	"silan-backend/internal/ent/session"
+	"silan-backend/internal/ent/signaturenonce"
 	"silan-backend/internal/ent/sociallink"
+	"silan-backend/internal/ent/spamscore"
 	"silan-backend/internal/ent/user"
 	"silan-backend/internal/ent/useridentity"
 	"silan-backend/internal/ent/workexperience"
@@ -63,6 +72,104 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.ApiKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[1].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = func() func(string) error {
+		validators := apikeyDescName.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(name string) error {
+			for _, fn := range fns {
+				if err := fn(name); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// apikeyDescKeyPrefix is the schema descriptor for key_prefix field.
+	apikeyDescKeyPrefix := apikeyFields[2].Descriptor()
+	// apikey.KeyPrefixValidator is a validator for the "key_prefix" field. It is called by the builders before save.
+	apikey.KeyPrefixValidator = apikeyDescKeyPrefix.Validators[0].(func(string) error)
+	// apikeyDescKeyHash is the schema descriptor for key_hash field.
+	apikeyDescKeyHash := apikeyFields[3].Descriptor()
+	// apikey.KeyHashValidator is a validator for the "key_hash" field. It is called by the builders before save.
+	apikey.KeyHashValidator = apikeyDescKeyHash.Validators[0].(func(string) error)
+	// apikeyDescDailyQuota is the schema descriptor for daily_quota field.
+	apikeyDescDailyQuota := apikeyFields[4].Descriptor()
+	// apikey.DefaultDailyQuota holds the default value on creation for the daily_quota field.
+	apikey.DefaultDailyQuota = apikeyDescDailyQuota.Default.(int)
+	// apikeyDescMonthlyQuota is the schema descriptor for monthly_quota field.
+	apikeyDescMonthlyQuota := apikeyFields[5].Descriptor()
+	// apikey.DefaultMonthlyQuota holds the default value on creation for the monthly_quota field.
+	apikey.DefaultMonthlyQuota = apikeyDescMonthlyQuota.Default.(int)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[7].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	apikey.IDValidator = apikeyDescID.Validators[0].(func(string) error)
+	autheventFields := schema.AuthEvent{}.Fields()
+	_ = autheventFields
+	// autheventDescType is the schema descriptor for type field.
+	autheventDescType := autheventFields[1].Descriptor()
+	// authevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	authevent.TypeValidator = autheventDescType.Validators[0].(func(string) error)
+	// autheventDescProvider is the schema descriptor for provider field.
+	autheventDescProvider := autheventFields[2].Descriptor()
+	// authevent.DefaultProvider holds the default value on creation for the provider field.
+	authevent.DefaultProvider = autheventDescProvider.Default.(string)
+	// authevent.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	authevent.ProviderValidator = autheventDescProvider.Validators[0].(func(string) error)
+	// autheventDescIdentityID is the schema descriptor for identity_id field.
+	autheventDescIdentityID := autheventFields[3].Descriptor()
+	// authevent.DefaultIdentityID holds the default value on creation for the identity_id field.
+	authevent.DefaultIdentityID = autheventDescIdentityID.Default.(string)
+	// authevent.IdentityIDValidator is a validator for the "identity_id" field. It is called by the builders before save.
+	authevent.IdentityIDValidator = autheventDescIdentityID.Validators[0].(func(string) error)
+	// autheventDescEmail is the schema descriptor for email field.
+	autheventDescEmail := autheventFields[4].Descriptor()
+	// authevent.DefaultEmail holds the default value on creation for the email field.
+	authevent.DefaultEmail = autheventDescEmail.Default.(string)
+	// authevent.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	authevent.EmailValidator = autheventDescEmail.Validators[0].(func(string) error)
+	// autheventDescIP is the schema descriptor for ip field.
+	autheventDescIP := autheventFields[5].Descriptor()
+	// authevent.DefaultIP holds the default value on creation for the ip field.
+	authevent.DefaultIP = autheventDescIP.Default.(string)
+	// authevent.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	authevent.IPValidator = autheventDescIP.Validators[0].(func(string) error)
+	// autheventDescUserAgent is the schema descriptor for user_agent field.
+	autheventDescUserAgent := autheventFields[6].Descriptor()
+	// authevent.DefaultUserAgent holds the default value on creation for the user_agent field.
+	authevent.DefaultUserAgent = autheventDescUserAgent.Default.(string)
+	// authevent.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	authevent.UserAgentValidator = autheventDescUserAgent.Validators[0].(func(string) error)
+	// autheventDescOutcome is the schema descriptor for outcome field.
+	autheventDescOutcome := autheventFields[7].Descriptor()
+	// authevent.OutcomeValidator is a validator for the "outcome" field. It is called by the builders before save.
+	authevent.OutcomeValidator = autheventDescOutcome.Validators[0].(func(string) error)
+	// autheventDescReason is the schema descriptor for reason field.
+	autheventDescReason := autheventFields[8].Descriptor()
+	// authevent.DefaultReason holds the default value on creation for the reason field.
+	authevent.DefaultReason = autheventDescReason.Default.(string)
+	// authevent.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	authevent.ReasonValidator = autheventDescReason.Validators[0].(func(string) error)
+	// autheventDescCreatedAt is the schema descriptor for created_at field.
+	autheventDescCreatedAt := autheventFields[9].Descriptor()
+	// authevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authevent.DefaultCreatedAt = autheventDescCreatedAt.Default.(func() time.Time)
+	// autheventDescID is the schema descriptor for id field.
+	autheventDescID := autheventFields[0].Descriptor()
+	// authevent.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	authevent.IDValidator = autheventDescID.Validators[0].(func(string) error)
 	awardFields := schema.Award{}.Fields()
 	_ = awardFields
 	// awardDescTitle is the schema descriptor for title field.
@@ -181,6 +288,30 @@ func init() {
 	awardtranslationDescID := awardtranslationFields[0].Descriptor()
 	// awardtranslation.DefaultID holds the default value on creation for the id field.
 	awardtranslation.DefaultID = awardtranslationDescID.Default.(func() uuid.UUID)
+	banFields := schema.Ban{}.Fields()
+	_ = banFields
+	// banDescKind is the schema descriptor for kind field.
+	banDescKind := banFields[1].Descriptor()
+	// ban.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	ban.KindValidator = banDescKind.Validators[0].(func(string) error)
+	// banDescValue is the schema descriptor for value field.
+	banDescValue := banFields[2].Descriptor()
+	// ban.ValueValidator is a validator for the "value" field. It is called by the builders before save.
+	ban.ValueValidator = banDescValue.Validators[0].(func(string) error)
+	// banDescReason is the schema descriptor for reason field.
+	banDescReason := banFields[3].Descriptor()
+	// ban.DefaultReason holds the default value on creation for the reason field.
+	ban.DefaultReason = banDescReason.Default.(string)
+	// ban.ReasonValidator is a validator for the "reason" field. It is called by the builders before save.
+	ban.ReasonValidator = banDescReason.Validators[0].(func(string) error)
+	// banDescCreatedAt is the schema descriptor for created_at field.
+	banDescCreatedAt := banFields[4].Descriptor()
+	// ban.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ban.DefaultCreatedAt = banDescCreatedAt.Default.(func() time.Time)
+	// banDescID is the schema descriptor for id field.
+	banDescID := banFields[0].Descriptor()
+	// ban.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	ban.IDValidator = banDescID.Validators[0].(func(string) error)
 	blogcategoryFields := schema.BlogCategory{}.Fields()
 	_ = blogcategoryFields
 	// blogcategoryDescName is the schema descriptor for name field.
@@ -562,21 +693,7 @@ func init() {
 	// commentDescAuthorEmail is the schema descriptor for author_email field.
 	commentDescAuthorEmail := commentFields[5].Descriptor()
 	// comment.AuthorEmailValidator is a validator for the "author_email" field. It is called by the builders before save.
-	comment.AuthorEmailValidator = func() func(string) error {
-		validators := commentDescAuthorEmail.Validators
-		fns := [...]func(string) error{
-			validators[0].(func(string) error),
-			validators[1].(func(string) error),
-		}
-		return func(author_email string) error {
-			for _, fn := range fns {
-				if err := fn(author_email); err != nil {
-					return err
-				}
-			}
-			return nil
-		}
-	}()
+	comment.AuthorEmailValidator = commentDescAuthorEmail.Validators[0].(func(string) error)
 	// commentDescAuthorWebsite is the schema descriptor for author_website field.
 	commentDescAuthorWebsite := commentFields[6].Descriptor()
 	// comment.AuthorWebsiteValidator is a validator for the "author_website" field. It is called by the builders before save.
@@ -613,12 +730,34 @@ func init() {
 	commentDescLikesCount := commentFields[15].Descriptor()
 	// comment.DefaultLikesCount holds the default value on creation for the likes_count field.
 	comment.DefaultLikesCount = commentDescLikesCount.Default.(int)
+	// commentDescIsEdited is the schema descriptor for is_edited field.
+	commentDescIsEdited := commentFields[16].Descriptor()
+	// comment.DefaultIsEdited holds the default value on creation for the is_edited field.
+	comment.DefaultIsEdited = commentDescIsEdited.Default.(bool)
+	// commentDescEditCount is the schema descriptor for edit_count field.
+	commentDescEditCount := commentFields[18].Descriptor()
+	// comment.DefaultEditCount holds the default value on creation for the edit_count field.
+	comment.DefaultEditCount = commentDescEditCount.Default.(int)
+	// commentDescIsAuthor is the schema descriptor for is_author field.
+	commentDescIsAuthor := commentFields[19].Descriptor()
+	// comment.DefaultIsAuthor holds the default value on creation for the is_author field.
+	comment.DefaultIsAuthor = commentDescIsAuthor.Default.(bool)
+	// commentDescEmailVerified is the schema descriptor for email_verified field.
+	commentDescEmailVerified := commentFields[20].Descriptor()
+	// comment.DefaultEmailVerified holds the default value on creation for the email_verified field.
+	comment.DefaultEmailVerified = commentDescEmailVerified.Default.(bool)
+	// commentDescLanguage is the schema descriptor for language field.
+	commentDescLanguage := commentFields[21].Descriptor()
+	// comment.DefaultLanguage holds the default value on creation for the language field.
+	comment.DefaultLanguage = commentDescLanguage.Default.(string)
+	// comment.LanguageValidator is a validator for the "language" field. It is called by the builders before save.
+	comment.LanguageValidator = commentDescLanguage.Validators[0].(func(string) error)
 	// commentDescCreatedAt is the schema descriptor for created_at field.
-	commentDescCreatedAt := commentFields[16].Descriptor()
+	commentDescCreatedAt := commentFields[23].Descriptor()
 	// comment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	comment.DefaultCreatedAt = commentDescCreatedAt.Default.(func() time.Time)
 	// commentDescUpdatedAt is the schema descriptor for updated_at field.
-	commentDescUpdatedAt := commentFields[17].Descriptor()
+	commentDescUpdatedAt := commentFields[24].Descriptor()
 	// comment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	comment.DefaultUpdatedAt = commentDescUpdatedAt.Default.(func() time.Time)
 	// comment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -647,6 +786,30 @@ func init() {
 	commentlikeDescID := commentlikeFields[0].Descriptor()
 	// commentlike.DefaultID holds the default value on creation for the id field.
 	commentlike.DefaultID = commentlikeDescID.Default.(func() uuid.UUID)
+	commentmentionFields := schema.CommentMention{}.Fields()
+	_ = commentmentionFields
+	// commentmentionDescCommentID is the schema descriptor for comment_id field.
+	commentmentionDescCommentID := commentmentionFields[1].Descriptor()
+	// commentmention.CommentIDValidator is a validator for the "comment_id" field. It is called by the builders before save.
+	commentmention.CommentIDValidator = commentmentionDescCommentID.Validators[0].(func(string) error)
+	// commentmentionDescUserIdentityID is the schema descriptor for user_identity_id field.
+	commentmentionDescUserIdentityID := commentmentionFields[2].Descriptor()
+	// commentmention.UserIdentityIDValidator is a validator for the "user_identity_id" field. It is called by the builders before save.
+	commentmention.UserIdentityIDValidator = commentmentionDescUserIdentityID.Validators[0].(func(string) error)
+	// commentmentionDescDisplayName is the schema descriptor for display_name field.
+	commentmentionDescDisplayName := commentmentionFields[3].Descriptor()
+	// commentmention.DefaultDisplayName holds the default value on creation for the display_name field.
+	commentmention.DefaultDisplayName = commentmentionDescDisplayName.Default.(string)
+	// commentmention.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
+	commentmention.DisplayNameValidator = commentmentionDescDisplayName.Validators[0].(func(string) error)
+	// commentmentionDescCreatedAt is the schema descriptor for created_at field.
+	commentmentionDescCreatedAt := commentmentionFields[4].Descriptor()
+	// commentmention.DefaultCreatedAt holds the default value on creation for the created_at field.
+	commentmention.DefaultCreatedAt = commentmentionDescCreatedAt.Default.(func() time.Time)
+	// commentmentionDescID is the schema descriptor for id field.
+	commentmentionDescID := commentmentionFields[0].Descriptor()
+	// commentmention.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	commentmention.IDValidator = commentmentionDescID.Validators[0].(func(string) error)
 	educationFields := schema.Education{}.Fields()
 	_ = educationFields
 	// educationDescInstitution is the schema descriptor for institution field.
@@ -991,6 +1154,48 @@ func init() {
 	ideatranslationDescID := ideatranslationFields[0].Descriptor()
 	// ideatranslation.DefaultID holds the default value on creation for the id field.
 	ideatranslation.DefaultID = ideatranslationDescID.Default.(func() uuid.UUID)
+	identitylinkFields := schema.IdentityLink{}.Fields()
+	_ = identitylinkFields
+	// identitylinkDescIdentityID is the schema descriptor for identity_id field.
+	identitylinkDescIdentityID := identitylinkFields[0].Descriptor()
+	// identitylink.IdentityIDValidator is a validator for the "identity_id" field. It is called by the builders before save.
+	identitylink.IdentityIDValidator = func() func(string) error {
+		validators := identitylinkDescIdentityID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(identity string) error {
+			for _, fn := range fns {
+				if err := fn(identity); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// identitylinkDescPrimaryID is the schema descriptor for primary_id field.
+	identitylinkDescPrimaryID := identitylinkFields[1].Descriptor()
+	// identitylink.PrimaryIDValidator is a validator for the "primary_id" field. It is called by the builders before save.
+	identitylink.PrimaryIDValidator = func() func(string) error {
+		validators := identitylinkDescPrimaryID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(primary string) error {
+			for _, fn := range fns {
+				if err := fn(primary); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// identitylinkDescCreatedAt is the schema descriptor for created_at field.
+	identitylinkDescCreatedAt := identitylinkFields[2].Descriptor()
+	// identitylink.DefaultCreatedAt holds the default value on creation for the created_at field.
+	identitylink.DefaultCreatedAt = identitylinkDescCreatedAt.Default.(func() time.Time)
 	languageFields := schema.Language{}.Fields()
 	_ = languageFields
 	// languageDescName is the schema descriptor for name field.
@@ -1693,6 +1898,46 @@ func init() {
 	publicationtranslationDescID := publicationtranslationFields[0].Descriptor()
 	// publicationtranslation.DefaultID holds the default value on creation for the id field.
 	publicationtranslation.DefaultID = publicationtranslationDescID.Default.(func() uuid.UUID)
+	reactionFields := schema.Reaction{}.Fields()
+	_ = reactionFields
+	// reactionDescEntityType is the schema descriptor for entity_type field.
+	reactionDescEntityType := reactionFields[1].Descriptor()
+	// reaction.EntityTypeValidator is a validator for the "entity_type" field. It is called by the builders before save.
+	reaction.EntityTypeValidator = reactionDescEntityType.Validators[0].(func(string) error)
+	// reactionDescEntityID is the schema descriptor for entity_id field.
+	reactionDescEntityID := reactionFields[2].Descriptor()
+	// reaction.EntityIDValidator is a validator for the "entity_id" field. It is called by the builders before save.
+	reaction.EntityIDValidator = reactionDescEntityID.Validators[0].(func(string) error)
+	// reactionDescEmoji is the schema descriptor for emoji field.
+	reactionDescEmoji := reactionFields[3].Descriptor()
+	// reaction.EmojiValidator is a validator for the "emoji" field. It is called by the builders before save.
+	reaction.EmojiValidator = reactionDescEmoji.Validators[0].(func(string) error)
+	// reactionDescUserIdentityID is the schema descriptor for user_identity_id field.
+	reactionDescUserIdentityID := reactionFields[4].Descriptor()
+	// reaction.DefaultUserIdentityID holds the default value on creation for the user_identity_id field.
+	reaction.DefaultUserIdentityID = reactionDescUserIdentityID.Default.(string)
+	// reaction.UserIdentityIDValidator is a validator for the "user_identity_id" field. It is called by the builders before save.
+	reaction.UserIdentityIDValidator = reactionDescUserIdentityID.Validators[0].(func(string) error)
+	// reactionDescFingerprint is the schema descriptor for fingerprint field.
+	reactionDescFingerprint := reactionFields[5].Descriptor()
+	// reaction.DefaultFingerprint holds the default value on creation for the fingerprint field.
+	reaction.DefaultFingerprint = reactionDescFingerprint.Default.(string)
+	// reaction.FingerprintValidator is a validator for the "fingerprint" field. It is called by the builders before save.
+	reaction.FingerprintValidator = reactionDescFingerprint.Validators[0].(func(string) error)
+	// reactionDescIP is the schema descriptor for ip field.
+	reactionDescIP := reactionFields[6].Descriptor()
+	// reaction.DefaultIP holds the default value on creation for the ip field.
+	reaction.DefaultIP = reactionDescIP.Default.(string)
+	// reaction.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	reaction.IPValidator = reactionDescIP.Validators[0].(func(string) error)
+	// reactionDescCreatedAt is the schema descriptor for created_at field.
+	reactionDescCreatedAt := reactionFields[7].Descriptor()
+	// reaction.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reaction.DefaultCreatedAt = reactionDescCreatedAt.Default.(func() time.Time)
+	// reactionDescID is the schema descriptor for id field.
+	reactionDescID := reactionFields[0].Descriptor()
+	// reaction.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	reaction.IDValidator = reactionDescID.Validators[0].(func(string) error)
 	recentupdateFields := schema.RecentUpdate{}.Fields()
 	_ = recentupdateFields
 	// recentupdateDescTitle is the schema descriptor for title field.
@@ -1953,6 +2198,62 @@ func init() {
 	researchprojecttranslationDescID := researchprojecttranslationFields[0].Descriptor()
 	// researchprojecttranslation.DefaultID holds the default value on creation for the id field.
 	researchprojecttranslation.DefaultID = researchprojecttranslationDescID.Default.(func() uuid.UUID)
+	sessionFields := schema.Session{}.Fields()
+	_ = sessionFields
+	// sessionDescIdentityID is the schema descriptor for identity_id field.
+	sessionDescIdentityID := sessionFields[1].Descriptor()
+	// session.IdentityIDValidator is a validator for the "identity_id" field. It is called by the builders before save.
+	session.IdentityIDValidator = func() func(string) error {
+		validators := sessionDescIdentityID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(identity_id string) error {
+			for _, fn := range fns {
+				if err := fn(identity_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// sessionDescRefreshHash is the schema descriptor for refresh_hash field.
+	sessionDescRefreshHash := sessionFields[2].Descriptor()
+	// session.RefreshHashValidator is a validator for the "refresh_hash" field. It is called by the builders before save.
+	session.RefreshHashValidator = sessionDescRefreshHash.Validators[0].(func(string) error)
+	// sessionDescMethod is the schema descriptor for method field.
+	sessionDescMethod := sessionFields[3].Descriptor()
+	// session.DefaultMethod holds the default value on creation for the method field.
+	session.DefaultMethod = sessionDescMethod.Default.(string)
+	// session.MethodValidator is a validator for the "method" field. It is called by the builders before save.
+	session.MethodValidator = sessionDescMethod.Validators[0].(func(string) error)
+	// sessionDescUserAgent is the schema descriptor for user_agent field.
+	sessionDescUserAgent := sessionFields[4].Descriptor()
+	// session.UserAgentValidator is a validator for the "user_agent" field. It is called by the builders before save.
+	session.UserAgentValidator = sessionDescUserAgent.Validators[0].(func(string) error)
+	// sessionDescIP is the schema descriptor for ip field.
+	sessionDescIP := sessionFields[5].Descriptor()
+	// session.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	session.IPValidator = sessionDescIP.Validators[0].(func(string) error)
+	// sessionDescCreatedAt is the schema descriptor for created_at field.
+	sessionDescCreatedAt := sessionFields[6].Descriptor()
+	// session.DefaultCreatedAt holds the default value on creation for the created_at field.
+	session.DefaultCreatedAt = sessionDescCreatedAt.Default.(func() time.Time)
+	// sessionDescLastUsedAt is the schema descriptor for last_used_at field.
+	sessionDescLastUsedAt := sessionFields[7].Descriptor()
+	// session.DefaultLastUsedAt holds the default value on creation for the last_used_at field.
+	session.DefaultLastUsedAt = sessionDescLastUsedAt.Default.(func() time.Time)
+	// sessionDescID is the schema descriptor for id field.
+	sessionDescID := sessionFields[0].Descriptor()
+	// session.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	session.IDValidator = sessionDescID.Validators[0].(func(string) error)
+	signaturenonceFields := schema.SignatureNonce{}.Fields()
+	_ = signaturenonceFields
+	// signaturenonceDescID is the schema descriptor for id field.
+	signaturenonceDescID := signaturenonceFields[0].Descriptor()
+	// signaturenonce.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	signaturenonce.IDValidator = signaturenonceDescID.Validators[0].(func(string) error)
 	sociallinkFields := schema.SocialLink{}.Fields()
 	_ = sociallinkFields
 	// sociallinkDescPlatform is the schema descriptor for platform field.
@@ -2011,6 +2312,24 @@ func init() {
 	sociallinkDescID := sociallinkFields[0].Descriptor()
 	// sociallink.DefaultID holds the default value on creation for the id field.
 	sociallink.DefaultID = sociallinkDescID.Default.(func() uuid.UUID)
+	spamscoreFields := schema.SpamScore{}.Fields()
+	_ = spamscoreFields
+	// spamscoreDescProvider is the schema descriptor for provider field.
+	spamscoreDescProvider := spamscoreFields[1].Descriptor()
+	// spamscore.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	spamscore.ProviderValidator = spamscoreDescProvider.Validators[0].(func(string) error)
+	// spamscoreDescReasons is the schema descriptor for reasons field.
+	spamscoreDescReasons := spamscoreFields[3].Descriptor()
+	// spamscore.DefaultReasons holds the default value on creation for the reasons field.
+	spamscore.DefaultReasons = spamscoreDescReasons.Default.(string)
+	// spamscoreDescCreatedAt is the schema descriptor for created_at field.
+	spamscoreDescCreatedAt := spamscoreFields[4].Descriptor()
+	// spamscore.DefaultCreatedAt holds the default value on creation for the created_at field.
+	spamscore.DefaultCreatedAt = spamscoreDescCreatedAt.Default.(func() time.Time)
+	// spamscoreDescID is the schema descriptor for id field.
+	spamscoreDescID := spamscoreFields[0].Descriptor()
+	// spamscore.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	spamscore.IDValidator = spamscoreDescID.Validators[0].(func(string) error)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescUsername is the schema descriptor for username field.
@@ -2143,12 +2462,20 @@ func init() {
 	useridentityDescVerified := useridentityFields[6].Descriptor()
 	// useridentity.DefaultVerified holds the default value on creation for the verified field.
 	useridentity.DefaultVerified = useridentityDescVerified.Default.(bool)
+	// useridentityDescDisplayNameEdited is the schema descriptor for display_name_edited field.
+	useridentityDescDisplayNameEdited := useridentityFields[7].Descriptor()
+	// useridentity.DefaultDisplayNameEdited holds the default value on creation for the display_name_edited field.
+	useridentity.DefaultDisplayNameEdited = useridentityDescDisplayNameEdited.Default.(bool)
+	// useridentityDescAvatarURLEdited is the schema descriptor for avatar_url_edited field.
+	useridentityDescAvatarURLEdited := useridentityFields[8].Descriptor()
+	// useridentity.DefaultAvatarURLEdited holds the default value on creation for the avatar_url_edited field.
+	useridentity.DefaultAvatarURLEdited = useridentityDescAvatarURLEdited.Default.(bool)
 	// useridentityDescCreatedAt is the schema descriptor for created_at field.
-	useridentityDescCreatedAt := useridentityFields[7].Descriptor()
+	useridentityDescCreatedAt := useridentityFields[10].Descriptor()
 	// useridentity.DefaultCreatedAt holds the default value on creation for the created_at field.
 	useridentity.DefaultCreatedAt = useridentityDescCreatedAt.Default.(func() time.Time)
 	// useridentityDescUpdatedAt is the schema descriptor for updated_at field.
-	useridentityDescUpdatedAt := useridentityFields[8].Descriptor()
+	useridentityDescUpdatedAt := useridentityFields[11].Descriptor()
 	// useridentity.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	useridentity.DefaultUpdatedAt = useridentityDescUpdatedAt.Default.(func() time.Time)
 	// useridentity.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
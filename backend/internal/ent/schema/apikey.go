@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ApiKey is a key for the admin and embed APIs. The plaintext key is never
+// stored, only its SHA-256 hash and a short prefix to recognise it by.
+type ApiKey struct {
+	ent.Schema
+}
+
+func (ApiKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "api_keys"},
+	}
+}
+
+func (ApiKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).Immutable(),
+		field.String("name").MaxLen(100).NotEmpty(),
+		field.String("key_prefix").MaxLen(16),
+		field.String("key_hash").MaxLen(64).Unique().Immutable(),
+		field.Int("daily_quota").Default(0).
+			Comment("Requests allowed per UTC day; 0 is unlimited"),
+		field.Int("monthly_quota").Default(0).
+			Comment("Requests allowed per calendar month; 0 is unlimited"),
+		field.Strings("scopes").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("last_used_at").Optional().Nillable(),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
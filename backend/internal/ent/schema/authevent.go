@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuthEvent is one authentication attempt: an identity verification, login,
+// session refresh or logout, whether it succeeded or not. IdentityID and
+// Email are empty when the attempt failed before the user was known.
+type AuthEvent struct {
+	ent.Schema
+}
+
+func (AuthEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "auth_events"},
+	}
+}
+
+func (AuthEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).Immutable(),
+		field.String("type").MaxLen(16),
+		field.String("provider").MaxLen(32).Default(""),
+		field.String("identity_id").MaxLen(64).Default(""),
+		field.String("email").MaxLen(255).Default(""),
+		field.String("ip").MaxLen(45).Default(""),
+		field.String("user_agent").MaxLen(500).Default(""),
+		field.String("outcome").MaxLen(16),
+		field.String("reason").MaxLen(255).Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (AuthEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").StorageKey("idx_auth_events_created"),
+		index.Fields("identity_id", "created_at").StorageKey("idx_auth_events_identity"),
+	}
+}
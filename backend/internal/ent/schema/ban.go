@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Ban keeps a visitor from commenting, liking and voting. It matches one
+// identity, email address, fingerprint hash or IP address or network, see
+// package ban.
+type Ban struct {
+	ent.Schema
+}
+
+func (Ban) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "bans"},
+	}
+}
+
+func (Ban) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).Immutable(),
+		field.String("kind").MaxLen(16),
+		field.String("value").MaxLen(255),
+		field.String("reason").MaxLen(500).Default(""),
+		// Banning a value again replaces the ban and its created_at
+		field.Time("created_at").Default(time.Now),
+		// Nil for permanent bans
+		field.Time("expires_at").Optional().Nillable(),
+	}
+}
+
+func (Ban) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("kind", "value").Unique().StorageKey("idx_bans_kind_value"),
+	}
+}
@@ -27,7 +27,7 @@ func (Comment) Annotations() []schema.Annotation {
 func (Comment) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(newUUIDv7).
 			StorageKey("id"),
 		field.String("entity_type").
 			Comment("Type of entity: 'blog' or 'idea'"),
@@ -42,7 +42,7 @@ func (Comment) Fields() []ent.Field {
 			NotEmpty(),
 		field.String("author_email").
 			MaxLen(255).
-			NotEmpty(),
+			Comment("Blanked once the comment is deleted"),
 		field.String("author_website").
 			Optional().
 			MaxLen(500),
@@ -71,6 +71,29 @@ func (Comment) Fields() []ent.Field {
 		field.Int("likes_count").
 			Default(0).
 			Comment("Number of likes for this comment"),
+		field.Bool("is_edited").
+			Default(false).
+			Comment("Whether the author changed the content after posting"),
+		field.Time("edited_at").
+			Optional().
+			Nillable().
+			Comment("When the author last edited the content; updated_at also changes with likes and moderation"),
+		field.Int("edit_count").
+			Default(0),
+		field.Bool("is_author").
+			Default(false).
+			Comment("Whether the site owner wrote the comment, decided when it is created"),
+		field.Bool("email_verified").
+			Default(false).
+			Comment("Whether the author confirmed author_email with an emailed code"),
+		field.String("language").
+			MaxLen(8).
+			Default("").
+			Comment("Language detected from the content, see commentlang.Detect; empty for comments from before it was stored"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("When the author deleted the comment; it stays, blanked, while others' replies need it"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -28,7 +28,7 @@ func (CommentLike) Annotations() []schema.Annotation {
 func (CommentLike) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(newUUIDv7).
 			StorageKey("id"),
 		field.UUID("comment_id", uuid.UUID{}).
 			StorageKey("comment_id").
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// CommentMention is an @mention of a user identity in a comment. NotifiedAt
+// is set once the identity was emailed about it.
+type CommentMention struct {
+	ent.Schema
+}
+
+func (CommentMention) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "comment_mentions"},
+	}
+}
+
+func (CommentMention) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).Immutable(),
+		field.String("comment_id").MaxLen(36),
+		field.String("user_identity_id").MaxLen(255),
+		field.String("display_name").MaxLen(255).Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("notified_at").Optional().Nillable(),
+	}
+}
+
+func (CommentMention) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("comment_id", "user_identity_id").Unique().StorageKey("uniq_comment_mentions"),
+		index.Fields("user_identity_id", "created_at").StorageKey("idx_comment_mentions_identity"),
+	}
+}
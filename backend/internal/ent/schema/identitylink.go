@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// IdentityLink makes a user identity part of the account of a primary
+// identity, such as a GitHub sign-in with the same verified email as an
+// earlier Google one. Identities without a link are their own primary.
+type IdentityLink struct {
+	ent.Schema
+}
+
+func (IdentityLink) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "identity_links"},
+		field.ID("identity_id", "primary_id"),
+	}
+}
+
+func (IdentityLink) Fields() []ent.Field {
+	return []ent.Field{
+		// The linked identity
+		field.String("identity_id").MaxLen(64).NotEmpty().Immutable(),
+		field.String("primary_id").MaxLen(64).NotEmpty().Immutable(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (IdentityLink) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("identity", UserIdentity.Type).
+			Field("identity_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("primary", UserIdentity.Type).
+			Field("primary_id").
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (IdentityLink) Indexes() []ent.Index {
+	return []ent.Index{
+		// An identity has at most one primary
+		index.Fields("identity_id").Unique().StorageKey("idx_identity_links_identity"),
+		index.Fields("primary_id").StorageKey("idx_identity_links_primary"),
+	}
+}
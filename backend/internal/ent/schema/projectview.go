@@ -28,7 +28,7 @@ func (ProjectView) Annotations() []schema.Annotation {
 func (ProjectView) Fields() []ent.Field {
 	return []ent.Field{
 		field.UUID("id", uuid.UUID{}).
-			Default(uuid.New).
+			Default(newUUIDv7).
 			StorageKey("id"),
 		field.UUID("project_id", uuid.UUID{}).
 			StorageKey("project_id").
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Reaction is an emoji reaction on a comment, blog post or project. A
+// visitor may react with several emoji to the same entity; signed-in
+// visitors are stored by identity, anonymous ones by fingerprint hash.
+type Reaction struct {
+	ent.Schema
+}
+
+func (Reaction) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "reactions"},
+	}
+}
+
+func (Reaction) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).Immutable(),
+		field.String("entity_type").MaxLen(16),
+		field.String("entity_id").MaxLen(36),
+		field.String("emoji").MaxLen(16),
+		field.String("user_identity_id").MaxLen(255).Default(""),
+		field.String("fingerprint").MaxLen(255).Default(""),
+		field.String("ip").MaxLen(64).Default(""),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (Reaction) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("entity_type", "entity_id").StorageKey("idx_reactions_entity"),
+	}
+}
@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Session is a sign-in of a user identity. The refresh token that renews its
+// access tokens is only stored as a SHA-256 hash.
+type Session struct {
+	ent.Schema
+}
+
+func (Session) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "sessions"},
+	}
+}
+
+func (Session) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).Immutable(),
+		field.String("identity_id").MaxLen(64).NotEmpty(),
+		field.String("refresh_hash").MaxLen(64).Unique(),
+		// method is how the visitor signed in: an email code, a verified
+		// Google ID token or the name of an OAuth provider
+		field.String("method").MaxLen(32).Default(""),
+		field.String("user_agent").MaxLen(512).Optional(),
+		field.String("ip").MaxLen(64).Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+		field.Time("last_used_at").Default(time.Now),
+		field.Time("expires_at"),
+		field.Time("revoked_at").Optional().Nillable(),
+	}
+}
+
+func (Session) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("identity_id").StorageKey("idx_sessions_identity"),
+	}
+}
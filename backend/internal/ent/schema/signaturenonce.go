@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SignatureNonce is the signature of a signed admin request, kept until its
+// timestamp leaves the tolerance window so the request can't be replayed.
+type SignatureNonce struct {
+	ent.Schema
+}
+
+func (SignatureNonce) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "signature_nonces"},
+	}
+}
+
+func (SignatureNonce) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(128).StorageKey("signature").Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+func (SignatureNonce) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at").StorageKey("idx_signature_nonces_expires"),
+	}
+}
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamScore is the verdict the spam checker gave a comment or AMA question,
+// keyed by the ID of what was scored. Reasons are newline separated.
+type SpamScore struct {
+	ent.Schema
+}
+
+func (SpamScore) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "comment_spam_scores"},
+	}
+}
+
+func (SpamScore) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").MaxLen(36).StorageKey("comment_id").Immutable(),
+		field.String("provider").MaxLen(32),
+		field.Float("score"),
+		field.Text("reasons").Default(""),
+		field.Time("created_at").Default(time.Now),
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 )
@@ -30,11 +31,30 @@ func (UserIdentity) Fields() []ent.Field {
 		field.String("display_name").Optional(),
 		field.String("avatar_url").Optional(),
 		field.Bool("verified").Default(false),
+		// Profile fields the user edited themselves; sign-ins leave them alone
+		field.Bool("display_name_edited").Default(false),
+		field.Bool("avatar_url_edited").Default(false),
+		// The display name the provider last gave while display_name was
+		// edited, brought back when the user resets theirs
+		field.String("provider_display_name").Optional(),
 		field.Time("created_at").Default(time.Now).Immutable(),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
 }
 
+func (UserIdentity) Edges() []ent.Edge {
+	return []ent.Edge{
+		// The primary identity this one is linked to, see IdentityLink, and
+		// the identities linked to this one. Deleting either side of a link
+		// deletes the link.
+		edge.To("primaries", UserIdentity.Type).
+			StorageKey(edge.Columns("identity_id", "primary_id")).
+			Through("links", IdentityLink.Type),
+		edge.From("linked_identities", UserIdentity.Type).
+			Ref("primaries"),
+	}
+}
+
 func (UserIdentity) Indexes() []ent.Index {
 	return []ent.Index{
 		// Unique identity per provider
@@ -0,0 +1,15 @@
+package schema
+
+import "github.com/google/uuid"
+
+// newUUIDv7 generates time-ordered IDs for high-volume tables (comments,
+// likes, views) so new rows are appended to the primary key index instead of
+// landing at random positions. Existing v4 IDs are still valid UUIDs and keep
+// parsing as before.
+func newUUIDv7() uuid.UUID {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return uuid.New()
+	}
+	return id
+}
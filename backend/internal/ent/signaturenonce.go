@@ -0,0 +1,104 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"silan-backend/internal/ent/signaturenonce"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SignatureNonce is the model entity for the SignatureNonce schema.
+type SignatureNonce struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt    time.Time `json:"expires_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SignatureNonce) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signaturenonce.FieldID:
+			values[i] = new(sql.NullString)
+		case signaturenonce.FieldExpiresAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SignatureNonce fields.
+func (sn *SignatureNonce) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signaturenonce.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				sn.ID = value.String
+			}
+		case signaturenonce.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				sn.ExpiresAt = value.Time
+			}
+		default:
+			sn.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SignatureNonce.
+// This includes values selected through modifiers, order, etc.
+func (sn *SignatureNonce) Value(name string) (ent.Value, error) {
+	return sn.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SignatureNonce.
+// Note that you need to call SignatureNonce.Unwrap() before calling this method if this SignatureNonce
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sn *SignatureNonce) Update() *SignatureNonceUpdateOne {
+	return NewSignatureNonceClient(sn.config).UpdateOne(sn)
+}
+
+// Unwrap unwraps the SignatureNonce entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sn *SignatureNonce) Unwrap() *SignatureNonce {
+	_tx, ok := sn.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SignatureNonce is not a transactional entity")
+	}
+	sn.config.driver = _tx.drv
+	return sn
+}
+
+// String implements the fmt.Stringer.
+func (sn *SignatureNonce) String() string {
+	var builder strings.Builder
+	builder.WriteString("SignatureNonce(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sn.ID))
+	builder.WriteString("expires_at=")
+	builder.WriteString(sn.ExpiresAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SignatureNonces is a parsable slice of SignatureNonce.
+type SignatureNonces []*SignatureNonce
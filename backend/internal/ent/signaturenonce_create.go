@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/signaturenonce"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SignatureNonceCreate is the builder for creating a SignatureNonce entity.
+type SignatureNonceCreate struct {
+	config
+	mutation *SignatureNonceMutation
+	hooks    []Hook
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (snc *SignatureNonceCreate) SetExpiresAt(t time.Time) *SignatureNonceCreate {
+	snc.mutation.SetExpiresAt(t)
+	return snc
+}
+
+// SetID sets the "id" field.
+func (snc *SignatureNonceCreate) SetID(s string) *SignatureNonceCreate {
+	snc.mutation.SetID(s)
+	return snc
+}
+
+// Mutation returns the SignatureNonceMutation object of the builder.
+func (snc *SignatureNonceCreate) Mutation() *SignatureNonceMutation {
+	return snc.mutation
+}
+
+// Save creates the SignatureNonce in the database.
+func (snc *SignatureNonceCreate) Save(ctx context.Context) (*SignatureNonce, error) {
+	return withHooks(ctx, snc.sqlSave, snc.mutation, snc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (snc *SignatureNonceCreate) SaveX(ctx context.Context) *SignatureNonce {
+	v, err := snc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (snc *SignatureNonceCreate) Exec(ctx context.Context) error {
+	_, err := snc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snc *SignatureNonceCreate) ExecX(ctx context.Context) {
+	if err := snc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (snc *SignatureNonceCreate) check() error {
+	if _, ok := snc.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "SignatureNonce.expires_at"`)}
+	}
+	if v, ok := snc.mutation.ID(); ok {
+		if err := signaturenonce.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SignatureNonce.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (snc *SignatureNonceCreate) sqlSave(ctx context.Context) (*SignatureNonce, error) {
+	if err := snc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := snc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, snc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SignatureNonce.ID type: %T", _spec.ID.Value)
+		}
+	}
+	snc.mutation.id = &_node.ID
+	snc.mutation.done = true
+	return _node, nil
+}
+
+func (snc *SignatureNonceCreate) createSpec() (*SignatureNonce, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SignatureNonce{config: snc.config}
+		_spec = sqlgraph.NewCreateSpec(signaturenonce.Table, sqlgraph.NewFieldSpec(signaturenonce.FieldID, field.TypeString))
+	)
+	if id, ok := snc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := snc.mutation.ExpiresAt(); ok {
+		_spec.SetField(signaturenonce.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	return _node, _spec
+}
+
+// SignatureNonceCreateBulk is the builder for creating many SignatureNonce entities in bulk.
+type SignatureNonceCreateBulk struct {
+	config
+	err      error
+	builders []*SignatureNonceCreate
+}
+
+// Save creates the SignatureNonce entities in the database.
+func (sncb *SignatureNonceCreateBulk) Save(ctx context.Context) ([]*SignatureNonce, error) {
+	if sncb.err != nil {
+		return nil, sncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sncb.builders))
+	nodes := make([]*SignatureNonce, len(sncb.builders))
+	mutators := make([]Mutator, len(sncb.builders))
+	for i := range sncb.builders {
+		func(i int, root context.Context) {
+			builder := sncb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SignatureNonceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sncb *SignatureNonceCreateBulk) SaveX(ctx context.Context) []*SignatureNonce {
+	v, err := sncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sncb *SignatureNonceCreateBulk) Exec(ctx context.Context) error {
+	_, err := sncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sncb *SignatureNonceCreateBulk) ExecX(ctx context.Context) {
+	if err := sncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
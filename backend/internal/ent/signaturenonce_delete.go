@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/signaturenonce"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SignatureNonceDelete is the builder for deleting a SignatureNonce entity.
+type SignatureNonceDelete struct {
+	config
+	hooks    []Hook
+	mutation *SignatureNonceMutation
+}
+
+// Where appends a list predicates to the SignatureNonceDelete builder.
+func (snd *SignatureNonceDelete) Where(ps ...predicate.SignatureNonce) *SignatureNonceDelete {
+	snd.mutation.Where(ps...)
+	return snd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (snd *SignatureNonceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, snd.sqlExec, snd.mutation, snd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snd *SignatureNonceDelete) ExecX(ctx context.Context) int {
+	n, err := snd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (snd *SignatureNonceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(signaturenonce.Table, sqlgraph.NewFieldSpec(signaturenonce.FieldID, field.TypeString))
+	if ps := snd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, snd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	snd.mutation.done = true
+	return affected, err
+}
+
+// SignatureNonceDeleteOne is the builder for deleting a single SignatureNonce entity.
+type SignatureNonceDeleteOne struct {
+	snd *SignatureNonceDelete
+}
+
+// Where appends a list predicates to the SignatureNonceDelete builder.
+func (sndo *SignatureNonceDeleteOne) Where(ps ...predicate.SignatureNonce) *SignatureNonceDeleteOne {
+	sndo.snd.mutation.Where(ps...)
+	return sndo
+}
+
+// Exec executes the deletion query.
+func (sndo *SignatureNonceDeleteOne) Exec(ctx context.Context) error {
+	n, err := sndo.snd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{signaturenonce.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sndo *SignatureNonceDeleteOne) ExecX(ctx context.Context) {
+	if err := sndo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
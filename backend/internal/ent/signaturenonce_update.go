@@ -0,0 +1,210 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/signaturenonce"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SignatureNonceUpdate is the builder for updating SignatureNonce entities.
+type SignatureNonceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SignatureNonceMutation
+}
+
+// Where appends a list predicates to the SignatureNonceUpdate builder.
+func (snu *SignatureNonceUpdate) Where(ps ...predicate.SignatureNonce) *SignatureNonceUpdate {
+	snu.mutation.Where(ps...)
+	return snu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (snu *SignatureNonceUpdate) SetExpiresAt(t time.Time) *SignatureNonceUpdate {
+	snu.mutation.SetExpiresAt(t)
+	return snu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (snu *SignatureNonceUpdate) SetNillableExpiresAt(t *time.Time) *SignatureNonceUpdate {
+	if t != nil {
+		snu.SetExpiresAt(*t)
+	}
+	return snu
+}
+
+// Mutation returns the SignatureNonceMutation object of the builder.
+func (snu *SignatureNonceUpdate) Mutation() *SignatureNonceMutation {
+	return snu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (snu *SignatureNonceUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, snu.sqlSave, snu.mutation, snu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (snu *SignatureNonceUpdate) SaveX(ctx context.Context) int {
+	affected, err := snu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (snu *SignatureNonceUpdate) Exec(ctx context.Context) error {
+	_, err := snu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snu *SignatureNonceUpdate) ExecX(ctx context.Context) {
+	if err := snu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (snu *SignatureNonceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signaturenonce.Table, signaturenonce.Columns, sqlgraph.NewFieldSpec(signaturenonce.FieldID, field.TypeString))
+	if ps := snu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := snu.mutation.ExpiresAt(); ok {
+		_spec.SetField(signaturenonce.FieldExpiresAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, snu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signaturenonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	snu.mutation.done = true
+	return n, nil
+}
+
+// SignatureNonceUpdateOne is the builder for updating a single SignatureNonce entity.
+type SignatureNonceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SignatureNonceMutation
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (snuo *SignatureNonceUpdateOne) SetExpiresAt(t time.Time) *SignatureNonceUpdateOne {
+	snuo.mutation.SetExpiresAt(t)
+	return snuo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (snuo *SignatureNonceUpdateOne) SetNillableExpiresAt(t *time.Time) *SignatureNonceUpdateOne {
+	if t != nil {
+		snuo.SetExpiresAt(*t)
+	}
+	return snuo
+}
+
+// Mutation returns the SignatureNonceMutation object of the builder.
+func (snuo *SignatureNonceUpdateOne) Mutation() *SignatureNonceMutation {
+	return snuo.mutation
+}
+
+// Where appends a list predicates to the SignatureNonceUpdate builder.
+func (snuo *SignatureNonceUpdateOne) Where(ps ...predicate.SignatureNonce) *SignatureNonceUpdateOne {
+	snuo.mutation.Where(ps...)
+	return snuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (snuo *SignatureNonceUpdateOne) Select(field string, fields ...string) *SignatureNonceUpdateOne {
+	snuo.fields = append([]string{field}, fields...)
+	return snuo
+}
+
+// Save executes the query and returns the updated SignatureNonce entity.
+func (snuo *SignatureNonceUpdateOne) Save(ctx context.Context) (*SignatureNonce, error) {
+	return withHooks(ctx, snuo.sqlSave, snuo.mutation, snuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (snuo *SignatureNonceUpdateOne) SaveX(ctx context.Context) *SignatureNonce {
+	node, err := snuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (snuo *SignatureNonceUpdateOne) Exec(ctx context.Context) error {
+	_, err := snuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (snuo *SignatureNonceUpdateOne) ExecX(ctx context.Context) {
+	if err := snuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (snuo *SignatureNonceUpdateOne) sqlSave(ctx context.Context) (_node *SignatureNonce, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signaturenonce.Table, signaturenonce.Columns, sqlgraph.NewFieldSpec(signaturenonce.FieldID, field.TypeString))
+	id, ok := snuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SignatureNonce.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := snuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signaturenonce.FieldID)
+		for _, f := range fields {
+			if !signaturenonce.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signaturenonce.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := snuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := snuo.mutation.ExpiresAt(); ok {
+		_spec.SetField(signaturenonce.FieldExpiresAt, field.TypeTime, value)
+	}
+	_node = &SignatureNonce{config: snuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, snuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signaturenonce.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	snuo.mutation.done = true
+	return _node, nil
+}
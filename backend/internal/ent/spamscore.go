@@ -0,0 +1,139 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"silan-backend/internal/ent/spamscore"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SpamScore is the model entity for the SpamScore schema.
+type SpamScore struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Score holds the value of the "score" field.
+	Score float64 `json:"score,omitempty"`
+	// Reasons holds the value of the "reasons" field.
+	Reasons string `json:"reasons,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SpamScore) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case spamscore.FieldScore:
+			values[i] = new(sql.NullFloat64)
+		case spamscore.FieldID, spamscore.FieldProvider, spamscore.FieldReasons:
+			values[i] = new(sql.NullString)
+		case spamscore.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SpamScore fields.
+func (ss *SpamScore) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case spamscore.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				ss.ID = value.String
+			}
+		case spamscore.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				ss.Provider = value.String
+			}
+		case spamscore.FieldScore:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field score", values[i])
+			} else if value.Valid {
+				ss.Score = value.Float64
+			}
+		case spamscore.FieldReasons:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reasons", values[i])
+			} else if value.Valid {
+				ss.Reasons = value.String
+			}
+		case spamscore.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ss.CreatedAt = value.Time
+			}
+		default:
+			ss.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SpamScore.
+// This includes values selected through modifiers, order, etc.
+func (ss *SpamScore) Value(name string) (ent.Value, error) {
+	return ss.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SpamScore.
+// Note that you need to call SpamScore.Unwrap() before calling this method if this SpamScore
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ss *SpamScore) Update() *SpamScoreUpdateOne {
+	return NewSpamScoreClient(ss.config).UpdateOne(ss)
+}
+
+// Unwrap unwraps the SpamScore entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ss *SpamScore) Unwrap() *SpamScore {
+	_tx, ok := ss.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SpamScore is not a transactional entity")
+	}
+	ss.config.driver = _tx.drv
+	return ss
+}
+
+// String implements the fmt.Stringer.
+func (ss *SpamScore) String() string {
+	var builder strings.Builder
+	builder.WriteString("SpamScore(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ss.ID))
+	builder.WriteString("provider=")
+	builder.WriteString(ss.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("score=")
+	builder.WriteString(fmt.Sprintf("%v", ss.Score))
+	builder.WriteString(", ")
+	builder.WriteString("reasons=")
+	builder.WriteString(ss.Reasons)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ss.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SpamScores is a parsable slice of SpamScore.
+type SpamScores []*SpamScore
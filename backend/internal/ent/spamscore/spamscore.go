@@ -0,0 +1,84 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamscore
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the spamscore type in the database.
+	Label = "spam_score"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "comment_id"
+	// FieldProvider holds the string denoting the provider field in the database.
+	FieldProvider = "provider"
+	// FieldScore holds the string denoting the score field in the database.
+	FieldScore = "score"
+	// FieldReasons holds the string denoting the reasons field in the database.
+	FieldReasons = "reasons"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the spamscore in the database.
+	Table = "comment_spam_scores"
+)
+
+// Columns holds all SQL columns for spamscore fields.
+var Columns = []string{
+	FieldID,
+	FieldProvider,
+	FieldScore,
+	FieldReasons,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	ProviderValidator func(string) error
+	// DefaultReasons holds the default value on creation for the "reasons" field.
+	DefaultReasons string
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the SpamScore queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProvider orders the results by the provider field.
+func ByProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProvider, opts...).ToFunc()
+}
+
+// ByScore orders the results by the score field.
+func ByScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScore, opts...).ToFunc()
+}
+
+// ByReasons orders the results by the reasons field.
+func ByReasons(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReasons, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
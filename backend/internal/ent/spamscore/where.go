@@ -0,0 +1,310 @@
+// Code generated by ent, DO NOT EDIT.
+
+package spamscore
+
+import (
+	"silan-backend/internal/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldContainsFold(FieldID, id))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldProvider, v))
+}
+
+// Score applies equality check predicate on the "score" field. It's identical to ScoreEQ.
+func Score(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldScore, v))
+}
+
+// Reasons applies equality check predicate on the "reasons" field. It's identical to ReasonsEQ.
+func Reasons(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldReasons, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ScoreEQ applies the EQ predicate on the "score" field.
+func ScoreEQ(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldScore, v))
+}
+
+// ScoreNEQ applies the NEQ predicate on the "score" field.
+func ScoreNEQ(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNEQ(FieldScore, v))
+}
+
+// ScoreIn applies the In predicate on the "score" field.
+func ScoreIn(vs ...float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldIn(FieldScore, vs...))
+}
+
+// ScoreNotIn applies the NotIn predicate on the "score" field.
+func ScoreNotIn(vs ...float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNotIn(FieldScore, vs...))
+}
+
+// ScoreGT applies the GT predicate on the "score" field.
+func ScoreGT(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGT(FieldScore, v))
+}
+
+// ScoreGTE applies the GTE predicate on the "score" field.
+func ScoreGTE(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGTE(FieldScore, v))
+}
+
+// ScoreLT applies the LT predicate on the "score" field.
+func ScoreLT(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLT(FieldScore, v))
+}
+
+// ScoreLTE applies the LTE predicate on the "score" field.
+func ScoreLTE(v float64) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLTE(FieldScore, v))
+}
+
+// ReasonsEQ applies the EQ predicate on the "reasons" field.
+func ReasonsEQ(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldReasons, v))
+}
+
+// ReasonsNEQ applies the NEQ predicate on the "reasons" field.
+func ReasonsNEQ(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNEQ(FieldReasons, v))
+}
+
+// ReasonsIn applies the In predicate on the "reasons" field.
+func ReasonsIn(vs ...string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldIn(FieldReasons, vs...))
+}
+
+// ReasonsNotIn applies the NotIn predicate on the "reasons" field.
+func ReasonsNotIn(vs ...string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNotIn(FieldReasons, vs...))
+}
+
+// ReasonsGT applies the GT predicate on the "reasons" field.
+func ReasonsGT(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGT(FieldReasons, v))
+}
+
+// ReasonsGTE applies the GTE predicate on the "reasons" field.
+func ReasonsGTE(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGTE(FieldReasons, v))
+}
+
+// ReasonsLT applies the LT predicate on the "reasons" field.
+func ReasonsLT(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLT(FieldReasons, v))
+}
+
+// ReasonsLTE applies the LTE predicate on the "reasons" field.
+func ReasonsLTE(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLTE(FieldReasons, v))
+}
+
+// ReasonsContains applies the Contains predicate on the "reasons" field.
+func ReasonsContains(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldContains(FieldReasons, v))
+}
+
+// ReasonsHasPrefix applies the HasPrefix predicate on the "reasons" field.
+func ReasonsHasPrefix(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldHasPrefix(FieldReasons, v))
+}
+
+// ReasonsHasSuffix applies the HasSuffix predicate on the "reasons" field.
+func ReasonsHasSuffix(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldHasSuffix(FieldReasons, v))
+}
+
+// ReasonsEqualFold applies the EqualFold predicate on the "reasons" field.
+func ReasonsEqualFold(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEqualFold(FieldReasons, v))
+}
+
+// ReasonsContainsFold applies the ContainsFold predicate on the "reasons" field.
+func ReasonsContainsFold(v string) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldContainsFold(FieldReasons, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SpamScore {
+	return predicate.SpamScore(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SpamScore) predicate.SpamScore {
+	return predicate.SpamScore(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SpamScore) predicate.SpamScore {
+	return predicate.SpamScore(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SpamScore) predicate.SpamScore {
+	return predicate.SpamScore(sql.NotPredicates(p))
+}
@@ -0,0 +1,274 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/spamscore"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamScoreCreate is the builder for creating a SpamScore entity.
+type SpamScoreCreate struct {
+	config
+	mutation *SpamScoreMutation
+	hooks    []Hook
+}
+
+// SetProvider sets the "provider" field.
+func (ssc *SpamScoreCreate) SetProvider(s string) *SpamScoreCreate {
+	ssc.mutation.SetProvider(s)
+	return ssc
+}
+
+// SetScore sets the "score" field.
+func (ssc *SpamScoreCreate) SetScore(f float64) *SpamScoreCreate {
+	ssc.mutation.SetScore(f)
+	return ssc
+}
+
+// SetReasons sets the "reasons" field.
+func (ssc *SpamScoreCreate) SetReasons(s string) *SpamScoreCreate {
+	ssc.mutation.SetReasons(s)
+	return ssc
+}
+
+// SetNillableReasons sets the "reasons" field if the given value is not nil.
+func (ssc *SpamScoreCreate) SetNillableReasons(s *string) *SpamScoreCreate {
+	if s != nil {
+		ssc.SetReasons(*s)
+	}
+	return ssc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssc *SpamScoreCreate) SetCreatedAt(t time.Time) *SpamScoreCreate {
+	ssc.mutation.SetCreatedAt(t)
+	return ssc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssc *SpamScoreCreate) SetNillableCreatedAt(t *time.Time) *SpamScoreCreate {
+	if t != nil {
+		ssc.SetCreatedAt(*t)
+	}
+	return ssc
+}
+
+// SetID sets the "id" field.
+func (ssc *SpamScoreCreate) SetID(s string) *SpamScoreCreate {
+	ssc.mutation.SetID(s)
+	return ssc
+}
+
+// Mutation returns the SpamScoreMutation object of the builder.
+func (ssc *SpamScoreCreate) Mutation() *SpamScoreMutation {
+	return ssc.mutation
+}
+
+// Save creates the SpamScore in the database.
+func (ssc *SpamScoreCreate) Save(ctx context.Context) (*SpamScore, error) {
+	ssc.defaults()
+	return withHooks(ctx, ssc.sqlSave, ssc.mutation, ssc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ssc *SpamScoreCreate) SaveX(ctx context.Context) *SpamScore {
+	v, err := ssc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ssc *SpamScoreCreate) Exec(ctx context.Context) error {
+	_, err := ssc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssc *SpamScoreCreate) ExecX(ctx context.Context) {
+	if err := ssc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ssc *SpamScoreCreate) defaults() {
+	if _, ok := ssc.mutation.Reasons(); !ok {
+		v := spamscore.DefaultReasons
+		ssc.mutation.SetReasons(v)
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		v := spamscore.DefaultCreatedAt()
+		ssc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssc *SpamScoreCreate) check() error {
+	if _, ok := ssc.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "SpamScore.provider"`)}
+	}
+	if v, ok := ssc.mutation.Provider(); ok {
+		if err := spamscore.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "SpamScore.provider": %w`, err)}
+		}
+	}
+	if _, ok := ssc.mutation.Score(); !ok {
+		return &ValidationError{Name: "score", err: errors.New(`ent: missing required field "SpamScore.score"`)}
+	}
+	if _, ok := ssc.mutation.Reasons(); !ok {
+		return &ValidationError{Name: "reasons", err: errors.New(`ent: missing required field "SpamScore.reasons"`)}
+	}
+	if _, ok := ssc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SpamScore.created_at"`)}
+	}
+	if v, ok := ssc.mutation.ID(); ok {
+		if err := spamscore.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "SpamScore.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ssc *SpamScoreCreate) sqlSave(ctx context.Context) (*SpamScore, error) {
+	if err := ssc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ssc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ssc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected SpamScore.ID type: %T", _spec.ID.Value)
+		}
+	}
+	ssc.mutation.id = &_node.ID
+	ssc.mutation.done = true
+	return _node, nil
+}
+
+func (ssc *SpamScoreCreate) createSpec() (*SpamScore, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SpamScore{config: ssc.config}
+		_spec = sqlgraph.NewCreateSpec(spamscore.Table, sqlgraph.NewFieldSpec(spamscore.FieldID, field.TypeString))
+	)
+	if id, ok := ssc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := ssc.mutation.Provider(); ok {
+		_spec.SetField(spamscore.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := ssc.mutation.Score(); ok {
+		_spec.SetField(spamscore.FieldScore, field.TypeFloat64, value)
+		_node.Score = value
+	}
+	if value, ok := ssc.mutation.Reasons(); ok {
+		_spec.SetField(spamscore.FieldReasons, field.TypeString, value)
+		_node.Reasons = value
+	}
+	if value, ok := ssc.mutation.CreatedAt(); ok {
+		_spec.SetField(spamscore.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// SpamScoreCreateBulk is the builder for creating many SpamScore entities in bulk.
+type SpamScoreCreateBulk struct {
+	config
+	err      error
+	builders []*SpamScoreCreate
+}
+
+// Save creates the SpamScore entities in the database.
+func (sscb *SpamScoreCreateBulk) Save(ctx context.Context) ([]*SpamScore, error) {
+	if sscb.err != nil {
+		return nil, sscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(sscb.builders))
+	nodes := make([]*SpamScore, len(sscb.builders))
+	mutators := make([]Mutator, len(sscb.builders))
+	for i := range sscb.builders {
+		func(i int, root context.Context) {
+			builder := sscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SpamScoreMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, sscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, sscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, sscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sscb *SpamScoreCreateBulk) SaveX(ctx context.Context) []*SpamScore {
+	v, err := sscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sscb *SpamScoreCreateBulk) Exec(ctx context.Context) error {
+	_, err := sscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sscb *SpamScoreCreateBulk) ExecX(ctx context.Context) {
+	if err := sscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/spamscore"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SpamScoreUpdate is the builder for updating SpamScore entities.
+type SpamScoreUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SpamScoreMutation
+}
+
+// Where appends a list predicates to the SpamScoreUpdate builder.
+func (ssu *SpamScoreUpdate) Where(ps ...predicate.SpamScore) *SpamScoreUpdate {
+	ssu.mutation.Where(ps...)
+	return ssu
+}
+
+// SetProvider sets the "provider" field.
+func (ssu *SpamScoreUpdate) SetProvider(s string) *SpamScoreUpdate {
+	ssu.mutation.SetProvider(s)
+	return ssu
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ssu *SpamScoreUpdate) SetNillableProvider(s *string) *SpamScoreUpdate {
+	if s != nil {
+		ssu.SetProvider(*s)
+	}
+	return ssu
+}
+
+// SetScore sets the "score" field.
+func (ssu *SpamScoreUpdate) SetScore(f float64) *SpamScoreUpdate {
+	ssu.mutation.ResetScore()
+	ssu.mutation.SetScore(f)
+	return ssu
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ssu *SpamScoreUpdate) SetNillableScore(f *float64) *SpamScoreUpdate {
+	if f != nil {
+		ssu.SetScore(*f)
+	}
+	return ssu
+}
+
+// AddScore adds f to the "score" field.
+func (ssu *SpamScoreUpdate) AddScore(f float64) *SpamScoreUpdate {
+	ssu.mutation.AddScore(f)
+	return ssu
+}
+
+// SetReasons sets the "reasons" field.
+func (ssu *SpamScoreUpdate) SetReasons(s string) *SpamScoreUpdate {
+	ssu.mutation.SetReasons(s)
+	return ssu
+}
+
+// SetNillableReasons sets the "reasons" field if the given value is not nil.
+func (ssu *SpamScoreUpdate) SetNillableReasons(s *string) *SpamScoreUpdate {
+	if s != nil {
+		ssu.SetReasons(*s)
+	}
+	return ssu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssu *SpamScoreUpdate) SetCreatedAt(t time.Time) *SpamScoreUpdate {
+	ssu.mutation.SetCreatedAt(t)
+	return ssu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssu *SpamScoreUpdate) SetNillableCreatedAt(t *time.Time) *SpamScoreUpdate {
+	if t != nil {
+		ssu.SetCreatedAt(*t)
+	}
+	return ssu
+}
+
+// Mutation returns the SpamScoreMutation object of the builder.
+func (ssu *SpamScoreUpdate) Mutation() *SpamScoreMutation {
+	return ssu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ssu *SpamScoreUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, ssu.sqlSave, ssu.mutation, ssu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssu *SpamScoreUpdate) SaveX(ctx context.Context) int {
+	affected, err := ssu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ssu *SpamScoreUpdate) Exec(ctx context.Context) error {
+	_, err := ssu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssu *SpamScoreUpdate) ExecX(ctx context.Context) {
+	if err := ssu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssu *SpamScoreUpdate) check() error {
+	if v, ok := ssu.mutation.Provider(); ok {
+		if err := spamscore.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "SpamScore.provider": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ssu *SpamScoreUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ssu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamscore.Table, spamscore.Columns, sqlgraph.NewFieldSpec(spamscore.FieldID, field.TypeString))
+	if ps := ssu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssu.mutation.Provider(); ok {
+		_spec.SetField(spamscore.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.Score(); ok {
+		_spec.SetField(spamscore.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := ssu.mutation.AddedScore(); ok {
+		_spec.AddField(spamscore.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := ssu.mutation.Reasons(); ok {
+		_spec.SetField(spamscore.FieldReasons, field.TypeString, value)
+	}
+	if value, ok := ssu.mutation.CreatedAt(); ok {
+		_spec.SetField(spamscore.FieldCreatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ssu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ssu.mutation.done = true
+	return n, nil
+}
+
+// SpamScoreUpdateOne is the builder for updating a single SpamScore entity.
+type SpamScoreUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SpamScoreMutation
+}
+
+// SetProvider sets the "provider" field.
+func (ssuo *SpamScoreUpdateOne) SetProvider(s string) *SpamScoreUpdateOne {
+	ssuo.mutation.SetProvider(s)
+	return ssuo
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (ssuo *SpamScoreUpdateOne) SetNillableProvider(s *string) *SpamScoreUpdateOne {
+	if s != nil {
+		ssuo.SetProvider(*s)
+	}
+	return ssuo
+}
+
+// SetScore sets the "score" field.
+func (ssuo *SpamScoreUpdateOne) SetScore(f float64) *SpamScoreUpdateOne {
+	ssuo.mutation.ResetScore()
+	ssuo.mutation.SetScore(f)
+	return ssuo
+}
+
+// SetNillableScore sets the "score" field if the given value is not nil.
+func (ssuo *SpamScoreUpdateOne) SetNillableScore(f *float64) *SpamScoreUpdateOne {
+	if f != nil {
+		ssuo.SetScore(*f)
+	}
+	return ssuo
+}
+
+// AddScore adds f to the "score" field.
+func (ssuo *SpamScoreUpdateOne) AddScore(f float64) *SpamScoreUpdateOne {
+	ssuo.mutation.AddScore(f)
+	return ssuo
+}
+
+// SetReasons sets the "reasons" field.
+func (ssuo *SpamScoreUpdateOne) SetReasons(s string) *SpamScoreUpdateOne {
+	ssuo.mutation.SetReasons(s)
+	return ssuo
+}
+
+// SetNillableReasons sets the "reasons" field if the given value is not nil.
+func (ssuo *SpamScoreUpdateOne) SetNillableReasons(s *string) *SpamScoreUpdateOne {
+	if s != nil {
+		ssuo.SetReasons(*s)
+	}
+	return ssuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ssuo *SpamScoreUpdateOne) SetCreatedAt(t time.Time) *SpamScoreUpdateOne {
+	ssuo.mutation.SetCreatedAt(t)
+	return ssuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ssuo *SpamScoreUpdateOne) SetNillableCreatedAt(t *time.Time) *SpamScoreUpdateOne {
+	if t != nil {
+		ssuo.SetCreatedAt(*t)
+	}
+	return ssuo
+}
+
+// Mutation returns the SpamScoreMutation object of the builder.
+func (ssuo *SpamScoreUpdateOne) Mutation() *SpamScoreMutation {
+	return ssuo.mutation
+}
+
+// Where appends a list predicates to the SpamScoreUpdate builder.
+func (ssuo *SpamScoreUpdateOne) Where(ps ...predicate.SpamScore) *SpamScoreUpdateOne {
+	ssuo.mutation.Where(ps...)
+	return ssuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ssuo *SpamScoreUpdateOne) Select(field string, fields ...string) *SpamScoreUpdateOne {
+	ssuo.fields = append([]string{field}, fields...)
+	return ssuo
+}
+
+// Save executes the query and returns the updated SpamScore entity.
+func (ssuo *SpamScoreUpdateOne) Save(ctx context.Context) (*SpamScore, error) {
+	return withHooks(ctx, ssuo.sqlSave, ssuo.mutation, ssuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ssuo *SpamScoreUpdateOne) SaveX(ctx context.Context) *SpamScore {
+	node, err := ssuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ssuo *SpamScoreUpdateOne) Exec(ctx context.Context) error {
+	_, err := ssuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ssuo *SpamScoreUpdateOne) ExecX(ctx context.Context) {
+	if err := ssuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ssuo *SpamScoreUpdateOne) check() error {
+	if v, ok := ssuo.mutation.Provider(); ok {
+		if err := spamscore.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "SpamScore.provider": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (ssuo *SpamScoreUpdateOne) sqlSave(ctx context.Context) (_node *SpamScore, err error) {
+	if err := ssuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(spamscore.Table, spamscore.Columns, sqlgraph.NewFieldSpec(spamscore.FieldID, field.TypeString))
+	id, ok := ssuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SpamScore.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ssuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, spamscore.FieldID)
+		for _, f := range fields {
+			if !spamscore.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != spamscore.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ssuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ssuo.mutation.Provider(); ok {
+		_spec.SetField(spamscore.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.Score(); ok {
+		_spec.SetField(spamscore.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := ssuo.mutation.AddedScore(); ok {
+		_spec.AddField(spamscore.FieldScore, field.TypeFloat64, value)
+	}
+	if value, ok := ssuo.mutation.Reasons(); ok {
+		_spec.SetField(spamscore.FieldReasons, field.TypeString, value)
+	}
+	if value, ok := ssuo.mutation.CreatedAt(); ok {
+		_spec.SetField(spamscore.FieldCreatedAt, field.TypeTime, value)
+	}
+	_node = &SpamScore{config: ssuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ssuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{spamscore.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ssuo.mutation.done = true
+	return _node, nil
+}
@@ -12,10 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// ApiKey is the client for interacting with the ApiKey builders.
+	ApiKey *ApiKeyClient
+	// AuthEvent is the client for interacting with the AuthEvent builders.
+	AuthEvent *AuthEventClient
 	// Award is the client for interacting with the Award builders.
 	Award *AwardClient
 	// AwardTranslation is the client for interacting with the AwardTranslation builders.
 	AwardTranslation *AwardTranslationClient
+	// Ban is the client for interacting with the Ban builders.
+	Ban *BanClient
 	// BlogCategory is the client for interacting with the BlogCategory builders.
 	BlogCategory *BlogCategoryClient
 	// BlogCategoryTranslation is the client for interacting with the BlogCategoryTranslation builders.
@@ -36,6 +42,8 @@ type Tx struct {
 	Comment *CommentClient
 	// CommentLike is the client for interacting with the CommentLike builders.
 	CommentLike *CommentLikeClient
+	// CommentMention is the client for interacting with the CommentMention builders.
+	CommentMention *CommentMentionClient
 	// Education is the client for interacting with the Education builders.
 	Education *EducationClient
 	// EducationDetail is the client for interacting with the EducationDetail builders.
@@ -54,6 +62,8 @@ type Tx struct {
 	IdeaTag *IdeaTagClient
 	// IdeaTranslation is the client for interacting with the IdeaTranslation builders.
 	IdeaTranslation *IdeaTranslationClient
+	// IdentityLink is the client for interacting with the IdentityLink builders.
+	IdentityLink *IdentityLinkClient
 	// Language is the client for interacting with the Language builders.
 	Language *LanguageClient
 	// PersonalInfo is the client for interacting with the PersonalInfo builders.
@@ -86,6 +96,8 @@ type Tx struct {
 	PublicationAuthor *PublicationAuthorClient
 	// PublicationTranslation is the client for interacting with the PublicationTranslation builders.
 	PublicationTranslation *PublicationTranslationClient
+	// Reaction is the client for interacting with the Reaction builders.
+	Reaction *ReactionClient
 	// RecentUpdate is the client for interacting with the RecentUpdate builders.
 	RecentUpdate *RecentUpdateClient
 	// RecentUpdateTranslation is the client for interacting with the RecentUpdateTranslation builders.
@@ -98,8 +110,14 @@ type Tx struct {
 	ResearchProjectDetailTranslation *ResearchProjectDetailTranslationClient
 	// ResearchProjectTranslation is the client for interacting with the ResearchProjectTranslation builders.
 	ResearchProjectTranslation *ResearchProjectTranslationClient
+	// Session is the client for interacting with the Session builders.
+	Session *SessionClient
+	// SignatureNonce is the client for interacting with the SignatureNonce builders.
+	SignatureNonce *SignatureNonceClient
 	// SocialLink is the client for interacting with the SocialLink builders.
 	SocialLink *SocialLinkClient
+	// SpamScore is the client for interacting with the SpamScore builders.
+	SpamScore *SpamScoreClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserIdentity is the client for interacting with the UserIdentity builders.
@@ -243,8 +261,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.ApiKey = NewApiKeyClient(tx.config)
+	tx.AuthEvent = NewAuthEventClient(tx.config)
 	tx.Award = NewAwardClient(tx.config)
 	tx.AwardTranslation = NewAwardTranslationClient(tx.config)
+	tx.Ban = NewBanClient(tx.config)
 	tx.BlogCategory = NewBlogCategoryClient(tx.config)
 	tx.BlogCategoryTranslation = NewBlogCategoryTranslationClient(tx.config)
 	tx.BlogPost = NewBlogPostClient(tx.config)
@@ -255,6 +276,7 @@ func (tx *Tx) init() {
 	tx.BlogTag = NewBlogTagClient(tx.config)
 	tx.Comment = NewCommentClient(tx.config)
 	tx.CommentLike = NewCommentLikeClient(tx.config)
+	tx.CommentMention = NewCommentMentionClient(tx.config)
 	tx.Education = NewEducationClient(tx.config)
 	tx.EducationDetail = NewEducationDetailClient(tx.config)
 	tx.EducationDetailTranslation = NewEducationDetailTranslationClient(tx.config)
@@ -264,6 +286,7 @@ func (tx *Tx) init() {
 	tx.IdeaDetailTranslation = NewIdeaDetailTranslationClient(tx.config)
 	tx.IdeaTag = NewIdeaTagClient(tx.config)
 	tx.IdeaTranslation = NewIdeaTranslationClient(tx.config)
+	tx.IdentityLink = NewIdentityLinkClient(tx.config)
 	tx.Language = NewLanguageClient(tx.config)
 	tx.PersonalInfo = NewPersonalInfoClient(tx.config)
 	tx.PersonalInfoTranslation = NewPersonalInfoTranslationClient(tx.config)
@@ -280,13 +303,17 @@ func (tx *Tx) init() {
 	tx.Publication = NewPublicationClient(tx.config)
 	tx.PublicationAuthor = NewPublicationAuthorClient(tx.config)
 	tx.PublicationTranslation = NewPublicationTranslationClient(tx.config)
+	tx.Reaction = NewReactionClient(tx.config)
 	tx.RecentUpdate = NewRecentUpdateClient(tx.config)
 	tx.RecentUpdateTranslation = NewRecentUpdateTranslationClient(tx.config)
 	tx.ResearchProject = NewResearchProjectClient(tx.config)
 	tx.ResearchProjectDetail = NewResearchProjectDetailClient(tx.config)
 	tx.ResearchProjectDetailTranslation = NewResearchProjectDetailTranslationClient(tx.config)
 	tx.ResearchProjectTranslation = NewResearchProjectTranslationClient(tx.config)
+	tx.Session = NewSessionClient(tx.config)
+	tx.SignatureNonce = NewSignatureNonceClient(tx.config)
 	tx.SocialLink = NewSocialLinkClient(tx.config)
+	tx.SpamScore = NewSpamScoreClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserIdentity = NewUserIdentityClient(tx.config)
 	tx.WorkExperience = NewWorkExperienceClient(tx.config)
@@ -302,7 +329,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Award.QueryXXX(), the query will be executed
+// applies a query, for example: ApiKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
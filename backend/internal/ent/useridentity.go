@@ -29,21 +29,70 @@ type UserIdentity struct {
 	AvatarURL string `json:"avatar_url,omitempty"`
 	// Verified holds the value of the "verified" field.
 	Verified bool `json:"verified,omitempty"`
+	// DisplayNameEdited holds the value of the "display_name_edited" field.
+	DisplayNameEdited bool `json:"display_name_edited,omitempty"`
+	// AvatarURLEdited holds the value of the "avatar_url_edited" field.
+	AvatarURLEdited bool `json:"avatar_url_edited,omitempty"`
+	// ProviderDisplayName holds the value of the "provider_display_name" field.
+	ProviderDisplayName string `json:"provider_display_name,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
-	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserIdentityQuery when eager-loading is set.
+	Edges        UserIdentityEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// UserIdentityEdges holds the relations/edges for other nodes in the graph.
+type UserIdentityEdges struct {
+	// Primaries holds the value of the primaries edge.
+	Primaries []*UserIdentity `json:"primaries,omitempty"`
+	// LinkedIdentities holds the value of the linked_identities edge.
+	LinkedIdentities []*UserIdentity `json:"linked_identities,omitempty"`
+	// Links holds the value of the links edge.
+	Links []*IdentityLink `json:"links,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// PrimariesOrErr returns the Primaries value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserIdentityEdges) PrimariesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[0] {
+		return e.Primaries, nil
+	}
+	return nil, &NotLoadedError{edge: "primaries"}
+}
+
+// LinkedIdentitiesOrErr returns the LinkedIdentities value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserIdentityEdges) LinkedIdentitiesOrErr() ([]*UserIdentity, error) {
+	if e.loadedTypes[1] {
+		return e.LinkedIdentities, nil
+	}
+	return nil, &NotLoadedError{edge: "linked_identities"}
+}
+
+// LinksOrErr returns the Links value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserIdentityEdges) LinksOrErr() ([]*IdentityLink, error) {
+	if e.loadedTypes[2] {
+		return e.Links, nil
+	}
+	return nil, &NotLoadedError{edge: "links"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*UserIdentity) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case useridentity.FieldVerified:
+		case useridentity.FieldVerified, useridentity.FieldDisplayNameEdited, useridentity.FieldAvatarURLEdited:
 			values[i] = new(sql.NullBool)
-		case useridentity.FieldID, useridentity.FieldProvider, useridentity.FieldExternalID, useridentity.FieldEmail, useridentity.FieldDisplayName, useridentity.FieldAvatarURL:
+		case useridentity.FieldID, useridentity.FieldProvider, useridentity.FieldExternalID, useridentity.FieldEmail, useridentity.FieldDisplayName, useridentity.FieldAvatarURL, useridentity.FieldProviderDisplayName:
 			values[i] = new(sql.NullString)
 		case useridentity.FieldCreatedAt, useridentity.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -104,6 +153,24 @@ func (ui *UserIdentity) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ui.Verified = value.Bool
 			}
+		case useridentity.FieldDisplayNameEdited:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field display_name_edited", values[i])
+			} else if value.Valid {
+				ui.DisplayNameEdited = value.Bool
+			}
+		case useridentity.FieldAvatarURLEdited:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar_url_edited", values[i])
+			} else if value.Valid {
+				ui.AvatarURLEdited = value.Bool
+			}
+		case useridentity.FieldProviderDisplayName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider_display_name", values[i])
+			} else if value.Valid {
+				ui.ProviderDisplayName = value.String
+			}
 		case useridentity.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -129,6 +196,21 @@ func (ui *UserIdentity) Value(name string) (ent.Value, error) {
 	return ui.selectValues.Get(name)
 }
 
+// QueryPrimaries queries the "primaries" edge of the UserIdentity entity.
+func (ui *UserIdentity) QueryPrimaries() *UserIdentityQuery {
+	return NewUserIdentityClient(ui.config).QueryPrimaries(ui)
+}
+
+// QueryLinkedIdentities queries the "linked_identities" edge of the UserIdentity entity.
+func (ui *UserIdentity) QueryLinkedIdentities() *UserIdentityQuery {
+	return NewUserIdentityClient(ui.config).QueryLinkedIdentities(ui)
+}
+
+// QueryLinks queries the "links" edge of the UserIdentity entity.
+func (ui *UserIdentity) QueryLinks() *IdentityLinkQuery {
+	return NewUserIdentityClient(ui.config).QueryLinks(ui)
+}
+
 // Update returns a builder for updating this UserIdentity.
 // Note that you need to call UserIdentity.Unwrap() before calling this method if this UserIdentity
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -170,6 +252,15 @@ func (ui *UserIdentity) String() string {
 	builder.WriteString("verified=")
 	builder.WriteString(fmt.Sprintf("%v", ui.Verified))
 	builder.WriteString(", ")
+	builder.WriteString("display_name_edited=")
+	builder.WriteString(fmt.Sprintf("%v", ui.DisplayNameEdited))
+	builder.WriteString(", ")
+	builder.WriteString("avatar_url_edited=")
+	builder.WriteString(fmt.Sprintf("%v", ui.AvatarURLEdited))
+	builder.WriteString(", ")
+	builder.WriteString("provider_display_name=")
+	builder.WriteString(ui.ProviderDisplayName)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(ui.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -25,12 +26,35 @@ const (
 	FieldAvatarURL = "avatar_url"
 	// FieldVerified holds the string denoting the verified field in the database.
 	FieldVerified = "verified"
+	// FieldDisplayNameEdited holds the string denoting the display_name_edited field in the database.
+	FieldDisplayNameEdited = "display_name_edited"
+	// FieldAvatarURLEdited holds the string denoting the avatar_url_edited field in the database.
+	FieldAvatarURLEdited = "avatar_url_edited"
+	// FieldProviderDisplayName holds the string denoting the provider_display_name field in the database.
+	FieldProviderDisplayName = "provider_display_name"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
+	// EdgePrimaries holds the string denoting the primaries edge name in mutations.
+	EdgePrimaries = "primaries"
+	// EdgeLinkedIdentities holds the string denoting the linked_identities edge name in mutations.
+	EdgeLinkedIdentities = "linked_identities"
+	// EdgeLinks holds the string denoting the links edge name in mutations.
+	EdgeLinks = "links"
 	// Table holds the table name of the useridentity in the database.
 	Table = "user_identities"
+	// PrimariesTable is the table that holds the primaries relation/edge. The primary key declared below.
+	PrimariesTable = "identity_links"
+	// LinkedIdentitiesTable is the table that holds the linked_identities relation/edge. The primary key declared below.
+	LinkedIdentitiesTable = "identity_links"
+	// LinksTable is the table that holds the links relation/edge.
+	LinksTable = "identity_links"
+	// LinksInverseTable is the table name for the IdentityLink entity.
+	// It exists in this package in order to avoid circular dependency with the "identitylink" package.
+	LinksInverseTable = "identity_links"
+	// LinksColumn is the table column denoting the links relation/edge.
+	LinksColumn = "identity_id"
 )
 
 // Columns holds all SQL columns for useridentity fields.
@@ -42,10 +66,22 @@ var Columns = []string{
 	FieldDisplayName,
 	FieldAvatarURL,
 	FieldVerified,
+	FieldDisplayNameEdited,
+	FieldAvatarURLEdited,
+	FieldProviderDisplayName,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
 
+var (
+	// PrimariesPrimaryKey and PrimariesColumn2 are the table columns denoting the
+	// primary key for the primaries relation (M2M).
+	PrimariesPrimaryKey = []string{"identity_id", "primary_id"}
+	// LinkedIdentitiesPrimaryKey and LinkedIdentitiesColumn2 are the table columns denoting the
+	// primary key for the linked_identities relation (M2M).
+	LinkedIdentitiesPrimaryKey = []string{"identity_id", "primary_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -63,6 +99,10 @@ var (
 	ExternalIDValidator func(string) error
 	// DefaultVerified holds the default value on creation for the "verified" field.
 	DefaultVerified bool
+	// DefaultDisplayNameEdited holds the default value on creation for the "display_name_edited" field.
+	DefaultDisplayNameEdited bool
+	// DefaultAvatarURLEdited holds the default value on creation for the "avatar_url_edited" field.
+	DefaultAvatarURLEdited bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -109,6 +149,21 @@ func ByVerified(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVerified, opts...).ToFunc()
 }
 
+// ByDisplayNameEdited orders the results by the display_name_edited field.
+func ByDisplayNameEdited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDisplayNameEdited, opts...).ToFunc()
+}
+
+// ByAvatarURLEdited orders the results by the avatar_url_edited field.
+func ByAvatarURLEdited(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvatarURLEdited, opts...).ToFunc()
+}
+
+// ByProviderDisplayName orders the results by the provider_display_name field.
+func ByProviderDisplayName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProviderDisplayName, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -118,3 +173,66 @@ func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
 }
+
+// ByPrimariesCount orders the results by primaries count.
+func ByPrimariesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPrimariesStep(), opts...)
+	}
+}
+
+// ByPrimaries orders the results by primaries terms.
+func ByPrimaries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPrimariesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLinkedIdentitiesCount orders the results by linked_identities count.
+func ByLinkedIdentitiesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinkedIdentitiesStep(), opts...)
+	}
+}
+
+// ByLinkedIdentities orders the results by linked_identities terms.
+func ByLinkedIdentities(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkedIdentitiesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByLinksCount orders the results by links count.
+func ByLinksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newLinksStep(), opts...)
+	}
+}
+
+// ByLinks orders the results by links terms.
+func ByLinks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPrimariesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, false, PrimariesTable, PrimariesPrimaryKey...),
+	)
+}
+func newLinkedIdentitiesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, LinkedIdentitiesTable, LinkedIdentitiesPrimaryKey...),
+	)
+}
+func newLinksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinksInverseTable, LinksColumn),
+		sqlgraph.Edge(sqlgraph.O2M, true, LinksTable, LinksColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 // ID filters vertices based on their ID field.
@@ -94,6 +95,21 @@ func Verified(v bool) predicate.UserIdentity {
 	return predicate.UserIdentity(sql.FieldEQ(FieldVerified, v))
 }
 
+// DisplayNameEdited applies equality check predicate on the "display_name_edited" field. It's identical to DisplayNameEditedEQ.
+func DisplayNameEdited(v bool) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEQ(FieldDisplayNameEdited, v))
+}
+
+// AvatarURLEdited applies equality check predicate on the "avatar_url_edited" field. It's identical to AvatarURLEditedEQ.
+func AvatarURLEdited(v bool) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEQ(FieldAvatarURLEdited, v))
+}
+
+// ProviderDisplayName applies equality check predicate on the "provider_display_name" field. It's identical to ProviderDisplayNameEQ.
+func ProviderDisplayName(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEQ(FieldProviderDisplayName, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.UserIdentity {
 	return predicate.UserIdentity(sql.FieldEQ(FieldCreatedAt, v))
@@ -469,6 +485,101 @@ func VerifiedNEQ(v bool) predicate.UserIdentity {
 	return predicate.UserIdentity(sql.FieldNEQ(FieldVerified, v))
 }
 
+// DisplayNameEditedEQ applies the EQ predicate on the "display_name_edited" field.
+func DisplayNameEditedEQ(v bool) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEQ(FieldDisplayNameEdited, v))
+}
+
+// DisplayNameEditedNEQ applies the NEQ predicate on the "display_name_edited" field.
+func DisplayNameEditedNEQ(v bool) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldNEQ(FieldDisplayNameEdited, v))
+}
+
+// AvatarURLEditedEQ applies the EQ predicate on the "avatar_url_edited" field.
+func AvatarURLEditedEQ(v bool) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEQ(FieldAvatarURLEdited, v))
+}
+
+// AvatarURLEditedNEQ applies the NEQ predicate on the "avatar_url_edited" field.
+func AvatarURLEditedNEQ(v bool) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldNEQ(FieldAvatarURLEdited, v))
+}
+
+// ProviderDisplayNameEQ applies the EQ predicate on the "provider_display_name" field.
+func ProviderDisplayNameEQ(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEQ(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameNEQ applies the NEQ predicate on the "provider_display_name" field.
+func ProviderDisplayNameNEQ(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldNEQ(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameIn applies the In predicate on the "provider_display_name" field.
+func ProviderDisplayNameIn(vs ...string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldIn(FieldProviderDisplayName, vs...))
+}
+
+// ProviderDisplayNameNotIn applies the NotIn predicate on the "provider_display_name" field.
+func ProviderDisplayNameNotIn(vs ...string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldNotIn(FieldProviderDisplayName, vs...))
+}
+
+// ProviderDisplayNameGT applies the GT predicate on the "provider_display_name" field.
+func ProviderDisplayNameGT(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldGT(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameGTE applies the GTE predicate on the "provider_display_name" field.
+func ProviderDisplayNameGTE(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldGTE(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameLT applies the LT predicate on the "provider_display_name" field.
+func ProviderDisplayNameLT(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldLT(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameLTE applies the LTE predicate on the "provider_display_name" field.
+func ProviderDisplayNameLTE(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldLTE(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameContains applies the Contains predicate on the "provider_display_name" field.
+func ProviderDisplayNameContains(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldContains(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameHasPrefix applies the HasPrefix predicate on the "provider_display_name" field.
+func ProviderDisplayNameHasPrefix(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldHasPrefix(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameHasSuffix applies the HasSuffix predicate on the "provider_display_name" field.
+func ProviderDisplayNameHasSuffix(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldHasSuffix(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameIsNil applies the IsNil predicate on the "provider_display_name" field.
+func ProviderDisplayNameIsNil() predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldIsNull(FieldProviderDisplayName))
+}
+
+// ProviderDisplayNameNotNil applies the NotNil predicate on the "provider_display_name" field.
+func ProviderDisplayNameNotNil() predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldNotNull(FieldProviderDisplayName))
+}
+
+// ProviderDisplayNameEqualFold applies the EqualFold predicate on the "provider_display_name" field.
+func ProviderDisplayNameEqualFold(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldEqualFold(FieldProviderDisplayName, v))
+}
+
+// ProviderDisplayNameContainsFold applies the ContainsFold predicate on the "provider_display_name" field.
+func ProviderDisplayNameContainsFold(v string) predicate.UserIdentity {
+	return predicate.UserIdentity(sql.FieldContainsFold(FieldProviderDisplayName, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.UserIdentity {
 	return predicate.UserIdentity(sql.FieldEQ(FieldCreatedAt, v))
@@ -549,6 +660,75 @@ func UpdatedAtLTE(v time.Time) predicate.UserIdentity {
 	return predicate.UserIdentity(sql.FieldLTE(FieldUpdatedAt, v))
 }
 
+// HasPrimaries applies the HasEdge predicate on the "primaries" edge.
+func HasPrimaries() predicate.UserIdentity {
+	return predicate.UserIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, PrimariesTable, PrimariesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPrimariesWith applies the HasEdge predicate on the "primaries" edge with a given conditions (other predicates).
+func HasPrimariesWith(preds ...predicate.UserIdentity) predicate.UserIdentity {
+	return predicate.UserIdentity(func(s *sql.Selector) {
+		step := newPrimariesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinkedIdentities applies the HasEdge predicate on the "linked_identities" edge.
+func HasLinkedIdentities() predicate.UserIdentity {
+	return predicate.UserIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, LinkedIdentitiesTable, LinkedIdentitiesPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkedIdentitiesWith applies the HasEdge predicate on the "linked_identities" edge with a given conditions (other predicates).
+func HasLinkedIdentitiesWith(preds ...predicate.UserIdentity) predicate.UserIdentity {
+	return predicate.UserIdentity(func(s *sql.Selector) {
+		step := newLinkedIdentitiesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinks applies the HasEdge predicate on the "links" edge.
+func HasLinks() predicate.UserIdentity {
+	return predicate.UserIdentity(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, LinksTable, LinksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinksWith applies the HasEdge predicate on the "links" edge with a given conditions (other predicates).
+func HasLinksWith(preds ...predicate.IdentityLink) predicate.UserIdentity {
+	return predicate.UserIdentity(func(s *sql.Selector) {
+		step := newLinksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.UserIdentity) predicate.UserIdentity {
 	return predicate.UserIdentity(sql.AndPredicates(predicates...))
@@ -88,6 +88,48 @@ func (uic *UserIdentityCreate) SetNillableVerified(b *bool) *UserIdentityCreate
 	return uic
 }
 
+// SetDisplayNameEdited sets the "display_name_edited" field.
+func (uic *UserIdentityCreate) SetDisplayNameEdited(b bool) *UserIdentityCreate {
+	uic.mutation.SetDisplayNameEdited(b)
+	return uic
+}
+
+// SetNillableDisplayNameEdited sets the "display_name_edited" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableDisplayNameEdited(b *bool) *UserIdentityCreate {
+	if b != nil {
+		uic.SetDisplayNameEdited(*b)
+	}
+	return uic
+}
+
+// SetAvatarURLEdited sets the "avatar_url_edited" field.
+func (uic *UserIdentityCreate) SetAvatarURLEdited(b bool) *UserIdentityCreate {
+	uic.mutation.SetAvatarURLEdited(b)
+	return uic
+}
+
+// SetNillableAvatarURLEdited sets the "avatar_url_edited" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableAvatarURLEdited(b *bool) *UserIdentityCreate {
+	if b != nil {
+		uic.SetAvatarURLEdited(*b)
+	}
+	return uic
+}
+
+// SetProviderDisplayName sets the "provider_display_name" field.
+func (uic *UserIdentityCreate) SetProviderDisplayName(s string) *UserIdentityCreate {
+	uic.mutation.SetProviderDisplayName(s)
+	return uic
+}
+
+// SetNillableProviderDisplayName sets the "provider_display_name" field if the given value is not nil.
+func (uic *UserIdentityCreate) SetNillableProviderDisplayName(s *string) *UserIdentityCreate {
+	if s != nil {
+		uic.SetProviderDisplayName(*s)
+	}
+	return uic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (uic *UserIdentityCreate) SetCreatedAt(t time.Time) *UserIdentityCreate {
 	uic.mutation.SetCreatedAt(t)
@@ -122,6 +164,36 @@ func (uic *UserIdentityCreate) SetID(s string) *UserIdentityCreate {
 	return uic
 }
 
+// AddPrimaryIDs adds the "primaries" edge to the UserIdentity entity by IDs.
+func (uic *UserIdentityCreate) AddPrimaryIDs(ids ...string) *UserIdentityCreate {
+	uic.mutation.AddPrimaryIDs(ids...)
+	return uic
+}
+
+// AddPrimaries adds the "primaries" edges to the UserIdentity entity.
+func (uic *UserIdentityCreate) AddPrimaries(u ...*UserIdentity) *UserIdentityCreate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uic.AddPrimaryIDs(ids...)
+}
+
+// AddLinkedIdentityIDs adds the "linked_identities" edge to the UserIdentity entity by IDs.
+func (uic *UserIdentityCreate) AddLinkedIdentityIDs(ids ...string) *UserIdentityCreate {
+	uic.mutation.AddLinkedIdentityIDs(ids...)
+	return uic
+}
+
+// AddLinkedIdentities adds the "linked_identities" edges to the UserIdentity entity.
+func (uic *UserIdentityCreate) AddLinkedIdentities(u ...*UserIdentity) *UserIdentityCreate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uic.AddLinkedIdentityIDs(ids...)
+}
+
 // Mutation returns the UserIdentityMutation object of the builder.
 func (uic *UserIdentityCreate) Mutation() *UserIdentityMutation {
 	return uic.mutation
@@ -161,6 +233,14 @@ func (uic *UserIdentityCreate) defaults() {
 		v := useridentity.DefaultVerified
 		uic.mutation.SetVerified(v)
 	}
+	if _, ok := uic.mutation.DisplayNameEdited(); !ok {
+		v := useridentity.DefaultDisplayNameEdited
+		uic.mutation.SetDisplayNameEdited(v)
+	}
+	if _, ok := uic.mutation.AvatarURLEdited(); !ok {
+		v := useridentity.DefaultAvatarURLEdited
+		uic.mutation.SetAvatarURLEdited(v)
+	}
 	if _, ok := uic.mutation.CreatedAt(); !ok {
 		v := useridentity.DefaultCreatedAt()
 		uic.mutation.SetCreatedAt(v)
@@ -192,6 +272,12 @@ func (uic *UserIdentityCreate) check() error {
 	if _, ok := uic.mutation.Verified(); !ok {
 		return &ValidationError{Name: "verified", err: errors.New(`ent: missing required field "UserIdentity.verified"`)}
 	}
+	if _, ok := uic.mutation.DisplayNameEdited(); !ok {
+		return &ValidationError{Name: "display_name_edited", err: errors.New(`ent: missing required field "UserIdentity.display_name_edited"`)}
+	}
+	if _, ok := uic.mutation.AvatarURLEdited(); !ok {
+		return &ValidationError{Name: "avatar_url_edited", err: errors.New(`ent: missing required field "UserIdentity.avatar_url_edited"`)}
+	}
 	if _, ok := uic.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserIdentity.created_at"`)}
 	}
@@ -257,6 +343,18 @@ func (uic *UserIdentityCreate) createSpec() (*UserIdentity, *sqlgraph.CreateSpec
 		_spec.SetField(useridentity.FieldVerified, field.TypeBool, value)
 		_node.Verified = value
 	}
+	if value, ok := uic.mutation.DisplayNameEdited(); ok {
+		_spec.SetField(useridentity.FieldDisplayNameEdited, field.TypeBool, value)
+		_node.DisplayNameEdited = value
+	}
+	if value, ok := uic.mutation.AvatarURLEdited(); ok {
+		_spec.SetField(useridentity.FieldAvatarURLEdited, field.TypeBool, value)
+		_node.AvatarURLEdited = value
+	}
+	if value, ok := uic.mutation.ProviderDisplayName(); ok {
+		_spec.SetField(useridentity.FieldProviderDisplayName, field.TypeString, value)
+		_node.ProviderDisplayName = value
+	}
 	if value, ok := uic.mutation.CreatedAt(); ok {
 		_spec.SetField(useridentity.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -265,6 +363,42 @@ func (uic *UserIdentityCreate) createSpec() (*UserIdentity, *sqlgraph.CreateSpec
 		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
 		_node.UpdatedAt = value
 	}
+	if nodes := uic.mutation.PrimariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &IdentityLinkCreate{config: uic.config, mutation: newIdentityLinkMutation(uic.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := uic.mutation.LinkedIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
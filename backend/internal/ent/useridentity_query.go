@@ -4,8 +4,10 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
+	"silan-backend/internal/ent/identitylink"
 	"silan-backend/internal/ent/predicate"
 	"silan-backend/internal/ent/useridentity"
 
@@ -18,10 +20,13 @@ import (
 // UserIdentityQuery is the builder for querying UserIdentity entities.
 type UserIdentityQuery struct {
 	config
-	ctx        *QueryContext
-	order      []useridentity.OrderOption
-	inters     []Interceptor
-	predicates []predicate.UserIdentity
+	ctx                  *QueryContext
+	order                []useridentity.OrderOption
+	inters               []Interceptor
+	predicates           []predicate.UserIdentity
+	withPrimaries        *UserIdentityQuery
+	withLinkedIdentities *UserIdentityQuery
+	withLinks            *IdentityLinkQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -58,6 +63,72 @@ func (uiq *UserIdentityQuery) Order(o ...useridentity.OrderOption) *UserIdentity
 	return uiq
 }
 
+// QueryPrimaries chains the current query on the "primaries" edge.
+func (uiq *UserIdentityQuery) QueryPrimaries() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: uiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, useridentity.PrimariesTable, useridentity.PrimariesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLinkedIdentities chains the current query on the "linked_identities" edge.
+func (uiq *UserIdentityQuery) QueryLinkedIdentities() *UserIdentityQuery {
+	query := (&UserIdentityClient{config: uiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, selector),
+			sqlgraph.To(useridentity.Table, useridentity.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, useridentity.LinkedIdentitiesTable, useridentity.LinkedIdentitiesPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(uiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryLinks chains the current query on the "links" edge.
+func (uiq *UserIdentityQuery) QueryLinks() *IdentityLinkQuery {
+	query := (&IdentityLinkClient{config: uiq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uiq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uiq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(useridentity.Table, useridentity.FieldID, selector),
+			sqlgraph.To(identitylink.Table, identitylink.IdentityColumn),
+			sqlgraph.Edge(sqlgraph.O2M, true, useridentity.LinksTable, useridentity.LinksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uiq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first UserIdentity entity from the query.
 // Returns a *NotFoundError when no UserIdentity was found.
 func (uiq *UserIdentityQuery) First(ctx context.Context) (*UserIdentity, error) {
@@ -245,17 +316,53 @@ func (uiq *UserIdentityQuery) Clone() *UserIdentityQuery {
 		return nil
 	}
 	return &UserIdentityQuery{
-		config:     uiq.config,
-		ctx:        uiq.ctx.Clone(),
-		order:      append([]useridentity.OrderOption{}, uiq.order...),
-		inters:     append([]Interceptor{}, uiq.inters...),
-		predicates: append([]predicate.UserIdentity{}, uiq.predicates...),
+		config:               uiq.config,
+		ctx:                  uiq.ctx.Clone(),
+		order:                append([]useridentity.OrderOption{}, uiq.order...),
+		inters:               append([]Interceptor{}, uiq.inters...),
+		predicates:           append([]predicate.UserIdentity{}, uiq.predicates...),
+		withPrimaries:        uiq.withPrimaries.Clone(),
+		withLinkedIdentities: uiq.withLinkedIdentities.Clone(),
+		withLinks:            uiq.withLinks.Clone(),
 		// clone intermediate query.
 		sql:  uiq.sql.Clone(),
 		path: uiq.path,
 	}
 }
 
+// WithPrimaries tells the query-builder to eager-load the nodes that are connected to
+// the "primaries" edge. The optional arguments are used to configure the query builder of the edge.
+func (uiq *UserIdentityQuery) WithPrimaries(opts ...func(*UserIdentityQuery)) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: uiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uiq.withPrimaries = query
+	return uiq
+}
+
+// WithLinkedIdentities tells the query-builder to eager-load the nodes that are connected to
+// the "linked_identities" edge. The optional arguments are used to configure the query builder of the edge.
+func (uiq *UserIdentityQuery) WithLinkedIdentities(opts ...func(*UserIdentityQuery)) *UserIdentityQuery {
+	query := (&UserIdentityClient{config: uiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uiq.withLinkedIdentities = query
+	return uiq
+}
+
+// WithLinks tells the query-builder to eager-load the nodes that are connected to
+// the "links" edge. The optional arguments are used to configure the query builder of the edge.
+func (uiq *UserIdentityQuery) WithLinks(opts ...func(*IdentityLinkQuery)) *UserIdentityQuery {
+	query := (&IdentityLinkClient{config: uiq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uiq.withLinks = query
+	return uiq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -332,8 +439,13 @@ func (uiq *UserIdentityQuery) prepareQuery(ctx context.Context) error {
 
 func (uiq *UserIdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*UserIdentity, error) {
 	var (
-		nodes = []*UserIdentity{}
-		_spec = uiq.querySpec()
+		nodes       = []*UserIdentity{}
+		_spec       = uiq.querySpec()
+		loadedTypes = [3]bool{
+			uiq.withPrimaries != nil,
+			uiq.withLinkedIdentities != nil,
+			uiq.withLinks != nil,
+		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*UserIdentity).scanValues(nil, columns)
@@ -341,6 +453,7 @@ func (uiq *UserIdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	_spec.Assign = func(columns []string, values []any) error {
 		node := &UserIdentity{config: uiq.config}
 		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
 	for i := range hooks {
@@ -352,9 +465,183 @@ func (uiq *UserIdentityQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 	if len(nodes) == 0 {
 		return nodes, nil
 	}
+	if query := uiq.withPrimaries; query != nil {
+		if err := uiq.loadPrimaries(ctx, query, nodes,
+			func(n *UserIdentity) { n.Edges.Primaries = []*UserIdentity{} },
+			func(n *UserIdentity, e *UserIdentity) { n.Edges.Primaries = append(n.Edges.Primaries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uiq.withLinkedIdentities; query != nil {
+		if err := uiq.loadLinkedIdentities(ctx, query, nodes,
+			func(n *UserIdentity) { n.Edges.LinkedIdentities = []*UserIdentity{} },
+			func(n *UserIdentity, e *UserIdentity) { n.Edges.LinkedIdentities = append(n.Edges.LinkedIdentities, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uiq.withLinks; query != nil {
+		if err := uiq.loadLinks(ctx, query, nodes,
+			func(n *UserIdentity) { n.Edges.Links = []*IdentityLink{} },
+			func(n *UserIdentity, e *IdentityLink) { n.Edges.Links = append(n.Edges.Links, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
+func (uiq *UserIdentityQuery) loadPrimaries(ctx context.Context, query *UserIdentityQuery, nodes []*UserIdentity, init func(*UserIdentity), assign func(*UserIdentity, *UserIdentity)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*UserIdentity)
+	nids := make(map[string]map[*UserIdentity]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(useridentity.PrimariesTable)
+		s.Join(joinT).On(s.C(useridentity.FieldID), joinT.C(useridentity.PrimariesPrimaryKey[1]))
+		s.Where(sql.InValues(joinT.C(useridentity.PrimariesPrimaryKey[0]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(useridentity.PrimariesPrimaryKey[0]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*UserIdentity]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*UserIdentity](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "primaries" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uiq *UserIdentityQuery) loadLinkedIdentities(ctx context.Context, query *UserIdentityQuery, nodes []*UserIdentity, init func(*UserIdentity), assign func(*UserIdentity, *UserIdentity)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[string]*UserIdentity)
+	nids := make(map[string]map[*UserIdentity]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(useridentity.LinkedIdentitiesTable)
+		s.Join(joinT).On(s.C(useridentity.FieldID), joinT.C(useridentity.LinkedIdentitiesPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(useridentity.LinkedIdentitiesPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(useridentity.LinkedIdentitiesPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullString)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := values[0].(*sql.NullString).String
+				inValue := values[1].(*sql.NullString).String
+				if nids[inValue] == nil {
+					nids[inValue] = map[*UserIdentity]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*UserIdentity](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "linked_identities" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
+func (uiq *UserIdentityQuery) loadLinks(ctx context.Context, query *IdentityLinkQuery, nodes []*UserIdentity, init func(*UserIdentity), assign func(*UserIdentity, *IdentityLink)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*UserIdentity)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(identitylink.FieldIdentityID)
+	}
+	query.Where(predicate.IdentityLink(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(useridentity.LinksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.IdentityID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "identity_id" returned %v for node %v`, fk, n)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
 func (uiq *UserIdentityQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uiq.querySpec()
 	_spec.Node.Columns = uiq.ctx.Fields
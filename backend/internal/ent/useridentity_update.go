@@ -130,17 +130,137 @@ func (uiu *UserIdentityUpdate) SetNillableVerified(b *bool) *UserIdentityUpdate
 	return uiu
 }
 
+// SetDisplayNameEdited sets the "display_name_edited" field.
+func (uiu *UserIdentityUpdate) SetDisplayNameEdited(b bool) *UserIdentityUpdate {
+	uiu.mutation.SetDisplayNameEdited(b)
+	return uiu
+}
+
+// SetNillableDisplayNameEdited sets the "display_name_edited" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableDisplayNameEdited(b *bool) *UserIdentityUpdate {
+	if b != nil {
+		uiu.SetDisplayNameEdited(*b)
+	}
+	return uiu
+}
+
+// SetAvatarURLEdited sets the "avatar_url_edited" field.
+func (uiu *UserIdentityUpdate) SetAvatarURLEdited(b bool) *UserIdentityUpdate {
+	uiu.mutation.SetAvatarURLEdited(b)
+	return uiu
+}
+
+// SetNillableAvatarURLEdited sets the "avatar_url_edited" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableAvatarURLEdited(b *bool) *UserIdentityUpdate {
+	if b != nil {
+		uiu.SetAvatarURLEdited(*b)
+	}
+	return uiu
+}
+
+// SetProviderDisplayName sets the "provider_display_name" field.
+func (uiu *UserIdentityUpdate) SetProviderDisplayName(s string) *UserIdentityUpdate {
+	uiu.mutation.SetProviderDisplayName(s)
+	return uiu
+}
+
+// SetNillableProviderDisplayName sets the "provider_display_name" field if the given value is not nil.
+func (uiu *UserIdentityUpdate) SetNillableProviderDisplayName(s *string) *UserIdentityUpdate {
+	if s != nil {
+		uiu.SetProviderDisplayName(*s)
+	}
+	return uiu
+}
+
+// ClearProviderDisplayName clears the value of the "provider_display_name" field.
+func (uiu *UserIdentityUpdate) ClearProviderDisplayName() *UserIdentityUpdate {
+	uiu.mutation.ClearProviderDisplayName()
+	return uiu
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uiu *UserIdentityUpdate) SetUpdatedAt(t time.Time) *UserIdentityUpdate {
 	uiu.mutation.SetUpdatedAt(t)
 	return uiu
 }
 
+// AddPrimaryIDs adds the "primaries" edge to the UserIdentity entity by IDs.
+func (uiu *UserIdentityUpdate) AddPrimaryIDs(ids ...string) *UserIdentityUpdate {
+	uiu.mutation.AddPrimaryIDs(ids...)
+	return uiu
+}
+
+// AddPrimaries adds the "primaries" edges to the UserIdentity entity.
+func (uiu *UserIdentityUpdate) AddPrimaries(u ...*UserIdentity) *UserIdentityUpdate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiu.AddPrimaryIDs(ids...)
+}
+
+// AddLinkedIdentityIDs adds the "linked_identities" edge to the UserIdentity entity by IDs.
+func (uiu *UserIdentityUpdate) AddLinkedIdentityIDs(ids ...string) *UserIdentityUpdate {
+	uiu.mutation.AddLinkedIdentityIDs(ids...)
+	return uiu
+}
+
+// AddLinkedIdentities adds the "linked_identities" edges to the UserIdentity entity.
+func (uiu *UserIdentityUpdate) AddLinkedIdentities(u ...*UserIdentity) *UserIdentityUpdate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiu.AddLinkedIdentityIDs(ids...)
+}
+
 // Mutation returns the UserIdentityMutation object of the builder.
 func (uiu *UserIdentityUpdate) Mutation() *UserIdentityMutation {
 	return uiu.mutation
 }
 
+// ClearPrimaries clears all "primaries" edges to the UserIdentity entity.
+func (uiu *UserIdentityUpdate) ClearPrimaries() *UserIdentityUpdate {
+	uiu.mutation.ClearPrimaries()
+	return uiu
+}
+
+// RemovePrimaryIDs removes the "primaries" edge to UserIdentity entities by IDs.
+func (uiu *UserIdentityUpdate) RemovePrimaryIDs(ids ...string) *UserIdentityUpdate {
+	uiu.mutation.RemovePrimaryIDs(ids...)
+	return uiu
+}
+
+// RemovePrimaries removes "primaries" edges to UserIdentity entities.
+func (uiu *UserIdentityUpdate) RemovePrimaries(u ...*UserIdentity) *UserIdentityUpdate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiu.RemovePrimaryIDs(ids...)
+}
+
+// ClearLinkedIdentities clears all "linked_identities" edges to the UserIdentity entity.
+func (uiu *UserIdentityUpdate) ClearLinkedIdentities() *UserIdentityUpdate {
+	uiu.mutation.ClearLinkedIdentities()
+	return uiu
+}
+
+// RemoveLinkedIdentityIDs removes the "linked_identities" edge to UserIdentity entities by IDs.
+func (uiu *UserIdentityUpdate) RemoveLinkedIdentityIDs(ids ...string) *UserIdentityUpdate {
+	uiu.mutation.RemoveLinkedIdentityIDs(ids...)
+	return uiu
+}
+
+// RemoveLinkedIdentities removes "linked_identities" edges to UserIdentity entities.
+func (uiu *UserIdentityUpdate) RemoveLinkedIdentities(u ...*UserIdentity) *UserIdentityUpdate {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiu.RemoveLinkedIdentityIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (uiu *UserIdentityUpdate) Save(ctx context.Context) (int, error) {
 	uiu.defaults()
@@ -231,9 +351,123 @@ func (uiu *UserIdentityUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uiu.mutation.Verified(); ok {
 		_spec.SetField(useridentity.FieldVerified, field.TypeBool, value)
 	}
+	if value, ok := uiu.mutation.DisplayNameEdited(); ok {
+		_spec.SetField(useridentity.FieldDisplayNameEdited, field.TypeBool, value)
+	}
+	if value, ok := uiu.mutation.AvatarURLEdited(); ok {
+		_spec.SetField(useridentity.FieldAvatarURLEdited, field.TypeBool, value)
+	}
+	if value, ok := uiu.mutation.ProviderDisplayName(); ok {
+		_spec.SetField(useridentity.FieldProviderDisplayName, field.TypeString, value)
+	}
+	if uiu.mutation.ProviderDisplayNameCleared() {
+		_spec.ClearField(useridentity.FieldProviderDisplayName, field.TypeString)
+	}
 	if value, ok := uiu.mutation.UpdatedAt(); ok {
 		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if uiu.mutation.PrimariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		createE := &IdentityLinkCreate{config: uiu.config, mutation: newIdentityLinkMutation(uiu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiu.mutation.RemovedPrimariesIDs(); len(nodes) > 0 && !uiu.mutation.PrimariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &IdentityLinkCreate{config: uiu.config, mutation: newIdentityLinkMutation(uiu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiu.mutation.PrimariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &IdentityLinkCreate{config: uiu.config, mutation: newIdentityLinkMutation(uiu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uiu.mutation.LinkedIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiu.mutation.RemovedLinkedIdentitiesIDs(); len(nodes) > 0 && !uiu.mutation.LinkedIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiu.mutation.LinkedIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uiu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{useridentity.Label}
@@ -356,17 +590,137 @@ func (uiuo *UserIdentityUpdateOne) SetNillableVerified(b *bool) *UserIdentityUpd
 	return uiuo
 }
 
+// SetDisplayNameEdited sets the "display_name_edited" field.
+func (uiuo *UserIdentityUpdateOne) SetDisplayNameEdited(b bool) *UserIdentityUpdateOne {
+	uiuo.mutation.SetDisplayNameEdited(b)
+	return uiuo
+}
+
+// SetNillableDisplayNameEdited sets the "display_name_edited" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableDisplayNameEdited(b *bool) *UserIdentityUpdateOne {
+	if b != nil {
+		uiuo.SetDisplayNameEdited(*b)
+	}
+	return uiuo
+}
+
+// SetAvatarURLEdited sets the "avatar_url_edited" field.
+func (uiuo *UserIdentityUpdateOne) SetAvatarURLEdited(b bool) *UserIdentityUpdateOne {
+	uiuo.mutation.SetAvatarURLEdited(b)
+	return uiuo
+}
+
+// SetNillableAvatarURLEdited sets the "avatar_url_edited" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableAvatarURLEdited(b *bool) *UserIdentityUpdateOne {
+	if b != nil {
+		uiuo.SetAvatarURLEdited(*b)
+	}
+	return uiuo
+}
+
+// SetProviderDisplayName sets the "provider_display_name" field.
+func (uiuo *UserIdentityUpdateOne) SetProviderDisplayName(s string) *UserIdentityUpdateOne {
+	uiuo.mutation.SetProviderDisplayName(s)
+	return uiuo
+}
+
+// SetNillableProviderDisplayName sets the "provider_display_name" field if the given value is not nil.
+func (uiuo *UserIdentityUpdateOne) SetNillableProviderDisplayName(s *string) *UserIdentityUpdateOne {
+	if s != nil {
+		uiuo.SetProviderDisplayName(*s)
+	}
+	return uiuo
+}
+
+// ClearProviderDisplayName clears the value of the "provider_display_name" field.
+func (uiuo *UserIdentityUpdateOne) ClearProviderDisplayName() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearProviderDisplayName()
+	return uiuo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (uiuo *UserIdentityUpdateOne) SetUpdatedAt(t time.Time) *UserIdentityUpdateOne {
 	uiuo.mutation.SetUpdatedAt(t)
 	return uiuo
 }
 
+// AddPrimaryIDs adds the "primaries" edge to the UserIdentity entity by IDs.
+func (uiuo *UserIdentityUpdateOne) AddPrimaryIDs(ids ...string) *UserIdentityUpdateOne {
+	uiuo.mutation.AddPrimaryIDs(ids...)
+	return uiuo
+}
+
+// AddPrimaries adds the "primaries" edges to the UserIdentity entity.
+func (uiuo *UserIdentityUpdateOne) AddPrimaries(u ...*UserIdentity) *UserIdentityUpdateOne {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiuo.AddPrimaryIDs(ids...)
+}
+
+// AddLinkedIdentityIDs adds the "linked_identities" edge to the UserIdentity entity by IDs.
+func (uiuo *UserIdentityUpdateOne) AddLinkedIdentityIDs(ids ...string) *UserIdentityUpdateOne {
+	uiuo.mutation.AddLinkedIdentityIDs(ids...)
+	return uiuo
+}
+
+// AddLinkedIdentities adds the "linked_identities" edges to the UserIdentity entity.
+func (uiuo *UserIdentityUpdateOne) AddLinkedIdentities(u ...*UserIdentity) *UserIdentityUpdateOne {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiuo.AddLinkedIdentityIDs(ids...)
+}
+
 // Mutation returns the UserIdentityMutation object of the builder.
 func (uiuo *UserIdentityUpdateOne) Mutation() *UserIdentityMutation {
 	return uiuo.mutation
 }
 
+// ClearPrimaries clears all "primaries" edges to the UserIdentity entity.
+func (uiuo *UserIdentityUpdateOne) ClearPrimaries() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearPrimaries()
+	return uiuo
+}
+
+// RemovePrimaryIDs removes the "primaries" edge to UserIdentity entities by IDs.
+func (uiuo *UserIdentityUpdateOne) RemovePrimaryIDs(ids ...string) *UserIdentityUpdateOne {
+	uiuo.mutation.RemovePrimaryIDs(ids...)
+	return uiuo
+}
+
+// RemovePrimaries removes "primaries" edges to UserIdentity entities.
+func (uiuo *UserIdentityUpdateOne) RemovePrimaries(u ...*UserIdentity) *UserIdentityUpdateOne {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiuo.RemovePrimaryIDs(ids...)
+}
+
+// ClearLinkedIdentities clears all "linked_identities" edges to the UserIdentity entity.
+func (uiuo *UserIdentityUpdateOne) ClearLinkedIdentities() *UserIdentityUpdateOne {
+	uiuo.mutation.ClearLinkedIdentities()
+	return uiuo
+}
+
+// RemoveLinkedIdentityIDs removes the "linked_identities" edge to UserIdentity entities by IDs.
+func (uiuo *UserIdentityUpdateOne) RemoveLinkedIdentityIDs(ids ...string) *UserIdentityUpdateOne {
+	uiuo.mutation.RemoveLinkedIdentityIDs(ids...)
+	return uiuo
+}
+
+// RemoveLinkedIdentities removes "linked_identities" edges to UserIdentity entities.
+func (uiuo *UserIdentityUpdateOne) RemoveLinkedIdentities(u ...*UserIdentity) *UserIdentityUpdateOne {
+	ids := make([]string, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return uiuo.RemoveLinkedIdentityIDs(ids...)
+}
+
 // Where appends a list predicates to the UserIdentityUpdate builder.
 func (uiuo *UserIdentityUpdateOne) Where(ps ...predicate.UserIdentity) *UserIdentityUpdateOne {
 	uiuo.mutation.Where(ps...)
@@ -487,9 +841,123 @@ func (uiuo *UserIdentityUpdateOne) sqlSave(ctx context.Context) (_node *UserIden
 	if value, ok := uiuo.mutation.Verified(); ok {
 		_spec.SetField(useridentity.FieldVerified, field.TypeBool, value)
 	}
+	if value, ok := uiuo.mutation.DisplayNameEdited(); ok {
+		_spec.SetField(useridentity.FieldDisplayNameEdited, field.TypeBool, value)
+	}
+	if value, ok := uiuo.mutation.AvatarURLEdited(); ok {
+		_spec.SetField(useridentity.FieldAvatarURLEdited, field.TypeBool, value)
+	}
+	if value, ok := uiuo.mutation.ProviderDisplayName(); ok {
+		_spec.SetField(useridentity.FieldProviderDisplayName, field.TypeString, value)
+	}
+	if uiuo.mutation.ProviderDisplayNameCleared() {
+		_spec.ClearField(useridentity.FieldProviderDisplayName, field.TypeString)
+	}
 	if value, ok := uiuo.mutation.UpdatedAt(); ok {
 		_spec.SetField(useridentity.FieldUpdatedAt, field.TypeTime, value)
 	}
+	if uiuo.mutation.PrimariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		createE := &IdentityLinkCreate{config: uiuo.config, mutation: newIdentityLinkMutation(uiuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiuo.mutation.RemovedPrimariesIDs(); len(nodes) > 0 && !uiuo.mutation.PrimariesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &IdentityLinkCreate{config: uiuo.config, mutation: newIdentityLinkMutation(uiuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiuo.mutation.PrimariesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   useridentity.PrimariesTable,
+			Columns: useridentity.PrimariesPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &IdentityLinkCreate{config: uiuo.config, mutation: newIdentityLinkMutation(uiuo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if uiuo.mutation.LinkedIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiuo.mutation.RemovedLinkedIdentitiesIDs(); len(nodes) > 0 && !uiuo.mutation.LinkedIdentitiesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := uiuo.mutation.LinkedIdentitiesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   useridentity.LinkedIdentitiesTable,
+			Columns: useridentity.LinkedIdentitiesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(useridentity.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &UserIdentity{config: uiuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
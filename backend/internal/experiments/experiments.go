@@ -0,0 +1,58 @@
+package experiments
+
+import (
+	"hash/fnv"
+	"strings"
+
+	"silan-backend/internal/config"
+)
+
+// Find returns the active experiment with the given name.
+func Find(list []config.ExperimentConfig, name string) (config.ExperimentConfig, bool) {
+	for _, exp := range list {
+		if exp.Active && strings.EqualFold(exp.Name, name) {
+			return exp, true
+		}
+	}
+	return config.ExperimentConfig{}, false
+}
+
+// Assign deterministically picks a variant for the fingerprint. The same
+// fingerprint always lands in the same variant for a given experiment, while
+// different experiments bucket independently because the name is hashed too.
+func Assign(exp config.ExperimentConfig, fingerprint string) string {
+	total := 0
+	for _, v := range exp.Variants {
+		if v.Weight > 0 {
+			total += v.Weight
+		}
+	}
+	if total == 0 || fingerprint == "" {
+		return control(exp)
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(exp.Name))
+	h.Write([]byte{0})
+	h.Write([]byte(fingerprint))
+	bucket := int(h.Sum32() % uint32(total))
+
+	for _, v := range exp.Variants {
+		if v.Weight <= 0 {
+			continue
+		}
+		if bucket < v.Weight {
+			return v.Name
+		}
+		bucket -= v.Weight
+	}
+	return control(exp)
+}
+
+// control is the first variant, used when no bucket can be computed.
+func control(exp config.ExperimentConfig) string {
+	if len(exp.Variants) == 0 {
+		return ""
+	}
+	return exp.Variants[0].Name
+}
@@ -0,0 +1,323 @@
+package faq
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown FAQ entries.
+var ErrNotFound = errors.New("faq not found")
+
+// Analytics events recorded by the "was this helpful" endpoint. The FAQ is
+// identified by the event path, /faq/<id>.
+const (
+	EventHelpful    = "faq_helpful"
+	EventNotHelpful = "faq_not_helpful"
+)
+
+// Item is a question and its answer. Question and Answer hold the English
+// text; other languages live in Translations keyed by language code.
+type Item struct {
+	ID           string
+	Category     string
+	Question     string
+	Answer       string
+	SortOrder    int
+	Translations map[string]Translation
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Translation is the localized text of an item.
+type Translation struct {
+	Question string
+	Answer   string
+}
+
+// Localized returns the question and answer in lang, falling back to
+// English when there is no translation.
+func (it *Item) Localized(lang string) (question, answer string) {
+	if tr, ok := it.Translations[lang]; ok && lang != "en" {
+		return tr.Question, tr.Answer
+	}
+	return it.Question, it.Answer
+}
+
+// Data converts the item to its public representation in lang.
+func (it *Item) Data(lang string) types.FAQData {
+	question, answer := it.Localized(lang)
+	return types.FAQData{
+		ID:        it.ID,
+		Category:  it.Category,
+		Question:  question,
+		Answer:    answer,
+		SortOrder: it.SortOrder,
+	}
+}
+
+// Store persists FAQ entries in the raw faqs and faq_translations tables.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+const itemColumns = `id, category, question, answer, sort_order, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanItem(row scanner) (*Item, error) {
+	var it Item
+	if err := row.Scan(&it.ID, &it.Category, &it.Question, &it.Answer, &it.SortOrder, &it.CreatedAt, &it.UpdatedAt); err != nil {
+		return nil, err
+	}
+	it.Translations = map[string]Translation{}
+	return &it, nil
+}
+
+// List returns all entries ordered by category, then sort order.
+func (s *Store) List(ctx context.Context) ([]*Item, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+itemColumns+` FROM faqs ORDER BY category, sort_order, created_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*Item
+	byID := map[string]*Item{}
+	for rows.Next() {
+		it, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, it)
+		byID[it.ID] = it
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	trRows, err := s.db.QueryContext(ctx,
+		`SELECT faq_id, language_code, question, answer FROM faq_translations`)
+	if err != nil {
+		return nil, err
+	}
+	defer trRows.Close()
+
+	for trRows.Next() {
+		var id, lang string
+		var tr Translation
+		if err := trRows.Scan(&id, &lang, &tr.Question, &tr.Answer); err != nil {
+			return nil, err
+		}
+		if it, ok := byID[id]; ok {
+			it.Translations[lang] = tr
+		}
+	}
+	return items, trRows.Err()
+}
+
+// Get returns an entry with its translations.
+func (s *Store) Get(ctx context.Context, id string) (*Item, error) {
+	it, err := scanItem(s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT `+itemColumns+` FROM faqs WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, utils.Rebind(s.driver,
+		`SELECT language_code, question, answer FROM faq_translations WHERE faq_id = ?`), id)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var lang string
+		var tr Translation
+		if err := rows.Scan(&lang, &tr.Question, &tr.Answer); err != nil {
+			return nil, err
+		}
+		it.Translations[lang] = tr
+	}
+	return it, rows.Err()
+}
+
+// Create stores a new entry and fills in its ID and timestamps.
+func (s *Store) Create(ctx context.Context, it *Item) error {
+	now := time.Now().UTC()
+	it.ID = uuid.New().String()
+	it.CreatedAt = now
+	it.UpdatedAt = now
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO faqs (id, category, question, answer, sort_order, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		it.ID, it.Category, it.Question, it.Answer, it.SortOrder, it.CreatedAt, it.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if err := s.insertTranslations(ctx, tx, it); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Restore stores a deleted entry and its translations again with the
+// original ID and timestamps.
+func (s *Store) Restore(ctx context.Context, it *Item) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO faqs (id, category, question, answer, sort_order, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		it.ID, it.Category, it.Question, it.Answer, it.SortOrder, it.CreatedAt, it.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if err := s.insertTranslations(ctx, tx, it); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Update saves all editable fields of an entry and replaces its translations.
+func (s *Store) Update(ctx context.Context, it *Item) error {
+	it.UpdatedAt = time.Now().UTC()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE faqs SET category = ?, question = ?, answer = ?, sort_order = ?, updated_at = ? WHERE id = ?`),
+		it.Category, it.Question, it.Answer, it.SortOrder, it.UpdatedAt, it.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM faq_translations WHERE faq_id = ?`), it.ID); err != nil {
+		return err
+	}
+	if err := s.insertTranslations(ctx, tx, it); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (s *Store) insertTranslations(ctx context.Context, tx *sql.Tx, it *Item) error {
+	langs := make([]string, 0, len(it.Translations))
+	for lang := range it.Translations {
+		langs = append(langs, lang)
+	}
+	sort.Strings(langs)
+
+	for _, lang := range langs {
+		tr := it.Translations[lang]
+		_, err := tx.ExecContext(ctx, utils.Rebind(s.driver,
+			`INSERT INTO faq_translations (faq_id, language_code, question, answer) VALUES (?, ?, ?, ?)`),
+			it.ID, lang, tr.Question, tr.Answer,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete removes an entry and its translations.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM faq_translations WHERE faq_id = ?`), id); err != nil {
+		return err
+	}
+	res, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM faqs WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// Feedback holds the "was this helpful" votes of an entry.
+type Feedback struct {
+	Helpful    int
+	NotHelpful int
+}
+
+// FeedbackCounts tallies the feedback events in analytics_events per FAQ ID.
+func (s *Store) FeedbackCounts(ctx context.Context) (map[string]Feedback, error) {
+	rows, err := s.db.QueryContext(ctx, utils.Rebind(s.driver,
+		`SELECT name, path, COUNT(*) FROM analytics_events
+		WHERE name IN (?, ?) GROUP BY name, path`),
+		EventHelpful, EventNotHelpful,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := map[string]Feedback{}
+	for rows.Next() {
+		var (
+			name string
+			path sql.NullString
+			n    int
+		)
+		if err := rows.Scan(&name, &path, &n); err != nil {
+			return nil, err
+		}
+		id := strings.TrimPrefix(path.String, "/faq/")
+		fb := counts[id]
+		if name == EventHelpful {
+			fb.Helpful += n
+		} else {
+			fb.NotHelpful += n
+		}
+		counts[id] = fb
+	}
+	return counts, rows.Err()
+}
@@ -0,0 +1,314 @@
+// Package feeds keeps the generated sitemap and RSS feeds in memory and
+// rebuilds them when content changes, rather than on every request.
+package feeds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogcategory"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/blogtag"
+	"silan-backend/internal/media"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/siteupdate"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Names of the cached artifacts and the paths they are served under, which
+// are also the paths sent to the CDN purge webhook.
+const (
+	Sitemap        = "/sitemap.xml"
+	BlogRSS        = "/rss.xml"
+	SiteUpdatesRSS = "/api/v1/site-updates/rss"
+	ProjectsRSS    = "/feeds/projects"
+	IdeasRSS       = "/feeds/ideas"
+	PodcastRSS     = "/feeds/podcast"
+)
+
+// TagRSS is the path of the feed of posts with the tag slug.
+func TagRSS(slug string) string {
+	return "/feeds/tags/" + slug
+}
+
+// CategoryRSS is the path of the feed of posts in the category slug.
+func CategoryRSS(slug string) string {
+	return "/feeds/categories/" + slug
+}
+
+const (
+	// blogFeedSize is the number of posts included in the blog, tag,
+	// category and podcast feeds.
+	blogFeedSize = 20
+	// sectionFeedSize is the number of projects or ideas included in their
+	// feeds.
+	sectionFeedSize = 20
+	// siteUpdatesFeedSize is the number of updates included in the
+	// site updates feed.
+	siteUpdatesFeedSize = 50
+)
+
+// Kinds of feeds listed in the index.
+const (
+	KindBlog        = "blog"
+	KindProjects    = "projects"
+	KindIdeas       = "ideas"
+	KindSiteUpdates = "site_updates"
+	KindPodcast     = "podcast"
+	KindTag         = "tag"
+	KindCategory    = "category"
+)
+
+// Feed describes one of the RSS feeds for the feeds index.
+type Feed struct {
+	Kind  string
+	Title string
+	Path  string
+}
+
+// Artifact is a generated document.
+type Artifact struct {
+	Body        []byte
+	ContentType string
+	BuiltAt     time.Time
+}
+
+// Cache holds the current artifacts. They are built on first use and
+// rebuilt by Handle when a content event comes through the outbox.
+type Cache struct {
+	client     *ent.Client
+	changelog  *siteupdate.Store
+	audio      *media.AudioStore
+	siteURL    string
+	purgeURL   string
+	purgeToken string
+	http       *http.Client
+
+	// build serializes rebuilds; mu guards artifacts and feeds
+	build     sync.Mutex
+	mu        sync.RWMutex
+	artifacts map[string]Artifact
+	feeds     []Feed
+}
+
+func NewCache(client *ent.Client, changelog *siteupdate.Store, audio *media.AudioStore, siteURL, purgeURL, purgeToken string) *Cache {
+	return &Cache{
+		client:     client,
+		changelog:  changelog,
+		audio:      audio,
+		siteURL:    strings.TrimRight(siteURL, "/"),
+		purgeURL:   purgeURL,
+		purgeToken: purgeToken,
+		http:       &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Get returns the named artifact, building all of them if nothing has been
+// built yet.
+func (c *Cache) Get(ctx context.Context, name string) (Artifact, error) {
+	c.mu.RLock()
+	built := c.artifacts != nil
+	a, ok := c.artifacts[name]
+	c.mu.RUnlock()
+	if ok {
+		return a, nil
+	}
+	if built {
+		return Artifact{}, fmt.Errorf("unknown feed %s", name)
+	}
+
+	if err := c.rebuild(ctx); err != nil {
+		return Artifact{}, err
+	}
+	return c.Get(ctx, name)
+}
+
+// Feeds returns the RSS feeds, building them if nothing has been built yet.
+// Tag and category feeds are only listed while they have posts.
+func (c *Cache) Feeds(ctx context.Context) ([]Feed, error) {
+	c.mu.RLock()
+	built := c.artifacts != nil
+	feeds := c.feeds
+	c.mu.RUnlock()
+	if built {
+		return feeds, nil
+	}
+
+	if err := c.rebuild(ctx); err != nil {
+		return nil, err
+	}
+	return c.Feeds(ctx)
+}
+
+// Rebuild regenerates every artifact and asks the CDN to drop its copies.
+func (c *Cache) Rebuild(ctx context.Context) error {
+	if err := c.rebuild(ctx); err != nil {
+		return err
+	}
+	return c.purge(ctx)
+}
+
+// Handle is an outbox handler that rebuilds the artifacts on content
+// events and ignores everything else.
+func (c *Cache) Handle(ctx context.Context, ev outbox.Event) error {
+	if !outbox.IsContentEvent(ev.Type) {
+		return nil
+	}
+	if err := c.Rebuild(ctx); err != nil {
+		return fmt.Errorf("rebuild feeds: %w", err)
+	}
+	logx.WithContext(ctx).Infof("Rebuilt feeds after %s", ev.Type)
+	return nil
+}
+
+func (c *Cache) rebuild(ctx context.Context) error {
+	c.build.Lock()
+	defer c.build.Unlock()
+
+	now := time.Now().UTC()
+	artifacts := map[string]Artifact{}
+
+	sitemap, err := c.sitemap(ctx)
+	if err != nil {
+		return fmt.Errorf("sitemap: %w", err)
+	}
+	artifacts[Sitemap] = Artifact{Body: sitemap, ContentType: "application/xml; charset=utf-8", BuiltAt: now}
+
+	const rssType = "application/rss+xml; charset=utf-8"
+	var feeds []Feed
+	add := func(f Feed, body []byte) {
+		artifacts[f.Path] = Artifact{Body: body, ContentType: rssType, BuiltAt: now}
+		feeds = append(feeds, f)
+	}
+
+	blog, err := c.postsRSS(ctx, rssChannel{
+		Title:       "Blog",
+		Link:        c.siteURL + "/blog",
+		Description: "Latest blog posts",
+	})
+	if err != nil {
+		return fmt.Errorf("blog feed: %w", err)
+	}
+	add(Feed{Kind: KindBlog, Title: "Blog", Path: BlogRSS}, blog)
+
+	projects, err := c.projectsRSS(ctx)
+	if err != nil {
+		return fmt.Errorf("projects feed: %w", err)
+	}
+	add(Feed{Kind: KindProjects, Title: "Projects", Path: ProjectsRSS}, projects)
+
+	ideas, err := c.ideasRSS(ctx)
+	if err != nil {
+		return fmt.Errorf("ideas feed: %w", err)
+	}
+	add(Feed{Kind: KindIdeas, Title: "Ideas", Path: IdeasRSS}, ideas)
+
+	updates, err := c.changelog.List(ctx, siteUpdatesFeedSize)
+	if err != nil {
+		return fmt.Errorf("site updates feed: %w", err)
+	}
+	changelog, err := siteupdate.RSS(c.siteURL, updates)
+	if err != nil {
+		return fmt.Errorf("site updates feed: %w", err)
+	}
+	add(Feed{Kind: KindSiteUpdates, Title: "Site updates", Path: SiteUpdatesRSS}, changelog)
+
+	podcast, err := c.podcastRSS(ctx)
+	if err != nil {
+		return fmt.Errorf("podcast feed: %w", err)
+	}
+	add(Feed{Kind: KindPodcast, Title: "Podcast", Path: PodcastRSS}, podcast)
+
+	// Tags and categories without published posts get no feed
+	published := blogpost.StatusEQ(blogpost.StatusPublished)
+	categories, err := c.client.BlogCategory.Query().
+		Where(blogcategory.HasBlogPostsWith(published)).
+		Order(ent.Asc(blogcategory.FieldName)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("category feeds: %w", err)
+	}
+	for _, cat := range categories {
+		body, err := c.postsRSS(ctx, rssChannel{
+			Title:       "Blog: " + cat.Name,
+			Link:        c.siteURL + "/blog",
+			Description: "Latest blog posts in " + cat.Name,
+		}, blogpost.HasCategoryWith(blogcategory.IDEQ(cat.ID)))
+		if err != nil {
+			return fmt.Errorf("category feed %s: %w", cat.Slug, err)
+		}
+		add(Feed{Kind: KindCategory, Title: cat.Name, Path: CategoryRSS(cat.Slug)}, body)
+	}
+
+	tags, err := c.client.BlogTag.Query().
+		Where(blogtag.HasBlogPostsWith(published)).
+		Order(ent.Asc(blogtag.FieldName)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("tag feeds: %w", err)
+	}
+	for _, tag := range tags {
+		body, err := c.postsRSS(ctx, rssChannel{
+			Title:       "Blog: " + tag.Name,
+			Link:        c.siteURL + "/blog",
+			Description: "Latest blog posts tagged " + tag.Name,
+		}, blogpost.HasTagsWith(blogtag.IDEQ(tag.ID)))
+		if err != nil {
+			return fmt.Errorf("tag feed %s: %w", tag.Slug, err)
+		}
+		add(Feed{Kind: KindTag, Title: tag.Name, Path: TagRSS(tag.Slug)}, body)
+	}
+
+	c.mu.Lock()
+	c.artifacts, c.feeds = artifacts, feeds
+	c.mu.Unlock()
+	return nil
+}
+
+// purge posts the artifact paths to the configured purge webhook, e.g. a
+// small worker in front of the CDN API. It is a no-op when unset.
+func (c *Cache) purge(ctx context.Context) error {
+	if c.purgeURL == "" {
+		return nil
+	}
+
+	c.mu.RLock()
+	paths := make([]string, 0, len(c.artifacts))
+	for path := range c.artifacts {
+		paths = append(paths, path)
+	}
+	c.mu.RUnlock()
+	sort.Strings(paths)
+
+	body, err := json.Marshal(map[string][]string{"paths": paths})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.purgeURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.purgeToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.purgeToken)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("purge cache: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("purge cache: unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package feeds
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/media"
+
+	"github.com/google/uuid"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Itunes  string     `xml:"xmlns:itunes,attr,omitempty"`
+	Channel rssChannel `xml:"channel"`
+}
+
+// itunesNS is the namespace of the iTunes podcast tags, which podcast apps
+// read durations from.
+const itunesNS = "http://www.itunes.com/dtds/podcast-1.0.dtd"
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Categories  []string `xml:"category,omitempty"`
+	GUID        rssGUID  `xml:"guid"`
+	PubDate     string   `xml:"pubDate"`
+	// Enclosure and Duration are only set in the podcast feed
+	Enclosure *rssEnclosure `xml:"enclosure,omitempty"`
+	Duration  string        `xml:"itunes:duration,omitempty"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// postsRSS renders the most recent published posts matching preds as an
+// RSS 2.0 feed.
+func (c *Cache) postsRSS(ctx context.Context, channel rssChannel, preds ...predicate.BlogPost) ([]byte, error) {
+	posts, err := c.client.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		Where(preds...).
+		WithTags().
+		Order(ent.Desc(blogpost.FieldPublishedAt)).
+		Limit(blogFeedSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, p := range posts {
+		published := p.PublishedAt
+		if published.IsZero() {
+			published = p.CreatedAt
+		}
+		if i == 0 {
+			channel.LastBuildDate = published.Format(time.RFC1123Z)
+		}
+
+		link := c.siteURL + "/blog/" + p.ID.String()
+		item := rssItem{
+			Title:       p.Title,
+			Link:        link,
+			Description: p.Excerpt,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     published.Format(time.RFC1123Z),
+		}
+		for _, t := range p.Edges.Tags {
+			item.Categories = append(item.Categories, t.Name)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+	return render(channel)
+}
+
+// projectsRSS renders the most recently added public projects.
+func (c *Cache) projectsRSS(ctx context.Context) ([]byte, error) {
+	projects, err := c.client.Project.Query().
+		Where(project.IsPublic(true)).
+		WithTechnologies().
+		Order(ent.Desc(project.FieldCreatedAt)).
+		Limit(sectionFeedSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := rssChannel{
+		Title:       "Projects",
+		Link:        c.siteURL + "/projects",
+		Description: "Latest projects",
+	}
+	for i, p := range projects {
+		if i == 0 {
+			channel.LastBuildDate = p.CreatedAt.Format(time.RFC1123Z)
+		}
+		link := c.siteURL + "/projects/" + p.ID.String()
+		item := rssItem{
+			Title:       p.Title,
+			Link:        link,
+			Description: p.Description,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     p.CreatedAt.Format(time.RFC1123Z),
+		}
+		for _, t := range p.Edges.Technologies {
+			item.Categories = append(item.Categories, t.TechnologyName)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+	return render(channel)
+}
+
+// ideasRSS renders the most recently added public ideas.
+func (c *Cache) ideasRSS(ctx context.Context) ([]byte, error) {
+	ideas, err := c.client.Idea.Query().
+		Where(idea.IsPublic(true)).
+		WithTags().
+		Order(ent.Desc(idea.FieldCreatedAt)).
+		Limit(sectionFeedSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := rssChannel{
+		Title:       "Ideas",
+		Link:        c.siteURL + "/ideas",
+		Description: "Latest ideas",
+	}
+	for i, it := range ideas {
+		if i == 0 {
+			channel.LastBuildDate = it.CreatedAt.Format(time.RFC1123Z)
+		}
+		link := c.siteURL + "/ideas/" + it.ID.String()
+		item := rssItem{
+			Title:       it.Title,
+			Link:        link,
+			Description: it.Abstract,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     it.CreatedAt.Format(time.RFC1123Z),
+		}
+		for _, t := range it.Edges.Tags {
+			item.Categories = append(item.Categories, t.Name)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+	return render(channel)
+}
+
+// podcastRSS renders the most recent published posts with audio attached,
+// the audio as each item's enclosure.
+func (c *Cache) podcastRSS(ctx context.Context) ([]byte, error) {
+	audio, err := c.audio.All(ctx, media.OwnerPost)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, 0, len(audio))
+	for id := range audio {
+		if uid, err := uuid.Parse(id); err == nil {
+			ids = append(ids, uid)
+		}
+	}
+	posts, err := c.client.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished), blogpost.IDIn(ids...)).
+		WithTags().
+		Order(ent.Desc(blogpost.FieldPublishedAt)).
+		Limit(blogFeedSize).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := rssChannel{
+		Title:       "Podcast",
+		Link:        c.siteURL + "/blog",
+		Description: "Narrated blog posts",
+	}
+	for i, p := range posts {
+		published := p.PublishedAt
+		if published.IsZero() {
+			published = p.CreatedAt
+		}
+		if i == 0 {
+			channel.LastBuildDate = published.Format(time.RFC1123Z)
+		}
+
+		a := audio[p.ID.String()]
+		link := c.siteURL + "/blog/" + p.ID.String()
+		item := rssItem{
+			Title:       p.Title,
+			Link:        link,
+			Description: p.Excerpt,
+			GUID:        rssGUID{IsPermaLink: true, Value: link},
+			PubDate:     published.Format(time.RFC1123Z),
+			Enclosure:   &rssEnclosure{URL: a.URL, Length: a.Length, Type: a.ContentType},
+		}
+		if a.DurationSeconds > 0 {
+			d := a.DurationSeconds
+			item.Duration = fmt.Sprintf("%d:%02d:%02d", d/3600, d/60%60, d%60)
+		}
+		for _, t := range p.Edges.Tags {
+			item.Categories = append(item.Categories, t.Name)
+		}
+		channel.Items = append(channel.Items, item)
+	}
+	out, err := xml.MarshalIndent(rssFeed{Version: "2.0", Itunes: itunesNS, Channel: channel}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+func render(channel rssChannel) ([]byte, error) {
+	out, err := xml.MarshalIndent(rssFeed{Version: "2.0", Channel: channel}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
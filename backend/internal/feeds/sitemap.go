@@ -0,0 +1,84 @@
+package feeds
+
+import (
+	"context"
+	"encoding/xml"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+)
+
+type urlSet struct {
+	XMLName xml.Name     `xml:"urlset"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	URLs    []sitemapURL `xml:"url"`
+}
+
+type sitemapURL struct {
+	Loc     string `xml:"loc"`
+	LastMod string `xml:"lastmod,omitempty"`
+}
+
+// sections are the static pages of the site.
+var sections = []string{"", "/blog", "/projects", "/ideas", "/plans", "/recent-updates", "/contact"}
+
+// sitemap lists the static pages and every published post, public project
+// and public idea.
+func (c *Cache) sitemap(ctx context.Context) ([]byte, error) {
+	set := urlSet{XMLNS: "http://www.sitemaps.org/schemas/sitemap/0.9"}
+	for _, s := range sections {
+		set.URLs = append(set.URLs, sitemapURL{Loc: c.siteURL + s})
+	}
+
+	posts, err := c.client.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		Select(blogpost.FieldID, blogpost.FieldUpdatedAt).
+		Order(ent.Desc(blogpost.FieldPublishedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		set.URLs = append(set.URLs, sitemapURL{Loc: c.siteURL + "/blog/" + p.ID.String(), LastMod: lastMod(p.UpdatedAt)})
+	}
+
+	projects, err := c.client.Project.Query().
+		Where(project.IsPublic(true)).
+		Select(project.FieldID, project.FieldUpdatedAt).
+		Order(ent.Desc(project.FieldSortOrder), ent.Desc(project.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		set.URLs = append(set.URLs, sitemapURL{Loc: c.siteURL + "/projects/" + p.ID.String(), LastMod: lastMod(p.UpdatedAt)})
+	}
+
+	ideas, err := c.client.Idea.Query().
+		Where(idea.IsPublic(true)).
+		Select(idea.FieldID, idea.FieldUpdatedAt).
+		Order(ent.Desc(idea.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ideas {
+		set.URLs = append(set.URLs, sitemapURL{Loc: c.siteURL + "/ideas/" + i.ID.String(), LastMod: lastMod(i.UpdatedAt)})
+	}
+
+	out, err := xml.MarshalIndent(set, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
+
+func lastMod(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
@@ -0,0 +1,209 @@
+// Package fingerprint turns browser fingerprints into keyed hashes before
+// they are stored, so that likes, views and comments can still be
+// deduplicated per browser without keeping the raw value.
+//
+// Hashes are HMACs keyed with a server secret and a random salt kept in the
+// fingerprint_salts table. Salts are rotated periodically; the newest one
+// hashes new rows while a few older ones are kept so that recent rows can
+// still be matched. Once a salt is dropped, rows hashed with it can no
+// longer be linked to a browser.
+package fingerprint
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Prefix starts every hashed fingerprint, telling them apart from raw
+// values written before hashing was introduced.
+const Prefix = "h1:"
+
+// reloadEvery is how often salts rotated by other instances are picked up.
+const reloadEvery = 5 * time.Minute
+
+// IsHashed reports whether v is a hashed fingerprint.
+func IsHashed(v string) bool {
+	return strings.HasPrefix(v, Prefix)
+}
+
+type salt struct {
+	value     []byte
+	createdAt time.Time
+}
+
+// Hasher hashes fingerprints with the current salts.
+type Hasher struct {
+	db     *sql.DB
+	driver string
+	secret string
+	rotate time.Duration
+	keep   int
+
+	mu       sync.Mutex
+	salts    []salt // newest first
+	loadedAt time.Time
+}
+
+// NewHasher returns a hasher keyed with secret that starts a new salt every
+// rotate (never when 0) and matches against the newest keep salts.
+func NewHasher(db *sql.DB, driver, secret string, rotate time.Duration, keep int) *Hasher {
+	if keep < 1 {
+		keep = 1
+	}
+	return &Hasher{db: db, driver: driver, secret: secret, rotate: rotate, keep: keep}
+}
+
+func (h *Hasher) rebind(q string) string {
+	return utils.Rebind(h.driver, q)
+}
+
+// Hash returns fingerprint hashed with the current salt, the form new rows
+// store it in. Empty fingerprints stay empty.
+func (h *Hasher) Hash(ctx context.Context, fingerprint string) string {
+	if fingerprint == "" {
+		return ""
+	}
+	return h.sum(h.current(ctx)[0], fingerprint)
+}
+
+// Hashes returns fingerprint hashed with every salt still kept, current
+// first, for matching stored rows. Empty fingerprints give nil.
+func (h *Hasher) Hashes(ctx context.Context, fingerprint string) []string {
+	if fingerprint == "" {
+		return nil
+	}
+	salts := h.current(ctx)
+	hashes := make([]string, len(salts))
+	for i, s := range salts {
+		hashes[i] = h.sum(s, fingerprint)
+	}
+	return hashes
+}
+
+func (h *Hasher) sum(s salt, fingerprint string) string {
+	mac := hmac.New(sha256.New, append([]byte(h.secret), s.value...))
+	mac.Write([]byte(fingerprint))
+	return Prefix + hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// current returns the salts in use, loading them when stale. Should the
+// table be unreachable, the secret alone keys the hash, so raw fingerprints
+// are never stored.
+func (h *Hasher) current(ctx context.Context) []salt {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.salts) > 0 && time.Since(h.loadedAt) < reloadEvery {
+		return h.salts
+	}
+	salts, err := h.load(ctx)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to load fingerprint salts: %v", err)
+		if len(h.salts) > 0 {
+			return h.salts
+		}
+		return []salt{{}}
+	}
+	h.salts, h.loadedAt = salts, time.Now()
+	return salts
+}
+
+// load reads the kept salts, creating the first one on a fresh database.
+func (h *Hasher) load(ctx context.Context) ([]salt, error) {
+	salts, err := h.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(salts) == 0 {
+		if err := h.add(ctx, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+		if salts, err = h.list(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if len(salts) > h.keep {
+		salts = salts[:h.keep]
+	}
+	return salts, nil
+}
+
+func (h *Hasher) list(ctx context.Context) ([]salt, error) {
+	rows, err := h.db.QueryContext(ctx, `SELECT salt, created_at FROM fingerprint_salts ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var salts []salt
+	for rows.Next() {
+		var (
+			s     salt
+			value string
+		)
+		if err := rows.Scan(&value, &s.createdAt); err != nil {
+			return nil, err
+		}
+		if s.value, err = hex.DecodeString(value); err != nil {
+			return nil, err
+		}
+		salts = append(salts, s)
+	}
+	return salts, rows.Err()
+}
+
+func (h *Hasher) add(ctx context.Context, now time.Time) error {
+	value := make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return err
+	}
+	_, err := h.db.ExecContext(ctx, h.rebind(
+		`INSERT INTO fingerprint_salts (id, salt, created_at) VALUES (?, ?, ?)`),
+		uuid.New().String(), hex.EncodeToString(value), now,
+	)
+	return err
+}
+
+// Rotate starts a new salt once the newest is older than the rotation
+// period and deletes the salts beyond the kept ones. It is run daily by the
+// scheduler with the current time.
+func (h *Hasher) Rotate(ctx context.Context, now time.Time) error {
+	if h.rotate <= 0 {
+		return nil
+	}
+	salts, err := h.list(ctx)
+	if err != nil {
+		return err
+	}
+	if len(salts) > 0 && now.Sub(salts[0].createdAt) < h.rotate {
+		return nil
+	}
+	if err := h.add(ctx, now.UTC()); err != nil {
+		return err
+	}
+
+	// The new salt is kept, so only keep-1 of the existing ones stay
+	if len(salts) >= h.keep {
+		_, err = h.db.ExecContext(ctx, h.rebind(
+			`DELETE FROM fingerprint_salts WHERE created_at <= ?`), salts[h.keep-1].createdAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	h.mu.Lock()
+	h.loadedAt = time.Time{}
+	h.mu.Unlock()
+	return nil
+}
@@ -0,0 +1,195 @@
+// Package googleauth verifies Google ID tokens. The signature is checked
+// against Google's published signing keys, which are cached for as long as
+// Google's response allows, and the issuer, audience and expiry are checked
+// before any claim is trusted.
+package googleauth
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// certsURL lists Google's current ID token signing keys as a JWK set.
+const certsURL = "https://www.googleapis.com/oauth2/v3/certs"
+
+// minKeyTTL is how long keys are cached when Google's response doesn't say.
+const minKeyTTL = 5 * time.Minute
+
+// minRefetch is the least time between two fetches of the key set, so
+// tokens naming unknown keys can't make every request fetch it again.
+const minRefetch = time.Minute
+
+var (
+	// ErrDisabled is returned while no Google client ID is configured; the
+	// audience of a token can't be checked without one.
+	ErrDisabled = errors.New("google sign-in is not configured")
+	// ErrInvalidToken is returned for tokens that fail verification.
+	ErrInvalidToken = errors.New("invalid google id token")
+)
+
+// issuers are the values Google puts in the iss claim.
+var issuers = map[string]bool{
+	"accounts.google.com":         true,
+	"https://accounts.google.com": true,
+}
+
+// Claims are the claims of a verified ID token.
+type Claims struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	GivenName     string `json:"given_name"`
+	FamilyName    string `json:"family_name"`
+	jwt.RegisteredClaims
+}
+
+// Verifier checks ID tokens issued to one OAuth client.
+type Verifier struct {
+	clientID string
+	certsURL string
+	client   *http.Client
+
+	mu        sync.Mutex
+	keys      map[string]*rsa.PublicKey
+	expires   time.Time
+	fetchedAt time.Time
+}
+
+func NewVerifier(clientID string) *Verifier {
+	return &Verifier{clientID: clientID, certsURL: certsURL, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// Enabled reports whether a client ID is configured.
+func (v *Verifier) Enabled() bool {
+	return v != nil && v.clientID != ""
+}
+
+// Verify checks the RS256 signature of idToken with Google's keys, that it
+// was issued by Google for the configured client and that it hasn't
+// expired, and returns its claims.
+func (v *Verifier) Verify(ctx context.Context, idToken string) (*Claims, error) {
+	if !v.Enabled() {
+		return nil, ErrDisabled
+	}
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (any, error) {
+		if t.Method.Alg() != jwt.SigningMethodRS256.Alg() {
+			return nil, fmt.Errorf("unexpected signing method %s", t.Method.Alg())
+		}
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	// ParseWithClaims has checked exp, nbf and iat
+	if !issuers[claims.Issuer] {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, claims.Issuer)
+	}
+	if !claims.VerifyAudience(v.clientID, true) {
+		return nil, fmt.Errorf("%w: issued for another client", ErrInvalidToken)
+	}
+	if claims.ExpiresAt == nil || claims.Subject == "" {
+		return nil, fmt.Errorf("%w: missing exp or sub", ErrInvalidToken)
+	}
+	return claims, nil
+}
+
+// key returns the signing key kid, fetching the key set again when it has
+// expired or doesn't hold kid, which happens after Google rotates its keys.
+// The set is fetched at most once every minRefetch and outside the lock;
+// meanwhile the keys at hand are used and unknown ones fail right away.
+func (v *Verifier) key(ctx context.Context, kid string) (*rsa.PublicKey, error) {
+	v.mu.Lock()
+	k, ok := v.keys[kid]
+	if (ok && time.Now().Before(v.expires)) || time.Since(v.fetchedAt) < minRefetch {
+		v.mu.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("unknown signing key %q", kid)
+		}
+		return k, nil
+	}
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+
+	keys, expires, err := v.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	v.mu.Lock()
+	v.keys, v.expires = keys, expires
+	v.mu.Unlock()
+	k, ok = keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return k, nil
+}
+
+func (v *Verifier) fetch(ctx context.Context) (map[string]*rsa.PublicKey, time.Time, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, v.certsURL, nil)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	res, err := v.client.Do(req)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, time.Time{}, fmt.Errorf("%s returned %s", v.certsURL, res.Status)
+	}
+	var set struct {
+		Keys []struct {
+			Kid string `json:"kid"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&set); err != nil {
+		return nil, time.Time{}, err
+	}
+	keys := make(map[string]*rsa.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			continue
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+	return keys, time.Now().Add(maxAge(res.Header.Get("Cache-Control"))), nil
+}
+
+// maxAge returns the max-age of a Cache-Control header, at least minKeyTTL.
+func maxAge(header string) time.Duration {
+	for _, part := range strings.Split(header, ",") {
+		part = strings.TrimSpace(part)
+		if v, ok := strings.CutPrefix(part, "max-age="); ok {
+			if secs, err := strconv.Atoi(v); err == nil && time.Duration(secs)*time.Second > minKeyTTL {
+				return time.Duration(secs) * time.Second
+			}
+		}
+	}
+	return minKeyTTL
+}
@@ -0,0 +1,159 @@
+package googleauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+const testClientID = "client-1"
+
+// newTestVerifier returns a verifier for testClientID that fetches its keys
+// from a local server publishing key as kid "k1", and the number of fetches
+// made so far.
+func newTestVerifier(t *testing.T, key *rsa.PrivateKey) (*Verifier, *atomic.Int32) {
+	t.Helper()
+	fetches := &atomic.Int32{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kid": "k1",
+				"kty": "RSA",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+	t.Cleanup(srv.Close)
+	v := NewVerifier(testClientID)
+	v.certsURL = srv.URL
+	return v, fetches
+}
+
+func newKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func validClaims() *Claims {
+	now := time.Now()
+	return &Claims{
+		Email: "a@example.com",
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    "https://accounts.google.com",
+			Subject:   "123",
+			Audience:  jwt.ClaimStrings{testClientID},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+	}
+}
+
+func sign(t *testing.T, key *rsa.PrivateKey, kid string, claims *Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestVerify(t *testing.T) {
+	key, other := newKey(t), newKey(t)
+
+	tests := []struct {
+		name   string
+		token  func() string
+		wantOK bool
+	}{
+		{"valid", func() string { return sign(t, key, "k1", validClaims()) }, true},
+		{"bad signature", func() string { return sign(t, other, "k1", validClaims()) }, false},
+		{"wrong issuer", func() string {
+			c := validClaims()
+			c.Issuer = "https://evil.example.com"
+			return sign(t, key, "k1", c)
+		}, false},
+		{"wrong audience", func() string {
+			c := validClaims()
+			c.Audience = jwt.ClaimStrings{"another-client"}
+			return sign(t, key, "k1", c)
+		}, false},
+		{"expired", func() string {
+			c := validClaims()
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			return sign(t, key, "k1", c)
+		}, false},
+		{"unknown kid", func() string { return sign(t, key, "k2", validClaims()) }, false},
+		{"HS256", func() string {
+			signed, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("secret"))
+			return signed
+		}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, _ := newTestVerifier(t, key)
+			claims, err := v.Verify(context.Background(), tt.token())
+			if tt.wantOK {
+				if err != nil || claims.Subject != "123" {
+					t.Fatalf("got %v, %v", claims, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalidToken) {
+				t.Fatalf("got %v, want ErrInvalidToken", err)
+			}
+		})
+	}
+}
+
+func TestVerifyLimitsRefetches(t *testing.T) {
+	key := newKey(t)
+	v, fetches := newTestVerifier(t, key)
+	ctx := context.Background()
+
+	if _, err := v.Verify(ctx, sign(t, key, "k1", validClaims())); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err := v.Verify(ctx, sign(t, key, "unknown", validClaims())); err == nil {
+			t.Fatal("token with unknown kid verified")
+		}
+	}
+	if n := fetches.Load(); n != 1 {
+		t.Fatalf("fetched the key set %d times, want 1", n)
+	}
+
+	// past minRefetch an unknown kid fetches the set once more
+	v.mu.Lock()
+	v.fetchedAt = time.Now().Add(-minRefetch)
+	v.mu.Unlock()
+	v.Verify(ctx, sign(t, key, "unknown", validClaims()))
+	v.Verify(ctx, sign(t, key, "unknown", validClaims()))
+	if n := fetches.Load(); n != 2 {
+		t.Fatalf("fetched the key set %d times, want 2", n)
+	}
+}
+
+func TestVerifyDisabled(t *testing.T) {
+	if _, err := NewVerifier("").Verify(context.Background(), "token"); !errors.Is(err, ErrDisabled) {
+		t.Fatalf("got %v, want ErrDisabled", err)
+	}
+}
@@ -0,0 +1,29 @@
+package activity
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/activity"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// List the public activity feed: publishes, updates and approved comments
+func GetActivityFeedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ActivityRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := activity.NewGetActivityFeedLogic(r.Context(), svcCtx)
+		resp, err := l.GetActivityFeed(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
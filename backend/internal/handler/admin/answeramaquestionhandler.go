@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/admin"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Answer an AMA question, by marking an owner reply or posting one
+func AnswerAmaQuestionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AnswerAmaQuestionRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAnswerAmaQuestionLogic(r.Context(), svcCtx)
+		resp, err := l.AnswerAmaQuestion(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/admin"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Approve a held comment
+func ApproveCommentHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ModerateCommentRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewApproveCommentLogic(r.Context(), svcCtx)
+		err := l.ApproveComment(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.Ok(w)
+		}
+	}
+}
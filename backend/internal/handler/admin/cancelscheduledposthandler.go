@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/admin"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Cancel the scheduled publishing of a blog post
+func CancelScheduledPostHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ScheduledPostRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewCancelScheduledPostLogic(r.Context(), svcCtx)
+		err := l.CancelScheduledPost(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.Ok(w)
+		}
+	}
+}
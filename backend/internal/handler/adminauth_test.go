@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"silan-backend/internal/apikey"
+	"silan-backend/internal/config"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/utils"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/rest"
+)
+
+const (
+	adminKey      = "sk_config_admin_key"
+	signingSecret = "test-signing-secret"
+)
+
+// newTestServer returns the API on a fresh sqlite database, with the
+// global middlewares of backend.go and the admin API open to adminKey and
+// requests signed with signingSecret.
+func newTestServer(t *testing.T) (*rest.Server, *svc.ServiceContext) {
+	t.Helper()
+	b, err := os.ReadFile("../../etc/backend-api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the example config leaves the required auth section commented out
+	b = append(b, "\nAuth:\n  google_client_id: test-client\n  session_secret: test-session-secret\n"...)
+	var c config.Config
+	if err := conf.LoadFromYamlBytes(b, &c); err != nil {
+		t.Fatal(err)
+	}
+	c.Database.Driver = "sqlite3"
+	c.Database.Source = t.TempDir() + "/test.db?_fk=1"
+	c.Admin.APIKeyHashes = []string{apikey.Hash(adminKey)}
+	c.Signing.Secret = signingSecret
+
+	ctx := svc.NewServiceContext(c)
+	if err := ctx.DB.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	server := rest.MustNewServer(c.RestConf)
+	t.Cleanup(server.Stop)
+	server.Use(ctx.Analytics)
+	server.Use(ctx.ApiKey)
+	RegisterHandlers(server, ctx)
+	return server, ctx
+}
+
+// requests numbers the admin requests, so no two share a signature
+var requests atomic.Int32
+
+func adminRequest(server *rest.Server, key string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/admin/bans?n="+strconv.Itoa(int(requests.Add(1))), nil)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	r.Header.Set("X-Signature-Timestamp", timestamp)
+	r.Header.Set("X-Signature", utils.Sign(signingSecret, utils.SignaturePayload(timestamp, r.Method, r.URL.RequestURI(), nil)))
+	if key != "" {
+		r.Header.Set("X-API-Key", key)
+	}
+	w := httptest.NewRecorder()
+	server.ServeHTTP(w, r)
+	return w
+}
+
+func TestAdminRouteAcceptsConfigKey(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	if w := adminRequest(server, adminKey); w.Code != http.StatusOK {
+		t.Fatalf("config key: got %d %s", w.Code, w.Body)
+	}
+	if w := adminRequest(server, "sk_unknown"); w.Code != http.StatusUnauthorized {
+		t.Fatalf("unknown key: got %d %s", w.Code, w.Body)
+	}
+	if w := adminRequest(server, ""); w.Code != http.StatusUnauthorized {
+		t.Fatalf("no key: got %d %s", w.Code, w.Body)
+	}
+}
+
+func TestAdminRouteCountsStoredKeyOnce(t *testing.T) {
+	server, ctx := newTestServer(t)
+	bg := context.Background()
+
+	key, plain, err := ctx.ApiKeys.Create(bg, "sync", 0, 0, []string{apikey.ScopeAdmin})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := adminRequest(server, plain); w.Code != http.StatusOK {
+		t.Fatalf("admin key: got %d %s", w.Code, w.Body)
+	}
+	day, month, err := ctx.ApiKeys.Counts(bg, key.ID, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if day != 1 || month != 1 {
+		t.Fatalf("counted %d today and %d this month, want 1", day, month)
+	}
+
+	_, readOnly, err := ctx.ApiKeys.Create(bg, "reader", 0, 0, []string{apikey.ScopeReadPosts})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w := adminRequest(server, readOnly); w.Code != http.StatusUnauthorized {
+		t.Fatalf("key without admin scope: got %d %s", w.Code, w.Body)
+	}
+}
@@ -0,0 +1,29 @@
+package amas
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/amas"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get an AMA session with its questions and answers
+func GetAmaHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AmaRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := amas.NewGetAmaLogic(r.Context(), svcCtx)
+		resp, err := l.GetAma(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
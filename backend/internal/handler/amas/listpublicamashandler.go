@@ -0,0 +1,22 @@
+package amas
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/amas"
+	"silan-backend/internal/svc"
+)
+
+// List AMA sessions
+func ListPublicAmasHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := amas.NewListPublicAmasLogic(r.Context(), svcCtx)
+		resp, err := l.ListPublicAmas()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package analytics
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/analytics"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Get the visitor's analytics opt-out status for the consent banner
+func GetAnalyticsOptOutStatusHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AnalyticsOptOutStatusRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// The status depends on the visitor's cookie and headers only
+		req.Choice = utils.AnalyticsChoice(r)
+		req.BrowserDoNotTrack = utils.BrowserDoNotTrack(r)
+		w.Header().Set("Cache-Control", "private, no-store")
+
+		l := analytics.NewGetAnalyticsOptOutStatusLogic(r.Context(), svcCtx)
+		resp, err := l.GetAnalyticsOptOutStatus(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package analytics
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/analytics"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Opt the visitor out of (or back into) analytics
+func SetAnalyticsOptOutHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SetAnalyticsOptOutRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		req.BrowserDoNotTrack = utils.BrowserDoNotTrack(r)
+
+		l := analytics.NewSetAnalyticsOptOutLogic(r.Context(), svcCtx)
+		resp, err := l.SetAnalyticsOptOut(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// Remember the choice so later requests are honored without a body
+			utils.SetAnalyticsChoice(w, r, resp.Choice)
+			w.Header().Set("Cache-Control", "private, no-store")
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
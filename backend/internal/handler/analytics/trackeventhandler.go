@@ -0,0 +1,37 @@
+package analytics
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/analytics"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Record a custom analytics event
+func TrackEventHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TrackEventRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// Extract client info
+		req.ClientIP = utils.GetClientIP(r)
+		req.UserAgentFull = utils.GetUserAgent(r)
+
+		// Respect DNT / Global Privacy Control / analytics opt-out
+		req.DoNotTrack = utils.DoNotTrack(r)
+
+		l := analytics.NewTrackEventLogic(r.Context(), svcCtx)
+		resp, err := l.TrackEvent(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
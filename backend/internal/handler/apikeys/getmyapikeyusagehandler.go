@@ -0,0 +1,29 @@
+package apikeys
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/apikeys"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get usage and remaining quota of the calling API key
+func GetMyApiKeyUsageHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.MyApiKeyUsageRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := apikeys.NewGetMyApiKeyUsageLogic(r.Context(), svcCtx)
+		resp, err := l.GetMyApiKeyUsage(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
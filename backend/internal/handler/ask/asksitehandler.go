@@ -0,0 +1,33 @@
+package ask
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/ask"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Answer a question about the site from its posts, projects and ideas
+func AskSiteHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AskRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// Extract client info for rate limiting
+		req.ClientIP = utils.GetClientIP(r)
+
+		l := ask.NewAskSiteLogic(r.Context(), svcCtx)
+		resp, err := l.AskSite(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/logic/auth"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 )
 
 // Verify Google ID token and upsert identity
@@ -17,6 +18,8 @@ func GoogleVerifyHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			httpx.ErrorCtx(r.Context(), w, err)
 			return
 		}
+		req.ClientIP = utils.GetClientIP(r)
+		req.UserAgentFull = utils.GetUserAgent(r)
 
 		l := auth.NewGoogleVerifyLogic(r.Context(), svcCtx)
 		resp, err := l.GoogleVerify(&req)
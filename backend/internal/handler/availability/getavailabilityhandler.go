@@ -0,0 +1,22 @@
+package availability
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/availability"
+	"silan-backend/internal/svc"
+)
+
+// Get the owner's availability and upcoming office hours
+func GetAvailabilityHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := availability.NewGetAvailabilityLogic(r.Context(), svcCtx)
+		resp, err := l.GetAvailability()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package availability
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/availability"
+	"silan-backend/internal/svc"
+)
+
+// iCalendar feed of upcoming office hours
+func GetOfficeHoursCalendarHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := availability.NewGetOfficeHoursCalendarLogic(r.Context(), svcCtx)
+		body, err := l.GetOfficeHoursCalendar()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			w.Write(body)
+		}
+	}
+}
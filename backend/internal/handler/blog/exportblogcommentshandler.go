@@ -0,0 +1,36 @@
+package blog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/blog"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Export the comment thread of a blog post as Markdown or JSON
+func ExportBlogCommentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.BlogCommentExportRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := blog.NewExportBlogCommentsLogic(r.Context(), svcCtx)
+		body, err := l.ExportBlogComments(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			contentType, ext := "text/markdown; charset=utf-8", "md"
+			if req.Format == "json" {
+				contentType, ext = "application/json; charset=utf-8", "json"
+			}
+			w.Header().Set("Content-Type", contentType)
+			w.Header().Set("Content-Disposition", `inline; filename="comments-`+req.ID+`.`+ext+`"`)
+			w.WriteHeader(http.StatusOK)
+			w.Write(body)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package blog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/blog"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get schema.org BlogPosting JSON-LD of a published post
+func GetBlogPostJsonLdHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.JsonLdRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := blog.NewGetBlogPostJsonLdLogic(r.Context(), svcCtx)
+		resp, err := l.GetBlogPostJsonLd(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package blog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/blog"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get engagement stats of a blog post
+func GetBlogPostStatsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.BlogPostStatsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := blog.NewGetBlogPostStatsLogic(r.Context(), svcCtx)
+		resp, err := l.GetBlogPostStats(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -20,17 +20,13 @@ func ListBlogCommentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 
 		l := blog.NewListBlogCommentsLogic(r.Context(), svcCtx)
-		// Log the request for analytics (optional)
-		clientIP := utils.GetClientIP(r)
-		userAgent := utils.GetUserAgent(r)
-		fingerprint := r.URL.Query().Get("fingerprint")
-		userIdentityID := r.URL.Query().Get("user_identity_id")
-		l.Infof("Comments list request for post %s from IP %s", req.ID, clientIP)
-
-		resp, err := l.ListBlogComments(&req, clientIP, userAgent, fingerprint, userIdentityID)
+		resp, err := l.ListBlogComments(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
+			// The comment tree is the same for everyone; like state comes
+			// from the like status endpoint
+			utils.SetSharedCache(w)
 			httpx.OkJsonCtx(r.Context(), w, resp)
 		}
 	}
@@ -0,0 +1,33 @@
+package blog
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/blog"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Record batched reading progress (scroll depth, dwell time) for posts
+func RecordReadingProgressHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ReadingBeaconRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// Respect DNT / Global Privacy Control / analytics opt-out
+		req.DoNotTrack = utils.DoNotTrack(r)
+
+		l := blog.NewRecordReadingProgressLogic(r.Context(), svcCtx)
+		resp, err := l.RecordReadingProgress(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
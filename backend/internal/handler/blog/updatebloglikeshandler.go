@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/logic/blog"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 )
 
 // Update blog post like count
@@ -18,6 +19,8 @@ func UpdateBlogLikesHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
+		req.ClientIP = utils.GetClientIP(r)
+
 		l := blog.NewUpdateBlogLikesLogic(r.Context(), svcCtx)
 		resp, err := l.UpdateBlogLikes(&req)
 		if err != nil {
@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/logic/blog"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 )
 
 // Update blog post view count
@@ -18,6 +19,9 @@ func UpdateBlogViewsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
+		// Respect DNT / Global Privacy Control / analytics opt-out
+		req.DoNotTrack = utils.DoNotTrack(r)
+
 		l := blog.NewUpdateBlogViewsLogic(r.Context(), svcCtx)
 		err := l.UpdateBlogViews(&req)
 		if err != nil {
@@ -0,0 +1,29 @@
+package comments
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/comments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Confirm the author address of a comment with the emailed code
+func ConfirmCommentVerificationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ConfirmCommentVerificationRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := comments.NewConfirmCommentVerificationLogic(r.Context(), svcCtx)
+		resp, err := l.ConfirmCommentVerification(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
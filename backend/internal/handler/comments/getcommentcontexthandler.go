@@ -0,0 +1,29 @@
+package comments
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/comments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Locate a comment in its thread for deep links
+func GetCommentContextHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CommentContextRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := comments.NewGetCommentContextLogic(r.Context(), svcCtx)
+		resp, err := l.GetCommentContext(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
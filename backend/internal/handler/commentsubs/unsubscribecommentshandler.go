@@ -0,0 +1,29 @@
+package commentsubs
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/commentsubs"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Stop reply notifications for a comment thread
+func UnsubscribeCommentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.CommentSubscriptionRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := commentsubs.NewUnsubscribeCommentsLogic(r.Context(), svcCtx)
+		resp, err := l.UnsubscribeComments(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
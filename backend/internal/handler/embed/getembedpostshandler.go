@@ -0,0 +1,29 @@
+package embed
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/embed"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// List recent blog posts for widgets on other sites; needs a key with the read:posts scope
+func GetEmbedPostsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.EmbedPostsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := embed.NewGetEmbedPostsLogic(r.Context(), svcCtx)
+		resp, err := l.GetEmbedPosts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package embed
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/embed"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// List public projects for widgets on other sites; needs a key with the read:projects scope
+func GetEmbedProjectsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.EmbedProjectsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := embed.NewGetEmbedProjectsLogic(r.Context(), svcCtx)
+		resp, err := l.GetEmbedProjects(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package embed
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/embed"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Themed list of the latest blog posts for the embed widget
+func GetWidgetPostsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.WidgetPostsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := embed.NewGetWidgetPostsLogic(r.Context(), svcCtx)
+		resp, err := l.GetWidgetPosts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
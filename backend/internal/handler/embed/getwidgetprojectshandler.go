@@ -0,0 +1,29 @@
+package embed
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/embed"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Themed list of public projects for the embed widget
+func GetWidgetProjectsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.WidgetProjectsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := embed.NewGetWidgetProjectsLogic(r.Context(), svcCtx)
+		resp, err := l.GetWidgetProjects(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
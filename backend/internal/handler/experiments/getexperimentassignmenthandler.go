@@ -0,0 +1,32 @@
+package experiments
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/experiments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Get the variant assigned to a fingerprint for one experiment
+func GetExperimentAssignmentHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ExperimentAssignmentRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := experiments.NewGetExperimentAssignmentLogic(r.Context(), svcCtx)
+		resp, err := l.GetExperimentAssignment(&req, utils.GetClientIP(r), utils.GetUserAgent(r))
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// Expose the variant as a header so edge/SSR layers can branch on it
+			w.Header().Set("X-Experiment-Variant", resp.Variant)
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package experiments
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/experiments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get variant assignments for all active experiments
+func GetExperimentAssignmentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ExperimentAssignmentsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := experiments.NewGetExperimentAssignmentsLogic(r.Context(), svcCtx)
+		resp, err := l.GetExperimentAssignments(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// e.g. "X-Experiments: homepage-hero=b, layout=control"
+			pairs := make([]string, 0, len(resp.Assignments))
+			for _, a := range resp.Assignments {
+				pairs = append(pairs, a.Experiment+"="+a.Variant)
+			}
+			w.Header().Set("X-Experiments", strings.Join(pairs, ", "))
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
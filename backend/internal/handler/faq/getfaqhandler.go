@@ -0,0 +1,29 @@
+package faq
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/faq"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get a single FAQ entry
+func GetFAQHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.FAQRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := faq.NewGetFAQLogic(r.Context(), svcCtx)
+		resp, err := l.GetFAQ(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package faq
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/faq"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// List FAQ entries grouped by category
+func GetFAQsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.FAQListRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := faq.NewGetFAQsLogic(r.Context(), svcCtx)
+		resp, err := l.GetFAQs(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
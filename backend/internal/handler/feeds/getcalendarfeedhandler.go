@@ -0,0 +1,24 @@
+package feeds
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/feeds"
+	"silan-backend/internal/svc"
+)
+
+// iCalendar feed of talks, project milestones and idea deadlines
+func GetCalendarFeedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := feeds.NewGetCalendarFeedLogic(r.Context(), svcCtx)
+		doc, err := l.GetCalendarFeed()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Content-Type", doc.ContentType)
+			w.WriteHeader(http.StatusOK)
+			w.Write(doc.Body)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package feeds
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/feeds"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// RSS feed of blog posts in a category
+func GetCategoryFeedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.FeedRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := feeds.NewGetCategoryFeedLogic(r.Context(), svcCtx)
+		doc, err := l.GetCategoryFeed(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Content-Type", doc.ContentType)
+			w.WriteHeader(http.StatusOK)
+			w.Write(doc.Body)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package feeds
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/feeds"
+	"silan-backend/internal/sitefiles"
+	"silan-backend/internal/svc"
+)
+
+// Get security.txt from the site settings
+func GetSecurityTxtHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := feeds.NewGetSecurityTxtLogic(r.Context(), svcCtx)
+		doc, err := l.GetSecurityTxt()
+		if errors.Is(err, sitefiles.ErrNotConfigured) {
+			http.NotFound(w, r)
+		} else if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Content-Type", doc.ContentType)
+			w.WriteHeader(http.StatusOK)
+			w.Write(doc.Body)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package graph
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/graph"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get the graph of related posts, projects and ideas
+func GetContentGraphHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ContentGraphRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := graph.NewGetContentGraphLogic(r.Context(), svcCtx)
+		resp, err := l.GetContentGraph(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -20,17 +20,14 @@ func ListIdeaCommentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		}
 
 		l := ideaslogic.NewListIdeaCommentsLogic(r.Context(), svcCtx)
-		clientIP := utils.GetClientIP(r)
-		userAgent := utils.GetUserAgent(r)
-		fingerprint := r.URL.Query().Get("fingerprint")
-		userIdentityID := r.URL.Query().Get("user_identity_id")
-
-		resp, err := l.ListComments(&req, clientIP, userAgent, fingerprint, userIdentityID)
+		resp, err := l.ListComments(&req)
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
-			return
+		} else {
+			// The comment tree is the same for everyone; like state comes
+			// from the like status endpoint
+			utils.SetSharedCache(w)
+			httpx.OkJsonCtx(r.Context(), w, resp)
 		}
-		httpx.OkJsonCtx(r.Context(), w, resp)
 	}
 }
-
@@ -0,0 +1,29 @@
+package likes
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/likes"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Report which of the given comments and projects the visitor has liked
+func GetLikeStatusHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LikeStatusRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := likes.NewGetLikeStatusLogic(r.Context(), svcCtx)
+		resp, err := l.GetLikeStatus(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
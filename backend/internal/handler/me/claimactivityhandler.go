@@ -0,0 +1,29 @@
+package me
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/me"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Move anonymous comments and likes of this browser to the signed-in visitor
+func ClaimActivityHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ClaimActivityRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := me.NewClaimActivityLogic(r.Context(), svcCtx)
+		resp, err := l.ClaimActivity(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package me
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/me"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Delete the comments, likes, views and accounts of the signed-in visitor, or of an email address confirmed with a code
+func DeleteMyDataHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DeleteMyDataRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := me.NewDeleteMyDataLogic(r.Context(), svcCtx)
+		resp, err := l.DeleteMyData(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
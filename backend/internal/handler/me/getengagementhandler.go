@@ -0,0 +1,29 @@
+package me
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/me"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get likes and replies received by the signed-in visitor's comments
+func GetEngagementHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.EngagementRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := me.NewGetEngagementLogic(r.Context(), svcCtx)
+		resp, err := l.GetEngagement(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package me
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/me"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// List the visitor's own comments across blog posts, ideas, projects and AMAs
+func ListMyCommentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.MyCommentsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := me.NewListMyCommentsLogic(r.Context(), svcCtx)
+		resp, err := l.ListMyComments(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package moderation
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/moderation"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Approve a held comment with the token of a signed link, posted from its confirmation page
+func ApproveCommentByLinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ModerationLinkRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := moderation.NewApproveCommentByLinkLogic(r.Context(), svcCtx)
+		resp, err := l.ApproveCommentByLink(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
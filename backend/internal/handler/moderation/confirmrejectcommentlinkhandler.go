@@ -0,0 +1,33 @@
+package moderation
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/logic/moderation"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Confirm rejecting a held comment from the signed link in a notification email
+func ConfirmRejectCommentLinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ModerationLinkRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := moderation.NewConfirmRejectCommentLinkLogic(r.Context(), svcCtx)
+		page, err := l.ConfirmRejectCommentLink(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Content-Type", commentnotify.PageContentType)
+			w.Header().Set("Cache-Control", "no-store")
+			w.WriteHeader(http.StatusOK)
+			w.Write(page)
+		}
+	}
+}
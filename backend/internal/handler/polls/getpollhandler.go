@@ -0,0 +1,29 @@
+package polls
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/polls"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get a poll with its results
+func GetPollHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PollRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := polls.NewGetPollLogic(r.Context(), svcCtx)
+		resp, err := l.GetPoll(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package projects
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/projects"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get schema.org SoftwareSourceCode JSON-LD of a public project
+func GetProjectJsonLdHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.JsonLdRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := projects.NewGetProjectJsonLdLogic(r.Context(), svcCtx)
+		resp, err := l.GetProjectJsonLd(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/logic/projects"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 )
 
 // List comments for a project
@@ -23,6 +24,9 @@ func ListProjectCommentsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 		if err != nil {
 			httpx.ErrorCtx(r.Context(), w, err)
 		} else {
+			// The comment tree is the same for everyone; like state comes
+			// from the like status endpoint
+			utils.SetSharedCache(w)
 			httpx.OkJsonCtx(r.Context(), w, resp)
 		}
 	}
@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/logic/projects"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 )
 
 // Record project view
@@ -18,6 +19,9 @@ func RecordProjectViewHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 			return
 		}
 
+		// Respect DNT / Global Privacy Control / analytics opt-out
+		req.DoNotTrack = utils.DoNotTrack(r)
+
 		l := projects.NewRecordProjectViewLogic(r.Context(), svcCtx)
 		resp, err := l.RecordProjectView(&req)
 		if err != nil {
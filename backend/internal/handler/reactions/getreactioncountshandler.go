@@ -0,0 +1,33 @@
+package reactions
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/reactions"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Count emoji reactions on comments, posts or projects
+func GetReactionCountsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ReactionCountsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := reactions.NewGetReactionCountsLogic(r.Context(), svcCtx)
+		resp, err := l.GetReactionCounts(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// Counts are the same for everyone; the visitor's own reactions
+			// come from the status endpoint
+			utils.SetSharedCache(w)
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package reactions
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/reactions"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Report the visitor's own reactions on the given entities
+func GetReactionStatusHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ReactionStatusRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := reactions.NewGetReactionStatusLogic(r.Context(), svcCtx)
+		resp, err := l.GetReactionStatus(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package resume
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/resume"
+	"silan-backend/internal/svc"
+)
+
+// Get schema.org Person JSON-LD of the site owner
+func GetPersonJsonLdHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := resume.NewGetPersonJsonLdLogic(r.Context(), svcCtx)
+		resp, err := l.GetPersonJsonLd()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -6,12 +6,37 @@ package handler
 import (
 	"net/http"
 
+	activity "silan-backend/internal/handler/activity"
+	admin "silan-backend/internal/handler/admin"
+	amas "silan-backend/internal/handler/amas"
+	analytics "silan-backend/internal/handler/analytics"
+	apikeys "silan-backend/internal/handler/apikeys"
+	ask "silan-backend/internal/handler/ask"
 	auth "silan-backend/internal/handler/auth"
+	availability "silan-backend/internal/handler/availability"
 	blog "silan-backend/internal/handler/blog"
+	comments "silan-backend/internal/handler/comments"
+	commentsubs "silan-backend/internal/handler/commentsubs"
+	embed "silan-backend/internal/handler/embed"
+	experiments "silan-backend/internal/handler/experiments"
+	faq "silan-backend/internal/handler/faq"
+	feeds "silan-backend/internal/handler/feeds"
+	graph "silan-backend/internal/handler/graph"
 	ideas "silan-backend/internal/handler/ideas"
+	likes "silan-backend/internal/handler/likes"
+	me "silan-backend/internal/handler/me"
+	moderation "silan-backend/internal/handler/moderation"
 	plans "silan-backend/internal/handler/plans"
+	polls "silan-backend/internal/handler/polls"
 	projects "silan-backend/internal/handler/projects"
+	reactions "silan-backend/internal/handler/reactions"
+	reports "silan-backend/internal/handler/reports"
 	resume "silan-backend/internal/handler/resume"
+	shortlinks "silan-backend/internal/handler/shortlinks"
+	siteupdates "silan-backend/internal/handler/siteupdates"
+	stats "silan-backend/internal/handler/stats"
+	tags "silan-backend/internal/handler/tags"
+	uses "silan-backend/internal/handler/uses"
 	"silan-backend/internal/svc"
 
 	"github.com/zeromicro/go-zero/rest"
@@ -22,102 +47,1085 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Cors},
 			[]rest.Route{
+				{
+					// List the public activity feed: publishes, updates and approved comments
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: activity.GetActivityFeedHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/activity"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors, serverCtx.AdminAuth, serverCtx.Signature},
+			[]rest.Route{
+				{
+					// List the activity log, including entries hidden from the public feed
+					Method:  http.MethodGet,
+					Path:    "/activity",
+					Handler: admin.ListActivityHandler(serverCtx),
+				},
+				{
+					// List AMA sessions
+					Method:  http.MethodGet,
+					Path:    "/amas",
+					Handler: admin.ListAmasHandler(serverCtx),
+				},
+				{
+					// Schedule an AMA session
+					Method:  http.MethodPost,
+					Path:    "/amas",
+					Handler: admin.CreateAmaHandler(serverCtx),
+				},
+				{
+					// Delete an AMA session and its questions
+					Method:  http.MethodDelete,
+					Path:    "/amas/:id",
+					Handler: admin.DeleteAmaHandler(serverCtx),
+				},
+				{
+					// Update an AMA session, including its window
+					Method:  http.MethodPut,
+					Path:    "/amas/:id",
+					Handler: admin.UpdateAmaHandler(serverCtx),
+				},
+				{
+					// Take back the answer mark of a reply
+					Method:  http.MethodDelete,
+					Path:    "/amas/:id/answers/:answer_id",
+					Handler: admin.UnmarkAmaAnswerHandler(serverCtx),
+				},
+				{
+					// Answer an AMA question, by marking an owner reply or posting one
+					Method:  http.MethodPut,
+					Path:    "/amas/:id/questions/:question_id/answer",
+					Handler: admin.AnswerAmaQuestionHandler(serverCtx),
+				},
+				{
+					// Get visits segmented by device class, OS and browser
+					Method:  http.MethodGet,
+					Path:    "/analytics/devices",
+					Handler: admin.GetDeviceBreakdownHandler(serverCtx),
+				},
+				{
+					// Compare response languages per site section
+					Method:  http.MethodGet,
+					Path:    "/analytics/languages",
+					Handler: admin.GetLanguageStatsHandler(serverCtx),
+				},
+				{
+					// Get session metrics: bounce rate, pages per session, entry and exit pages
+					Method:  http.MethodGet,
+					Path:    "/analytics/sessions",
+					Handler: admin.GetSessionStatsHandler(serverCtx),
+				},
+				{
+					// List API keys
+					Method:  http.MethodGet,
+					Path:    "/api-keys",
+					Handler: admin.ListApiKeysHandler(serverCtx),
+				},
+				{
+					// Create an API key with optional quotas
+					Method:  http.MethodPost,
+					Path:    "/api-keys",
+					Handler: admin.CreateApiKeyHandler(serverCtx),
+				},
+				{
+					// Update the daily/monthly quotas of an API key
+					Method:  http.MethodPut,
+					Path:    "/api-keys/:id/quota",
+					Handler: admin.UpdateApiKeyQuotaHandler(serverCtx),
+				},
+				{
+					// Get daily usage of an API key
+					Method:  http.MethodGet,
+					Path:    "/api-keys/:id/usage",
+					Handler: admin.GetApiKeyUsageHandler(serverCtx),
+				},
+				{
+					// List recent audit log entries
+					Method:  http.MethodGet,
+					Path:    "/audit-log",
+					Handler: admin.ListAuditLogHandler(serverCtx),
+				},
+				{
+					// List sign-in, refresh and logout events, optionally only those of the site owner
+					Method:  http.MethodGet,
+					Path:    "/auth-events",
+					Handler: admin.ListAuthEventsHandler(serverCtx),
+				},
+				{
+					// Set the owner's availability status
+					Method:  http.MethodPut,
+					Path:    "/availability",
+					Handler: admin.UpdateAvailabilityHandler(serverCtx),
+				},
+				{
+					// List banned visitors
+					Method:  http.MethodGet,
+					Path:    "/bans",
+					Handler: admin.ListBansHandler(serverCtx),
+				},
+				{
+					// Ban a visitor by user identity, email, fingerprint or IP
+					Method:  http.MethodPost,
+					Path:    "/bans",
+					Handler: admin.CreateBanHandler(serverCtx),
+				},
+				{
+					// Lift a ban
+					Method:  http.MethodDelete,
+					Path:    "/bans/:id",
+					Handler: admin.DeleteBanHandler(serverCtx),
+				},
+				{
+					// Remove the narration audio of a blog post
+					Method:  http.MethodDelete,
+					Path:    "/blog/:id/audio",
+					Handler: admin.DeletePostAudioHandler(serverCtx),
+				},
+				{
+					// Attach a narration audio file to a blog post
+					Method:  http.MethodPut,
+					Path:    "/blog/:id/audio",
+					Handler: admin.SetPostAudioHandler(serverCtx),
+				},
+				{
+					// Set the hero image of a blog post and its responsive variants
+					Method:  http.MethodPut,
+					Path:    "/blog/:id/hero",
+					Handler: admin.SetPostHeroHandler(serverCtx),
+				},
+				{
+					// Cancel the scheduled publishing of a blog post
+					Method:  http.MethodDelete,
+					Path:    "/blog/:id/schedule",
+					Handler: admin.CancelScheduledPostHandler(serverCtx),
+				},
+				{
+					// Schedule a draft blog post for publishing
+					Method:  http.MethodPut,
+					Path:    "/blog/:id/schedule",
+					Handler: admin.SchedulePostHandler(serverCtx),
+				},
+				{
+					// List calendar entries
+					Method:  http.MethodGet,
+					Path:    "/calendar",
+					Handler: admin.ListCalendarEntriesHandler(serverCtx),
+				},
+				{
+					// Add a talk, milestone or deadline to the calendar
+					Method:  http.MethodPost,
+					Path:    "/calendar",
+					Handler: admin.CreateCalendarEntryHandler(serverCtx),
+				},
+				{
+					// Delete a calendar entry
+					Method:  http.MethodDelete,
+					Path:    "/calendar/:id",
+					Handler: admin.DeleteCalendarEntryHandler(serverCtx),
+				},
+				{
+					// List canned responses for owner replies
+					Method:  http.MethodGet,
+					Path:    "/canned-responses",
+					Handler: admin.ListCannedResponsesHandler(serverCtx),
+				},
+				{
+					// Add a canned response
+					Method:  http.MethodPost,
+					Path:    "/canned-responses",
+					Handler: admin.CreateCannedResponseHandler(serverCtx),
+				},
+				{
+					// Delete a canned response
+					Method:  http.MethodDelete,
+					Path:    "/canned-responses/:id",
+					Handler: admin.DeleteCannedResponseHandler(serverCtx),
+				},
+				{
+					// Update a canned response
+					Method:  http.MethodPut,
+					Path:    "/canned-responses/:id",
+					Handler: admin.UpdateCannedResponseHandler(serverCtx),
+				},
+				{
+					// Reject a held comment and its held replies
+					Method:  http.MethodDelete,
+					Path:    "/comments/:id",
+					Handler: admin.RejectCommentHandler(serverCtx),
+				},
+				{
+					// Approve a held comment
+					Method:  http.MethodPost,
+					Path:    "/comments/:id/approve",
+					Handler: admin.ApproveCommentHandler(serverCtx),
+				},
+				{
+					// Permanently delete a comment and all of its replies
+					Method:  http.MethodDelete,
+					Path:    "/comments/:id/purge",
+					Handler: admin.PurgeCommentHandler(serverCtx),
+				},
+				{
+					// Reply to a comment as the site owner, approving it if held
+					Method:  http.MethodPost,
+					Path:    "/comments/:id/reply",
+					Handler: admin.ReplyToCommentHandler(serverCtx),
+				},
+				{
+					// List comments held for approval
+					Method:  http.MethodGet,
+					Path:    "/comments/pending",
+					Handler: admin.ListPendingCommentsHandler(serverCtx),
+				},
+				{
+					// Create or update an idea by slug, with details, tags and translations
+					Method:  http.MethodPut,
+					Path:    "/content/ideas/:slug",
+					Handler: admin.UpsertIdeaHandler(serverCtx),
+				},
+				{
+					// Export every idea with its details as Notion-style JSON or an org-mode outline
+					Method:  http.MethodGet,
+					Path:    "/content/ideas/export",
+					Handler: admin.ExportIdeasHandler(serverCtx),
+				},
+				{
+					// Create or update ideas from Notion-style JSON or an org-mode outline
+					Method:  http.MethodPost,
+					Path:    "/content/ideas/import",
+					Handler: admin.ImportIdeasHandler(serverCtx),
+				},
+				{
+					// Create or update a blog post by slug, with tags and translations
+					Method:  http.MethodPut,
+					Path:    "/content/posts/:slug",
+					Handler: admin.UpsertPostHandler(serverCtx),
+				},
+				{
+					// Create or update a project by slug, with details, technologies and translations
+					Method:  http.MethodPut,
+					Path:    "/content/projects/:slug",
+					Handler: admin.UpsertProjectHandler(serverCtx),
+				},
+				{
+					// List the generated drafts of a post, project or idea
+					Method:  http.MethodGet,
+					Path:    "/drafts",
+					Handler: admin.ListDraftsHandler(serverCtx),
+				},
+				{
+					// Edit a generated draft
+					Method:  http.MethodPut,
+					Path:    "/drafts",
+					Handler: admin.UpdateDraftHandler(serverCtx),
+				},
+				{
+					// Generate summary and excerpt drafts for a post, project or idea
+					Method:  http.MethodPost,
+					Path:    "/drafts/generate",
+					Handler: admin.GenerateDraftsHandler(serverCtx),
+				},
+				{
+					// Get exposure and conversion counts per variant
+					Method:  http.MethodGet,
+					Path:    "/experiments/:name/results",
+					Handler: admin.GetExperimentResultsHandler(serverCtx),
+				},
+				{
+					// List FAQ entries with translations and feedback counts
+					Method:  http.MethodGet,
+					Path:    "/faqs",
+					Handler: admin.ListFAQsHandler(serverCtx),
+				},
+				{
+					// Create an FAQ entry
+					Method:  http.MethodPost,
+					Path:    "/faqs",
+					Handler: admin.CreateFAQHandler(serverCtx),
+				},
+				{
+					// Delete an FAQ entry
+					Method:  http.MethodDelete,
+					Path:    "/faqs/:id",
+					Handler: admin.DeleteFAQHandler(serverCtx),
+				},
+				{
+					// Update an FAQ entry
+					Method:  http.MethodPut,
+					Path:    "/faqs/:id",
+					Handler: admin.UpdateFAQHandler(serverCtx),
+				},
+				{
+					// Announce a content change so the sitemap and feeds are rebuilt
+					Method:  http.MethodPost,
+					Path:    "/feeds/rebuild",
+					Handler: admin.RebuildFeedsHandler(serverCtx),
+				},
+				{
+					// List project inquiries
+					Method:  http.MethodGet,
+					Path:    "/inquiries",
+					Handler: admin.ListInquiriesHandler(serverCtx),
+				},
+				{
+					// Suggest internal links to posts, projects and ideas for a draft post
+					Method:  http.MethodPost,
+					Path:    "/link-suggestions",
+					Handler: admin.SuggestLinksHandler(serverCtx),
+				},
+				{
+					// List outbound links in published posts whose latest check failed
+					Method:  http.MethodGet,
+					Path:    "/links/broken",
+					Handler: admin.ListBrokenLinksHandler(serverCtx),
+				},
+				{
+					// Add a bookable office hour slot
+					Method:  http.MethodPost,
+					Path:    "/office-hours",
+					Handler: admin.CreateOfficeHourHandler(serverCtx),
+				},
+				{
+					// Delete an office hour slot
+					Method:  http.MethodDelete,
+					Path:    "/office-hours/:id",
+					Handler: admin.DeleteOfficeHourHandler(serverCtx),
+				},
+				{
+					// List polls with their results
+					Method:  http.MethodGet,
+					Path:    "/polls",
+					Handler: admin.ListPollsHandler(serverCtx),
+				},
+				{
+					// Create a poll
+					Method:  http.MethodPost,
+					Path:    "/polls",
+					Handler: admin.CreatePollHandler(serverCtx),
+				},
+				{
+					// Delete a poll and its votes
+					Method:  http.MethodDelete,
+					Path:    "/polls/:id",
+					Handler: admin.DeletePollHandler(serverCtx),
+				},
+				{
+					// Update a poll and its options
+					Method:  http.MethodPut,
+					Path:    "/polls/:id",
+					Handler: admin.UpdatePollHandler(serverCtx),
+				},
+				{
+					// List the stored revisions of a blog post
+					Method:  http.MethodGet,
+					Path:    "/posts/:id/revisions",
+					Handler: admin.ListPostRevisionsHandler(serverCtx),
+				},
+				{
+					// Diff two revisions of a blog post
+					Method:  http.MethodGet,
+					Path:    "/posts/:id/revisions/:a/diff/:b",
+					Handler: admin.DiffPostRevisionsHandler(serverCtx),
+				},
+				{
+					// Issue a signed preview link for an unpublished post or project
+					Method:  http.MethodPost,
+					Path:    "/previews",
+					Handler: admin.CreatePreviewHandler(serverCtx),
+				},
+				{
+					// List content reports
+					Method:  http.MethodGet,
+					Path:    "/reports",
+					Handler: admin.ListReportsHandler(serverCtx),
+				},
+				{
+					// Resolve, dismiss or reopen a content report
+					Method:  http.MethodPut,
+					Path:    "/reports/:id",
+					Handler: admin.ResolveReportHandler(serverCtx),
+				},
+				{
+					// List blog posts waiting to be published
+					Method:  http.MethodGet,
+					Path:    "/scheduled-posts",
+					Handler: admin.ListScheduledPostsHandler(serverCtx),
+				},
+				{
+					// Get top and zero-result site-search terms
+					Method:  http.MethodGet,
+					Path:    "/search/report",
+					Handler: admin.GetSearchReportHandler(serverCtx),
+				},
+				{
+					// List short links with click counts
+					Method:  http.MethodGet,
+					Path:    "/short-links",
+					Handler: admin.ListShortLinksHandler(serverCtx),
+				},
+				{
+					// Create a short link for a URL, post or project
+					Method:  http.MethodPost,
+					Path:    "/short-links",
+					Handler: admin.CreateShortLinkHandler(serverCtx),
+				},
+				{
+					// Delete a short link
+					Method:  http.MethodDelete,
+					Path:    "/short-links/:code",
+					Handler: admin.DeleteShortLinkHandler(serverCtx),
+				},
+				{
+					// List all site updates
+					Method:  http.MethodGet,
+					Path:    "/site-updates",
+					Handler: admin.ListSiteUpdatesHandler(serverCtx),
+				},
+				{
+					// Announce a site update
+					Method:  http.MethodPost,
+					Path:    "/site-updates",
+					Handler: admin.CreateSiteUpdateHandler(serverCtx),
+				},
+				{
+					// Delete a site update
+					Method:  http.MethodDelete,
+					Path:    "/site-updates/:id",
+					Handler: admin.DeleteSiteUpdateHandler(serverCtx),
+				},
+				{
+					// Edit a site update
+					Method:  http.MethodPut,
+					Path:    "/site-updates/:id",
+					Handler: admin.UpdateSiteUpdateHandler(serverCtx),
+				},
+				{
+					// List all tools of the uses page
+					Method:  http.MethodGet,
+					Path:    "/tools",
+					Handler: admin.ListToolsHandler(serverCtx),
+				},
+				{
+					// Add a tool to the uses page
+					Method:  http.MethodPost,
+					Path:    "/tools",
+					Handler: admin.CreateToolHandler(serverCtx),
+				},
+				{
+					// Delete a tool of the uses page
+					Method:  http.MethodDelete,
+					Path:    "/tools/:id",
+					Handler: admin.DeleteToolHandler(serverCtx),
+				},
+				{
+					// Update a tool of the uses page
+					Method:  http.MethodPut,
+					Path:    "/tools/:id",
+					Handler: admin.UpdateToolHandler(serverCtx),
+				},
+				{
+					// List deleted entries that can still be restored
+					Method:  http.MethodGet,
+					Path:    "/trash",
+					Handler: admin.ListTrashHandler(serverCtx),
+				},
+				{
+					// Permanently remove an entry from the trash
+					Method:  http.MethodDelete,
+					Path:    "/trash/:id",
+					Handler: admin.PurgeTrashItemHandler(serverCtx),
+				},
+				{
+					// Restore a deleted entry from the trash
+					Method:  http.MethodPost,
+					Path:    "/trash/:id/restore",
+					Handler: admin.RestoreTrashItemHandler(serverCtx),
+				},
+				{
+					// Redeliver a previous webhook delivery
+					Method:  http.MethodPost,
+					Path:    "/webhook-deliveries/:delivery_id/redeliver",
+					Handler: admin.RedeliverWebhookHandler(serverCtx),
+				},
+				{
+					// List webhook endpoints
+					Method:  http.MethodGet,
+					Path:    "/webhooks",
+					Handler: admin.ListWebhooksHandler(serverCtx),
+				},
+				{
+					// Register a webhook endpoint
+					Method:  http.MethodPost,
+					Path:    "/webhooks",
+					Handler: admin.CreateWebhookHandler(serverCtx),
+				},
+				{
+					// Delete a webhook endpoint
+					Method:  http.MethodDelete,
+					Path:    "/webhooks/:id",
+					Handler: admin.DeleteWebhookHandler(serverCtx),
+				},
+				{
+					// Update a webhook endpoint
+					Method:  http.MethodPut,
+					Path:    "/webhooks/:id",
+					Handler: admin.UpdateWebhookHandler(serverCtx),
+				},
+				{
+					// List recent deliveries of a webhook
+					Method:  http.MethodGet,
+					Path:    "/webhooks/:id/deliveries",
+					Handler: admin.ListWebhookDeliveriesHandler(serverCtx),
+				},
+				{
+					// Send a ping event to a webhook endpoint
+					Method:  http.MethodPost,
+					Path:    "/webhooks/:id/ping",
+					Handler: admin.PingWebhookHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/admin"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// List AMA sessions
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: amas.ListPublicAmasHandler(serverCtx),
+				},
+				{
+					// Get an AMA session with its questions and answers
+					Method:  http.MethodGet,
+					Path:    "/:id",
+					Handler: amas.GetAmaHandler(serverCtx),
+				},
+				{
+					// Ask a question in an open AMA session
+					Method:  http.MethodPost,
+					Path:    "/:id/questions",
+					Handler: amas.SubmitAmaQuestionHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/amas"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Record a custom analytics event
+					Method:  http.MethodPost,
+					Path:    "/events",
+					Handler: analytics.TrackEventHandler(serverCtx),
+				},
+				{
+					// Get the visitor's analytics opt-out status for the consent banner
+					Method:  http.MethodGet,
+					Path:    "/opt-out",
+					Handler: analytics.GetAnalyticsOptOutStatusHandler(serverCtx),
+				},
+				{
+					// Opt the visitor out of (or back into) analytics
+					Method:  http.MethodPost,
+					Path:    "/opt-out",
+					Handler: analytics.SetAnalyticsOptOutHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/analytics"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get usage and remaining quota of the calling API key
+					Method:  http.MethodGet,
+					Path:    "/usage",
+					Handler: apikeys.GetMyApiKeyUsageHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/keys"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Answer a question about the site from its posts, projects and ideas
+					Method:  http.MethodPost,
+					Path:    "/",
+					Handler: ask.AskSiteHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/ask"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Email a sign-in code and magic link
+					Method:  http.MethodPost,
+					Path:    "/email/request",
+					Handler: auth.RequestEmailCodeHandler(serverCtx),
+				},
+				{
+					// Sign in with an emailed code or magic link token
+					Method:  http.MethodPost,
+					Path:    "/email/verify",
+					Handler: auth.VerifyEmailCodeHandler(serverCtx),
+				},
 				{
 					// Verify Google ID token and upsert identity
 					Method:  http.MethodPost,
 					Path:    "/google/verify",
 					Handler: auth.GoogleVerifyHandler(serverCtx),
 				},
+				{
+					// Revoke the current session, or all sessions of the identity
+					Method:  http.MethodPost,
+					Path:    "/logout",
+					Handler: auth.LogoutHandler(serverCtx),
+				},
+				{
+					// Get the profile of the signed-in user
+					Method:  http.MethodGet,
+					Path:    "/me",
+					Handler: auth.GetProfileHandler(serverCtx),
+				},
+				{
+					// Update the display name and avatar of the signed-in user
+					Method:  http.MethodPut,
+					Path:    "/me",
+					Handler: auth.UpdateProfileHandler(serverCtx),
+				},
+				{
+					// Sign in with a QQ Connect authorization code
+					Method:  http.MethodPost,
+					Path:    "/qq/verify",
+					Handler: auth.QQVerifyHandler(serverCtx),
+				},
+				{
+					// Exchange a refresh token for a new session token
+					Method:  http.MethodPost,
+					Path:    "/refresh",
+					Handler: auth.RefreshSessionHandler(serverCtx),
+				},
+				{
+					// List the active sessions of the signed-in user
+					Method:  http.MethodGet,
+					Path:    "/sessions",
+					Handler: auth.ListSessionsHandler(serverCtx),
+				},
+				{
+					// Revoke one session of the signed-in user
+					Method:  http.MethodDelete,
+					Path:    "/sessions/:id",
+					Handler: auth.RevokeSessionHandler(serverCtx),
+				},
+				{
+					// Sign in with a WeChat website login authorization code
+					Method:  http.MethodPost,
+					Path:    "/wechat/verify",
+					Handler: auth.WeChatVerifyHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/auth"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get the owner's availability and upcoming office hours
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: availability.GetAvailabilityHandler(serverCtx),
+				},
+				{
+					// iCalendar feed of upcoming office hours
+					Method:  http.MethodGet,
+					Path:    "/office-hours.ics",
+					Handler: availability.GetOfficeHoursCalendarHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/availability"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get blog categories
+					Method:  http.MethodGet,
+					Path:    "/categories",
+					Handler: blog.GetBlogCategoriesHandler(serverCtx),
+				},
+				{
+					// Delete a comment (fingerprint required)
+					Method:  http.MethodDelete,
+					Path:    "/comments/:comment_id",
+					Handler: blog.DeleteBlogCommentHandler(serverCtx),
+				},
+				{
+					// Like/Unlike a comment
+					Method:  http.MethodPost,
+					Path:    "/comments/:comment_id/like",
+					Handler: blog.LikeCommentHandler(serverCtx),
+				},
+				{
+					// Get blog posts list with pagination and filtering
+					Method:  http.MethodGet,
+					Path:    "/posts",
+					Handler: blog.GetBlogPostsHandler(serverCtx),
+				},
+				{
+					// List comments for a blog post
+					Method:  http.MethodGet,
+					Path:    "/posts/:id/comments",
+					Handler: blog.ListBlogCommentsHandler(serverCtx),
+				},
+				{
+					// Create a comment for a blog post
+					Method:  http.MethodPost,
+					Path:    "/posts/:id/comments",
+					Handler: blog.CreateBlogCommentHandler(serverCtx),
+				},
+				{
+					// Export the comment thread of a blog post as Markdown or JSON
+					Method:  http.MethodGet,
+					Path:    "/posts/:id/comments/export",
+					Handler: blog.ExportBlogCommentsHandler(serverCtx),
+				},
+				{
+					// Update blog post like count
+					Method:  http.MethodPost,
+					Path:    "/posts/:id/likes",
+					Handler: blog.UpdateBlogLikesHandler(serverCtx),
+				},
+				{
+					// Get engagement stats of a blog post
+					Method:  http.MethodGet,
+					Path:    "/posts/:id/stats",
+					Handler: blog.GetBlogPostStatsHandler(serverCtx),
+				},
+				{
+					// Update blog post view count
+					Method:  http.MethodPost,
+					Path:    "/posts/:id/views",
+					Handler: blog.UpdateBlogViewsHandler(serverCtx),
+				},
+				{
+					// Get single blog post by slug
+					Method:  http.MethodGet,
+					Path:    "/posts/:slug",
+					Handler: blog.GetBlogPostHandler(serverCtx),
+				},
+				{
+					// Get schema.org BlogPosting JSON-LD of a published post
+					Method:  http.MethodGet,
+					Path:    "/posts/:slug/jsonld",
+					Handler: blog.GetBlogPostJsonLdHandler(serverCtx),
+				},
+				{
+					// Get single blog post by ID
+					Method:  http.MethodGet,
+					Path:    "/posts/id/:id",
+					Handler: blog.GetBlogPostByIdHandler(serverCtx),
+				},
+				{
+					// Record batched reading progress (scroll depth, dwell time) for posts
+					Method:  http.MethodPost,
+					Path:    "/reading-progress",
+					Handler: blog.RecordReadingProgressHandler(serverCtx),
+				},
+				{
+					// Search blog posts with filters
+					Method:  http.MethodGet,
+					Path:    "/search",
+					Handler: blog.SearchBlogPostsHandler(serverCtx),
+				},
+				{
+					// Get blog series data
+					Method:  http.MethodGet,
+					Path:    "/series/:series_id",
+					Handler: blog.GetBlogSeriesHandler(serverCtx),
+				},
+				{
+					// Get blog tags
+					Method:  http.MethodGet,
+					Path:    "/tags",
+					Handler: blog.GetBlogTagsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/blog"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Edit the content of one's own comment within the edit window
+					Method:  http.MethodPut,
+					Path:    "/:id",
+					Handler: comments.EditCommentHandler(serverCtx),
+				},
+				{
+					// Locate a comment in its thread for deep links
+					Method:  http.MethodGet,
+					Path:    "/:id/context",
+					Handler: comments.GetCommentContextHandler(serverCtx),
+				},
+				{
+					// Email a code verifying the author address of an anonymous comment
+					Method:  http.MethodPost,
+					Path:    "/:id/verification",
+					Handler: comments.RequestCommentVerificationHandler(serverCtx),
+				},
+				{
+					// Confirm the author address of a comment with the emailed code
+					Method:  http.MethodPost,
+					Path:    "/:id/verification/confirm",
+					Handler: comments.ConfirmCommentVerificationHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/comments"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Stop reply notifications for a comment thread
+					Method:  http.MethodGet,
+					Path:    "/unsubscribe",
+					Handler: commentsubs.UnsubscribeCommentsHandler(serverCtx),
+				},
+				{
+					// Confirm the email address of a comment reply subscription
+					Method:  http.MethodGet,
+					Path:    "/verify",
+					Handler: commentsubs.VerifyCommentSubscriptionHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/comment-subscriptions"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Embed},
+			[]rest.Route{
+				{
+					// List recent blog posts for widgets on other sites; needs a key with the read:posts scope
+					Method:  http.MethodGet,
+					Path:    "/posts",
+					Handler: embed.GetEmbedPostsHandler(serverCtx),
+				},
+				{
+					// List public projects for widgets on other sites; needs a key with the read:projects scope
+					Method:  http.MethodGet,
+					Path:    "/projects",
+					Handler: embed.GetEmbedProjectsHandler(serverCtx),
+				},
 			}...,
 		),
-		rest.WithPrefix("/api/v1/auth"),
+		rest.WithPrefix("/api/v1/embed"),
 	)
 
 	server.AddRoutes(
 		rest.WithMiddlewares(
-			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Middleware{serverCtx.Widget},
 			[]rest.Route{
 				{
-					// Get blog categories
+					// Themed list of the latest blog posts for the embed widget
 					Method:  http.MethodGet,
-					Path:    "/categories",
-					Handler: blog.GetBlogCategoriesHandler(serverCtx),
-				},
-				{
-					// Delete a comment (fingerprint required)
-					Method:  http.MethodDelete,
-					Path:    "/comments/:comment_id",
-					Handler: blog.DeleteBlogCommentHandler(serverCtx),
-				},
-				{
-					// Like/Unlike a comment
-					Method:  http.MethodPost,
-					Path:    "/comments/:comment_id/like",
-					Handler: blog.LikeCommentHandler(serverCtx),
+					Path:    "/latest-posts",
+					Handler: embed.GetWidgetPostsHandler(serverCtx),
 				},
 				{
-					// Get blog posts list with pagination and filtering
+					// Themed list of public projects for the embed widget
 					Method:  http.MethodGet,
-					Path:    "/posts",
-					Handler: blog.GetBlogPostsHandler(serverCtx),
+					Path:    "/projects",
+					Handler: embed.GetWidgetProjectsHandler(serverCtx),
 				},
 				{
-					// List comments for a blog post
+					// Script that renders the embed widget on other sites
 					Method:  http.MethodGet,
-					Path:    "/posts/:id/comments",
-					Handler: blog.ListBlogCommentsHandler(serverCtx),
+					Path:    "/widget.js",
+					Handler: embed.GetWidgetScriptHandler(serverCtx),
 				},
+			}...,
+		),
+		rest.WithPrefix("/embed"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
 				{
-					// Create a comment for a blog post
-					Method:  http.MethodPost,
-					Path:    "/posts/:id/comments",
-					Handler: blog.CreateBlogCommentHandler(serverCtx),
+					// Get variant assignments for all active experiments
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: experiments.GetExperimentAssignmentsHandler(serverCtx),
 				},
 				{
-					// Update blog post like count
-					Method:  http.MethodPost,
-					Path:    "/posts/:id/likes",
-					Handler: blog.UpdateBlogLikesHandler(serverCtx),
+					// Get the variant assigned to a fingerprint for one experiment
+					Method:  http.MethodGet,
+					Path:    "/:name",
+					Handler: experiments.GetExperimentAssignmentHandler(serverCtx),
 				},
 				{
-					// Update blog post view count
+					// Record a conversion for the fingerprint's variant
 					Method:  http.MethodPost,
-					Path:    "/posts/:id/views",
-					Handler: blog.UpdateBlogViewsHandler(serverCtx),
-				},
-				{
-					// Get single blog post by slug
-					Method:  http.MethodGet,
-					Path:    "/posts/:slug",
-					Handler: blog.GetBlogPostHandler(serverCtx),
+					Path:    "/:name/conversions",
+					Handler: experiments.RecordExperimentConversionHandler(serverCtx),
 				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/experiments"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
 				{
-					// Get single blog post by ID
+					// List FAQ entries grouped by category
 					Method:  http.MethodGet,
-					Path:    "/posts/id/:id",
-					Handler: blog.GetBlogPostByIdHandler(serverCtx),
+					Path:    "/",
+					Handler: faq.GetFAQsHandler(serverCtx),
 				},
 				{
-					// Search blog posts with filters
+					// Get a single FAQ entry
 					Method:  http.MethodGet,
-					Path:    "/search",
-					Handler: blog.SearchBlogPostsHandler(serverCtx),
+					Path:    "/:id",
+					Handler: faq.GetFAQHandler(serverCtx),
 				},
 				{
-					// Get blog series data
-					Method:  http.MethodGet,
-					Path:    "/series/:series_id",
-					Handler: blog.GetBlogSeriesHandler(serverCtx),
+					// Record whether an FAQ answer was helpful
+					Method:  http.MethodPost,
+					Path:    "/:id/feedback",
+					Handler: faq.SubmitFAQFeedbackHandler(serverCtx),
 				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/faq"),
+	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// Get security.txt from the site settings
+				Method:  http.MethodGet,
+				Path:    "/.well-known/security.txt",
+				Handler: feeds.GetSecurityTxtHandler(serverCtx),
+			},
+			{
+				// iCalendar feed of talks, project milestones and idea deadlines
+				Method:  http.MethodGet,
+				Path:    "/calendar.ics",
+				Handler: feeds.GetCalendarFeedHandler(serverCtx),
+			},
+			{
+				// Get robots.txt with the sitemap and crawler rules from the site settings
+				Method:  http.MethodGet,
+				Path:    "/robots.txt",
+				Handler: feeds.GetRobotsTxtHandler(serverCtx),
+			},
+			{
+				// RSS feed of blog posts
+				Method:  http.MethodGet,
+				Path:    "/rss.xml",
+				Handler: feeds.GetBlogFeedHandler(serverCtx),
+			},
+			{
+				// List the RSS feeds, including one per blog tag and category
+				Method:  http.MethodGet,
+				Path:    "/feeds",
+				Handler: feeds.ListFeedsHandler(serverCtx),
+			},
+			{
+				// RSS feed of projects
+				Method:  http.MethodGet,
+				Path:    "/feeds/projects",
+				Handler: feeds.GetProjectsFeedHandler(serverCtx),
+			},
+			{
+				// RSS feed of ideas
+				Method:  http.MethodGet,
+				Path:    "/feeds/ideas",
+				Handler: feeds.GetIdeasFeedHandler(serverCtx),
+			},
+			{
+				// RSS feed of narrated blog posts with their audio as enclosures
+				Method:  http.MethodGet,
+				Path:    "/feeds/podcast",
+				Handler: feeds.GetPodcastFeedHandler(serverCtx),
+			},
+			{
+				// RSS feed of blog posts with a tag
+				Method:  http.MethodGet,
+				Path:    "/feeds/tags/:slug",
+				Handler: feeds.GetTagFeedHandler(serverCtx),
+			},
+			{
+				// RSS feed of blog posts in a category
+				Method:  http.MethodGet,
+				Path:    "/feeds/categories/:slug",
+				Handler: feeds.GetCategoryFeedHandler(serverCtx),
+			},
+			{
+				// Get the sitemap
+				Method:  http.MethodGet,
+				Path:    "/sitemap.xml",
+				Handler: feeds.GetSitemapHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
 				{
-					// Get blog tags
+					// Get the graph of related posts, projects and ideas
 					Method:  http.MethodGet,
-					Path:    "/tags",
-					Handler: blog.GetBlogTagsHandler(serverCtx),
+					Path:    "/",
+					Handler: graph.GetContentGraphHandler(serverCtx),
 				},
 			}...,
 		),
-		rest.WithPrefix("/api/v1/blog"),
+		rest.WithPrefix("/api/v1/graph"),
 	)
 
 	server.AddRoutes(
@@ -183,6 +1191,99 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/ideas"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Report which of the given comments and projects the visitor has liked
+					Method:  http.MethodPost,
+					Path:    "/status",
+					Handler: likes.GetLikeStatusHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/likes"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Move anonymous comments and likes of this browser to the signed-in visitor
+					Method:  http.MethodPost,
+					Path:    "/claim",
+					Handler: me.ClaimActivityHandler(serverCtx),
+				},
+				{
+					// Claim the anonymous comments left with the signed-in visitor's verified email
+					Method:  http.MethodPost,
+					Path:    "/claim/comments",
+					Handler: me.ClaimCommentsHandler(serverCtx),
+				},
+				{
+					// List the visitor's own comments across blog posts, ideas, projects and AMAs
+					Method:  http.MethodGet,
+					Path:    "/comments",
+					Handler: me.ListMyCommentsHandler(serverCtx),
+				},
+				{
+					// Delete the comments, likes, views and accounts of the signed-in visitor, or of an email address confirmed with a code
+					Method:  http.MethodPost,
+					Path:    "/delete",
+					Handler: me.DeleteMyDataHandler(serverCtx),
+				},
+				{
+					// Email a code confirming the deletion of the data of an address
+					Method:  http.MethodPost,
+					Path:    "/delete/code",
+					Handler: me.RequestDataDeletionCodeHandler(serverCtx),
+				},
+				{
+					// Get likes and replies received by the signed-in visitor's comments
+					Method:  http.MethodGet,
+					Path:    "/engagement",
+					Handler: me.GetEngagementHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/me"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Confirm approving a held comment from the signed link in a notification email
+					Method:  http.MethodGet,
+					Path:    "/approve",
+					Handler: moderation.ConfirmApproveCommentLinkHandler(serverCtx),
+				},
+				{
+					// Approve a held comment with the token of a signed link, posted from its confirmation page
+					Method:  http.MethodPost,
+					Path:    "/approve",
+					Handler: moderation.ApproveCommentByLinkHandler(serverCtx),
+				},
+				{
+					// Confirm rejecting a held comment from the signed link in a notification email
+					Method:  http.MethodGet,
+					Path:    "/reject",
+					Handler: moderation.ConfirmRejectCommentLinkHandler(serverCtx),
+				},
+				{
+					// Reject a held comment with the token of a signed link, posted from its confirmation page
+					Method:  http.MethodPost,
+					Path:    "/reject",
+					Handler: moderation.RejectCommentByLinkHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/moderation"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Cors},
@@ -222,6 +1323,27 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/plans"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get a poll with its results
+					Method:  http.MethodGet,
+					Path:    "/:id",
+					Handler: polls.GetPollHandler(serverCtx),
+				},
+				{
+					// Vote on a poll
+					Method:  http.MethodPost,
+					Path:    "/:id/vote",
+					Handler: polls.VotePollHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/polls"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Cors},
@@ -256,6 +1378,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/:id/detail",
 					Handler: projects.GetProjectDetailHandler(serverCtx),
 				},
+				{
+					// Send a hire me inquiry about a project
+					Method:  http.MethodPost,
+					Path:    "/:id/inquiries",
+					Handler: projects.CreateInquiryHandler(serverCtx),
+				},
 				{
 					// Like/Unlike a project
 					Method:  http.MethodPost,
@@ -280,6 +1408,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/:slug",
 					Handler: projects.GetProjectHandler(serverCtx),
 				},
+				{
+					// Get schema.org SoftwareSourceCode JSON-LD of a public project
+					Method:  http.MethodGet,
+					Path:    "/:slug/jsonld",
+					Handler: projects.GetProjectJsonLdHandler(serverCtx),
+				},
 				{
 					// Get project categories
 					Method:  http.MethodGet,
@@ -327,6 +1461,48 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		rest.WithPrefix("/api/v1/projects"),
 	)
 
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Count emoji reactions on comments, posts or projects
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: reactions.GetReactionCountsHandler(serverCtx),
+				},
+				{
+					// Report the visitor's own reactions on the given entities
+					Method:  http.MethodPost,
+					Path:    "/status",
+					Handler: reactions.GetReactionStatusHandler(serverCtx),
+				},
+				{
+					// Add or remove the visitor's emoji reaction
+					Method:  http.MethodPost,
+					Path:    "/toggle",
+					Handler: reactions.ToggleReactionHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/reactions"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Report public content for abuse, copyright or privacy review
+					Method:  http.MethodPost,
+					Path:    "/report",
+					Handler: reports.CreateReportHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1"),
+	)
+
 	server.AddRoutes(
 		rest.WithMiddlewares(
 			[]rest.Middleware{serverCtx.Cors},
@@ -355,6 +1531,12 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 					Path:    "/experience",
 					Handler: resume.GetWorkExperienceHandler(serverCtx),
 				},
+				{
+					// Get schema.org Person JSON-LD of the site owner
+					Method:  http.MethodGet,
+					Path:    "/jsonld",
+					Handler: resume.GetPersonJsonLdHandler(serverCtx),
+				},
 				{
 					// Get personal information
 					Method:  http.MethodGet,
@@ -383,4 +1565,81 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 		),
 		rest.WithPrefix("/api/v1/resume"),
 	)
+
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				// Redirect a short link to its target
+				Method:  http.MethodGet,
+				Path:    "/s/:code",
+				Handler: shortlinks.RedirectShortLinkHandler(serverCtx),
+			},
+		},
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// List recent site updates
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: siteupdates.GetSiteUpdatesHandler(serverCtx),
+				},
+				{
+					// RSS feed of site updates
+					Method:  http.MethodGet,
+					Path:    "/rss",
+					Handler: siteupdates.GetSiteUpdatesFeedHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/site-updates"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get content production statistics per month
+					Method:  http.MethodGet,
+					Path:    "/content",
+					Handler: stats.GetContentStatsHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/stats"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get all tags with usage counts and weights
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: tags.GetTagCloudHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/tags"),
+	)
+
+	server.AddRoutes(
+		rest.WithMiddlewares(
+			[]rest.Middleware{serverCtx.Cors},
+			[]rest.Route{
+				{
+					// Get the tools of the uses page grouped by category
+					Method:  http.MethodGet,
+					Path:    "/",
+					Handler: uses.GetUsesHandler(serverCtx),
+				},
+			}...,
+		),
+		rest.WithPrefix("/api/v1/uses"),
+	)
 }
@@ -0,0 +1,39 @@
+package shortlinks
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/shortlinks"
+	"silan-backend/internal/shortlink"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Redirect a short link to its target
+func RedirectShortLinkHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ShortLinkRedirectRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// Extract client info
+		req.ClientIP = utils.GetClientIP(r)
+		req.UserAgentFull = utils.GetUserAgent(r)
+		req.Referrer = r.Referer()
+
+		l := shortlinks.NewRedirectShortLinkLogic(r.Context(), svcCtx)
+		resp, err := l.RedirectShortLink(&req)
+		if errors.Is(err, shortlink.ErrNotFound) {
+			http.NotFound(w, r)
+		} else if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			http.Redirect(w, r, resp.TargetURL, http.StatusFound)
+		}
+	}
+}
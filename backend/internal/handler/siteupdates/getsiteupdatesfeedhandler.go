@@ -0,0 +1,24 @@
+package siteupdates
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/siteupdates"
+	"silan-backend/internal/svc"
+)
+
+// RSS feed of site updates
+func GetSiteUpdatesFeedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := siteupdates.NewGetSiteUpdatesFeedLogic(r.Context(), svcCtx)
+		feed, err := l.GetSiteUpdatesFeed()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+			w.WriteHeader(http.StatusOK)
+			w.Write(feed)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package siteupdates
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/siteupdates"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// List recent site updates
+func GetSiteUpdatesHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SiteUpdateListRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := siteupdates.NewGetSiteUpdatesLogic(r.Context(), svcCtx)
+		resp, err := l.GetSiteUpdates(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
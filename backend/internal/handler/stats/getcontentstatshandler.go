@@ -0,0 +1,29 @@
+package stats
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/stats"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get content production statistics per month
+func GetContentStatsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ContentStatsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := stats.NewGetContentStatsLogic(r.Context(), svcCtx)
+		resp, err := l.GetContentStats(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
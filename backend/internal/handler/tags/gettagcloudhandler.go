@@ -0,0 +1,29 @@
+package tags
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/tags"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+)
+
+// Get all tags with usage counts and weights
+func GetTagCloudHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TagCloudRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := tags.NewGetTagCloudLogic(r.Context(), svcCtx)
+		resp, err := l.GetTagCloud(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
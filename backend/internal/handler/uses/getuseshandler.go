@@ -0,0 +1,22 @@
+package uses
+
+import (
+	"net/http"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+	"silan-backend/internal/logic/uses"
+	"silan-backend/internal/svc"
+)
+
+// Get the tools of the uses page grouped by category
+func GetUsesHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := uses.NewGetUsesLogic(r.Context(), svcCtx)
+		resp, err := l.GetUses()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Package ical writes iCalendar (RFC 5545) feeds that calendar apps can
+// subscribe to.
+package ical
+
+import (
+	"bytes"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// dateTime is the UTC date-time format of iCalendar.
+	dateTime = "20060102T150405Z"
+	// date is the format of all-day values.
+	date = "20060102"
+)
+
+// Event is a VEVENT. End may be zero; all-day events use the dates of Start
+// and End, with End exclusive.
+type Event struct {
+	UID         string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Summary     string
+	Description string
+	Location    string
+	URL         string
+	Categories  []string
+}
+
+// Calendar renders events as a VCALENDAR named name. host identifies the
+// producer and makes the event UIDs globally unique.
+func Calendar(name, host string, events []Event, now time.Time) []byte {
+	var b bytes.Buffer
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//"+host+"//"+escapeText(name)+"//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+	writeLine(&b, "METHOD:PUBLISH")
+	writeLine(&b, "X-WR-CALNAME:"+escapeText(name))
+	for _, ev := range events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+ev.UID+"@"+host)
+		writeLine(&b, "DTSTAMP:"+now.UTC().Format(dateTime))
+		if ev.AllDay {
+			writeLine(&b, "DTSTART;VALUE=DATE:"+ev.Start.Format(date))
+			if !ev.End.IsZero() {
+				writeLine(&b, "DTEND;VALUE=DATE:"+ev.End.Format(date))
+			}
+		} else {
+			writeLine(&b, "DTSTART:"+ev.Start.UTC().Format(dateTime))
+			if !ev.End.IsZero() {
+				writeLine(&b, "DTEND:"+ev.End.UTC().Format(dateTime))
+			}
+		}
+		writeLine(&b, "SUMMARY:"+escapeText(ev.Summary))
+		if ev.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escapeText(ev.Description))
+		}
+		if ev.Location != "" {
+			writeLine(&b, "LOCATION:"+escapeText(ev.Location))
+		}
+		if ev.URL != "" {
+			writeLine(&b, "URL:"+ev.URL)
+		}
+		if len(ev.Categories) > 0 {
+			cats := make([]string, len(ev.Categories))
+			for i, c := range ev.Categories {
+				cats[i] = escapeText(c)
+			}
+			writeLine(&b, "CATEGORIES:"+strings.Join(cats, ","))
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+	writeLine(&b, "END:VCALENDAR")
+	return b.Bytes()
+}
+
+// escapeText escapes a TEXT value.
+func escapeText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeLine writes a content line, folding it at 75 octets without
+// splitting UTF-8 sequences.
+func writeLine(b *bytes.Buffer, s string) {
+	const limit = 75
+	width := limit
+	for len(s) > width {
+		cut := width
+		for cut > 0 && s[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(s[:cut])
+		b.WriteString("\r\n ")
+		s = s[cut:]
+		// Continuation lines start with the folding space
+		width = limit - 1
+	}
+	b.WriteString(s)
+	b.WriteString("\r\n")
+}
+
+// Host returns the host name of baseURL for use in UIDs, or localhost when
+// it has none.
+func Host(baseURL string) string {
+	if u, err := url.Parse(baseURL); err == nil && u.Hostname() != "" {
+		return u.Hostname()
+	}
+	return "localhost"
+}
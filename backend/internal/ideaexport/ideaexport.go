@@ -0,0 +1,96 @@
+// Package ideaexport converts ideas to and from the formats research notes
+// usually live in before they become public ideas: a Notion-style JSON page
+// list and an org-mode outline.
+//
+// Both formats carry an idea with its details, tags and category. They use
+// the content upsert request as their in-memory shape, so an import can be
+// written with the same code as PUT /content/ideas/:slug. Translations are
+// not part of either format.
+package ideaexport
+
+import (
+	"errors"
+	"strings"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Formats accepted by Encode and Decode.
+const (
+	FormatNotion = "notion"
+	FormatOrg    = "org"
+)
+
+// ErrFormat is returned for unknown formats.
+var ErrFormat = errors.New("unknown idea export format")
+
+// Encode renders ideas in format.
+func Encode(format string, ideas []types.UpsertIdeaRequest) (string, error) {
+	switch format {
+	case FormatNotion:
+		return encodeNotion(ideas)
+	case FormatOrg:
+		return encodeOrg(ideas), nil
+	}
+	return "", ErrFormat
+}
+
+// Decode parses content written in format. Ideas without a slug get one
+// from their title and ideas without a status are drafts.
+func Decode(format, content string) ([]types.UpsertIdeaRequest, error) {
+	var (
+		ideas []types.UpsertIdeaRequest
+		err   error
+	)
+	switch format {
+	case FormatNotion:
+		ideas, err = decodeNotion(content)
+	case FormatOrg:
+		ideas = decodeOrg(content)
+	default:
+		return nil, ErrFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	for i := range ideas {
+		in := &ideas[i]
+		in.Title = strings.TrimSpace(in.Title)
+		if in.Slug == "" {
+			in.Slug = utils.Slugify(in.Title)
+		}
+		if in.Status == "" {
+			in.Status = "draft"
+		}
+	}
+	return ideas, nil
+}
+
+// section is a long-text field of an idea, rendered as a heading followed
+// by its text.
+type section struct {
+	name string
+	text func(in *types.UpsertIdeaRequest) *string
+}
+
+// sections lists the long-text fields in the order they are exported.
+var sections = []section{
+	{"Description", func(in *types.UpsertIdeaRequest) *string { return &in.Description }},
+	{"Abstract", func(in *types.UpsertIdeaRequest) *string { return &in.Abstract }},
+	{"Progress", func(in *types.UpsertIdeaRequest) *string { return &in.Details.Progress }},
+	{"Results", func(in *types.UpsertIdeaRequest) *string { return &in.Details.Results }},
+	{"References", func(in *types.UpsertIdeaRequest) *string { return &in.Details.References }},
+	{"Required resources", func(in *types.UpsertIdeaRequest) *string { return &in.Details.RequiredResources }},
+}
+
+// sectionText returns the field of in named by heading, matched without
+// regard to case, or nil for headings that aren't idea fields.
+func sectionText(in *types.UpsertIdeaRequest, heading string) *string {
+	for _, s := range sections {
+		if strings.EqualFold(s.name, strings.TrimSpace(heading)) {
+			return s.text(in)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,254 @@
+package ideaexport
+
+import (
+	"encoding/json"
+	"strings"
+
+	"silan-backend/internal/types"
+)
+
+// Notion property names. The title property is found by its type on import,
+// so pages from databases that renamed it still load.
+const (
+	propTitle         = "Name"
+	propSlug          = "Slug"
+	propStatus        = "Status"
+	propCategory      = "Category"
+	propTags          = "Tags"
+	propPublic        = "Public"
+	propCollaboration = "Collaboration needed"
+	propFunding       = "Funding required"
+	propDuration      = "Duration (months)"
+	propBudget        = "Budget"
+)
+
+// notionTextLimit is the longest text Notion accepts in one rich text item.
+const notionTextLimit = 2000
+
+// The notion types mirror the subset of the Notion API page objects the
+// export uses: a list of pages with database properties and their body as
+// heading_2 and paragraph blocks.
+type notionList struct {
+	Object  string       `json:"object"`
+	Results []notionPage `json:"results"`
+}
+
+type notionPage struct {
+	Object     string                    `json:"object"`
+	Properties map[string]notionProperty `json:"properties"`
+	Children   []notionBlock             `json:"children,omitempty"`
+}
+
+type notionProperty struct {
+	Type        string         `json:"type"`
+	Title       []notionText   `json:"title,omitempty"`
+	RichText    []notionText   `json:"rich_text,omitempty"`
+	Select      *notionOption  `json:"select,omitempty"`
+	MultiSelect []notionOption `json:"multi_select,omitempty"`
+	Checkbox    *bool          `json:"checkbox,omitempty"`
+	Number      *float64       `json:"number,omitempty"`
+}
+
+type notionText struct {
+	Type string `json:"type"`
+	Text struct {
+		Content string `json:"content"`
+	} `json:"text"`
+	PlainText string `json:"plain_text,omitempty"`
+}
+
+type notionOption struct {
+	Name string `json:"name"`
+}
+
+type notionBlock struct {
+	Object    string          `json:"object"`
+	Type      string          `json:"type"`
+	Heading2  *notionRichText `json:"heading_2,omitempty"`
+	Paragraph *notionRichText `json:"paragraph,omitempty"`
+}
+
+type notionRichText struct {
+	RichText []notionText `json:"rich_text"`
+}
+
+func encodeNotion(ideas []types.UpsertIdeaRequest) (string, error) {
+	list := notionList{Object: "list", Results: make([]notionPage, 0, len(ideas))}
+	for i := range ideas {
+		list.Results = append(list.Results, notionPageOf(&ideas[i]))
+	}
+	b, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func notionPageOf(in *types.UpsertIdeaRequest) notionPage {
+	props := map[string]notionProperty{
+		propTitle:         {Type: "title", Title: richText(in.Title)},
+		propSlug:          {Type: "rich_text", RichText: richText(in.Slug)},
+		propStatus:        {Type: "select", Select: &notionOption{Name: in.Status}},
+		propTags:          {Type: "multi_select", MultiSelect: []notionOption{}},
+		propPublic:        checkbox(in.IsPublic),
+		propCollaboration: checkbox(in.Details.CollaborationNeeded),
+		propFunding:       checkbox(in.Details.FundingRequired),
+	}
+	if in.Category != "" {
+		props[propCategory] = notionProperty{Type: "select", Select: &notionOption{Name: in.Category}}
+	}
+	for _, tag := range in.Tags {
+		p := props[propTags]
+		p.MultiSelect = append(p.MultiSelect, notionOption{Name: tag})
+		props[propTags] = p
+	}
+	if in.Details.EstimatedDurationMonths > 0 {
+		months := float64(in.Details.EstimatedDurationMonths)
+		props[propDuration] = notionProperty{Type: "number", Number: &months}
+	}
+	if in.Details.EstimatedBudget > 0 {
+		budget := in.Details.EstimatedBudget
+		props[propBudget] = notionProperty{Type: "number", Number: &budget}
+	}
+
+	page := notionPage{Object: "page", Properties: props}
+	for _, s := range sections {
+		text := strings.TrimSpace(*s.text(in))
+		if text == "" {
+			continue
+		}
+		page.Children = append(page.Children, notionBlock{
+			Object: "block", Type: "heading_2", Heading2: &notionRichText{RichText: richText(s.name)},
+		})
+		for _, para := range strings.Split(text, "\n\n") {
+			page.Children = append(page.Children, notionBlock{
+				Object: "block", Type: "paragraph", Paragraph: &notionRichText{RichText: richText(para)},
+			})
+		}
+	}
+	return page
+}
+
+// richText splits s into text items within Notion's length limit.
+func richText(s string) []notionText {
+	runes := []rune(s)
+	items := make([]notionText, 0, len(runes)/notionTextLimit+1)
+	for len(runes) > 0 {
+		n := min(len(runes), notionTextLimit)
+		var t notionText
+		t.Type = "text"
+		t.Text.Content = string(runes[:n])
+		items = append(items, t)
+		runes = runes[n:]
+	}
+	return items
+}
+
+func checkbox(v bool) notionProperty {
+	return notionProperty{Type: "checkbox", Checkbox: &v}
+}
+
+// plainText joins rich text items, preferring the plain_text Notion adds to
+// its own exports.
+func plainText(items []notionText) string {
+	var b strings.Builder
+	for _, t := range items {
+		if t.PlainText != "" {
+			b.WriteString(t.PlainText)
+		} else {
+			b.WriteString(t.Text.Content)
+		}
+	}
+	return b.String()
+}
+
+// decodeNotion accepts a page list as exported, a bare array of pages or a
+// single page. Blocks other than headings and paragraphs are skipped.
+func decodeNotion(content string) ([]types.UpsertIdeaRequest, error) {
+	content = strings.TrimSpace(content)
+	var pages []notionPage
+	switch {
+	case strings.HasPrefix(content, "["):
+		if err := json.Unmarshal([]byte(content), &pages); err != nil {
+			return nil, err
+		}
+	default:
+		var list notionList
+		if err := json.Unmarshal([]byte(content), &list); err != nil {
+			return nil, err
+		}
+		if list.Object == "list" {
+			pages = list.Results
+			break
+		}
+		var page notionPage
+		if err := json.Unmarshal([]byte(content), &page); err != nil {
+			return nil, err
+		}
+		pages = []notionPage{page}
+	}
+
+	ideas := make([]types.UpsertIdeaRequest, 0, len(pages))
+	for _, page := range pages {
+		ideas = append(ideas, ideaOfNotionPage(&page))
+	}
+	return ideas, nil
+}
+
+func ideaOfNotionPage(page *notionPage) types.UpsertIdeaRequest {
+	var in types.UpsertIdeaRequest
+	for name, p := range page.Properties {
+		if p.Type == "title" {
+			in.Title = plainText(p.Title)
+			continue
+		}
+		switch name {
+		case propSlug:
+			in.Slug = strings.TrimSpace(plainText(p.RichText))
+		case propStatus:
+			if p.Select != nil {
+				in.Status = p.Select.Name
+			}
+		case propCategory:
+			if p.Select != nil {
+				in.Category = p.Select.Name
+			}
+		case propTags:
+			for _, o := range p.MultiSelect {
+				in.Tags = append(in.Tags, o.Name)
+			}
+		case propPublic:
+			in.IsPublic = p.Checkbox != nil && *p.Checkbox
+		case propCollaboration:
+			in.Details.CollaborationNeeded = p.Checkbox != nil && *p.Checkbox
+		case propFunding:
+			in.Details.FundingRequired = p.Checkbox != nil && *p.Checkbox
+		case propDuration:
+			if p.Number != nil {
+				in.Details.EstimatedDurationMonths = int(*p.Number)
+			}
+		case propBudget:
+			if p.Number != nil {
+				in.Details.EstimatedBudget = *p.Number
+			}
+		}
+	}
+
+	// Paragraphs before the first known heading are the description.
+	text := &in.Description
+	for _, b := range page.Children {
+		switch {
+		case b.Type == "heading_2" && b.Heading2 != nil:
+			if t := sectionText(&in, plainText(b.Heading2.RichText)); t != nil {
+				text = t
+			}
+		case b.Type == "paragraph" && b.Paragraph != nil:
+			para := plainText(b.Paragraph.RichText)
+			if *text != "" {
+				*text += "\n\n"
+			}
+			*text += para
+		}
+	}
+	return in
+}
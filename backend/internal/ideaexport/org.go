@@ -0,0 +1,192 @@
+package ideaexport
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"silan-backend/internal/types"
+)
+
+// An org export has one top-level heading per idea, tagged with the idea's
+// tags, a property drawer with its scalar fields and a second-level heading
+// per long-text field:
+//
+//	* Sparse attention for long documents  :transformers:nlp:
+//	:PROPERTIES:
+//	:SLUG: sparse-attention
+//	:STATUS: experimenting
+//	:END:
+//	** Abstract
+//	...
+//
+// Org tags can't contain spaces, so they are written with underscores.
+// Body lines starting with "*" are escaped with a comma, as org does in
+// blocks.
+
+// Property drawer keys.
+const (
+	orgSlug          = "SLUG"
+	orgStatus        = "STATUS"
+	orgCategory      = "CATEGORY"
+	orgPublic        = "PUBLIC"
+	orgCollaboration = "COLLABORATION_NEEDED"
+	orgFunding       = "FUNDING_REQUIRED"
+	orgDuration      = "DURATION_MONTHS"
+	orgBudget        = "BUDGET"
+)
+
+var (
+	orgTagsRe     = regexp.MustCompile(`\s+((?::[^\s:]+)+:)\s*$`)
+	orgPropertyRe = regexp.MustCompile(`^:([A-Za-z_-]+):\s*(.*)$`)
+)
+
+func encodeOrg(ideas []types.UpsertIdeaRequest) string {
+	var b strings.Builder
+	b.WriteString("#+TITLE: Ideas\n")
+	for i := range ideas {
+		in := &ideas[i]
+		b.WriteString("\n* " + in.Title)
+		if len(in.Tags) > 0 {
+			tags := make([]string, 0, len(in.Tags))
+			for _, tag := range in.Tags {
+				tags = append(tags, strings.ReplaceAll(strings.TrimSpace(tag), " ", "_"))
+			}
+			b.WriteString("  :" + strings.Join(tags, ":") + ":")
+		}
+		b.WriteString("\n:PROPERTIES:\n")
+		writeOrgProperty(&b, orgSlug, in.Slug)
+		writeOrgProperty(&b, orgStatus, in.Status)
+		writeOrgProperty(&b, orgCategory, in.Category)
+		writeOrgProperty(&b, orgPublic, orgBool(in.IsPublic))
+		writeOrgProperty(&b, orgCollaboration, orgBool(in.Details.CollaborationNeeded))
+		writeOrgProperty(&b, orgFunding, orgBool(in.Details.FundingRequired))
+		if in.Details.EstimatedDurationMonths > 0 {
+			writeOrgProperty(&b, orgDuration, strconv.Itoa(in.Details.EstimatedDurationMonths))
+		}
+		if in.Details.EstimatedBudget > 0 {
+			writeOrgProperty(&b, orgBudget, strconv.FormatFloat(in.Details.EstimatedBudget, 'f', -1, 64))
+		}
+		b.WriteString(":END:\n")
+
+		for _, s := range sections {
+			text := strings.TrimSpace(*s.text(in))
+			if text == "" {
+				continue
+			}
+			b.WriteString("** " + s.name + "\n")
+			for _, line := range strings.Split(text, "\n") {
+				if strings.HasPrefix(line, "*") {
+					line = "," + line
+				}
+				b.WriteString(line + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func writeOrgProperty(b *strings.Builder, key, value string) {
+	if value != "" {
+		b.WriteString(":" + key + ": " + value + "\n")
+	}
+}
+
+func orgBool(v bool) string {
+	if v {
+		return "t"
+	}
+	return "nil"
+}
+
+// decodeOrg reads an outline in the export layout. Text under the idea
+// heading before any field heading is the description, and subtrees below
+// unknown headings are skipped.
+func decodeOrg(content string) []types.UpsertIdeaRequest {
+	var (
+		ideas      []types.UpsertIdeaRequest
+		in         *types.UpsertIdeaRequest
+		text       *string
+		inDrawer   bool
+		skipDepth  int
+		paragraphs []string
+	)
+	flush := func() {
+		if text != nil {
+			*text = strings.TrimSpace(strings.Join(paragraphs, "\n"))
+		}
+		paragraphs = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		depth := len(line) - len(strings.TrimLeft(line, "*"))
+		isHeading := depth > 0 && len(line) > depth && line[depth] == ' '
+
+		switch {
+		case isHeading && depth == 1:
+			flush()
+			ideas = append(ideas, types.UpsertIdeaRequest{})
+			in = &ideas[len(ideas)-1]
+			title := strings.TrimSpace(line[depth:])
+			if m := orgTagsRe.FindStringSubmatchIndex(title); m != nil {
+				for _, tag := range strings.Split(strings.Trim(title[m[2]:m[3]], ":"), ":") {
+					in.Tags = append(in.Tags, strings.ReplaceAll(tag, "_", " "))
+				}
+				title = title[:m[0]]
+			}
+			in.Title = title
+			text = &in.Description
+			inDrawer = false
+			skipDepth = 0
+		case in == nil:
+			// Keywords and text before the first idea
+		case isHeading && skipDepth > 0 && depth > skipDepth:
+		case isHeading:
+			flush()
+			skipDepth = 0
+			text = sectionText(in, line[depth:])
+			if text == nil {
+				skipDepth = depth
+			}
+		case skipDepth > 0:
+		case strings.TrimSpace(line) == ":PROPERTIES:" && len(paragraphs) == 0 && text == &in.Description:
+			inDrawer = true
+		case inDrawer:
+			if strings.TrimSpace(line) == ":END:" {
+				inDrawer = false
+				continue
+			}
+			if m := orgPropertyRe.FindStringSubmatch(strings.TrimSpace(line)); m != nil {
+				setOrgProperty(in, strings.ToUpper(m[1]), strings.TrimSpace(m[2]))
+			}
+		default:
+			if strings.HasPrefix(line, ",*") {
+				line = line[1:]
+			}
+			paragraphs = append(paragraphs, line)
+		}
+	}
+	flush()
+	return ideas
+}
+
+func setOrgProperty(in *types.UpsertIdeaRequest, key, value string) {
+	switch key {
+	case orgSlug:
+		in.Slug = value
+	case orgStatus:
+		in.Status = strings.ToLower(value)
+	case orgCategory:
+		in.Category = value
+	case orgPublic:
+		in.IsPublic = value == "t"
+	case orgCollaboration:
+		in.Details.CollaborationNeeded = value == "t"
+	case orgFunding:
+		in.Details.FundingRequired = value == "t"
+	case orgDuration:
+		in.Details.EstimatedDurationMonths, _ = strconv.Atoi(value)
+	case orgBudget:
+		in.Details.EstimatedBudget, _ = strconv.ParseFloat(value, 64)
+	}
+}
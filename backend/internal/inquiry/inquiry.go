@@ -0,0 +1,187 @@
+// Package inquiry stores "hire me" requests sent from a project page and
+// notifies the site owner about them.
+package inquiry
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/mail"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EventReceived is the outbox event written for every stored inquiry.
+const EventReceived = "inquiry.received"
+
+// Inquiry is a structured request about a project. Budget and Timeline are
+// ranges picked from fixed lists, see the api definition.
+type Inquiry struct {
+	ID           string
+	ProjectID    string
+	ProjectTitle string
+	Name         string
+	Email        string
+	Company      string
+	Budget       string
+	Timeline     string
+	Message      string
+	IP           string
+	Fingerprint  string
+	CreatedAt    time.Time
+}
+
+// Event is the payload of inquiry.received. Unlike comment events it carries
+// the contact details, since replying to them is the point.
+type Event struct {
+	ID           string `json:"id"`
+	ProjectID    string `json:"project_id"`
+	ProjectTitle string `json:"project_title"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	Company      string `json:"company,omitempty"`
+	Budget       string `json:"budget"`
+	Timeline     string `json:"timeline"`
+	Message      string `json:"message"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// NewEvent builds the event payload for a stored inquiry.
+func NewEvent(in *Inquiry) Event {
+	return Event{
+		ID:           in.ID,
+		ProjectID:    in.ProjectID,
+		ProjectTitle: in.ProjectTitle,
+		Name:         in.Name,
+		Email:        in.Email,
+		Company:      in.Company,
+		Budget:       in.Budget,
+		Timeline:     in.Timeline,
+		Message:      in.Message,
+		CreatedAt:    utils.FormatTime(in.CreatedAt),
+	}
+}
+
+// Store persists inquiries in the raw project_inquiries table, apart from
+// comments and other visitor messages.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Create stores in and writes its inquiry.received event in the same
+// transaction, filling in the ID and creation time.
+func (s *Store) Create(ctx context.Context, in *Inquiry) error {
+	in.ID = uuid.New().String()
+	in.CreatedAt = time.Now().UTC()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, s.rebind(
+		`INSERT INTO project_inquiries (id, project_id, project_title, name, email, company, budget, timeline, message, ip, fingerprint, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		in.ID, in.ProjectID, in.ProjectTitle, in.Name, in.Email, in.Company, in.Budget, in.Timeline, in.Message, in.IP, in.Fingerprint, in.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if err := outbox.Write(ctx, tx, s.driver, EventReceived, NewEvent(in)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// List returns the inquiries about projectID, or about every project when
+// it is empty, newest first.
+func (s *Store) List(ctx context.Context, projectID string) ([]*Inquiry, error) {
+	query := `SELECT id, project_id, project_title, name, email, company, budget, timeline, message, ip, fingerprint, created_at
+		FROM project_inquiries`
+	var args []any
+	if projectID != "" {
+		query += ` WHERE project_id = ?`
+		args = append(args, projectID)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(query+` ORDER BY created_at DESC`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var inquiries []*Inquiry
+	for rows.Next() {
+		var in Inquiry
+		if err := rows.Scan(&in.ID, &in.ProjectID, &in.ProjectTitle, &in.Name, &in.Email, &in.Company,
+			&in.Budget, &in.Timeline, &in.Message, &in.IP, &in.Fingerprint, &in.CreatedAt); err != nil {
+			return nil, err
+		}
+		inquiries = append(inquiries, &in)
+	}
+	return inquiries, rows.Err()
+}
+
+// Notifier emails new inquiries to the site owner.
+type Notifier struct {
+	mailer *mail.Sender
+	to     []string
+}
+
+func NewNotifier(mailer *mail.Sender, to []string) *Notifier {
+	return &Notifier{mailer: mailer, to: to}
+}
+
+// Handle is an outbox handler for inquiry.received. Send failures are only
+// logged so one bad address doesn't replay the event to every handler.
+func (n *Notifier) Handle(ctx context.Context, ev outbox.Event) error {
+	if ev.Type != EventReceived || !n.mailer.Enabled() || len(n.to) == 0 {
+		return nil
+	}
+	var payload Event
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return nil
+	}
+
+	from := payload.Name
+	if payload.Company != "" {
+		from += " (" + payload.Company + ")"
+	}
+	body := fmt.Sprintf("%s <%s> asked about %s.\n\nBudget: %s\nTimeline: %s\n\n%s\n",
+		from, payload.Email, payload.ProjectTitle, payload.Budget, payload.Timeline, payload.Message)
+	for _, to := range n.to {
+		err := n.mailer.Send(mail.Message{
+			To:      to,
+			Subject: "New inquiry about " + payload.ProjectTitle,
+			Body:    body,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to send notification for inquiry %s: %v", payload.ID, err)
+		}
+	}
+	return nil
+}
+
+// Normalize trims the free-text fields of in.
+func Normalize(in *Inquiry) {
+	in.Name = strings.TrimSpace(in.Name)
+	in.Email = strings.TrimSpace(in.Email)
+	in.Company = strings.TrimSpace(in.Company)
+	in.Message = strings.TrimSpace(in.Message)
+}
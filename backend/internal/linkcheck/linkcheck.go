@@ -0,0 +1,306 @@
+// Package linkcheck finds the outbound links in published blog posts and
+// checks them periodically, so links that stopped working in old posts show
+// up in the admin broken links report instead of rotting silently.
+package linkcheck
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/utils"
+)
+
+// urlPattern matches absolute http(s) URLs in Markdown or HTML. Parentheses
+// and brackets end a URL so Markdown link syntax around it is left out.
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"'()\[\]{}]+`)
+
+// maxErrorLength caps the stored error message of a failed check.
+const maxErrorLength = 255
+
+// Extract returns the distinct outbound http(s) URLs in content in the
+// order they first appear, leaving out those on siteHost.
+func Extract(content, siteHost string) []string {
+	seen := make(map[string]bool)
+	var links []string
+	for _, m := range urlPattern.FindAllString(content, -1) {
+		m = strings.TrimRight(m, ".,;:!?*_`")
+		u, err := url.Parse(m)
+		if err != nil || u.Host == "" {
+			continue
+		}
+		if siteHost != "" && strings.EqualFold(u.Hostname(), siteHost) {
+			continue
+		}
+		if !seen[m] {
+			seen[m] = true
+			links = append(links, m)
+		}
+	}
+	return links
+}
+
+// Link is the latest check of an outbound link. Failures counts the checks
+// failed in a row; StatusCode is 0 when no response was received.
+type Link struct {
+	URL          string
+	StatusCode   int
+	Error        string
+	Failures     int
+	CheckedAt    time.Time
+	LastOKAt     *time.Time
+	FailingSince *time.Time
+	PostIDs      []string
+}
+
+// Store keeps the links of each post in the raw post_links table and their
+// check results in link_checks.
+type Store struct {
+	db       *sql.DB
+	driver   string
+	client   *ent.Client
+	siteHost string
+	interval time.Duration
+	batch    int
+	http     *http.Client
+}
+
+// NewStore returns a store that rechecks each link after interval, at most
+// batch links per run. Links to siteURL are not checked.
+func NewStore(db *sql.DB, driver string, client *ent.Client, siteURL string, interval time.Duration, batch int, timeout time.Duration) *Store {
+	var siteHost string
+	if u, err := url.Parse(siteURL); err == nil {
+		siteHost = u.Hostname()
+	}
+	return &Store{
+		db:       db,
+		driver:   driver,
+		client:   client,
+		siteHost: siteHost,
+		interval: interval,
+		batch:    batch,
+		http:     &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Sync replaces the stored links of every published post with those in its
+// current content and forgets the checks of links no longer linked to. It
+// returns the number of links found.
+func (s *Store) Sync(ctx context.Context) (int, error) {
+	posts, err := s.client.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		Select(blogpost.FieldID, blogpost.FieldContent).
+		All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM post_links`); err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, p := range posts {
+		for _, link := range Extract(p.Content, s.siteHost) {
+			if _, err := tx.ExecContext(ctx, s.rebind(
+				`INSERT INTO post_links (post_id, url) VALUES (?, ?)`), p.ID.String(), link); err != nil {
+				return n, err
+			}
+			n++
+		}
+	}
+	if _, err := tx.ExecContext(ctx,
+		`DELETE FROM link_checks WHERE url NOT IN (SELECT url FROM post_links)`); err != nil {
+		return n, err
+	}
+	return n, tx.Commit()
+}
+
+// CheckDue checks the links never checked or last checked before the
+// interval, those never checked first, and returns how many were checked.
+func (s *Store) CheckDue(ctx context.Context, now time.Time) (int, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT DISTINCT pl.url, lc.checked_at FROM post_links pl
+		LEFT JOIN link_checks lc ON lc.url = pl.url
+		WHERE lc.checked_at IS NULL OR lc.checked_at < ?`), now.Add(-s.interval))
+	if err != nil {
+		return 0, err
+	}
+	type due struct {
+		url       string
+		checkedAt sql.NullTime
+	}
+	var list []due
+	for rows.Next() {
+		var d due
+		if err := rows.Scan(&d.url, &d.checkedAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		list = append(list, d)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	sort.SliceStable(list, func(i, j int) bool {
+		if list[i].checkedAt.Valid != list[j].checkedAt.Valid {
+			return !list[i].checkedAt.Valid
+		}
+		return list[i].checkedAt.Time.Before(list[j].checkedAt.Time)
+	})
+	if s.batch > 0 && len(list) > s.batch {
+		list = list[:s.batch]
+	}
+
+	for i, d := range list {
+		if err := ctx.Err(); err != nil {
+			return i, err
+		}
+		status, checkErr := s.check(ctx, d.url)
+		if err := s.record(ctx, d.url, status, checkErr, time.Now().UTC()); err != nil {
+			return i, err
+		}
+	}
+	return len(list), nil
+}
+
+// check requests link and returns the final status code after redirects.
+// Servers that refuse HEAD requests are asked again with GET.
+func (s *Store) check(ctx context.Context, link string) (int, error) {
+	status, err := s.request(ctx, http.MethodHead, link)
+	if (err == nil && !working(status)) || (err != nil && ctx.Err() == nil) {
+		return s.request(ctx, http.MethodGet, link)
+	}
+	return status, err
+}
+
+func (s *Store) request(ctx context.Context, method, link string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, link, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", "silan-backend link checker")
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+// working reports whether status means the link works.
+func working(status int) bool {
+	return status >= 200 && status < 400
+}
+
+// record stores the result of checking link at now.
+func (s *Store) record(ctx context.Context, link string, status int, checkErr error, now time.Time) error {
+	var (
+		message  string
+		lastOK   any
+		failures = 1
+	)
+	if checkErr != nil {
+		message = checkErr.Error()
+		if len(message) > maxErrorLength {
+			message = message[:maxErrorLength]
+		}
+	} else if !working(status) {
+		message = http.StatusText(status)
+	}
+	if message == "" {
+		lastOK = now
+		failures = 0
+	}
+
+	var query string
+	if s.driver == "mysql" {
+		query = `INSERT INTO link_checks (url, status_code, error, failures, checked_at, last_ok_at, failing_since)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				status_code = VALUES(status_code),
+				error = VALUES(error),
+				failing_since = CASE WHEN VALUES(failures) = 0 THEN NULL ELSE COALESCE(failing_since, VALUES(failing_since)) END,
+				failures = CASE WHEN VALUES(failures) = 0 THEN 0 ELSE failures + 1 END,
+				checked_at = VALUES(checked_at),
+				last_ok_at = COALESCE(VALUES(last_ok_at), last_ok_at)`
+	} else {
+		query = `INSERT INTO link_checks (url, status_code, error, failures, checked_at, last_ok_at, failing_since)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (url) DO UPDATE SET
+				status_code = excluded.status_code,
+				error = excluded.error,
+				failures = CASE WHEN excluded.failures = 0 THEN 0 ELSE link_checks.failures + 1 END,
+				checked_at = excluded.checked_at,
+				last_ok_at = COALESCE(excluded.last_ok_at, link_checks.last_ok_at),
+				failing_since = CASE WHEN excluded.failures = 0 THEN NULL ELSE COALESCE(link_checks.failing_since, excluded.failing_since) END`
+	}
+	var failingSince any
+	if failures > 0 {
+		failingSince = now
+	}
+	_, err := s.db.ExecContext(ctx, s.rebind(query), link, status, message, failures, now, lastOK, failingSince)
+	return err
+}
+
+// Broken returns the links whose latest check failed, those failing the
+// longest first, with the posts linking to them.
+func (s *Store) Broken(ctx context.Context) ([]*Link, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT lc.url, lc.status_code, lc.error, lc.failures, lc.checked_at, lc.last_ok_at, lc.failing_since, pl.post_id
+		FROM link_checks lc JOIN post_links pl ON pl.url = lc.url
+		WHERE lc.failures > 0
+		ORDER BY lc.failing_since, lc.url, pl.post_id`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Link
+	byURL := make(map[string]*Link)
+	for rows.Next() {
+		var (
+			l            Link
+			message      sql.NullString
+			lastOK       sql.NullTime
+			failingSince sql.NullTime
+			postID       string
+		)
+		if err := rows.Scan(&l.URL, &l.StatusCode, &message, &l.Failures, &l.CheckedAt, &lastOK, &failingSince, &postID); err != nil {
+			return nil, err
+		}
+		if prev, ok := byURL[l.URL]; ok {
+			prev.PostIDs = append(prev.PostIDs, postID)
+			continue
+		}
+		l.Error = message.String
+		if lastOK.Valid {
+			l.LastOKAt = &lastOK.Time
+		}
+		if failingSince.Valid {
+			l.FailingSince = &failingSince.Time
+		}
+		l.PostIDs = []string{postID}
+		byURL[l.URL] = &l
+		list = append(list, &l)
+	}
+	return list, rows.Err()
+}
@@ -0,0 +1,234 @@
+// Package linksuggest suggests internal links for a draft post: published
+// posts, public projects and public ideas sharing tags or keywords with it,
+// together with phrases of the draft that could carry the link.
+package linksuggest
+
+import (
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"silan-backend/internal/ask"
+)
+
+// Scoring and output limits.
+const (
+	// labelWeight is the score of each shared tag, worth a few keywords
+	labelWeight = 3.0
+	// minKeywords is how many keywords a target shares with the draft to be
+	// suggested without a shared tag
+	minKeywords = 2
+	maxKeywords = 5
+	maxAnchors  = 5
+)
+
+// Target is content the draft could link to. Labels are its tags, or the
+// technologies of a project; Text is a summary matched for keywords.
+type Target struct {
+	Type   string
+	ID     string
+	Slug   string
+	Title  string
+	URL    string
+	Labels []string
+	Text   string
+}
+
+// Draft is the post links are suggested for. Its own ID is never
+// suggested.
+type Draft struct {
+	ID      string
+	Title   string
+	Content string
+	Labels  []string
+}
+
+// Suggestion is a target worth linking to from the draft. Anchors are
+// phrases found in the draft content, in the draft's spelling.
+type Suggestion struct {
+	Target       *Target
+	Score        float64
+	SharedLabels []string
+	Keywords     []string
+	Anchors      []string
+}
+
+// Suggest ranks targets by the tags and keywords they share with the draft
+// and returns up to limit of them. Targets the draft already links to are
+// left out.
+func Suggest(d Draft, targets []*Target, limit int) []Suggestion {
+	draftTerms := map[string]bool{}
+	for _, t := range ask.Tokenize(d.Title + " " + d.Content) {
+		draftTerms[t] = true
+	}
+	draftLabels := map[string]bool{}
+	for _, l := range d.Labels {
+		draftLabels[strings.ToLower(strings.TrimSpace(l))] = true
+	}
+
+	// Terms are weighted by how few targets use them
+	targetTerms := make([]map[string]bool, len(targets))
+	df := map[string]int{}
+	for i, t := range targets {
+		targetTerms[i] = keywords(t.Title + " " + t.Text)
+		for term := range targetTerms[i] {
+			df[term]++
+		}
+	}
+	n := float64(len(targets))
+
+	var out []Suggestion
+	for i, t := range targets {
+		if t.ID == d.ID || linksTo(d.Content, t) {
+			continue
+		}
+
+		s := Suggestion{Target: t}
+		for _, l := range t.Labels {
+			if draftLabels[strings.ToLower(strings.TrimSpace(l))] {
+				s.SharedLabels = append(s.SharedLabels, l)
+			}
+		}
+		type weighted struct {
+			term string
+			idf  float64
+		}
+		var shared []weighted
+		keywordScore := 0.0
+		for term := range targetTerms[i] {
+			if draftTerms[term] {
+				idf := math.Log(1 + n/float64(df[term]))
+				shared = append(shared, weighted{term, idf})
+				keywordScore += idf
+			}
+		}
+		if len(s.SharedLabels) == 0 && len(shared) < minKeywords {
+			continue
+		}
+		// Long summaries would otherwise win on volume alone
+		if len(targetTerms[i]) > 0 {
+			keywordScore /= math.Sqrt(float64(len(targetTerms[i])))
+		}
+		s.Score = labelWeight*float64(len(s.SharedLabels)) + keywordScore
+
+		sort.Slice(shared, func(a, b int) bool {
+			if shared[a].idf != shared[b].idf {
+				return shared[a].idf > shared[b].idf
+			}
+			return shared[a].term < shared[b].term
+		})
+		for j := 0; j < len(shared) && j < maxKeywords; j++ {
+			s.Keywords = append(s.Keywords, shared[j].term)
+		}
+		s.Anchors = findAnchors(d.Content, t, s.SharedLabels)
+		out = append(out, s)
+	}
+
+	sort.SliceStable(out, func(a, b int) bool { return out[a].Score > out[b].Score })
+	if limit > 0 && len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+// keywords returns the distinct tokens of s worth matching. Tokens of one or
+// two characters, single Han characters among them, are too common to mean
+// anything.
+func keywords(s string) map[string]bool {
+	terms := map[string]bool{}
+	for _, t := range ask.Tokenize(s) {
+		if utf8.RuneCountInString(t) > 2 {
+			terms[t] = true
+		}
+	}
+	return terms
+}
+
+// sections are the site paths of each target type.
+var sections = map[string]string{
+	"blog":    "/blog/",
+	"project": "/projects/",
+	"idea":    "/ideas/",
+}
+
+// linksTo reports whether content already links to the target by URL, or
+// by its ID or slug under the target's section of the site.
+func linksTo(content string, t *Target) bool {
+	if t.URL != "" && strings.Contains(content, t.URL) {
+		return true
+	}
+	for _, key := range []string{t.ID, t.Slug} {
+		if key != "" && strings.Contains(content, sections[t.Type]+key) {
+			return true
+		}
+	}
+	return false
+}
+
+// findAnchors returns up to maxAnchors phrases of content that could carry a
+// link to t: its title, the shared labels and runs of words from its
+// title, the longest first. Phrases inside an earlier anchor are skipped.
+func findAnchors(content string, t *Target, labels []string) []string {
+	phrases := []string{t.Title}
+	phrases = append(phrases, labels...)
+	words := strings.Fields(t.Title)
+	for size := len(words) - 1; size >= 2; size-- {
+		for i := 0; i+size <= len(words); i++ {
+			phrases = append(phrases, strings.Join(words[i:i+size], " "))
+		}
+	}
+
+	seen := map[string]bool{}
+	var anchors []string
+	for _, p := range phrases {
+		p = strings.TrimFunc(p, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) })
+		if p == "" || seen[strings.ToLower(p)] || len(ask.Tokenize(p)) == 0 {
+			continue
+		}
+		seen[strings.ToLower(p)] = true
+		if found, ok := find(content, p); ok && !within(anchors, found) {
+			anchors = append(anchors, found)
+			if len(anchors) == maxAnchors {
+				break
+			}
+		}
+	}
+	return anchors
+}
+
+// within reports whether phrase is part of one of the anchors already
+// found, which would make it a weaker duplicate.
+func within(anchors []string, phrase string) bool {
+	for _, a := range anchors {
+		if strings.Contains(strings.ToLower(a), strings.ToLower(phrase)) {
+			return true
+		}
+	}
+	return false
+}
+
+// find looks up phrase in content case-insensitively as whole words and
+// returns it as spelled in content. Phrases with Han characters match
+// anywhere, since Chinese has no spaces between words.
+func find(content, phrase string) (string, bool) {
+	pattern := regexp.QuoteMeta(phrase)
+	pattern = strings.Join(strings.Fields(pattern), `\s+`)
+	hasHan := strings.IndexFunc(phrase, func(r rune) bool { return unicode.Is(unicode.Han, r) }) >= 0
+	if !hasHan {
+		pattern = `(?:^|[^\p{L}\p{N}])(` + pattern + `)(?:$|[^\p{L}\p{N}])`
+	} else {
+		pattern = `(` + pattern + `)`
+	}
+	re, err := regexp.Compile(`(?i)` + pattern)
+	if err != nil {
+		return "", false
+	}
+	m := re.FindStringSubmatch(content)
+	if m == nil {
+		return "", false
+	}
+	return m[1], true
+}
@@ -0,0 +1,168 @@
+// Package llm sends prompts to a hosted language model. Two wire formats are
+// supported: OpenAI-style chat completions (which also covers most
+// self-hosted and compatible providers) and the Anthropic messages API.
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Providers understood by New.
+const (
+	ProviderOpenAI    = "openai"
+	ProviderAnthropic = "anthropic"
+)
+
+// ErrDisabled is returned when no model is configured.
+var ErrDisabled = errors.New("no language model is configured")
+
+// Client completes prompts with the configured model.
+type Client struct {
+	provider string
+	baseURL  string
+	model    string
+	apiKey   string
+	http     *http.Client
+}
+
+// New returns a client for provider. An empty baseURL selects the provider's
+// public API. The client is disabled while model is empty.
+func New(provider, baseURL, model, apiKey string, timeout time.Duration) *Client {
+	if baseURL == "" {
+		baseURL = "https://api.openai.com/v1"
+		if provider == ProviderAnthropic {
+			baseURL = "https://api.anthropic.com/v1"
+		}
+	}
+	return &Client{
+		provider: provider,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		model:    model,
+		apiKey:   apiKey,
+		http:     &http.Client{Timeout: timeout},
+	}
+}
+
+// Enabled reports whether a model is configured.
+func (c *Client) Enabled() bool {
+	return c != nil && c.model != ""
+}
+
+// Model is the configured model name.
+func (c *Client) Model() string {
+	return c.model
+}
+
+// Complete sends a system instruction and a user prompt and returns the
+// model's text reply.
+func (c *Client) Complete(ctx context.Context, system, prompt string) (string, error) {
+	if !c.Enabled() {
+		return "", ErrDisabled
+	}
+	switch c.provider {
+	case ProviderAnthropic:
+		return c.completeAnthropic(ctx, system, prompt)
+	case ProviderOpenAI, "":
+		return c.completeOpenAI(ctx, system, prompt)
+	default:
+		return "", fmt.Errorf("unknown llm provider %q", c.provider)
+	}
+}
+
+func (c *Client) completeOpenAI(ctx context.Context, system, prompt string) (string, error) {
+	body := map[string]any{
+		"model": c.model,
+		"messages": []map[string]string{
+			{"role": "system", "content": system},
+			{"role": "user", "content": prompt},
+		},
+	}
+	headers := map[string]string{}
+	if c.apiKey != "" {
+		headers["Authorization"] = "Bearer " + c.apiKey
+	}
+
+	var out struct {
+		Choices []struct {
+			Message struct {
+				Content string `json:"content"`
+			} `json:"message"`
+		} `json:"choices"`
+	}
+	if err := c.post(ctx, "/chat/completions", headers, body, &out); err != nil {
+		return "", err
+	}
+	if len(out.Choices) == 0 {
+		return "", errors.New("llm: empty response")
+	}
+	return out.Choices[0].Message.Content, nil
+}
+
+func (c *Client) completeAnthropic(ctx context.Context, system, prompt string) (string, error) {
+	body := map[string]any{
+		"model":      c.model,
+		"max_tokens": 4096,
+		"system":     system,
+		"messages": []map[string]string{
+			{"role": "user", "content": prompt},
+		},
+	}
+	headers := map[string]string{
+		"x-api-key":         c.apiKey,
+		"anthropic-version": "2023-06-01",
+	}
+
+	var out struct {
+		Content []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"content"`
+	}
+	if err := c.post(ctx, "/messages", headers, body, &out); err != nil {
+		return "", err
+	}
+	var text strings.Builder
+	for _, block := range out.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	if text.Len() == 0 {
+		return "", errors.New("llm: empty response")
+	}
+	return text.String(), nil
+}
+
+func (c *Client) post(ctx context.Context, path string, headers map[string]string, body, out any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("llm: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("llm: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
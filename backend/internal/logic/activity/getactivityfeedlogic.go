@@ -0,0 +1,29 @@
+package activity
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetActivityFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the public activity feed: publishes, updates and approved comments
+func NewGetActivityFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetActivityFeedLogic {
+	return &GetActivityFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetActivityFeedLogic) GetActivityFeed(req *types.ActivityRequest) (resp *types.ActivityResponse, err error) {
+	return l.svcCtx.ListActivity(l.ctx, req, true)
+}
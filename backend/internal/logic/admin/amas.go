@@ -0,0 +1,33 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ama"
+)
+
+// applyAmaFields validates and copies the editable fields onto a.
+func applyAmaFields(a *ama.AMA, title, description, opensAt, closesAt string) error {
+	a.Title = strings.TrimSpace(title)
+	a.Description = strings.TrimSpace(description)
+	if a.Title == "" {
+		return fmt.Errorf("title is required")
+	}
+
+	opens, err := time.Parse(time.RFC3339, opensAt)
+	if err != nil {
+		return fmt.Errorf("invalid opens_at, expected RFC 3339")
+	}
+	closes, err := time.Parse(time.RFC3339, closesAt)
+	if err != nil {
+		return fmt.Errorf("invalid closes_at, expected RFC 3339")
+	}
+	if !closes.After(opens) {
+		return fmt.Errorf("closes_at must be after opens_at")
+	}
+	a.OpensAt = opens.UTC()
+	a.ClosesAt = closes.UTC()
+	return nil
+}
@@ -0,0 +1,102 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AnswerAmaQuestionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Answer an AMA question, by marking an owner reply or posting one
+func NewAnswerAmaQuestionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AnswerAmaQuestionLogic {
+	return &AnswerAmaQuestionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AnswerAmaQuestionLogic) AnswerAmaQuestion(req *types.AnswerAmaQuestionRequest) (resp *types.AmaAnswerData, err error) {
+	a, err := l.svcCtx.AMAs.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	// A closed AMA is read-only, for the owner as well
+	if a.Status(time.Now()) == ama.StatusClosed {
+		return nil, fmt.Errorf("this AMA is closed")
+	}
+	questionID, err := uuid.Parse(req.QuestionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid question id")
+	}
+	question, err := l.svcCtx.DB.Comment.Get(l.ctx, questionID)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("question not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if question.EntityType != ama.EntityType || question.EntityID.String() != a.ID || question.ParentID != uuid.Nil {
+		return nil, fmt.Errorf("comment %s is not a question of this AMA", questionID)
+	}
+
+	// Either post the answer as the owner or mark a reply already in the
+	// thread
+	content := strings.TrimSpace(req.Content)
+	var answer *ent.Comment
+	switch {
+	case content != "" && req.AnswerID != "":
+		return nil, fmt.Errorf("content and answer_id are mutually exclusive")
+	case content != "":
+		answer, _, err = l.svcCtx.ReplyAsOwner(l.ctx, questionID, content)
+		if err != nil {
+			return nil, err
+		}
+	case req.AnswerID != "":
+		answerID, err := uuid.Parse(req.AnswerID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid answer_id")
+		}
+		answer, err = l.svcCtx.DB.Comment.Get(l.ctx, answerID)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("answer not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		if answer.ParentID != questionID || !answer.IsApproved {
+			return nil, fmt.Errorf("comment %s is not an approved reply to the question", answerID)
+		}
+	default:
+		return nil, fmt.Errorf("content or answer_id is required")
+	}
+
+	if err := l.svcCtx.AMAs.MarkAnswer(l.ctx, a.ID, questionID.String(), answer.ID.String()); err != nil {
+		l.Errorf("Failed to mark comment %s as answer in AMA %s: %v", answer.ID, a.ID, err)
+		return nil, fmt.Errorf("failed to mark answer")
+	}
+
+	l.Infof("Answered question %s of AMA %s with comment %s", questionID, a.ID, answer.ID)
+	return &types.AmaAnswerData{
+		ID:         answer.ID.String(),
+		AuthorName: answer.AuthorName,
+		Content:    answer.Content,
+		CreatedAt:  utils.FormatTime(answer.CreatedAt),
+		IsAuthor:   l.svcCtx.IsOwnerComment(answer),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"silan-backend/internal/apikey"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+func toApiKeyData(k *apikey.Key) types.ApiKeyData {
+	data := types.ApiKeyData{
+		ID:           k.ID,
+		Name:         k.Name,
+		Prefix:       k.Prefix,
+		DailyQuota:   k.DailyQuota,
+		MonthlyQuota: k.MonthlyQuota,
+		Scopes:       k.Scopes,
+		CreatedAt:    utils.FormatTime(k.CreatedAt),
+		Revoked:      k.RevokedAt != nil,
+	}
+	if k.LastUsedAt != nil {
+		data.LastUsedAt = utils.FormatTime(*k.LastUsedAt)
+	}
+	return data
+}
+
+func toApiKeyUsage(r *apikey.Report) *types.ApiKeyUsageResponse {
+	daily := make([]types.ApiKeyDailyUsage, 0, len(r.Daily))
+	for _, d := range r.Daily {
+		daily = append(daily, types.ApiKeyDailyUsage{Day: d.Day, Count: d.Count})
+	}
+	remainingToday, remainingMonth := r.Remaining()
+	return &types.ApiKeyUsageResponse{
+		KeyID:            r.Key.ID,
+		Name:             r.Key.Name,
+		DailyQuota:       r.Key.DailyQuota,
+		MonthlyQuota:     r.Key.MonthlyQuota,
+		UsedToday:        r.UsedToday,
+		UsedThisMonth:    r.UsedMonth,
+		RemainingToday:   remainingToday,
+		RemainingMonthly: remainingMonth,
+		Daily:            daily,
+	}
+}
@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Approve a held comment
+func NewApproveCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveCommentLogic {
+	return &ApproveCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ApproveCommentLogic) ApproveComment(req *types.ModerateCommentRequest) error {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return fmt.Errorf("invalid comment id")
+	}
+	c, err := l.svcCtx.ApproveComment(l.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	l.Infof("Approved comment %s by %s", c.ID, c.AuthorName)
+	return nil
+}
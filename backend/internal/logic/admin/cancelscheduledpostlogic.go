@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CancelScheduledPostLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Cancel the scheduled publishing of a blog post
+func NewCancelScheduledPostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CancelScheduledPostLogic {
+	return &CancelScheduledPostLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CancelScheduledPostLogic) CancelScheduledPost(req *types.ScheduledPostRequest) error {
+	return l.svcCtx.Publishing.Cancel(l.ctx, req.ID)
+}
@@ -0,0 +1,18 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/cannedreply"
+)
+
+// applyCannedResponseFields validates and copies the editable fields onto r.
+func applyCannedResponseFields(r *cannedreply.Response, title, content string) error {
+	r.Title = strings.TrimSpace(title)
+	r.Content = strings.TrimSpace(content)
+	if r.Title == "" || r.Content == "" {
+		return fmt.Errorf("title and content are required")
+	}
+	return nil
+}
@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"silan-backend/internal/ent"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+func toPendingCommentData(c *ent.Comment) types.PendingCommentData {
+	data := types.PendingCommentData{
+		ID:          c.ID.String(),
+		EntityType:  c.EntityType,
+		EntityID:    c.EntityID.String(),
+		AuthorName:  c.AuthorName,
+		AuthorEmail: c.AuthorEmail,
+		Content:     c.Content,
+		IPAddress:   c.IPAddress,
+		CreatedAt:   utils.FormatTime(c.CreatedAt),
+	}
+	if c.ParentID != uuid.Nil {
+		data.ParentID = c.ParentID.String()
+	}
+	return data
+}
@@ -0,0 +1,744 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/blogposttag"
+	"silan-backend/internal/ent/blogposttranslation"
+	"silan-backend/internal/ent/blogtag"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/ideadetail"
+	"silan-backend/internal/ent/ideatag"
+	"silan-backend/internal/ent/ideatranslation"
+	"silan-backend/internal/ent/language"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/ent/projectdetail"
+	"silan-backend/internal/ent/projectdetailtranslation"
+	"silan-backend/internal/ent/projecttechnology"
+	"silan-backend/internal/ent/projecttranslation"
+	"silan-backend/internal/ent/user"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// The content upserts treat the request as the complete state of an entry:
+// scalar fields are overwritten and the nested lists (tags, technologies,
+// translations) replace what is stored. Everything runs in the caller's
+// transaction, so a failing nested write leaves the entry untouched, and
+// every write is recorded in a contentChanges list that dry runs report
+// before rolling back. Writes that would make an entry public for the first
+// time ask publish first, which fails while publishing is blocked; drafts
+// and edits to live entries are always saved.
+
+// contentChanges lists the rows an upsert creates, updates or deletes.
+type contentChanges []types.ContentChange
+
+func (c *contentChanges) add(entity, key, action string) {
+	*c = append(*c, types.ContentChange{Entity: entity, Key: key, Action: action})
+}
+
+// replace records a list that is rewritten: keys in want are created or
+// updated and keys only in have are deleted.
+func (c *contentChanges) replace(entity string, have, want []string) {
+	c.diff(entity, have, want, true)
+}
+
+// link records a set of links, where kept links are no change.
+func (c *contentChanges) link(entity string, have, want []string) {
+	c.diff(entity, have, want, false)
+}
+
+func (c *contentChanges) diff(entity string, have, want []string, rewritten bool) {
+	old := make(map[string]bool, len(have))
+	for _, key := range have {
+		old[key] = true
+	}
+	for _, key := range want {
+		switch {
+		case !old[key]:
+			c.add(entity, key, "created")
+		case rewritten:
+			c.add(entity, key, "updated")
+		}
+		delete(old, key)
+	}
+	for _, key := range have {
+		if old[key] {
+			c.add(entity, key, "deleted")
+			delete(old, key)
+		}
+	}
+}
+
+func upsertAction(created bool) string {
+	if created {
+		return "created"
+	}
+	return "updated"
+}
+
+// contentOwner returns the user that owns synced content, preferring an
+// admin account.
+func contentOwner(ctx context.Context, tx *ent.Tx) (uuid.UUID, error) {
+	id, err := tx.User.Query().
+		Order(ent.Desc(user.FieldIsAdmin), ent.Asc(user.FieldCreatedAt)).
+		FirstID(ctx)
+	if ent.IsNotFound(err) {
+		return uuid.Nil, fmt.Errorf("no user exists to own the content")
+	}
+	return id, err
+}
+
+// parseContentTime accepts RFC 3339 timestamps and plain dates.
+func parseContentTime(field, value string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t.UTC(), nil
+	}
+	if t, err := time.Parse("2006-01-02", value); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("%s must be an RFC 3339 timestamp or a YYYY-MM-DD date", field)
+}
+
+// checkLanguages rejects duplicate or unknown language codes, which would
+// otherwise fail on the translation indexes and foreign keys.
+func checkLanguages(ctx context.Context, tx *ent.Tx, codes []string) error {
+	if len(codes) == 0 {
+		return nil
+	}
+	seen := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		if seen[code] {
+			return fmt.Errorf("duplicate translation for language %s", code)
+		}
+		seen[code] = true
+	}
+	known, err := tx.Language.Query().Where(language.IDIn(codes...)).IDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, code := range known {
+		delete(seen, code)
+	}
+	for code := range seen {
+		return fmt.Errorf("unknown language %s", code)
+	}
+	return nil
+}
+
+// publishCheck returns the publish callback of an upsert, which checks the
+// publishing policy and sets published once the entry goes live.
+func publishCheck(svcCtx *svc.ServiceContext, published *bool) func() error {
+	return func() error {
+		if err := svcCtx.CheckPublish(); err != nil {
+			return err
+		}
+		*published = true
+		return nil
+	}
+}
+
+// publishUpsertEvent records the content event of an upsert in tx: a publish
+// when the upsert made the entry public for the first time, an update
+// otherwise.
+func publishUpsertEvent(ctx context.Context, svcCtx *svc.ServiceContext, tx *ent.Tx, published bool, ev outbox.ContentEvent) error {
+	eventType := outbox.EventContentUpdated
+	if published {
+		eventType = outbox.EventContentPublished
+	}
+	return svcCtx.PublishEvent(ctx, tx, eventType, ev)
+}
+
+// upsertPost writes a blog post with its tags and translations.
+func upsertPost(ctx context.Context, tx *ent.Tx, req *types.UpsertPostRequest, publish func() error, changes *contentChanges) (*ent.BlogPost, bool, error) {
+	codes := make([]string, 0, len(req.Translations))
+	for _, t := range req.Translations {
+		codes = append(codes, t.LanguageCode)
+	}
+	if err := checkLanguages(ctx, tx, codes); err != nil {
+		return nil, false, err
+	}
+	var publishedAt time.Time
+	if req.PublishedAt != "" {
+		t, err := parseContentTime("published_at", req.PublishedAt)
+		if err != nil {
+			return nil, false, err
+		}
+		publishedAt = t
+	}
+
+	existing, err := tx.BlogPost.Query().Where(blogpost.SlugEQ(req.Slug)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+	if req.Status == string(blogpost.StatusPublished) && (existing == nil || existing.Status != blogpost.StatusPublished) {
+		if err := publish(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	var m *ent.BlogPostMutation
+	var create *ent.BlogPostCreate
+	var update *ent.BlogPostUpdateOne
+	if existing == nil {
+		ownerID, err := contentOwner(ctx, tx)
+		if err != nil {
+			return nil, false, err
+		}
+		create = tx.BlogPost.Create().SetUserID(ownerID).SetSlug(req.Slug)
+		m = create.Mutation()
+	} else {
+		update = tx.BlogPost.UpdateOne(existing)
+		m = update.Mutation()
+	}
+
+	m.SetTitle(req.Title)
+	m.SetExcerpt(req.Excerpt)
+	m.SetContent(req.Content)
+	m.SetContentType(blogpost.ContentType(req.ContentType))
+	m.SetStatus(blogpost.Status(req.Status))
+	m.SetIsFeatured(req.IsFeatured)
+	m.SetFeaturedImageURL(req.FeaturedImageURL)
+	if req.ReadingTimeMinutes > 0 {
+		m.SetReadingTimeMinutes(req.ReadingTimeMinutes)
+	} else if update != nil {
+		m.ClearReadingTimeMinutes()
+	}
+	switch {
+	case !publishedAt.IsZero():
+		m.SetPublishedAt(publishedAt)
+	case req.Status == string(blogpost.StatusPublished) && (existing == nil || existing.PublishedAt.IsZero()):
+		// First publication without an explicit date
+		m.SetPublishedAt(time.Now().UTC())
+	}
+
+	var post *ent.BlogPost
+	if create != nil {
+		post, err = create.Save(ctx)
+	} else {
+		post, err = update.Save(ctx)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	changes.add("post", post.Slug, upsertAction(existing == nil))
+
+	if err := setPostTags(ctx, tx, post.ID, req.Tags, changes); err != nil {
+		return nil, false, err
+	}
+	have, err := tx.BlogPostTranslation.Query().
+		Where(blogposttranslation.BlogPostIDEQ(post.ID)).
+		Select(blogposttranslation.FieldLanguageCode).
+		Strings(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	changes.replace("post_translation", have, codes)
+	if _, err := tx.BlogPostTranslation.Delete().Where(blogposttranslation.BlogPostIDEQ(post.ID)).Exec(ctx); err != nil {
+		return nil, false, err
+	}
+	for _, t := range req.Translations {
+		err := tx.BlogPostTranslation.Create().
+			SetBlogPostID(post.ID).
+			SetLanguageCode(t.LanguageCode).
+			SetTitle(t.Title).
+			SetExcerpt(t.Excerpt).
+			SetContent(t.Content).
+			Exec(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return post, existing == nil, nil
+}
+
+// setPostTags links the post to the named tags, creating missing tags and
+// keeping their usage counts in step.
+func setPostTags(ctx context.Context, tx *ent.Tx, postID uuid.UUID, names []string, changes *contentChanges) error {
+	links, err := tx.BlogPostTag.Query().Where(blogposttag.BlogPostIDEQ(postID)).All(ctx)
+	if err != nil {
+		return err
+	}
+	current := make(map[uuid.UUID]bool, len(links))
+	currentIDs := make([]uuid.UUID, 0, len(links))
+	for _, link := range links {
+		current[link.BlogTagID] = true
+		currentIDs = append(currentIDs, link.BlogTagID)
+	}
+	have, err := tx.BlogTag.Query().Where(blogtag.IDIn(currentIDs...)).Select(blogtag.FieldSlug).Strings(ctx)
+	if err != nil {
+		return err
+	}
+
+	var added, want []uuid.UUID
+	var wantSlugs []string
+	wanted := make(map[uuid.UUID]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		slug := utils.Slugify(name)
+		if slug == "" {
+			continue
+		}
+		tagID, err := tx.BlogTag.Query().Where(blogtag.SlugEQ(slug)).OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			var tag *ent.BlogTag
+			if tag, err = tx.BlogTag.Create().SetName(name).SetSlug(slug).Save(ctx); err == nil {
+				tagID = tag.ID
+				changes.add("blog_tag", slug, "created")
+			}
+		}
+		if err != nil {
+			return err
+		}
+		if wanted[tagID] {
+			continue
+		}
+		wanted[tagID] = true
+		want = append(want, tagID)
+		wantSlugs = append(wantSlugs, slug)
+		if !current[tagID] {
+			added = append(added, tagID)
+		}
+	}
+	changes.link("post_tag", have, wantSlugs)
+
+	var removed []uuid.UUID
+	for _, tagID := range currentIDs {
+		if !wanted[tagID] {
+			removed = append(removed, tagID)
+		}
+	}
+	if len(removed) > 0 {
+		_, err := tx.BlogPostTag.Delete().
+			Where(blogposttag.BlogPostIDEQ(postID), blogposttag.BlogTagIDIn(removed...)).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+		if err := tx.BlogTag.Update().Where(blogtag.IDIn(removed...)).AddUsageCount(-1).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, tagID := range added {
+		if err := tx.BlogPostTag.Create().SetBlogPostID(postID).SetBlogTagID(tagID).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if len(added) > 0 {
+		return tx.BlogTag.Update().Where(blogtag.IDIn(added...)).AddUsageCount(1).Exec(ctx)
+	}
+	return nil
+}
+
+// upsertProject writes a project with its details, technologies and
+// translations.
+func upsertProject(ctx context.Context, tx *ent.Tx, req *types.UpsertProjectRequest, publish func() error, changes *contentChanges) (*ent.Project, bool, error) {
+	codes := make([]string, 0, len(req.Translations))
+	for _, t := range req.Translations {
+		codes = append(codes, t.LanguageCode)
+	}
+	if err := checkLanguages(ctx, tx, codes); err != nil {
+		return nil, false, err
+	}
+	detailCodes := make([]string, 0, len(req.Details.Translations))
+	for _, t := range req.Details.Translations {
+		detailCodes = append(detailCodes, t.LanguageCode)
+	}
+	if err := checkLanguages(ctx, tx, detailCodes); err != nil {
+		return nil, false, err
+	}
+	var startDate, endDate time.Time
+	if req.StartDate != "" {
+		t, err := parseContentTime("start_date", req.StartDate)
+		if err != nil {
+			return nil, false, err
+		}
+		startDate = t
+	}
+	if req.EndDate != "" {
+		t, err := parseContentTime("end_date", req.EndDate)
+		if err != nil {
+			return nil, false, err
+		}
+		endDate = t
+	}
+
+	existing, err := tx.Project.Query().Where(project.SlugEQ(req.Slug)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, false, err
+	}
+	if req.IsPublic && (existing == nil || !existing.IsPublic) {
+		if err := publish(); err != nil {
+			return nil, false, err
+		}
+	}
+
+	var m *ent.ProjectMutation
+	var create *ent.ProjectCreate
+	var update *ent.ProjectUpdateOne
+	if existing == nil {
+		ownerID, err := contentOwner(ctx, tx)
+		if err != nil {
+			return nil, false, err
+		}
+		create = tx.Project.Create().SetUserID(ownerID).SetSlug(req.Slug)
+		m = create.Mutation()
+	} else {
+		update = tx.Project.UpdateOne(existing)
+		m = update.Mutation()
+	}
+
+	projectType := req.ProjectType
+	if projectType == "" {
+		projectType = project.DefaultProjectType
+	}
+	m.SetTitle(req.Title)
+	m.SetDescription(req.Description)
+	m.SetProjectType(projectType)
+	m.SetStatus(project.Status(req.Status))
+	m.SetGithubURL(req.GithubURL)
+	m.SetDemoURL(req.DemoURL)
+	m.SetDocumentationURL(req.DocumentationURL)
+	m.SetThumbnailURL(req.ThumbnailURL)
+	m.SetIsFeatured(req.IsFeatured)
+	m.SetIsPublic(req.IsPublic)
+	m.SetSortOrder(req.SortOrder)
+	if !startDate.IsZero() {
+		m.SetStartDate(startDate)
+	} else if update != nil {
+		m.ClearStartDate()
+	}
+	if !endDate.IsZero() {
+		m.SetEndDate(endDate)
+	} else if update != nil {
+		m.ClearEndDate()
+	}
+
+	var p *ent.Project
+	if create != nil {
+		p, err = create.Save(ctx)
+	} else {
+		p, err = update.Save(ctx)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	changes.add("project", p.Slug, upsertAction(existing == nil))
+
+	haveTech, err := tx.ProjectTechnology.Query().
+		Where(projecttechnology.ProjectIDEQ(p.ID)).
+		Select(projecttechnology.FieldTechnologyName).
+		Strings(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	wantTech := make([]string, 0, len(req.Technologies))
+	for _, tech := range req.Technologies {
+		wantTech = append(wantTech, tech.Name)
+	}
+	changes.replace("project_technology", haveTech, wantTech)
+	if _, err := tx.ProjectTechnology.Delete().Where(projecttechnology.ProjectIDEQ(p.ID)).Exec(ctx); err != nil {
+		return nil, false, err
+	}
+	for i, tech := range req.Technologies {
+		err := tx.ProjectTechnology.Create().
+			SetProjectID(p.ID).
+			SetTechnologyName(tech.Name).
+			SetTechnologyType(tech.Type).
+			SetSortOrder(i).
+			Exec(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := upsertProjectDetails(ctx, tx, p, &req.Details, detailCodes, changes); err != nil {
+		return nil, false, err
+	}
+
+	have, err := tx.ProjectTranslation.Query().
+		Where(projecttranslation.ProjectIDEQ(p.ID)).
+		Select(projecttranslation.FieldLanguageCode).
+		Strings(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	changes.replace("project_translation", have, codes)
+	if _, err := tx.ProjectTranslation.Delete().Where(projecttranslation.ProjectIDEQ(p.ID)).Exec(ctx); err != nil {
+		return nil, false, err
+	}
+	for _, t := range req.Translations {
+		err := tx.ProjectTranslation.Create().
+			SetProjectID(p.ID).
+			SetLanguageCode(t.LanguageCode).
+			SetTitle(t.Title).
+			SetDescription(t.Description).
+			SetProjectType(t.ProjectType).
+			Exec(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	return p, existing == nil, nil
+}
+
+func upsertProjectDetails(ctx context.Context, tx *ent.Tx, p *ent.Project, d *types.ProjectDetailInput, codes []string, changes *contentChanges) error {
+	detailID, err := tx.ProjectDetail.Query().Where(projectdetail.ProjectIDEQ(p.ID)).OnlyID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	var m *ent.ProjectDetailMutation
+	var create *ent.ProjectDetailCreate
+	var update *ent.ProjectDetailUpdateOne
+	if detailID == uuid.Nil {
+		create = tx.ProjectDetail.Create().SetProjectID(p.ID)
+		m = create.Mutation()
+	} else {
+		update = tx.ProjectDetail.UpdateOneID(detailID)
+		m = update.Mutation()
+	}
+	m.SetProjectDetails(d.ProjectDetails)
+	m.SetQuickStart(d.QuickStart)
+	m.SetReleaseNotes(d.ReleaseNotes)
+	m.SetDependencies(d.Dependencies)
+	m.SetLicense(d.License)
+	m.SetLicenseText(d.LicenseText)
+	m.SetVersion(d.Version)
+	if create != nil {
+		var detail *ent.ProjectDetail
+		if detail, err = create.Save(ctx); err == nil {
+			detailID = detail.ID
+		}
+	} else {
+		err = update.Exec(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	changes.add("project_detail", p.Slug, upsertAction(create != nil))
+
+	have, err := tx.ProjectDetailTranslation.Query().
+		Where(projectdetailtranslation.ProjectDetailIDEQ(detailID)).
+		Select(projectdetailtranslation.FieldLanguageCode).
+		Strings(ctx)
+	if err != nil {
+		return err
+	}
+	changes.replace("project_detail_translation", have, codes)
+	if _, err := tx.ProjectDetailTranslation.Delete().Where(projectdetailtranslation.ProjectDetailIDEQ(detailID)).Exec(ctx); err != nil {
+		return err
+	}
+	for _, t := range d.Translations {
+		err := tx.ProjectDetailTranslation.Create().
+			SetProjectDetailID(detailID).
+			SetLanguageCode(t.LanguageCode).
+			SetDetailedDescription(t.DetailedDescription).
+			SetGoals(t.Goals).
+			SetChallenges(t.Challenges).
+			SetSolutions(t.Solutions).
+			SetLessonsLearned(t.LessonsLearned).
+			SetFutureEnhancements(t.FutureEnhancements).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ideaEvent returns the content event of an idea upserted from the previous
+// status.
+func ideaEvent(i *ent.Idea, previous string) outbox.ContentEvent {
+	return outbox.ContentEvent{
+		Type:           "idea",
+		ID:             i.ID.String(),
+		Title:          i.Title,
+		Public:         i.IsPublic,
+		Status:         string(i.Status),
+		PreviousStatus: previous,
+	}
+}
+
+// upsertIdea writes an idea with its details, tags and translations. It
+// returns the idea and its status before the upsert, which is empty for new
+// ideas.
+func upsertIdea(ctx context.Context, tx *ent.Tx, req *types.UpsertIdeaRequest, publish func() error, changes *contentChanges) (*ent.Idea, string, error) {
+	codes := make([]string, 0, len(req.Translations))
+	for _, t := range req.Translations {
+		codes = append(codes, t.LanguageCode)
+	}
+	if err := checkLanguages(ctx, tx, codes); err != nil {
+		return nil, "", err
+	}
+
+	existing, err := tx.Idea.Query().Where(idea.SlugEQ(req.Slug)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, "", err
+	}
+	if req.IsPublic && (existing == nil || !existing.IsPublic) {
+		if err := publish(); err != nil {
+			return nil, "", err
+		}
+	}
+
+	tagIDs, tagSlugs, err := ideaTagIDs(ctx, tx, req.Tags, changes)
+	if err != nil {
+		return nil, "", err
+	}
+	var haveTags []string
+	if existing != nil {
+		haveTags, err = tx.Idea.QueryTags(existing).Select(ideatag.FieldSlug).Strings(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	changes.link("idea_tag_link", haveTags, tagSlugs)
+
+	var m *ent.IdeaMutation
+	var create *ent.IdeaCreate
+	var update *ent.IdeaUpdateOne
+	if existing == nil {
+		ownerID, err := contentOwner(ctx, tx)
+		if err != nil {
+			return nil, "", err
+		}
+		create = tx.Idea.Create().SetUserID(ownerID).SetSlug(req.Slug).AddTagIDs(tagIDs...)
+		m = create.Mutation()
+	} else {
+		update = tx.Idea.UpdateOne(existing).ClearTags().AddTagIDs(tagIDs...)
+		m = update.Mutation()
+	}
+	m.SetTitle(req.Title)
+	m.SetDescription(req.Description)
+	m.SetAbstract(req.Abstract)
+	m.SetStatus(idea.Status(req.Status))
+	m.SetIsPublic(req.IsPublic)
+	m.SetCategory(req.Category)
+
+	var i *ent.Idea
+	if create != nil {
+		i, err = create.Save(ctx)
+	} else {
+		i, err = update.Save(ctx)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	changes.add("idea", i.Slug, upsertAction(existing == nil))
+
+	if err := upsertIdeaDetails(ctx, tx, i, &req.Details, changes); err != nil {
+		return nil, "", err
+	}
+
+	have, err := tx.IdeaTranslation.Query().
+		Where(ideatranslation.IdeaIDEQ(i.ID)).
+		Select(ideatranslation.FieldLanguageCode).
+		Strings(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	changes.replace("idea_translation", have, codes)
+	if _, err := tx.IdeaTranslation.Delete().Where(ideatranslation.IdeaIDEQ(i.ID)).Exec(ctx); err != nil {
+		return nil, "", err
+	}
+	for _, t := range req.Translations {
+		err := tx.IdeaTranslation.Create().
+			SetIdeaID(i.ID).
+			SetLanguageCode(t.LanguageCode).
+			SetTitle(t.Title).
+			SetAbstract(t.Abstract).
+			SetMotivation(t.Motivation).
+			SetMethodology(t.Methodology).
+			SetExpectedOutcome(t.ExpectedOutcome).
+			SetRequiredResources(t.RequiredResources).
+			Exec(ctx)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	if existing == nil {
+		return i, "", nil
+	}
+	return i, string(existing.Status), nil
+}
+
+// ideaTagIDs returns the IDs and slugs of the named idea tags, creating
+// missing ones.
+func ideaTagIDs(ctx context.Context, tx *ent.Tx, names []string, changes *contentChanges) ([]uuid.UUID, []string, error) {
+	var ids []uuid.UUID
+	var slugs []string
+	seen := make(map[uuid.UUID]bool, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		slug := utils.Slugify(name)
+		if slug == "" {
+			continue
+		}
+		id, err := tx.IdeaTag.Query().Where(ideatag.SlugEQ(slug)).OnlyID(ctx)
+		if ent.IsNotFound(err) {
+			var tag *ent.IdeaTag
+			if tag, err = tx.IdeaTag.Create().SetName(name).SetSlug(slug).Save(ctx); err == nil {
+				id = tag.ID
+				changes.add("idea_tag", slug, "created")
+			}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+			slugs = append(slugs, slug)
+		}
+	}
+	return ids, slugs, nil
+}
+
+func upsertIdeaDetails(ctx context.Context, tx *ent.Tx, i *ent.Idea, d *types.IdeaDetailInput, changes *contentChanges) error {
+	detailID, err := tx.IdeaDetail.Query().Where(ideadetail.IdeaIDEQ(i.ID)).OnlyID(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	var m *ent.IdeaDetailMutation
+	var create *ent.IdeaDetailCreate
+	var update *ent.IdeaDetailUpdateOne
+	if detailID == uuid.Nil {
+		create = tx.IdeaDetail.Create().SetIdeaID(i.ID)
+		m = create.Mutation()
+	} else {
+		update = tx.IdeaDetail.UpdateOneID(detailID)
+		m = update.Mutation()
+	}
+	m.SetProgress(d.Progress)
+	m.SetResults(d.Results)
+	m.SetReferences(d.References)
+	m.SetRequiredResources(d.RequiredResources)
+	m.SetCollaborationNeeded(d.CollaborationNeeded)
+	m.SetFundingRequired(d.FundingRequired)
+	if d.EstimatedDurationMonths > 0 {
+		m.SetEstimatedDurationMonths(d.EstimatedDurationMonths)
+	} else if update != nil {
+		m.ClearEstimatedDurationMonths()
+	}
+	if d.EstimatedBudget > 0 {
+		m.SetEstimatedBudget(d.EstimatedBudget)
+	} else if update != nil {
+		m.ClearEstimatedBudget()
+	}
+	changes.add("idea_detail", i.Slug, upsertAction(create != nil))
+	if create != nil {
+		return create.Exec(ctx)
+	}
+	return update.Exec(ctx)
+}
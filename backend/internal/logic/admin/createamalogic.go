@@ -0,0 +1,43 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateAmaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Schedule an AMA session
+func NewCreateAmaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateAmaLogic {
+	return &CreateAmaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateAmaLogic) CreateAma(req *types.CreateAmaRequest) (resp *types.AmaData, err error) {
+	a := &ama.AMA{}
+	if err := applyAmaFields(a, req.Title, req.Description, req.OpensAt, req.ClosesAt); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.AMAs.Create(l.ctx, a); err != nil {
+		l.Errorf("Failed to create AMA %q: %v", a.Title, err)
+		return nil, fmt.Errorf("failed to create AMA")
+	}
+
+	data := a.Data(time.Now(), 0)
+	return &data, nil
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"silan-backend/internal/apikey"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateApiKeyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create an API key with optional quotas
+func NewCreateApiKeyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateApiKeyLogic {
+	return &CreateApiKeyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateApiKeyLogic) CreateApiKey(req *types.CreateApiKeyRequest) (resp *types.CreateApiKeyResponse, err error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if req.DailyQuota < 0 || req.MonthlyQuota < 0 {
+		return nil, fmt.Errorf("quotas must not be negative")
+	}
+	for _, scope := range req.Scopes {
+		if !apikey.ValidScope(scope) {
+			return nil, fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+	// Read scopes go into keys that end up in public pages
+	if slices.Contains(req.Scopes, apikey.ScopeAdmin) && len(req.Scopes) > 1 {
+		return nil, fmt.Errorf("the admin scope can't be combined with read scopes")
+	}
+
+	key, secret, err := l.svcCtx.ApiKeys.Create(l.ctx, name, req.DailyQuota, req.MonthlyQuota, slices.Compact(slices.Sorted(slices.Values(req.Scopes))))
+	if err != nil {
+		l.Errorf("Failed to create API key %q: %v", name, err)
+		return nil, fmt.Errorf("failed to create API key")
+	}
+
+	l.Infof("Created API key %s (%s) with scopes %v", key.ID, key.Name, key.Scopes)
+
+	return &types.CreateApiKeyResponse{
+		Key:    toApiKeyData(key),
+		Secret: secret,
+	}, nil
+}
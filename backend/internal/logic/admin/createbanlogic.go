@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ban"
+	"silan-backend/internal/fingerprint"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateBanLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Ban a visitor by user identity, email, fingerprint or IP
+func NewCreateBanLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateBanLogic {
+	return &CreateBanLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateBanLogic) CreateBan(req *types.CreateBanRequest) (resp *types.BanData, err error) {
+	value, err := ban.Normalize(req.Kind, req.Value)
+	if err != nil {
+		return nil, err
+	}
+	// fingerprints are banned in the hashed form they are stored in; a hash
+	// copied from a stored row is kept as is
+	if req.Kind == ban.KindFingerprint && !fingerprint.IsHashed(value) {
+		value = l.svcCtx.Fingerprints.Hash(l.ctx, value)
+	}
+	b := &ban.Ban{
+		Kind:   req.Kind,
+		Value:  value,
+		Reason: strings.TrimSpace(req.Reason),
+	}
+	if req.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return nil, fmt.Errorf("expires_at must be an RFC 3339 timestamp")
+		}
+		t = t.UTC()
+		b.ExpiresAt = &t
+	}
+
+	if err := l.svcCtx.Bans.Save(l.ctx, b); err != nil {
+		l.Errorf("Failed to save ban: %v", err)
+		return nil, fmt.Errorf("failed to save ban")
+	}
+
+	data := b.Data()
+	return &data, nil
+}
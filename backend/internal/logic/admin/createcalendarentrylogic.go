@@ -0,0 +1,119 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/calendar"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCalendarEntryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add a talk, milestone or deadline to the calendar
+func NewCreateCalendarEntryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCalendarEntryLogic {
+	return &CreateCalendarEntryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCalendarEntryLogic) CreateCalendarEntry(req *types.CreateCalendarEntryRequest) (resp *types.CalendarEntryData, err error) {
+	e := &calendar.Entry{
+		Kind:        req.Kind,
+		Title:       strings.TrimSpace(req.Title),
+		Description: strings.TrimSpace(req.Description),
+		Location:    strings.TrimSpace(req.Location),
+		URL:         strings.TrimSpace(req.URL),
+		AllDay:      req.AllDay,
+	}
+	if e.Title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	if e.URL != "" && !utils.IsHTTPURL(e.URL) {
+		return nil, fmt.Errorf("url must be an absolute http(s) URL")
+	}
+
+	e.StartsAt, err = parseCalendarTime(req.StartsAt, req.AllDay)
+	if err != nil {
+		return nil, fmt.Errorf("starts_at: %w", err)
+	}
+	if req.EndsAt != "" {
+		endsAt, err := parseCalendarTime(req.EndsAt, req.AllDay)
+		if err != nil {
+			return nil, fmt.Errorf("ends_at: %w", err)
+		}
+		if endsAt.Before(e.StartsAt) {
+			return nil, fmt.Errorf("ends_at must not be before starts_at")
+		}
+		e.EndsAt = &endsAt
+	}
+
+	if (req.EntityType == "") != (req.EntityID == "") {
+		return nil, fmt.Errorf("entity_type and entity_id must be given together")
+	}
+	if req.EntityType != "" {
+		if err := l.checkEntity(req.EntityType, req.EntityID); err != nil {
+			return nil, err
+		}
+		e.EntityType = req.EntityType
+		e.EntityID = req.EntityID
+	}
+
+	if err := l.svcCtx.Calendar.Create(l.ctx, e); err != nil {
+		l.Errorf("Failed to create calendar entry: %v", err)
+		return nil, fmt.Errorf("failed to create calendar entry")
+	}
+
+	data := e.Data()
+	return &data, nil
+}
+
+// checkEntity makes sure the linked project or idea exists.
+func (l *CreateCalendarEntryLogic) checkEntity(entityType, entityID string) error {
+	id := uuid.MustParse(entityID)
+	var exists bool
+	var err error
+	switch entityType {
+	case "project":
+		exists, err = l.svcCtx.DB.Project.Query().Where(project.ID(id)).Exist(l.ctx)
+	case "idea":
+		exists, err = l.svcCtx.DB.Idea.Query().Where(idea.ID(id)).Exist(l.ctx)
+	}
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("%s not found", entityType)
+	}
+	return nil
+}
+
+// parseCalendarTime accepts an RFC 3339 timestamp, or for all-day entries
+// also a YYYY-MM-DD date.
+func parseCalendarTime(s string, allDay bool) (time.Time, error) {
+	if allDay {
+		if d, err := time.Parse("2006-01-02", s); err == nil {
+			return d, nil
+		}
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("must be an RFC 3339 timestamp or, for all-day entries, a YYYY-MM-DD date")
+	}
+	return t, nil
+}
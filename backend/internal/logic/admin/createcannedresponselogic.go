@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/cannedreply"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateCannedResponseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add a canned response
+func NewCreateCannedResponseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateCannedResponseLogic {
+	return &CreateCannedResponseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateCannedResponseLogic) CreateCannedResponse(req *types.CreateCannedResponseRequest) (resp *types.CannedResponseData, err error) {
+	r := &cannedreply.Response{}
+	if err := applyCannedResponseFields(r, req.Title, req.Content); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.CannedResponses.Create(l.ctx, r); err != nil {
+		l.Errorf("Failed to create canned response %q: %v", r.Title, err)
+		return nil, fmt.Errorf("failed to create canned response")
+	}
+
+	data := r.Data()
+	return &data, nil
+}
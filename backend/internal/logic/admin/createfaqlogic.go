@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/faq"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateFAQLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create an FAQ entry
+func NewCreateFAQLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateFAQLogic {
+	return &CreateFAQLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateFAQLogic) CreateFAQ(req *types.CreateFAQRequest) (resp *types.FAQAdminData, err error) {
+	it := &faq.Item{}
+	if err := applyFAQFields(it, req.Category, req.Question, req.Answer, req.SortOrder, req.Translations); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.FAQs.Create(l.ctx, it); err != nil {
+		l.Errorf("Failed to create FAQ entry: %v", err)
+		return nil, fmt.Errorf("failed to create FAQ entry")
+	}
+
+	data := toFAQAdminData(it, faq.Feedback{})
+	return &data, nil
+}
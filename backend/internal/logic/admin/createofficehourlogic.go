@@ -0,0 +1,68 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/availability"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateOfficeHourLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add a bookable office hour slot
+func NewCreateOfficeHourLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateOfficeHourLogic {
+	return &CreateOfficeHourLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateOfficeHourLogic) CreateOfficeHour(req *types.CreateOfficeHourRequest) (resp *types.OfficeHourSlotData, err error) {
+	startsAt, err := time.Parse(time.RFC3339, req.StartsAt)
+	if err != nil {
+		return nil, fmt.Errorf("starts_at must be an RFC 3339 timestamp")
+	}
+	endsAt, err := time.Parse(time.RFC3339, req.EndsAt)
+	if err != nil {
+		return nil, fmt.Errorf("ends_at must be an RFC 3339 timestamp")
+	}
+	if !endsAt.After(startsAt) {
+		return nil, fmt.Errorf("ends_at must be after starts_at")
+	}
+	if !endsAt.After(time.Now()) {
+		return nil, fmt.Errorf("office hours must end in the future")
+	}
+
+	slot := &availability.Slot{
+		Title:      strings.TrimSpace(req.Title),
+		StartsAt:   startsAt,
+		EndsAt:     endsAt,
+		Location:   strings.TrimSpace(req.Location),
+		BookingURL: strings.TrimSpace(req.BookingURL),
+	}
+	if slot.Title == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+	if slot.BookingURL != "" && !utils.IsHTTPURL(slot.BookingURL) && !strings.HasPrefix(slot.BookingURL, "mailto:") {
+		return nil, fmt.Errorf("booking_url must be an absolute http(s) or mailto URL")
+	}
+	if err := l.svcCtx.Availability.CreateSlot(l.ctx, slot); err != nil {
+		l.Errorf("Failed to create office hour slot: %v", err)
+		return nil, fmt.Errorf("failed to create office hour slot")
+	}
+
+	data := slot.Data()
+	return &data, nil
+}
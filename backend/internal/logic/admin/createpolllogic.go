@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/poll"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create a poll
+func NewCreatePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePollLogic {
+	return &CreatePollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreatePollLogic) CreatePoll(req *types.CreatePollRequest) (resp *types.PollData, err error) {
+	p := &poll.Poll{}
+	if err := applyPollFields(p, req.Question, req.MultipleChoice, req.ClosesAt, req.Options); err != nil {
+		return nil, err
+	}
+	for i := range p.Options {
+		p.Options[i].ID = ""
+	}
+
+	if err := l.svcCtx.Polls.Create(l.ctx, p); err != nil {
+		l.Errorf("Failed to create poll: %v", err)
+		return nil, fmt.Errorf("failed to create poll")
+	}
+
+	data := p.Data(nil)
+	return &data, nil
+}
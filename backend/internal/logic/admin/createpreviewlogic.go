@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/preview"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreatePreviewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Issue a signed preview link for an unpublished post or project
+func NewCreatePreviewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreatePreviewLogic {
+	return &CreatePreviewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreatePreviewLogic) CreatePreview(req *types.CreatePreviewRequest) (resp *types.PreviewData, err error) {
+	secret := l.svcCtx.Config.Preview.Secret
+	if secret == "" {
+		return nil, fmt.Errorf("preview links are not configured")
+	}
+
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid id")
+	}
+	var section string
+	switch req.Type {
+	case preview.KindBlog:
+		_, err = l.svcCtx.DB.BlogPost.Get(l.ctx, id)
+		section = "/blog/"
+	case preview.KindProject:
+		_, err = l.svcCtx.DB.Project.Get(l.ctx, id)
+		section = "/projects/"
+	}
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%s %s not found", req.Type, req.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := req.TTLMinutes
+	if ttl <= 0 {
+		ttl = l.svcCtx.Config.Preview.TTLMinutes
+	}
+	claims := preview.Claims{
+		Kind:      req.Type,
+		ID:        id.String(),
+		ExpiresAt: time.Now().UTC().Add(time.Duration(ttl) * time.Minute),
+	}
+	token := preview.Sign([]byte(secret), claims)
+
+	return &types.PreviewData{
+		Token:     token,
+		URL:       strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/") + section + claims.ID + "?preview=" + url.QueryEscape(token),
+		ExpiresAt: utils.FormatTime(claims.ExpiresAt),
+	}, nil
+}
@@ -0,0 +1,92 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/shortlink"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create a short link for a URL, post or project
+func NewCreateShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateShortLinkLogic {
+	return &CreateShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateShortLinkLogic) CreateShortLink(req *types.CreateShortLinkRequest) (resp *types.ShortLinkData, err error) {
+	link := &shortlink.Link{
+		Code:    strings.TrimSpace(req.Code),
+		Channel: strings.TrimSpace(req.Channel),
+	}
+	if link.Code != "" && !shortlink.ValidCode(link.Code) {
+		return nil, fmt.Errorf("code must be 2-64 letters, digits, '-' or '_'")
+	}
+
+	// Links to posts/projects resolve to their page on the public site
+	if req.EntityType != "" {
+		target, err := l.entityURL(req.EntityType, req.EntityID)
+		if err != nil {
+			return nil, err
+		}
+		link.TargetURL = target
+		link.EntityType = req.EntityType
+		link.EntityID = req.EntityID
+	} else {
+		link.TargetURL = strings.TrimSpace(req.TargetURL)
+		if !utils.IsHTTPURL(link.TargetURL) {
+			return nil, fmt.Errorf("target_url must be an absolute http(s) URL")
+		}
+	}
+
+	err = l.svcCtx.Links.Create(l.ctx, link)
+	if errors.Is(err, shortlink.ErrCodeTaken) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to create short link: %v", err)
+		return nil, fmt.Errorf("failed to create short link")
+	}
+
+	data := toShortLinkData(link, l.svcCtx.Config.Site.ShortLinkBase)
+	return &data, nil
+}
+
+func (l *CreateShortLinkLogic) entityURL(entityType, entityID string) (string, error) {
+	id, err := uuid.Parse(entityID)
+	if err != nil {
+		return "", fmt.Errorf("invalid entity_id")
+	}
+
+	base := strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/")
+	switch entityType {
+	case "blog":
+		if _, err := l.svcCtx.DB.BlogPost.Get(l.ctx, id); err != nil {
+			return "", fmt.Errorf("blog post not found")
+		}
+		return base + "/blog/" + id.String(), nil
+	case "project":
+		if _, err := l.svcCtx.DB.Project.Get(l.ctx, id); err != nil {
+			return "", fmt.Errorf("project not found")
+		}
+		return base + "/projects/" + id.String(), nil
+	default:
+		return "", fmt.Errorf("entity_type must be 'blog' or 'project'")
+	}
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/siteupdate"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateSiteUpdateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Announce a site update
+func NewCreateSiteUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateSiteUpdateLogic {
+	return &CreateSiteUpdateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateSiteUpdateLogic) CreateSiteUpdate(req *types.CreateSiteUpdateRequest) (resp *types.SiteUpdateData, err error) {
+	u := &siteupdate.Update{}
+	if err := applySiteUpdateFields(u, req.Title, req.Description, req.Kind, req.Link, req.PublishedAt); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.Changelog.Create(l.ctx, u); err != nil {
+		l.Errorf("Failed to create site update: %v", err)
+		return nil, fmt.Errorf("failed to create site update")
+	}
+	publishSiteUpdateEvent(l.ctx, l.svcCtx, outbox.EventContentPublished, u.ID, u.Title)
+
+	data := u.Data()
+	return &data, nil
+}
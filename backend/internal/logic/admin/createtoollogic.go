@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/uses"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateToolLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add a tool to the uses page
+func NewCreateToolLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateToolLogic {
+	return &CreateToolLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateToolLogic) CreateTool(req *types.CreateToolRequest) (resp *types.ToolData, err error) {
+	tool := &uses.Tool{}
+	if err := applyToolFields(tool, req.Category, req.Name, req.Description, req.Link, req.SortOrder); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.Tools.Create(l.ctx, tool); err != nil {
+		l.Errorf("Failed to create tool %q: %v", tool.Name, err)
+		return nil, fmt.Errorf("failed to create tool")
+	}
+
+	data := tool.Data()
+	return &data, nil
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/webhook"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateWebhookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Register a webhook endpoint
+func NewCreateWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateWebhookLogic {
+	return &CreateWebhookLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateWebhookLogic) CreateWebhook(req *types.CreateWebhookRequest) (resp *types.CreateWebhookResponse, err error) {
+	url := strings.TrimSpace(req.URL)
+	if err := webhook.ValidateURL(url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := normalizeEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := strings.TrimSpace(req.Secret)
+	if secret == "" {
+		if secret, err = webhook.GenerateSecret(); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret")
+		}
+	}
+
+	sub := &webhook.Subscription{
+		URL:         url,
+		Secret:      secret,
+		Description: strings.TrimSpace(req.Description),
+		EventTypes:  eventTypes,
+		Active:      req.Active,
+	}
+	if err := l.svcCtx.Webhooks.Create(l.ctx, sub); err != nil {
+		l.Errorf("Failed to create webhook for %s: %v", url, err)
+		return nil, fmt.Errorf("failed to create webhook")
+	}
+
+	l.Infof("Created webhook %s -> %s (%s)", sub.ID, sub.URL, strings.Join(sub.EventTypes, ","))
+
+	return &types.CreateWebhookResponse{
+		Webhook: toWebhookData(sub),
+		Secret:  secret,
+	}, nil
+}
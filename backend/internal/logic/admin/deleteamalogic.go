@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteAmaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete an AMA session and its questions
+func NewDeleteAmaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteAmaLogic {
+	return &DeleteAmaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteAmaLogic) DeleteAma(req *types.AmaRequest) error {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return fmt.Errorf("invalid AMA id")
+	}
+
+	err = l.svcCtx.AMAs.Delete(l.ctx, req.ID)
+	if errors.Is(err, ama.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		l.Errorf("Failed to delete AMA %s: %v", req.ID, err)
+		return fmt.Errorf("failed to delete AMA")
+	}
+
+	// Questions go with their answers, replies first
+	questions, err := l.svcCtx.DB.Comment.Query().
+		Where(
+			comment.EntityTypeEQ(ama.EntityType),
+			comment.EntityIDEQ(id),
+			comment.ParentIDIsNil(),
+		).
+		IDs(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to find questions of AMA %s: %v", req.ID, err)
+		return fmt.Errorf("failed to delete AMA questions")
+	}
+	removed := 0
+	for _, q := range questions {
+		n, err := l.svcCtx.PurgeComment(l.ctx, q)
+		if err != nil {
+			l.Errorf("Failed to delete question %s of AMA %s: %v", q, req.ID, err)
+			return fmt.Errorf("failed to delete AMA questions")
+		}
+		removed += n
+	}
+
+	l.Infof("Deleted AMA %s with %d comments", req.ID, removed)
+	return nil
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/ban"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteBanLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Lift a ban
+func NewDeleteBanLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteBanLogic {
+	return &DeleteBanLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteBanLogic) DeleteBan(req *types.BanRequest) error {
+	err := l.svcCtx.Bans.Delete(l.ctx, req.ID)
+	if errors.Is(err, ban.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		l.Errorf("Failed to delete ban %s: %v", req.ID, err)
+		return fmt.Errorf("failed to delete ban")
+	}
+	return nil
+}
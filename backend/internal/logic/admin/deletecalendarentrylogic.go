@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/calendar"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCalendarEntryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a calendar entry
+func NewDeleteCalendarEntryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCalendarEntryLogic {
+	return &DeleteCalendarEntryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteCalendarEntryLogic) DeleteCalendarEntry(req *types.CalendarEntryRequest) error {
+	err := l.svcCtx.Calendar.Delete(l.ctx, req.ID)
+	if errors.Is(err, calendar.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		l.Errorf("Failed to delete calendar entry %s: %v", req.ID, err)
+		return fmt.Errorf("failed to delete calendar entry")
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/cannedreply"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteCannedResponseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a canned response
+func NewDeleteCannedResponseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteCannedResponseLogic {
+	return &DeleteCannedResponseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteCannedResponseLogic) DeleteCannedResponse(req *types.CannedResponseRequest) error {
+	err := l.svcCtx.CannedResponses.Delete(l.ctx, req.ID)
+	if errors.Is(err, cannedreply.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		l.Errorf("Failed to delete canned response %s: %v", req.ID, err)
+		return fmt.Errorf("failed to delete canned response")
+	}
+	return nil
+}
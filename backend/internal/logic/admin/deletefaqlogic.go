@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/trash"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteFAQLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete an FAQ entry
+func NewDeleteFAQLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteFAQLogic {
+	return &DeleteFAQLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteFAQLogic) DeleteFAQ(req *types.FAQRequest) error {
+	it, err := l.svcCtx.FAQs.Get(l.ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	return moveToTrash(l.ctx, l.svcCtx, trash.KindFAQ, it.ID, it.Question, it, func() error {
+		return l.svcCtx.FAQs.Delete(l.ctx, it.ID)
+	})
+}
@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/availability"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteOfficeHourLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete an office hour slot
+func NewDeleteOfficeHourLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteOfficeHourLogic {
+	return &DeleteOfficeHourLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteOfficeHourLogic) DeleteOfficeHour(req *types.OfficeHourRequest) error {
+	err := l.svcCtx.Availability.DeleteSlot(l.ctx, req.ID)
+	if errors.Is(err, availability.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		l.Errorf("Failed to delete office hour slot %s: %v", req.ID, err)
+		return fmt.Errorf("failed to delete office hour slot")
+	}
+	return nil
+}
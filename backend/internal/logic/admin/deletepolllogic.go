@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a poll and its votes
+func NewDeletePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePollLogic {
+	return &DeletePollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeletePollLogic) DeletePoll(req *types.PollRequest) error {
+	return l.svcCtx.Polls.Delete(l.ctx, req.ID)
+}
@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/media"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeletePostAudioLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Remove the narration audio of a blog post
+func NewDeletePostAudioLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeletePostAudioLogic {
+	return &DeletePostAudioLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeletePostAudioLogic) DeletePostAudio(req *types.PostAudioRequest) error {
+	removed, err := l.svcCtx.Audio.Remove(l.ctx, media.OwnerPost, req.ID)
+	if err != nil {
+		l.Errorf("Failed to remove audio of blog post %s: %v", req.ID, err)
+		return fmt.Errorf("failed to remove audio")
+	}
+	if !removed {
+		return fmt.Errorf("blog post has no audio")
+	}
+
+	if err := l.svcCtx.PublishEvent(l.ctx, l.svcCtx.RawDB, outbox.EventContentUpdated, outbox.ContentEvent{
+		Type: "blog",
+		ID:   req.ID,
+	}); err != nil {
+		l.Errorf("Failed to record content event for blog post %s: %v", req.ID, err)
+	}
+	return nil
+}
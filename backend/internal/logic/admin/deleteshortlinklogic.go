@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/trash"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a short link
+func NewDeleteShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteShortLinkLogic {
+	return &DeleteShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteShortLinkLogic) DeleteShortLink(req *types.ShortLinkRequest) error {
+	link, err := l.svcCtx.Links.Get(l.ctx, req.Code)
+	if err != nil {
+		return err
+	}
+	return moveToTrash(l.ctx, l.svcCtx, trash.KindShortLink, link.Code, link.TargetURL, link, func() error {
+		return l.svcCtx.Links.Delete(l.ctx, link.Code)
+	})
+}
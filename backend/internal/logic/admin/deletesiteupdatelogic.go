@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/trash"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteSiteUpdateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a site update
+func NewDeleteSiteUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteSiteUpdateLogic {
+	return &DeleteSiteUpdateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteSiteUpdateLogic) DeleteSiteUpdate(req *types.SiteUpdateRequest) error {
+	u, err := l.svcCtx.Changelog.Get(l.ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	err = moveToTrash(l.ctx, l.svcCtx, trash.KindSiteUpdate, u.ID, u.Title, u, func() error {
+		return l.svcCtx.Changelog.Delete(l.ctx, u.ID)
+	})
+	if err != nil {
+		return err
+	}
+	publishSiteUpdateEvent(l.ctx, l.svcCtx, outbox.EventContentDeleted, req.ID, "")
+	return nil
+}
@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/trash"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteToolLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a tool of the uses page
+func NewDeleteToolLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteToolLogic {
+	return &DeleteToolLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteToolLogic) DeleteTool(req *types.ToolRequest) error {
+	t, err := l.svcCtx.Tools.Get(l.ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	return moveToTrash(l.ctx, l.svcCtx, trash.KindTool, t.ID, t.Name, t, func() error {
+		return l.svcCtx.Tools.Delete(l.ctx, t.ID)
+	})
+}
@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteWebhookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete a webhook endpoint
+func NewDeleteWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteWebhookLogic {
+	return &DeleteWebhookLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteWebhookLogic) DeleteWebhook(req *types.WebhookRequest) error {
+	if err := l.svcCtx.Webhooks.Delete(l.ctx, req.ID); err != nil {
+		return err
+	}
+
+	l.Infof("Deleted webhook %s", req.ID)
+	return nil
+}
@@ -0,0 +1,60 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/revision"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DiffPostRevisionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Diff two revisions of a blog post
+func NewDiffPostRevisionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DiffPostRevisionsLogic {
+	return &DiffPostRevisionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DiffPostRevisionsLogic) DiffPostRevisions(req *types.RevisionDiffRequest) (resp *types.RevisionDiffResponse, err error) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		return nil, fmt.Errorf("invalid post ID: %w", err)
+	}
+
+	from, err := l.svcCtx.Revisions.Get(l.ctx, req.ID, req.A)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d: %w", req.A, err)
+	}
+	to, err := l.svcCtx.Revisions.Get(l.ctx, req.ID, req.B)
+	if err != nil {
+		return nil, fmt.Errorf("revision %d: %w", req.B, err)
+	}
+
+	lines := revision.Diff(from.Content, to.Content)
+	resp = &types.RevisionDiffResponse{
+		PostID: req.ID,
+		From:   toPostRevisionData(from),
+		To:     toPostRevisionData(to),
+		Lines:  make([]types.DiffLine, 0, len(lines)),
+	}
+	resp.Added, resp.Removed = revision.Stats(lines)
+	for _, line := range lines {
+		dl := types.DiffLine{Op: line.Op, OldLine: line.OldLine, NewLine: line.NewLine, Text: line.Text}
+		for _, w := range line.Words {
+			dl.Words = append(dl.Words, types.DiffSegment{Op: w.Op, Text: w.Text})
+		}
+		resp.Lines = append(resp.Lines, dl)
+	}
+	return resp, nil
+}
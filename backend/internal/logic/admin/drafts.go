@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/drafts"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+func toContentDraftData(d *drafts.Draft) types.ContentDraftData {
+	return types.ContentDraftData{
+		Type:      d.ContentType,
+		ID:        d.ContentID,
+		Field:     d.Field,
+		Language:  d.Language,
+		Text:      d.Text,
+		Model:     d.Model,
+		Edited:    d.Edited,
+		UpdatedAt: utils.FormatTime(d.UpdatedAt),
+	}
+}
+
+// loadDraftSource collects the text drafts are generated from: the Markdown
+// of a post, the description and details of a project, or the abstract and
+// description of an idea.
+func loadDraftSource(ctx context.Context, svcCtx *svc.ServiceContext, kind, id string) (drafts.Source, error) {
+	src := drafts.Source{ContentType: kind, ContentID: id}
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return src, err
+	}
+
+	var parts []string
+	switch kind {
+	case "blog":
+		post, err := svcCtx.DB.BlogPost.Get(ctx, uid)
+		if err != nil {
+			return src, err
+		}
+		src.Title = post.Title
+		parts = append(parts, post.Content)
+	case "project":
+		p, err := svcCtx.DB.Project.Query().Where(project.ID(uid)).WithDetails().Only(ctx)
+		if err != nil {
+			return src, err
+		}
+		src.Title = p.Title
+		parts = append(parts, p.Description)
+		if p.Edges.Details != nil {
+			parts = append(parts, p.Edges.Details.ProjectDetails)
+		}
+	case "idea":
+		i, err := svcCtx.DB.Idea.Get(ctx, uid)
+		if err != nil {
+			return src, err
+		}
+		src.Title = i.Title
+		parts = append(parts, i.Abstract, i.Description)
+	}
+
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			src.Body += p + "\n\n"
+		}
+	}
+	src.Body = strings.TrimSpace(src.Body)
+	return src, nil
+}
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ideaexport"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportIdeasLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Export every idea with its details as Notion-style JSON or an org-mode outline
+func NewExportIdeasLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportIdeasLogic {
+	return &ExportIdeasLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ExportIdeasLogic) ExportIdeas(req *types.ExportIdeasRequest) (resp *types.ExportIdeasResponse, err error) {
+	ideas, err := l.svcCtx.DB.Idea.Query().
+		WithDetails().
+		WithTags().
+		Order(ent.Asc(idea.FieldCreatedAt)).
+		All(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to query ideas for export: %v", err)
+		return nil, fmt.Errorf("failed to export ideas")
+	}
+
+	exported := make([]types.UpsertIdeaRequest, 0, len(ideas))
+	for _, i := range ideas {
+		exported = append(exported, exportIdea(i))
+	}
+	content, err := ideaexport.Encode(req.Format, exported)
+	if err != nil {
+		return nil, err
+	}
+	return &types.ExportIdeasResponse{
+		Format:  req.Format,
+		Count:   len(exported),
+		Content: content,
+	}, nil
+}
+
+// exportIdea converts an idea with its details and tags to the shape the
+// export formats share.
+func exportIdea(i *ent.Idea) types.UpsertIdeaRequest {
+	in := types.UpsertIdeaRequest{
+		Slug:        i.Slug,
+		Title:       i.Title,
+		Description: i.Description,
+		Abstract:    i.Abstract,
+		Status:      string(i.Status),
+		IsPublic:    i.IsPublic,
+		Category:    i.Category,
+	}
+	for _, tag := range i.Edges.Tags {
+		in.Tags = append(in.Tags, tag.Name)
+	}
+	if d := i.Edges.Details; d != nil {
+		in.Details = types.IdeaDetailInput{
+			Progress:                d.Progress,
+			Results:                 d.Results,
+			References:              d.References,
+			EstimatedDurationMonths: d.EstimatedDurationMonths,
+			RequiredResources:       d.RequiredResources,
+			CollaborationNeeded:     d.CollaborationNeeded,
+			FundingRequired:         d.FundingRequired,
+			EstimatedBudget:         d.EstimatedBudget,
+		}
+	}
+	return in
+}
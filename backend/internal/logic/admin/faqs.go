@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"silan-backend/internal/faq"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// applyFAQFields validates the editable FAQ fields and copies them onto it.
+func applyFAQFields(it *faq.Item, category, question, answer string, sortOrder int, translations []types.FAQTranslationData) error {
+	it.Category = strings.TrimSpace(category)
+	it.Question = strings.TrimSpace(question)
+	it.Answer = strings.TrimSpace(answer)
+	it.SortOrder = sortOrder
+	if it.Category == "" || it.Question == "" || it.Answer == "" {
+		return fmt.Errorf("category, question and answer are required")
+	}
+
+	it.Translations = map[string]faq.Translation{}
+	for _, tr := range translations {
+		lang := strings.ToLower(strings.TrimSpace(tr.Language))
+		if lang == "" || lang == "en" {
+			return fmt.Errorf("translation language must be set and not 'en'")
+		}
+		if _, dup := it.Translations[lang]; dup {
+			return fmt.Errorf("duplicate translation for %q", lang)
+		}
+		q, a := strings.TrimSpace(tr.Question), strings.TrimSpace(tr.Answer)
+		if q == "" || a == "" {
+			return fmt.Errorf("translation %q needs a question and an answer", lang)
+		}
+		it.Translations[lang] = faq.Translation{Question: q, Answer: a}
+	}
+	return nil
+}
+
+func toFAQAdminData(it *faq.Item, fb faq.Feedback) types.FAQAdminData {
+	translations := make([]types.FAQTranslationData, 0, len(it.Translations))
+	for lang, tr := range it.Translations {
+		translations = append(translations, types.FAQTranslationData{
+			Language: lang,
+			Question: tr.Question,
+			Answer:   tr.Answer,
+		})
+	}
+	sort.Slice(translations, func(i, j int) bool {
+		return translations[i].Language < translations[j].Language
+	})
+
+	return types.FAQAdminData{
+		ID:              it.ID,
+		Category:        it.Category,
+		Question:        it.Question,
+		Answer:          it.Answer,
+		SortOrder:       it.SortOrder,
+		Translations:    translations,
+		HelpfulCount:    fb.Helpful,
+		NotHelpfulCount: fb.NotHelpful,
+		CreatedAt:       utils.FormatTime(it.CreatedAt),
+		UpdatedAt:       utils.FormatTime(it.UpdatedAt),
+	}
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/drafts"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/llm"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GenerateDraftsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Generate summary and excerpt drafts for a post, project or idea
+func NewGenerateDraftsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GenerateDraftsLogic {
+	return &GenerateDraftsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GenerateDraftsLogic) GenerateDrafts(req *types.GenerateDraftsRequest) (resp *types.ContentDraftListResponse, err error) {
+	if !l.svcCtx.LLM.Enabled() {
+		return nil, llm.ErrDisabled
+	}
+
+	src, err := loadDraftSource(l.ctx, l.svcCtx, req.Type, req.ID)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("%s not found", req.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if src.Body == "" {
+		return nil, errors.New("nothing to summarize: the content is empty")
+	}
+
+	list, err := drafts.Generate(l.ctx, l.svcCtx.LLM, src, req.Translate)
+	if err != nil {
+		l.Errorf("Failed to generate drafts for %s %s: %v", req.Type, req.ID, err)
+		return nil, fmt.Errorf("failed to generate drafts")
+	}
+	if err := l.svcCtx.Drafts.Save(l.ctx, list); err != nil {
+		l.Errorf("Failed to save drafts for %s %s: %v", req.Type, req.ID, err)
+		return nil, fmt.Errorf("failed to save drafts")
+	}
+
+	resp = &types.ContentDraftListResponse{Drafts: make([]types.ContentDraftData, 0, len(list))}
+	for _, d := range list {
+		resp.Drafts = append(resp.Drafts, toContentDraftData(d))
+	}
+	return resp, nil
+}
@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetApiKeyUsageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get daily usage of an API key
+func NewGetApiKeyUsageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetApiKeyUsageLogic {
+	return &GetApiKeyUsageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetApiKeyUsageLogic) GetApiKeyUsage(req *types.ApiKeyUsageRequest) (resp *types.ApiKeyUsageResponse, err error) {
+	key, err := l.svcCtx.ApiKeys.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := l.svcCtx.ApiKeys.Report(l.ctx, key, req.Days)
+	if err != nil {
+		return nil, err
+	}
+
+	return toApiKeyUsage(report), nil
+}
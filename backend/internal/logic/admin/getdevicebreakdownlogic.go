@@ -0,0 +1,176 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetDeviceBreakdownLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get visits segmented by device class, OS and browser
+func NewGetDeviceBreakdownLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetDeviceBreakdownLogic {
+	return &GetDeviceBreakdownLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// segmentCounts accumulates visitors/requests per segment name.
+type segmentCounts map[string]*types.SegmentCount
+
+func (m segmentCounts) add(name string, visitors, requests int) {
+	c, ok := m[name]
+	if !ok {
+		c = &types.SegmentCount{Name: name}
+		m[name] = c
+	}
+	c.Visitors += visitors
+	c.Requests += requests
+}
+
+// sorted returns the segments with the most visitors first.
+func (m segmentCounts) sorted() []types.SegmentCount {
+	out := make([]types.SegmentCount, 0, len(m))
+	for _, c := range m {
+		out = append(out, *c)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Visitors != out[j].Visitors {
+			return out[i].Visitors > out[j].Visitors
+		}
+		return out[i].Name < out[j].Name
+	})
+	return out
+}
+
+type deviceSegments struct {
+	devices, os, browsers segmentCounts
+}
+
+func newDeviceSegments() *deviceSegments {
+	return &deviceSegments{devices: segmentCounts{}, os: segmentCounts{}, browsers: segmentCounts{}}
+}
+
+func (s *deviceSegments) add(info utils.UserAgentInfo, visitors, requests int) {
+	s.devices.add(info.Device, visitors, requests)
+	s.os.add(info.OS, visitors, requests)
+	s.browsers.add(info.Browser, visitors, requests)
+}
+
+func (l *GetDeviceBreakdownLogic) GetDeviceBreakdown(req *types.DeviceBreakdownRequest) (resp *types.DeviceBreakdownResponse, err error) {
+	v, err := l.svcCtx.Aggregates.Get(l.ctx, fmt.Sprintf("device_breakdown:%+v", *req), func(ctx context.Context) (any, error) {
+		return NewGetDeviceBreakdownLogic(ctx, l.svcCtx).build(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*types.DeviceBreakdownResponse), nil
+}
+
+// build computes the device breakdown, served through the aggregates cache.
+func (l *GetDeviceBreakdownLogic) build(req *types.DeviceBreakdownRequest) (resp *types.DeviceBreakdownResponse, err error) {
+	days := req.Days
+	if days <= 0 || days > 365 {
+		days = 30
+	}
+	loc, err := utils.LoadTimezone(req.TZ)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	// A visitor is a distinct IP per user agent and day; admin traffic is
+	// excluded. Days are bucketed in Go so they follow the requested tz.
+	rows, err := l.svcCtx.RawDB.QueryContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT created_at, user_agent, ip FROM request_logs
+		WHERE created_at >= ? AND method = 'GET' AND path NOT LIKE '/api/v1/admin%'`),
+		since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	type dayAgent struct{ day, ua string }
+	requests := map[dayAgent]int{}
+	visitors := map[dayAgent]map[string]bool{}
+	for rows.Next() {
+		var (
+			createdAt     time.Time
+			userAgent, ip *string
+		)
+		if err := rows.Scan(&createdAt, &userAgent, &ip); err != nil {
+			return nil, err
+		}
+
+		key := dayAgent{day: createdAt.In(loc).Format("2006-01-02")}
+		if userAgent != nil {
+			key.ua = *userAgent
+		}
+		requests[key]++
+		if visitors[key] == nil {
+			visitors[key] = map[string]bool{}
+		}
+		if ip != nil {
+			visitors[key][*ip] = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	parsed := map[string]utils.UserAgentInfo{}
+	total := newDeviceSegments()
+	daily := map[string]*deviceSegments{}
+	for key, n := range requests {
+		info, ok := parsed[key.ua]
+		if !ok {
+			info = utils.ParseUserAgent(key.ua)
+			parsed[key.ua] = info
+		}
+
+		total.add(info, len(visitors[key]), n)
+		if daily[key.day] == nil {
+			daily[key.day] = newDeviceSegments()
+		}
+		daily[key.day].add(info, len(visitors[key]), n)
+	}
+
+	dayKeys := make([]string, 0, len(daily))
+	for day := range daily {
+		dayKeys = append(dayKeys, day)
+	}
+	sort.Strings(dayKeys)
+
+	series := make([]types.DeviceBreakdownDay, 0, len(dayKeys))
+	for _, day := range dayKeys {
+		s := daily[day]
+		series = append(series, types.DeviceBreakdownDay{
+			Day:      day,
+			Devices:  s.devices.sorted(),
+			OS:       s.os.sorted(),
+			Browsers: s.browsers.sorted(),
+		})
+	}
+
+	return &types.DeviceBreakdownResponse{
+		Since:    utils.FormatTimeIn(since, loc),
+		Devices:  total.devices.sorted(),
+		OS:       total.os.sorted(),
+		Browsers: total.browsers.sorted(),
+		Daily:    series,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/experiments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetExperimentResultsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get exposure and conversion counts per variant
+func NewGetExperimentResultsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetExperimentResultsLogic {
+	return &GetExperimentResultsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetExperimentResultsLogic) GetExperimentResults(req *types.ExperimentResultsRequest) (resp *types.ExperimentResultsResponse, err error) {
+	exp, ok := experiments.Find(l.svcCtx.Config.Experiments, req.Name)
+	if !ok {
+		return nil, fmt.Errorf("experiment not found")
+	}
+
+	days := req.Days
+	if days <= 0 {
+		days = 30
+	}
+	loc, err := utils.LoadTimezone(req.TZ)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	rows, err := l.svcCtx.RawDB.QueryContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT name, fingerprint, properties FROM analytics_events
+		WHERE name IN ('experiment_exposure', 'experiment_conversion') AND created_at >= ?`),
+		since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	// Count distinct fingerprints so repeated page loads do not inflate results
+	exposed := map[string]map[string]bool{}
+	converted := map[string]map[string]bool{}
+	for rows.Next() {
+		var (
+			name        string
+			fingerprint sql.NullString
+			properties  sql.NullString
+		)
+		if err := rows.Scan(&name, &fingerprint, &properties); err != nil {
+			return nil, err
+		}
+		if !fingerprint.Valid || fingerprint.String == "" || !properties.Valid {
+			continue
+		}
+
+		var props map[string]string
+		if err := json.Unmarshal([]byte(properties.String), &props); err != nil {
+			continue
+		}
+		if props["experiment"] != exp.Name {
+			continue
+		}
+		if name == "experiment_conversion" && req.Goal != "" && props["goal"] != req.Goal {
+			continue
+		}
+
+		target := exposed
+		if name == "experiment_conversion" {
+			target = converted
+		}
+		variant := props["variant"]
+		if target[variant] == nil {
+			target[variant] = map[string]bool{}
+		}
+		target[variant][fingerprint.String] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	results := make([]types.ExperimentVariantResult, 0, len(exp.Variants))
+	for _, v := range exp.Variants {
+		result := types.ExperimentVariantResult{
+			Variant:     v.Name,
+			Exposures:   len(exposed[v.Name]),
+			Conversions: len(converted[v.Name]),
+		}
+		if result.Exposures > 0 {
+			result.ConversionRate = float64(result.Conversions) / float64(result.Exposures)
+		}
+		results = append(results, result)
+	}
+
+	return &types.ExperimentResultsResponse{
+		Experiment: exp.Name,
+		Since:      utils.FormatTimeIn(since, loc),
+		Variants:   results,
+	}, nil
+}
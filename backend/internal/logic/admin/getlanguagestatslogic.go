@@ -0,0 +1,132 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// contentSections maps the reported section names to their API prefixes.
+var contentSections = []struct {
+	name   string
+	prefix string
+}{
+	{"blog", "/api/v1/blog"},
+	{"projects", "/api/v1/projects"},
+	{"ideas", "/api/v1/ideas"},
+	{"resume", "/api/v1/resume"},
+	{"plans", "/api/v1/plans"},
+}
+
+type GetLanguageStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Compare response languages per site section
+func NewGetLanguageStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLanguageStatsLogic {
+	return &GetLanguageStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetLanguageStatsLogic) GetLanguageStats(req *types.LanguageStatsRequest) (resp *types.LanguageStatsResponse, err error) {
+	v, err := l.svcCtx.Aggregates.Get(l.ctx, fmt.Sprintf("language_stats:%+v", *req), func(ctx context.Context) (any, error) {
+		return NewGetLanguageStatsLogic(ctx, l.svcCtx).build(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*types.LanguageStatsResponse), nil
+}
+
+// build computes the language statistics, served through the aggregates cache.
+func (l *GetLanguageStatsLogic) build(req *types.LanguageStatsRequest) (resp *types.LanguageStatsResponse, err error) {
+	days := req.Days
+	if days <= 0 || days > 365 {
+		days = 30
+	}
+	loc, err := utils.LoadTimezone(req.TZ)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	resp = &types.LanguageStatsResponse{
+		Since:    utils.FormatTimeIn(since, loc),
+		Sections: make([]types.SectionLanguageStats, 0, len(contentSections)),
+	}
+	for _, section := range contentSections {
+		stats, err := l.sectionStats(section.name, section.prefix, since)
+		if err != nil {
+			return nil, err
+		}
+		resp.Sections = append(resp.Sections, stats)
+	}
+	return resp, nil
+}
+
+func (l *GetLanguageStatsLogic) sectionStats(name, prefix string, since time.Time) (types.SectionLanguageStats, error) {
+	stats := types.SectionLanguageStats{Section: name, Languages: []types.LanguageCount{}}
+
+	rows, err := l.svcCtx.RawDB.QueryContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT lang, COUNT(*), COUNT(DISTINCT ip) FROM request_logs
+		WHERE created_at >= ? AND method = 'GET' AND path LIKE ?
+		GROUP BY lang`),
+		since, prefix+"/%",
+	)
+	if err != nil {
+		return stats, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			lang sql.NullString
+			c    types.LanguageCount
+		)
+		if err := rows.Scan(&lang, &c.Requests, &c.Visitors); err != nil {
+			return stats, err
+		}
+		// Rows logged before languages were recorded count as the default
+		c.Language = lang.String
+		if c.Language == "" {
+			c.Language = "en"
+		}
+		stats.Requests += c.Requests
+		stats.Languages = mergeLanguage(stats.Languages, c)
+	}
+	if err := rows.Err(); err != nil {
+		return stats, err
+	}
+
+	for i := range stats.Languages {
+		stats.Languages[i].Share = float64(stats.Languages[i].Requests) / float64(stats.Requests)
+	}
+	sort.Slice(stats.Languages, func(i, j int) bool {
+		return stats.Languages[i].Requests > stats.Languages[j].Requests
+	})
+	return stats, nil
+}
+
+func mergeLanguage(list []types.LanguageCount, c types.LanguageCount) []types.LanguageCount {
+	for i := range list {
+		if list[i].Language == c.Language {
+			list[i].Requests += c.Requests
+			list[i].Visitors += c.Visitors
+			return list
+		}
+	}
+	return append(list, c)
+}
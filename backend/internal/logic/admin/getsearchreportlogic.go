@@ -0,0 +1,102 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSearchReportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get top and zero-result site-search terms
+func NewGetSearchReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSearchReportLogic {
+	return &GetSearchReportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSearchReportLogic) GetSearchReport(req *types.SearchReportRequest) (resp *types.SearchReportResponse, err error) {
+	days := req.Days
+	if days <= 0 {
+		days = 30
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+	loc, err := utils.LoadTimezone(req.TZ)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	where := ` WHERE created_at >= ?`
+	args := []any{since}
+	if req.Scope != "" {
+		where += ` AND scope = ?`
+		args = append(args, req.Scope)
+	}
+
+	resp = &types.SearchReportResponse{Since: utils.FormatTimeIn(since, loc)}
+
+	var total, zero sql.NullInt64
+	err = l.svcCtx.RawDB.QueryRowContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT COUNT(*), SUM(CASE WHEN result_count = 0 THEN 1 ELSE 0 END) FROM search_queries`+where),
+		args...,
+	).Scan(&total, &zero)
+	if err != nil {
+		return nil, err
+	}
+	resp.TotalSearches = int(total.Int64)
+	resp.ZeroResultSearches = int(zero.Int64)
+
+	if resp.TopSearches, err = l.termStats(where, args, limit); err != nil {
+		return nil, err
+	}
+	if resp.ZeroResultTerms, err = l.termStats(where+` AND result_count = 0`, args, limit); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// termStats groups the matching queries by term, most searched first.
+func (l *GetSearchReportLogic) termStats(where string, args []any, limit int) ([]types.SearchTermStat, error) {
+	rows, err := l.svcCtx.RawDB.QueryContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT term, COUNT(*) AS searches, COUNT(DISTINCT fingerprint), AVG(result_count)
+		FROM search_queries`+where+`
+		GROUP BY term ORDER BY searches DESC, term LIMIT `+strconv.Itoa(limit)),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := []types.SearchTermStat{}
+	for rows.Next() {
+		var (
+			s   types.SearchTermStat
+			avg sql.NullFloat64
+		)
+		if err := rows.Scan(&s.Term, &s.Searches, &s.Searchers, &avg); err != nil {
+			return nil, err
+		}
+		s.AvgResults = avg.Float64
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
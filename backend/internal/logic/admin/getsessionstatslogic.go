@@ -0,0 +1,172 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	// pageViewEvent is the custom event the frontend sends on every navigation.
+	pageViewEvent = "page_view"
+	// sessionTimeout is the inactivity window that ends a session.
+	sessionTimeout = 30 * time.Minute
+)
+
+type GetSessionStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get session metrics: bounce rate, pages per session, entry and exit pages
+func NewGetSessionStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSessionStatsLogic {
+	return &GetSessionStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+type visitSession struct {
+	entry, exit string
+	pages       int
+	start, end  time.Time
+}
+
+func (l *GetSessionStatsLogic) GetSessionStats(req *types.SessionStatsRequest) (resp *types.SessionStatsResponse, err error) {
+	v, err := l.svcCtx.Aggregates.Get(l.ctx, fmt.Sprintf("session_stats:%+v", *req), func(ctx context.Context) (any, error) {
+		return NewGetSessionStatsLogic(ctx, l.svcCtx).build(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*types.SessionStatsResponse), nil
+}
+
+// build computes the session statistics, served through the aggregates cache.
+func (l *GetSessionStatsLogic) build(req *types.SessionStatsRequest) (resp *types.SessionStatsResponse, err error) {
+	days := req.Days
+	if days <= 0 || days > 365 {
+		days = 30
+	}
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 10
+	}
+	loc, err := utils.LoadTimezone(req.TZ)
+	if err != nil {
+		return nil, err
+	}
+	since := time.Now().UTC().AddDate(0, 0, -days)
+
+	// Ordered by visitor and time so sessions can be stitched in one pass
+	rows, err := l.svcCtx.RawDB.QueryContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT fingerprint, path, created_at FROM analytics_events
+		WHERE name = ? AND created_at >= ? AND fingerprint IS NOT NULL AND fingerprint <> ''
+		ORDER BY fingerprint, created_at`),
+		pageViewEvent, since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		sessions    []visitSession
+		current     *visitSession
+		lastVisitor string
+		visitors    int
+		pageViews   int
+	)
+	for rows.Next() {
+		var (
+			fingerprint string
+			path        *string
+			at          time.Time
+		)
+		if err := rows.Scan(&fingerprint, &path, &at); err != nil {
+			return nil, err
+		}
+		page := "/"
+		if path != nil && *path != "" {
+			page = *path
+		}
+		pageViews++
+
+		if fingerprint != lastVisitor {
+			visitors++
+			lastVisitor = fingerprint
+			current = nil
+		}
+		if current == nil || at.Sub(current.end) > sessionTimeout {
+			sessions = append(sessions, visitSession{entry: page, start: at})
+			current = &sessions[len(sessions)-1]
+		}
+		current.pages++
+		current.exit = page
+		current.end = at
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	resp = &types.SessionStatsResponse{
+		Since:      utils.FormatTimeIn(since, loc),
+		Sessions:   len(sessions),
+		Visitors:   visitors,
+		PageViews:  pageViews,
+		EntryPages: []types.PageCount{},
+		ExitPages:  []types.PageCount{},
+	}
+	if len(sessions) == 0 {
+		return resp, nil
+	}
+
+	var (
+		bounces  int
+		duration time.Duration
+		entries  = map[string]int{}
+		exits    = map[string]int{}
+	)
+	for _, s := range sessions {
+		if s.pages == 1 {
+			bounces++
+		}
+		duration += s.end.Sub(s.start)
+		entries[s.entry]++
+		exits[s.exit]++
+	}
+
+	n := float64(len(sessions))
+	resp.BounceRate = float64(bounces) / n
+	resp.PagesPerSession = float64(pageViews) / n
+	resp.AvgDurationSeconds = duration.Seconds() / n
+	resp.EntryPages = topPages(entries, limit)
+	resp.ExitPages = topPages(exits, limit)
+	return resp, nil
+}
+
+func topPages(counts map[string]int, limit int) []types.PageCount {
+	pages := make([]types.PageCount, 0, len(counts))
+	for path, count := range counts {
+		pages = append(pages, types.PageCount{Path: path, Count: count})
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		if pages[i].Count != pages[j].Count {
+			return pages[i].Count > pages[j].Count
+		}
+		return pages[i].Path < pages[j].Path
+	})
+	if len(pages) > limit {
+		pages = pages[:limit]
+	}
+	return pages
+}
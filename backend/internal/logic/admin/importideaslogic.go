@@ -0,0 +1,115 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/ideatranslation"
+	"silan-backend/internal/ideaexport"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/validation"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ImportIdeasLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create or update ideas from Notion-style JSON or an org-mode outline
+func NewImportIdeasLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ImportIdeasLogic {
+	return &ImportIdeasLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ImportIdeasLogic) ImportIdeas(req *types.ImportIdeasRequest) (resp *types.ImportIdeasResponse, err error) {
+	ideas, err := ideaexport.Decode(req.Format, req.Content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s content: %w", req.Format, err)
+	}
+	// Check the decoded ideas like upsert request bodies before writing any
+	if err := validation.Struct(struct {
+		Ideas []types.UpsertIdeaRequest `json:"ideas"`
+	}{ideas}); err != nil {
+		return nil, err
+	}
+
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	resp = &types.ImportIdeasResponse{DryRun: req.DryRun, Ideas: make([]types.ContentUpsertResponse, 0, len(ideas))}
+	for n := range ideas {
+		in := &ideas[n]
+		in.DryRun = req.DryRun
+		if err := keepIdeaTranslations(l.ctx, tx, in); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		var changes contentChanges
+		var published bool
+		i, previous, err := upsertIdea(l.ctx, tx, in, publishCheck(l.svcCtx, &published), &changes)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to import idea %q: %w", in.Slug, err)
+		}
+		if err := publishUpsertEvent(l.ctx, l.svcCtx, tx, published, ideaEvent(i, previous)); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to record content event: %w", err)
+		}
+		resp.Ideas = append(resp.Ideas, types.ContentUpsertResponse{
+			Type:    "idea",
+			ID:      i.ID.String(),
+			Slug:    i.Slug,
+			Created: previous == "",
+			DryRun:  req.DryRun,
+			Changes: changes,
+		})
+	}
+
+	// A dry run reports the planned changes and discards them
+	if req.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back dry run: %w", err)
+		}
+		return resp, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return resp, nil
+}
+
+// keepIdeaTranslations copies the stored translations of an existing idea
+// into in. The export formats don't carry translations, and the upsert
+// would otherwise delete them.
+func keepIdeaTranslations(ctx context.Context, tx *ent.Tx, in *types.UpsertIdeaRequest) error {
+	translations, err := tx.IdeaTranslation.Query().
+		Where(ideatranslation.HasIdeaWith(idea.SlugEQ(in.Slug))).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, t := range translations {
+		in.Translations = append(in.Translations, types.IdeaTranslationInput{
+			LanguageCode:      t.LanguageCode,
+			Title:             t.Title,
+			Abstract:          t.Abstract,
+			Motivation:        t.Motivation,
+			Methodology:       t.Methodology,
+			ExpectedOutcome:   t.ExpectedOutcome,
+			RequiredResources: t.RequiredResources,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListActivityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the activity log, including entries hidden from the public feed
+func NewListActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListActivityLogic {
+	return &ListActivityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListActivityLogic) ListActivity(req *types.ActivityRequest) (resp *types.ActivityResponse, err error) {
+	return l.svcCtx.ListActivity(l.ctx, req, false)
+}
@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAmasLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List AMA sessions
+func NewListAmasLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAmasLogic {
+	return &ListAmasLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListAmasLogic) ListAmas() (resp *types.AmaListResponse, err error) {
+	amas, err := l.svcCtx.AMAs.List(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to list AMAs: %v", err)
+		return nil, fmt.Errorf("failed to list AMAs")
+	}
+	counts, err := l.svcCtx.AMAQuestionCounts(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to count AMA questions: %v", err)
+		return nil, fmt.Errorf("failed to list AMAs")
+	}
+
+	now := time.Now()
+	resp = &types.AmaListResponse{Amas: make([]types.AmaData, 0, len(amas))}
+	for _, a := range amas {
+		resp.Amas = append(resp.Amas, a.Data(now, counts[a.ID]))
+	}
+	return resp, nil
+}
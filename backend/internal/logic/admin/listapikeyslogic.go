@@ -0,0 +1,39 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListApiKeysLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List API keys
+func NewListApiKeysLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListApiKeysLogic {
+	return &ListApiKeysLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListApiKeysLogic) ListApiKeys() (resp *types.ApiKeyListResponse, err error) {
+	keys, err := l.svcCtx.ApiKeys.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.ApiKeyData, 0, len(keys))
+	for _, k := range keys {
+		list = append(list, toApiKeyData(k))
+	}
+
+	return &types.ApiKeyListResponse{Keys: list}, nil
+}
@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAuditLogLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List recent audit log entries
+func NewListAuditLogLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAuditLogLogic {
+	return &ListAuditLogLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListAuditLogLogic) ListAuditLog(req *types.AuditLogRequest) (resp *types.AuditLogResponse, err error) {
+	limit := req.Limit
+	if limit <= 0 || limit > 500 {
+		limit = 50
+	}
+
+	where := ``
+	args := []any{}
+	if req.Action != "" {
+		where = ` WHERE action = ?`
+		args = append(args, req.Action)
+	}
+
+	rows, err := l.svcCtx.RawDB.QueryContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT id, action, subject, ip, detail, created_at FROM audit_log`+where+`
+		ORDER BY created_at DESC, id DESC LIMIT `+strconv.Itoa(limit)),
+		args...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	resp = &types.AuditLogResponse{Entries: []types.AuditEntry{}}
+	for rows.Next() {
+		var (
+			e                   types.AuditEntry
+			subject, ip, detail sql.NullString
+			createdAt           time.Time
+		)
+		if err := rows.Scan(&e.ID, &e.Action, &subject, &ip, &detail, &createdAt); err != nil {
+			return nil, err
+		}
+		e.Subject = subject.String
+		e.IP = ip.String
+		e.Detail = detail.String
+		e.CreatedAt = utils.FormatTime(createdAt)
+		resp.Entries = append(resp.Entries, e)
+	}
+	return resp, rows.Err()
+}
@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/authlog"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListAuthEventsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List sign-in, refresh and logout events, optionally only those of the site owner
+func NewListAuthEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListAuthEventsLogic {
+	return &ListAuthEventsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListAuthEventsLogic) ListAuthEvents(req *types.AuthEventsRequest) (resp *types.AuthEventListResponse, err error) {
+	f := authlog.Filter{
+		Type:    req.Type,
+		Outcome: req.Outcome,
+		IP:      req.IP,
+		Limit:   req.Limit,
+	}
+	if f.Limit <= 0 || f.Limit > 500 {
+		f.Limit = 50
+	}
+	if req.Since != "" {
+		f.Since, err = time.Parse(time.RFC3339, req.Since)
+		if err != nil {
+			return nil, fmt.Errorf("since must be an RFC 3339 timestamp")
+		}
+	}
+	if req.IdentityID != "" {
+		f.IdentityIDs = append(f.IdentityIDs, req.IdentityID)
+	}
+	if req.Email != "" {
+		f.Emails = append(f.Emails, req.Email)
+	}
+	// Failed attempts against the owner carry their email, not yet an identity
+	if req.Owner {
+		owner := l.svcCtx.Config.Owner
+		if len(owner.IdentityIDs) == 0 && len(owner.Emails) == 0 {
+			return nil, fmt.Errorf("no owner identity or email is configured")
+		}
+		f.IdentityIDs = append(f.IdentityIDs, owner.IdentityIDs...)
+		f.Emails = append(f.Emails, owner.Emails...)
+	}
+
+	events, err := l.svcCtx.AuthEvents.List(l.ctx, f)
+	if err != nil {
+		l.Errorf("Failed to list auth events: %v", err)
+		return nil, fmt.Errorf("failed to list auth events")
+	}
+
+	resp = &types.AuthEventListResponse{Events: make([]types.AuthEventData, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, e.Data())
+	}
+	return resp, nil
+}
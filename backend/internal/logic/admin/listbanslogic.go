@@ -0,0 +1,40 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListBansLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List banned visitors
+func NewListBansLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBansLogic {
+	return &ListBansLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListBansLogic) ListBans() (resp *types.BanListResponse, err error) {
+	bans, err := l.svcCtx.Bans.List(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to list bans: %v", err)
+		return nil, fmt.Errorf("failed to list bans")
+	}
+
+	list := make([]types.BanData, 0, len(bans))
+	for _, b := range bans {
+		list = append(list, b.Data())
+	}
+	return &types.BanListResponse{Bans: list}, nil
+}
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListBrokenLinksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List outbound links in published posts whose latest check failed
+func NewListBrokenLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBrokenLinksLogic {
+	return &ListBrokenLinksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListBrokenLinksLogic) ListBrokenLinks() (resp *types.BrokenLinkListResponse, err error) {
+	links, err := l.svcCtx.LinkChecks.Broken(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to list broken links: %v", err)
+		return nil, fmt.Errorf("failed to list broken links")
+	}
+
+	var ids []uuid.UUID
+	for _, link := range links {
+		for _, id := range link.PostIDs {
+			if postID, err := uuid.Parse(id); err == nil {
+				ids = append(ids, postID)
+			}
+		}
+	}
+	posts, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.IDIn(ids...)).
+		Select(blogpost.FieldID, blogpost.FieldTitle, blogpost.FieldSlug).
+		All(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to load posts with broken links: %v", err)
+		return nil, fmt.Errorf("failed to list broken links")
+	}
+	byID := make(map[string]*ent.BlogPost, len(posts))
+	for _, p := range posts {
+		byID[p.ID.String()] = p
+	}
+
+	resp = &types.BrokenLinkListResponse{Links: make([]types.BrokenLinkData, 0, len(links))}
+	for _, link := range links {
+		data := types.BrokenLinkData{
+			URL:        link.URL,
+			StatusCode: link.StatusCode,
+			Error:      link.Error,
+			Failures:   link.Failures,
+			CheckedAt:  utils.FormatTime(link.CheckedAt),
+			Posts:      make([]types.BrokenLinkPost, 0, len(link.PostIDs)),
+		}
+		if link.LastOKAt != nil {
+			data.LastOKAt = utils.FormatTime(*link.LastOKAt)
+		}
+		if link.FailingSince != nil {
+			data.FailingSince = utils.FormatTime(*link.FailingSince)
+		}
+		for _, id := range link.PostIDs {
+			// Posts deleted since the last sync are left out
+			if p, ok := byID[id]; ok {
+				data.Posts = append(data.Posts, types.BrokenLinkPost{ID: id, Title: p.Title, Slug: p.Slug})
+			}
+		}
+		resp.Links = append(resp.Links, data)
+	}
+	return resp, nil
+}
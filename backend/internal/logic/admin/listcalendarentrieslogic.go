@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCalendarEntriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List calendar entries
+func NewListCalendarEntriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCalendarEntriesLogic {
+	return &ListCalendarEntriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListCalendarEntriesLogic) ListCalendarEntries() (resp *types.CalendarEntryListResponse, err error) {
+	entries, err := l.svcCtx.Calendar.Since(l.ctx, time.Time{})
+	if err != nil {
+		l.Errorf("Failed to list calendar entries: %v", err)
+		return nil, fmt.Errorf("failed to list calendar entries")
+	}
+
+	resp = &types.CalendarEntryListResponse{Entries: make([]types.CalendarEntryData, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, e.Data())
+	}
+	return resp, nil
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListCannedResponsesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List canned responses for owner replies
+func NewListCannedResponsesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCannedResponsesLogic {
+	return &ListCannedResponsesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListCannedResponsesLogic) ListCannedResponses() (resp *types.CannedResponseListResponse, err error) {
+	list, err := l.svcCtx.CannedResponses.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	responses := make([]types.CannedResponseData, 0, len(list))
+	for _, r := range list {
+		responses = append(responses, r.Data())
+	}
+	return &types.CannedResponseListResponse{Responses: responses}, nil
+}
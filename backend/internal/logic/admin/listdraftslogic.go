@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListDraftsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the generated drafts of a post, project or idea
+func NewListDraftsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListDraftsLogic {
+	return &ListDraftsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListDraftsLogic) ListDrafts(req *types.ContentDraftsRequest) (resp *types.ContentDraftListResponse, err error) {
+	list, err := l.svcCtx.Drafts.List(l.ctx, req.Type, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.ContentDraftListResponse{Drafts: make([]types.ContentDraftData, 0, len(list))}
+	for _, d := range list {
+		resp.Drafts = append(resp.Drafts, toContentDraftData(d))
+	}
+	return resp, nil
+}
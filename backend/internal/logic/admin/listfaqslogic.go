@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFAQsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List FAQ entries with translations and feedback counts
+func NewListFAQsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFAQsLogic {
+	return &ListFAQsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFAQsLogic) ListFAQs() (resp *types.FAQAdminListResponse, err error) {
+	items, err := l.svcCtx.FAQs.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	feedback, err := l.svcCtx.FAQs.FeedbackCounts(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.FAQAdminData, 0, len(items))
+	for _, it := range items {
+		list = append(list, toFAQAdminData(it, feedback[it.ID]))
+	}
+	return &types.FAQAdminListResponse{Items: list}, nil
+}
@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListInquiriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List project inquiries
+func NewListInquiriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListInquiriesLogic {
+	return &ListInquiriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListInquiriesLogic) ListInquiries(req *types.InquiryListRequest) (resp *types.InquiryListResponse, err error) {
+	inquiries, err := l.svcCtx.Inquiries.List(l.ctx, req.ProjectID)
+	if err != nil {
+		l.Errorf("Failed to list inquiries: %v", err)
+		return nil, fmt.Errorf("failed to list inquiries")
+	}
+
+	resp = &types.InquiryListResponse{Inquiries: make([]types.InquiryData, 0, len(inquiries))}
+	for _, in := range inquiries {
+		resp.Inquiries = append(resp.Inquiries, types.InquiryData{
+			ID:           in.ID,
+			ProjectID:    in.ProjectID,
+			ProjectTitle: in.ProjectTitle,
+			Name:         in.Name,
+			Email:        in.Email,
+			Company:      in.Company,
+			Budget:       in.Budget,
+			Timeline:     in.Timeline,
+			Message:      in.Message,
+			IP:           in.IP,
+			CreatedAt:    utils.FormatTime(in.CreatedAt),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPendingCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List comments held for approval
+func NewListPendingCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPendingCommentsLogic {
+	return &ListPendingCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPendingCommentsLogic) ListPendingComments() (resp *types.PendingCommentListResponse, err error) {
+	if err := l.svcCtx.Legacy.CopyHeld(l.ctx); err != nil {
+		l.Errorf("Failed to copy held legacy comments: %v", err)
+	}
+
+	list, err := l.svcCtx.DB.Comment.Query().
+		Where(comment.IsApproved(false)).
+		Order(ent.Asc(comment.FieldCreatedAt)).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(list))
+	for _, c := range list {
+		ids = append(ids, c.ID.String())
+	}
+	verdicts, err := l.svcCtx.SpamScores.Get(l.ctx, ids)
+	if err != nil {
+		l.Errorf("Failed to load spam scores: %v", err)
+	}
+
+	comments := make([]types.PendingCommentData, 0, len(list))
+	for _, c := range list {
+		data := toPendingCommentData(c)
+		if v, ok := verdicts[data.ID]; ok {
+			data.SpamScore = v.Score
+			data.SpamReasons = v.Reasons
+		}
+		comments = append(comments, data)
+	}
+	return &types.PendingCommentListResponse{Comments: comments}, nil
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPollsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List polls with their results
+func NewListPollsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPollsLogic {
+	return &ListPollsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPollsLogic) ListPolls() (resp *types.PollListResponse, err error) {
+	polls, err := l.svcCtx.Polls.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.PollData, 0, len(polls))
+	for _, p := range polls {
+		list = append(list, p.Data(nil))
+	}
+	return &types.PollListResponse{Polls: list}, nil
+}
@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListPostRevisionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the stored revisions of a blog post
+func NewListPostRevisionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListPostRevisionsLogic {
+	return &ListPostRevisionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListPostRevisionsLogic) ListPostRevisions(req *types.PostRevisionsRequest) (resp *types.PostRevisionListResponse, err error) {
+	if _, err := uuid.Parse(req.ID); err != nil {
+		return nil, fmt.Errorf("invalid post ID: %w", err)
+	}
+
+	list, err := l.svcCtx.Revisions.List(l.ctx, req.ID)
+	if err != nil {
+		l.Errorf("Failed to list revisions of post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to list revisions")
+	}
+
+	resp = &types.PostRevisionListResponse{PostID: req.ID, Revisions: make([]types.PostRevisionData, 0, len(list))}
+	for _, r := range list {
+		resp.Revisions = append(resp.Revisions, toPostRevisionData(r))
+	}
+	return resp, nil
+}
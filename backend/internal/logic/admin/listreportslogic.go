@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/report"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListReportsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List content reports
+func NewListReportsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListReportsLogic {
+	return &ListReportsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListReportsLogic) ListReports(req *types.ReportListRequest) (resp *types.ReportListResponse, err error) {
+	tickets, err := l.svcCtx.Reports.List(l.ctx, report.Filter{
+		Status:      req.Status,
+		ContentType: req.ContentType,
+		ContentID:   req.ContentID,
+	})
+	if err != nil {
+		l.Errorf("Failed to list reports: %v", err)
+		return nil, fmt.Errorf("failed to list reports")
+	}
+
+	resp = &types.ReportListResponse{Reports: make([]types.ReportData, 0, len(tickets))}
+	for _, t := range tickets {
+		data := types.ReportData{
+			ID:            t.ID,
+			ContentType:   t.ContentType,
+			ContentID:     t.ContentID,
+			ContentTitle:  t.ContentTitle,
+			Category:      t.Category,
+			Details:       t.Details,
+			ReporterName:  t.ReporterName,
+			ReporterEmail: t.ReporterEmail,
+			IP:            t.IP,
+			Status:        t.Status,
+			Resolution:    t.Resolution,
+			CreatedAt:     utils.FormatTime(t.CreatedAt),
+		}
+		if t.ResolvedAt != nil {
+			data.ResolvedAt = utils.FormatTime(*t.ResolvedAt)
+		}
+		resp.Reports = append(resp.Reports, data)
+	}
+	return resp, nil
+}
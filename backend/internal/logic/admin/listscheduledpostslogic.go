@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListScheduledPostsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List blog posts waiting to be published
+func NewListScheduledPostsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListScheduledPostsLogic {
+	return &ListScheduledPostsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListScheduledPostsLogic) ListScheduledPosts() (resp *types.ScheduledPostListResponse, err error) {
+	pending, err := l.svcCtx.Publishing.Pending(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(pending))
+	for _, sc := range pending {
+		if id, err := uuid.Parse(sc.PostID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	posts, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.IDIn(ids...)).
+		Select(blogpost.FieldID, blogpost.FieldTitle).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	titles := make(map[string]string, len(posts))
+	for _, p := range posts {
+		titles[p.ID.String()] = p.Title
+	}
+
+	resp = &types.ScheduledPostListResponse{Posts: make([]types.ScheduledPostData, 0, len(pending))}
+	for _, sc := range pending {
+		resp.Posts = append(resp.Posts, toScheduledPostData(sc, titles[sc.PostID]))
+	}
+	return resp, nil
+}
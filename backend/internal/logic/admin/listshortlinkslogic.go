@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListShortLinksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List short links with click counts
+func NewListShortLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListShortLinksLogic {
+	return &ListShortLinksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListShortLinksLogic) ListShortLinks() (resp *types.ShortLinkListResponse, err error) {
+	links, err := l.svcCtx.Links.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.ShortLinkData, 0, len(links))
+	for _, link := range links {
+		list = append(list, toShortLinkData(link, l.svcCtx.Config.Site.ShortLinkBase))
+	}
+	return &types.ShortLinkListResponse{Links: list}, nil
+}
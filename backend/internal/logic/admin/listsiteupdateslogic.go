@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSiteUpdatesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List all site updates
+func NewListSiteUpdatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSiteUpdatesLogic {
+	return &ListSiteUpdatesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListSiteUpdatesLogic) ListSiteUpdates() (resp *types.SiteUpdateListResponse, err error) {
+	updates, err := l.svcCtx.Changelog.List(l.ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.SiteUpdateData, 0, len(updates))
+	for _, u := range updates {
+		list = append(list, u.Data())
+	}
+	return &types.SiteUpdateListResponse{Updates: list}, nil
+}
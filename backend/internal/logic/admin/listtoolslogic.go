@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListToolsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List all tools of the uses page
+func NewListToolsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListToolsLogic {
+	return &ListToolsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListToolsLogic) ListTools() (resp *types.ToolListResponse, err error) {
+	tools, err := l.svcCtx.Tools.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.ToolData, 0, len(tools))
+	for _, t := range tools {
+		list = append(list, t.Data())
+	}
+	return &types.ToolListResponse{Tools: list}, nil
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListTrashLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List deleted entries that can still be restored
+func NewListTrashLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListTrashLogic {
+	return &ListTrashLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListTrashLogic) ListTrash(req *types.TrashListRequest) (resp *types.TrashListResponse, err error) {
+	items, err := l.svcCtx.Trash.List(l.ctx, req.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.TrashListResponse{Items: make([]types.TrashItemData, 0, len(items))}
+	for _, it := range items {
+		resp.Items = append(resp.Items, toTrashItemData(it, l.svcCtx.Config.Trash.RetentionDays))
+	}
+	return resp, nil
+}
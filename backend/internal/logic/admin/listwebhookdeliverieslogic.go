@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWebhookDeliveriesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List recent deliveries of a webhook
+func NewListWebhookDeliveriesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWebhookDeliveriesLogic {
+	return &ListWebhookDeliveriesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListWebhookDeliveriesLogic) ListWebhookDeliveries(req *types.WebhookDeliveriesRequest) (resp *types.WebhookDeliveryListResponse, err error) {
+	if _, err := l.svcCtx.Webhooks.Get(l.ctx, req.ID); err != nil {
+		return nil, err
+	}
+
+	list, err := l.svcCtx.Webhooks.Deliveries(l.ctx, req.ID, req.Failed, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]types.WebhookDeliveryData, 0, len(list))
+	for _, d := range list {
+		deliveries = append(deliveries, *toWebhookDelivery(d))
+	}
+	return &types.WebhookDeliveryListResponse{Deliveries: deliveries}, nil
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListWebhooksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List webhook endpoints
+func NewListWebhooksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListWebhooksLogic {
+	return &ListWebhooksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListWebhooksLogic) ListWebhooks() (resp *types.WebhookListResponse, err error) {
+	subs, err := l.svcCtx.Webhooks.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks := make([]types.WebhookData, 0, len(subs))
+	for _, s := range subs {
+		webhooks = append(webhooks, toWebhookData(s))
+	}
+	return &types.WebhookListResponse{Webhooks: webhooks}, nil
+}
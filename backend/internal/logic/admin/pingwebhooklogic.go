@@ -0,0 +1,34 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PingWebhookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Send a ping event to a webhook endpoint
+func NewPingWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PingWebhookLogic {
+	return &PingWebhookLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PingWebhookLogic) PingWebhook(req *types.WebhookRequest) (resp *types.WebhookDeliveryData, err error) {
+	delivery, err := l.svcCtx.Webhooks.Ping(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toWebhookDelivery(delivery), nil
+}
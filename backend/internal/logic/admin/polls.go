@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/poll"
+	"silan-backend/internal/types"
+)
+
+// applyPollFields validates the editable poll fields and copies them onto p.
+func applyPollFields(p *poll.Poll, question string, multipleChoice bool, closesAt string, options []types.PollOptionInput) error {
+	p.Question = strings.TrimSpace(question)
+	if p.Question == "" {
+		return fmt.Errorf("question is required")
+	}
+	p.MultipleChoice = multipleChoice
+
+	p.ClosesAt = nil
+	if closesAt != "" {
+		t, err := time.Parse(time.RFC3339, closesAt)
+		if err != nil {
+			return fmt.Errorf("closes_at must be an RFC 3339 timestamp")
+		}
+		t = t.UTC()
+		p.ClosesAt = &t
+	}
+
+	p.Options = p.Options[:0]
+	for _, o := range options {
+		text := strings.TrimSpace(o.Text)
+		if text == "" {
+			return fmt.Errorf("option text is required")
+		}
+		p.Options = append(p.Options, poll.Option{ID: o.ID, Text: text})
+	}
+	if len(p.Options) < 2 {
+		return fmt.Errorf("a poll needs at least two options")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PurgeCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Permanently delete a comment and all of its replies
+func NewPurgeCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PurgeCommentLogic {
+	return &PurgeCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PurgeCommentLogic) PurgeComment(req *types.ModerateCommentRequest) error {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return fmt.Errorf("invalid comment id")
+	}
+
+	if err := l.svcCtx.Legacy.CopyComment(l.ctx, id.String()); err != nil {
+		l.Errorf("Failed to copy legacy comment %s: %v", id, err)
+	}
+	n, err := l.svcCtx.PurgeComment(l.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	l.Infof("Purged comment %s (%d comments removed)", id, n)
+	return nil
+}
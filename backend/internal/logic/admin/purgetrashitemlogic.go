@@ -0,0 +1,29 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PurgeTrashItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Permanently remove an entry from the trash
+func NewPurgeTrashItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PurgeTrashItemLogic {
+	return &PurgeTrashItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PurgeTrashItemLogic) PurgeTrashItem(req *types.TrashItemRequest) error {
+	return l.svcCtx.Trash.Remove(l.ctx, req.ID)
+}
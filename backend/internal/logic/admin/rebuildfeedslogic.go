@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RebuildFeedsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Announce a content change so the sitemap and feeds are rebuilt
+func NewRebuildFeedsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RebuildFeedsLogic {
+	return &RebuildFeedsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// RebuildFeeds lets the content sync (which writes to the database directly)
+// report a change. The rebuild itself happens when the outbox relay picks up
+// the event.
+func (l *RebuildFeedsLogic) RebuildFeeds(req *types.RebuildFeedsRequest) error {
+	return l.svcCtx.PublishEvent(l.ctx, l.svcCtx.RawDB, outbox.EventContentUpdated, outbox.ContentEvent{
+		Type: req.Type,
+		ID:   req.ID,
+	})
+}
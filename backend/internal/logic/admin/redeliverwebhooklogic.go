@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RedeliverWebhookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Redeliver a previous webhook delivery
+func NewRedeliverWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedeliverWebhookLogic {
+	return &RedeliverWebhookLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RedeliverWebhookLogic) RedeliverWebhook(req *types.RedeliverWebhookRequest) (resp *types.WebhookDeliveryData, err error) {
+	delivery, err := l.svcCtx.Webhooks.Redeliver(l.ctx, req.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("Redelivered %s to webhook %s (attempt %d, status %d)", delivery.EventType, delivery.WebhookID, delivery.Attempt, delivery.StatusCode)
+	return toWebhookDelivery(delivery), nil
+}
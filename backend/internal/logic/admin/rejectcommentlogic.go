@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Reject a held comment and its held replies
+func NewRejectCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectCommentLogic {
+	return &RejectCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RejectCommentLogic) RejectComment(req *types.ModerateCommentRequest) error {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return fmt.Errorf("invalid comment id")
+	}
+	c, n, err := l.svcCtx.RejectComment(l.ctx, id)
+	if err != nil {
+		return err
+	}
+
+	l.Infof("Rejected comment %s by %s (%d comments removed)", c.ID, c.AuthorName, n)
+	return nil
+}
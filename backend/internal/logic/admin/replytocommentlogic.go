@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/cannedreply"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ReplyToCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Reply to a comment as the site owner, approving it if held
+func NewReplyToCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReplyToCommentLogic {
+	return &ReplyToCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ReplyToCommentLogic) ReplyToComment(req *types.OwnerReplyRequest) (resp *types.OwnerReplyResponse, err error) {
+	parentID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment id")
+	}
+
+	// A canned response is addressed to the commenter replied to
+	content := strings.TrimSpace(req.Content)
+	var canned *cannedreply.Response
+	if req.CannedResponseID != "" {
+		if content != "" {
+			return nil, fmt.Errorf("content and canned_response_id are mutually exclusive")
+		}
+		canned, err = l.svcCtx.CannedResponses.Get(l.ctx, req.CannedResponseID)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.svcCtx.Legacy.CopyComment(l.ctx, parentID.String()); err != nil {
+			l.Errorf("Failed to copy legacy comment %s: %v", parentID, err)
+		}
+		parent, err := l.svcCtx.DB.Comment.Get(l.ctx, parentID)
+		if err != nil {
+			return nil, fmt.Errorf("comment not found")
+		}
+		content = canned.Render(parent.AuthorName)
+	}
+	if content == "" {
+		return nil, fmt.Errorf("content or canned_response_id is required")
+	}
+
+	c, approved, err := l.svcCtx.ReplyAsOwner(l.ctx, parentID, content)
+	if err != nil {
+		return nil, err
+	}
+	if canned != nil {
+		if err := l.svcCtx.CannedResponses.MarkUsed(l.ctx, canned.ID); err != nil {
+			l.Errorf("Failed to count use of canned response %s: %v", canned.ID, err)
+		}
+	}
+
+	l.Infof("Owner replied to comment %s with comment %s", parentID, c.ID)
+	return &types.OwnerReplyResponse{
+		ID:             c.ID.String(),
+		EntityType:     c.EntityType,
+		EntityID:       c.EntityID.String(),
+		ParentID:       c.ParentID.String(),
+		AuthorName:     c.AuthorName,
+		Content:        c.Content,
+		CreatedAt:      utils.FormatTime(c.CreatedAt),
+		ParentApproved: approved,
+	}, nil
+}
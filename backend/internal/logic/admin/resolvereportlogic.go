@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/report"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ResolveReportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Resolve, dismiss or reopen a content report
+func NewResolveReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ResolveReportLogic {
+	return &ResolveReportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ResolveReportLogic) ResolveReport(req *types.ResolveReportRequest) error {
+	err := l.svcCtx.Reports.Resolve(l.ctx, req.ID, req.Status, req.Resolution)
+	if errors.Is(err, report.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		l.Errorf("Failed to update report %s: %v", req.ID, err)
+		return fmt.Errorf("failed to update report")
+	}
+
+	l.Infof("Marked report %s as %s", req.ID, req.Status)
+	return nil
+}
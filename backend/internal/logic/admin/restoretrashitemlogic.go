@@ -0,0 +1,41 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RestoreTrashItemLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Restore a deleted entry from the trash
+func NewRestoreTrashItemLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RestoreTrashItemLogic {
+	return &RestoreTrashItemLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RestoreTrashItemLogic) RestoreTrashItem(req *types.TrashItemRequest) error {
+	it, err := l.svcCtx.Trash.Get(l.ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if err := restoreFromTrash(l.ctx, l.svcCtx, it); err != nil {
+		return err
+	}
+	if err := l.svcCtx.Trash.Remove(l.ctx, it.ID); err != nil {
+		return err
+	}
+
+	l.Infof("Restored %s %s from trash", it.Kind, it.EntityID)
+	return nil
+}
@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"silan-backend/internal/revision"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+func toPostRevisionData(r *revision.Revision) types.PostRevisionData {
+	return types.PostRevisionData{
+		Number:    r.Number,
+		Title:     r.Title,
+		Hash:      r.Hash,
+		CreatedAt: utils.FormatTime(r.CreatedAt),
+	}
+}
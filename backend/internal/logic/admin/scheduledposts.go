@@ -0,0 +1,16 @@
+package admin
+
+import (
+	"silan-backend/internal/publishing"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+func toScheduledPostData(sc *publishing.Schedule, title string) types.ScheduledPostData {
+	return types.ScheduledPostData{
+		PostID:    sc.PostID,
+		Title:     title,
+		PublishAt: utils.FormatTime(sc.PublishAt),
+		CreatedAt: utils.FormatTime(sc.CreatedAt),
+	}
+}
@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/publishing"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SchedulePostLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Schedule a draft blog post for publishing
+func NewSchedulePostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SchedulePostLogic {
+	return &SchedulePostLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SchedulePostLogic) SchedulePost(req *types.SchedulePostRequest) (resp *types.ScheduledPostData, err error) {
+	postID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blog post ID")
+	}
+	publishAt, err := time.Parse(time.RFC3339, req.PublishAt)
+	if err != nil {
+		return nil, fmt.Errorf("publish_at must be an RFC 3339 timestamp")
+	}
+
+	sc, err := l.svcCtx.Publishing.Schedule(l.ctx, postID, publishAt)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("blog post not found")
+	}
+	if errors.Is(err, publishing.ErrPublished) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to schedule blog post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to schedule blog post")
+	}
+
+	post, err := l.svcCtx.DB.BlogPost.Get(l.ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	data := toScheduledPostData(sc, post.Title)
+	return &data, nil
+}
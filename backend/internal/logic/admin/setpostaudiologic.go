@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/media"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetPostAudioLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Attach a narration audio file to a blog post
+func NewSetPostAudioLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetPostAudioLogic {
+	return &SetPostAudioLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetPostAudioLogic) SetPostAudio(req *types.SetPostAudioRequest) (resp *types.PostAudioResponse, err error) {
+	postID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blog post ID")
+	}
+	exists, err := l.svcCtx.DB.BlogPost.Query().Where(blogpost.IDEQ(postID)).Exist(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, fmt.Errorf("blog post not found")
+	}
+
+	contentType := media.AudioType(req.URL, req.ContentType)
+	if contentType == "" {
+		return nil, fmt.Errorf("url is not an audio file; set content_type")
+	}
+	audio := media.Audio{
+		URL:             req.URL,
+		ContentType:     contentType,
+		Length:          req.Length,
+		DurationSeconds: req.DurationSeconds,
+		UpdatedAt:       time.Now(),
+	}
+	if err := l.svcCtx.Audio.Set(l.ctx, media.OwnerPost, postID.String(), audio); err != nil {
+		l.Errorf("Failed to attach audio to blog post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to attach audio")
+	}
+
+	// The podcast feed is rebuilt with the new enclosure
+	if err := l.svcCtx.PublishEvent(l.ctx, l.svcCtx.RawDB, outbox.EventContentUpdated, outbox.ContentEvent{
+		Type: "blog",
+		ID:   postID.String(),
+	}); err != nil {
+		l.Errorf("Failed to record content event for blog post %s: %v", req.ID, err)
+	}
+
+	return &types.PostAudioResponse{
+		PostID:          postID.String(),
+		URL:             audio.URL,
+		ContentType:     audio.ContentType,
+		Length:          audio.Length,
+		DurationSeconds: audio.DurationSeconds,
+	}, nil
+}
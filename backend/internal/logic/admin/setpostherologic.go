@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/media"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetPostHeroLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Set the hero image of a blog post and its responsive variants
+func NewSetPostHeroLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetPostHeroLogic {
+	return &SetPostHeroLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetPostHeroLogic) SetPostHero(req *types.SetPostHeroRequest) (resp *types.PostHeroResponse, err error) {
+	postID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blog post ID")
+	}
+
+	post, err := l.svcCtx.DB.BlogPost.UpdateOneID(postID).
+		SetFeaturedImageURL(req.URL).
+		Save(l.ctx)
+	if ent.IsNotFound(err) {
+		return nil, fmt.Errorf("blog post not found")
+	}
+	if err != nil {
+		l.Errorf("Failed to set hero image of blog post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to set hero image")
+	}
+
+	variants := make([]media.Variant, 0, len(req.Variants))
+	for _, v := range req.Variants {
+		variants = append(variants, media.Variant{URL: v.URL, Width: v.Width, Format: v.Format})
+	}
+	if err := l.svcCtx.ImageVariants.SetVariants(l.ctx, media.OwnerPost, post.ID.String(), post.FeaturedImageURL, variants); err != nil {
+		l.Errorf("Failed to store hero variants of blog post %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to store hero variants")
+	}
+
+	return &types.PostHeroResponse{
+		PostID:       post.ID.String(),
+		HeroImage:    post.FeaturedImageURL,
+		HeroVariants: svc.ToImageVariants(variants),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package admin
+
+import (
+	"strings"
+
+	"silan-backend/internal/shortlink"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+func toShortLinkData(l *shortlink.Link, base string) types.ShortLinkData {
+	return types.ShortLinkData{
+		Code:       l.Code,
+		ShortURL:   strings.TrimRight(base, "/") + "/s/" + l.Code,
+		TargetURL:  l.TargetURL,
+		Channel:    l.Channel,
+		EntityType: l.EntityType,
+		EntityID:   l.EntityID,
+		Clicks:     l.Clicks,
+		CreatedAt:  utils.FormatTime(l.CreatedAt),
+	}
+}
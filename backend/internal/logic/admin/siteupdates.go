@@ -0,0 +1,53 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/siteupdate"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// publishSiteUpdateEvent queues a content event so the cached feeds are
+// rebuilt. The change itself is already stored, so failures are only logged.
+// title is empty for deletions.
+func publishSiteUpdateEvent(ctx context.Context, svcCtx *svc.ServiceContext, eventType, id, title string) {
+	ev := outbox.ContentEvent{Type: "site_update", ID: id, Title: title, Public: title != ""}
+	if err := svcCtx.PublishEvent(ctx, svcCtx.RawDB, eventType, ev); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to record %s for site update %s: %v", eventType, id, err)
+	}
+}
+
+// applySiteUpdateFields validates the editable site update fields and copies
+// them onto u. An empty publishedAt keeps the current publish time.
+func applySiteUpdateFields(u *siteupdate.Update, title, description, kind, link, publishedAt string) error {
+	u.Title = strings.TrimSpace(title)
+	u.Description = strings.TrimSpace(description)
+	if u.Title == "" || u.Description == "" {
+		return fmt.Errorf("title and description are required")
+	}
+	if !siteupdate.ValidKind(kind) {
+		return fmt.Errorf("kind must be one of %s", strings.Join(siteupdate.Kinds, ", "))
+	}
+	u.Kind = kind
+
+	u.Link = strings.TrimSpace(link)
+	if u.Link != "" && !utils.IsHTTPURL(u.Link) {
+		return fmt.Errorf("link must be an absolute http(s) URL")
+	}
+
+	if publishedAt != "" {
+		t, err := time.Parse(time.RFC3339, publishedAt)
+		if err != nil {
+			return fmt.Errorf("published_at must be an RFC 3339 timestamp")
+		}
+		u.PublishedAt = t.UTC()
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/linksuggest"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SuggestLinksLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Suggest internal links to posts, projects and ideas for a draft post
+func NewSuggestLinksLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SuggestLinksLogic {
+	return &SuggestLinksLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SuggestLinksLogic) SuggestLinks(req *types.SuggestLinksRequest) (resp *types.LinkSuggestionListResponse, err error) {
+	draft := linksuggest.Draft{Title: req.Title, Content: req.Content, Labels: req.Tags}
+
+	// A stored post fills in whatever the request leaves out, so the editor
+	// can send its unsaved content
+	if req.PostID != "" {
+		postID, err := uuid.Parse(req.PostID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid post ID: %w", err)
+		}
+		p, err := l.svcCtx.DB.BlogPost.Query().
+			Where(blogpost.IDEQ(postID)).
+			WithTags().
+			Only(l.ctx)
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("post not found")
+		}
+		if err != nil {
+			return nil, err
+		}
+		draft.ID = p.ID.String()
+		if draft.Title == "" {
+			draft.Title = p.Title
+		}
+		if draft.Content == "" {
+			draft.Content = p.Content
+		}
+		if len(draft.Labels) == 0 {
+			for _, t := range p.Edges.Tags {
+				draft.Labels = append(draft.Labels, t.Name)
+			}
+		}
+	}
+	if strings.TrimSpace(draft.Title+draft.Content) == "" {
+		return nil, fmt.Errorf("post_id, title or content is required")
+	}
+
+	targets, err := l.linkTargets()
+	if err != nil {
+		l.Errorf("Failed to load link targets: %v", err)
+		return nil, fmt.Errorf("failed to suggest links")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = 10
+	}
+	suggestions := linksuggest.Suggest(draft, targets, limit)
+
+	resp = &types.LinkSuggestionListResponse{Suggestions: make([]types.LinkSuggestionData, 0, len(suggestions))}
+	for _, s := range suggestions {
+		resp.Suggestions = append(resp.Suggestions, types.LinkSuggestionData{
+			Type:       s.Target.Type,
+			ID:         s.Target.ID,
+			Slug:       s.Target.Slug,
+			Title:      s.Target.Title,
+			URL:        s.Target.URL,
+			Score:      math.Round(s.Score*100) / 100,
+			SharedTags: nonNil(s.SharedLabels),
+			Keywords:   nonNil(s.Keywords),
+			Anchors:    nonNil(s.Anchors),
+		})
+	}
+	return resp, nil
+}
+
+// linkTargets loads the published posts, public projects and public ideas
+// a post can link to, with their tags or technologies.
+func (l *SuggestLinksLogic) linkTargets() ([]*linksuggest.Target, error) {
+	site := l.svcCtx.Config.Site.BaseURL
+	var targets []*linksuggest.Target
+
+	posts, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		WithTags().
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		t := &linksuggest.Target{Type: "blog", ID: p.ID.String(), Slug: p.Slug, Title: p.Title, Text: p.Excerpt}
+		t.URL = commentsub.PageURL(site, t.Type, t.ID)
+		for _, tag := range p.Edges.Tags {
+			t.Labels = append(t.Labels, tag.Name)
+		}
+		targets = append(targets, t)
+	}
+
+	projects, err := l.svcCtx.DB.Project.Query().
+		Where(project.IsPublic(true)).
+		WithTechnologies().
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		t := &linksuggest.Target{Type: "project", ID: p.ID.String(), Slug: p.Slug, Title: p.Title, Text: p.Description}
+		t.URL = commentsub.PageURL(site, t.Type, t.ID)
+		for _, tech := range p.Edges.Technologies {
+			t.Labels = append(t.Labels, tech.TechnologyName)
+		}
+		targets = append(targets, t)
+	}
+
+	ideas, err := l.svcCtx.DB.Idea.Query().
+		Where(idea.IsPublic(true)).
+		WithTags().
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ideas {
+		t := &linksuggest.Target{Type: "idea", ID: i.ID.String(), Slug: i.Slug, Title: i.Title, Text: i.Abstract}
+		t.URL = commentsub.PageURL(site, t.Type, t.ID)
+		for _, tag := range i.Edges.Tags {
+			t.Labels = append(t.Labels, tag.Name)
+		}
+		targets = append(targets, t)
+	}
+	return targets, nil
+}
+
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
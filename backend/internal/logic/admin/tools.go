@@ -0,0 +1,26 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/uses"
+	"silan-backend/internal/utils"
+)
+
+// applyToolFields validates and copies the editable fields onto t.
+func applyToolFields(t *uses.Tool, category, name, description, link string, sortOrder int) error {
+	t.Category = strings.TrimSpace(category)
+	t.Name = strings.TrimSpace(name)
+	t.Description = strings.TrimSpace(description)
+	t.Link = strings.TrimSpace(link)
+	t.SortOrder = sortOrder
+
+	if t.Category == "" || t.Name == "" {
+		return fmt.Errorf("category and name are required")
+	}
+	if t.Link != "" && !utils.IsHTTPURL(t.Link) {
+		return fmt.Errorf("link must be an absolute http(s) URL")
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"silan-backend/internal/faq"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/shortlink"
+	"silan-backend/internal/siteupdate"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/trash"
+	"silan-backend/internal/types"
+	"silan-backend/internal/uses"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// moveToTrash snapshots v into the trash and then runs del. The snapshot is
+// dropped again when del fails, so the trash only lists deleted entries.
+func moveToTrash(ctx context.Context, svcCtx *svc.ServiceContext, kind, entityID, title string, v any, del func() error) error {
+	it, err := svcCtx.Trash.Put(ctx, kind, entityID, title, v)
+	if err != nil {
+		return fmt.Errorf("failed to move %s to trash: %w", kind, err)
+	}
+	if err := del(); err != nil {
+		if rmErr := svcCtx.Trash.Remove(ctx, it.ID); rmErr != nil {
+			logx.WithContext(ctx).Errorf("Failed to drop trash item %s: %v", it.ID, rmErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// restoreFromTrash stores the snapshot of a trash item again.
+func restoreFromTrash(ctx context.Context, svcCtx *svc.ServiceContext, it *trash.Item) error {
+	switch it.Kind {
+	case trash.KindTool:
+		var t uses.Tool
+		if err := json.Unmarshal(it.Payload, &t); err != nil {
+			return err
+		}
+		return svcCtx.Tools.Restore(ctx, &t)
+	case trash.KindFAQ:
+		var f faq.Item
+		if err := json.Unmarshal(it.Payload, &f); err != nil {
+			return err
+		}
+		return svcCtx.FAQs.Restore(ctx, &f)
+	case trash.KindSiteUpdate:
+		var u siteupdate.Update
+		if err := json.Unmarshal(it.Payload, &u); err != nil {
+			return err
+		}
+		if err := svcCtx.Changelog.Restore(ctx, &u); err != nil {
+			return err
+		}
+		publishSiteUpdateEvent(ctx, svcCtx, outbox.EventContentPublished, u.ID, u.Title)
+		return nil
+	case trash.KindShortLink:
+		var l shortlink.Link
+		if err := json.Unmarshal(it.Payload, &l); err != nil {
+			return err
+		}
+		return svcCtx.Links.Restore(ctx, &l)
+	}
+	return fmt.Errorf("cannot restore %s entries", it.Kind)
+}
+
+func toTrashItemData(it *trash.Item, retentionDays int) types.TrashItemData {
+	data := types.TrashItemData{
+		ID:        it.ID,
+		Kind:      it.Kind,
+		EntityID:  it.EntityID,
+		Title:     it.Title,
+		DeletedAt: utils.FormatTime(it.DeletedAt),
+	}
+	if retentionDays > 0 {
+		data.PurgeAt = utils.FormatTime(it.DeletedAt.AddDate(0, 0, retentionDays))
+	}
+	return data
+}
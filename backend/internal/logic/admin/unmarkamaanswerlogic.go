@@ -0,0 +1,47 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnmarkAmaAnswerLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Take back the answer mark of a reply
+func NewUnmarkAmaAnswerLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnmarkAmaAnswerLogic {
+	return &UnmarkAmaAnswerLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnmarkAmaAnswerLogic) UnmarkAmaAnswer(req *types.UnmarkAmaAnswerRequest) error {
+	a, err := l.svcCtx.AMAs.Get(l.ctx, req.ID)
+	if err != nil {
+		return err
+	}
+	if a.Status(time.Now()) == ama.StatusClosed {
+		return fmt.Errorf("this AMA is closed")
+	}
+	marked, err := l.svcCtx.AMAs.UnmarkAnswer(l.ctx, a.ID, req.AnswerID)
+	if err != nil {
+		l.Errorf("Failed to unmark answer %s of AMA %s: %v", req.AnswerID, a.ID, err)
+		return fmt.Errorf("failed to unmark answer")
+	}
+	if !marked {
+		return fmt.Errorf("comment %s is not marked as an answer", req.AnswerID)
+	}
+	return nil
+}
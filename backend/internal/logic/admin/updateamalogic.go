@@ -0,0 +1,55 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateAmaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update an AMA session, including its window
+func NewUpdateAmaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateAmaLogic {
+	return &UpdateAmaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateAmaLogic) UpdateAma(req *types.UpdateAmaRequest) (resp *types.AmaData, err error) {
+	a, err := l.svcCtx.AMAs.Get(l.ctx, req.ID)
+	if errors.Is(err, ama.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to get AMA %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update AMA")
+	}
+	if err := applyAmaFields(a, req.Title, req.Description, req.OpensAt, req.ClosesAt); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.AMAs.Update(l.ctx, a); err != nil {
+		l.Errorf("Failed to update AMA %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update AMA")
+	}
+
+	counts, err := l.svcCtx.AMAQuestionCounts(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to count AMA questions: %v", err)
+	}
+	data := a.Data(time.Now(), counts[a.ID])
+	return &data, nil
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateApiKeyQuotaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update the daily/monthly quotas of an API key
+func NewUpdateApiKeyQuotaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateApiKeyQuotaLogic {
+	return &UpdateApiKeyQuotaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateApiKeyQuotaLogic) UpdateApiKeyQuota(req *types.UpdateApiKeyQuotaRequest) (resp *types.ApiKeyData, err error) {
+	if req.DailyQuota < 0 || req.MonthlyQuota < 0 {
+		return nil, fmt.Errorf("quotas must not be negative")
+	}
+
+	if err := l.svcCtx.ApiKeys.UpdateQuota(l.ctx, req.ID, req.DailyQuota, req.MonthlyQuota); err != nil {
+		return nil, err
+	}
+
+	key, err := l.svcCtx.ApiKeys.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := toApiKeyData(key)
+	return &data, nil
+}
@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/availability"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateAvailabilityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Set the owner's availability status
+func NewUpdateAvailabilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateAvailabilityLogic {
+	return &UpdateAvailabilityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateAvailabilityLogic) UpdateAvailability(req *types.UpdateAvailabilityRequest) (resp *types.AvailabilityData, err error) {
+	st := &availability.Status{
+		Status:  req.Status,
+		Message: strings.TrimSpace(req.Message),
+	}
+	if req.BusyUntil != "" {
+		until, err := parseBusyUntil(req.BusyUntil)
+		if err != nil {
+			return nil, err
+		}
+		st.BusyUntil = &until
+	}
+
+	if err := l.svcCtx.Availability.SetStatus(l.ctx, st); err != nil {
+		l.Errorf("Failed to set availability: %v", err)
+		return nil, fmt.Errorf("failed to set availability")
+	}
+
+	now := time.Now()
+	slots, err := l.svcCtx.Availability.Upcoming(l.ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	data := availability.Data(st, slots, now)
+	return &data, nil
+}
+
+// parseBusyUntil accepts an RFC 3339 timestamp or a date; a date means busy
+// through the end of that day (UTC).
+func parseBusyUntil(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.UTC(), nil
+	}
+	d, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("busy_until must be an RFC 3339 timestamp or a YYYY-MM-DD date")
+	}
+	return d.AddDate(0, 0, 1), nil
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateCannedResponseLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update a canned response
+func NewUpdateCannedResponseLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateCannedResponseLogic {
+	return &UpdateCannedResponseLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateCannedResponseLogic) UpdateCannedResponse(req *types.UpdateCannedResponseRequest) (resp *types.CannedResponseData, err error) {
+	r, err := l.svcCtx.CannedResponses.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyCannedResponseFields(r, req.Title, req.Content); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.CannedResponses.Update(l.ctx, r); err != nil {
+		return nil, err
+	}
+
+	data := r.Data()
+	return &data, nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/drafts"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateDraftLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Edit a generated draft
+func NewUpdateDraftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateDraftLogic {
+	return &UpdateDraftLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateDraftLogic) UpdateDraft(req *types.UpdateDraftRequest) (resp *types.ContentDraftData, err error) {
+	d := &drafts.Draft{
+		ContentType: req.Type,
+		ContentID:   req.ID,
+		Field:       req.Field,
+		Language:    req.Language,
+		Text:        strings.TrimSpace(req.Text),
+	}
+	if err := l.svcCtx.Drafts.Update(l.ctx, d); err != nil {
+		return nil, err
+	}
+
+	// Reload to return the model that produced the draft
+	list, err := l.svcCtx.Drafts.List(l.ctx, req.Type, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, stored := range list {
+		if stored.Field == d.Field && stored.Language == d.Language {
+			d = stored
+			break
+		}
+	}
+	data := toContentDraftData(d)
+	return &data, nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/faq"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateFAQLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update an FAQ entry
+func NewUpdateFAQLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateFAQLogic {
+	return &UpdateFAQLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateFAQLogic) UpdateFAQ(req *types.UpdateFAQRequest) (resp *types.FAQAdminData, err error) {
+	it, err := l.svcCtx.FAQs.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyFAQFields(it, req.Category, req.Question, req.Answer, req.SortOrder, req.Translations); err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.FAQs.Update(l.ctx, it)
+	if errors.Is(err, faq.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to update FAQ entry %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update FAQ entry")
+	}
+
+	feedback, err := l.svcCtx.FAQs.FeedbackCounts(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	data := toFAQAdminData(it, feedback[it.ID])
+	return &data, nil
+}
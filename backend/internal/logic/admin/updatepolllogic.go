@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/poll"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdatePollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update a poll and its options
+func NewUpdatePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdatePollLogic {
+	return &UpdatePollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdatePollLogic) UpdatePoll(req *types.UpdatePollRequest) (resp *types.PollData, err error) {
+	p := &poll.Poll{ID: req.ID}
+	if err := applyPollFields(p, req.Question, req.MultipleChoice, req.ClosesAt, req.Options); err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.Polls.Update(l.ctx, p)
+	if errors.Is(err, poll.ErrNotFound) {
+		return nil, err
+	}
+	if errors.Is(err, poll.ErrInvalidChoice) {
+		return nil, fmt.Errorf("option does not belong to this poll")
+	}
+	if err != nil {
+		l.Errorf("Failed to update poll %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update poll")
+	}
+
+	updated, err := l.svcCtx.Polls.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	data := updated.Data(nil)
+	return &data, nil
+}
@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/siteupdate"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateSiteUpdateLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Edit a site update
+func NewUpdateSiteUpdateLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateSiteUpdateLogic {
+	return &UpdateSiteUpdateLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateSiteUpdateLogic) UpdateSiteUpdate(req *types.UpdateSiteUpdateRequest) (resp *types.SiteUpdateData, err error) {
+	u, err := l.svcCtx.Changelog.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applySiteUpdateFields(u, req.Title, req.Description, req.Kind, req.Link, req.PublishedAt); err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.Changelog.Update(l.ctx, u)
+	if errors.Is(err, siteupdate.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to update site update %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to update site update")
+	}
+	publishSiteUpdateEvent(l.ctx, l.svcCtx, outbox.EventContentUpdated, u.ID, u.Title)
+
+	data := u.Data()
+	return &data, nil
+}
@@ -0,0 +1,42 @@
+package admin
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateToolLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update a tool of the uses page
+func NewUpdateToolLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateToolLogic {
+	return &UpdateToolLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateToolLogic) UpdateTool(req *types.UpdateToolRequest) (resp *types.ToolData, err error) {
+	tool, err := l.svcCtx.Tools.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyToolFields(tool, req.Category, req.Name, req.Description, req.Link, req.SortOrder); err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.Tools.Update(l.ctx, tool); err != nil {
+		return nil, err
+	}
+
+	data := tool.Data()
+	return &data, nil
+}
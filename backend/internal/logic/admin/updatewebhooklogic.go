@@ -0,0 +1,64 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/webhook"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateWebhookLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update a webhook endpoint
+func NewUpdateWebhookLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateWebhookLogic {
+	return &UpdateWebhookLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateWebhookLogic) UpdateWebhook(req *types.UpdateWebhookRequest) (resp *types.UpdateWebhookResponse, err error) {
+	sub, err := l.svcCtx.Webhooks.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	url := strings.TrimSpace(req.URL)
+	if err := webhook.ValidateURL(url); err != nil {
+		return nil, err
+	}
+	eventTypes, err := normalizeEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	sub.URL = url
+	sub.Description = strings.TrimSpace(req.Description)
+	sub.EventTypes = eventTypes
+	sub.Active = req.Active
+
+	resp = &types.UpdateWebhookResponse{}
+	if req.RotateSecret {
+		if sub.Secret, err = webhook.GenerateSecret(); err != nil {
+			return nil, fmt.Errorf("failed to generate webhook secret")
+		}
+		resp.Secret = sub.Secret
+	}
+
+	if err := l.svcCtx.Webhooks.Update(l.ctx, sub); err != nil {
+		return nil, err
+	}
+
+	resp.Webhook = toWebhookData(sub)
+	return resp, nil
+}
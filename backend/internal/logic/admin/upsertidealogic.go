@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpsertIdeaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create or update an idea by slug, with details, tags and translations
+func NewUpsertIdeaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpsertIdeaLogic {
+	return &UpsertIdeaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpsertIdeaLogic) UpsertIdea(req *types.UpsertIdeaRequest) (resp *types.ContentUpsertResponse, err error) {
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	var changes contentChanges
+	var published bool
+	i, previous, err := upsertIdea(l.ctx, tx, req, publishCheck(l.svcCtx, &published), &changes)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := publishUpsertEvent(l.ctx, l.svcCtx, tx, published, ideaEvent(i, previous)); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record content event: %w", err)
+	}
+
+	resp = &types.ContentUpsertResponse{
+		Type:    "idea",
+		ID:      i.ID.String(),
+		Slug:    i.Slug,
+		Created: previous == "",
+		DryRun:  req.DryRun,
+		Changes: changes,
+	}
+	// A dry run reports the planned changes and discards them
+	if req.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back dry run: %w", err)
+		}
+		return resp, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return resp, nil
+}
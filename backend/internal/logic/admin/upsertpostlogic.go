@@ -0,0 +1,77 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpsertPostLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create or update a blog post by slug, with tags and translations
+func NewUpsertPostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpsertPostLogic {
+	return &UpsertPostLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpsertPostLogic) UpsertPost(req *types.UpsertPostRequest) (resp *types.ContentUpsertResponse, err error) {
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	var changes contentChanges
+	var published bool
+	post, created, err := upsertPost(l.ctx, tx, req, publishCheck(l.svcCtx, &published), &changes)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if _, err := l.svcCtx.Revisions.Record(l.ctx, tx, post.ID.String(), post.Title, post.Content); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record revision: %w", err)
+	}
+	ev := outbox.ContentEvent{
+		Type:   "blog",
+		ID:     post.ID.String(),
+		Title:  post.Title,
+		Public: post.Status == blogpost.StatusPublished,
+	}
+	if err := publishUpsertEvent(l.ctx, l.svcCtx, tx, published, ev); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record content event: %w", err)
+	}
+
+	resp = &types.ContentUpsertResponse{
+		Type:    "blog",
+		ID:      post.ID.String(),
+		Slug:    post.Slug,
+		Created: created,
+		DryRun:  req.DryRun,
+		Changes: changes,
+	}
+	// A dry run reports the planned changes and discards them
+	if req.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back dry run: %w", err)
+		}
+		return resp, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpsertProjectLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Create or update a project by slug, with details, technologies and translations
+func NewUpsertProjectLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpsertProjectLogic {
+	return &UpsertProjectLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpsertProjectLogic) UpsertProject(req *types.UpsertProjectRequest) (resp *types.ContentUpsertResponse, err error) {
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	var changes contentChanges
+	var published bool
+	p, created, err := upsertProject(l.ctx, tx, req, publishCheck(l.svcCtx, &published), &changes)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	ev := outbox.ContentEvent{Type: "project", ID: p.ID.String(), Title: p.Title, Public: p.IsPublic}
+	if err := publishUpsertEvent(l.ctx, l.svcCtx, tx, published, ev); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record content event: %w", err)
+	}
+
+	resp = &types.ContentUpsertResponse{
+		Type:    "project",
+		ID:      p.ID.String(),
+		Slug:    p.Slug,
+		Created: created,
+		DryRun:  req.DryRun,
+		Changes: changes,
+	}
+	// A dry run reports the planned changes and discards them
+	if req.DryRun {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("failed to roll back dry run: %w", err)
+		}
+		return resp, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+	"silan-backend/internal/webhook"
+)
+
+func toWebhookData(s *webhook.Subscription) types.WebhookData {
+	eventTypes := s.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+	return types.WebhookData{
+		ID:          s.ID,
+		URL:         s.URL,
+		Description: s.Description,
+		EventTypes:  eventTypes,
+		Active:      s.Active,
+		CreatedAt:   utils.FormatTime(s.CreatedAt),
+		UpdatedAt:   utils.FormatTime(s.UpdatedAt),
+	}
+}
+
+func toWebhookDelivery(d *webhook.Delivery) *types.WebhookDeliveryData {
+	return &types.WebhookDeliveryData{
+		ID:         d.ID,
+		WebhookID:  d.WebhookID,
+		EventID:    d.EventID,
+		EventType:  d.EventType,
+		Payload:    d.Payload,
+		StatusCode: d.StatusCode,
+		Error:      d.Error,
+		Success:    d.Success,
+		Attempt:    d.Attempt,
+		DurationMs: d.DurationMs,
+		CreatedAt:  utils.FormatTime(d.CreatedAt),
+	}
+}
+
+// normalizeEventTypes trims and de-duplicates the event filter of a webhook.
+func normalizeEventTypes(in []string) ([]string, error) {
+	seen := make(map[string]bool)
+	var out []string
+	for _, t := range in {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		if strings.Contains(t, ",") {
+			return nil, fmt.Errorf("invalid event type %q", t)
+		}
+		seen[t] = true
+		out = append(out, t)
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("at least one event type is required (use \"*\" for all)")
+	}
+	return out, nil
+}
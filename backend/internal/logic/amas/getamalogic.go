@@ -0,0 +1,119 @@
+package amas
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAmaLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get an AMA session with its questions and answers
+func NewGetAmaLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAmaLogic {
+	return &GetAmaLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAmaLogic) GetAma(req *types.AmaRequest) (resp *types.AmaThreadResponse, err error) {
+	a, err := l.svcCtx.AMAs.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(a.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Questions and replies are approved comments on the AMA; the most
+	// liked questions come first
+	comments, err := l.svcCtx.DB.Comment.Query().
+		Where(
+			comment.EntityTypeEQ(ama.EntityType),
+			comment.EntityIDEQ(id),
+			comment.IsApproved(true),
+		).
+		Order(ent.Desc(comment.FieldLikesCount), ent.Asc(comment.FieldCreatedAt)).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	answers, err := l.svcCtx.AMAs.Answers(l.ctx, a.ID)
+	if err != nil {
+		l.Errorf("Failed to get answers of AMA %s: %v", a.ID, err)
+		return nil, fmt.Errorf("failed to get AMA")
+	}
+
+	lookupAvatar := func(email string) string {
+		if email == "" {
+			return ""
+		}
+		identity, err := l.svcCtx.DB.UserIdentity.
+			Query().
+			Where(useridentity.EmailEQ(email)).
+			Order(ent.Desc(useridentity.FieldUpdatedAt)).
+			First(l.ctx)
+		if err == nil {
+			return identity.AvatarURL
+		}
+		return ""
+	}
+
+	byID := make(map[string]*ent.Comment, len(comments))
+	for _, c := range comments {
+		byID[c.ID.String()] = c
+	}
+
+	questions := []types.AmaQuestionData{}
+	for _, c := range comments {
+		if c.ParentID != uuid.Nil {
+			continue
+		}
+		q := types.AmaQuestionData{
+			ID:              c.ID.String(),
+			AuthorName:      c.AuthorName,
+			AuthorAvatarURL: lookupAvatar(c.AuthorEmail),
+			Content:         c.Content,
+			CreatedAt:       utils.FormatTime(c.CreatedAt),
+			LikesCount:      c.LikesCount,
+			Answers:         []types.AmaAnswerData{},
+		}
+		for _, answerID := range answers[q.ID] {
+			answer, ok := byID[answerID]
+			if !ok {
+				continue
+			}
+			q.Answers = append(q.Answers, types.AmaAnswerData{
+				ID:         answerID,
+				AuthorName: answer.AuthorName,
+				Content:    answer.Content,
+				CreatedAt:  utils.FormatTime(answer.CreatedAt),
+				IsAuthor:   l.svcCtx.IsOwnerComment(answer),
+			})
+		}
+		q.IsAnswered = len(q.Answers) > 0
+		questions = append(questions, q)
+	}
+
+	return &types.AmaThreadResponse{
+		Ama:       a.Data(time.Now(), len(questions)),
+		Questions: questions,
+	}, nil
+}
@@ -0,0 +1,197 @@
+package amas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/ban"
+	"silan-backend/internal/commentlang"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/spam"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitAmaQuestionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Ask a question in an open AMA session
+func NewSubmitAmaQuestionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitAmaQuestionLogic {
+	return &SubmitAmaQuestionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubmitAmaQuestionLogic) SubmitAmaQuestion(req *types.SubmitAmaQuestionRequest) (resp *types.SubmitAmaQuestionResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
+	if strings.TrimSpace(req.Content) == "" {
+		return nil, fmt.Errorf("content is required")
+	}
+
+	// Questions are only taken while the AMA is open
+	a, err := l.svcCtx.CheckAMAOpen(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	amaUUID, err := uuid.Parse(a.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid AMA id")
+	}
+
+	// Resolve author
+	authorName := req.AuthorName
+	authorEmail := req.AuthorEmail
+	if req.UserIdentityId != "" {
+		user, err := l.svcCtx.DB.UserIdentity.Get(l.ctx, req.UserIdentityId)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user identity")
+		}
+		authorName = user.DisplayName
+		authorEmail = user.Email
+	} else {
+		if authorName == "" {
+			return nil, fmt.Errorf("author_name is required for anonymous questions")
+		}
+		// The address format is checked by the request validator
+		if authorEmail == "" {
+			return nil, fmt.Errorf("author_email is required for anonymous questions")
+		}
+	}
+
+	userAgent := l.svcCtx.CommentUserAgent(l.ctx, req.Fingerprint, req.UserAgentFull)
+
+	// Banned visitors can't ask at all
+	if err := l.svcCtx.CheckBanned(l.ctx, ama.EntityType+":"+a.ID, ban.Actor{
+		IdentityID:  req.UserIdentityId,
+		Email:       authorEmail,
+		Fingerprint: req.Fingerprint,
+		IP:          req.ClientIP,
+	}); err != nil {
+		return nil, err
+	}
+
+	// A double click or resubmit gets the question already stored
+	existing, err := l.svcCtx.DuplicateComment(l.ctx, svc.CommentDraft{
+		EntityType:  ama.EntityType,
+		EntityID:    amaUUID,
+		IdentityID:  req.UserIdentityId,
+		Email:       authorEmail,
+		Fingerprint: req.Fingerprint,
+		Content:     req.Content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicate comments: %w", err)
+	}
+	if existing != nil {
+		l.Infof("Returned question %s for a duplicate submission (ip: %s)", existing.ID, req.ClientIP)
+		return questionData(existing, !existing.IsApproved), nil
+	}
+
+	// Floods of questions from one browser or address are refused
+	if err := l.svcCtx.CheckCommentRate(l.ctx, req.ClientIP, req.Fingerprint); err != nil {
+		return nil, err
+	}
+
+	// Anonymous questions need a solved captcha when one is configured
+	if err := l.svcCtx.CheckCaptcha(l.ctx, req.CaptchaToken, req.ClientIP, req.UserIdentityId); err != nil {
+		return nil, err
+	}
+
+	// Blocked words reject the question, are masked or hold it for approval
+	content, filterHeld, err := l.svcCtx.FilterComment(l.ctx, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	req.Content = content
+
+	// Questions go through the same moderation as comments
+	held, err := l.svcCtx.HoldComment(l.ctx, ama.EntityType, req.UserIdentityId, authorEmail, req.Fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check comment moderation: %w", err)
+	}
+	verdict, spamHeld := l.svcCtx.ScoreComment(l.ctx, spam.Submission{
+		EntityType:  ama.EntityType,
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		Content:     req.Content,
+		IP:          req.ClientIP,
+		UserAgent:   req.UserAgentFull,
+		Fingerprint: req.Fingerprint,
+	})
+	held = held || spamHeld || filterHeld
+
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	builder := tx.Comment.Create().
+		SetEntityType(ama.EntityType).
+		SetEntityID(amaUUID).
+		SetType("question").
+		SetAuthorName(authorName).
+		SetAuthorEmail(authorEmail).
+		SetContent(req.Content).
+		SetLanguage(commentlang.Detect(req.Content)).
+		SetIsApproved(!held).
+		SetLikesCount(0)
+	if req.ClientIP != "" {
+		builder = builder.SetIPAddress(req.ClientIP)
+	}
+	if userAgent != "" {
+		builder = builder.SetUserAgent(userAgent)
+	}
+	if req.UserIdentityId != "" {
+		builder = builder.SetUserIdentityID(req.UserIdentityId)
+	}
+	question, err := builder.Save(l.ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := l.svcCtx.PublishEvent(l.ctx, tx, outbox.EventCommentCreated, outbox.NewCommentEvent(question)); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record comment event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	l.svcCtx.RecordSpamScore(l.ctx, question.ID.String(), verdict)
+
+	// The asker hears about the answer by email once the address is
+	// confirmed
+	if req.NotifyReplies && question.AuthorEmail != "" {
+		if err := l.svcCtx.ReplyNotifier.Subscribe(l.ctx, question, question.AuthorEmail); err != nil {
+			l.Errorf("Failed to subscribe the author of question %s to replies: %v", question.ID, err)
+		}
+	}
+
+	return questionData(question, held), nil
+}
+
+// questionData builds the response for a stored question.
+func questionData(c *ent.Comment, pending bool) *types.SubmitAmaQuestionResponse {
+	return &types.SubmitAmaQuestionResponse{
+		ID:        c.ID.String(),
+		Content:   c.Content,
+		CreatedAt: utils.FormatTime(c.CreatedAt),
+		Pending:   pending,
+	}
+}
@@ -0,0 +1,40 @@
+package analytics
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAnalyticsOptOutStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the visitor's analytics opt-out status for the consent banner
+func NewGetAnalyticsOptOutStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAnalyticsOptOutStatusLogic {
+	return &GetAnalyticsOptOutStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAnalyticsOptOutStatusLogic) GetAnalyticsOptOutStatus(req *types.AnalyticsOptOutStatusRequest) (resp *types.AnalyticsOptOutStatusResponse, err error) {
+	return optOutStatus(req.Choice, req.BrowserDoNotTrack), nil
+}
+
+// optOutStatus combines the stored choice with the browser's own signal. A
+// browser sending DNT or Sec-GPC stays opted out whatever the banner says,
+// so the frontend can skip asking.
+func optOutStatus(choice string, browserDoNotTrack bool) *types.AnalyticsOptOutStatusResponse {
+	return &types.AnalyticsOptOutStatusResponse{
+		OptedOut:          choice == "out" || browserDoNotTrack,
+		Choice:            choice,
+		BrowserDoNotTrack: browserDoNotTrack,
+	}
+}
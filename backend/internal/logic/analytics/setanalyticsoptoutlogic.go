@@ -0,0 +1,33 @@
+package analytics
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetAnalyticsOptOutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Opt the visitor out of (or back into) analytics
+func NewSetAnalyticsOptOutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetAnalyticsOptOutLogic {
+	return &SetAnalyticsOptOutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetAnalyticsOptOutLogic) SetAnalyticsOptOut(req *types.SetAnalyticsOptOutRequest) (resp *types.AnalyticsOptOutStatusResponse, err error) {
+	choice := "in"
+	if req.OptOut {
+		choice = "out"
+	}
+	return optOutStatus(choice, req.BrowserDoNotTrack), nil
+}
@@ -0,0 +1,69 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// eventNamePattern keeps custom event names short and aggregatable
+var eventNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+
+const maxEventProperties = 20
+
+type TrackEventLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Record a custom analytics event
+func NewTrackEventLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TrackEventLogic {
+	return &TrackEventLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *TrackEventLogic) TrackEvent(req *types.TrackEventRequest) (resp *types.TrackEventResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
+	if !eventNamePattern.MatchString(req.Name) {
+		return nil, fmt.Errorf("invalid event name")
+	}
+	if len(req.Properties) > maxEventProperties {
+		return nil, fmt.Errorf("too many event properties (max %d)", maxEventProperties)
+	}
+
+	// Events from visitors who opted out of tracking are dropped
+	if req.DoNotTrack {
+		return &types.TrackEventResponse{Recorded: false}, nil
+	}
+
+	err = l.svcCtx.RecordEvent(l.ctx, svc.AnalyticsEvent{
+		Name:           req.Name,
+		Fingerprint:    req.Fingerprint,
+		UserIdentityID: req.UserIdentityId,
+		Path:           req.Path,
+		Properties:     req.Properties,
+		IP:             req.ClientIP,
+		UserAgent:      req.UserAgentFull,
+	})
+	if err != nil {
+		l.Errorf("Failed to record event %s: %v", req.Name, err)
+		return nil, fmt.Errorf("failed to record event")
+	}
+
+	return &types.TrackEventResponse{Recorded: true}, nil
+}
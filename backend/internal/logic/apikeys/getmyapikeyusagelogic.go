@@ -0,0 +1,57 @@
+package apikeys
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/apikey"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMyApiKeyUsageLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get usage and remaining quota of the calling API key
+func NewGetMyApiKeyUsageLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMyApiKeyUsageLogic {
+	return &GetMyApiKeyUsageLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMyApiKeyUsageLogic) GetMyApiKeyUsage(req *types.MyApiKeyUsageRequest) (resp *types.ApiKeyUsageResponse, err error) {
+	key, ok := apikey.FromContext(l.ctx)
+	if !ok {
+		return nil, fmt.Errorf("X-API-Key header is required")
+	}
+
+	report, err := l.svcCtx.ApiKeys.Report(l.ctx, key, req.Days)
+	if err != nil {
+		return nil, err
+	}
+
+	daily := make([]types.ApiKeyDailyUsage, 0, len(report.Daily))
+	for _, d := range report.Daily {
+		daily = append(daily, types.ApiKeyDailyUsage{Day: d.Day, Count: d.Count})
+	}
+	remainingToday, remainingMonth := report.Remaining()
+
+	return &types.ApiKeyUsageResponse{
+		KeyID:            key.ID,
+		Name:             key.Name,
+		DailyQuota:       key.DailyQuota,
+		MonthlyQuota:     key.MonthlyQuota,
+		UsedToday:        report.UsedToday,
+		UsedThisMonth:    report.UsedMonth,
+		RemainingToday:   remainingToday,
+		RemainingMonthly: remainingMonth,
+		Daily:            daily,
+	}, nil
+}
@@ -0,0 +1,88 @@
+package ask
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"silan-backend/internal/ask"
+	"silan-backend/internal/llm"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AskSiteLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Answer a question about the site from its posts, projects and ideas
+func NewAskSiteLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AskSiteLogic {
+	return &AskSiteLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// citationPattern matches the [n] source references in an answer.
+var citationPattern = regexp.MustCompile(`\[(\d+)\]`)
+
+// snippetChars is the length of the source excerpts returned to the client.
+const snippetChars = 240
+
+func (l *AskSiteLogic) AskSite(req *types.AskRequest) (resp *types.AskResponse, err error) {
+	if !l.svcCtx.LLM.Enabled() {
+		return nil, llm.ErrDisabled
+	}
+	if err := l.svcCtx.CheckQuestion(l.ctx, req.ClientIP, req.Fingerprint); err != nil {
+		return nil, err
+	}
+
+	question := strings.TrimSpace(req.Question)
+	chunks, err := l.svcCtx.Ask.Search(l.ctx, question, l.svcCtx.Config.Ask.Sources)
+	if err != nil {
+		l.Errorf("Failed to search content for question: %v", err)
+		return nil, fmt.Errorf("failed to answer question")
+	}
+
+	// Questions are reported alongside site searches
+	if err := l.svcCtx.LogSearch(l.ctx, "ask", question, req.Fingerprint, len(chunks)); err != nil {
+		l.Errorf("Failed to log question: %v", err)
+	}
+
+	resp = &types.AskResponse{Sources: make([]types.AskSource, 0, len(chunks))}
+	if len(chunks) == 0 {
+		resp.Answer = "I couldn't find anything on this site about that."
+		return resp, nil
+	}
+
+	resp.Answer, err = ask.Answer(l.ctx, l.svcCtx.LLM, question, chunks)
+	if err != nil {
+		l.Errorf("Failed to answer question: %v", err)
+		return nil, fmt.Errorf("failed to answer question")
+	}
+
+	cited := map[int]bool{}
+	for _, m := range citationPattern.FindAllStringSubmatch(resp.Answer, -1) {
+		n, _ := strconv.Atoi(m[1])
+		cited[n] = true
+	}
+	for i, c := range chunks {
+		resp.Sources = append(resp.Sources, types.AskSource{
+			Index:   i + 1,
+			Type:    c.Type,
+			ID:      c.ID,
+			Title:   c.Title,
+			URL:     c.URL,
+			Snippet: c.Snippet(snippetChars),
+			Cited:   cited[i+1],
+		})
+	}
+	return resp, nil
+}
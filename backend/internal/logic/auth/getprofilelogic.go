@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetProfileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the profile of the signed-in user
+func NewGetProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProfileLogic {
+	return &GetProfileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetProfileLogic) GetProfile(req *types.ProfileRequest) (resp *types.ProfileResponse, err error) {
+	identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := l.svcCtx.DB.UserIdentity.Get(l.ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+	return toProfileResponse(u), nil
+}
@@ -6,13 +6,16 @@ import (
 	"strings"
 	"time"
 
+	"silan-backend/internal/authlog"
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/googleauth"
+	"silan-backend/internal/session"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/google/uuid"
-	"github.com/golang-jwt/jwt/v4"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -31,36 +34,26 @@ func NewGoogleVerifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Goog
 	}
 }
 
-// GoogleClaims represents the claims in a Google ID token
-type GoogleClaims struct {
-	Email         string `json:"email"`
-	EmailVerified bool   `json:"email_verified"`
-	Name          string `json:"name"`
-	Picture       string `json:"picture"`
-	GivenName     string `json:"given_name"`
-	FamilyName    string `json:"family_name"`
-	Sub           string `json:"sub"` // User ID
-	Aud           string `json:"aud"` // Audience (client ID)
-	jwt.StandardClaims
-}
-
 func (l *GoogleVerifyLogic) GoogleVerify(req *types.GoogleVerifyRequest) (resp *types.GoogleVerifyResponse, err error) {
+	event := authlog.Event{
+		Type:      authlog.EventVerify,
+		Provider:  "google",
+		IP:        req.ClientIP,
+		UserAgent: req.UserAgentFull,
+	}
+	defer func() { l.svcCtx.RecordAuthEvent(l.ctx, event, err) }()
+
 	if req.IdToken == "" {
 		return nil, fmt.Errorf("id_token is required")
 	}
 
-	// Parse the JWT token without verification (Google signs it, we trust it for now)
-	// In production, you should verify the signature using Google's public keys
-	token, _, err := new(jwt.Parser).ParseUnverified(req.IdToken, &GoogleClaims{})
+	// Only tokens Google signed for this site's client are trusted
+	claims, err := l.svcCtx.Google.Verify(l.ctx, req.IdToken)
 	if err != nil {
-		l.Errorf("Failed to parse Google ID token: %v", err)
-		return nil, fmt.Errorf("failed to parse token: %v", err)
-	}
-
-	claims, ok := token.Claims.(*GoogleClaims)
-	if !ok {
-		return nil, fmt.Errorf("invalid token claims")
+		l.Errorf("Failed to verify Google ID token: %v", err)
+		return nil, err
 	}
+	event.Email = claims.Email
 
 	// Basic validation
 	if !claims.EmailVerified {
@@ -71,31 +64,47 @@ func (l *GoogleVerifyLogic) GoogleVerify(req *types.GoogleVerifyRequest) (resp *
 		return nil, fmt.Errorf("email not provided")
 	}
 
-	// Optional audience (client id) check if configured
-	if l.svcCtx.Config.Auth.GoogleClientID != "" {
-		if claims.Aud != l.svcCtx.Config.Auth.GoogleClientID {
-			return nil, fmt.Errorf("invalid audience")
-		}
-	}
-
 	// Upsert user identity
-	userIdentity, err := l.upsertUserIdentity("google", claims.Sub, claims)
+	userIdentity, err := l.upsertUserIdentity("google", claims.Subject, claims)
 	if err != nil {
 		l.Errorf("Failed to upsert user identity: %v", err)
 		return nil, fmt.Errorf("failed to process user identity")
 	}
 
-	return &types.GoogleVerifyResponse{
+	// Identities from other sign-ins with the same verified email act as one user
+	userIdentity, err = l.svcCtx.CanonicalIdentity(l.ctx, userIdentity)
+	if err != nil {
+		l.Errorf("Failed to link user identity: %v", err)
+		return nil, fmt.Errorf("failed to process user identity")
+	}
+
+	event.IdentityID = userIdentity.ID
+
+	resp = &types.GoogleVerifyResponse{
 		ID:        userIdentity.ID,
 		Email:     userIdentity.Email,
 		Name:      userIdentity.DisplayName,
 		AvatarURL: userIdentity.AvatarURL,
 		Provider:  userIdentity.Provider,
 		Verified:  userIdentity.Verified,
-	}, nil
+	}
+
+	// Start a backend session so the client stops re-sending the ID token
+	if l.svcCtx.Config.Auth.SessionSecret != "" {
+		tokens, err := l.svcCtx.StartSession(l.ctx, userIdentity.ID, session.MethodGoogle, req.UserAgentFull, req.ClientIP)
+		if err != nil {
+			l.Errorf("Failed to start session for %s: %v", userIdentity.ID, err)
+			return nil, fmt.Errorf("failed to create session")
+		}
+		resp.SessionToken = tokens.AccessToken
+		resp.SessionExpiresAt = utils.FormatTime(tokens.AccessExpiresAt)
+		resp.RefreshToken = tokens.RefreshToken
+		resp.RefreshExpiresAt = utils.FormatTime(tokens.RefreshExpiresAt)
+	}
+	return resp, nil
 }
 
-func (l *GoogleVerifyLogic) upsertUserIdentity(provider, externalID string, claims *GoogleClaims) (*ent.UserIdentity, error) {
+func (l *GoogleVerifyLogic) upsertUserIdentity(provider, externalID string, claims *googleauth.Claims) (*ent.UserIdentity, error) {
 	// Try to find existing identity
 	existing, err := l.svcCtx.DB.UserIdentity.
 		Query().
@@ -114,13 +123,8 @@ func (l *GoogleVerifyLogic) upsertUserIdentity(provider, externalID string, clai
 		if claims.Email != "" && existing.Email != claims.Email {
 			updateBuilder = updateBuilder.SetEmail(claims.Email)
 		}
-		if claims.Name != "" && existing.DisplayName != claims.Name {
-			updateBuilder = updateBuilder.SetDisplayName(claims.Name)
-		}
-		if claims.Picture != "" && existing.AvatarURL != claims.Picture {
-			updateBuilder = updateBuilder.SetAvatarURL(claims.Picture)
-		}
-		updateBuilder = updateBuilder.SetVerified(claims.EmailVerified)
+		updateBuilder = svc.SyncProviderProfile(updateBuilder, existing, claims.Name, claims.Picture).
+			SetVerified(claims.EmailVerified)
 
 		return updateBuilder.Save(l.ctx)
 	}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the active sessions of the signed-in user
+func NewListSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSessionsLogic {
+	return &ListSessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListSessionsLogic) ListSessions(req *types.SessionListRequest) (resp *types.SessionListResponse, err error) {
+	claims, err := l.svcCtx.RequireSession(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := l.svcCtx.Sessions.List(l.ctx, claims.IdentityID)
+	if err != nil {
+		l.Errorf("Failed to list sessions of %s: %v", claims.IdentityID, err)
+		return nil, fmt.Errorf("failed to list sessions")
+	}
+
+	resp = &types.SessionListResponse{Sessions: make([]types.SessionData, 0, len(sessions))}
+	for _, sess := range sessions {
+		resp.Sessions = append(resp.Sessions, types.SessionData{
+			ID:         sess.ID,
+			UserAgent:  sess.UserAgent,
+			IP:         sess.IP,
+			CreatedAt:  utils.FormatTime(sess.CreatedAt),
+			LastSeenAt: utils.FormatTime(sess.LastUsedAt),
+			ExpiresAt:  utils.FormatTime(sess.ExpiresAt),
+			Current:    sess.ID == claims.SessionID,
+		})
+	}
+	return resp, nil
+}
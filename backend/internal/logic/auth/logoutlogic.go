@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"context"
+
+	"silan-backend/internal/authlog"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LogoutLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Revoke the current session, or all sessions of the identity
+func NewLogoutLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LogoutLogic {
+	return &LogoutLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LogoutLogic) Logout(req *types.LogoutRequest) (resp *types.LogoutResponse, err error) {
+	identityID, revoked, err := l.svcCtx.EndSession(l.ctx, req.RefreshToken, req.SessionToken, req.All)
+	l.svcCtx.RecordAuthEvent(l.ctx, authlog.Event{
+		Type:       authlog.EventLogout,
+		Provider:   "session",
+		IdentityID: identityID,
+		IP:         req.ClientIP,
+		UserAgent:  req.UserAgentFull,
+	}, err)
+	if err != nil {
+		return nil, err
+	}
+	return &types.LogoutResponse{Revoked: revoked}, nil
+}
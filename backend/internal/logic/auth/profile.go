@@ -0,0 +1,19 @@
+package auth
+
+import (
+	"silan-backend/internal/ent"
+	"silan-backend/internal/types"
+)
+
+func toProfileResponse(u *ent.UserIdentity) *types.ProfileResponse {
+	return &types.ProfileResponse{
+		ID:          u.ID,
+		Email:       u.Email,
+		DisplayName: u.DisplayName,
+		AvatarURL:   u.AvatarURL,
+		Provider:    u.Provider,
+		Verified:    u.Verified,
+
+		DisplayNameEdited: u.DisplayNameEdited,
+	}
+}
@@ -0,0 +1,34 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/socialauth"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type QQVerifyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Sign in with a QQ Connect authorization code
+func NewQQVerifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *QQVerifyLogic {
+	return &QQVerifyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *QQVerifyLogic) QQVerify(req *types.SocialVerifyRequest) (resp *types.GoogleVerifyResponse, err error) {
+	if req.RedirectURI == "" {
+		return nil, fmt.Errorf("redirect_uri is required")
+	}
+	return socialVerify(l.ctx, l.svcCtx, socialauth.ProviderQQ, req)
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+
+	"silan-backend/internal/authlog"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RefreshSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Exchange a refresh token for a new session token
+func NewRefreshSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RefreshSessionLogic {
+	return &RefreshSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RefreshSessionLogic) RefreshSession(req *types.RefreshSessionRequest) (resp *types.SessionResponse, err error) {
+	tokens, err := l.svcCtx.RefreshSession(l.ctx, req.RefreshToken)
+	event := authlog.Event{
+		Type:      authlog.EventRefresh,
+		Provider:  "session",
+		IP:        req.ClientIP,
+		UserAgent: req.UserAgentFull,
+	}
+	if tokens != nil {
+		event.IdentityID = tokens.IdentityID
+	}
+	l.svcCtx.RecordAuthEvent(l.ctx, event, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.SessionResponse{
+		SessionToken:     tokens.AccessToken,
+		SessionExpiresAt: utils.FormatTime(tokens.AccessExpiresAt),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: utils.FormatTime(tokens.RefreshExpiresAt),
+	}, nil
+}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestEmailCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Email a sign-in code and magic link
+func NewRequestEmailCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestEmailCodeLogic {
+	return &RequestEmailCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RequestEmailCodeLogic) RequestEmailCode(req *types.EmailCodeRequest) (resp *types.EmailCodeResponse, err error) {
+	expiresAt, err := l.svcCtx.RequestEmailLogin(l.ctx, req.Email, req.ClientIP)
+	if err != nil {
+		l.Errorf("Failed to send sign-in code: %v", err)
+		return nil, err
+	}
+
+	return &types.EmailCodeResponse{Sent: true, ExpiresAt: utils.FormatTime(expiresAt)}, nil
+}
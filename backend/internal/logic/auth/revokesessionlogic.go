@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/session"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Revoke one session of the signed-in user
+func NewRevokeSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeSessionLogic {
+	return &RevokeSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeSessionLogic) RevokeSession(req *types.RevokeSessionRequest) (resp *types.LogoutResponse, err error) {
+	identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.Sessions.RevokeOwned(l.ctx, identityID, req.ID)
+	if errors.Is(err, session.ErrNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to revoke session %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to revoke session")
+	}
+	return &types.LogoutResponse{Revoked: 1}, nil
+}
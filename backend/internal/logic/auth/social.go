@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/authlog"
+	"silan-backend/internal/socialauth"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// socialVerify signs a visitor in with an authorization code of provider
+// and starts a session the same way Google sign-in does.
+func socialVerify(ctx context.Context, svcCtx *svc.ServiceContext, provider string, req *types.SocialVerifyRequest) (resp *types.GoogleVerifyResponse, err error) {
+	event := authlog.Event{
+		Type:      authlog.EventVerify,
+		Provider:  provider,
+		IP:        req.ClientIP,
+		UserAgent: req.UserAgentFull,
+	}
+	defer func() { svcCtx.RecordAuthEvent(ctx, event, err) }()
+
+	userIdentity, err := svcCtx.SocialLogin(ctx, provider, req.Code, req.RedirectURI)
+	if errors.Is(err, socialauth.ErrDisabled) || errors.Is(err, socialauth.ErrInvalidCode) {
+		return nil, err
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to verify %s sign-in: %v", provider, err)
+		return nil, fmt.Errorf("failed to verify %s sign-in", provider)
+	}
+	event.IdentityID = userIdentity.ID
+	event.Email = userIdentity.Email
+
+	resp = &types.GoogleVerifyResponse{
+		ID:        userIdentity.ID,
+		Email:     userIdentity.Email,
+		Name:      userIdentity.DisplayName,
+		AvatarURL: userIdentity.AvatarURL,
+		Provider:  userIdentity.Provider,
+		Verified:  userIdentity.Verified,
+	}
+
+	if svcCtx.Config.Auth.SessionSecret != "" {
+		tokens, err := svcCtx.StartSession(ctx, userIdentity.ID, provider, req.UserAgentFull, req.ClientIP)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to start session for %s: %v", userIdentity.ID, err)
+			return nil, fmt.Errorf("failed to create session")
+		}
+		resp.SessionToken = tokens.AccessToken
+		resp.SessionExpiresAt = utils.FormatTime(tokens.AccessExpiresAt)
+		resp.RefreshToken = tokens.RefreshToken
+		resp.RefreshExpiresAt = utils.FormatTime(tokens.RefreshExpiresAt)
+	}
+	return resp, nil
+}
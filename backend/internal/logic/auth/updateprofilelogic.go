@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UpdateProfileLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Update the display name and avatar of the signed-in user
+func NewUpdateProfileLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateProfileLogic {
+	return &UpdateProfileLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UpdateProfileLogic) UpdateProfile(req *types.UpdateProfileRequest) (resp *types.ProfileResponse, err error) {
+	identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	displayName := strings.TrimSpace(req.DisplayName)
+	avatarURL := strings.TrimSpace(req.AvatarURL)
+	if avatarURL != "" && !utils.IsHTTPURL(avatarURL) {
+		return nil, fmt.Errorf("avatar_url must be an absolute http(s) URL")
+	}
+	if displayName != "" && req.ResetDisplayName {
+		return nil, fmt.Errorf("display_name and reset_display_name are mutually exclusive")
+	}
+
+	current, err := l.svcCtx.DB.UserIdentity.Get(l.ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+	update := current.Update()
+	if displayName != "" {
+		// The name being replaced came from the provider; keep it for a reset
+		if !current.DisplayNameEdited && current.DisplayName != "" {
+			update.SetProviderDisplayName(current.DisplayName)
+		}
+		update.SetDisplayName(displayName).SetDisplayNameEdited(true)
+	}
+	// Names edited before provider names were recorded stay until the next
+	// sign-in brings the provider's
+	if req.ResetDisplayName && current.DisplayNameEdited {
+		if current.ProviderDisplayName != "" {
+			update.SetDisplayName(current.ProviderDisplayName)
+		}
+		update.SetDisplayNameEdited(false)
+	}
+	if avatarURL != "" {
+		update.SetAvatarURL(avatarURL).SetAvatarURLEdited(true)
+	}
+	u, err := update.Save(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toProfileResponse(u), nil
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/authlog"
+	"silan-backend/internal/session"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyEmailCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Sign in with an emailed code or magic link token
+func NewVerifyEmailCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyEmailCodeLogic {
+	return &VerifyEmailCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyEmailCodeLogic) VerifyEmailCode(req *types.EmailVerifyRequest) (resp *types.GoogleVerifyResponse, err error) {
+	event := authlog.Event{
+		Type:      authlog.EventLogin,
+		Provider:  "email",
+		Email:     req.Email,
+		IP:        req.ClientIP,
+		UserAgent: req.UserAgentFull,
+	}
+	defer func() { l.svcCtx.RecordAuthEvent(l.ctx, event, err) }()
+
+	if req.Token == "" && (req.Email == "" || req.Code == "") {
+		return nil, fmt.Errorf("email and code, or token, are required")
+	}
+
+	userIdentity, err := l.svcCtx.VerifyEmailLogin(l.ctx, req.Email, req.Code, req.Token)
+	if err != nil {
+		return nil, err
+	}
+	event.IdentityID = userIdentity.ID
+	event.Email = userIdentity.Email
+
+	resp = &types.GoogleVerifyResponse{
+		ID:        userIdentity.ID,
+		Email:     userIdentity.Email,
+		Name:      userIdentity.DisplayName,
+		AvatarURL: userIdentity.AvatarURL,
+		Provider:  userIdentity.Provider,
+		Verified:  userIdentity.Verified,
+	}
+
+	// Same session handling as Google sign-in
+	if l.svcCtx.Config.Auth.SessionSecret != "" {
+		tokens, err := l.svcCtx.StartSession(l.ctx, userIdentity.ID, session.MethodEmailCode, req.UserAgentFull, req.ClientIP)
+		if err != nil {
+			l.Errorf("Failed to start session for %s: %v", userIdentity.ID, err)
+			return nil, fmt.Errorf("failed to create session")
+		}
+		resp.SessionToken = tokens.AccessToken
+		resp.SessionExpiresAt = utils.FormatTime(tokens.AccessExpiresAt)
+		resp.RefreshToken = tokens.RefreshToken
+		resp.RefreshExpiresAt = utils.FormatTime(tokens.RefreshExpiresAt)
+	}
+	return resp, nil
+}
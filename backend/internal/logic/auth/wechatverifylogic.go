@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"context"
+
+	"silan-backend/internal/socialauth"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type WeChatVerifyLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Sign in with a WeChat website login authorization code
+func NewWeChatVerifyLogic(ctx context.Context, svcCtx *svc.ServiceContext) *WeChatVerifyLogic {
+	return &WeChatVerifyLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *WeChatVerifyLogic) WeChatVerify(req *types.SocialVerifyRequest) (resp *types.GoogleVerifyResponse, err error) {
+	return socialVerify(l.ctx, l.svcCtx, socialauth.ProviderWeChat, req)
+}
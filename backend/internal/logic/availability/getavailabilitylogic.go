@@ -0,0 +1,41 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/availability"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetAvailabilityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the owner's availability and upcoming office hours
+func NewGetAvailabilityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetAvailabilityLogic {
+	return &GetAvailabilityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetAvailabilityLogic) GetAvailability() (resp *types.AvailabilityData, err error) {
+	st, err := l.svcCtx.Availability.Status(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	slots, err := l.svcCtx.Availability.Upcoming(l.ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	data := availability.Data(st, slots, now)
+	return &data, nil
+}
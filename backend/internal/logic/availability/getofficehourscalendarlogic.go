@@ -0,0 +1,36 @@
+package availability
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/availability"
+	"silan-backend/internal/ical"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetOfficeHoursCalendarLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// iCalendar feed of upcoming office hours
+func NewGetOfficeHoursCalendarLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetOfficeHoursCalendarLogic {
+	return &GetOfficeHoursCalendarLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetOfficeHoursCalendarLogic) GetOfficeHoursCalendar() ([]byte, error) {
+	now := time.Now()
+	slots, err := l.svcCtx.Availability.Upcoming(l.ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	return availability.ICS(slots, ical.Host(l.svcCtx.Config.Site.BaseURL), now), nil
+}
@@ -5,15 +5,18 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
-	"time"
 
+	"silan-backend/internal/ban"
+	"silan-backend/internal/commentlang"
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/spam"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/google/uuid"
-	"github.com/golang-jwt/jwt/v4"
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
@@ -33,6 +36,13 @@ func NewCreateBlogCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentRequest) (resp *types.BlogCommentData, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	if req.Content == "" {
 		return nil, fmt.Errorf("content is required")
 	}
@@ -50,15 +60,24 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 			return nil, fmt.Errorf("invalid parent_id format")
 		}
 
+		if err := l.svcCtx.Legacy.CopyComment(l.ctx, pid.String()); err != nil {
+			l.Errorf("Failed to copy legacy comment %s: %v", pid, err)
+		}
+
 		// Check if parent comment exists and belongs to the same post
 		parentComment, err := l.svcCtx.DB.Comment.Get(l.ctx, pid)
 		if err != nil {
 			return nil, fmt.Errorf("parent comment not found")
 		}
-		if parentComment.EntityID != postID {
+		if parentComment.EntityType != "blog" || parentComment.EntityID != postID {
 			return nil, fmt.Errorf("parent comment belongs to different post")
 		}
 
+		// Replies past the depth limit are attached one level up
+		pid, err = l.svcCtx.ReplyParent(l.ctx, parentComment)
+		if err != nil {
+			return nil, err
+		}
 		parentID = &pid
 	}
 
@@ -66,18 +85,23 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 	var userIdentity *ent.UserIdentity
 	var authorName, authorEmail, avatarURL string
 
-	// If user provides an ID token, verify and get user info
-	if req.IdToken != "" {
+	// If user provides an ID token, verify and get user info. Clients with a
+	// session token skip this; the raw ID token is kept for older clients.
+	if req.IdToken != "" && req.SessionToken == "" {
 		userIdentity, err = l.verifyAndGetUser(req.IdToken)
 		if err != nil {
 			return nil, fmt.Errorf("token verification failed: %v", err)
 		}
+		userIdentity, err = l.svcCtx.CanonicalIdentity(l.ctx, userIdentity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve user identity: %v", err)
+		}
 		authorName = userIdentity.DisplayName
 		authorEmail = userIdentity.Email
 		avatarURL = userIdentity.AvatarURL
-	} else if req.UserIdentityId != "" && strings.TrimSpace(req.UserIdentityId) != "" {
-		// If user provides identity ID, validate it exists
-		userIdentity, err = l.svcCtx.DB.UserIdentity.Get(l.ctx, req.UserIdentityId)
+	} else if identityID != "" {
+		// Signed in with a session; a bare user_identity_id is never trusted
+		userIdentity, err = l.svcCtx.DB.UserIdentity.Get(l.ctx, identityID)
 		if err != nil {
 			return nil, fmt.Errorf("invalid user identity")
 		}
@@ -89,12 +113,10 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 		if req.AuthorName == "" {
 			return nil, fmt.Errorf("author_name is required for anonymous comments")
 		}
+		// The address format is checked by the request validator
 		if req.AuthorEmail == "" {
 			return nil, fmt.Errorf("author_email is required for anonymous comments")
 		}
-		if !strings.Contains(req.AuthorEmail, "@") || len(req.AuthorEmail) < 5 {
-			return nil, fmt.Errorf("author_email format is invalid")
-		}
 		authorName = req.AuthorName
 		authorEmail = req.AuthorEmail
 		// Try to get avatar from existing user identities
@@ -102,19 +124,96 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 	}
 
 	// Prepare user agent string with fingerprint and browser info
-	userAgent := "fp:" + req.Fingerprint
-	if req.UserAgentFull != "" {
-		userAgent += " | " + req.UserAgentFull
+	userAgent := l.svcCtx.CommentUserAgent(l.ctx, req.Fingerprint, req.UserAgentFull)
+
+	var identityIDStr string
+	if userIdentity != nil {
+		identityIDStr = userIdentity.ID
+	}
+
+	// Banned visitors can't comment at all
+	if err := l.svcCtx.CheckBanned(l.ctx, "blog:"+req.ID, ban.Actor{
+		IdentityID:  identityIDStr,
+		Email:       authorEmail,
+		Fingerprint: req.Fingerprint,
+		IP:          req.ClientIP,
+	}); err != nil {
+		return nil, err
+	}
+
+	// A double click or resubmit gets the comment already stored
+	existing, err := l.svcCtx.DuplicateComment(l.ctx, svc.CommentDraft{
+		EntityType:  "blog",
+		EntityID:    postID,
+		ParentID:    parentID,
+		IdentityID:  identityIDStr,
+		Email:       authorEmail,
+		Fingerprint: req.Fingerprint,
+		Content:     req.Content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicate comments: %w", err)
+	}
+	if existing != nil {
+		l.Infof("Returned comment %s for a duplicate submission (ip: %s)", existing.ID, req.ClientIP)
+		return l.commentData(existing, avatarURL, !existing.IsApproved), nil
+	}
+
+	// Floods of comments from one browser or address are refused
+	if err := l.svcCtx.CheckCommentRate(l.ctx, req.ClientIP, req.Fingerprint); err != nil {
+		return nil, err
+	}
+
+	// Anonymous comments need a solved captcha when one is configured
+	if err := l.svcCtx.CheckCaptcha(l.ctx, req.CaptchaToken, req.ClientIP, identityIDStr); err != nil {
+		return nil, err
+	}
+
+	// Blocked words reject the comment, are masked or hold it for approval
+	content, filterHeld, err := l.svcCtx.FilterComment(l.ctx, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	req.Content = content
+
+	// The first comment of an unknown author waits for approval
+	held, err := l.svcCtx.HoldComment(l.ctx, "blog", identityIDStr, authorEmail, req.Fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check comment moderation: %w", err)
+	}
+
+	// Likely spam waits for approval as well
+	verdict, spamHeld := l.svcCtx.ScoreComment(l.ctx, spam.Submission{
+		EntityType:  "blog",
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		Content:     req.Content,
+		IP:          req.ClientIP,
+		UserAgent:   req.UserAgentFull,
+		Fingerprint: req.Fingerprint,
+	})
+	held = held || spamHeld || filterHeld
+
+	// Owner comments get the author badge
+	isAuthor := false
+	if userIdentity != nil {
+		isAuthor = l.svcCtx.IsOwnerIdentity(l.ctx, userIdentity.ID)
 	}
 
-	// Create comment
-	createBuilder := l.svcCtx.DB.Comment.Create().
+	// Create comment; it is written together with its outbox event
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	createBuilder := tx.Comment.Create().
 		SetEntityType("blog").
 		SetEntityID(postID).
 		SetAuthorName(authorName).
 		SetAuthorEmail(authorEmail).
 		SetContent(req.Content).
-		SetIsApproved(true).
+		SetLanguage(commentlang.Detect(req.Content)).
+		SetIsApproved(!held).
 		SetUserAgent(userAgent)
 
 	// Set IP address if provided
@@ -127,13 +226,37 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 	}
 
 	if userIdentity != nil {
-		createBuilder = createBuilder.SetUserIdentityID(userIdentity.ID)
+		createBuilder = createBuilder.
+			SetUserIdentityID(userIdentity.ID).
+			SetIsAuthor(isAuthor)
 	}
 
 	c, err := createBuilder.Save(l.ctx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
+	if err := l.svcCtx.PublishEvent(l.ctx, tx, outbox.EventCommentCreated, outbox.NewCommentEvent(c)); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record comment event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	l.svcCtx.RecordSpamScore(l.ctx, c.ID.String(), verdict)
+
+	// Follow the thread, or the whole page, by email; the address is
+	// confirmed before any notification is sent
+	if req.NotifyReplies && c.AuthorEmail != "" {
+		if err := l.svcCtx.ReplyNotifier.Subscribe(l.ctx, c, c.AuthorEmail); err != nil {
+			l.Errorf("Failed to subscribe the author of comment %s to replies: %v", c.ID, err)
+		}
+	}
+	if req.NotifyComments && c.AuthorEmail != "" {
+		if err := l.svcCtx.ReplyNotifier.SubscribePage(l.ctx, c, c.AuthorEmail); err != nil {
+			l.Errorf("Failed to subscribe the author of comment %s to the page: %v", c.ID, err)
+		}
+	}
 
 	// Log the comment creation for audit trail
 	commentType := "root"
@@ -148,14 +271,14 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 	l.Infof("Created %s comment %s by %s user (author: %s, ip: %s, fingerprint: %s)",
 		commentType, c.ID, userType, authorName, req.ClientIP, req.Fingerprint)
 
-	var parentIDStr string
-	if parentID != nil {
-		parentIDStr = parentID.String()
-	}
+	return l.commentData(c, avatarURL, held), nil
+}
 
-	var userIdentityIDStr string
-	if userIdentity != nil {
-		userIdentityIDStr = userIdentity.ID
+// commentData builds the response for a stored comment.
+func (l *CreateBlogCommentLogic) commentData(c *ent.Comment, avatarURL string, pending bool) *types.BlogCommentData {
+	var parentIDStr string
+	if c.ParentID != (uuid.UUID{}) {
+		parentIDStr = c.ParentID.String()
 	}
 
 	return &types.BlogCommentData{
@@ -165,56 +288,30 @@ func (l *CreateBlogCommentLogic) CreateBlogComment(req *types.CreateBlogCommentR
 		AuthorName:     c.AuthorName,
 		AuthorAvatarURL: avatarURL,
 		Content:        c.Content,
-		CreatedAt:      c.CreatedAt.Format(time.RFC3339),
-		UserIdentityID: userIdentityIDStr,
+		CreatedAt:      utils.FormatTime(c.CreatedAt),
+		UserIdentityID: c.UserIdentityID,
+		IsAuthor:       l.svcCtx.IsOwnerComment(c),
+		Pending:        pending,
 		Replies:        []types.BlogCommentData{},
-	}, nil
-}
-
-// GoogleClaims represents the claims in a Google ID token
-type GoogleClaims struct {
-	Email         string `json:"email"`
-	EmailVerified bool   `json:"email_verified"`
-	Name          string `json:"name"`
-	Picture       string `json:"picture"`
-	GivenName     string `json:"given_name"`
-	FamilyName    string `json:"family_name"`
-	Sub           string `json:"sub"` // User ID
-	Aud           string `json:"aud"` // Audience (client ID)
-	jwt.StandardClaims
+	}
 }
 
 func (l *CreateBlogCommentLogic) verifyAndGetUser(idToken string) (*ent.UserIdentity, error) {
-	// Parse the JWT token without verification (Google signs it, we trust it for now)
-	// In production, you should verify the signature using Google's public keys
-	token, _, err := new(jwt.Parser).ParseUnverified(idToken, &GoogleClaims{})
+	// Only tokens Google signed for this site's client are trusted
+	claims, err := l.svcCtx.Google.Verify(l.ctx, idToken)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse token: %v", err)
-	}
-
-	claims, ok := token.Claims.(*GoogleClaims)
-	if !ok {
-		return nil, fmt.Errorf("invalid token claims")
+		return nil, err
 	}
-
-	// Basic validation
 	if !claims.EmailVerified {
 		return nil, fmt.Errorf("email not verified")
 	}
 
-	// Optional audience (client id) check if configured
-	if l.svcCtx.Config.Auth.GoogleClientID != "" {
-		if claims.Aud != l.svcCtx.Config.Auth.GoogleClientID {
-			return nil, fmt.Errorf("invalid audience")
-		}
-	}
-
 	// Find or create user identity
 	existingUser, err := l.svcCtx.DB.UserIdentity.
 		Query().
 		Where(
 			useridentity.ProviderEQ("google"),
-			useridentity.ExternalIDEQ(claims.Sub),
+			useridentity.ExternalIDEQ(claims.Subject),
 		).
 		First(l.ctx)
 
@@ -225,13 +322,8 @@ func (l *CreateBlogCommentLogic) verifyAndGetUser(idToken string) (*ent.UserIden
 		if claims.Email != "" && existingUser.Email != claims.Email {
 			updateBuilder = updateBuilder.SetEmail(claims.Email)
 		}
-		if claims.Name != "" && existingUser.DisplayName != claims.Name {
-			updateBuilder = updateBuilder.SetDisplayName(claims.Name)
-		}
-		if claims.Picture != "" && existingUser.AvatarURL != claims.Picture {
-			updateBuilder = updateBuilder.SetAvatarURL(claims.Picture)
-		}
-		updateBuilder = updateBuilder.SetVerified(claims.EmailVerified)
+		updateBuilder = svc.SyncProviderProfile(updateBuilder, existingUser, claims.Name, claims.Picture).
+			SetVerified(claims.EmailVerified)
 
 		updatedUser, updateErr := updateBuilder.Save(l.ctx)
 		if updateErr != nil {
@@ -246,7 +338,7 @@ func (l *CreateBlogCommentLogic) verifyAndGetUser(idToken string) (*ent.UserIden
 		Create().
 		SetID(l.generateUserID()).
 		SetProvider("google").
-		SetExternalID(claims.Sub)
+		SetExternalID(claims.Subject)
 
 	if claims.Email != "" {
 		createBuilder = createBuilder.SetEmail(claims.Email)
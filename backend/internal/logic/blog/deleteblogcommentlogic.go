@@ -3,7 +3,6 @@ package blog
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"silan-backend/internal/ent/comment"
 	"silan-backend/internal/svc"
@@ -29,11 +28,22 @@ func NewDeleteBlogCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *DeleteBlogCommentLogic) DeleteBlogComment(req *types.DeleteBlogCommentRequest) error {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return err
+	}
+	req.UserIdentityId = identityID
+
 	cid, err := uuid.Parse(req.CommentID)
 	if err != nil {
 		return err
 	}
 
+	if err := l.svcCtx.Legacy.CopyComment(l.ctx, cid.String()); err != nil {
+		l.Errorf("Failed to copy legacy comment %s: %v", cid, err)
+	}
+
 	c, err := l.svcCtx.DB.Comment.Query().Where(comment.IDEQ(cid), comment.EntityTypeEQ("blog")).Only(l.ctx)
 	if err != nil {
 		return err
@@ -48,7 +58,7 @@ func (l *DeleteBlogCommentLogic) DeleteBlogComment(req *types.DeleteBlogCommentR
 	}
 
 	// Method 2: Check fingerprint for anonymous users (fallback)
-	if !authorized && req.Fingerprint != "" && strings.Contains(c.UserAgent, "fp:"+req.Fingerprint) {
+	if !authorized && l.svcCtx.IsCommentAuthor(l.ctx, c, "", req.Fingerprint) {
 		authorized = true
 	}
 
@@ -62,34 +72,13 @@ func (l *DeleteBlogCommentLogic) DeleteBlogComment(req *types.DeleteBlogCommentR
 	l.Infof("User authorized to delete comment %s (userID: %s, ip: %s, fingerprint: %s)",
 		req.CommentID, req.UserIdentityId, req.ClientIP, req.Fingerprint)
 
-	// Delete the comment and all its replies (cascade delete)
-	return l.deleteCommentWithReplies(cid)
-}
-
-// deleteCommentWithReplies recursively deletes a comment and all its replies
-func (l *DeleteBlogCommentLogic) deleteCommentWithReplies(commentID uuid.UUID) error {
-	// First, find all direct replies to this comment
-	replies, err := l.svcCtx.DB.Comment.
-		Query().
-		Where(comment.ParentIDEQ(commentID), comment.EntityTypeEQ("blog")).
-		All(l.ctx)
+	// Replies by others stay in the thread under a tombstone
+	tombstoned, err := l.svcCtx.DeleteComment(l.ctx, c)
 	if err != nil {
-		return fmt.Errorf("failed to find replies: %v", err)
+		return err
 	}
-
-	// Recursively delete all replies first
-	for _, reply := range replies {
-		if err := l.deleteCommentWithReplies(reply.ID); err != nil {
-			return fmt.Errorf("failed to delete reply %s: %v", reply.ID, err)
-		}
+	if tombstoned {
+		l.Infof("Replaced comment %s with a tombstone", cid)
 	}
-
-	// Finally, delete the comment itself
-	err = l.svcCtx.DB.Comment.DeleteOneID(commentID).Exec(l.ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete comment %s: %v", commentID, err)
-	}
-
-	l.Infof("Deleted comment %s and %d replies", commentID, len(replies))
 	return nil
 }
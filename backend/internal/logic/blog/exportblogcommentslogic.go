@@ -0,0 +1,105 @@
+package blog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"silan-backend/internal/commentexport"
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/tombstone"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ExportBlogCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Export the comment thread of a blog post as Markdown or JSON
+func NewExportBlogCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ExportBlogCommentsLogic {
+	return &ExportBlogCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ExportBlogCommentsLogic) ExportBlogComments(req *types.BlogCommentExportRequest) ([]byte, error) {
+	postID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, errors.New("invalid post id")
+	}
+	post, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.ID(postID), blogpost.StatusEQ(blogpost.StatusPublished)).
+		Only(l.ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("post not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Pick up comments that still only exist in the legacy table
+	if err := l.svcCtx.Legacy.CopyPost(l.ctx, postID.String()); err != nil {
+		l.Errorf("Failed to copy legacy comments of post %s: %v", postID, err)
+	}
+
+	list, err := l.svcCtx.DB.Comment.Query().
+		Where(comment.EntityIDEQ(postID), comment.EntityTypeEQ("blog"), comment.IsApproved(true)).
+		Order(svc.CommentOrder("oldest")...).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Replies are attached to their parent, flat past the depth limit;
+	// replies whose parent is gone are kept at the top level rather than
+	// dropped
+	nodes := make(map[uuid.UUID]*commentexport.Comment, len(list))
+	for _, c := range list {
+		node := &commentexport.Comment{
+			ID:         c.ID.String(),
+			AuthorName: c.AuthorName,
+			IsAuthor:   l.svcCtx.IsOwnerComment(c),
+			Content:    c.Content,
+			CreatedAt:  c.CreatedAt,
+			Replies:    []*commentexport.Comment{},
+		}
+		if c.DeletedAt != nil {
+			node.AuthorName = tombstone.Placeholder
+			node.Content = tombstone.Placeholder
+			node.Deleted = true
+		} else if c.EditedAt != nil {
+			node.EditedAt = c.EditedAt
+		}
+		nodes[c.ID] = node
+	}
+	thread := &commentexport.Thread{
+		PostID:     postID.String(),
+		Title:      post.Title,
+		URL:        commentsub.PageURL(l.svcCtx.Config.Site.BaseURL, "blog", postID.String()),
+		ExportedAt: time.Now().UTC(),
+		Total:      len(list),
+		Comments:   []*commentexport.Comment{},
+	}
+	parents := l.svcCtx.ThreadParents(list)
+	for _, c := range list {
+		parentID, _ := uuid.Parse(parents[c.ID.String()])
+		if parent, ok := nodes[parentID]; ok && parentID != uuid.Nil {
+			parent.Replies = append(parent.Replies, nodes[c.ID])
+		} else {
+			thread.Comments = append(thread.Comments, nodes[c.ID])
+		}
+	}
+
+	return commentexport.Encode(req.Format, thread)
+}
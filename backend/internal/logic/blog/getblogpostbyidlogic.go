@@ -2,9 +2,13 @@ package blog
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/mapper"
+	"silan-backend/internal/preview"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -44,71 +48,22 @@ func (l *GetBlogPostByIdLogic) GetBlogPostById(req *types.BlogByIdRequest) (resp
 	if err != nil {
 		return nil, err
 	}
-
-	// Convert to response format
-	var publishDate string
-	if !post.PublishedAt.IsZero() {
-		publishDate = post.PublishedAt.Format("2006-01-02")
-	}
-
-	var readTime string
-	if post.ReadingTimeMinutes > 0 {
-		readTime = fmt.Sprintf("%d min read", post.ReadingTimeMinutes)
-	}
-
-	var category string
-	if post.Edges.Category != nil {
-		category = post.Edges.Category.Name
-	}
-
-	var tags []string
-	for _, tag := range post.Edges.Tags {
-		tags = append(tags, tag.Name)
+	// Unpublished posts are only shown with a preview token for them
+	if post.Status != blogpost.StatusPublished && !l.svcCtx.CanPreview(req.Preview, preview.KindBlog, post.ID.String()) {
+		return nil, errors.New("blog post not found")
 	}
 
-	var author string
-	if post.Edges.User != nil {
-		author = post.Edges.User.FirstName + " " + post.Edges.User.LastName
-	}
-
-	// Parse content - create structured blog content
-	content := []types.BlogContent{
-		{
-			Type:    "text",
-			Content: post.Content,
-			ID:      post.ID.String() + "-content",
-		},
+	variants, err := l.svcCtx.HeroVariants(l.ctx, post)
+	if err != nil {
+		return nil, err
 	}
-
-	// Add series information if this is part of a series
-	var seriesID, seriesTitle, seriesDescription string
-	var episodeNumber, totalEpisodes int
-	if post.Edges.Series != nil {
-		seriesID = post.Edges.Series.ID.String()
-		seriesTitle = post.Edges.Series.Title
-		seriesDescription = post.Edges.Series.Description
-		episodeNumber = post.SeriesOrder
-		totalEpisodes = post.Edges.Series.EpisodeCount
+	data := mapper.BlogPostDetail(post)
+	data.HeroVariants = variants[data.ID]
+	l.svcCtx.AttachAudio(l.ctx, &data)
+	if n, err := l.svcCtx.Readers.Today(l.ctx, data.ID, time.Now()); err != nil {
+		l.Errorf("Failed to count today's readers of post %s: %v", data.ID, err)
+	} else {
+		data.ReadersToday = n
 	}
-
-	return &types.BlogData{
-		ID:                post.ID.String(),
-		Title:             post.Title,
-		Slug:              post.Slug,
-		Author:            author,
-		PublishDate:       publishDate,
-		ReadTime:          readTime,
-		Category:          category,
-		Tags:              tags,
-		Content:           content,
-		Likes:             int64(post.LikeCount),
-		Views:             int64(post.ViewCount),
-		Summary:           post.Excerpt,
-		Type:              string(post.ContentType),
-		SeriesID:          seriesID,
-		SeriesTitle:       seriesTitle,
-		SeriesDescription: seriesDescription,
-		EpisodeNumber:     episodeNumber,
-		TotalEpisodes:     totalEpisodes,
-	}, nil
+	return &data, nil
 }
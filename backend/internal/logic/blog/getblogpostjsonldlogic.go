@@ -0,0 +1,57 @@
+package blog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/mapper"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetBlogPostJsonLdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get schema.org BlogPosting JSON-LD of a published post
+func NewGetBlogPostJsonLdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetBlogPostJsonLdLogic {
+	return &GetBlogPostJsonLdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetBlogPostJsonLdLogic) GetBlogPostJsonLd(req *types.JsonLdRequest) (resp *types.BlogPostingJsonLd, err error) {
+	post, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.Slug(req.Slug), blogpost.StatusEQ(blogpost.StatusPublished)).
+		WithUser().
+		WithCategory().
+		WithTags().
+		First(l.ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("blog post not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Without personal info the author falls back to the user record
+	author, err := l.svcCtx.OwnerJsonLd(l.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		l.Errorf("Failed to load site owner for JSON-LD: %v", err)
+		return nil, fmt.Errorf("failed to load post author")
+	}
+
+	data := mapper.BlogPostingJsonLd(post, author, strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/"))
+	return &data, nil
+}
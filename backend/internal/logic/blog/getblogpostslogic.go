@@ -2,13 +2,13 @@ package blog
 
 import (
 	"context"
-	"fmt"
 	"math"
 	"sort"
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/blogcategory"
 	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/mapper"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -126,82 +126,20 @@ func (l *GetBlogPostsLogic) GetBlogPosts(req *types.BlogListRequest) (resp *type
 		posts = allFilteredPosts[offset:end]
 	}
 
-	var result []types.BlogData
-	for _, post := range posts {
-		var publishDate string
-		if !post.PublishedAt.IsZero() {
-			publishDate = post.PublishedAt.Format("2006-01-02")
-		}
-
-		var readTime string
-		if post.ReadingTimeMinutes > 0 {
-			readTime = fmt.Sprintf("%d min read", post.ReadingTimeMinutes)
-		}
-
-		var category string
-		if post.Edges.Category != nil {
-			category = post.Edges.Category.Name
-		}
-
-		var tags []string
-		for _, tag := range post.Edges.Tags {
-			tags = append(tags, tag.Name)
-		}
-
-		var author string
-		if post.Edges.User != nil {
-			author = post.Edges.User.FirstName + " " + post.Edges.User.LastName
-		}
-
-		// Handle language-specific content
-		title := post.Title
-		excerpt := post.Excerpt
-		
-		// If requesting non-English content, look for translations
-		if req.Language != "en" && post.Edges.Translations != nil {
-			for _, translation := range post.Edges.Translations {
-				if translation.LanguageCode == req.Language {
-					title = translation.Title
-					excerpt = translation.Excerpt
-					break
-				}
-			}
-		}
+	variants, err := l.svcCtx.HeroVariants(l.ctx, posts...)
+	if err != nil {
+		return nil, err
+	}
 
-		// Add series information if this is part of a series
-		var seriesID, seriesTitle, seriesDescription string
-		var episodeNumber, totalEpisodes int
-		var contentType string
+	result := make([]types.BlogData, 0, len(posts))
+	for _, post := range posts {
+		data := mapper.BlogPost(post, req.Language)
+		data.HeroVariants = variants[data.ID]
+		// Posts in a series are listed as episodes
 		if post.Edges.Series != nil {
-			seriesID = post.Edges.Series.ID.String()
-			seriesTitle = post.Edges.Series.Title
-			seriesDescription = post.Edges.Series.Description
-			episodeNumber = post.SeriesOrder
-			totalEpisodes = post.Edges.Series.EpisodeCount
-			contentType = "episode"  // Override type for posts with series
-		} else {
-			contentType = string(post.ContentType)
+			data.Type = "episode"
 		}
-
-		result = append(result, types.BlogData{
-			ID:                post.ID.String(),
-			Title:             title,
-			Slug:              post.Slug,
-			Author:            author,
-			PublishDate:       publishDate,
-			ReadTime:          readTime,
-			Category:          category,
-			Tags:              tags,
-			Likes:             int64(post.LikeCount),
-			Views:             int64(post.ViewCount),
-			Summary:           excerpt,
-			Type:              contentType,
-			SeriesID:          seriesID,
-			SeriesTitle:       seriesTitle,
-			SeriesDescription: seriesDescription,
-			EpisodeNumber:     episodeNumber,
-			TotalEpisodes:     totalEpisodes,
-		})
+		result = append(result, data)
 	}
 
 	totalPages := int(math.Ceil(float64(total) / float64(req.Size)))
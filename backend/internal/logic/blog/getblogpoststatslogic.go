@@ -0,0 +1,72 @@
+package blog
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// completedScrollDepth is the scroll depth from which a read counts as complete.
+const completedScrollDepth = 90
+
+type GetBlogPostStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get engagement stats of a blog post
+func NewGetBlogPostStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetBlogPostStatsLogic {
+	return &GetBlogPostStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetBlogPostStatsLogic) GetBlogPostStats(req *types.BlogPostStatsRequest) (resp *types.BlogPostStatsResponse, err error) {
+	postID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid post id")
+	}
+
+	post, err := l.svcCtx.DB.BlogPost.Get(l.ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		readers            int
+		completed          sql.NullInt64
+		avgDepth, avgDwell sql.NullFloat64
+	)
+	err = l.svcCtx.RawDB.QueryRowContext(l.ctx, l.svcCtx.Rebind(
+		`SELECT COUNT(*), SUM(CASE WHEN max_scroll_depth >= ? THEN 1 ELSE 0 END),
+			AVG(max_scroll_depth), AVG(dwell_seconds)
+		FROM reading_progress WHERE post_id = ?`),
+		completedScrollDepth, post.ID.String(),
+	).Scan(&readers, &completed, &avgDepth, &avgDwell)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.BlogPostStatsResponse{
+		PostID:          post.ID.String(),
+		Views:           post.ViewCount,
+		Likes:           post.LikeCount,
+		Comments:        post.CommentCount,
+		Readers:         readers,
+		AvgScrollDepth:  avgDepth.Float64,
+		AvgDwellSeconds: avgDwell.Float64,
+	}
+	if readers > 0 {
+		resp.CompletionRate = float64(completed.Int64) / float64(readers)
+	}
+	return resp, nil
+}
@@ -9,6 +9,7 @@ import (
 	"silan-backend/internal/ent/blogseries"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -146,7 +147,7 @@ func (l *GetBlogSeriesLogic) GetBlogSeries(req *types.BlogSeriesRequest) (resp *
 		Episodes:       episodes,
 		TotalDuration:  totalDuration,
 		CompletedCount: completedCount,
-		CreatedAt:      series.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:      series.UpdatedAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:      utils.FormatTime(series.CreatedAt),
+		UpdatedAt:      utils.FormatTime(series.UpdatedAt),
 	}, nil
 }
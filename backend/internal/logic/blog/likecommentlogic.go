@@ -6,6 +6,7 @@ import (
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/outbox"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -29,11 +30,22 @@ func NewLikeCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikeC
 }
 
 func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *types.LikeCommentResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	commentID, err := uuid.Parse(req.CommentID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid comment ID: %w", err)
 	}
 
+	if err := l.svcCtx.Legacy.CopyComment(l.ctx, commentID.String()); err != nil {
+		l.Errorf("Failed to copy legacy comment %s: %v", commentID, err)
+	}
+
 	// Check if comment exists
 	_, err = l.svcCtx.DB.Comment.Get(l.ctx, commentID)
 	if err != nil {
@@ -68,11 +80,11 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 		existingLike, existingErr = tx.CommentLike.Query().
 			Where(
 				commentlike.CommentIDEQ(commentID),
-				commentlike.FingerprintEQ(req.Fingerprint),
+				commentlike.FingerprintIn(l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)...),
 			).
 			Only(l.ctx)
 	} else {
-		return nil, fmt.Errorf("either user_identity_id or fingerprint must be provided")
+		return nil, fmt.Errorf("either session_token or fingerprint must be provided")
 	}
 
 	var isLiked bool
@@ -96,6 +108,10 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 		isLiked = false
 		newLikesCount = updatedComment.LikesCount
 	} else if ent.IsNotFound(existingErr) {
+		if err = l.svcCtx.CheckLike(l.ctx, "blog_comment", req.CommentID, req.ClientIP, req.Fingerprint, req.UserIdentityId); err != nil {
+			return nil, err
+		}
+
 		// Like: create new like and increase count
 		likeBuilder := tx.CommentLike.Create().
 			SetCommentID(commentID).
@@ -105,7 +121,7 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 			likeBuilder = likeBuilder.SetUserIdentityID(req.UserIdentityId)
 		}
 		if req.Fingerprint != "" {
-			likeBuilder = likeBuilder.SetFingerprint(req.Fingerprint)
+			likeBuilder = likeBuilder.SetFingerprint(l.svcCtx.Fingerprints.Hash(l.ctx, req.Fingerprint))
 		}
 
 		_, err = likeBuilder.Save(l.ctx)
@@ -127,6 +143,19 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 		return nil, fmt.Errorf("failed to check existing like: %w", existingErr)
 	}
 
+	// Record the toggle in the outbox as part of the same transaction
+	eventType := outbox.EventCommentUnliked
+	if isLiked {
+		eventType = outbox.EventCommentLiked
+	}
+	err = l.svcCtx.PublishEvent(l.ctx, tx, eventType, outbox.CommentLikeEvent{
+		CommentID:  commentID.String(),
+		LikesCount: newLikesCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record like event: %w", err)
+	}
+
 	// Commit transaction
 	err = tx.Commit()
 	if err != nil {
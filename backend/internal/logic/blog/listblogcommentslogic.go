@@ -3,12 +3,13 @@ package blog
 import (
 	"context"
 	"database/sql"
-	"time"
 
 	"silan-backend/internal/ent/comment"
-	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/predicate"
 	"silan-backend/internal/svc"
+	"silan-backend/internal/tombstone"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -29,20 +30,35 @@ func NewListBlogCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
-func (l *ListBlogCommentsLogic) ListBlogComments(req *types.BlogCommentListRequest, clientIP, userAgent, fingerprint, userIdentityID string) (resp *types.BlogCommentListResponse, err error) {
+func (l *ListBlogCommentsLogic) ListBlogComments(req *types.BlogCommentListRequest) (resp *types.BlogCommentListResponse, err error) {
 	postID, err := uuid.Parse(req.ID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Pick up comments that still only exist in the legacy table
+	if err := l.svcCtx.Legacy.CopyPost(l.ctx, postID.String()); err != nil {
+		l.Errorf("Failed to copy legacy comments of post %s: %v", postID, err)
+	}
+
+	where := []predicate.Comment{comment.EntityIDEQ(postID), comment.EntityTypeEQ("blog"), comment.IsApproved(true)}
+	// Bilingual pages can show each language's threads apart
+	if req.CommentLanguage != "" {
+		inLanguage, err := l.svcCtx.InCommentLanguage(l.ctx, req.CommentLanguage, where...)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, inLanguage)
+	}
 	list, err := l.svcCtx.DB.Comment.
 		Query().
-		Where(comment.EntityIDEQ(postID), comment.EntityTypeEQ("blog")).
-		Order(comment.ByCreatedAt()).
+		Where(where...).
+		Order(svc.CommentOrder(req.Sort)...).
 		All(l.ctx)
 	if err != nil {
 		return nil, err
 	}
+	list, langs, languages := svc.CommentLanguageThreads(list, req.GroupByLanguage)
 
 	// cache avatar lookups per email within this request
 	avatarCache := map[string]string{}
@@ -101,12 +117,24 @@ func (l *ListBlogCommentsLogic) ListBlogComments(req *types.BlogCommentListReque
 			AuthorName:     c.AuthorName,
 			AuthorAvatarURL: lookupAvatar(c.AuthorEmail),
 			Content:        c.Content,
-			CreatedAt:      c.CreatedAt.Format(time.RFC3339),
+			CreatedAt:      utils.FormatTime(c.CreatedAt),
 			UserIdentityID: userIdentityIDStr,
 			LikesCount:     c.LikesCount,
-			IsLikedByUser:  false, // Will be set below
+			IsAuthor:       l.svcCtx.IsOwnerComment(c),
+			EmailVerified:  c.EmailVerified,
+			Language:       langs[c.ID.String()],
 			Replies:        []types.BlogCommentData{},
 		}
+		if c.DeletedAt != nil {
+			// Tombstones keep their place for the replies but show nothing
+			comment.AuthorName = tombstone.Placeholder
+			comment.Content = tombstone.Placeholder
+			comment.IsDeleted = true
+			comment.DeletedAt = utils.FormatTime(*c.DeletedAt)
+		} else if c.EditedAt != nil {
+			comment.IsEdited = true
+			comment.EditedAt = utils.FormatTime(*c.EditedAt)
+		}
 		commentMap[c.ID.String()] = &comment
 
 		// Track root comments
@@ -115,11 +143,13 @@ func (l *ListBlogCommentsLogic) ListBlogComments(req *types.BlogCommentListReque
 		}
 	}
 
-	// Second pass: build tree structure
+	// Second pass: build tree structure; replies past the depth limit are
+	// listed flat under their deepest allowed ancestor
+	parents := l.svcCtx.ThreadParents(list)
 	for _, c := range list {
 		if c.ParentID != (uuid.UUID{}) {
 			// This is a reply - add to parent's replies
-			parentID := c.ParentID.String()
+			parentID := parents[c.ID.String()]
 			if parent, exists := commentMap[parentID]; exists {
 				comment := commentMap[c.ID.String()]
 				parent.Replies = append(parent.Replies, *comment)
@@ -135,66 +165,5 @@ func (l *ListBlogCommentsLogic) ListBlogComments(req *types.BlogCommentListReque
 		}
 	}
 
-	// Fourth pass: check if user has liked each comment
-	if userIdentityID != "" || fingerprint != "" {
-		l.setLikeStatus(commentMap, userIdentityID, fingerprint)
-	}
-
-	// Log analytics data (optional - could be moved to a separate analytics service)
-	l.Infof("Returned %d comments (%d root, %d total) for post %s to IP %s",
-		len(rootComments), len(rootComments), len(list), req.ID, clientIP)
-
-	return &types.BlogCommentListResponse{Comments: rootComments, Total: len(list)}, nil
-}
-
-// setLikeStatus checks if the user has liked each comment and updates the IsLikedByUser field
-func (l *ListBlogCommentsLogic) setLikeStatus(commentMap map[string]*types.BlogCommentData, userIdentityID, fingerprint string) {
-	var commentIDs []uuid.UUID
-	for commentIDStr := range commentMap {
-		if commentID, err := uuid.Parse(commentIDStr); err == nil {
-			commentIDs = append(commentIDs, commentID)
-		}
-	}
-
-	if len(commentIDs) == 0 {
-		return
-	}
-
-	// Query all likes for these comments by this user
-	query := l.svcCtx.DB.CommentLike.Query().Where(commentlike.CommentIDIn(commentIDs...))
-
-	if userIdentityID != "" {
-		query = query.Where(commentlike.UserIdentityIDEQ(userIdentityID))
-	} else if fingerprint != "" {
-		query = query.Where(commentlike.FingerprintEQ(fingerprint))
-	} else {
-		return
-	}
-
-	likes, err := query.All(l.ctx)
-	if err != nil {
-		l.Errorf("Failed to query comment likes: %v", err)
-		return
-	}
-
-	// Create a set of liked comment IDs for O(1) lookup
-	likedComments := make(map[string]bool)
-	for _, like := range likes {
-		likedComments[like.CommentID.String()] = true
-	}
-
-	// Update the IsLikedByUser field for all comments
-	var updateComment func(*types.BlogCommentData)
-	updateComment = func(comment *types.BlogCommentData) {
-		comment.IsLikedByUser = likedComments[comment.ID]
-		// Recursively update replies
-		for i := range comment.Replies {
-			updateComment(&comment.Replies[i])
-		}
-	}
-
-	for _, comment := range commentMap {
-		updateComment(comment)
-	}
+	return &types.BlogCommentListResponse{Comments: rootComments, Total: len(list), Languages: languages}, nil
 }
-
@@ -0,0 +1,130 @@
+package blog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	maxBeaconEntries = 20
+	// maxBeaconDwell caps a single report so a forgotten tab cannot skew averages
+	maxBeaconDwell = 30 * 60
+)
+
+type RecordReadingProgressLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Record batched reading progress (scroll depth, dwell time) for posts
+func NewRecordReadingProgressLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordReadingProgressLogic {
+	return &RecordReadingProgressLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RecordReadingProgressLogic) RecordReadingProgress(req *types.ReadingBeaconRequest) (resp *types.ReadingBeaconResponse, err error) {
+	// Nothing is stored for visitors who opted out of tracking
+	if req.DoNotTrack || req.Fingerprint == "" {
+		return &types.ReadingBeaconResponse{Recorded: 0}, nil
+	}
+	if len(req.Entries) > maxBeaconEntries {
+		return nil, fmt.Errorf("at most %d entries per beacon", maxBeaconEntries)
+	}
+
+	// Merge entries per post so one batch cannot count a post twice
+	merged := map[uuid.UUID]types.ReadingBeaconEntry{}
+	for _, e := range req.Entries {
+		postID, err := uuid.Parse(e.PostID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid post id %q", e.PostID)
+		}
+		m := merged[postID]
+		m.ScrollDepth = max(m.ScrollDepth, min(max(e.ScrollDepth, 0), 100))
+		m.DwellSeconds += min(max(e.DwellSeconds, 0), maxBeaconDwell)
+		merged[postID] = m
+	}
+	if len(merged) == 0 {
+		return &types.ReadingBeaconResponse{Recorded: 0}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(merged))
+	for id := range merged {
+		ids = append(ids, id)
+	}
+	existing, err := l.svcCtx.DB.BlogPost.Query().Where(blogpost.IDIn(ids...)).IDs(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	readers := l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)
+	now := time.Now().UTC()
+	recorded := 0
+	for _, id := range existing {
+		e := merged[id]
+		if err := l.upsert(id.String(), readers, e.ScrollDepth, e.DwellSeconds, now); err != nil {
+			l.Errorf("Failed to record reading progress for post %s: %v", id, err)
+			continue
+		}
+		recorded++
+	}
+
+	return &types.ReadingBeaconResponse{Recorded: recorded}, nil
+}
+
+// upsert keeps the deepest scroll position seen and accumulates dwell time.
+// readers are the reader's fingerprint hashed with every salt still kept,
+// current first: progress stored under an older salt is added to, new
+// progress is stored under the current one.
+func (l *RecordReadingProgressLogic) upsert(postID string, readers []string, depth, dwell int, now time.Time) error {
+	args := []any{depth, depth, dwell, now, postID}
+	for _, r := range readers {
+		args = append(args, r)
+	}
+	res, err := l.svcCtx.RawDB.ExecContext(l.ctx, l.svcCtx.Rebind(
+		`UPDATE reading_progress SET
+			max_scroll_depth = CASE WHEN ? > max_scroll_depth THEN ? ELSE max_scroll_depth END,
+			dwell_seconds = dwell_seconds + ?,
+			updated_at = ?
+		WHERE post_id = ? AND reader_hash IN (?`+strings.Repeat(`, ?`, len(readers)-1)+`)`),
+		args...,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+
+	var query string
+	if l.svcCtx.Config.Database.Driver == "mysql" {
+		query = `INSERT INTO reading_progress (post_id, reader_hash, max_scroll_depth, dwell_seconds, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE
+				max_scroll_depth = GREATEST(max_scroll_depth, VALUES(max_scroll_depth)),
+				dwell_seconds = dwell_seconds + VALUES(dwell_seconds),
+				updated_at = VALUES(updated_at)`
+	} else {
+		query = `INSERT INTO reading_progress (post_id, reader_hash, max_scroll_depth, dwell_seconds, created_at, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?)
+			ON CONFLICT (post_id, reader_hash) DO UPDATE SET
+				max_scroll_depth = CASE WHEN excluded.max_scroll_depth > reading_progress.max_scroll_depth
+					THEN excluded.max_scroll_depth ELSE reading_progress.max_scroll_depth END,
+				dwell_seconds = reading_progress.dwell_seconds + excluded.dwell_seconds,
+				updated_at = excluded.updated_at`
+	}
+	_, err = l.svcCtx.RawDB.ExecContext(l.ctx, l.svcCtx.Rebind(query), postID, readers[0], depth, dwell, now, now)
+	return err
+}
@@ -37,5 +37,14 @@ func (l *SearchBlogPostsLogic) SearchBlogPosts(req *types.BlogSearchRequest) (re
 
 	// Use the existing GetBlogPosts logic
 	getBlogPostsLogic := NewGetBlogPostsLogic(l.ctx, l.svcCtx)
-	return getBlogPostsLogic.GetBlogPosts(blogListReq)
+	resp, err = getBlogPostsLogic.GetBlogPosts(blogListReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := l.svcCtx.LogSearch(l.ctx, "blog", req.Query, req.Fingerprint, int(resp.Total)); err != nil {
+		l.Errorf("Failed to log search query: %v", err)
+	}
+
+	return resp, nil
 }
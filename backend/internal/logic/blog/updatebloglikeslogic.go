@@ -2,8 +2,10 @@ package blog
 
 import (
 	"context"
+	"fmt"
 
 	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/outbox"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -33,15 +35,28 @@ func (l *UpdateBlogLikesLogic) UpdateBlogLikes(req *types.UpdateBlogLikesRequest
 		return nil, err
 	}
 
+	// Blog post likes carry no fingerprint, so only the subnet is counted
+	if req.Increment {
+		if err := l.svcCtx.CheckLike(l.ctx, "blog", req.ID, req.ClientIP, "", ""); err != nil {
+			return nil, err
+		}
+	}
+
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	// Update like count
 	var newLikeCount int
 	if req.Increment {
-		err = l.svcCtx.DB.BlogPost.Update().
+		err = tx.BlogPost.Update().
 			Where(blogpost.ID(postID)).
 			AddLikeCount(1).
 			Exec(l.ctx)
 	} else {
-		err = l.svcCtx.DB.BlogPost.Update().
+		err = tx.BlogPost.Update().
 			Where(blogpost.ID(postID)).
 			AddLikeCount(-1).
 			Exec(l.ctx)
@@ -51,13 +66,29 @@ func (l *UpdateBlogLikesLogic) UpdateBlogLikes(req *types.UpdateBlogLikesRequest
 	}
 
 	// Get updated like count
-	post, err := l.svcCtx.DB.BlogPost.Get(l.ctx, postID)
+	post, err := tx.BlogPost.Get(l.ctx, postID)
 	if err != nil {
 		return nil, err
 	}
 
 	newLikeCount = post.LikeCount
 
+	// Record the toggle in the outbox as part of the same transaction
+	eventType := outbox.EventPostUnliked
+	if req.Increment {
+		eventType = outbox.EventPostLiked
+	}
+	err = l.svcCtx.PublishEvent(l.ctx, tx, eventType, outbox.LikeEvent{
+		ID:         postID.String(),
+		LikesCount: newLikeCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record like event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return &types.UpdateBlogLikesResponse{
 		Likes: int64(newLikeCount),
 	}, nil
@@ -2,6 +2,7 @@ package blog
 
 import (
 	"context"
+	"time"
 
 	"silan-backend/internal/ent/blogpost"
 	"silan-backend/internal/svc"
@@ -33,6 +34,11 @@ func (l *UpdateBlogViewsLogic) UpdateBlogViews(req *types.UpdateBlogViewsRequest
 		return err
 	}
 
+	// Nothing is counted for visitors who opted out of tracking
+	if req.DoNotTrack {
+		return nil
+	}
+
 	// Increment view count
 	err = l.svcCtx.DB.BlogPost.Update().
 		Where(blogpost.ID(postID)).
@@ -42,6 +48,14 @@ func (l *UpdateBlogViewsLogic) UpdateBlogViews(req *types.UpdateBlogViewsRequest
 		return err
 	}
 
+	// The browser counts once a day towards the post's readers today
+	if req.Fingerprint != "" {
+		readers := l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)
+		if err := l.svcCtx.Readers.Record(l.ctx, postID.String(), readers, time.Now()); err != nil {
+			l.Errorf("Failed to record reader of post %s: %v", postID, err)
+		}
+	}
+
 	// Optional: log basic view event
 	l.Logger.Infof("View recorded for post %s", req.ID)
 
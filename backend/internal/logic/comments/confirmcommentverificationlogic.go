@@ -0,0 +1,46 @@
+package comments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/commentverify"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmCommentVerificationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Confirm the author address of a comment with the emailed code
+func NewConfirmCommentVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmCommentVerificationLogic {
+	return &ConfirmCommentVerificationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ConfirmCommentVerificationLogic) ConfirmCommentVerification(req *types.ConfirmCommentVerificationRequest) (resp *types.ConfirmCommentVerificationResponse, err error) {
+	commentID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = l.svcCtx.ConfirmCommentVerification(l.ctx, commentID, req.Code)
+	if errors.Is(err, commentverify.ErrInvalid) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to confirm verification of comment %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to verify comment")
+	}
+	return &types.ConfirmCommentVerificationResponse{Verified: true}, nil
+}
@@ -0,0 +1,65 @@
+package comments
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type EditCommentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Edit the content of one's own comment within the edit window
+func NewEditCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *EditCommentLogic {
+	return &EditCommentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *EditCommentLogic) EditComment(req *types.EditCommentRequest) (resp *types.EditCommentResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if identityID == "" && req.Fingerprint == "" {
+		return nil, fmt.Errorf("either session_token or fingerprint must be provided")
+	}
+
+	commentID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, editedAt, err := l.svcCtx.EditComment(l.ctx, svc.CommentEdit{
+		CommentID:   commentID,
+		Content:     req.Content,
+		IdentityID:  identityID,
+		Fingerprint: req.Fingerprint,
+		IP:          req.ClientIP,
+		UserAgent:   req.UserAgentFull,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("Comment %s edited (ip: %s, fingerprint: %s)", c.ID, req.ClientIP, req.Fingerprint)
+	return &types.EditCommentResponse{
+		ID:       c.ID.String(),
+		Content:  c.Content,
+		IsEdited: true,
+		EditedAt: utils.FormatTime(editedAt),
+		Pending:  !c.IsApproved,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package comments
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCommentContextLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Locate a comment in its thread for deep links
+func NewGetCommentContextLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCommentContextLogic {
+	return &GetCommentContextLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetCommentContextLogic) GetCommentContext(req *types.CommentContextRequest) (resp *types.CommentContextResponse, err error) {
+	id, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment id")
+	}
+	if req.Size <= 0 {
+		return nil, fmt.Errorf("size must be at least 1")
+	}
+	if err := l.svcCtx.Legacy.CopyComment(l.ctx, id.String()); err != nil {
+		l.Errorf("Failed to copy legacy comment %s: %v", id, err)
+	}
+
+	// Held comments are not linked to until they are approved
+	c, err := l.svcCtx.DB.Comment.Get(l.ctx, id)
+	if ent.IsNotFound(err) || (err == nil && !c.IsApproved) {
+		return nil, fmt.Errorf("comment not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	root, depth, err := l.svcCtx.ThreadRoot(l.ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	// The root's place among the top-level comments in the thread's order
+	// gives the page
+	roots, err := l.svcCtx.DB.Comment.Query().
+		Where(
+			comment.EntityTypeEQ(root.EntityType),
+			comment.EntityIDEQ(root.EntityID),
+			comment.TypeEQ(root.Type),
+			comment.ParentIDIsNil(),
+			comment.IsApproved(true),
+		).
+		Order(svc.CommentOrder(req.Sort)...).
+		IDs(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	position := slices.Index(roots, root.ID)
+	if position < 0 {
+		position = 0
+	}
+
+	return &types.CommentContextResponse{
+		ID:         c.ID.String(),
+		EntityType: c.EntityType,
+		EntityID:   c.EntityID.String(),
+		EntitySlug: l.entitySlug(c),
+		URL:        commentsub.PageURL(l.svcCtx.Config.Site.BaseURL, c.EntityType, c.EntityID.String()) + "#comment-" + c.ID.String(),
+		RootID:     root.ID.String(),
+		Depth:      depth,
+		Page:       position/req.Size + 1,
+		Position:   position,
+	}, nil
+}
+
+// entitySlug returns the slug of the post, idea or project c is on, or ""
+// for entities without one.
+func (l *GetCommentContextLogic) entitySlug(c *ent.Comment) string {
+	var (
+		slug string
+		err  error
+	)
+	switch {
+	case c.EntityType == "blog":
+		slug, err = l.svcCtx.DB.BlogPost.Query().Where(blogpost.ID(c.EntityID)).Select(blogpost.FieldSlug).String(l.ctx)
+	case strings.HasPrefix(c.EntityType, "idea"):
+		slug, err = l.svcCtx.DB.Idea.Query().Where(idea.ID(c.EntityID)).Select(idea.FieldSlug).String(l.ctx)
+	case strings.HasPrefix(c.EntityType, "project"):
+		slug, err = l.svcCtx.DB.Project.Query().Where(project.ID(c.EntityID)).Select(project.FieldSlug).String(l.ctx)
+	}
+	if err != nil && !ent.IsNotFound(err) {
+		l.Errorf("Failed to look up the slug of %s %s: %v", c.EntityType, c.EntityID, err)
+	}
+	return slug
+}
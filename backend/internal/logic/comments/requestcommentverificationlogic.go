@@ -0,0 +1,41 @@
+package comments
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestCommentVerificationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Email a code verifying the author address of an anonymous comment
+func NewRequestCommentVerificationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestCommentVerificationLogic {
+	return &RequestCommentVerificationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RequestCommentVerificationLogic) RequestCommentVerification(req *types.CommentVerificationRequest) (resp *types.CommentVerificationResponse, err error) {
+	commentID, err := uuid.Parse(req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := l.svcCtx.RequestCommentVerification(l.ctx, commentID, req.Email, req.ClientIP)
+	if err != nil {
+		l.Errorf("Failed to send verification code for comment %s: %v", req.ID, err)
+		return nil, err
+	}
+	return &types.CommentVerificationResponse{Sent: true, ExpiresAt: utils.FormatTime(expiresAt)}, nil
+}
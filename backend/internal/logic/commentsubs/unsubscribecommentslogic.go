@@ -0,0 +1,32 @@
+package commentsubs
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type UnsubscribeCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Stop reply notifications for a comment thread
+func NewUnsubscribeCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnsubscribeCommentsLogic {
+	return &UnsubscribeCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *UnsubscribeCommentsLogic) UnsubscribeComments(req *types.CommentSubscriptionRequest) (resp *types.CommentSubscriptionResponse, err error) {
+	if err := l.svcCtx.CommentSubs.Unsubscribe(l.ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &types.CommentSubscriptionResponse{Status: "unsubscribed"}, nil
+}
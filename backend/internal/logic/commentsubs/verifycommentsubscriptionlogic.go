@@ -0,0 +1,32 @@
+package commentsubs
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyCommentSubscriptionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Confirm the email address of a comment reply subscription
+func NewVerifyCommentSubscriptionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyCommentSubscriptionLogic {
+	return &VerifyCommentSubscriptionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyCommentSubscriptionLogic) VerifyCommentSubscription(req *types.CommentSubscriptionRequest) (resp *types.CommentSubscriptionResponse, err error) {
+	if err := l.svcCtx.CommentSubs.Verify(l.ctx, req.Token); err != nil {
+		return nil, err
+	}
+	return &types.CommentSubscriptionResponse{Status: "verified"}, nil
+}
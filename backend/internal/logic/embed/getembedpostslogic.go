@@ -0,0 +1,55 @@
+package embed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEmbedPostsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List recent blog posts for widgets on other sites; needs a key with the read:posts scope
+func NewGetEmbedPostsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEmbedPostsLogic {
+	return &GetEmbedPostsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEmbedPostsLogic) GetEmbedPosts(req *types.EmbedPostsRequest) (resp *types.EmbedPostsResponse, err error) {
+	posts, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		Order(ent.Desc(blogpost.FieldPublishedAt)).
+		Limit(req.Limit).
+		All(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to query blog posts for embed: %v", err)
+		return nil, fmt.Errorf("failed to list blog posts")
+	}
+
+	siteURL := strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/")
+	resp = &types.EmbedPostsResponse{Posts: make([]types.EmbedPostData, 0, len(posts))}
+	for _, p := range posts {
+		resp.Posts = append(resp.Posts, types.EmbedPostData{
+			Title:       p.Title,
+			Excerpt:     p.Excerpt,
+			URL:         siteURL + "/blog/" + p.ID.String(),
+			HeroImage:   p.FeaturedImageURL,
+			PublishedAt: utils.FormatTime(p.PublishedAt),
+		})
+	}
+	return resp, nil
+}
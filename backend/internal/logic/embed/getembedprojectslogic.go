@@ -0,0 +1,64 @@
+package embed
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/mapper"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEmbedProjectsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List public projects for widgets on other sites; needs a key with the read:projects scope
+func NewGetEmbedProjectsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEmbedProjectsLogic {
+	return &GetEmbedProjectsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEmbedProjectsLogic) GetEmbedProjects(req *types.EmbedProjectsRequest) (resp *types.EmbedProjectsResponse, err error) {
+	query := l.svcCtx.DB.Project.Query().
+		Where(project.IsPublic(true)).
+		WithTechnologies()
+	if req.Featured {
+		query = query.Where(project.IsFeatured(true))
+	}
+	projects, err := query.
+		Order(ent.Desc(project.FieldSortOrder), ent.Desc(project.FieldCreatedAt)).
+		Limit(req.Limit).
+		All(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to query projects for embed: %v", err)
+		return nil, fmt.Errorf("failed to list projects")
+	}
+
+	siteURL := strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/")
+	resp = &types.EmbedProjectsResponse{Projects: make([]types.EmbedProjectData, 0, len(projects))}
+	for _, p := range projects {
+		item := mapper.Project(p)
+		resp.Projects = append(resp.Projects, types.EmbedProjectData{
+			Title:        p.Title,
+			Description:  p.Description,
+			URL:          siteURL + "/projects/" + p.ID.String(),
+			ThumbnailURL: p.ThumbnailURL,
+			GithubURL:    p.GithubURL,
+			DemoURL:      p.DemoURL,
+			Technologies: item.Tags,
+			Year:         item.Year,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,45 @@
+package embed
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/widget"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWidgetPostsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Themed list of the latest blog posts for the embed widget
+func NewGetWidgetPostsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWidgetPostsLogic {
+	return &GetWidgetPostsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetWidgetPostsLogic) GetWidgetPosts(req *types.WidgetPostsRequest) (resp *types.WidgetPostsResponse, err error) {
+	theme, err := widget.Theme(req.Theme, req.Accent)
+	if err != nil {
+		return nil, err
+	}
+	list, err := NewGetEmbedPostsLogic(l.ctx, l.svcCtx).GetEmbedPosts(&types.EmbedPostsRequest{
+		Limit: req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.WidgetPostsResponse{
+		SiteURL: strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/") + "/blog",
+		Theme:   theme,
+		Posts:   list.Posts,
+	}, nil
+}
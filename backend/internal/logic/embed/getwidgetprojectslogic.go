@@ -0,0 +1,46 @@
+package embed
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/widget"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWidgetProjectsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Themed list of public projects for the embed widget
+func NewGetWidgetProjectsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWidgetProjectsLogic {
+	return &GetWidgetProjectsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetWidgetProjectsLogic) GetWidgetProjects(req *types.WidgetProjectsRequest) (resp *types.WidgetProjectsResponse, err error) {
+	theme, err := widget.Theme(req.Theme, req.Accent)
+	if err != nil {
+		return nil, err
+	}
+	list, err := NewGetEmbedProjectsLogic(l.ctx, l.svcCtx).GetEmbedProjects(&types.EmbedProjectsRequest{
+		Featured: req.Featured,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.WidgetProjectsResponse{
+		SiteURL:  strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/") + "/projects",
+		Theme:    theme,
+		Projects: list.Projects,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package embed
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/widget"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetWidgetScriptLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Script that renders the embed widget on other sites
+func NewGetWidgetScriptLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetWidgetScriptLogic {
+	return &GetWidgetScriptLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetWidgetScriptLogic) GetWidgetScript() ([]byte, string, error) {
+	return widget.Script, widget.ETag, nil
+}
@@ -0,0 +1,59 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/experiments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetExperimentAssignmentLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the variant assigned to a fingerprint for one experiment
+func NewGetExperimentAssignmentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetExperimentAssignmentLogic {
+	return &GetExperimentAssignmentLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetExperimentAssignmentLogic) GetExperimentAssignment(req *types.ExperimentAssignmentRequest, clientIP, userAgent string) (resp *types.ExperimentAssignment, err error) {
+	exp, ok := experiments.Find(l.svcCtx.Config.Experiments, req.Name)
+	if !ok {
+		return nil, fmt.Errorf("experiment not found")
+	}
+
+	variant := experiments.Assign(exp, req.Fingerprint)
+
+	// Exposures are only meaningful for identifiable visitors
+	if req.Fingerprint != "" {
+		err := l.svcCtx.RecordEvent(l.ctx, svc.AnalyticsEvent{
+			Name:        "experiment_exposure",
+			Fingerprint: req.Fingerprint,
+			Properties: map[string]string{
+				"experiment": exp.Name,
+				"variant":    variant,
+			},
+			IP:        clientIP,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			// Assignment must keep working even if analytics is unavailable
+			l.Errorf("Failed to record exposure for experiment %s: %v", exp.Name, err)
+		}
+	}
+
+	return &types.ExperimentAssignment{
+		Experiment: exp.Name,
+		Variant:    variant,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package experiments
+
+import (
+	"context"
+
+	"silan-backend/internal/experiments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetExperimentAssignmentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get variant assignments for all active experiments
+func NewGetExperimentAssignmentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetExperimentAssignmentsLogic {
+	return &GetExperimentAssignmentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetExperimentAssignmentsLogic) GetExperimentAssignments(req *types.ExperimentAssignmentsRequest) (resp *types.ExperimentAssignmentsResponse, err error) {
+	assignments := []types.ExperimentAssignment{}
+	for _, exp := range l.svcCtx.Config.Experiments {
+		if !exp.Active {
+			continue
+		}
+		assignments = append(assignments, types.ExperimentAssignment{
+			Experiment: exp.Name,
+			Variant:    experiments.Assign(exp, req.Fingerprint),
+		})
+	}
+
+	return &types.ExperimentAssignmentsResponse{Assignments: assignments}, nil
+}
@@ -0,0 +1,78 @@
+package experiments
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/experiments"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RecordExperimentConversionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Record a conversion for the fingerprint's variant
+func NewRecordExperimentConversionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordExperimentConversionLogic {
+	return &RecordExperimentConversionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RecordExperimentConversionLogic) RecordExperimentConversion(req *types.ExperimentConversionRequest) (resp *types.ExperimentConversionResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
+	if req.Fingerprint == "" {
+		return nil, fmt.Errorf("fingerprint is required")
+	}
+
+	exp, ok := experiments.Find(l.svcCtx.Config.Experiments, req.Name)
+	if !ok {
+		return nil, fmt.Errorf("experiment not found")
+	}
+
+	goal := req.Goal
+	if goal == "" {
+		goal = "default"
+	}
+
+	// The variant is recomputed server-side so clients cannot attribute
+	// conversions to a variant they were never shown
+	variant := experiments.Assign(exp, req.Fingerprint)
+
+	err = l.svcCtx.RecordEvent(l.ctx, svc.AnalyticsEvent{
+		Name:           "experiment_conversion",
+		Fingerprint:    req.Fingerprint,
+		UserIdentityID: req.UserIdentityId,
+		Properties: map[string]string{
+			"experiment": exp.Name,
+			"variant":    variant,
+			"goal":       goal,
+		},
+		IP:        req.ClientIP,
+		UserAgent: req.UserAgentFull,
+	})
+	if err != nil {
+		l.Errorf("Failed to record conversion for experiment %s: %v", exp.Name, err)
+		return nil, fmt.Errorf("failed to record conversion")
+	}
+
+	return &types.ExperimentConversionResponse{
+		Experiment: exp.Name,
+		Variant:    variant,
+		Goal:       goal,
+		Recorded:   true,
+	}, nil
+}
@@ -0,0 +1,35 @@
+package faq
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFAQLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get a single FAQ entry
+func NewGetFAQLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFAQLogic {
+	return &GetFAQLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetFAQLogic) GetFAQ(req *types.FAQRequest) (resp *types.FAQData, err error) {
+	it, err := l.svcCtx.FAQs.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := it.Data(req.Language)
+	return &data, nil
+}
@@ -0,0 +1,49 @@
+package faq
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFAQsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List FAQ entries grouped by category
+func NewGetFAQsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFAQsLogic {
+	return &GetFAQsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetFAQsLogic) GetFAQs(req *types.FAQListRequest) (resp *types.FAQListResponse, err error) {
+	items, err := l.svcCtx.FAQs.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Items arrive ordered by category and sort order
+	resp = &types.FAQListResponse{Categories: []types.FAQCategory{}}
+	index := map[string]int{}
+	for _, it := range items {
+		if req.Category != "" && it.Category != req.Category {
+			continue
+		}
+		i, ok := index[it.Category]
+		if !ok {
+			i = len(resp.Categories)
+			index[it.Category] = i
+			resp.Categories = append(resp.Categories, types.FAQCategory{Name: it.Category})
+		}
+		resp.Categories[i].Items = append(resp.Categories[i].Items, it.Data(req.Language))
+	}
+	return resp, nil
+}
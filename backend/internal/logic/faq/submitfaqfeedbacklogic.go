@@ -0,0 +1,61 @@
+package faq
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/faq"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubmitFAQFeedbackLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Record whether an FAQ answer was helpful
+func NewSubmitFAQFeedbackLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitFAQFeedbackLogic {
+	return &SubmitFAQFeedbackLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubmitFAQFeedbackLogic) SubmitFAQFeedback(req *types.FAQFeedbackRequest) (resp *types.FAQFeedbackResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
+	it, err := l.svcCtx.FAQs.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	name := faq.EventNotHelpful
+	if req.Helpful {
+		name = faq.EventHelpful
+	}
+	err = l.svcCtx.RecordEvent(l.ctx, svc.AnalyticsEvent{
+		Name:           name,
+		Fingerprint:    req.Fingerprint,
+		UserIdentityID: req.UserIdentityId,
+		Path:           "/faq/" + it.ID,
+		Properties:     map[string]string{"category": it.Category},
+		IP:             req.ClientIP,
+		UserAgent:      req.UserAgentFull,
+	})
+	if err != nil {
+		l.Errorf("Failed to record FAQ feedback: %v", err)
+		return nil, fmt.Errorf("failed to record feedback")
+	}
+
+	return &types.FAQFeedbackResponse{Recorded: true}, nil
+}
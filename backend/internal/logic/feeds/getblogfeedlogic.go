@@ -0,0 +1,33 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetBlogFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of blog posts
+func NewGetBlogFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetBlogFeedLogic {
+	return &GetBlogFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetBlogFeedLogic) GetBlogFeed() (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.BlogRSS)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -0,0 +1,138 @@
+package feeds
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"silan-backend/internal/calendar"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/ical"
+	"silan-backend/internal/svc"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCalendarFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// iCalendar feed of talks, project milestones and idea deadlines
+func NewGetCalendarFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCalendarFeedLogic {
+	return &GetCalendarFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// calendarHistoryDays keeps recent entries in the feed so subscribers don't
+// see them vanish the moment they start.
+const calendarHistoryDays = 30
+
+func (l *GetCalendarFeedLogic) GetCalendarFeed() (*feeds.Artifact, error) {
+	now := time.Now()
+	since := now.AddDate(0, 0, -calendarHistoryDays)
+	siteURL := strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/")
+
+	entries, err := l.svcCtx.Calendar.Since(l.ctx, since)
+	if err != nil {
+		return nil, err
+	}
+	public, err := l.publicEntities(entries)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []ical.Event
+	for _, e := range entries {
+		var pageURL string
+		if e.EntityType != "" {
+			if !public[e.EntityType+":"+e.EntityID] {
+				continue
+			}
+			pageURL = siteURL + "/" + e.EntityType + "s/" + e.EntityID
+		}
+		events = append(events, e.Event(pageURL))
+	}
+
+	// Planned end dates of active projects are milestones as well
+	projects, err := l.svcCtx.DB.Project.Query().
+		Where(
+			project.IsPublic(true),
+			project.StatusEQ(project.StatusActive),
+			project.EndDateGTE(since),
+		).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		events = append(events, ical.Event{
+			UID:        "project-end-" + p.ID.String(),
+			Start:      p.EndDate,
+			AllDay:     true,
+			Summary:    "Planned completion: " + p.Title,
+			URL:        siteURL + "/projects/" + p.ID.String(),
+			Categories: []string{calendar.KindMilestone},
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start.Before(events[j].Start)
+	})
+
+	return &feeds.Artifact{
+		Body:        ical.Calendar("Talks and milestones", ical.Host(siteURL), events, now),
+		ContentType: "text/calendar; charset=utf-8",
+		BuiltAt:     now,
+	}, nil
+}
+
+// publicEntities returns the "type:id" keys of the projects and ideas
+// linked from entries that are public.
+func (l *GetCalendarFeedLogic) publicEntities(entries []*calendar.Entry) (map[string]bool, error) {
+	var projectIDs, ideaIDs []uuid.UUID
+	for _, e := range entries {
+		id, err := uuid.Parse(e.EntityID)
+		if err != nil {
+			continue
+		}
+		switch e.EntityType {
+		case "project":
+			projectIDs = append(projectIDs, id)
+		case "idea":
+			ideaIDs = append(ideaIDs, id)
+		}
+	}
+
+	public := map[string]bool{}
+	if len(projectIDs) > 0 {
+		ids, err := l.svcCtx.DB.Project.Query().
+			Where(project.IDIn(projectIDs...), project.IsPublic(true)).
+			IDs(l.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			public["project:"+id.String()] = true
+		}
+	}
+	if len(ideaIDs) > 0 {
+		ids, err := l.svcCtx.DB.Idea.Query().
+			Where(idea.IDIn(ideaIDs...), idea.IsPublic(true)).
+			IDs(l.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			public["idea:"+id.String()] = true
+		}
+	}
+	return public, nil
+}
@@ -0,0 +1,34 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCategoryFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of blog posts in a category
+func NewGetCategoryFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCategoryFeedLogic {
+	return &GetCategoryFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetCategoryFeedLogic) GetCategoryFeed(req *types.FeedRequest) (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.CategoryRSS(req.Slug))
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
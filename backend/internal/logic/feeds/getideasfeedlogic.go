@@ -0,0 +1,33 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetIdeasFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of ideas
+func NewGetIdeasFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetIdeasFeedLogic {
+	return &GetIdeasFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetIdeasFeedLogic) GetIdeasFeed() (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.IdeasRSS)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
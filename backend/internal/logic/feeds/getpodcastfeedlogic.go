@@ -0,0 +1,33 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPodcastFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of narrated blog posts with their audio as enclosures
+func NewGetPodcastFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPodcastFeedLogic {
+	return &GetPodcastFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPodcastFeedLogic) GetPodcastFeed() (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.PodcastRSS)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -0,0 +1,33 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetProjectsFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of projects
+func NewGetProjectsFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProjectsFeedLogic {
+	return &GetProjectsFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetProjectsFeedLogic) GetProjectsFeed() (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.ProjectsRSS)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
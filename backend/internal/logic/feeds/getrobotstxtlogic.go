@@ -0,0 +1,34 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/sitefiles"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetRobotsTxtLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get robots.txt with the sitemap and crawler rules from the site settings
+func NewGetRobotsTxtLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetRobotsTxtLogic {
+	return &GetRobotsTxtLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetRobotsTxtLogic) GetRobotsTxt() (*feeds.Artifact, error) {
+	site := l.svcCtx.Config.Site
+	return &feeds.Artifact{
+		Body:        sitefiles.Robots(site.Robots, site.BaseURL),
+		ContentType: sitefiles.ContentType,
+	}, nil
+}
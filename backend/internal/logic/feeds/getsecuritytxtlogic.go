@@ -0,0 +1,36 @@
+package feeds
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/sitefiles"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSecurityTxtLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get security.txt from the site settings
+func NewGetSecurityTxtLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSecurityTxtLogic {
+	return &GetSecurityTxtLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSecurityTxtLogic) GetSecurityTxt() (*feeds.Artifact, error) {
+	site := l.svcCtx.Config.Site
+	body, err := sitefiles.SecurityTxt(site.SecurityTxt, site.BaseURL, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return &feeds.Artifact{Body: body, ContentType: sitefiles.ContentType}, nil
+}
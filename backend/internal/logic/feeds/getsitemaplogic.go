@@ -0,0 +1,33 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSitemapLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the sitemap
+func NewGetSitemapLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSitemapLogic {
+	return &GetSitemapLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSitemapLogic) GetSitemap() (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.Sitemap)
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -0,0 +1,34 @@
+package feeds
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTagFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of blog posts with a tag
+func NewGetTagFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTagFeedLogic {
+	return &GetTagFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTagFeedLogic) GetTagFeed(req *types.FeedRequest) (*feeds.Artifact, error) {
+	doc, err := l.svcCtx.Feeds.Get(l.ctx, feeds.TagRSS(req.Slug))
+	if err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
@@ -0,0 +1,45 @@
+package feeds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListFeedsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the RSS feeds, including one per blog tag and category
+func NewListFeedsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFeedsLogic {
+	return &ListFeedsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListFeedsLogic) ListFeeds() (resp *types.FeedIndexResponse, err error) {
+	list, err := l.svcCtx.Feeds.Feeds(l.ctx)
+	if err != nil {
+		l.Errorf("Failed to build feeds: %v", err)
+		return nil, fmt.Errorf("failed to list feeds")
+	}
+
+	resp = &types.FeedIndexResponse{Feeds: make([]types.FeedData, 0, len(list))}
+	for _, f := range list {
+		resp.Feeds = append(resp.Feeds, types.FeedData{
+			Kind:  f.Kind,
+			Title: f.Title,
+			URL:   strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/") + f.Path,
+		})
+	}
+	return resp, nil
+}
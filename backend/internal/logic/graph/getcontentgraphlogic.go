@@ -0,0 +1,248 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetContentGraphLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the graph of related posts, projects and ideas
+func NewGetContentGraphLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetContentGraphLogic {
+	return &GetContentGraphLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetContentGraphLogic) GetContentGraph(req *types.ContentGraphRequest) (resp *types.ContentGraphResponse, err error) {
+	v, err := l.svcCtx.Aggregates.Get(l.ctx, fmt.Sprintf("content_graph:%+v", *req), func(ctx context.Context) (any, error) {
+		return NewGetContentGraphLogic(ctx, l.svcCtx).build(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*types.ContentGraphResponse), nil
+}
+
+// build computes the content graph, served through the aggregates cache.
+func (l *GetContentGraphLogic) build(req *types.ContentGraphRequest) (resp *types.ContentGraphResponse, err error) {
+	g := newContentGraph()
+
+	posts, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		WithTags().
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		labels := make([]string, 0, len(p.Edges.Tags))
+		for _, t := range p.Edges.Tags {
+			labels = append(labels, t.Name)
+		}
+		g.addNode("blog", p.ID.String(), p.Title, p.Slug, labels)
+	}
+
+	ideas, err := l.svcCtx.DB.Idea.Query().
+		Where(idea.IsPublic(true)).
+		WithTags().
+		WithBlogPosts(func(q *ent.BlogPostQuery) {
+			q.Where(blogpost.StatusEQ(blogpost.StatusPublished))
+		}).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ideas {
+		labels := make([]string, 0, len(i.Edges.Tags))
+		for _, t := range i.Edges.Tags {
+			labels = append(labels, t.Name)
+		}
+		g.addNode("idea", i.ID.String(), i.Title, i.Slug, labels)
+	}
+
+	// Project technologies take the place of tags, as in the tag cloud
+	projects, err := l.svcCtx.DB.Project.Query().
+		Where(project.IsPublic(true)).
+		WithTechnologies().
+		WithSourceRelationships().
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		labels := make([]string, 0, len(p.Edges.Technologies))
+		for _, t := range p.Edges.Technologies {
+			labels = append(labels, t.TechnologyName)
+		}
+		g.addNode("project", p.ID.String(), p.Title, p.Slug, labels)
+	}
+
+	// Explicit links: blog posts written about an idea and project relationships
+	for _, i := range ideas {
+		for _, p := range i.Edges.BlogPosts {
+			g.addLink(nodeID("idea", i.ID.String()), nodeID("blog", p.ID.String()), "idea_post")
+		}
+	}
+	for _, p := range projects {
+		for _, r := range p.Edges.SourceRelationships {
+			g.addLink(nodeID("project", p.ID.String()), nodeID("project", r.TargetProjectID.String()), r.RelationshipType)
+		}
+	}
+
+	g.linkSharedLabels(req.MinShared)
+	return g.response(), nil
+}
+
+// Edge kinds of the content graph.
+const (
+	edgeLink       = "link"
+	edgeTag        = "tag"
+	edgeTechnology = "technology"
+)
+
+func nodeID(kind, id string) string {
+	return kind + ":" + id
+}
+
+// contentGraph collects the nodes and edges of the content map. Labels (tags
+// and technologies) are matched case-insensitively.
+type contentGraph struct {
+	nodes  []types.ContentGraphNode
+	index  map[string]int
+	labels map[string][]string // lowercased label -> node IDs
+	names  map[string]string   // lowercased label -> first spelling seen
+	edges  []types.ContentGraphEdge
+}
+
+func newContentGraph() *contentGraph {
+	return &contentGraph{
+		index:  map[string]int{},
+		labels: map[string][]string{},
+		names:  map[string]string{},
+	}
+}
+
+func (g *contentGraph) addNode(kind, id, title, slug string, labels []string) {
+	nid := nodeID(kind, id)
+	g.index[nid] = len(g.nodes)
+	g.nodes = append(g.nodes, types.ContentGraphNode{ID: nid, Type: kind, Title: title, Slug: slug})
+
+	seen := map[string]bool{}
+	for _, name := range labels {
+		name = strings.TrimSpace(name)
+		key := strings.ToLower(name)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		if _, ok := g.names[key]; !ok {
+			g.names[key] = name
+		}
+		g.labels[key] = append(g.labels[key], nid)
+	}
+}
+
+// addLink adds an explicit relation. Links to content missing from the graph,
+// such as private projects, are dropped.
+func (g *contentGraph) addLink(source, target, relation string) {
+	_, okSource := g.index[source]
+	_, okTarget := g.index[target]
+	if !okSource || !okTarget || source == target {
+		return
+	}
+	g.edges = append(g.edges, types.ContentGraphEdge{
+		Source:   source,
+		Target:   target,
+		Kind:     edgeLink,
+		Weight:   1,
+		Relation: relation,
+	})
+}
+
+// linkSharedLabels connects every pair of nodes sharing at least minShared
+// labels. Two projects sharing technologies get a technology edge, any
+// other pair a tag edge.
+func (g *contentGraph) linkSharedLabels(minShared int) {
+	type pair struct{ a, b string }
+	shared := map[pair][]string{}
+	for key, ids := range g.labels {
+		for i := 0; i < len(ids); i++ {
+			for j := i + 1; j < len(ids); j++ {
+				a, b := ids[i], ids[j]
+				if a > b {
+					a, b = b, a
+				}
+				shared[pair{a, b}] = append(shared[pair{a, b}], g.names[key])
+			}
+		}
+	}
+
+	for p, labels := range shared {
+		if len(labels) < minShared {
+			continue
+		}
+		kind := edgeTag
+		if g.nodes[g.index[p.a]].Type == "project" && g.nodes[g.index[p.b]].Type == "project" {
+			kind = edgeTechnology
+		}
+		sort.Strings(labels)
+		g.edges = append(g.edges, types.ContentGraphEdge{
+			Source: p.a,
+			Target: p.b,
+			Kind:   kind,
+			Weight: len(labels),
+			Labels: labels,
+		})
+	}
+}
+
+// response returns the graph with node degrees filled in and the edges in a
+// stable order, heaviest first.
+func (g *contentGraph) response() *types.ContentGraphResponse {
+	for _, e := range g.edges {
+		g.nodes[g.index[e.Source]].Degree++
+		g.nodes[g.index[e.Target]].Degree++
+	}
+
+	sort.Slice(g.edges, func(i, j int) bool {
+		a, b := g.edges[i], g.edges[j]
+		if a.Weight != b.Weight {
+			return a.Weight > b.Weight
+		}
+		if a.Source != b.Source {
+			return a.Source < b.Source
+		}
+		if a.Target != b.Target {
+			return a.Target < b.Target
+		}
+		return a.Kind < b.Kind
+	})
+
+	edges := g.edges
+	if edges == nil {
+		edges = []types.ContentGraphEdge{}
+	}
+	nodes := g.nodes
+	if nodes == nil {
+		nodes = []types.ContentGraphNode{}
+	}
+	return &types.ContentGraphResponse{Nodes: nodes, Edges: edges}
+}
@@ -3,9 +3,9 @@ package ideas
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/mapper"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -46,83 +46,6 @@ func (l *GetIdeaLogic) GetIdea(req *types.IdeaRequest) (resp *types.IdeaData, er
 		return nil, err
 	}
 
-	// Convert to response format
-	// Note: Author field not used in IdeaData response
-
-	// Handle non-nullable fields
-	abstract := ideaEntity.Abstract
-	description := ideaEntity.Description
-
-	// Get detail fields from IdeaDetail edge
-	var progress, results, references, requiredResources string
-	var collaborationNeeded bool
-	var estimatedDuration string
-
-	if ideaEntity.Edges.Details != nil {
-		detail := ideaEntity.Edges.Details
-		progress = detail.Progress
-		results = detail.Results
-		references = detail.References
-		requiredResources = detail.RequiredResources
-		collaborationNeeded = detail.CollaborationNeeded
-
-		if detail.EstimatedDurationMonths > 0 {
-			estimatedDuration = fmt.Sprintf("%d months", detail.EstimatedDurationMonths)
-		}
-	}
-
-	// Tags from M2M edge (IdeaTag)
-	var tags []string
-	if len(ideaEntity.Edges.Tags) > 0 {
-		for _, t := range ideaEntity.Edges.Tags {
-			if t.Name != "" {
-				tags = append(tags, t.Name)
-			}
-		}
-	} else {
-		tags = []string{}
-	}
-	// Category: now directly from Ent field
-	category := ideaEntity.Category
-
-	// Initialize empty slices and missing variables
-	var techStack []string
-	var keywords []string
-	var collaborators []types.Collaborator
-	var feedbackRequested []types.FeedbackType
-	var publications []types.IdeaPublicationRef
-	var conferences []string
-	var codeRepository string
-	var demoURL string
-
-	return &types.IdeaData{
-		ID:                   ideaEntity.ID.String(),
-		Title:                ideaEntity.Title,
-		Description:          description,
-		Category:             category,
-		Tags:                 tags,
-		Status:               strings.ToLower(string(ideaEntity.Status)),
-		CreatedAt:            ideaEntity.CreatedAt.Format("2006-01-02T15:04:05Z"),
-		LastUpdated:          ideaEntity.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-		Abstract:             abstract,
-		AbstractZh:           abstract,
-		Progress:             progress,
-		ProgressZh:           progress,
-		Results:              results,
-		ResultsZh:            results,
-		Reference:            references,
-		Reference_Zh:         references,
-		CodeRepository:       codeRepository,
-		DemoURL:              demoURL,
-		TechStack:            techStack,
-		Collaborators:        collaborators,
-		OpenForCollaboration: collaborationNeeded,
-		FeedbackRequested:    feedbackRequested,
-		Publications:         publications,
-		Conferences:          conferences,
-		ResearchField:        category,
-		Keywords:             keywords,
-		EstimatedDuration:    estimatedDuration,
-		FundingStatus:        requiredResources,
-	}, nil
+	data := mapper.Idea(ideaEntity)
+	return &data, nil
 }
@@ -2,13 +2,12 @@ package ideas
 
 import (
 	"context"
-	"fmt"
 	"math"
-	"strings"
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/idea"
 	"silan-backend/internal/ent/ideadetail"
+	"silan-backend/internal/mapper"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -79,89 +78,7 @@ func (l *GetIdeasLogic) GetIdeas(req *types.IdeaListRequest) (resp *types.IdeaLi
 		return nil, err
 	}
 
-	// Category now comes directly from Ent field after schema sync
-
-	var result []types.IdeaData
-	for _, ideaEntity := range ideas {
-		// Handle non-nullable fields
-		abstract := ideaEntity.Abstract
-		description := ideaEntity.Description
-
-		// Get detail fields from IdeaDetail edge
-		var progress, results, references, requiredResources string
-		var collaborationNeeded bool
-		var estimatedDuration string
-
-		if ideaEntity.Edges.Details != nil {
-			detail := ideaEntity.Edges.Details
-			progress = detail.Progress
-			results = detail.Results
-			references = detail.References
-			requiredResources = detail.RequiredResources
-			collaborationNeeded = detail.CollaborationNeeded
-
-			if detail.EstimatedDurationMonths > 0 {
-				estimatedDuration = fmt.Sprintf("%d months", detail.EstimatedDurationMonths)
-			}
-		}
-
-		// Tags from M2M edge (IdeaTag)
-		tags := []string{}
-		if len(ideaEntity.Edges.Tags) > 0 {
-			for _, t := range ideaEntity.Edges.Tags {
-				if t.Name != "" {
-					tags = append(tags, t.Name)
-				}
-			}
-		}
-		category := ideaEntity.Category
-
-		// Initialize missing variables
-		var codeRepository string
-		var demoURL string
-		var techStack []string
-		var collaborators []types.Collaborator
-		var feedbackRequested []types.FeedbackType
-		var publications []types.IdeaPublicationRef
-		var conferences []string
-		var keywords []string
-
-		result = append(result, types.IdeaData{
-			ID:                   ideaEntity.ID.String(),
-			Title:                ideaEntity.Title,
-			Description:          description,
-			Category:             category,
-			Tags:                 tags,
-			Status:               strings.ToLower(string(ideaEntity.Status)),
-			CreatedAt:            ideaEntity.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			LastUpdated:          ideaEntity.UpdatedAt.Format("2006-01-02T15:04:05Z"),
-			Abstract:             abstract,
-			AbstractZh:           abstract,
-			Progress:             progress,
-			ProgressZh:           progress,
-			Results:              results,
-			ResultsZh:            results,
-			Reference:            references,
-			Reference_Zh:         references,
-			TechStack:            techStack,
-			CodeRepository:       codeRepository,
-			DemoURL:              demoURL,
-			Collaborators:        collaborators,
-			OpenForCollaboration: collaborationNeeded,
-			FeedbackRequested:    feedbackRequested,
-			Publications:         publications,
-			Conferences:          conferences,
-			ResearchField:        category,
-			Keywords:             keywords,
-			EstimatedDuration:    estimatedDuration,
-			FundingStatus:        requiredResources,
-		})
-	}
-
-	// Handle empty result
-	if result == nil {
-		result = []types.IdeaData{}
-	}
+	result := mapper.Ideas(ideas)
 
 	totalPages := int(math.Ceil(float64(total) / float64(req.Size)))
 
@@ -5,10 +5,10 @@ import (
 	"fmt"
 
 	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/outbox"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
-	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -29,6 +29,13 @@ func NewLikeIdeaCommentLogic(ctx context.Context, svcCtx *svc.ServiceContext) *L
 }
 
 func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *types.LikeCommentResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	// Validate comment id format
 	commentUUID, err := uuid.Parse(req.CommentID)
 	if err != nil {
@@ -36,18 +43,18 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 	}
 
 	// Check if like exists using entgo
+	// Fingerprints are stored hashed; older salts still match
+	fingerprints := l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)
 	likeQuery := l.svcCtx.DB.CommentLike.Query().Where(commentlike.CommentIDEQ(commentUUID))
 	if req.UserIdentityId != "" && req.Fingerprint != "" {
-		likeQuery = likeQuery.Where(func(s *sql.Selector) {
-			s.Where(sql.Or(
-				sql.EQ(s.C("user_identity_id"), req.UserIdentityId),
-				sql.EQ(s.C("fingerprint"), req.Fingerprint),
-			))
-		})
+		likeQuery = likeQuery.Where(commentlike.Or(
+			commentlike.UserIdentityIDEQ(req.UserIdentityId),
+			commentlike.FingerprintIn(fingerprints...),
+		))
 	} else if req.UserIdentityId != "" {
 		likeQuery = likeQuery.Where(commentlike.UserIdentityIDEQ(req.UserIdentityId))
 	} else if req.Fingerprint != "" {
-		likeQuery = likeQuery.Where(commentlike.FingerprintEQ(req.Fingerprint))
+		likeQuery = likeQuery.Where(commentlike.FingerprintIn(fingerprints...))
 	}
 
 	existingLike, err := likeQuery.First(l.ctx)
@@ -68,10 +75,14 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 			return nil, fmt.Errorf("failed to update likes count: %v", err)
 		}
 	} else {
+		if err := l.svcCtx.CheckLike(l.ctx, "idea_comment", req.CommentID, req.ClientIP, req.Fingerprint, req.UserIdentityId); err != nil {
+			return nil, err
+		}
+
 		// Like: insert like and increment counter using entgo
 		likeBuilder := l.svcCtx.DB.CommentLike.Create().
 			SetCommentID(commentUUID).
-			SetFingerprint(req.Fingerprint)
+			SetFingerprint(l.svcCtx.Fingerprints.Hash(l.ctx, req.Fingerprint))
 
 		if req.UserIdentityId != "" {
 			likeBuilder = likeBuilder.SetUserIdentityID(req.UserIdentityId)
@@ -97,6 +108,18 @@ func (l *LikeCommentLogic) LikeComment(req *types.LikeCommentRequest) (resp *typ
 		return nil, fmt.Errorf("failed to get comment: %v", err)
 	}
 
+	// The like is already stored, so a failure to announce it is only logged
+	eventType := outbox.EventCommentLiked
+	if exists {
+		eventType = outbox.EventCommentUnliked
+	}
+	if err := l.svcCtx.PublishEvent(l.ctx, l.svcCtx.RawDB, eventType, outbox.CommentLikeEvent{
+		CommentID:  commentUUID.String(),
+		LikesCount: comment.LikesCount,
+	}); err != nil {
+		l.Errorf("Failed to record like event of comment %s: %v", commentUUID, err)
+	}
+
 	return &types.LikeCommentResponse{LikesCount: comment.LikesCount, IsLikedByUser: !exists}, nil
 }
 
@@ -2,13 +2,15 @@ package ideas
 
 import (
 	"context"
-	"time"
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
 	"silan-backend/internal/ent/useridentity"
 	"silan-backend/internal/svc"
+	"silan-backend/internal/tombstone"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"strings"
 
@@ -32,7 +34,7 @@ func NewListIdeaCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
-func (l *ListCommentsLogic) ListComments(req *types.IdeaCommentListRequest, clientIP, userAgent, fingerprint, userIdentityID string) (resp *types.IdeaCommentListResponse, err error) {
+func (l *ListCommentsLogic) ListComments(req *types.IdeaCommentListRequest) (resp *types.IdeaCommentListResponse, err error) {
 	// Validate idea id format
 	ideaUUID, err := uuid.Parse(req.ID)
 	if err != nil {
@@ -42,23 +44,35 @@ func (l *ListCommentsLogic) ListComments(req *types.IdeaCommentListRequest, clie
 	// Fetch comments using entgo
 	// Support both legacy entity_type "idea" and new namespaced form "idea_<type>"
 	desiredEntityType := "idea_" + strings.ToLower(req.Type)
+	where := []predicate.Comment{
+		comment.EntityIDEQ(ideaUUID),
+		func(s *sql.Selector) {
+			s.Where(sql.Or(
+				sql.EQ(s.C("entity_type"), "idea"),
+				sql.EQ(s.C("entity_type"), desiredEntityType),
+			))
+		},
+		comment.TypeEQ(req.Type),
+		// Held comments stay hidden until they are approved
+		comment.IsApproved(true),
+	}
+	// Bilingual pages can show each language's threads apart
+	if req.CommentLanguage != "" {
+		inLanguage, err := l.svcCtx.InCommentLanguage(l.ctx, req.CommentLanguage, where...)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, inLanguage)
+	}
 	comments, err := l.svcCtx.DB.Comment.
 		Query().
-		Where(
-			comment.EntityIDEQ(ideaUUID),
-			func(s *sql.Selector) {
-				s.Where(sql.Or(
-					sql.EQ(s.C("entity_type"), "idea"),
-					sql.EQ(s.C("entity_type"), desiredEntityType),
-				))
-			},
-			comment.TypeEQ(req.Type),
-		).
-		Order(ent.Asc(comment.FieldCreatedAt)).
+		Where(where...).
+		Order(svc.CommentOrder(req.Sort)...).
 		All(l.ctx)
 	if err != nil {
 		return nil, err
 	}
+	comments, langs, languages := svc.CommentLanguageThreads(comments, req.GroupByLanguage)
 
 	lookupAvatar := func(email string) string {
 		if email == "" {
@@ -91,17 +105,32 @@ func (l *ListCommentsLogic) ListComments(req *types.IdeaCommentListRequest, clie
 			AuthorAvatarURL: lookupAvatar(comment.AuthorEmail),
 			Content:         comment.Content,
 			Type:            comment.Type,
-			CreatedAt:       comment.CreatedAt.Format(time.RFC3339),
+			CreatedAt:       utils.FormatTime(comment.CreatedAt),
 			UserIdentityID:  comment.UserIdentityID,
 			LikesCount:      comment.LikesCount,
 			IsLikedByUser:   false,
+			IsAuthor:        l.svcCtx.IsOwnerComment(comment),
+			EmailVerified:   comment.EmailVerified,
+			Language:        langs[comment.ID.String()],
 			Replies:         []types.IdeaCommentData{},
 		}
+		if comment.DeletedAt != nil {
+			// Tombstones keep their place for the replies but show nothing
+			commentData.AuthorName = tombstone.Placeholder
+			commentData.Content = tombstone.Placeholder
+			commentData.IsDeleted = true
+			commentData.DeletedAt = utils.FormatTime(*comment.DeletedAt)
+		} else if comment.EditedAt != nil {
+			commentData.IsEdited = true
+			commentData.EditedAt = utils.FormatTime(*comment.EditedAt)
+		}
 		commentMap[comment.ID.String()] = &commentData
 		order = append(order, comment.ID.String())
 	}
 
-	// Build tree: parent->children
+	// Build tree: parent->children; replies past the depth limit are listed
+	// flat under their deepest allowed ancestor
+	parents := l.svcCtx.ThreadParents(comments)
 	var rootIDs []string
 	for _, id := range order {
 		c := commentMap[id]
@@ -109,67 +138,11 @@ func (l *ListCommentsLogic) ListComments(req *types.IdeaCommentListRequest, clie
 			rootIDs = append(rootIDs, id)
 			continue
 		}
-		if parent, ok := commentMap[c.ParentID]; ok {
+		if parent, ok := commentMap[parents[id]]; ok {
 			parent.Replies = append(parent.Replies, *c)
 		}
 	}
 
-	// Determine like status for this user using entgo
-	if (userIdentityID != "" || fingerprint != "") && len(order) > 0 {
-		// Convert comment IDs to UUIDs
-		commentUUIDs := make([]uuid.UUID, 0, len(order))
-		for _, id := range order {
-			if commentUUID, err := uuid.Parse(id); err == nil {
-				commentUUIDs = append(commentUUIDs, commentUUID)
-			}
-		}
-
-		if len(commentUUIDs) > 0 {
-			// Use entgo to query likes
-			likeQuery := l.svcCtx.DB.CommentLike.Query()
-
-			// Add comment ID filter
-			likeQuery = likeQuery.Where(func(s *sql.Selector) {
-				s.Where(sql.In(s.C("comment_id"), func() []interface{} {
-					result := make([]interface{}, len(commentUUIDs))
-					for i, id := range commentUUIDs {
-						result[i] = id
-					}
-					return result
-				}()...))
-			})
-
-			// Add user identity or fingerprint filter
-			if userIdentityID != "" && fingerprint != "" {
-				likeQuery = likeQuery.Where(func(s *sql.Selector) {
-					s.Where(sql.Or(
-						sql.EQ(s.C("user_identity_id"), userIdentityID),
-						sql.EQ(s.C("fingerprint"), fingerprint),
-					))
-				})
-			} else if userIdentityID != "" {
-				likeQuery = likeQuery.Where(func(s *sql.Selector) {
-					s.Where(sql.EQ(s.C("user_identity_id"), userIdentityID))
-				})
-			} else if fingerprint != "" {
-				likeQuery = likeQuery.Where(func(s *sql.Selector) {
-					s.Where(sql.EQ(s.C("fingerprint"), fingerprint))
-				})
-			}
-
-			likes, err := likeQuery.All(l.ctx)
-			if err == nil {
-				liked := make(map[string]bool)
-				for _, like := range likes {
-					liked[like.CommentID.String()] = true
-				}
-				for _, c := range commentMap {
-					c.IsLikedByUser = liked[c.ID]
-				}
-			}
-		}
-	}
-
 	// Build final root list
 	var roots []types.IdeaCommentData
 	for _, id := range rootIDs {
@@ -180,5 +153,5 @@ func (l *ListCommentsLogic) ListComments(req *types.IdeaCommentListRequest, clie
 	if roots == nil {
 		roots = []types.IdeaCommentData{}
 	}
-	return &types.IdeaCommentListResponse{Comments: roots, Total: len(order)}, nil
+	return &types.IdeaCommentListResponse{Comments: roots, Total: len(order), Languages: languages}, nil
 }
@@ -0,0 +1,118 @@
+package likes
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/projectlike"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetLikeStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Report which of the given comments and projects the visitor has liked
+func NewGetLikeStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetLikeStatusLogic {
+	return &GetLikeStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetLikeStatusLogic) GetLikeStatus(req *types.LikeStatusRequest) (resp *types.LikeStatusResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+
+	commentIDs, err := parseIDs(req.CommentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid comment ID: %w", err)
+	}
+	projectIDs, err := parseIDs(req.ProjectIDs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project ID: %w", err)
+	}
+
+	resp = &types.LikeStatusResponse{
+		Comments: make(map[string]bool, len(commentIDs)),
+		Projects: make(map[string]bool, len(projectIDs)),
+	}
+	for _, id := range commentIDs {
+		resp.Comments[id.String()] = false
+	}
+	for _, id := range projectIDs {
+		resp.Projects[id.String()] = false
+	}
+
+	// Without an identity or fingerprint nothing can have been liked
+	if identityID == "" && req.Fingerprint == "" {
+		return resp, nil
+	}
+
+	if len(commentIDs) > 0 {
+		// A comment like counts under either the identity or the fingerprint,
+		// as in the comment like toggles
+		var who []predicate.CommentLike
+		if identityID != "" {
+			who = append(who, commentlike.UserIdentityIDEQ(identityID))
+		}
+		if req.Fingerprint != "" {
+			who = append(who, commentlike.FingerprintIn(l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)...))
+		}
+		likes, err := l.svcCtx.DB.CommentLike.Query().
+			Where(commentlike.CommentIDIn(commentIDs...), commentlike.Or(who...)).
+			All(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to query comment likes: %v", err)
+			return nil, fmt.Errorf("failed to load like status")
+		}
+		for _, like := range likes {
+			resp.Comments[like.CommentID.String()] = true
+		}
+	}
+
+	if len(projectIDs) > 0 {
+		// Project likes are matched by identity when signed in, else by
+		// fingerprint, as in LikeProject
+		query := l.svcCtx.DB.ProjectLike.Query().Where(projectlike.ProjectIDIn(projectIDs...))
+		if identityID != "" {
+			query = query.Where(projectlike.UserIdentityID(identityID))
+		} else {
+			query = query.Where(projectlike.FingerprintIn(l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)...))
+		}
+		likes, err := query.All(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to query project likes: %v", err)
+			return nil, fmt.Errorf("failed to load like status")
+		}
+		for _, like := range likes {
+			resp.Projects[like.ProjectID.String()] = true
+		}
+	}
+
+	return resp, nil
+}
+
+func parseIDs(raw []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(raw))
+	for _, s := range raw {
+		id, err := uuid.Parse(s)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
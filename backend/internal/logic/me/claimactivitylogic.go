@@ -0,0 +1,50 @@
+package me
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ClaimActivityLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Move anonymous comments and likes of this browser to the signed-in visitor
+func NewClaimActivityLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClaimActivityLogic {
+	return &ClaimActivityLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ClaimActivityLogic) ClaimActivity(req *types.ClaimActivityRequest) (resp *types.ClaimActivityResponse, err error) {
+	identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+	ident, err := l.svcCtx.DB.UserIdentity.Get(l.ctx, identityID)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := l.svcCtx.ClaimAnonymousActivity(l.ctx, ident, req.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	l.Infof("Identity %s claimed %d comments, %d comment likes and %d project likes",
+		identityID, claimed.Comments, claimed.CommentLikes, claimed.ProjectLikes)
+
+	return &types.ClaimActivityResponse{
+		IdentityID:   identityID,
+		Comments:     claimed.Comments,
+		CommentLikes: claimed.CommentLikes,
+		ProjectLikes: claimed.ProjectLikes,
+	}, nil
+}
@@ -0,0 +1,40 @@
+package me
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ClaimCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Claim the anonymous comments left with the signed-in visitor's verified email
+func NewClaimCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ClaimCommentsLogic {
+	return &ClaimCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ClaimCommentsLogic) ClaimComments(req *types.ClaimCommentsRequest) (resp *types.ClaimCommentsResponse, err error) {
+	claims, err := l.svcCtx.RequireSession(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed, err := l.svcCtx.ClaimCommentsByEmail(l.ctx, claims)
+	if err != nil {
+		return nil, err
+	}
+	l.Infof("Identity %s claimed %d comments by email", claims.IdentityID, claimed)
+
+	return &types.ClaimCommentsResponse{IdentityID: claims.IdentityID, Comments: claimed}, nil
+}
@@ -0,0 +1,59 @@
+package me
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DeleteMyDataLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Delete the comments, likes, views and accounts of the signed-in visitor, or of an email address confirmed with a code
+func NewDeleteMyDataLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DeleteMyDataLogic {
+	return &DeleteMyDataLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DeleteMyDataLogic) DeleteMyData(req *types.DeleteMyDataRequest) (resp *types.DeleteMyDataResponse, err error) {
+	var erased *svc.ErasedData
+	if req.Authorization != "" {
+		identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+		if err != nil {
+			return nil, err
+		}
+		if erased, err = l.svcCtx.EraseIdentity(l.ctx, identityID, req.Fingerprint); err != nil {
+			return nil, err
+		}
+		l.Infof("Erased the data of identity %s", identityID)
+	} else {
+		if req.Email == "" || req.Code == "" {
+			return nil, fmt.Errorf("sign in, or give the email and the code mailed to it")
+		}
+		if erased, err = l.svcCtx.EraseEmail(l.ctx, req.Email, req.Code, req.Fingerprint); err != nil {
+			return nil, err
+		}
+		l.Infof("Erased the data of an email address on request")
+	}
+
+	return &types.DeleteMyDataResponse{
+		Comments:           erased.Comments,
+		AnonymizedComments: erased.AnonymizedComments,
+		CommentLikes:       erased.CommentLikes,
+		ProjectLikes:       erased.ProjectLikes,
+		Views:              erased.Views,
+		Reactions:          erased.Reactions,
+		PollVotes:          erased.PollVotes,
+		Identities:         erased.Identities,
+	}, nil
+}
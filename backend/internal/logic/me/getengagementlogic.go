@@ -0,0 +1,65 @@
+package me
+
+import (
+	"context"
+
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetEngagementLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get likes and replies received by the signed-in visitor's comments
+func NewGetEngagementLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetEngagementLogic {
+	return &GetEngagementLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetEngagementLogic) GetEngagement(req *types.EngagementRequest) (resp *types.EngagementResponse, err error) {
+	identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+	if err != nil {
+		return nil, err
+	}
+
+	own, err := l.svcCtx.DB.Comment.Query().
+		Where(comment.UserIdentityIDEQ(identityID), comment.IsApproved(true)).
+		Select(comment.FieldID, comment.FieldLikesCount).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.EngagementResponse{IdentityID: identityID, Comments: len(own)}
+	if len(own) == 0 {
+		return resp, nil
+	}
+	ids := make([]uuid.UUID, 0, len(own))
+	for _, c := range own {
+		ids = append(ids, c.ID)
+		resp.LikesReceived += c.LikesCount
+	}
+
+	// Replies the visitor wrote to their own comments are not counted
+	resp.RepliesReceived, err = l.svcCtx.DB.Comment.Query().
+		Where(
+			comment.ParentIDIn(ids...),
+			comment.IsApproved(true),
+			comment.Or(comment.UserIdentityIDIsNil(), comment.UserIdentityIDNEQ(identityID)),
+		).
+		Count(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
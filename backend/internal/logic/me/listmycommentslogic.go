@@ -0,0 +1,169 @@
+package me
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListMyCommentsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List the visitor's own comments across blog posts, ideas, projects and AMAs
+func NewListMyCommentsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListMyCommentsLogic {
+	return &ListMyCommentsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListMyCommentsLogic) ListMyComments(req *types.MyCommentsRequest) (resp *types.MyCommentsResponse, err error) {
+	// Signed-in visitors see the comments of their identity, anonymous ones
+	// those written from their browser
+	var owned predicate.Comment
+	switch {
+	case req.Authorization != "":
+		identityID, err := l.svcCtx.RequireIdentity(l.ctx, req.Authorization)
+		if err != nil {
+			return nil, err
+		}
+		owned = comment.UserIdentityIDEQ(identityID)
+	case req.Fingerprint != "":
+		owned = l.svcCtx.CommentByFingerprint(l.ctx, req.Fingerprint)
+	default:
+		return nil, fmt.Errorf("a session token or fingerprint is required")
+	}
+
+	where := []predicate.Comment{owned}
+	switch req.Kind {
+	case "":
+	case "blog", "idea", "project", ama.EntityType:
+		// Idea and project comments use entity types such as idea_general
+		where = append(where, comment.EntityTypeHasPrefix(req.Kind))
+	default:
+		return nil, fmt.Errorf("invalid kind %q", req.Kind)
+	}
+
+	page := max(req.Page, 1)
+	size := min(max(req.Size, 1), 100)
+	query := l.svcCtx.DB.Comment.Query().Where(where...)
+	total, err := query.Clone().Count(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := query.
+		Order(ent.Desc(comment.FieldCreatedAt)).
+		Offset((page - 1) * size).
+		Limit(size).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	titles := l.entityTitles(list)
+	resp = &types.MyCommentsResponse{
+		Comments: make([]types.MyCommentData, 0, len(list)),
+		Total:    total,
+		Page:     page,
+		Size:     size,
+	}
+	for _, c := range list {
+		data := types.MyCommentData{
+			ID:          c.ID.String(),
+			EntityType:  c.EntityType,
+			EntityID:    c.EntityID.String(),
+			EntityTitle: titles[c.EntityID],
+			URL:         commentsub.PageURL(l.svcCtx.Config.Site.BaseURL, c.EntityType, c.EntityID.String()),
+			Content:     c.Content,
+			CreatedAt:   utils.FormatTime(c.CreatedAt),
+			Status:      "published",
+			LikesCount:  c.LikesCount,
+		}
+		if c.ParentID != uuid.Nil {
+			data.ParentID = c.ParentID.String()
+		}
+		if !c.IsApproved {
+			data.Status = "pending"
+		}
+		data.IsEdited = c.IsEdited
+		resp.Comments = append(resp.Comments, data)
+	}
+	return resp, nil
+}
+
+// entityTitles returns the titles of the posts, ideas, projects and AMAs
+// the comments are on, by entity ID. Lookup failures are logged and leave
+// the titles out.
+func (l *ListMyCommentsLogic) entityTitles(list []*ent.Comment) map[uuid.UUID]string {
+	var posts, ideas, projects []uuid.UUID
+	amas := map[uuid.UUID]bool{}
+	for _, c := range list {
+		switch {
+		case c.EntityType == "blog":
+			posts = append(posts, c.EntityID)
+		case strings.HasPrefix(c.EntityType, "idea"):
+			ideas = append(ideas, c.EntityID)
+		case strings.HasPrefix(c.EntityType, "project"):
+			projects = append(projects, c.EntityID)
+		case c.EntityType == ama.EntityType:
+			amas[c.EntityID] = true
+		}
+	}
+
+	titles := make(map[uuid.UUID]string)
+	if len(posts) > 0 {
+		found, err := l.svcCtx.DB.BlogPost.Query().Where(blogpost.IDIn(posts...)).All(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to look up blog post titles: %v", err)
+		}
+		for _, p := range found {
+			titles[p.ID] = p.Title
+		}
+	}
+	if len(ideas) > 0 {
+		found, err := l.svcCtx.DB.Idea.Query().Where(idea.IDIn(ideas...)).All(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to look up idea titles: %v", err)
+		}
+		for _, i := range found {
+			titles[i.ID] = i.Title
+		}
+	}
+	if len(projects) > 0 {
+		found, err := l.svcCtx.DB.Project.Query().Where(project.IDIn(projects...)).All(l.ctx)
+		if err != nil {
+			l.Errorf("Failed to look up project titles: %v", err)
+		}
+		for _, p := range found {
+			titles[p.ID] = p.Title
+		}
+	}
+	for id := range amas {
+		a, err := l.svcCtx.AMAs.Get(l.ctx, id.String())
+		if err != nil {
+			l.Errorf("Failed to look up AMA %s: %v", id, err)
+			continue
+		}
+		titles[id] = a.Title
+	}
+	return titles
+}
@@ -0,0 +1,36 @@
+package me
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RequestDataDeletionCodeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Email a code confirming the deletion of the data of an address
+func NewRequestDataDeletionCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RequestDataDeletionCodeLogic {
+	return &RequestDataDeletionCodeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RequestDataDeletionCodeLogic) RequestDataDeletionCode(req *types.EmailCodeRequest) (resp *types.EmailCodeResponse, err error) {
+	expiresAt, err := l.svcCtx.RequestDataDeletion(l.ctx, req.Email, req.ClientIP)
+	if err != nil {
+		l.Errorf("Failed to send data deletion code: %v", err)
+		return nil, err
+	}
+
+	return &types.EmailCodeResponse{Sent: true, ExpiresAt: utils.FormatTime(expiresAt)}, nil
+}
@@ -0,0 +1,45 @@
+package moderation
+
+import (
+	"context"
+
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ApproveCommentByLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Approve a held comment with the token of a signed link, posted from its confirmation page
+func NewApproveCommentByLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ApproveCommentByLinkLogic {
+	return &ApproveCommentByLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ApproveCommentByLinkLogic) ApproveCommentByLink(req *types.ModerationLinkRequest) (resp *types.ModerationLinkResponse, err error) {
+	id, err := l.svcCtx.CommentLinkTarget(req.Token, commentnotify.ActionApprove)
+	if err != nil {
+		return nil, err
+	}
+	c, err := l.svcCtx.ApproveComment(l.ctx, id)
+	if ent.IsNotFound(err) {
+		// Approved, rejected or deleted in the meantime
+		return &types.ModerationLinkResponse{Status: "already_moderated", CommentID: id.String()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("Approved comment %s by %s from an email link", c.ID, c.AuthorName)
+	return &types.ModerationLinkResponse{Status: "approved", CommentID: c.ID.String()}, nil
+}
@@ -0,0 +1,32 @@
+package moderation
+
+import (
+	"context"
+
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmApproveCommentLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Confirm approving a held comment from the signed link in a notification email
+func NewConfirmApproveCommentLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmApproveCommentLinkLogic {
+	return &ConfirmApproveCommentLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ConfirmApproveCommentLink only shows the comment; opening the link must not
+// approve it, since mail scanners open links too.
+func (l *ConfirmApproveCommentLinkLogic) ConfirmApproveCommentLink(req *types.ModerationLinkRequest) ([]byte, error) {
+	return l.svcCtx.CommentLinkPage(l.ctx, req.Token, commentnotify.ActionApprove)
+}
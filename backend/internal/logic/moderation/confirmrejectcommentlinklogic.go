@@ -0,0 +1,32 @@
+package moderation
+
+import (
+	"context"
+
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ConfirmRejectCommentLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Confirm rejecting a held comment from the signed link in a notification email
+func NewConfirmRejectCommentLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ConfirmRejectCommentLinkLogic {
+	return &ConfirmRejectCommentLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// ConfirmRejectCommentLink only shows the comment; opening the link must not
+// reject it, since mail scanners open links too.
+func (l *ConfirmRejectCommentLinkLogic) ConfirmRejectCommentLink(req *types.ModerationLinkRequest) ([]byte, error) {
+	return l.svcCtx.CommentLinkPage(l.ctx, req.Token, commentnotify.ActionReject)
+}
@@ -0,0 +1,45 @@
+package moderation
+
+import (
+	"context"
+
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RejectCommentByLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Reject a held comment with the token of a signed link, posted from its confirmation page
+func NewRejectCommentByLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RejectCommentByLinkLogic {
+	return &RejectCommentByLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RejectCommentByLinkLogic) RejectCommentByLink(req *types.ModerationLinkRequest) (resp *types.ModerationLinkResponse, err error) {
+	id, err := l.svcCtx.CommentLinkTarget(req.Token, commentnotify.ActionReject)
+	if err != nil {
+		return nil, err
+	}
+	c, n, err := l.svcCtx.RejectComment(l.ctx, id)
+	if ent.IsNotFound(err) {
+		// Approved, rejected or deleted in the meantime
+		return &types.ModerationLinkResponse{Status: "already_moderated", CommentID: id.String()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	l.Infof("Rejected comment %s by %s from an email link (%d comments removed)", c.ID, c.AuthorName, n)
+	return &types.ModerationLinkResponse{Status: "rejected", CommentID: c.ID.String()}, nil
+}
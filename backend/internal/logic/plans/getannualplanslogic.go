@@ -9,6 +9,7 @@ import (
 	"silan-backend/internal/ent/project"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -73,8 +74,8 @@ func (l *GetAnnualPlansLogic) GetAnnualPlans(req *types.AnnualPlanListRequest) (
 			ProjectCount: len(projectTitles),
 			Objectives:   []string{fmt.Sprintf("Complete %d projects", len(projectTitles))},
 			Projects:     yearProjects[year],
-			CreatedAt:    time.Now().Format("2006-01-02T15:04:05Z"),
-			UpdatedAt:    time.Now().Format("2006-01-02T15:04:05Z"),
+			CreatedAt:    utils.FormatTime(time.Now()),
+			UpdatedAt:    utils.FormatTime(time.Now()),
 		}
 		annualPlans = append(annualPlans, plan)
 	}
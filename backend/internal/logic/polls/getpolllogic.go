@@ -0,0 +1,51 @@
+package polls
+
+import (
+	"context"
+
+	"silan-backend/internal/poll"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get a poll with its results
+func NewGetPollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPollLogic {
+	return &GetPollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPollLogic) GetPoll(req *types.PollRequest) (resp *types.PollData, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
+	p, err := l.svcCtx.Polls.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	choices, err := l.svcCtx.Polls.Choices(l.ctx, p.ID, poll.Voter{
+		Fingerprints:   l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint),
+		UserIdentityID: req.UserIdentityId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	data := p.Data(choices)
+	return &data, nil
+}
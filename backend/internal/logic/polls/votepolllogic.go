@@ -0,0 +1,68 @@
+package polls
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/poll"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VotePollLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Vote on a poll
+func NewVotePollLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VotePollLogic {
+	return &VotePollLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VotePollLogic) VotePoll(req *types.PollVoteRequest) (resp *types.PollData, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
+	if req.UserIdentityId == "" && req.Fingerprint == "" {
+		return nil, fmt.Errorf("either session_token or fingerprint must be provided")
+	}
+
+	voter := poll.Voter{
+		Fingerprints:   l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint),
+		UserIdentityID: req.UserIdentityId,
+		IP:             req.ClientIP,
+	}
+
+	err = l.svcCtx.Polls.Vote(l.ctx, req.ID, req.OptionIDs, voter)
+	if errors.Is(err, poll.ErrNotFound) || errors.Is(err, poll.ErrClosed) || errors.Is(err, poll.ErrInvalidChoice) {
+		return nil, err
+	}
+	if err != nil {
+		l.Errorf("Failed to record vote on poll %s: %v", req.ID, err)
+		return nil, fmt.Errorf("failed to record vote")
+	}
+
+	p, err := l.svcCtx.Polls.Get(l.ctx, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	choices, err := l.svcCtx.Polls.Choices(l.ctx, p.ID, voter)
+	if err != nil {
+		return nil, err
+	}
+
+	data := p.Data(choices)
+	return &data, nil
+}
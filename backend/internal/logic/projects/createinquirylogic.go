@@ -0,0 +1,80 @@
+package projects
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/inquiry"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateInquiryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Send a hire me inquiry about a project
+func NewCreateInquiryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateInquiryLogic {
+	return &CreateInquiryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateInquiryLogic) CreateInquiry(req *types.CreateInquiryRequest) (resp *types.CreateInquiryResponse, err error) {
+	// Bots fill in the hidden website field; they get the same answer as a
+	// visitor so they don't learn to skip it
+	if req.Website != "" {
+		l.Infof("Dropped inquiry with honeypot field from %s", req.ClientIP)
+		return &types.CreateInquiryResponse{Received: true}, nil
+	}
+	if err := l.svcCtx.CheckInquiry(l.ctx, req.ClientIP, req.Fingerprint); err != nil {
+		return nil, err
+	}
+	if err := l.svcCtx.CheckCaptcha(l.ctx, req.CaptchaToken, req.ClientIP, ""); err != nil {
+		return nil, err
+	}
+
+	projectID, err := uuid.Parse(req.ProjectID)
+	if err != nil {
+		return nil, errors.New("invalid project ID")
+	}
+	proj, err := l.svcCtx.DB.Project.Get(l.ctx, projectID)
+	if ent.IsNotFound(err) || (err == nil && !proj.IsPublic) {
+		return nil, errors.New("project not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	in := &inquiry.Inquiry{
+		ProjectID:    proj.ID.String(),
+		ProjectTitle: proj.Title,
+		Name:         req.Name,
+		Email:        req.Email,
+		Company:      req.Company,
+		Budget:       req.Budget,
+		Timeline:     req.Timeline,
+		Message:      req.Message,
+		IP:           req.ClientIP,
+		Fingerprint:  l.svcCtx.Fingerprints.Hash(l.ctx, req.Fingerprint),
+	}
+	inquiry.Normalize(in)
+	if in.Name == "" || in.Message == "" {
+		return nil, errors.New("name and message are required")
+	}
+	if err := l.svcCtx.Inquiries.Create(l.ctx, in); err != nil {
+		l.Errorf("Failed to store inquiry about project %s: %v", req.ProjectID, err)
+		return nil, errors.New("failed to send inquiry")
+	}
+
+	l.Infof("Received inquiry %s about project %s (ip: %s, fingerprint: %s)", in.ID, in.ProjectID, req.ClientIP, in.Fingerprint)
+	return &types.CreateInquiryResponse{Received: true}, nil
+}
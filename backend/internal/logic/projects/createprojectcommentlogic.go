@@ -5,11 +5,15 @@ import (
 	"errors"
 	"fmt"
 	"strings"
-	"time"
 
+	"silan-backend/internal/ban"
+	"silan-backend/internal/commentlang"
 	"silan-backend/internal/ent"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/spam"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
@@ -32,6 +36,13 @@ func NewCreateProjectCommentLogic(ctx context.Context, svcCtx *svc.ServiceContex
 }
 
 func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjectCommentRequest) (resp *types.ProjectCommentData, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	if strings.TrimSpace(req.Content) == "" {
 		return nil, fmt.Errorf("content is required")
 	}
@@ -54,6 +65,11 @@ func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjec
 		if parentComment.EntityID.String() != req.ID {
 			return nil, errors.New("parent comment belongs to different project")
 		}
+		// Replies past the depth limit are attached one level up
+		parentIDParsed, err = l.svcCtx.ReplyParent(l.ctx, parentComment)
+		if err != nil {
+			return nil, err
+		}
 		parentUUID = &parentIDParsed
 	}
 
@@ -73,8 +89,9 @@ func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjec
 		if authorName == "" {
 			return nil, fmt.Errorf("author_name is required for anonymous comments")
 		}
-		if authorEmail == "" || !strings.Contains(authorEmail, "@") || len(authorEmail) < 5 {
-			return nil, fmt.Errorf("author_email is required and must be valid")
+		// The address format is checked by the request validator
+		if authorEmail == "" {
+			return nil, fmt.Errorf("author_email is required for anonymous comments")
 		}
 		// Try to get avatar from stored identities using entgo
 		userIdentity, err := l.svcCtx.DB.UserIdentity.Query().
@@ -89,10 +106,7 @@ func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjec
 	}
 
 	// Prepare user agent tagging with fingerprint
-	userAgent := "fp:" + req.Fingerprint
-	if req.UserAgentFull != "" {
-		userAgent += " | " + req.UserAgentFull
-	}
+	userAgent := l.svcCtx.CommentUserAgent(l.ctx, req.Fingerprint, req.UserAgentFull)
 
 	// Parse project ID
 	projectUUID, err := uuid.Parse(req.ID)
@@ -100,17 +114,91 @@ func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjec
 		return nil, fmt.Errorf("invalid project id")
 	}
 
-	// Create comment using entgo
 	// Use entity_type with project_<type> for better filtering while keeping the type field
 	entityType := "project_" + strings.ToLower(req.Type)
-	commentBuilder := l.svcCtx.DB.Comment.Create().
+
+	// Banned visitors can't comment at all
+	if err := l.svcCtx.CheckBanned(l.ctx, entityType+":"+req.ID, ban.Actor{
+		IdentityID:  req.UserIdentityId,
+		Email:       authorEmail,
+		Fingerprint: req.Fingerprint,
+		IP:          req.ClientIP,
+	}); err != nil {
+		return nil, err
+	}
+
+	// A double click or resubmit gets the comment already stored
+	existing, err := l.svcCtx.DuplicateComment(l.ctx, svc.CommentDraft{
+		EntityType:  entityType,
+		EntityID:    projectUUID,
+		ParentID:    parentUUID,
+		IdentityID:  req.UserIdentityId,
+		Email:       authorEmail,
+		Fingerprint: req.Fingerprint,
+		Content:     req.Content,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for duplicate comments: %w", err)
+	}
+	if existing != nil {
+		l.Infof("Returned comment %s for a duplicate submission (ip: %s)", existing.ID, req.ClientIP)
+		return l.commentData(existing, avatarURL, !existing.IsApproved), nil
+	}
+
+	// Floods of comments from one browser or address are refused
+	if err := l.svcCtx.CheckCommentRate(l.ctx, req.ClientIP, req.Fingerprint); err != nil {
+		return nil, err
+	}
+
+	// Anonymous comments need a solved captcha when one is configured
+	if err := l.svcCtx.CheckCaptcha(l.ctx, req.CaptchaToken, req.ClientIP, req.UserIdentityId); err != nil {
+		return nil, err
+	}
+
+	// Blocked words reject the comment, are masked or hold it for approval
+	content, filterHeld, err := l.svcCtx.FilterComment(l.ctx, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	req.Content = content
+
+	// The first comment of an unknown author waits for approval
+	held, err := l.svcCtx.HoldComment(l.ctx, entityType, req.UserIdentityId, authorEmail, req.Fingerprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check comment moderation: %w", err)
+	}
+
+	// Likely spam waits for approval as well
+	verdict, spamHeld := l.svcCtx.ScoreComment(l.ctx, spam.Submission{
+		EntityType:    entityType,
+		AuthorName:    authorName,
+		AuthorEmail:   authorEmail,
+		AuthorWebsite: req.AuthorWebsite,
+		Content:       req.Content,
+		IP:            req.ClientIP,
+		UserAgent:     req.UserAgentFull,
+		Fingerprint:   req.Fingerprint,
+	})
+	held = held || spamHeld || filterHeld
+
+	// Owner comments get the author badge
+	isAuthor := l.svcCtx.IsOwnerIdentity(l.ctx, req.UserIdentityId)
+
+	// Create comment using entgo; it is written together with its outbox event
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	commentBuilder := tx.Comment.Create().
 		SetEntityType(entityType).
 		SetEntityID(projectUUID).
 		SetType(req.Type).
 		SetAuthorName(authorName).
 		SetAuthorEmail(authorEmail).
 		SetContent(req.Content).
-		SetIsApproved(true). // Auto-approve for now
+		SetLanguage(commentlang.Detect(req.Content)).
+		SetIsApproved(!held).
 		SetLikesCount(0)
 
 	if parentUUID != nil {
@@ -126,14 +214,43 @@ func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjec
 		commentBuilder = commentBuilder.SetUserAgent(userAgent)
 	}
 	if req.UserIdentityId != "" {
-		commentBuilder = commentBuilder.SetUserIdentityID(req.UserIdentityId)
+		commentBuilder = commentBuilder.
+			SetUserIdentityID(req.UserIdentityId).
+			SetIsAuthor(isAuthor)
 	}
 
 	comment, err := commentBuilder.Save(l.ctx)
 	if err != nil {
+		tx.Rollback()
 		return nil, err
 	}
+	if err := l.svcCtx.PublishEvent(l.ctx, tx, outbox.EventCommentCreated, outbox.NewCommentEvent(comment)); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record comment event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	l.svcCtx.RecordSpamScore(l.ctx, comment.ID.String(), verdict)
+
+	// Follow the thread, or the whole page, by email; the address is
+	// confirmed before any notification is sent
+	if req.NotifyReplies && comment.AuthorEmail != "" {
+		if err := l.svcCtx.ReplyNotifier.Subscribe(l.ctx, comment, comment.AuthorEmail); err != nil {
+			l.Errorf("Failed to subscribe the author of comment %s to replies: %v", comment.ID, err)
+		}
+	}
+	if req.NotifyComments && comment.AuthorEmail != "" {
+		if err := l.svcCtx.ReplyNotifier.SubscribePage(l.ctx, comment, comment.AuthorEmail); err != nil {
+			l.Errorf("Failed to subscribe the author of comment %s to the page: %v", comment.ID, err)
+		}
+	}
 
+	return l.commentData(comment, avatarURL, held), nil
+}
+
+// commentData builds the response for a stored comment.
+func (l *CreateProjectCommentLogic) commentData(comment *ent.Comment, avatarURL string, pending bool) *types.ProjectCommentData {
 	parentIDStr := ""
 	if comment.ParentID != (uuid.UUID{}) {
 		parentIDStr = comment.ParentID.String()
@@ -147,10 +264,12 @@ func (l *CreateProjectCommentLogic) CreateProjectComment(req *types.CreateProjec
 		AuthorAvatarURL: avatarURL,
 		Content:         comment.Content,
 		Type:            comment.Type,
-		CreatedAt:       comment.CreatedAt.Format(time.RFC3339),
+		CreatedAt:       utils.FormatTime(comment.CreatedAt),
 		UserIdentityID:  comment.UserIdentityID,
 		LikesCount:      comment.LikesCount,
 		IsLikedByUser:   false,
+		IsAuthor:        l.svcCtx.IsOwnerComment(comment),
+		Pending:         pending,
 		Replies:         []types.ProjectCommentData{},
-	}, nil
+	}
 }
@@ -3,7 +3,6 @@ package projects
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"silan-backend/internal/ent/comment"
 	"silan-backend/internal/svc"
@@ -29,6 +28,13 @@ func NewDeleteProjectCommentLogic(ctx context.Context, svcCtx *svc.ServiceContex
 }
 
 func (l *DeleteProjectCommentLogic) DeleteProjectComment(req *types.DeleteProjectCommentRequest) error {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return err
+	}
+	req.UserIdentityId = identityID
+
 	commentUUID, err := uuid.Parse(req.CommentID)
 	if err != nil {
 		return fmt.Errorf("invalid comment id")
@@ -48,40 +54,14 @@ func (l *DeleteProjectCommentLogic) DeleteProjectComment(req *types.DeleteProjec
 	if req.UserIdentityId != "" && cmt.UserIdentityID != "" && req.UserIdentityId == cmt.UserIdentityID {
 		authorized = true
 	}
-	if !authorized && req.Fingerprint != "" && strings.Contains(cmt.UserAgent, "fp:"+req.Fingerprint) {
+	if !authorized && l.svcCtx.IsCommentAuthor(l.ctx, cmt, "", req.Fingerprint) {
 		authorized = true
 	}
 	if !authorized {
 		return fmt.Errorf("forbidden: insufficient permissions to delete this comment")
 	}
 
-	// Recursive delete
-	return l.deleteWithReplies(req.CommentID)
-}
-
-func (l *DeleteProjectCommentLogic) deleteWithReplies(commentID string) error {
-	commentUUID, err := uuid.Parse(commentID)
-	if err != nil {
-		return err
-	}
-
-	// Find replies using entgo (filter by entity_type like blog implementation)
-	replies, err := l.svcCtx.DB.Comment.Query().
-		Where(comment.ParentIDEQ(commentUUID)).
-		Where(comment.EntityTypeHasPrefix("project")).
-		All(l.ctx)
-	if err != nil {
-		return err
-	}
-
-	// Recursively delete replies
-	for _, reply := range replies {
-		if err := l.deleteWithReplies(reply.ID.String()); err != nil {
-			return err
-		}
-	}
-
-	// Delete self using entgo
-	err = l.svcCtx.DB.Comment.DeleteOneID(commentUUID).Exec(l.ctx)
+	// Replies by others stay in the thread under a tombstone
+	_, err = l.svcCtx.DeleteComment(l.ctx, cmt)
 	return err
 }
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"silan-backend/internal/ent/project"
+	"silan-backend/internal/mapper"
+	"silan-backend/internal/preview"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -37,36 +39,17 @@ func (l *GetProjectByIdLogic) GetProjectById(req *types.ProjectByIdRequest) (res
 	// Get the project by UUID
 	proj, err := l.svcCtx.DB.Project.Query().
 		Where(project.ID(projectID)).
-		Where(project.IsPublic(true)).
 		WithTechnologies().
 		First(l.ctx)
 	if err != nil {
 		return nil, fmt.Errorf("project with ID %s not found", req.ID)
 	}
 
-	var technologies []string
-	for _, tech := range proj.Edges.Technologies {
-		technologies = append(technologies, tech.TechnologyName)
-	}
-
-	// Get the year from start date or created date
-	year := proj.CreatedAt.Year()
-	if !proj.StartDate.IsZero() {
-		year = proj.StartDate.Year()
+	// Private projects are only shown with a preview token for them
+	if !proj.IsPublic && !l.svcCtx.CanPreview(req.Preview, preview.KindProject, proj.ID.String()) {
+		return nil, fmt.Errorf("project with ID %s not found", req.ID)
 	}
 
-	// Generate annual plan name based on year
-	annualPlan := fmt.Sprintf("Annual Plan %d", year)
-
-	// Handle description field (now non-nullable)
-	description := proj.Description
-
-	return &types.Project{
-		ID:          proj.ID.String(),
-		Name:        proj.Title,
-		Description: description,
-		Tags:        technologies,
-		Year:        year,
-		AnnualPlan:  annualPlan,
-	}, nil
+	data := mapper.Project(proj)
+	return &data, nil
 }
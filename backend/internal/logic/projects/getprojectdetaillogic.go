@@ -2,8 +2,10 @@ package projects
 
 import (
 	"context"
-	"strings"
+	"errors"
 	"silan-backend/internal/ent/project"
+	"silan-backend/internal/mapper"
+	"silan-backend/internal/preview"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -26,41 +28,6 @@ func NewGetProjectDetailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
-func (l *GetProjectDetailLogic) GetLicenseText(str string) string {
-	if str == "" {
-		return "MIT"
-	}
-
-	str = strings.TrimSpace(str)
-	if str == "" {
-		return "MIT"
-	}
-
-	// Simple license detection
-	lower := strings.ToLower(str)
-
-	if strings.Contains(lower, "mit") {
-		return "MIT"
-	}
-	if strings.Contains(lower, "apache") {
-		return "Apache 2.0"
-	}
-	if strings.Contains(lower, "gpl") || strings.Contains(lower, "gnu") {
-		return "GPL"
-	}
-	if strings.Contains(lower, "bsd") {
-		return "BSD"
-	}
-
-	// If it's a short string, return it directly
-	if len(str) <= 50 {
-		return str
-	}
-
-	// For longer text, return first 50 chars
-	return str[:50] + "..."
-}
-
 func (l *GetProjectDetailLogic) GetProjectDetail(req *types.ProjectDetailRequest) (resp *types.ProjectDetail, err error) {
 	projectUUID, err := uuid.Parse(req.ID)
 	if err != nil {
@@ -70,7 +37,6 @@ func (l *GetProjectDetailLogic) GetProjectDetail(req *types.ProjectDetailRequest
 	// Fetch project with all related data including details
 	proj, err := l.svcCtx.DB.Project.Query().
 		Where(project.ID(projectUUID)).
-		Where(project.IsPublic(true)).
 		WithUser().
 		WithTechnologies().
 		WithDetails().
@@ -80,75 +46,11 @@ func (l *GetProjectDetailLogic) GetProjectDetail(req *types.ProjectDetailRequest
 		return nil, err
 	}
 
-	// Get basic project information
-	var startDate, endDate string
-	if !proj.StartDate.IsZero() {
-		startDate = proj.StartDate.Format("2006-01-02")
-	}
-	if !proj.EndDate.IsZero() {
-		endDate = proj.EndDate.Format("2006-01-02")
-	}
-
-	// Parse timeline
-	var timeline types.ProjectTimeline
-	timeline.Start = startDate
-	timeline.End = endDate
-	if startDate != "" && endDate != "" {
-		timeline.Duration = "Completed"
-	} else if startDate != "" {
-		timeline.Duration = "In Progress"
-	} else {
-		timeline.Duration = ""
-	}
-
-	// Parse metrics
-	var metrics types.ProjectMetrics
-	metrics.LinesOfCode = 0 // These could be calculated from git repos
-	metrics.Commits = 0
-	metrics.Stars = proj.LikeCount
-	metrics.Downloads = 0
-
-	// Create detail information
-	var detailID string
-	var detailedDescription, release, dependencies, quickStart, license, version string
-	var licenseText string
-	var createdAt, updatedAt string
-	if proj.Edges.Details != nil {
-		detail := proj.Edges.Details
-		detailID = detail.ID.String()
-		detailedDescription = detail.ProjectDetails
-		release = detail.ReleaseNotes
-		dependencies = detail.Dependencies
-		quickStart = detail.QuickStart
-		license = l.GetLicenseText(detail.LicenseText)
-		licenseText = detail.LicenseText
-		version = detail.Version
-		createdAt = detail.CreatedAt.Format("2006-01-02 15:04:05")
-		updatedAt = detail.UpdatedAt.Format("2006-01-02 15:04:05")
-	} else {
-		// No details found - return empty values
-		detailID = proj.ID.String()
-		detailedDescription = ""
-		license = "MIT"
-		version = "1.0.0"
-		createdAt = proj.CreatedAt.Format("2006-01-02 15:04:05")
-		updatedAt = proj.UpdatedAt.Format("2006-01-02 15:04:05")
+	// Private projects are only shown with a preview token for them
+	if !proj.IsPublic && !l.svcCtx.CanPreview(req.Preview, preview.KindProject, proj.ID.String()) {
+		return nil, errors.New("project not found")
 	}
 
-	return &types.ProjectDetail{
-		ID:                  detailID,
-		ProjectID:           proj.ID.String(),
-		DetailedDescription: detailedDescription,
-		Release:             release,
-		QuickStart:          quickStart,
-		Dependance:          dependencies,
-		License:             license,
-		LicenseText:         licenseText,
-		Version:             version,
-		Timeline:            timeline,
-		Metrics:             metrics,
-		RelatedBlogs:        []types.ProjectBlogRef{}, // This would need to be implemented
-		CreatedAt:           createdAt,
-		UpdatedAt:           updatedAt,
-	}, nil
+	data := mapper.ProjectDetail(proj, proj.Edges.Details)
+	return &data, nil
 }
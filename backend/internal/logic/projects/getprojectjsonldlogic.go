@@ -0,0 +1,57 @@
+package projects
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/mapper"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetProjectJsonLdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get schema.org SoftwareSourceCode JSON-LD of a public project
+func NewGetProjectJsonLdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetProjectJsonLdLogic {
+	return &GetProjectJsonLdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetProjectJsonLdLogic) GetProjectJsonLd(req *types.JsonLdRequest) (resp *types.SoftwareSourceCodeJsonLd, err error) {
+	proj, err := l.svcCtx.DB.Project.Query().
+		Where(project.Slug(req.Slug), project.IsPublic(true)).
+		WithUser().
+		WithTechnologies().
+		WithDetails().
+		First(l.ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("project not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Without personal info the author falls back to the user record
+	author, err := l.svcCtx.OwnerJsonLd(l.ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		l.Errorf("Failed to load site owner for JSON-LD: %v", err)
+		return nil, fmt.Errorf("failed to load project author")
+	}
+
+	data := mapper.SoftwareSourceCodeJsonLd(proj, author, strings.TrimRight(l.svcCtx.Config.Site.BaseURL, "/"))
+	return &data, nil
+}
@@ -2,9 +2,11 @@ package projects
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	"silan-backend/internal/ent/project"
+	"silan-backend/internal/mapper"
+	"silan-backend/internal/preview"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -38,63 +40,11 @@ func (l *GetProjectLogic) GetProject(req *types.ProjectRequest) (resp *types.Pro
 		return nil, err
 	}
 
-	var startDate, endDate string
-	if !proj.StartDate.IsZero() {
-		startDate = proj.StartDate.Format("2006-01-02")
-	}
-	if !proj.EndDate.IsZero() {
-		endDate = proj.EndDate.Format("2006-01-02")
-	}
-
-	var technologies []string
-	for _, tech := range proj.Edges.Technologies {
-		technologies = append(technologies, tech.TechnologyName)
-	}
-
-	// Handle description field (now non-nullable)
-	description := proj.Description
-
-	// Generate annual plan name based on year
-	year := proj.CreatedAt.Year()
-	if !proj.StartDate.IsZero() {
-		year = proj.StartDate.Year()
-	}
-	annualPlan := fmt.Sprintf("Annual Plan %d", year)
-
-	// Handle URL fields (now non-nullable)
-	githubURL := proj.GithubURL
-	demoURL := proj.DemoURL
-	documentationURL := proj.DocumentationURL
-	thumbnailURL := proj.ThumbnailURL
-
-	// Get user ID from edge relationship
-	var userID string
-	if proj.Edges.User != nil {
-		userID = proj.Edges.User.ID.String()
+	// Private projects are only shown with a preview token for them
+	if !proj.IsPublic && !l.svcCtx.CanPreview(req.Preview, preview.KindProject, proj.ID.String()) {
+		return nil, errors.New("project not found")
 	}
 
-	return &types.ProjectExtended{
-		ID:               proj.ID.String(),
-		UserID:           userID,
-		Title:            proj.Title,
-		Slug:             proj.Slug,
-		Description:      description,
-		ProjectType:      proj.ProjectType,
-		Status:           string(proj.Status),
-		StartDate:        startDate,
-		EndDate:          endDate,
-		Technologies:     technologies,
-		GithubURL:        githubURL,
-		DemoURL:          demoURL,
-		DocumentationURL: documentationURL,
-		ThumbnailURL:     thumbnailURL,
-		IsFeatured:       proj.IsFeatured,
-		IsPublic:         proj.IsPublic,
-		ViewCount:        int64(proj.ViewCount),
-		SortOrder:        proj.SortOrder,
-		Year:             year,
-		AnnualPlan:       annualPlan,
-		CreatedAt:        proj.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:        proj.UpdatedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+	data := mapper.ProjectExtended(proj)
+	return &data, nil
 }
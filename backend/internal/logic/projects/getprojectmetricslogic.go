@@ -27,6 +27,13 @@ func NewGetProjectMetricsLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *GetProjectMetricsLogic) GetProjectMetrics(req *types.ProjectMetricsRequest) (resp *types.ProjectMetricsResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	// Parse project UUID
 	projectID, err := uuid.Parse(req.ProjectID)
 	if err != nil {
@@ -56,7 +63,7 @@ func (l *GetProjectMetricsLogic) GetProjectMetrics(req *types.ProjectMetricsRequ
 		// For anonymous users
 		likeCount, err := l.svcCtx.DB.ProjectLike.Query().
 			Where(projectlike.ProjectID(projectID)).
-			Where(projectlike.Fingerprint(req.Fingerprint)).
+			Where(projectlike.FingerprintIn(l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)...)).
 			Count(l.ctx)
 		if err != nil {
 			return nil, err
@@ -2,11 +2,11 @@ package projects
 
 import (
 	"context"
-	"fmt"
 	"math"
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/project"
+	"silan-backend/internal/mapper"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -76,34 +76,7 @@ func (l *GetProjectsLogic) GetProjects(req *types.ProjectListRequest) (resp *typ
 		return nil, err
 	}
 
-	result := make([]types.Project, 0)
-	for _, proj := range projects {
-		var technologies []string
-		for _, tech := range proj.Edges.Technologies {
-			technologies = append(technologies, tech.TechnologyName)
-		}
-
-		// Get the year from start date or created date
-		year := proj.CreatedAt.Year()
-		if !proj.StartDate.IsZero() {
-			year = proj.StartDate.Year()
-		}
-
-		// Generate annual plan name based on year
-		annualPlan := fmt.Sprintf("Annual Plan %d", year)
-
-		// Handle description field (now non-nullable)
-		description := proj.Description
-
-		result = append(result, types.Project{
-			ID:          proj.ID.String(),
-			Name:        proj.Title,
-			Description: description,
-			Tags:        technologies,
-			Year:        year,
-			AnnualPlan:  annualPlan,
-		})
-	}
+	result := mapper.Projects(projects)
 
 	totalPages := int(math.Ceil(float64(total) / float64(req.Size)))
 
@@ -2,10 +2,12 @@ package projects
 
 import (
 	"context"
+	"fmt"
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/project"
 	"silan-backend/internal/ent/projectlike"
+	"silan-backend/internal/outbox"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -29,6 +31,13 @@ func NewLikeProjectLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LikeP
 }
 
 func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *types.LikeProjectResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	// Parse project UUID
 	projectID, err := uuid.Parse(req.ProjectID)
 	if err != nil {
@@ -39,12 +48,18 @@ func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *typ
 	clientIP := req.ClientIP
 	userAgent := req.UserAgentFull
 
+	tx, err := l.svcCtx.DB.Tx(l.ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer tx.Rollback()
+
 	// Check if user already liked this project
 	var existingLike *ent.ProjectLike
 
 	if req.UserIdentityId != "" {
 		// For authenticated users
-		existingLike, err = l.svcCtx.DB.ProjectLike.Query().
+		existingLike, err = tx.ProjectLike.Query().
 			Where(projectlike.ProjectID(projectID)).
 			Where(projectlike.UserIdentityID(req.UserIdentityId)).
 			Only(l.ctx)
@@ -53,9 +68,9 @@ func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *typ
 		}
 	} else if req.Fingerprint != "" {
 		// For anonymous users
-		existingLike, err = l.svcCtx.DB.ProjectLike.Query().
+		existingLike, err = tx.ProjectLike.Query().
 			Where(projectlike.ProjectID(projectID)).
-			Where(projectlike.Fingerprint(req.Fingerprint)).
+			Where(projectlike.FingerprintIn(l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)...)).
 			Only(l.ctx)
 		if err != nil && !ent.IsNotFound(err) {
 			return nil, err
@@ -67,13 +82,13 @@ func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *typ
 
 	if isLiked {
 		// Unlike: remove like record and decrement counter
-		err = l.svcCtx.DB.ProjectLike.DeleteOne(existingLike).Exec(l.ctx)
+		err = tx.ProjectLike.DeleteOne(existingLike).Exec(l.ctx)
 		if err != nil {
 			return nil, err
 		}
 
 		// Decrement like count
-		err = l.svcCtx.DB.Project.Update().
+		err = tx.Project.Update().
 			Where(project.ID(projectID)).
 			AddLikeCount(-1).
 			Exec(l.ctx)
@@ -81,15 +96,19 @@ func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *typ
 			return nil, err
 		}
 	} else {
+		if err := l.svcCtx.CheckLike(l.ctx, "project", req.ProjectID, clientIP, req.Fingerprint, req.UserIdentityId); err != nil {
+			return nil, err
+		}
+
 		// Like: create like record and increment counter
-		builder := l.svcCtx.DB.ProjectLike.Create().
+		builder := tx.ProjectLike.Create().
 			SetProjectID(projectID)
 
 		if req.UserIdentityId != "" {
 			builder = builder.SetUserIdentityID(req.UserIdentityId)
 		}
 		if req.Fingerprint != "" {
-			builder = builder.SetFingerprint(req.Fingerprint)
+			builder = builder.SetFingerprint(l.svcCtx.Fingerprints.Hash(l.ctx, req.Fingerprint))
 		}
 		if clientIP != "" {
 			builder = builder.SetIPAddress(clientIP)
@@ -104,7 +123,7 @@ func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *typ
 		}
 
 		// Increment like count
-		err = l.svcCtx.DB.Project.Update().
+		err = tx.Project.Update().
 			Where(project.ID(projectID)).
 			AddLikeCount(1).
 			Exec(l.ctx)
@@ -114,13 +133,29 @@ func (l *LikeProjectLogic) LikeProject(req *types.LikeProjectRequest) (resp *typ
 	}
 
 	// Get updated like count
-	proj, err := l.svcCtx.DB.Project.Get(l.ctx, projectID)
+	proj, err := tx.Project.Get(l.ctx, projectID)
 	if err != nil {
 		return nil, err
 	}
 
 	likesCount = proj.LikeCount
 
+	// Record the toggle in the outbox as part of the same transaction
+	eventType := outbox.EventProjectLiked
+	if isLiked {
+		eventType = outbox.EventProjectUnliked
+	}
+	err = l.svcCtx.PublishEvent(l.ctx, tx, eventType, outbox.LikeEvent{
+		ID:         projectID.String(),
+		LikesCount: likesCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to record like event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return &types.LikeProjectResponse{
 		LikesCount:    likesCount,
 		IsLikedByUser: !isLiked, // Toggle the state
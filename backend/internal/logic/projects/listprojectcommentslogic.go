@@ -3,13 +3,15 @@ package projects
 import (
 	"context"
 	"strings"
-	"time"
 
 	"silan-backend/internal/ent"
 	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
 	"silan-backend/internal/ent/useridentity"
 	"silan-backend/internal/svc"
+	"silan-backend/internal/tombstone"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
@@ -40,23 +42,35 @@ func (l *ListProjectCommentsLogic) ListProjectComments(req *types.ProjectComment
 
 	// Fetch comments using entgo - using project_<type> entity type format
 	desiredEntityType := "project_" + strings.ToLower(req.Type)
+	where := []predicate.Comment{
+		comment.EntityIDEQ(projectUUID),
+		func(s *sql.Selector) {
+			s.Where(sql.Or(
+				sql.EQ(s.C("entity_type"), "project"),
+				sql.EQ(s.C("entity_type"), desiredEntityType),
+			))
+		},
+		comment.TypeEQ(req.Type),
+		// Held comments stay hidden until they are approved
+		comment.IsApproved(true),
+	}
+	// Bilingual pages can show each language's threads apart
+	if req.CommentLanguage != "" {
+		inLanguage, err := l.svcCtx.InCommentLanguage(l.ctx, req.CommentLanguage, where...)
+		if err != nil {
+			return nil, err
+		}
+		where = append(where, inLanguage)
+	}
 	comments, err := l.svcCtx.DB.Comment.
 		Query().
-		Where(
-			comment.EntityIDEQ(projectUUID),
-			func(s *sql.Selector) {
-				s.Where(sql.Or(
-					sql.EQ(s.C("entity_type"), "project"),
-					sql.EQ(s.C("entity_type"), desiredEntityType),
-				))
-			},
-			comment.TypeEQ(req.Type),
-		).
-		Order(ent.Asc(comment.FieldCreatedAt)).
+		Where(where...).
+		Order(svc.CommentOrder(req.Sort)...).
 		All(l.ctx)
 	if err != nil {
 		return nil, err
 	}
+	comments, langs, languages := svc.CommentLanguageThreads(comments, req.GroupByLanguage)
 
 	lookupAvatar := func(email string) string {
 		if email == "" {
@@ -89,17 +103,32 @@ func (l *ListProjectCommentsLogic) ListProjectComments(req *types.ProjectComment
 			AuthorAvatarURL: lookupAvatar(comment.AuthorEmail),
 			Content:         comment.Content,
 			Type:            comment.Type,
-			CreatedAt:       comment.CreatedAt.Format(time.RFC3339),
+			CreatedAt:       utils.FormatTime(comment.CreatedAt),
 			UserIdentityID:  comment.UserIdentityID,
 			LikesCount:      comment.LikesCount,
 			IsLikedByUser:   false,
+			IsAuthor:        l.svcCtx.IsOwnerComment(comment),
+			EmailVerified:   comment.EmailVerified,
+			Language:        langs[comment.ID.String()],
 			Replies:         []types.ProjectCommentData{},
 		}
+		if comment.DeletedAt != nil {
+			// Tombstones keep their place for the replies but show nothing
+			commentData.AuthorName = tombstone.Placeholder
+			commentData.Content = tombstone.Placeholder
+			commentData.IsDeleted = true
+			commentData.DeletedAt = utils.FormatTime(*comment.DeletedAt)
+		} else if comment.EditedAt != nil {
+			commentData.IsEdited = true
+			commentData.EditedAt = utils.FormatTime(*comment.EditedAt)
+		}
 		commentMap[comment.ID.String()] = &commentData
 		order = append(order, comment.ID.String())
 	}
 
-	// Build tree: parent->children
+	// Build tree: parent->children; replies past the depth limit are listed
+	// flat under their deepest allowed ancestor
+	parents := l.svcCtx.ThreadParents(comments)
 	var rootIDs []string
 	for _, id := range order {
 		c := commentMap[id]
@@ -107,7 +136,7 @@ func (l *ListProjectCommentsLogic) ListProjectComments(req *types.ProjectComment
 			rootIDs = append(rootIDs, id)
 			continue
 		}
-		if parent, ok := commentMap[c.ParentID]; ok {
+		if parent, ok := commentMap[parents[id]]; ok {
 			parent.Replies = append(parent.Replies, *c)
 		}
 	}
@@ -122,5 +151,5 @@ func (l *ListProjectCommentsLogic) ListProjectComments(req *types.ProjectComment
 	if roots == nil {
 		roots = []types.ProjectCommentData{}
 	}
-	return &types.ProjectCommentListResponse{Comments: roots, Total: len(order)}, nil
+	return &types.ProjectCommentListResponse{Comments: roots, Total: len(order), Languages: languages}, nil
 }
@@ -29,12 +29,28 @@ func NewRecordProjectViewLogic(ctx context.Context, svcCtx *svc.ServiceContext)
 }
 
 func (l *RecordProjectViewLogic) RecordProjectView(req *types.RecordProjectViewRequest) (resp *types.RecordProjectViewResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	req.UserIdentityId = identityID
+
 	// Parse project UUID
 	projectID, err := uuid.Parse(req.ProjectID)
 	if err != nil {
 		return nil, err
 	}
 
+	// Visitors who opted out of tracking only get the current count back
+	if req.DoNotTrack {
+		proj, err := l.svcCtx.DB.Project.Get(l.ctx, projectID)
+		if err != nil {
+			return nil, err
+		}
+		return &types.RecordProjectViewResponse{ViewsCount: proj.ViewCount}, nil
+	}
+
 	// Get client IP and user agent from context if available
 	clientIP := req.ClientIP
 	userAgent := req.UserAgentFull
@@ -59,7 +75,7 @@ func (l *RecordProjectViewLogic) RecordProjectView(req *types.RecordProjectViewR
 		// For anonymous users
 		count, err := l.svcCtx.DB.ProjectView.Query().
 			Where(projectview.ProjectID(projectID)).
-			Where(projectview.Fingerprint(req.Fingerprint)).
+			Where(projectview.FingerprintIn(l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint)...)).
 			Where(projectview.CreatedAtGT(oneHourAgo)).
 			Count(l.ctx)
 		if err != nil {
@@ -79,7 +95,7 @@ func (l *RecordProjectViewLogic) RecordProjectView(req *types.RecordProjectViewR
 			builder = builder.SetUserIdentityID(req.UserIdentityId)
 		}
 		if req.Fingerprint != "" {
-			builder = builder.SetFingerprint(req.Fingerprint)
+			builder = builder.SetFingerprint(l.svcCtx.Fingerprints.Hash(l.ctx, req.Fingerprint))
 		}
 		if clientIP != "" {
 			builder = builder.SetIPAddress(clientIP)
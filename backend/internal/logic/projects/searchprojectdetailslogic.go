@@ -2,12 +2,12 @@ package projects
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	"silan-backend/internal/ent/project"
 	"silan-backend/internal/ent/projectdetail"
 	"silan-backend/internal/ent/projecttechnology"
+	"silan-backend/internal/mapper"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
 
@@ -83,73 +83,15 @@ func (l *SearchProjectDetailsLogic) SearchProjectDetails(req *types.ProjectSearc
 	// Convert to response format
 	result := make([]types.ProjectDetail, 0, len(projectDetails))
 	for _, pd := range projectDetails {
-		// Get timeline data from the related project
-		timeline := types.ProjectTimeline{
-			Start:    "",
-			End:      "",
-			Duration: "",
-		}
-		
-		// Get metrics data from the related project
-		metrics := types.ProjectMetrics{
-			LinesOfCode: 0,
-			Commits:     0,
-			Stars:       0,
-			Downloads:   0,
-		}
-		
-		// If project is loaded, get additional data
-		if pd.Edges.Project != nil {
-			proj := pd.Edges.Project
-			if !proj.StartDate.IsZero() {
-				timeline.Start = proj.StartDate.Format("2006-01-02")
-			}
-			if !proj.EndDate.IsZero() {
-				timeline.End = proj.EndDate.Format("2006-01-02")
-			}
-
-			// Calculate duration if both dates are available
-			if !proj.StartDate.IsZero() && !proj.EndDate.IsZero() {
-				duration := proj.EndDate.Sub(proj.StartDate)
-				days := int(duration.Hours() / 24)
-				if days > 0 {
-					timeline.Duration = fmt.Sprintf("%d days", days)
-				}
-			}
-
-			metrics.Stars = proj.LikeCount
-		}
-		
-		// Get values from project detail entity (these are strings, not pointers)
-		releaseNotes := pd.ReleaseNotes
-		quickStart := pd.QuickStart
-		dependencies := pd.Dependencies
-		license := pd.License
-		licenseText := pd.LicenseText
-		version := pd.Version
-
-		// Get detailed description from related project if available
-		var detailedDescription string
-		if pd.Edges.Project != nil {
-			detailedDescription = pd.Edges.Project.Description
+		// Details are matched through their project, so the edge is loaded
+		if pd.Edges.Project == nil {
+			continue
 		}
+		result = append(result, mapper.ProjectDetail(pd.Edges.Project, pd))
+	}
 
-		result = append(result, types.ProjectDetail{
-			ID:                  pd.ID.String(),
-			ProjectID:           pd.ProjectID.String(),
-			DetailedDescription: detailedDescription,
-			Release:             releaseNotes,
-			QuickStart:          quickStart,
-			Dependance:          dependencies,
-			LicenseText:         licenseText,
-			License:             license,
-			Version:             version,
-			Timeline:            timeline,
-			Metrics:             metrics,
-			RelatedBlogs:        []types.ProjectBlogRef{},
-			CreatedAt:           pd.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:           pd.UpdatedAt.Format("2006-01-02 15:04:05"),
-		})
+	if err := l.svcCtx.LogSearch(l.ctx, "projects", req.Query, req.Fingerprint, len(result)); err != nil {
+		l.Errorf("Failed to log search query: %v", err)
 	}
 	
 	return result, nil
@@ -0,0 +1,67 @@
+package reactions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/reaction"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxCountIDs caps the ids of one counts request.
+const maxCountIDs = 100
+
+type GetReactionCountsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Count emoji reactions on comments, posts or projects
+func NewGetReactionCountsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetReactionCountsLogic {
+	return &GetReactionCountsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetReactionCountsLogic) GetReactionCounts(req *types.ReactionCountsRequest) (resp *types.ReactionCountsResponse, err error) {
+	// ids is a comma separated list
+	var ids []string
+	for _, id := range strings.Split(req.IDs, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) > maxCountIDs {
+		return nil, fmt.Errorf("at most %d ids per request", maxCountIDs)
+	}
+	if err := checkIDs(ids); err != nil {
+		return nil, err
+	}
+
+	counts, err := l.svcCtx.Reactions.Counts(l.ctx, req.EntityType, ids)
+	if err != nil {
+		l.Errorf("Failed to count reactions: %v", err)
+		return nil, fmt.Errorf("failed to load reactions")
+	}
+	return &types.ReactionCountsResponse{
+		Emoji:  reaction.Emoji,
+		Counts: counts,
+	}, nil
+}
+
+func checkIDs(ids []string) error {
+	for _, id := range ids {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("invalid id %q", id)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+package reactions
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/reaction"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetReactionStatusLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Report the visitor's own reactions on the given entities
+func NewGetReactionStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetReactionStatusLogic {
+	return &GetReactionStatusLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetReactionStatusLogic) GetReactionStatus(req *types.ReactionStatusRequest) (resp *types.ReactionStatusResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIDs(req.EntityIDs); err != nil {
+		return nil, err
+	}
+
+	mine, err := l.svcCtx.Reactions.Mine(l.ctx, req.EntityType, req.EntityIDs, reaction.Reactor{
+		IdentityID:   identityID,
+		Fingerprints: l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint),
+	})
+	if err != nil {
+		l.Errorf("Failed to load reaction status: %v", err)
+		return nil, fmt.Errorf("failed to load reactions")
+	}
+	return &types.ReactionStatusResponse{Reactions: mine}, nil
+}
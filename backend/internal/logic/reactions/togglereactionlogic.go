@@ -0,0 +1,79 @@
+package reactions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/reaction"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ToggleReactionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Add or remove the visitor's emoji reaction
+func NewToggleReactionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ToggleReactionLogic {
+	return &ToggleReactionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ToggleReactionLogic) ToggleReaction(req *types.ToggleReactionRequest) (resp *types.ToggleReactionResponse, err error) {
+	// A session token identifies the visitor in place of user_identity_id
+	identityID, err := l.svcCtx.ResolveIdentity(l.ctx, req.SessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if identityID == "" && req.Fingerprint == "" {
+		return nil, errors.New("fingerprint or user identity is required")
+	}
+	if !reaction.Allowed(req.Emoji) {
+		return nil, errors.New("unsupported reaction")
+	}
+	if err := l.svcCtx.CheckReactionTarget(l.ctx, req.EntityType, req.EntityID); err != nil {
+		return nil, err
+	}
+	// Reactions share the like allowance and ban checks
+	if err := l.svcCtx.CheckLike(l.ctx, likeKind(req.EntityType), req.EntityID, req.ClientIP, req.Fingerprint, identityID); err != nil {
+		return nil, err
+	}
+
+	reactor := reaction.Reactor{
+		IdentityID:   identityID,
+		Fingerprints: l.svcCtx.Fingerprints.Hashes(l.ctx, req.Fingerprint),
+	}
+	reacted, err := l.svcCtx.Reactions.Toggle(l.ctx, req.EntityType, req.EntityID, req.Emoji, reactor, req.ClientIP)
+	if err != nil {
+		l.Errorf("Failed to toggle reaction on %s %s: %v", req.EntityType, req.EntityID, err)
+		return nil, fmt.Errorf("failed to update reaction")
+	}
+
+	counts, err := l.svcCtx.Reactions.Counts(l.ctx, req.EntityType, []string{req.EntityID})
+	if err != nil {
+		l.Errorf("Failed to count reactions on %s %s: %v", req.EntityType, req.EntityID, err)
+		return nil, fmt.Errorf("failed to load reactions")
+	}
+
+	return &types.ToggleReactionResponse{
+		Reacted: reacted,
+		Counts:  counts[req.EntityID],
+	}, nil
+}
+
+// likeKind maps a reaction entity type to the subject kind used for likes,
+// so bans on a post also cover reactions to it.
+func likeKind(entityType string) string {
+	if entityType == "post" {
+		return "blog"
+	}
+	return entityType
+}
@@ -0,0 +1,74 @@
+package reports
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/report"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateReportLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Report public content for abuse, copyright or privacy review
+func NewCreateReportLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateReportLogic {
+	return &CreateReportLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *CreateReportLogic) CreateReport(req *types.CreateReportRequest) (resp *types.CreateReportResponse, err error) {
+	// Bots fill in the hidden website field; they get the same answer as a
+	// visitor so they don't learn to skip it
+	if req.Website != "" {
+		l.Infof("Dropped report with honeypot field from %s", req.ClientIP)
+		return &types.CreateReportResponse{Received: true}, nil
+	}
+	if err := l.svcCtx.CheckReport(l.ctx, req.ClientIP, req.Fingerprint); err != nil {
+		return nil, err
+	}
+	if err := l.svcCtx.CheckCaptcha(l.ctx, req.CaptchaToken, req.ClientIP, ""); err != nil {
+		return nil, err
+	}
+
+	title, err := l.svcCtx.ReportedContentTitle(l.ctx, req.ContentType, req.ContentID)
+	if err != nil {
+		return nil, err
+	}
+
+	t := &report.Ticket{
+		ContentType:   req.ContentType,
+		ContentID:     req.ContentID,
+		ContentTitle:  title,
+		Category:      req.Category,
+		Details:       req.Details,
+		ReporterName:  req.ReporterName,
+		ReporterEmail: req.ReporterEmail,
+		IP:            req.ClientIP,
+		Fingerprint:   l.svcCtx.Fingerprints.Hash(l.ctx, req.Fingerprint),
+	}
+	report.Normalize(t)
+	if t.Details == "" {
+		return nil, errors.New("details are required")
+	}
+	// Copyright notices are followed up by email, so they need an address
+	if t.Category == "copyright" && t.ReporterEmail == "" {
+		return nil, errors.New("copyright reports need a contact email")
+	}
+	if err := l.svcCtx.Reports.Create(l.ctx, t); err != nil {
+		l.Errorf("Failed to store report about %s %s: %v", req.ContentType, req.ContentID, err)
+		return nil, errors.New("failed to send report")
+	}
+
+	l.Infof("Received %s report %s about %s %s (ip: %s)", t.Category, t.ID, t.ContentType, t.ContentID, req.ClientIP)
+	return &types.CreateReportResponse{Received: true}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/ent/award"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -57,8 +58,8 @@ func (l *GetAwardsLogic) GetAwards(req *types.ResumeRequest) (resp []types.Award
 			AwardDate:    awardDate,
 			Category:     awardEntity.AwardType,
 			SortOrder:    awardEntity.SortOrder,
-			CreatedAt:    awardEntity.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:    awardEntity.UpdatedAt.Format("2006-01-02 15:04:05"),
+			CreatedAt:    utils.FormatTime(awardEntity.CreatedAt),
+			UpdatedAt:    utils.FormatTime(awardEntity.UpdatedAt),
 		})
 	}
 
@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/ent/education"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -75,8 +76,8 @@ func (l *GetEducationLogic) GetEducation(req *types.ResumeRequest) (resp []types
 			InstitutionLogoURL: edu.InstitutionLogoURL,
 			Details:            details,
 			SortOrder:          edu.SortOrder,
-			CreatedAt:          edu.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:          edu.UpdatedAt.Format("2006-01-02 15:04:05"),
+			CreatedAt:          utils.FormatTime(edu.CreatedAt),
+			UpdatedAt:          utils.FormatTime(edu.UpdatedAt),
 		})
 	}
 
@@ -6,6 +6,7 @@ import (
 	"silan-backend/internal/ent/sociallink"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -64,7 +65,7 @@ func (l *GetPersonalInfoLogic) GetPersonalInfo(req *types.PersonalInfoRequest) (
 		AvatarURL:     user.AvatarURL,
 		IsPrimary:     true,
 		SocialLinks:   socialLinksResp,
-		CreatedAt:     personalInfo.CreatedAt.Format("2006-01-02 15:04:05"),
-		UpdatedAt:     personalInfo.UpdatedAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:     utils.FormatTime(personalInfo.CreatedAt),
+		UpdatedAt:     utils.FormatTime(personalInfo.UpdatedAt),
 	}, nil
 }
@@ -0,0 +1,41 @@
+package resume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/ent"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetPersonJsonLdLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get schema.org Person JSON-LD of the site owner
+func NewGetPersonJsonLdLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetPersonJsonLdLogic {
+	return &GetPersonJsonLdLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetPersonJsonLdLogic) GetPersonJsonLd() (resp *types.PersonJsonLd, err error) {
+	person, err := l.svcCtx.OwnerJsonLd(l.ctx)
+	if ent.IsNotFound(err) {
+		return nil, errors.New("personal info not found")
+	}
+	if err != nil {
+		l.Errorf("Failed to load site owner for JSON-LD: %v", err)
+		return nil, fmt.Errorf("failed to load personal info")
+	}
+	return &person, nil
+}
@@ -8,6 +8,7 @@ import (
 	"silan-backend/internal/ent/publication"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -74,8 +75,8 @@ func (l *GetPublicationsLogic) GetPublications(req *types.ResumeRequest) (resp [
 			DOI:           pub.Doi,
 			URL:           pub.URL,
 			CitationCount: pub.CitationCount,
-			CreatedAt:     pub.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:     pub.UpdatedAt.Format("2006-01-02 15:04:05"),
+			CreatedAt:     utils.FormatTime(pub.CreatedAt),
+			UpdatedAt:     utils.FormatTime(pub.UpdatedAt),
 		})
 	}
 
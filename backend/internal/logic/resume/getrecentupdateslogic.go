@@ -2,9 +2,11 @@ package resume
 
 import (
 	"context"
+	"sort"
 
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/zeromicro/go-zero/core/logx"
@@ -56,10 +58,37 @@ func (l *GetRecentUpdatesLogic) GetRecentUpdates(req *types.ResumeRequest) (resp
 			Tags:        update.Tags,
 			Status:      update.Status.String(),
 			Priority:    update.Priority.String(),
-			CreatedAt:   update.CreatedAt.Format("2006-01-02T15:04:05Z"),
-			UpdatedAt:   update.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+			CreatedAt:   utils.FormatTime(update.CreatedAt),
+			UpdatedAt:   utils.FormatTime(update.UpdatedAt),
 		}
 	}
+
+	// Site changelog entries are part of the activity feed as well
+	siteUpdates, err := l.svcCtx.Changelog.List(l.ctx, 10)
+	if err != nil {
+		l.Logger.Errorf("Failed to query site updates: %v", err)
+		return nil, err
+	}
+	for _, update := range siteUpdates {
+		resp = append(resp, types.RecentUpdate{
+			ID:          update.ID,
+			Type:        "site",
+			Title:       update.Title,
+			Description: update.Description,
+			Date:        update.PublishedAt.Format("2006-01-02"),
+			Tags:        []string{update.Kind},
+			Status:      "completed",
+			Priority:    "medium",
+			CreatedAt:   utils.FormatTime(update.CreatedAt),
+			UpdatedAt:   utils.FormatTime(update.UpdatedAt),
+		})
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Date > resp[j].Date
+	})
+	if len(resp) > 10 {
+		resp = resp[:10]
+	}
 	
 	return resp, nil
 }
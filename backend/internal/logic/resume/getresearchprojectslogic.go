@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/ent/researchproject"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -70,8 +71,8 @@ func (l *GetResearchProjectsLogic) GetResearchProjects(req *types.ResumeRequest)
 			EndDate:     endDate,
 			Details:     details,
 			SortOrder:   rp.SortOrder,
-			CreatedAt:   rp.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:   rp.UpdatedAt.Format("2006-01-02 15:04:05"),
+			CreatedAt:   utils.FormatTime(rp.CreatedAt),
+			UpdatedAt:   utils.FormatTime(rp.UpdatedAt),
 		})
 	}
 
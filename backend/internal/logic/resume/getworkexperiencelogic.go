@@ -7,6 +7,7 @@ import (
 	"silan-backend/internal/ent/workexperience"
 	"silan-backend/internal/svc"
 	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -73,8 +74,8 @@ func (l *GetWorkExperienceLogic) GetWorkExperience(req *types.ResumeRequest) (re
 			CompanyLogoURL: we.CompanyLogoURL,
 			Details:        details,
 			SortOrder:      we.SortOrder,
-			CreatedAt:      we.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:      we.UpdatedAt.Format("2006-01-02 15:04:05"),
+			CreatedAt:      utils.FormatTime(we.CreatedAt),
+			UpdatedAt:      utils.FormatTime(we.UpdatedAt),
 		})
 	}
 
@@ -0,0 +1,57 @@
+package shortlinks
+
+import (
+	"context"
+
+	"silan-backend/internal/shortlink"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RedirectShortLinkLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Redirect a short link to its target
+func NewRedirectShortLinkLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RedirectShortLinkLogic {
+	return &RedirectShortLinkLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RedirectShortLinkLogic) RedirectShortLink(req *types.ShortLinkRedirectRequest) (resp *types.ShortLinkRedirectResponse, err error) {
+	link, err := l.svcCtx.Links.Get(l.ctx, req.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	// Counting must never block the redirect
+	if err := l.svcCtx.Links.RecordClick(l.ctx, link.Code); err != nil {
+		l.Errorf("Failed to count click on short link %s: %v", link.Code, err)
+	}
+	props := map[string]string{"code": link.Code}
+	if link.Channel != "" {
+		props["channel"] = link.Channel
+	}
+	if req.Referrer != "" {
+		props["referrer"] = req.Referrer
+	}
+	err = l.svcCtx.RecordEvent(l.ctx, svc.AnalyticsEvent{
+		Name:       shortlink.ClickEvent,
+		Path:       "/s/" + link.Code,
+		Properties: props,
+		IP:         req.ClientIP,
+		UserAgent:  req.UserAgentFull,
+	})
+	if err != nil {
+		l.Errorf("Failed to record short link click: %v", err)
+	}
+
+	return &types.ShortLinkRedirectResponse{TargetURL: link.TargetURL}, nil
+}
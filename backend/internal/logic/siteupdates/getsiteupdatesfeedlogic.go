@@ -0,0 +1,33 @@
+package siteupdates
+
+import (
+	"context"
+
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSiteUpdatesFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// RSS feed of site updates
+func NewGetSiteUpdatesFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSiteUpdatesFeedLogic {
+	return &GetSiteUpdatesFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSiteUpdatesFeedLogic) GetSiteUpdatesFeed() ([]byte, error) {
+	feed, err := l.svcCtx.Feeds.Get(l.ctx, feeds.SiteUpdatesRSS)
+	if err != nil {
+		return nil, err
+	}
+	return feed.Body, nil
+}
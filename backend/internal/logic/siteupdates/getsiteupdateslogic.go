@@ -0,0 +1,43 @@
+package siteupdates
+
+import (
+	"context"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetSiteUpdatesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// List recent site updates
+func NewGetSiteUpdatesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetSiteUpdatesLogic {
+	return &GetSiteUpdatesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetSiteUpdatesLogic) GetSiteUpdates(req *types.SiteUpdateListRequest) (resp *types.SiteUpdateListResponse, err error) {
+	limit := req.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 20
+	}
+
+	updates, err := l.svcCtx.Changelog.List(l.ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]types.SiteUpdateData, 0, len(updates))
+	for _, u := range updates {
+		list = append(list, u.Data())
+	}
+	return &types.SiteUpdateListResponse{Updates: list}, nil
+}
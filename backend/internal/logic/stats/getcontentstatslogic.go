@@ -0,0 +1,193 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetContentStatsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get content production statistics per month
+func NewGetContentStatsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetContentStatsLogic {
+	return &GetContentStatsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+const monthLayout = "2006-01"
+
+func (l *GetContentStatsLogic) GetContentStats(req *types.ContentStatsRequest) (resp *types.ContentStatsResponse, err error) {
+	v, err := l.svcCtx.Aggregates.Get(l.ctx, fmt.Sprintf("content_stats:%+v", *req), func(ctx context.Context) (any, error) {
+		return NewGetContentStatsLogic(ctx, l.svcCtx).build(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*types.ContentStatsResponse), nil
+}
+
+// build computes the monthly content statistics, served through the aggregates cache.
+func (l *GetContentStatsLogic) build(req *types.ContentStatsRequest) (resp *types.ContentStatsResponse, err error) {
+	loc, err := utils.LoadTimezone(req.TZ)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = &types.ContentStatsResponse{Months: []types.ContentMonth{}}
+	months := map[string]*types.ContentMonth{}
+	bucket := func(t time.Time) *types.ContentMonth {
+		key := t.In(loc).Format(monthLayout)
+		m, ok := months[key]
+		if !ok {
+			m = &types.ContentMonth{Month: key}
+			months[key] = m
+		}
+		return m
+	}
+
+	posts, err := l.svcCtx.DB.BlogPost.Query().
+		Where(blogpost.StatusEQ(blogpost.StatusPublished)).
+		Select(blogpost.FieldContent, blogpost.FieldPublishedAt, blogpost.FieldCreatedAt).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range posts {
+		date := p.PublishedAt
+		if date.IsZero() {
+			date = p.CreatedAt
+		}
+		words := wordCount(p.Content)
+		m := bucket(date)
+		m.Posts++
+		m.Words += words
+		resp.TotalPosts++
+		resp.PostWords += words
+	}
+
+	projects, err := l.svcCtx.DB.Project.Query().
+		Where(project.IsPublic(true)).
+		Select(project.FieldDescription, project.FieldCreatedAt).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range projects {
+		words := wordCount(p.Description)
+		m := bucket(p.CreatedAt)
+		m.Projects++
+		m.Words += words
+		resp.TotalProjects++
+		resp.ProjectWords += words
+	}
+
+	ideas, err := l.svcCtx.DB.Idea.Query().
+		Where(idea.IsPublic(true)).
+		Select(idea.FieldDescription, idea.FieldAbstract, idea.FieldCreatedAt).
+		All(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range ideas {
+		words := wordCount(i.Description) + wordCount(i.Abstract)
+		m := bucket(i.CreatedAt)
+		m.Ideas++
+		m.Words += words
+		resp.TotalIdeas++
+		resp.IdeaWords += words
+	}
+
+	resp.TotalWords = resp.PostWords + resp.ProjectWords + resp.IdeaWords
+
+	now := time.Now().In(loc)
+	resp.CurrentStreak, resp.LongestStreak = streaks(months, now)
+
+	// Report the requested window, or everything since the first item,
+	// including months without any content
+	var first time.Time
+	if req.Months > 0 {
+		first = monthStart(now).AddDate(0, -(req.Months - 1), 0)
+	} else if len(months) > 0 {
+		keys := make([]string, 0, len(months))
+		for k := range months {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		first, _ = time.ParseInLocation(monthLayout, keys[0], loc)
+	} else {
+		return resp, nil
+	}
+
+	for t := first; !t.After(now); t = t.AddDate(0, 1, 0) {
+		key := t.Format(monthLayout)
+		if m, ok := months[key]; ok {
+			resp.Months = append(resp.Months, *m)
+		} else {
+			resp.Months = append(resp.Months, types.ContentMonth{Month: key})
+		}
+	}
+
+	return resp, nil
+}
+
+// streaks returns the number of consecutive months with new content up to
+// now, and the longest such run. The current month does not break the
+// streak while it is still empty.
+func streaks(months map[string]*types.ContentMonth, now time.Time) (current, longest int) {
+	if len(months) == 0 {
+		return 0, 0
+	}
+
+	keys := make([]string, 0, len(months))
+	for k := range months {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	run := 0
+	var prev time.Time
+	for _, k := range keys {
+		t, _ := time.Parse(monthLayout, k)
+		if run > 0 && t.Equal(prev.AddDate(0, 1, 0)) {
+			run++
+		} else {
+			run = 1
+		}
+		if run > longest {
+			longest = run
+		}
+		prev = t
+	}
+
+	thisMonth, _ := time.Parse(monthLayout, now.Format(monthLayout))
+	if prev.Equal(thisMonth) || prev.Equal(thisMonth.AddDate(0, -1, 0)) {
+		current = run
+	}
+	return current, longest
+}
+
+func monthStart(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+}
+
+func wordCount(s string) int {
+	return len(strings.Fields(s))
+}
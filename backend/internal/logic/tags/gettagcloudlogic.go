@@ -0,0 +1,142 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/idea"
+	"silan-backend/internal/ent/project"
+	"silan-backend/internal/ent/projecttechnology"
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetTagCloudLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get all tags with usage counts and weights
+func NewGetTagCloudLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetTagCloudLogic {
+	return &GetTagCloudLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetTagCloudLogic) GetTagCloud(req *types.TagCloudRequest) (resp *types.TagCloudResponse, err error) {
+	v, err := l.svcCtx.Aggregates.Get(l.ctx, fmt.Sprintf("tags:%+v", *req), func(ctx context.Context) (any, error) {
+		return NewGetTagCloudLogic(ctx, l.svcCtx).build(req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(*types.TagCloudResponse), nil
+}
+
+// build computes the tag cloud, served through the aggregates cache.
+func (l *GetTagCloudLogic) build(req *types.TagCloudRequest) (resp *types.TagCloudResponse, err error) {
+	cloud := tagCloud{}
+
+	if req.Type == "" || req.Type == "blog" {
+		tags, err := l.svcCtx.DB.BlogTag.Query().
+			WithBlogPosts(func(q *ent.BlogPostQuery) {
+				q.Where(blogpost.StatusEQ(blogpost.StatusPublished))
+			}).
+			All(l.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tags {
+			cloud.add(t.Name, len(t.Edges.BlogPosts), 0, 0)
+		}
+	}
+
+	if req.Type == "" || req.Type == "idea" {
+		tags, err := l.svcCtx.DB.IdeaTag.Query().
+			WithIdeas(func(q *ent.IdeaQuery) {
+				q.Where(idea.IsPublic(true))
+			}).
+			All(l.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range tags {
+			cloud.add(t.Name, 0, len(t.Edges.Ideas), 0)
+		}
+	}
+
+	if req.Type == "" || req.Type == "project" {
+		// Project tags are the technologies listed on public projects
+		techs, err := l.svcCtx.DB.ProjectTechnology.Query().
+			Where(projecttechnology.HasProjectWith(project.IsPublic(true))).
+			All(l.ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range techs {
+			cloud.add(t.TechnologyName, 0, 0, 1)
+		}
+	}
+
+	return &types.TagCloudResponse{Tags: cloud.weights(req.Limit)}, nil
+}
+
+// tagCloud merges tag usage across content types. Tags are matched
+// case-insensitively and shown with the first spelling seen.
+type tagCloud map[string]*types.TagWeight
+
+func (c tagCloud) add(name string, blogs, ideas, projects int) {
+	name = strings.TrimSpace(name)
+	if name == "" || blogs+ideas+projects == 0 {
+		return
+	}
+	key := strings.ToLower(name)
+	t, ok := c[key]
+	if !ok {
+		t = &types.TagWeight{Name: name}
+		c[key] = t
+	}
+	t.Blogs += blogs
+	t.Ideas += ideas
+	t.Projects += projects
+	t.Count += blogs + ideas + projects
+}
+
+// weights returns the tags most used first, keeping at most limit entries
+// when limit is positive. Weight is the usage relative to the most used tag,
+// rounded to two decimals.
+func (c tagCloud) weights(limit int) []types.TagWeight {
+	list := make([]types.TagWeight, 0, len(c))
+	maxCount := 0
+	for _, t := range c {
+		list = append(list, *t)
+		if t.Count > maxCount {
+			maxCount = t.Count
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Count != list[j].Count {
+			return list[i].Count > list[j].Count
+		}
+		return strings.ToLower(list[i].Name) < strings.ToLower(list[j].Name)
+	})
+	if limit > 0 && len(list) > limit {
+		list = list[:limit]
+	}
+
+	for i := range list {
+		list[i].Weight = math.Round(float64(list[i].Count)/float64(maxCount)*100) / 100
+	}
+	return list
+}
@@ -0,0 +1,51 @@
+package uses
+
+import (
+	"context"
+	"sort"
+
+	"silan-backend/internal/svc"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetUsesLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+// Get the tools of the uses page grouped by category
+func NewGetUsesLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetUsesLogic {
+	return &GetUsesLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetUsesLogic) GetUses() (resp *types.UsesResponse, err error) {
+	tools, err := l.svcCtx.Tools.List(l.ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Categories keep the order of their first tool by sort order
+	sort.SliceStable(tools, func(i, j int) bool {
+		return tools[i].SortOrder < tools[j].SortOrder
+	})
+
+	resp = &types.UsesResponse{Categories: []types.ToolCategory{}}
+	index := map[string]int{}
+	for _, t := range tools {
+		i, ok := index[t.Category]
+		if !ok {
+			i = len(resp.Categories)
+			index[t.Category] = i
+			resp.Categories = append(resp.Categories, types.ToolCategory{Name: t.Category})
+		}
+		resp.Categories[i].Tools = append(resp.Categories[i].Tools, t.Data())
+	}
+	return resp, nil
+}
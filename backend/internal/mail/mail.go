@@ -0,0 +1,121 @@
+// Package mail sends plain-text notification emails over SMTP.
+package mail
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrDisabled is returned when no SMTP server is configured.
+var ErrDisabled = errors.New("email is not configured")
+
+// dialTimeout bounds connecting to the SMTP server.
+const dialTimeout = 10 * time.Second
+
+// Message is a plain-text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender delivers messages through one SMTP server.
+type Sender struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+// NewSender returns a sender for host:port. Authentication is skipped when
+// username is empty. The sender is disabled while host is empty.
+func NewSender(host string, port int, username, password, from string) *Sender {
+	return &Sender{host: host, port: port, username: username, password: password, from: from}
+}
+
+// Enabled reports whether an SMTP server is configured.
+func (s *Sender) Enabled() bool {
+	return s != nil && s.host != "" && s.from != ""
+}
+
+// Send delivers msg. Port 465 uses implicit TLS; other ports upgrade with
+// STARTTLS when the server offers it.
+func (s *Sender) Send(msg Message) error {
+	if !s.Enabled() {
+		return ErrDisabled
+	}
+	if strings.ContainsAny(msg.To+msg.Subject, "\r\n") {
+		return errors.New("mail: header values must not contain line breaks")
+	}
+
+	addr := net.JoinHostPort(s.host, strconv.Itoa(s.port))
+	var (
+		conn net.Conn
+		err  error
+	)
+	if s.port == 465 {
+		conn, err = tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, "tcp", addr, &tls.Config{ServerName: s.host})
+	} else {
+		conn, err = net.DialTimeout("tcp", addr, dialTimeout)
+	}
+	if err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("mail: %w", err)
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok && s.port != 465 {
+		if err := c.StartTLS(&tls.Config{ServerName: s.host}); err != nil {
+			return fmt.Errorf("mail: %w", err)
+		}
+	}
+	if s.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return fmt.Errorf("mail: %w", err)
+		}
+	}
+	if err := c.Mail(s.from); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+
+	w, err := c.Data()
+	if err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	if _, err := w.Write(s.format(msg)); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
+	return c.Quit()
+}
+
+func (s *Sender) format(msg Message) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", s.from)
+	fmt.Fprintf(&b, "To: %s\r\n", msg.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(b.String())
+}
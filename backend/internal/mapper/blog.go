@@ -0,0 +1,74 @@
+package mapper
+
+import (
+	"fmt"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/poll"
+	"silan-backend/internal/types"
+)
+
+// BlogPost converts a post into its list representation. When the
+// translations edge is loaded, the title and summary are taken from the
+// translation for lang.
+func BlogPost(post *ent.BlogPost, lang string) types.BlogData {
+	data := types.BlogData{
+		ID:          post.ID.String(),
+		Title:       post.Title,
+		Slug:        post.Slug,
+		PublishDate: formatDate(post.PublishedAt),
+		Tags:        []string{},
+		Likes:       int64(post.LikeCount),
+		Views:       int64(post.ViewCount),
+		Summary:     post.Excerpt,
+		Type:        string(post.ContentType),
+		HeroImage:   post.FeaturedImageURL,
+	}
+
+	if post.ReadingTimeMinutes > 0 {
+		data.ReadTime = fmt.Sprintf("%d min read", post.ReadingTimeMinutes)
+	}
+	if post.Edges.Category != nil {
+		data.Category = post.Edges.Category.Name
+	}
+	for _, tag := range post.Edges.Tags {
+		data.Tags = append(data.Tags, tag.Name)
+	}
+	if post.Edges.User != nil {
+		data.Author = post.Edges.User.FirstName + " " + post.Edges.User.LastName
+	}
+	if s := post.Edges.Series; s != nil {
+		data.SeriesID = s.ID.String()
+		data.SeriesTitle = s.Title
+		data.SeriesDescription = s.Description
+		data.EpisodeNumber = post.SeriesOrder
+		data.TotalEpisodes = s.EpisodeCount
+	}
+
+	if lang != "" && lang != "en" {
+		for _, tr := range post.Edges.Translations {
+			if tr.LanguageCode == lang {
+				data.Title = tr.Title
+				data.Summary = tr.Excerpt
+				break
+			}
+		}
+	}
+
+	return data
+}
+
+// BlogPostDetail converts a post for the single-post endpoints, adding the
+// content blocks and the polls embedded in it.
+func BlogPostDetail(post *ent.BlogPost) types.BlogData {
+	data := BlogPost(post, "")
+	data.Content = []types.BlogContent{
+		{
+			Type:    "text",
+			Content: post.Content,
+			ID:      post.ID.String(),
+		},
+	}
+	data.PollIDs = poll.ReferencedIDs(post.Content)
+	return data
+}
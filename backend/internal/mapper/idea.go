@@ -0,0 +1,73 @@
+// Package mapper converts ent entities into the API types shared by the
+// get, list and search endpoints, so that every endpoint returns the same
+// shape for the same record.
+package mapper
+
+import (
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// Idea converts an idea. The details and tags edges are used when loaded.
+// Fields that have no backing column yet are returned as empty values so
+// that clients always receive arrays rather than null.
+func Idea(e *ent.Idea) types.IdeaData {
+	data := types.IdeaData{
+		ID:                e.ID.String(),
+		Title:             e.Title,
+		Description:       e.Description,
+		Category:          e.Category,
+		Tags:              ideaTags(e.Edges.Tags),
+		Status:            strings.ToLower(string(e.Status)),
+		CreatedAt:         utils.FormatTime(e.CreatedAt),
+		LastUpdated:       utils.FormatTime(e.UpdatedAt),
+		Abstract:          e.Abstract,
+		AbstractZh:        e.Abstract,
+		TechStack:         []string{},
+		Collaborators:     []types.Collaborator{},
+		FeedbackRequested: []types.FeedbackType{},
+		Publications:      []types.IdeaPublicationRef{},
+		Conferences:       []string{},
+		ResearchField:     e.Category,
+		Keywords:          []string{},
+	}
+
+	if d := e.Edges.Details; d != nil {
+		data.Progress = d.Progress
+		data.ProgressZh = d.Progress
+		data.Results = d.Results
+		data.ResultsZh = d.Results
+		data.Reference = d.References
+		data.Reference_Zh = d.References
+		data.OpenForCollaboration = d.CollaborationNeeded
+		data.FundingStatus = d.RequiredResources
+		if d.EstimatedDurationMonths > 0 {
+			data.EstimatedDuration = fmt.Sprintf("%d months", d.EstimatedDurationMonths)
+		}
+	}
+
+	return data
+}
+
+// Ideas converts a list of ideas, returning an empty slice rather than nil.
+func Ideas(list []*ent.Idea) []types.IdeaData {
+	result := make([]types.IdeaData, 0, len(list))
+	for _, e := range list {
+		result = append(result, Idea(e))
+	}
+	return result
+}
+
+func ideaTags(tags []*ent.IdeaTag) []string {
+	names := []string{}
+	for _, t := range tags {
+		if t.Name != "" {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,125 @@
+package mapper
+
+import (
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+const schemaContext = "https://schema.org"
+
+// PersonJsonLd describes the site owner as a schema.org Person. The active
+// social links become sameAs profiles; siteURL is used when the personal
+// info has no website of its own.
+func PersonJsonLd(info *ent.PersonalInfo, links []*ent.SocialLink, siteURL string) types.PersonJsonLd {
+	person := types.PersonJsonLd{
+		Context:  schemaContext,
+		Type:     "Person",
+		Name:     info.FullName,
+		JobTitle: info.Title,
+		URL:      info.Website,
+		Image:    info.AvatarURL,
+		Email:    info.Email,
+		Address:  info.Location,
+	}
+	if person.URL == "" {
+		person.URL = siteURL
+	}
+	if person.Image == "" && info.Edges.User != nil {
+		person.Image = info.Edges.User.AvatarURL
+	}
+	for _, link := range links {
+		if link.IsActive && link.URL != "" {
+			person.SameAs = append(person.SameAs, link.URL)
+		}
+	}
+	return person
+}
+
+// BlogPostingJsonLd describes a post as a schema.org BlogPosting written by
+// author, or by the post's user when author is empty. The page URL matches
+// the one listed in the sitemap.
+func BlogPostingJsonLd(post *ent.BlogPost, author types.PersonJsonLd, siteURL string) types.BlogPostingJsonLd {
+	url := siteURL + "/blog/" + post.ID.String()
+	author = authorJsonLd(author, post.Edges.User, siteURL)
+
+	data := types.BlogPostingJsonLd{
+		Context:          schemaContext,
+		Type:             "BlogPosting",
+		Headline:         post.Title,
+		Description:      post.Excerpt,
+		Image:            post.FeaturedImageURL,
+		URL:              url,
+		MainEntityOfPage: url,
+		Author:           author,
+	}
+	if !post.PublishedAt.IsZero() {
+		data.DatePublished = utils.FormatTime(post.PublishedAt)
+	}
+	if !post.UpdatedAt.IsZero() {
+		data.DateModified = utils.FormatTime(post.UpdatedAt)
+	}
+	if post.Edges.Category != nil {
+		data.ArticleSection = post.Edges.Category.Name
+	}
+	for _, tag := range post.Edges.Tags {
+		data.Keywords = append(data.Keywords, tag.Name)
+	}
+	if post.ReadingTimeMinutes > 0 {
+		// ISO 8601 duration, as schema.org expects
+		data.TimeRequired = fmt.Sprintf("PT%dM", post.ReadingTimeMinutes)
+	}
+	return data
+}
+
+// SoftwareSourceCodeJsonLd describes a project as schema.org
+// SoftwareSourceCode written by author, or by the project's user when
+// author is empty. The details edge supplies the license and version when it
+// is loaded.
+func SoftwareSourceCodeJsonLd(p *ent.Project, author types.PersonJsonLd, siteURL string) types.SoftwareSourceCodeJsonLd {
+	author = authorJsonLd(author, p.Edges.User, siteURL)
+
+	data := types.SoftwareSourceCodeJsonLd{
+		Context:             schemaContext,
+		Type:                "SoftwareSourceCode",
+		Name:                p.Title,
+		Description:         p.Description,
+		Image:               p.ThumbnailURL,
+		URL:                 siteURL + "/projects/" + p.ID.String(),
+		CodeRepository:      p.GithubURL,
+		ProgrammingLanguage: technologies(p.Edges.Technologies),
+		DateCreated:         formatDate(p.StartDate),
+		Author:              author,
+	}
+	if data.DateCreated == "" {
+		data.DateCreated = formatDate(p.CreatedAt)
+	}
+	if !p.UpdatedAt.IsZero() {
+		data.DateModified = utils.FormatTime(p.UpdatedAt)
+	}
+	if d := p.Edges.Details; d != nil {
+		data.License = strings.TrimSpace(d.License)
+		if data.License == "" && d.LicenseText != "" {
+			data.License = LicenseName(d.LicenseText)
+		}
+		data.Version = d.Version
+	}
+	return data
+}
+
+// authorJsonLd nests author inside another node, filling it in from user
+// when the site owner is unknown.
+func authorJsonLd(author types.PersonJsonLd, user *ent.User, siteURL string) types.PersonJsonLd {
+	if author.Name == "" {
+		author = types.PersonJsonLd{Type: "Person", URL: siteURL}
+		if user != nil {
+			author.Name = strings.TrimSpace(user.FirstName + " " + user.LastName)
+			author.Image = user.AvatarURL
+		}
+	}
+	author.Context = ""
+	return author
+}
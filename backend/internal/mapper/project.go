@@ -0,0 +1,180 @@
+package mapper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+const dateLayout = "2006-01-02"
+
+// Project converts a project into its list representation. The
+// technologies edge provides the tags when loaded.
+func Project(p *ent.Project) types.Project {
+	year := projectYear(p)
+	return types.Project{
+		ID:          p.ID.String(),
+		Name:        p.Title,
+		Description: p.Description,
+		Tags:        technologies(p.Edges.Technologies),
+		Year:        year,
+		AnnualPlan:  annualPlan(year),
+	}
+}
+
+// Projects converts a list of projects, returning an empty slice rather
+// than nil.
+func Projects(list []*ent.Project) []types.Project {
+	result := make([]types.Project, 0, len(list))
+	for _, p := range list {
+		result = append(result, Project(p))
+	}
+	return result
+}
+
+// ProjectExtended converts a project with all of its own columns.
+func ProjectExtended(p *ent.Project) types.ProjectExtended {
+	var userID string
+	if p.Edges.User != nil {
+		userID = p.Edges.User.ID.String()
+	}
+
+	year := projectYear(p)
+	return types.ProjectExtended{
+		ID:               p.ID.String(),
+		UserID:           userID,
+		Title:            p.Title,
+		Slug:             p.Slug,
+		Description:      p.Description,
+		ProjectType:      p.ProjectType,
+		Status:           string(p.Status),
+		StartDate:        formatDate(p.StartDate),
+		EndDate:          formatDate(p.EndDate),
+		Technologies:     technologies(p.Edges.Technologies),
+		GithubURL:        p.GithubURL,
+		DemoURL:          p.DemoURL,
+		DocumentationURL: p.DocumentationURL,
+		ThumbnailURL:     p.ThumbnailURL,
+		IsFeatured:       p.IsFeatured,
+		IsPublic:         p.IsPublic,
+		ViewCount:        int64(p.ViewCount),
+		SortOrder:        p.SortOrder,
+		Year:             year,
+		AnnualPlan:       annualPlan(year),
+		CreatedAt:        utils.FormatTime(p.CreatedAt),
+		UpdatedAt:        utils.FormatTime(p.UpdatedAt),
+	}
+}
+
+// ProjectDetail converts the detail record of project p. d may be nil for
+// projects that have no details yet, in which case defaults are returned.
+func ProjectDetail(p *ent.Project, d *ent.ProjectDetail) types.ProjectDetail {
+	data := types.ProjectDetail{
+		ID:           p.ID.String(),
+		ProjectID:    p.ID.String(),
+		License:      "MIT",
+		Version:      "1.0.0",
+		Timeline:     ProjectTimeline(p),
+		Metrics:      types.ProjectMetrics{Stars: p.LikeCount},
+		RelatedBlogs: []types.ProjectBlogRef{},
+		CreatedAt:    utils.FormatTime(p.CreatedAt),
+		UpdatedAt:    utils.FormatTime(p.UpdatedAt),
+	}
+	if d == nil {
+		return data
+	}
+
+	data.ID = d.ID.String()
+	data.DetailedDescription = d.ProjectDetails
+	if data.DetailedDescription == "" {
+		data.DetailedDescription = p.Description
+	}
+	data.Release = d.ReleaseNotes
+	data.QuickStart = d.QuickStart
+	data.Dependance = d.Dependencies
+	data.License = d.License
+	if data.License == "" {
+		data.License = LicenseName(d.LicenseText)
+	}
+	data.LicenseText = d.LicenseText
+	if d.Version != "" {
+		data.Version = d.Version
+	}
+	data.CreatedAt = utils.FormatTime(d.CreatedAt)
+	data.UpdatedAt = utils.FormatTime(d.UpdatedAt)
+	return data
+}
+
+// ProjectTimeline reports the project's dates and whether it is finished.
+func ProjectTimeline(p *ent.Project) types.ProjectTimeline {
+	t := types.ProjectTimeline{
+		Start: formatDate(p.StartDate),
+		End:   formatDate(p.EndDate),
+	}
+	switch {
+	case t.Start != "" && t.End != "":
+		t.Duration = "Completed"
+	case t.Start != "":
+		t.Duration = "In Progress"
+	}
+	return t
+}
+
+// LicenseName derives a short license name from the full license text,
+// defaulting to MIT when none is given.
+func LicenseName(text string) string {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return "MIT"
+	}
+
+	lower := strings.ToLower(text)
+	switch {
+	case strings.Contains(lower, "mit"):
+		return "MIT"
+	case strings.Contains(lower, "apache"):
+		return "Apache 2.0"
+	case strings.Contains(lower, "gpl"), strings.Contains(lower, "gnu"):
+		return "GPL"
+	case strings.Contains(lower, "bsd"):
+		return "BSD"
+	}
+
+	// Short strings are most likely a license name already
+	if len(text) <= 50 {
+		return text
+	}
+	return text[:50] + "..."
+}
+
+// projectYear is the year a project is filed under: its start date, or the
+// creation date when no start date is set.
+func projectYear(p *ent.Project) int {
+	if !p.StartDate.IsZero() {
+		return p.StartDate.Year()
+	}
+	return p.CreatedAt.Year()
+}
+
+func annualPlan(year int) string {
+	return fmt.Sprintf("Annual Plan %d", year)
+}
+
+func technologies(techs []*ent.ProjectTechnology) []string {
+	names := make([]string, 0, len(techs))
+	for _, t := range techs {
+		names = append(names, t.TechnologyName)
+	}
+	return names
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(dateLayout)
+}
@@ -0,0 +1,135 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"mime"
+	"path"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+)
+
+// audioTypes are the content types accepted for audio attachments, keyed by
+// file extension for attachments given without one.
+var audioTypes = map[string]string{
+	".mp3":  "audio/mpeg",
+	".m4a":  "audio/mp4",
+	".aac":  "audio/aac",
+	".ogg":  "audio/ogg",
+	".oga":  "audio/ogg",
+	".opus": "audio/ogg",
+	".wav":  "audio/wav",
+}
+
+// AudioType returns the content type of an audio attachment: contentType
+// if it is an audio type, otherwise the type of the file extension in
+// rawURL. It is empty for files that aren't audio.
+func AudioType(rawURL, contentType string) string {
+	if contentType != "" {
+		if t, _, err := mime.ParseMediaType(contentType); err == nil && strings.HasPrefix(t, "audio/") {
+			return t
+		}
+		return ""
+	}
+	p := rawURL
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		p = p[:i]
+	}
+	return audioTypes[strings.ToLower(path.Ext(p))]
+}
+
+// Audio is an audio file attached to an owner, such as the narration of a
+// blog post. Length is the file size in bytes, as RSS enclosures require.
+type Audio struct {
+	URL             string
+	ContentType     string
+	Length          int64
+	DurationSeconds int
+	UpdatedAt       time.Time
+}
+
+// AudioStore keeps audio attachments in the raw media_audio table, one per
+// owner.
+type AudioStore struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewAudioStore(db *sql.DB, driver string) *AudioStore {
+	return &AudioStore{db: db, driver: driver}
+}
+
+func (s *AudioStore) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Set attaches a to an owner, replacing any earlier attachment.
+func (s *AudioStore) Set(ctx context.Context, ownerType, ownerID string, a Audio) error {
+	var query string
+	if s.driver == "mysql" {
+		query = `INSERT INTO media_audio (owner_type, owner_id, url, content_type, length_bytes, duration_seconds, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON DUPLICATE KEY UPDATE url = VALUES(url), content_type = VALUES(content_type),
+				length_bytes = VALUES(length_bytes), duration_seconds = VALUES(duration_seconds), updated_at = VALUES(updated_at)`
+	} else {
+		query = `INSERT INTO media_audio (owner_type, owner_id, url, content_type, length_bytes, duration_seconds, updated_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)
+			ON CONFLICT (owner_type, owner_id) DO UPDATE SET url = excluded.url, content_type = excluded.content_type,
+				length_bytes = excluded.length_bytes, duration_seconds = excluded.duration_seconds, updated_at = excluded.updated_at`
+	}
+	_, err := s.db.ExecContext(ctx, s.rebind(query),
+		ownerType, ownerID, a.URL, a.ContentType, a.Length, a.DurationSeconds, a.UpdatedAt.UTC())
+	return err
+}
+
+// Remove detaches the audio of an owner and reports whether it had any.
+func (s *AudioStore) Remove(ctx context.Context, ownerType, ownerID string) (bool, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`DELETE FROM media_audio WHERE owner_type = ? AND owner_id = ?`), ownerType, ownerID)
+	if err != nil {
+		return false, err
+	}
+	n, err := res.RowsAffected()
+	return n > 0, err
+}
+
+// Get returns the audio of an owner, or nil if it has none.
+func (s *AudioStore) Get(ctx context.Context, ownerType, ownerID string) (*Audio, error) {
+	var a Audio
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT url, content_type, length_bytes, duration_seconds, updated_at FROM media_audio
+		WHERE owner_type = ? AND owner_id = ?`), ownerType, ownerID,
+	).Scan(&a.URL, &a.ContentType, &a.Length, &a.DurationSeconds, &a.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// All returns the audio of every owner of ownerType, keyed by owner ID.
+func (s *AudioStore) All(ctx context.Context, ownerType string) (map[string]*Audio, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT owner_id, url, content_type, length_bytes, duration_seconds, updated_at FROM media_audio
+		WHERE owner_type = ?`), ownerType)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := map[string]*Audio{}
+	for rows.Next() {
+		var ownerID string
+		var a Audio
+		if err := rows.Scan(&ownerID, &a.URL, &a.ContentType, &a.Length, &a.DurationSeconds, &a.UpdatedAt); err != nil {
+			return nil, err
+		}
+		result[ownerID] = &a
+	}
+	return result, rows.Err()
+}
@@ -0,0 +1,173 @@
+// Package media checks uploaded files before they are served publicly.
+// The content type is sniffed from the bytes rather than trusted from the
+// client, raster images are decoded and re-encoded so EXIF data such as GPS
+// positions is dropped, SVGs are rewritten without scripts, event handlers
+// or external references, and every file can additionally be sent to a
+// ClamAV daemon.
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrUnsupported is returned for files of a type that isn't accepted.
+	ErrUnsupported = errors.New("unsupported media type")
+	// ErrInfected is returned when ClamAV reports a signature match.
+	ErrInfected = errors.New("file failed the virus scan")
+)
+
+// jpegQuality is used when re-encoding JPEG uploads.
+const jpegQuality = 90
+
+// File is a checked upload, ready to be stored and served as ContentType.
+type File struct {
+	Data        []byte
+	ContentType string
+	Ext         string
+}
+
+// Scanner runs the checks of one upload path.
+type Scanner struct {
+	// clamdAddr is the host:port of clamd; virus scanning is skipped while
+	// it is empty
+	clamdAddr string
+	timeout   time.Duration
+}
+
+func NewScanner(clamdAddr string, timeout time.Duration) *Scanner {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &Scanner{clamdAddr: clamdAddr, timeout: timeout}
+}
+
+// Scan checks data and returns the file to store. Images and SVGs come back
+// rewritten; PDFs and MP3, WAV and Ogg audio are kept as uploaded. Anything
+// else is rejected.
+func (s *Scanner) Scan(ctx context.Context, data []byte) (*File, error) {
+	if s.clamdAddr != "" {
+		if err := s.clamScan(ctx, data); err != nil {
+			return nil, err
+		}
+	}
+
+	switch contentType := http.DetectContentType(data); {
+	case contentType == "image/jpeg":
+		return reencode(data, "image/jpeg", ".jpg", func(w io.Writer, img image.Image) error {
+			return jpeg.Encode(w, img, &jpeg.Options{Quality: jpegQuality})
+		})
+	case contentType == "image/png":
+		return reencode(data, "image/png", ".png", png.Encode)
+	case contentType == "image/gif":
+		// DecodeAll keeps the frames of animations; comments and other
+		// extensions are not written back
+		g, err := gif.DecodeAll(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("invalid gif: %w", err)
+		}
+		var buf bytes.Buffer
+		if err := gif.EncodeAll(&buf, g); err != nil {
+			return nil, err
+		}
+		return &File{Data: buf.Bytes(), ContentType: "image/gif", Ext: ".gif"}, nil
+	case contentType == "application/pdf":
+		return &File{Data: data, ContentType: "application/pdf", Ext: ".pdf"}, nil
+	case contentType == "audio/mpeg":
+		return &File{Data: data, ContentType: "audio/mpeg", Ext: ".mp3"}, nil
+	case contentType == "audio/wave":
+		return &File{Data: data, ContentType: "audio/wav", Ext: ".wav"}, nil
+	case contentType == "application/ogg":
+		return &File{Data: data, ContentType: "audio/ogg", Ext: ".ogg"}, nil
+	case isSVG(data):
+		clean, err := SanitizeSVG(data)
+		if err != nil {
+			return nil, err
+		}
+		return &File{Data: clean, ContentType: "image/svg+xml", Ext: ".svg"}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupported, contentType)
+	}
+}
+
+// reencode decodes a raster image and writes only its pixels back. The EXIF
+// orientation is lost with the rest of the metadata.
+func reencode(data []byte, contentType, ext string, encode func(io.Writer, image.Image) error) (*File, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return &File{Data: buf.Bytes(), ContentType: contentType, Ext: ext}, nil
+}
+
+// isSVG reports whether data is an XML document with an svg root element.
+// http.DetectContentType only reports SVGs as text or XML.
+func isSVG(data []byte) bool {
+	head := data
+	if len(head) > 1024 {
+		head = head[:1024]
+	}
+	return bytes.Contains(bytes.ToLower(head), []byte("<svg"))
+}
+
+// clamScan streams data to clamd with the INSTREAM command.
+func (s *Scanner) clamScan(ctx context.Context, data []byte) error {
+	d := net.Dialer{Timeout: s.timeout}
+	conn, err := d.DialContext(ctx, "tcp", s.clamdAddr)
+	if err != nil {
+		return fmt.Errorf("clamav: %w", err)
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(s.timeout))
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return fmt.Errorf("clamav: %w", err)
+	}
+	const chunk = 64 << 10
+	size := make([]byte, 4)
+	for off := 0; off < len(data); off += chunk {
+		end := min(off+chunk, len(data))
+		binary.BigEndian.PutUint32(size, uint32(end-off))
+		if _, err := conn.Write(size); err != nil {
+			return fmt.Errorf("clamav: %w", err)
+		}
+		if _, err := conn.Write(data[off:end]); err != nil {
+			return fmt.Errorf("clamav: %w", err)
+		}
+	}
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err := conn.Write(size); err != nil {
+		return fmt.Errorf("clamav: %w", err)
+	}
+
+	reply, err := io.ReadAll(io.LimitReader(conn, 1024))
+	if err != nil {
+		return fmt.Errorf("clamav: %w", err)
+	}
+	// Replies look like "stream: OK" or "stream: <signature> FOUND"
+	result := strings.TrimRight(string(reply), "\x00\n")
+	switch {
+	case strings.HasSuffix(result, " OK"):
+		return nil
+	case strings.HasSuffix(result, " FOUND"):
+		return fmt.Errorf("%w: %s", ErrInfected, strings.TrimSuffix(strings.TrimPrefix(result, "stream: "), " FOUND"))
+	}
+	return fmt.Errorf("clamav: unexpected reply %q", result)
+}
@@ -0,0 +1,114 @@
+package media
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// blockedSVGElements are dropped with everything inside them.
+var blockedSVGElements = map[string]bool{
+	"script":        true,
+	"foreignobject": true,
+	"iframe":        true,
+	"embed":         true,
+	"object":        true,
+	"handler":       true,
+	"listener":      true,
+}
+
+// SanitizeSVG rewrites an SVG keeping only markup that can't run code or
+// load anything: blocked elements, event handler attributes, links other
+// than fragment references, comments and DOCTYPEs (with their entities) are
+// removed.
+func SanitizeSVG(data []byte) ([]byte, error) {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Strict = true
+
+	var buf bytes.Buffer
+	skip := 0
+	sawRoot := false
+	for {
+		tok, err := d.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid svg: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			if skip > 0 || blockedSVGElements[strings.ToLower(t.Name.Local)] {
+				skip++
+				continue
+			}
+			if !sawRoot {
+				if !strings.EqualFold(t.Name.Local, "svg") {
+					return nil, fmt.Errorf("%w: root element is %s", ErrUnsupported, t.Name.Local)
+				}
+				sawRoot = true
+			}
+			buf.WriteString("<" + qualifiedName(t.Name))
+			for _, a := range t.Attr {
+				if !allowedSVGAttr(a) {
+					continue
+				}
+				buf.WriteString(" " + qualifiedName(a.Name) + `="`)
+				xml.EscapeText(&buf, []byte(a.Value))
+				buf.WriteString(`"`)
+			}
+			buf.WriteString(">")
+		case xml.EndElement:
+			if skip > 0 {
+				skip--
+				continue
+			}
+			buf.WriteString("</" + qualifiedName(t.Name) + ">")
+		case xml.CharData:
+			if skip == 0 {
+				xml.EscapeText(&buf, t)
+			}
+		case xml.ProcInst:
+			if t.Target == "xml" && !sawRoot {
+				buf.WriteString("<?xml " + string(t.Inst) + "?>")
+			}
+		}
+	}
+	if !sawRoot {
+		return nil, fmt.Errorf("%w: no svg element", ErrUnsupported)
+	}
+	return buf.Bytes(), nil
+}
+
+func qualifiedName(n xml.Name) string {
+	if n.Space == "" {
+		return n.Local
+	}
+	return n.Space + ":" + n.Local
+}
+
+// allowedSVGAttr drops event handlers and any link that leaves the
+// document, including javascript: and data: URLs.
+func allowedSVGAttr(a xml.Attr) bool {
+	name := strings.ToLower(a.Name.Local)
+	if strings.HasPrefix(name, "on") {
+		return false
+	}
+	value := strings.ToLower(strings.TrimSpace(a.Value))
+	// Animation values can turn into links, so no attribute may carry a
+	// script URL
+	if strings.Contains(value, "javascript:") {
+		return false
+	}
+	switch name {
+	case "href", "src", "action", "formaction":
+		return strings.HasPrefix(value, "#")
+	case "style":
+		return !strings.Contains(value, "url(")
+	}
+	return true
+}
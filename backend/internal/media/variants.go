@@ -0,0 +1,101 @@
+package media
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+)
+
+// OwnerPost marks the media of a blog post: the variants of its hero image
+// and its audio.
+const OwnerPost = "post"
+
+// Variant is a resized or re-encoded copy of an image, rendered by the
+// image pipeline when the source is uploaded.
+type Variant struct {
+	URL    string
+	Width  int
+	Format string
+}
+
+// Store keeps image variants in the raw image_variants table. Each owner has
+// one image; the variants remember the source URL they were rendered from,
+// so they are ignored once the owner points at another image.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// SetVariants replaces the variants of an owner's image.
+func (s *Store) SetVariants(ctx context.Context, ownerType, ownerID, sourceURL string, variants []Variant) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, s.rebind(
+		`DELETE FROM image_variants WHERE owner_type = ? AND owner_id = ?`), ownerType, ownerID,
+	); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for _, v := range variants {
+		_, err := tx.ExecContext(ctx, s.rebind(
+			`INSERT INTO image_variants (owner_type, owner_id, source_url, url, width, format, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`),
+			ownerType, ownerID, sourceURL, v.URL, v.Width, v.Format, now,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Variants returns the variants of the given owners' images rendered from
+// the source URL each owner currently uses, keyed by owner ID and ordered by
+// format and width.
+func (s *Store) Variants(ctx context.Context, ownerType string, sources map[string]string) (map[string][]Variant, error) {
+	result := map[string][]Variant{}
+	if len(sources) == 0 {
+		return result, nil
+	}
+	ids := make([]any, 0, len(sources)+1)
+	ids = append(ids, ownerType)
+	for id := range sources {
+		ids = append(ids, id)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT owner_id, source_url, url, width, format FROM image_variants
+		WHERE owner_type = ? AND owner_id IN (?`+strings.Repeat(", ?", len(sources)-1)+`)
+		ORDER BY format, width`), ids...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var ownerID, sourceURL string
+		var v Variant
+		if err := rows.Scan(&ownerID, &sourceURL, &v.URL, &v.Width, &v.Format); err != nil {
+			return nil, err
+		}
+		if sources[ownerID] == sourceURL {
+			result[ownerID] = append(result[ownerID], v)
+		}
+	}
+	return result, rows.Err()
+}
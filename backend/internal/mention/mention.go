@@ -0,0 +1,180 @@
+// Package mention finds @displayName mentions in comments, resolves them to
+// user identities and emails the people mentioned. Display names are
+// matched ignoring case and spaces, so "@SilanHu" reaches "Silan Hu".
+package mention
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/commentmention"
+	"silan-backend/internal/ent/useridentity"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// MaxPerComment caps the mentions resolved in one comment so a comment
+// can't be used to mail everyone with an account.
+const MaxPerComment = 5
+
+// mentionPattern matches @name where the @ doesn't follow a letter or
+// digit, which leaves email addresses alone.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_.@])@([\p{L}\p{N}_.-]{1,64})`)
+
+// Parse returns the normalized names mentioned in content, in order of
+// first appearance and at most MaxPerComment of them.
+func Parse(content string) []string {
+	var names []string
+	seen := map[string]bool{}
+	for _, m := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		// Sentence punctuation right after a name isn't part of it
+		name := Normalize(strings.TrimRight(m[1], ".-"))
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+		if len(names) == MaxPerComment {
+			break
+		}
+	}
+	return names
+}
+
+// Normalize folds a display name the way mentions are matched.
+func Normalize(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, " ", ""))
+}
+
+// Mention is an identity mentioned in a comment.
+type Mention struct {
+	CommentID      string
+	UserIdentityID string
+	DisplayName    string
+	Email          string
+	Notified       bool
+}
+
+// Store keeps the mentions of comments and resolves names against user
+// identities.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Resolve maps the normalized names to identities with a verified email.
+// When several identities share a display name the most recently active
+// one is taken. Unknown names are left out.
+func (s *Store) Resolve(ctx context.Context, names []string) (map[string]Mention, error) {
+	found := map[string]Mention{}
+	if len(names) == 0 {
+		return found, nil
+	}
+
+	args := make([]any, len(names))
+	for i, n := range names {
+		args[i] = n
+	}
+	idents, err := s.client.UserIdentity.Query().
+		Where(
+			useridentity.Verified(true),
+			useridentity.EmailNEQ(""),
+			func(s *sql.Selector) {
+				s.Where(sql.In("LOWER(REPLACE("+s.C(useridentity.FieldDisplayName)+", ' ', ''))", args...))
+			},
+		).
+		Order(ent.Desc(useridentity.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range idents {
+		m := Mention{UserIdentityID: u.ID, DisplayName: u.DisplayName, Email: u.Email}
+		key := Normalize(m.DisplayName)
+		if _, ok := found[key]; !ok {
+			found[key] = m
+		}
+	}
+	return found, nil
+}
+
+// Record stores the mentions of commentID. Mentions already stored keep
+// their notified state.
+func (s *Store) Record(ctx context.Context, commentID string, mentions []Mention) error {
+	for _, m := range mentions {
+		exists, err := s.client.CommentMention.Query().
+			Where(commentmention.CommentID(commentID), commentmention.UserIdentityID(m.UserIdentityID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		err = s.client.CommentMention.Create().
+			SetID(uuid.New().String()).
+			SetCommentID(commentID).
+			SetUserIdentityID(m.UserIdentityID).
+			SetDisplayName(m.DisplayName).
+			SetCreatedAt(time.Now().UTC()).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pending returns the mentions of commentID that haven't been notified yet,
+// with the current email of each identity.
+func (s *Store) Pending(ctx context.Context, commentID string) ([]Mention, error) {
+	pending, err := s.client.CommentMention.Query().
+		Where(commentmention.CommentID(commentID), commentmention.NotifiedAtIsNil()).
+		All(ctx)
+	if err != nil || len(pending) == 0 {
+		return nil, err
+	}
+	ids := make([]string, len(pending))
+	for i, m := range pending {
+		ids[i] = m.UserIdentityID
+	}
+	idents, err := s.client.UserIdentity.Query().Where(useridentity.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	emails := make(map[string]string, len(idents))
+	for _, u := range idents {
+		emails[u.ID] = u.Email
+	}
+
+	var mentions []Mention
+	for _, m := range pending {
+		email, ok := emails[m.UserIdentityID]
+		if !ok {
+			continue
+		}
+		mentions = append(mentions, Mention{
+			CommentID:      commentID,
+			UserIdentityID: m.UserIdentityID,
+			DisplayName:    m.DisplayName,
+			Email:          email,
+		})
+	}
+	return mentions, nil
+}
+
+// MarkNotified records that the identity was told about its mention in
+// commentID.
+func (s *Store) MarkNotified(ctx context.Context, commentID, identityID string) error {
+	return s.client.CommentMention.Update().
+		Where(commentmention.CommentID(commentID), commentmention.UserIdentityID(identityID)).
+		SetNotifiedAt(time.Now().UTC()).
+		Exec(ctx)
+}
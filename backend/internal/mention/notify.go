@@ -0,0 +1,102 @@
+package mention
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/mail"
+	"silan-backend/internal/outbox"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Notifier records the mentions of new and edited comments and emails the
+// people mentioned once the comment is visible.
+type Notifier struct {
+	store   *Store
+	client  *ent.Client
+	mailer  *mail.Sender
+	siteURL string
+}
+
+func NewNotifier(store *Store, client *ent.Client, mailer *mail.Sender, siteURL string) *Notifier {
+	return &Notifier{store: store, client: client, mailer: mailer, siteURL: siteURL}
+}
+
+// Handle is an outbox handler for comment.created, comment.approved and
+// comment.edited. Mentions are stored as soon as the comment is; held
+// comments notify on approval and edits only notify newly added names.
+// Send failures are only logged so they don't replay the event to every
+// handler.
+func (n *Notifier) Handle(ctx context.Context, ev outbox.Event) error {
+	switch ev.Type {
+	case outbox.EventCommentCreated, outbox.EventCommentApproved, outbox.EventCommentEdited:
+	default:
+		return nil
+	}
+	var payload outbox.CommentEvent
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return nil
+	}
+	id, err := uuid.Parse(payload.ID)
+	if err != nil {
+		return nil
+	}
+
+	c, err := n.client.Comment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	resolved, err := n.store.Resolve(ctx, Parse(c.Content))
+	if err != nil {
+		return err
+	}
+	var mentions []Mention
+	for _, m := range resolved {
+		// Mentioning yourself does nothing
+		if m.UserIdentityID == c.UserIdentityID || strings.EqualFold(m.Email, c.AuthorEmail) {
+			continue
+		}
+		mentions = append(mentions, m)
+	}
+	if err := n.store.Record(ctx, c.ID.String(), mentions); err != nil {
+		return err
+	}
+
+	// Held comments are announced once they are approved
+	if !c.IsApproved || !n.mailer.Enabled() {
+		return nil
+	}
+	pending, err := n.store.Pending(ctx, c.ID.String())
+	if err != nil {
+		return err
+	}
+	page := commentsub.PageURL(n.siteURL, c.EntityType, c.EntityID.String())
+	for _, m := range pending {
+		if m.Email != "" {
+			err := n.mailer.Send(mail.Message{
+				To:      m.Email,
+				Subject: fmt.Sprintf("%s mentioned you in a comment", c.AuthorName),
+				Body: fmt.Sprintf("%s mentioned you:\n\n%s\n\nRead the conversation at\n%s\n",
+					c.AuthorName, c.Content, page),
+			})
+			if err != nil {
+				logx.WithContext(ctx).Errorf("Failed to send mention notification for comment %s: %v", c.ID, err)
+				continue
+			}
+		}
+		if err := n.store.MarkNotified(ctx, c.ID.String(), m.UserIdentityID); err != nil {
+			logx.WithContext(ctx).Errorf("Failed to mark mention in comment %s as notified: %v", c.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package middleware
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AnalyticsMiddleware writes one request_logs row per request. The insert
+// runs in the background so logging never adds latency to the response.
+// Requests from visitors who opted out of tracking are not logged.
+type AnalyticsMiddleware struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewAnalyticsMiddleware(db *sql.DB, driver string) *AnalyticsMiddleware {
+	return &AnalyticsMiddleware{db: db, driver: driver}
+}
+
+func (m *AnalyticsMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions || utils.DoNotTrack(r) {
+			next(w, r)
+			return
+		}
+
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next(rec, r)
+
+		method := r.Method
+		path := r.URL.Path
+		status := rec.status
+		duration := time.Since(start).Milliseconds()
+		referrer := truncate(r.Referer(), 1024)
+		userAgent := utils.GetUserAgent(r)
+		ip := utils.GetClientIP(r)
+		lang := requestLang(r)
+
+		go func() {
+			_, err := m.db.Exec(utils.Rebind(m.driver,
+				`INSERT INTO request_logs (method, path, status, duration_ms, referrer, user_agent, ip, lang)
+				VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+				method, truncate(path, 1024), status, duration, referrer, userAgent, ip, lang,
+			)
+			if err != nil {
+				logx.Errorf("Failed to write request log: %v", err)
+			}
+		}()
+	}
+}
+
+// requestLang returns the language the response was served in. Content
+// endpoints negotiate it from the ?lang= parameter and default to English,
+// so "zh-CN" and "zh" are both recorded as "zh".
+func requestLang(r *http.Request) string {
+	lang := strings.ToLower(strings.TrimSpace(r.URL.Query().Get("lang")))
+	if i := strings.IndexAny(lang, "-_"); i >= 0 {
+		lang = lang[:i]
+	}
+	if lang == "" {
+		lang = "en"
+	}
+	return truncate(lang, 8)
+}
+
+func truncate(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+// statusRecorder captures the status code written by the handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// Flush keeps streaming responses working through the wrapper.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
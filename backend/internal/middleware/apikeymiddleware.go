@@ -0,0 +1,112 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"silan-backend/internal/apikey"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ApiKeyMiddleware authenticates requests carrying an X-API-Key header and
+// enforces the key's daily/monthly quotas. Publishable keys may also come in
+// the api_key query parameter, for embeds that can't set headers. Requests
+// without a key pass through untouched so the public site keeps working as
+// before. Admin keys listed in the config aren't stored; they pass through
+// unmetered for AdminAuthMiddleware to check.
+type ApiKeyMiddleware struct {
+	store     *apikey.Store
+	adminKeys keyHashes
+}
+
+func NewApiKeyMiddleware(store *apikey.Store, adminKeyHashes []string) *ApiKeyMiddleware {
+	return &ApiKeyMiddleware{store: store, adminKeys: newKeyHashes(adminKeyHashes)}
+}
+
+func (m *ApiKeyMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		plain := r.Header.Get("X-API-Key")
+		fromQuery := false
+		if plain == "" {
+			plain, fromQuery = r.URL.Query().Get("api_key"), true
+		}
+		if plain == "" || (!fromQuery && m.adminKeys.match(plain)) {
+			next(w, r)
+			return
+		}
+
+		ctx := r.Context()
+		key, err := m.store.Lookup(ctx, plain)
+		if errors.Is(err, apikey.ErrNotFound) {
+			httpx.WriteJsonCtx(ctx, w, http.StatusUnauthorized, map[string]string{
+				"error": "invalid API key",
+			})
+			return
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to look up API key: %v", err)
+			httpx.WriteJsonCtx(ctx, w, http.StatusInternalServerError, map[string]string{
+				"error": "failed to verify API key",
+			})
+			return
+		}
+		// Secret keys in URLs end up in logs and browser histories
+		if fromQuery && !key.Publishable() {
+			httpx.WriteJsonCtx(ctx, w, http.StatusUnauthorized, map[string]string{
+				"error": "only publishable keys may be passed as a query parameter",
+			})
+			return
+		}
+
+		now := time.Now()
+		day, month, err := m.store.Counts(ctx, key.ID, now)
+		if err != nil {
+			// Don't lock clients out because the counter table is unavailable
+			logx.WithContext(ctx).Errorf("Failed to read usage for API key %s: %v", key.ID, err)
+		} else {
+			if key.DailyQuota > 0 {
+				w.Header().Set("X-Quota-Daily-Limit", strconv.Itoa(key.DailyQuota))
+				w.Header().Set("X-Quota-Daily-Remaining", strconv.Itoa(max(key.DailyQuota-day-1, 0)))
+			}
+			if key.MonthlyQuota > 0 {
+				w.Header().Set("X-Quota-Monthly-Limit", strconv.Itoa(key.MonthlyQuota))
+				w.Header().Set("X-Quota-Monthly-Remaining", strconv.Itoa(max(key.MonthlyQuota-month-1, 0)))
+			}
+
+			if (key.DailyQuota > 0 && day >= key.DailyQuota) || (key.MonthlyQuota > 0 && month >= key.MonthlyQuota) {
+				w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(now, key.DailyQuota > 0 && day >= key.DailyQuota)))
+				httpx.WriteJsonCtx(ctx, w, http.StatusTooManyRequests, map[string]any{
+					"error":         "API key quota exceeded",
+					"daily_used":    day,
+					"daily_quota":   key.DailyQuota,
+					"monthly_used":  month,
+					"monthly_quota": key.MonthlyQuota,
+				})
+				return
+			}
+		}
+
+		if err := m.store.Increment(ctx, key.ID, now); err != nil {
+			logx.WithContext(ctx).Errorf("Failed to count request for API key %s: %v", key.ID, err)
+		}
+
+		next(w, r.WithContext(apikey.WithKey(ctx, key)))
+	}
+}
+
+// retryAfterSeconds returns the time until the exhausted quota resets: the
+// next UTC midnight for daily quotas, the first of next month otherwise.
+func retryAfterSeconds(now time.Time, daily bool) int {
+	now = now.UTC()
+	var reset time.Time
+	if daily {
+		reset = time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+	} else {
+		reset = time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return int(reset.Sub(now).Seconds()) + 1
+}
@@ -48,8 +48,8 @@ func (m *CorsMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
 			w.Header().Set("Access-Control-Allow-Origin", "*")
 		}
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, Origin, X-Requested-With")
-		w.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Type")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, Accept, Origin, X-Requested-With, X-API-Key, X-Signature, X-Signature-Timestamp")
+		w.Header().Set("Access-Control-Expose-Headers", "Content-Length, Content-Type, X-Experiment-Variant, X-Experiments, X-Quota-Daily-Limit, X-Quota-Daily-Remaining, X-Quota-Monthly-Limit, X-Quota-Monthly-Remaining, Retry-After")
 		w.Header().Set("Access-Control-Allow-Credentials", "true")
 		w.Header().Set("Access-Control-Max-Age", "86400") // 24 hours
 
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"net/http"
+	"path"
+
+	"silan-backend/internal/apikey"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// EmbedMiddleware serves the embed API to other sites. Responses may be read
+// from any origin without credentials and are cached for an hour, then
+// served stale for a day while they revalidate. Every route needs an API
+// key, authenticated by ApiKeyMiddleware, holding the read scope named
+// after the last path segment: read:projects for /embed/projects.
+type EmbedMiddleware struct {
+}
+
+func NewEmbedMiddleware() *EmbedMiddleware {
+	return &EmbedMiddleware{}
+}
+
+func (m *EmbedMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Preflight requests are answered by the global OPTIONS route
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Quota-Daily-Limit, X-Quota-Daily-Remaining, X-Quota-Monthly-Limit, X-Quota-Monthly-Remaining, Retry-After")
+
+		ctx := r.Context()
+		key, ok := apikey.FromContext(ctx)
+		if !ok {
+			httpx.WriteJsonCtx(ctx, w, http.StatusUnauthorized, map[string]string{
+				"error": "an API key is required",
+			})
+			return
+		}
+		if scope := "read:" + path.Base(r.URL.Path); !key.HasScope(scope) {
+			httpx.WriteJsonCtx(ctx, w, http.StatusForbidden, map[string]string{
+				"error": "API key lacks the " + scope + " scope",
+			})
+			return
+		}
+
+		w.Header().Set("Cache-Control", "public, max-age=3600, stale-while-revalidate=86400")
+		next(w, r)
+	}
+}
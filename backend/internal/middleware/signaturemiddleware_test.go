@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/replay"
+	"silan-backend/internal/utils"
+
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testSigningSecret = "signing-secret"
+
+func newNonceStore(t *testing.T) *replay.Store {
+	t.Helper()
+	db, err := sql.Open("sqlite3", t.TempDir()+"/test.db?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return replay.NewStore(client)
+}
+
+// signedRequest returns a POST with body, signed at ts with secret.
+func signedRequest(secret string, ts time.Time, body string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/api/v1/admin/sync?x=1", strings.NewReader(body))
+	timestamp := strconv.FormatInt(ts.Unix(), 10)
+	r.Header.Set("X-Signature-Timestamp", timestamp)
+	r.Header.Set("X-Signature", utils.Sign(secret, utils.SignaturePayload(timestamp, r.Method, r.URL.RequestURI(), []byte(body))))
+	return r
+}
+
+func serve(m *SignatureMiddleware, r *http.Request) int {
+	w := httptest.NewRecorder()
+	m.Handle(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})(w, r)
+	return w.Code
+}
+
+func TestSignatureMiddleware(t *testing.T) {
+	now := time.Now()
+	unsigned := func() *http.Request {
+		return httptest.NewRequest(http.MethodPost, "/api/v1/admin/sync", strings.NewReader("{}"))
+	}
+
+	tests := []struct {
+		name     string
+		secret   string
+		optional bool
+		request  func() *http.Request
+		want     int
+	}{
+		{"signed", testSigningSecret, false, func() *http.Request { return signedRequest(testSigningSecret, now, "{}") }, http.StatusOK},
+		{"missing signature", testSigningSecret, false, unsigned, http.StatusUnauthorized},
+		{"missing signature, optional", testSigningSecret, true, unsigned, http.StatusOK},
+		{"no secret configured", "", false, unsigned, http.StatusUnauthorized},
+		{"bad signature", testSigningSecret, false, func() *http.Request { return signedRequest("another-secret", now, "{}") }, http.StatusUnauthorized},
+		{"bad signature, optional", testSigningSecret, true, func() *http.Request { return signedRequest("another-secret", now, "{}") }, http.StatusUnauthorized},
+		{"tampered body", testSigningSecret, false, func() *http.Request {
+			r := signedRequest(testSigningSecret, now, "{}")
+			r.Body = httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"a":1}`)).Body
+			return r
+		}, http.StatusUnauthorized},
+		{"timestamp too old", testSigningSecret, false, func() *http.Request { return signedRequest(testSigningSecret, now.Add(-10*time.Minute), "{}") }, http.StatusUnauthorized},
+		{"timestamp in the future", testSigningSecret, false, func() *http.Request { return signedRequest(testSigningSecret, now.Add(10*time.Minute), "{}") }, http.StatusUnauthorized},
+		{"malformed timestamp", testSigningSecret, false, func() *http.Request {
+			r := signedRequest(testSigningSecret, now, "{}")
+			r.Header.Set("X-Signature-Timestamp", "yesterday")
+			return r
+		}, http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewSignatureMiddleware(tt.secret, tt.optional, 300, newNonceStore(t))
+			if got := serve(m, tt.request()); got != tt.want {
+				t.Fatalf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignatureMiddlewareRejectsReplays(t *testing.T) {
+	nonces := newNonceStore(t)
+	m := NewSignatureMiddleware(testSigningSecret, false, 300, nonces)
+	now := time.Now()
+
+	first := signedRequest(testSigningSecret, now, "{}")
+	signature := first.Header.Get("X-Signature")
+	if got := serve(m, first); got != http.StatusOK {
+		t.Fatalf("first request: got %d", got)
+	}
+	if got := serve(m, signedRequest(testSigningSecret, now, "{}")); got != http.StatusUnauthorized {
+		t.Fatalf("replay: got %d", got)
+	}
+
+	// other spellings of the same signature are replays too
+	respelled := signedRequest(testSigningSecret, now, "{}")
+	respelled.Header.Set("X-Signature", "sha256="+strings.ToUpper(signature))
+	if got := serve(m, respelled); got != http.StatusUnauthorized {
+		t.Fatalf("respelled replay: got %d", got)
+	}
+
+	// seen signatures outlive the middleware, as they do a restart
+	restarted := NewSignatureMiddleware(testSigningSecret, false, 300, nonces)
+	if got := serve(restarted, signedRequest(testSigningSecret, now, "{}")); got != http.StatusUnauthorized {
+		t.Fatalf("replay after restart: got %d", got)
+	}
+
+	if got := serve(m, signedRequest(testSigningSecret, now.Add(time.Second), "{}")); got != http.StatusOK {
+		t.Fatalf("new request: got %d", got)
+	}
+}
@@ -0,0 +1,25 @@
+package middleware
+
+import "net/http"
+
+// WidgetMiddleware serves the public embed widget. Unlike EmbedMiddleware it
+// needs no API key, since the widget only shows what the site shows, but it
+// is readable from any origin without credentials and fixes how long
+// browsers (10 minutes) and shared caches (an hour, then a day stale while
+// revalidating) may keep responses.
+type WidgetMiddleware struct {
+}
+
+func NewWidgetMiddleware() *WidgetMiddleware {
+	return &WidgetMiddleware{}
+}
+
+func (m *WidgetMiddleware) Handle(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Preflight requests are answered by the global OPTIONS route
+		w.Header().Set("Access-Control-Allow-Origin", "*")
+		w.Header().Set("Cache-Control", "public, max-age=600, s-maxage=3600, stale-while-revalidate=86400")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		next(w, r)
+	}
+}
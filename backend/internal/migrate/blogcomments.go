@@ -0,0 +1,157 @@
+// Package migrate holds one-off data migrations between table layouts.
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// BlogComments moves rows of the legacy blog_comments table into the
+// unified comments table with entity_type 'blog', keeping their IDs so that
+// existing comment_likes rows and reply links stay valid.
+//
+// While the legacy table exists the copy methods are also used for
+// read-repair: request paths call CopyPost/CopyComment before querying
+// comments so that rows still only present in blog_comments are not missed.
+// Once the table has been backfilled and dropped every method is a no-op.
+type BlogComments struct {
+	db      *sql.DB
+	driver  string
+	enabled bool
+}
+
+// NewBlogComments checks whether the legacy table is present.
+func NewBlogComments(db *sql.DB, driver string) *BlogComments {
+	m := &BlogComments{db: db, driver: driver}
+	exists, err := m.legacyTableExists(context.Background())
+	if err != nil {
+		logx.Errorf("Failed to look up legacy blog_comments table: %v", err)
+	}
+	m.enabled = exists
+	if exists {
+		logx.Infof("Legacy blog_comments table found; run with -migrate-blog-comments to backfill it")
+	}
+	return m
+}
+
+// Enabled reports whether the legacy table exists.
+func (m *BlogComments) Enabled() bool {
+	return m != nil && m.enabled
+}
+
+func (m *BlogComments) legacyTableExists(ctx context.Context) (bool, error) {
+	return tableExists(ctx, m.db, m.driver, "blog_comments")
+}
+
+// tableExists reports whether the table name exists.
+func tableExists(ctx context.Context, db *sql.DB, driver, name string) (bool, error) {
+	var query string
+	switch driver {
+	case "sqlite3":
+		query = `SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = ?`
+	case "mysql":
+		query = `SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = ?`
+	case "postgres", "postgresql":
+		query = `SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = ?`
+	default:
+		return false, nil
+	}
+
+	var n int
+	if err := db.QueryRowContext(ctx, utils.Rebind(driver, query), name).Scan(&n); err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// copyQuery inserts the legacy rows matching filter that are not in comments
+// yet. Rows are copied oldest first so parents precede their replies, and the
+// like counter is rebuilt from comment_likes since the legacy table had none.
+const copyQuery = `INSERT INTO comments (id, entity_type, entity_id, parent_id, author_name, author_email,
+	author_website, content, type, is_approved, ip_address, user_agent, user_identity_id, likes_count,
+	created_at, updated_at)
+SELECT b.id, 'blog', b.blog_post_id, b.parent_id, b.author_name, b.author_email,
+	b.author_website, b.content, 'general', b.is_approved, b.ip_address, b.user_agent, b.user_identity_id,
+	(SELECT COUNT(*) FROM comment_likes l WHERE l.comment_id = b.id),
+	b.created_at, b.updated_at
+FROM blog_comments b
+WHERE NOT EXISTS (SELECT 1 FROM comments c WHERE c.id = b.id)`
+
+func (m *BlogComments) copyRows(ctx context.Context, filter string, args ...any) (int64, error) {
+	res, err := m.db.ExecContext(ctx, utils.Rebind(m.driver, copyQuery+filter+` ORDER BY b.created_at`), args...)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
+
+// CopyPost copies the legacy comments of a blog post.
+func (m *BlogComments) CopyPost(ctx context.Context, postID string) error {
+	if !m.Enabled() {
+		return nil
+	}
+	_, err := m.copyRows(ctx, ` AND b.blog_post_id = ?`, postID)
+	return err
+}
+
+// CopyComment copies a legacy comment together with the rest of its post's
+// thread, so that its parent and replies are available too.
+func (m *BlogComments) CopyComment(ctx context.Context, commentID string) error {
+	if !m.Enabled() {
+		return nil
+	}
+	_, err := m.copyRows(ctx,
+		` AND b.blog_post_id = (SELECT p.blog_post_id FROM blog_comments p WHERE p.id = ?)`, commentID)
+	return err
+}
+
+// CopyHeld copies the threads of the blog posts that have legacy comments
+// waiting for approval, so that they show up in the moderation queue.
+func (m *BlogComments) CopyHeld(ctx context.Context) error {
+	if !m.Enabled() {
+		return nil
+	}
+	_, err := m.copyRows(ctx,
+		` AND b.blog_post_id IN (SELECT p.blog_post_id FROM blog_comments p WHERE p.is_approved = ?)`, false)
+	return err
+}
+
+// Report summarizes a backfill.
+type Report struct {
+	Legacy   int   // rows in blog_comments
+	Migrated int   // of those, rows present in comments as blog comments
+	Copied   int64 // rows inserted by this run
+}
+
+// Backfill copies every legacy row and verifies that all of them are now
+// present in comments.
+func (m *BlogComments) Backfill(ctx context.Context) (Report, error) {
+	var r Report
+	if !m.Enabled() {
+		return r, nil
+	}
+
+	copied, err := m.copyRows(ctx, "")
+	if err != nil {
+		return r, fmt.Errorf("copy blog_comments: %w", err)
+	}
+	r.Copied = copied
+
+	if err := m.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM blog_comments`).Scan(&r.Legacy); err != nil {
+		return r, err
+	}
+	err = m.db.QueryRowContext(ctx, `SELECT COUNT(*) FROM blog_comments b
+		WHERE EXISTS (SELECT 1 FROM comments c WHERE c.id = b.id AND c.entity_type = 'blog')`).Scan(&r.Migrated)
+	if err != nil {
+		return r, err
+	}
+	if r.Migrated != r.Legacy {
+		return r, fmt.Errorf("verification failed: %d of %d legacy comments are in comments", r.Migrated, r.Legacy)
+	}
+	return r, nil
+}
@@ -0,0 +1,101 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"silan-backend/internal/fingerprint"
+	"silan-backend/internal/utils"
+)
+
+// FingerprintReport counts the rows Fingerprints hashed, per table.
+type FingerprintReport struct {
+	CommentLikes int
+	ProjectLikes int
+	ProjectViews int
+	Comments     int
+}
+
+// Fingerprints replaces the raw browser fingerprints stored before hashing
+// was introduced with their hash: the fingerprint column of comment_likes,
+// project_likes and project_views and the "fp:<fingerprint>" tag at the
+// start of comments.user_agent. Hashed values are left alone, so it can be
+// run again, e.g. after the legacy blog_comments backfill.
+func Fingerprints(ctx context.Context, db *sql.DB, driver string, hash func(string) string) (FingerprintReport, error) {
+	var (
+		r   FingerprintReport
+		err error
+	)
+	for _, t := range []struct {
+		table string
+		n     *int
+	}{
+		{"comment_likes", &r.CommentLikes},
+		{"project_likes", &r.ProjectLikes},
+		{"project_views", &r.ProjectViews},
+	} {
+		*t.n, err = rewriteColumn(ctx, db, driver, t.table, "fingerprint",
+			`fingerprint IS NOT NULL AND fingerprint <> '' AND fingerprint NOT LIKE '`+fingerprint.Prefix+`%'`,
+			hash)
+		if err != nil {
+			return r, fmt.Errorf("hash %s: %w", t.table, err)
+		}
+	}
+
+	r.Comments, err = rewriteColumn(ctx, db, driver, "comments", "user_agent",
+		`user_agent LIKE 'fp:%' AND user_agent NOT LIKE 'fp:`+fingerprint.Prefix+`%'`,
+		func(userAgent string) string {
+			// "fp:<fingerprint>[ | <browser>]"
+			raw, browser, hasBrowser := strings.Cut(strings.TrimPrefix(userAgent, "fp:"), " | ")
+			if raw == "" {
+				return userAgent
+			}
+			tag := "fp:" + hash(raw)
+			if hasBrowser {
+				tag += " | " + browser
+			}
+			return tag
+		})
+	if err != nil {
+		return r, fmt.Errorf("hash comments: %w", err)
+	}
+	return r, nil
+}
+
+// rewriteColumn replaces column with rewrite(column) in the rows of table
+// matching where and returns how many changed.
+func rewriteColumn(ctx context.Context, db *sql.DB, driver, table, column, where string, rewrite func(string) string) (int, error) {
+	rows, err := db.QueryContext(ctx, `SELECT id, `+column+` FROM `+table+` WHERE `+where)
+	if err != nil {
+		return 0, err
+	}
+	values := map[string]string{}
+	for rows.Next() {
+		var id, value string
+		if err := rows.Scan(&id, &value); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		values[id] = value
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	update := utils.Rebind(driver, `UPDATE `+table+` SET `+column+` = ? WHERE id = ?`)
+	changed := 0
+	for id, value := range values {
+		next := rewrite(value)
+		if next == value {
+			continue
+		}
+		if _, err := db.ExecContext(ctx, update, next, id); err != nil {
+			return changed, err
+		}
+		changed++
+	}
+	return changed, nil
+}
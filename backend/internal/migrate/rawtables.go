@@ -0,0 +1,181 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/commentlang"
+	"silan-backend/internal/utils"
+)
+
+// RawTablesReport counts the rows RawTables copied, per retired table.
+type RawTablesReport struct {
+	CommentTombstones int
+	CommentLanguages  int
+	IdentityProfiles  int
+}
+
+// RawTables copies what the raw tables replaced by ent fields still hold
+// into those fields: the deletion times of comment_tombstones into
+// comments.deleted_at and comment_languages into comments.language, where
+// comments it never covered are detected from their content, and
+// identity_profiles and identity_provider_names into the profile fields of
+// user_identities. Tables that
+// are gone are skipped and rows already copied are left alone, so it can be
+// run again; the retired tables can be dropped afterwards.
+func RawTables(ctx context.Context, db *sql.DB, driver string) (RawTablesReport, error) {
+	var (
+		r   RawTablesReport
+		err error
+	)
+	r.CommentTombstones, err = copyTombstones(ctx, db, driver)
+	if err != nil {
+		return r, fmt.Errorf("copy comment_tombstones: %w", err)
+	}
+	r.CommentLanguages, err = copyLanguages(ctx, db, driver)
+	if err != nil {
+		return r, fmt.Errorf("copy comment_languages: %w", err)
+	}
+	r.IdentityProfiles, err = copyIdentityProfiles(ctx, db, driver)
+	if err != nil {
+		return r, fmt.Errorf("copy identity profiles: %w", err)
+	}
+	return r, nil
+}
+
+// copyTombstones sets deleted_at on the comments comment_tombstones lists.
+func copyTombstones(ctx context.Context, db *sql.DB, driver string) (int, error) {
+	if exists, err := tableExists(ctx, db, driver, "comment_tombstones"); err != nil || !exists {
+		return 0, err
+	}
+	rows, err := db.QueryContext(ctx, `SELECT comment_id, deleted_at FROM comment_tombstones`)
+	if err != nil {
+		return 0, err
+	}
+	deleted := map[string]time.Time{}
+	for rows.Next() {
+		var (
+			id string
+			at time.Time
+		)
+		if err := rows.Scan(&id, &at); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		deleted[id] = at
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	update := utils.Rebind(driver, `UPDATE comments SET deleted_at = ? WHERE id = ? AND deleted_at IS NULL`)
+	copied := 0
+	for id, at := range deleted {
+		res, err := db.ExecContext(ctx, update, at, id)
+		if err != nil {
+			return copied, err
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			copied += int(n)
+		}
+	}
+	return copied, nil
+}
+
+// copyLanguages fills in comments.language from comment_languages, and
+// from the content of the comments it doesn't list.
+func copyLanguages(ctx context.Context, db *sql.DB, driver string) (int, error) {
+	langs := map[string]string{}
+	exists, err := tableExists(ctx, db, driver, "comment_languages")
+	if err != nil {
+		return 0, err
+	}
+	if exists {
+		rows, err := db.QueryContext(ctx, `SELECT comment_id, language FROM comment_languages`)
+		if err != nil {
+			return 0, err
+		}
+		for rows.Next() {
+			var id, lang string
+			if err := rows.Scan(&id, &lang); err != nil {
+				rows.Close()
+				return 0, err
+			}
+			langs[id] = lang
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	rows, err := db.QueryContext(ctx, `SELECT id, content FROM comments WHERE language = ''`)
+	if err != nil {
+		return 0, err
+	}
+	missing := map[string]string{}
+	for rows.Next() {
+		var id, content string
+		if err := rows.Scan(&id, &content); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		lang, ok := langs[id]
+		if !ok {
+			lang = commentlang.Detect(content)
+		}
+		missing[id] = lang
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	update := utils.Rebind(driver, `UPDATE comments SET language = ? WHERE id = ? AND language = ''`)
+	copied := 0
+	for id, lang := range missing {
+		if _, err := db.ExecContext(ctx, update, lang, id); err != nil {
+			return copied, err
+		}
+		copied++
+	}
+	return copied, nil
+}
+
+// copyIdentityProfiles sets the edited flags of user_identities from
+// identity_profiles, and provider_display_name from identity_provider_names
+// where it is still empty.
+func copyIdentityProfiles(ctx context.Context, db *sql.DB, driver string) (int, error) {
+	copied := 0
+	for _, t := range []struct {
+		table, query string
+	}{
+		{"identity_profiles", `UPDATE user_identities SET
+			display_name_edited = (SELECT p.display_name_edited FROM identity_profiles p WHERE p.identity_id = user_identities.id),
+			avatar_url_edited = (SELECT p.avatar_url_edited FROM identity_profiles p WHERE p.identity_id = user_identities.id)
+			WHERE id IN (SELECT identity_id FROM identity_profiles)`},
+		{"identity_provider_names", `UPDATE user_identities SET
+			provider_display_name = (SELECT n.display_name FROM identity_provider_names n WHERE n.identity_id = user_identities.id)
+			WHERE (provider_display_name IS NULL OR provider_display_name = '')
+			AND id IN (SELECT identity_id FROM identity_provider_names)`},
+	} {
+		exists, err := tableExists(ctx, db, driver, t.table)
+		if err != nil {
+			return copied, err
+		}
+		if !exists {
+			continue
+		}
+		res, err := db.ExecContext(ctx, t.query)
+		if err != nil {
+			return copied, err
+		}
+		if n, err := res.RowsAffected(); err == nil {
+			copied += int(n)
+		}
+	}
+	return copied, nil
+}
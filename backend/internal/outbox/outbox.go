@@ -0,0 +1,361 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Domain event types written to the outbox.
+const (
+	EventCommentCreated  = "comment.created"
+	EventCommentApproved = "comment.approved"
+	EventCommentEdited   = "comment.edited"
+	EventCommentDeleted  = "comment.deleted"
+	EventCommentLiked    = "comment.liked"
+	EventCommentUnliked  = "comment.unliked"
+
+	EventPostLiked      = "post.liked"
+	EventPostUnliked    = "post.unliked"
+	EventProjectLiked   = "project.liked"
+	EventProjectUnliked = "project.unliked"
+
+	EventContentPublished = "content.published"
+	EventContentUpdated   = "content.updated"
+	EventContentDeleted   = "content.deleted"
+)
+
+// IsContentEvent reports whether eventType is one of the content.* events
+// that change what the public site lists.
+func IsContentEvent(eventType string) bool {
+	return strings.HasPrefix(eventType, "content.")
+}
+
+// ContentEvent is the payload of content.* events. Type is the kind of
+// content (blog, project, idea, site_update) and is "all" for bulk changes
+// such as a content sync. Title and Public describe the entry after the
+// change and are only set for single entries; Status and PreviousStatus are
+// set for ideas.
+type ContentEvent struct {
+	Type           string `json:"type"`
+	ID             string `json:"id,omitempty"`
+	Title          string `json:"title,omitempty"`
+	Public         bool   `json:"public,omitempty"`
+	Status         string `json:"status,omitempty"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+}
+
+// CommentEvent is the payload of comment.* events. Contact details of the
+// author (email, IP, user agent) are deliberately left out.
+type CommentEvent struct {
+	ID         string `json:"id"`
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	ParentID   string `json:"parent_id,omitempty"`
+	AuthorName string `json:"author_name"`
+	Content    string `json:"content"`
+	Approved   bool   `json:"approved"`
+	CreatedAt  string `json:"created_at"`
+}
+
+// NewCommentEvent builds the event payload for a stored comment.
+func NewCommentEvent(c *ent.Comment) CommentEvent {
+	ev := CommentEvent{
+		ID:         c.ID.String(),
+		EntityType: c.EntityType,
+		EntityID:   c.EntityID.String(),
+		AuthorName: c.AuthorName,
+		Content:    c.Content,
+		Approved:   c.IsApproved,
+		CreatedAt:  utils.FormatTime(c.CreatedAt.UTC()),
+	}
+	if c.ParentID != uuid.Nil {
+		ev.ParentID = c.ParentID.String()
+	}
+	return ev
+}
+
+// CommentLikeEvent is the payload of comment.liked and comment.unliked.
+type CommentLikeEvent struct {
+	CommentID  string `json:"comment_id"`
+	LikesCount int    `json:"likes_count"`
+}
+
+// LikeEvent is the payload of post.liked, post.unliked, project.liked and
+// project.unliked.
+type LikeEvent struct {
+	ID         string `json:"id"`
+	LikesCount int    `json:"likes_count"`
+}
+
+// Execer is satisfied by *sql.DB, *sql.Tx and *ent.Tx, so events can be
+// written in the same transaction as the domain change they describe.
+type Execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+// Event is a row of the events_outbox table.
+type Event struct {
+	ID        string
+	Type      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+	Attempts  int
+}
+
+// Handler receives drained events. A non-nil error leaves the event in the
+// outbox to be retried later.
+type Handler func(ctx context.Context, ev Event) error
+
+// Write stores an event through ex. Pass the transaction of the domain write
+// so the event is committed (or rolled back) together with it.
+func Write(ctx context.Context, ex Execer, driver, eventType string, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	_, err = ex.ExecContext(ctx, utils.Rebind(driver,
+		`INSERT INTO events_outbox (id, event_type, payload, attempts, next_attempt_at, created_at)
+		VALUES (?, ?, ?, 0, ?, ?)`),
+		uuid.New().String(), eventType, string(payload), now, now,
+	)
+	return err
+}
+
+// Queryer is an Execer that can also query, which *sql.DB, *sql.Tx and
+// *ent.Tx all are.
+type Queryer interface {
+	Execer
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// ForgetComment deletes the events of a comment through q, delivered or
+// not, since their payloads hold what the author wrote, which is erased on
+// request. A pending comment.deleted event is kept so subscribers still
+// learn of the deletion; its payload holds no content. Events are matched
+// by the id or comment_id of their decoded payload.
+func ForgetComment(ctx context.Context, q Queryer, driver, commentID string) error {
+	rows, err := q.QueryContext(ctx, utils.Rebind(driver,
+		`SELECT id, event_type, payload, dispatched_at FROM events_outbox
+		WHERE event_type LIKE 'comment.%' AND payload LIKE ?`),
+		"%"+commentID+"%",
+	)
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for rows.Next() {
+		var (
+			id, eventType, payload string
+			dispatchedAt           sql.NullTime
+		)
+		if err := rows.Scan(&id, &eventType, &payload, &dispatchedAt); err != nil {
+			rows.Close()
+			return err
+		}
+		if eventType == EventCommentDeleted && !dispatchedAt.Valid {
+			continue
+		}
+		if PayloadComment(json.RawMessage(payload)) == commentID {
+			ids = append(ids, id)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := q.ExecContext(ctx, utils.Rebind(driver, `DELETE FROM events_outbox WHERE id = ?`), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PayloadComment returns the comment a comment.* event payload is about:
+// its id, or comment_id for likes. It is empty for other payloads.
+func PayloadComment(payload json.RawMessage) string {
+	var ref struct {
+		ID        string `json:"id"`
+		CommentID string `json:"comment_id"`
+	}
+	if err := json.Unmarshal(payload, &ref); err != nil {
+		return ""
+	}
+	if ref.CommentID != "" {
+		return ref.CommentID
+	}
+	return ref.ID
+}
+
+// Relay drains the outbox in the background and hands each event to the
+// registered handlers. An event is marked dispatched only once every handler
+// has accepted it, so nothing is lost if the process dies mid-way; handlers
+// must therefore tolerate the occasional duplicate (the event ID is stable).
+type Relay struct {
+	db          *sql.DB
+	driver      string
+	interval    time.Duration
+	batchSize   int
+	maxAttempts int
+
+	mu       sync.Mutex
+	handlers []Handler
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+func NewRelay(db *sql.DB, driver string) *Relay {
+	return &Relay{
+		db:          db,
+		driver:      driver,
+		interval:    2 * time.Second,
+		batchSize:   50,
+		maxAttempts: 10,
+	}
+}
+
+// Register adds a handler that receives every drained event.
+func (r *Relay) Register(h Handler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers = append(r.handlers, h)
+}
+
+// Start launches the polling loop. It is a no-op if already running.
+func (r *Relay) Start() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stop != nil {
+		return
+	}
+	r.stop = make(chan struct{})
+	r.done = make(chan struct{})
+	go r.loop(r.stop, r.done)
+}
+
+// Stop ends the polling loop and waits for the current batch to finish.
+func (r *Relay) Stop() {
+	r.mu.Lock()
+	stop, done := r.stop, r.done
+	r.stop, r.done = nil, nil
+	r.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (r *Relay) loop(stop, done chan struct{}) {
+	defer close(done)
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if _, err := r.Drain(context.Background()); err != nil {
+				logx.Errorf("outbox drain failed: %v", err)
+			}
+		}
+	}
+}
+
+// Drain dispatches one batch of pending events and returns how many were
+// delivered.
+func (r *Relay) Drain(ctx context.Context) (int, error) {
+	events, err := r.pending(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	r.mu.Lock()
+	handlers := append([]Handler(nil), r.handlers...)
+	r.mu.Unlock()
+
+	delivered := 0
+	for _, ev := range events {
+		var handlerErr error
+		for _, h := range handlers {
+			if err := h(ctx, ev); err != nil {
+				handlerErr = err
+				break
+			}
+		}
+		if handlerErr != nil {
+			r.markFailed(ctx, ev, handlerErr)
+			continue
+		}
+		if err := r.markDispatched(ctx, ev.ID); err != nil {
+			return delivered, err
+		}
+		delivered++
+	}
+	return delivered, nil
+}
+
+func (r *Relay) pending(ctx context.Context) ([]Event, error) {
+	rows, err := r.db.QueryContext(ctx, utils.Rebind(r.driver,
+		`SELECT id, event_type, payload, attempts, created_at FROM events_outbox
+		WHERE dispatched_at IS NULL AND attempts < ? AND next_attempt_at <= ?
+		ORDER BY created_at LIMIT `+strconv.Itoa(r.batchSize)),
+		r.maxAttempts, time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var (
+			ev      Event
+			payload string
+		)
+		if err := rows.Scan(&ev.ID, &ev.Type, &payload, &ev.Attempts, &ev.CreatedAt); err != nil {
+			return nil, err
+		}
+		ev.Payload = json.RawMessage(payload)
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+func (r *Relay) markDispatched(ctx context.Context, id string) error {
+	_, err := r.db.ExecContext(ctx, utils.Rebind(r.driver,
+		`UPDATE events_outbox SET dispatched_at = ?, last_error = NULL WHERE id = ?`),
+		time.Now().UTC(), id,
+	)
+	return err
+}
+
+// markFailed schedules a retry with exponential backoff (capped at one hour).
+func (r *Relay) markFailed(ctx context.Context, ev Event, cause error) {
+	attempts := ev.Attempts + 1
+	backoff := time.Duration(1<<min(attempts, 12)) * time.Second
+	if backoff > time.Hour {
+		backoff = time.Hour
+	}
+	logx.Errorf("outbox event %s (%s) failed, attempt %d: %v", ev.ID, ev.Type, attempts, cause)
+
+	_, err := r.db.ExecContext(ctx, utils.Rebind(r.driver,
+		`UPDATE events_outbox SET attempts = ?, last_error = ?, next_attempt_at = ? WHERE id = ?`),
+		attempts, cause.Error(), time.Now().UTC().Add(backoff), ev.ID,
+	)
+	if err != nil {
+		logx.Errorf("failed to record outbox failure for %s: %v", ev.ID, err)
+	}
+}
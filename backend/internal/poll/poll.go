@@ -0,0 +1,454 @@
+package poll
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned for unknown polls.
+	ErrNotFound = errors.New("poll not found")
+	// ErrClosed is returned when voting on a poll past its close date.
+	ErrClosed = errors.New("poll is closed")
+	// ErrInvalidChoice is returned when the selected options do not fit the poll.
+	ErrInvalidChoice = errors.New("invalid poll choice")
+)
+
+// embedPattern matches poll references in post content, e.g. {{poll:<uuid>}}.
+var embedPattern = regexp.MustCompile(`\{\{\s*poll:([0-9a-fA-F-]{36})\s*\}\}`)
+
+// ReferencedIDs returns the IDs of the polls embedded in content, in order
+// of first appearance.
+func ReferencedIDs(content string) []string {
+	var ids []string
+	seen := map[string]bool{}
+	for _, m := range embedPattern.FindAllStringSubmatch(content, -1) {
+		id := m[1]
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Poll is a question with a fixed set of options.
+type Poll struct {
+	ID             string
+	Question       string
+	MultipleChoice bool
+	ClosesAt       *time.Time
+	Options        []Option
+	TotalVoters    int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Closed reports whether the poll no longer accepts votes.
+func (p *Poll) Closed() bool {
+	return p.ClosesAt != nil && !time.Now().Before(*p.ClosesAt)
+}
+
+// Data converts the poll to its API representation. choices are the option
+// IDs picked by the requesting voter, if any.
+func (p *Poll) Data(choices []string) types.PollData {
+	options := make([]types.PollOptionData, 0, len(p.Options))
+	for _, o := range p.Options {
+		options = append(options, types.PollOptionData{ID: o.ID, Text: o.Text, Votes: o.Votes})
+	}
+
+	var closesAt string
+	if p.ClosesAt != nil {
+		closesAt = utils.FormatTime(*p.ClosesAt)
+	}
+
+	return types.PollData{
+		ID:             p.ID,
+		Question:       p.Question,
+		MultipleChoice: p.MultipleChoice,
+		ClosesAt:       closesAt,
+		IsClosed:       p.Closed(),
+		Options:        options,
+		TotalVoters:    p.TotalVoters,
+		VotedOptionIDs: choices,
+		CreatedAt:      utils.FormatTime(p.CreatedAt),
+		UpdatedAt:      utils.FormatTime(p.UpdatedAt),
+	}
+}
+
+// Option is one answer of a poll with its vote count.
+type Option struct {
+	ID        string
+	Text      string
+	SortOrder int
+	Votes     int
+}
+
+// Voter identifies who cast a ballot. Votes are deduplicated on the user
+// identity when present and on the browser fingerprint otherwise, the same
+// way likes are. Fingerprints holds the fingerprint hashed with every salt
+// still kept, current first; new votes store the first.
+type Voter struct {
+	Fingerprints   []string
+	UserIdentityID string
+	IP             string
+}
+
+// keys returns the voter keys that match the voter's ballots, the one new
+// ballots are stored under first.
+func (v Voter) keys() []string {
+	if v.UserIdentityID != "" {
+		return []string{"user:" + v.UserIdentityID}
+	}
+	keys := make([]string, len(v.Fingerprints))
+	for i, fp := range v.Fingerprints {
+		keys[i] = "fp:" + fp
+	}
+	return keys
+}
+
+// fingerprint returns the hash new ballots store.
+func (v Voter) fingerprint() string {
+	if len(v.Fingerprints) == 0 {
+		return ""
+	}
+	return v.Fingerprints[0]
+}
+
+// inKeys returns the placeholder list of an IN over keys.
+func inKeys(keys []string) (string, []any) {
+	args := make([]any, len(keys))
+	for i, k := range keys {
+		args[i] = k
+	}
+	return `(?` + strings.Repeat(`, ?`, len(keys)-1) + `)`, args
+}
+
+// Store persists polls in the raw polls, poll_options and poll_votes tables.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+const pollColumns = `id, question, multiple_choice, closes_at, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanPoll(row scanner) (*Poll, error) {
+	var (
+		p        Poll
+		closesAt sql.NullTime
+	)
+	if err := row.Scan(&p.ID, &p.Question, &p.MultipleChoice, &closesAt, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if closesAt.Valid {
+		t := closesAt.Time
+		p.ClosesAt = &t
+	}
+	return &p, nil
+}
+
+// Get returns a poll with its options and current results.
+func (s *Store) Get(ctx context.Context, id string) (*Poll, error) {
+	p, err := scanPoll(s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT `+pollColumns+` FROM polls WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadResults(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// List returns all polls with their results, newest first.
+func (s *Store) List(ctx context.Context) ([]*Poll, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+pollColumns+` FROM polls ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var polls []*Poll
+	for rows.Next() {
+		p, err := scanPoll(rows)
+		if err != nil {
+			return nil, err
+		}
+		polls = append(polls, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, p := range polls {
+		if err := s.loadResults(ctx, p); err != nil {
+			return nil, err
+		}
+	}
+	return polls, nil
+}
+
+func (s *Store) loadResults(ctx context.Context, p *Poll) error {
+	rows, err := s.db.QueryContext(ctx, utils.Rebind(s.driver,
+		`SELECT o.id, o.text, o.sort_order, COUNT(v.option_id)
+		FROM poll_options o
+		LEFT JOIN poll_votes v ON v.option_id = o.id
+		WHERE o.poll_id = ?
+		GROUP BY o.id, o.text, o.sort_order
+		ORDER BY o.sort_order, o.id`), p.ID)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	p.Options = nil
+	for rows.Next() {
+		var o Option
+		if err := rows.Scan(&o.ID, &o.Text, &o.SortOrder, &o.Votes); err != nil {
+			return err
+		}
+		p.Options = append(p.Options, o)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	return s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT COUNT(DISTINCT voter_key) FROM poll_votes WHERE poll_id = ?`), p.ID).Scan(&p.TotalVoters)
+}
+
+// Create stores a new poll with its options and fills in the generated IDs.
+func (s *Store) Create(ctx context.Context, p *Poll) error {
+	now := time.Now().UTC()
+	p.ID = uuid.New().String()
+	p.CreatedAt = now
+	p.UpdatedAt = now
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO polls (id, question, multiple_choice, closes_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?)`),
+		p.ID, p.Question, p.MultipleChoice, p.ClosesAt, p.CreatedAt, p.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	for i := range p.Options {
+		if err := s.insertOption(ctx, tx, p.ID, &p.Options[i], i); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (s *Store) insertOption(ctx context.Context, tx *sql.Tx, pollID string, o *Option, order int) error {
+	o.ID = uuid.New().String()
+	o.SortOrder = order
+	_, err := tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO poll_options (id, poll_id, text, sort_order) VALUES (?, ?, ?, ?)`),
+		o.ID, pollID, o.Text, o.SortOrder,
+	)
+	return err
+}
+
+// Update saves the poll fields and its option list. Options with an ID are
+// renamed and reordered, options without one are added, and existing options
+// missing from the list are removed together with their votes.
+func (s *Store) Update(ctx context.Context, p *Poll) error {
+	p.UpdatedAt = time.Now().UTC()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE polls SET question = ?, multiple_choice = ?, closes_at = ?, updated_at = ? WHERE id = ?`),
+		p.Question, p.MultipleChoice, p.ClosesAt, p.UpdatedAt, p.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+
+	existing := map[string]bool{}
+	rows, err := tx.QueryContext(ctx, utils.Rebind(s.driver, `SELECT id FROM poll_options WHERE poll_id = ?`), p.ID)
+	if err != nil {
+		return err
+	}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			rows.Close()
+			return err
+		}
+		existing[id] = true
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for i := range p.Options {
+		o := &p.Options[i]
+		if o.ID == "" {
+			if err := s.insertOption(ctx, tx, p.ID, o, i); err != nil {
+				return err
+			}
+			continue
+		}
+		if !existing[o.ID] {
+			return ErrInvalidChoice
+		}
+		delete(existing, o.ID)
+		o.SortOrder = i
+		_, err := tx.ExecContext(ctx, utils.Rebind(s.driver,
+			`UPDATE poll_options SET text = ?, sort_order = ? WHERE id = ?`), o.Text, o.SortOrder, o.ID)
+		if err != nil {
+			return err
+		}
+	}
+	for id := range existing {
+		if _, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM poll_votes WHERE option_id = ?`), id); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM poll_options WHERE id = ?`), id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Delete removes a poll with its options and votes.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM poll_votes WHERE poll_id = ?`), id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM poll_options WHERE poll_id = ?`), id); err != nil {
+		return err
+	}
+	res, err := tx.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM polls WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit()
+}
+
+// Vote records the voter's ballot, replacing any earlier one so that each
+// voter is counted once per poll.
+func (s *Store) Vote(ctx context.Context, pollID string, optionIDs []string, voter Voter) error {
+	keys := voter.keys()
+	if len(keys) == 0 {
+		return errors.New("either user_identity_id or fingerprint must be provided")
+	}
+
+	p, err := s.Get(ctx, pollID)
+	if err != nil {
+		return err
+	}
+	if p.Closed() {
+		return ErrClosed
+	}
+	if len(optionIDs) == 0 || (!p.MultipleChoice && len(optionIDs) > 1) {
+		return ErrInvalidChoice
+	}
+	valid := map[string]bool{}
+	for _, o := range p.Options {
+		valid[o.ID] = true
+	}
+	chosen := map[string]bool{}
+	for _, id := range optionIDs {
+		if !valid[id] {
+			return ErrInvalidChoice
+		}
+		chosen[id] = true
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	in, args := inKeys(keys)
+	_, err = tx.ExecContext(ctx, utils.Rebind(s.driver,
+		`DELETE FROM poll_votes WHERE poll_id = ? AND voter_key IN `+in), append([]any{pollID}, args...)...)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	for id := range chosen {
+		_, err := tx.ExecContext(ctx, utils.Rebind(s.driver,
+			`INSERT INTO poll_votes (poll_id, option_id, voter_key, fingerprint, user_identity_id, ip, created_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`),
+			pollID, id, keys[0], voter.fingerprint(), voter.UserIdentityID, voter.IP, now,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// Choices returns the option IDs the voter selected on a poll.
+func (s *Store) Choices(ctx context.Context, pollID string, voter Voter) ([]string, error) {
+	keys := voter.keys()
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	in, args := inKeys(keys)
+	rows, err := s.db.QueryContext(ctx, utils.Rebind(s.driver,
+		`SELECT DISTINCT option_id FROM poll_votes WHERE poll_id = ? AND voter_key IN `+in), append([]any{pollID}, args...)...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
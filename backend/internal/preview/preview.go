@@ -0,0 +1,89 @@
+// Package preview issues and checks signed, time-limited tokens that grant
+// read access to a single unpublished post or project. Tokens aren't
+// stored: the expiry is signed into each token and checked on every use, so
+// there is nothing for a background job to expire.
+package preview
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrInvalid is returned for malformed or tampered tokens.
+	ErrInvalid = errors.New("invalid preview token")
+	// ErrExpired is returned for tokens past their expiry.
+	ErrExpired = errors.New("preview token has expired")
+)
+
+// Content kinds that can be previewed.
+const (
+	KindBlog    = "blog"
+	KindProject = "project"
+)
+
+// Claims identify what a token grants access to.
+type Claims struct {
+	Kind      string
+	ID        string
+	ExpiresAt time.Time
+}
+
+// Sign returns a token for c. The claims are readable by anyone holding the
+// token; only the signature is secret.
+func Sign(secret []byte, c Claims) string {
+	payload := c.Kind + ":" + c.ID + ":" + strconv.FormatInt(c.ExpiresAt.Unix(), 10)
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(payload)) + "." + enc.EncodeToString(sign(secret, payload))
+}
+
+// Verify checks the token signature and expiry and returns its claims.
+func Verify(secret []byte, token string, now time.Time) (Claims, error) {
+	enc := base64.RawURLEncoding
+	encPayload, encSig, ok := strings.Cut(token, ".")
+	if !ok {
+		return Claims{}, ErrInvalid
+	}
+	payload, err := enc.DecodeString(encPayload)
+	if err != nil {
+		return Claims{}, ErrInvalid
+	}
+	sig, err := enc.DecodeString(encSig)
+	if err != nil || !hmac.Equal(sig, sign(secret, string(payload))) {
+		return Claims{}, ErrInvalid
+	}
+
+	parts := strings.Split(string(payload), ":")
+	if len(parts) != 3 {
+		return Claims{}, ErrInvalid
+	}
+	exp, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return Claims{}, ErrInvalid
+	}
+	c := Claims{Kind: parts[0], ID: parts[1], ExpiresAt: time.Unix(exp, 0).UTC()}
+	if !now.Before(c.ExpiresAt) {
+		return c, ErrExpired
+	}
+	return c, nil
+}
+
+// Allows reports whether token is valid for the given content right now.
+func Allows(secret []byte, token, kind, id string) bool {
+	if len(secret) == 0 || token == "" {
+		return false
+	}
+	c, err := Verify(secret, token, time.Now())
+	return err == nil && c.Kind == kind && c.ID == id
+}
+
+func sign(secret []byte, payload string) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
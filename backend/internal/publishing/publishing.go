@@ -0,0 +1,200 @@
+// Package publishing publishes draft blog posts at a scheduled time and
+// decides, through a Policy, when publishing is allowed at all.
+package publishing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/activity"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNotFound is returned when a post has no pending schedule.
+	ErrNotFound = errors.New("scheduled post not found")
+	// ErrPublished is returned when scheduling a post that is already live.
+	ErrPublished = errors.New("post is already published")
+)
+
+// Schedule is a pending or processed entry of the scheduled_posts table.
+type Schedule struct {
+	PostID      string
+	PublishAt   time.Time
+	PublishedAt *time.Time
+	CreatedAt   time.Time
+}
+
+// Store keeps publication schedules in the raw scheduled_posts table and
+// applies them to the ent blog posts. Publishes are logged to activity.
+type Store struct {
+	db       *sql.DB
+	driver   string
+	client   *ent.Client
+	activity *activity.Store
+}
+
+func NewStore(db *sql.DB, driver string, client *ent.Client, activity *activity.Store) *Store {
+	return &Store{db: db, driver: driver, client: client, activity: activity}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Schedule sets (or moves) the publish time of a draft post.
+func (s *Store) Schedule(ctx context.Context, postID uuid.UUID, at time.Time) (*Schedule, error) {
+	post, err := s.client.BlogPost.Get(ctx, postID)
+	if err != nil {
+		return nil, err
+	}
+	if post.Status == blogpost.StatusPublished {
+		return nil, ErrPublished
+	}
+
+	at = at.UTC()
+	now := time.Now().UTC()
+	if _, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM scheduled_posts WHERE post_id = ?`), postID.String()); err != nil {
+		return nil, err
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO scheduled_posts (post_id, publish_at, created_at) VALUES (?, ?, ?)`),
+		postID.String(), at, now,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &Schedule{PostID: postID.String(), PublishAt: at, CreatedAt: now}, nil
+}
+
+// Cancel removes the pending schedule of a post.
+func (s *Store) Cancel(ctx context.Context, postID string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`DELETE FROM scheduled_posts WHERE post_id = ? AND published_at IS NULL`), postID)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrNotFound
+	}
+	return err
+}
+
+// Pending lists the schedules that have not run yet, soonest first.
+func (s *Store) Pending(ctx context.Context) ([]*Schedule, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT post_id, publish_at, created_at FROM scheduled_posts
+		WHERE published_at IS NULL ORDER BY publish_at`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Schedule
+	for rows.Next() {
+		sc := &Schedule{}
+		if err := rows.Scan(&sc.PostID, &sc.PublishAt, &sc.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, sc)
+	}
+	return list, rows.Err()
+}
+
+// PublishDue publishes every post whose publish time has passed, including
+// ones missed while the server was down. Each post is claimed, published and
+// announced on the outbox in one transaction, so running it twice never
+// publishes a post twice. It returns the number of posts published.
+func (s *Store) PublishDue(ctx context.Context) (int, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT post_id, publish_at FROM scheduled_posts
+		WHERE published_at IS NULL AND publish_at <= ? ORDER BY publish_at`),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	var due []*Schedule
+	for rows.Next() {
+		sc := &Schedule{}
+		if err := rows.Scan(&sc.PostID, &sc.PublishAt); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		due = append(due, sc)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, sc := range due {
+		ok, err := s.publish(ctx, sc)
+		if err != nil {
+			return published, fmt.Errorf("publish post %s: %w", sc.PostID, err)
+		}
+		if ok {
+			published++
+		}
+	}
+	return published, nil
+}
+
+func (s *Store) publish(ctx context.Context, sc *Schedule) (bool, error) {
+	postID, err := uuid.Parse(sc.PostID)
+	if err != nil {
+		return false, err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	res, err := tx.ExecContext(ctx, s.rebind(
+		`UPDATE scheduled_posts SET published_at = ? WHERE post_id = ? AND published_at IS NULL`),
+		time.Now().UTC(), sc.PostID,
+	)
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if n, err := res.RowsAffected(); err != nil || n == 0 {
+		// Claimed by another run in the meantime
+		tx.Rollback()
+		return false, err
+	}
+
+	post, err := tx.BlogPost.UpdateOneID(postID).
+		SetStatus(blogpost.StatusPublished).
+		SetPublishedAt(sc.PublishAt).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		// The post was removed; the schedule stays marked as processed
+		return false, tx.Commit()
+	}
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	ev := outbox.ContentEvent{Type: "blog", ID: sc.PostID, Title: post.Title, Public: true}
+	if err := s.activity.Record(ctx, tx, outbox.EventContentPublished, ev); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	if err := outbox.Write(ctx, tx, s.driver, outbox.EventContentPublished, ev); err != nil {
+		tx.Rollback()
+		return false, err
+	}
+	return true, tx.Commit()
+}
@@ -0,0 +1,189 @@
+package publishing
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Codes of BlockedError.
+const (
+	CodeFrozen        = "publishing_frozen"
+	CodeOutsideWindow = "outside_publish_window"
+)
+
+// BlockedError is returned for publishes the Policy doesn't allow. It is
+// written to the client as JSON; NextAllowedAt is empty when it isn't known,
+// such as during an open-ended freeze.
+type BlockedError struct {
+	Message       string `json:"message"`
+	Code          string `json:"code"`
+	Reason        string `json:"reason,omitempty"`
+	NextAllowedAt string `json:"next_allowed_at,omitempty"`
+}
+
+func (e *BlockedError) Error() string {
+	return e.Message
+}
+
+// Window is a weekly period publishing is allowed in. Start and End are
+// minutes after midnight; a window ending before it starts runs past
+// midnight into the next day. No Days means every day.
+type Window struct {
+	Days  []time.Weekday
+	Start int
+	End   int
+}
+
+// contains reports whether the local time t falls in w.
+func (w Window) contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	if w.Start <= w.End {
+		return w.onDay(t.Weekday()) && minute >= w.Start && minute < w.End
+	}
+	// Past midnight the window belongs to the day it started on
+	if minute >= w.Start {
+		return w.onDay(t.Weekday())
+	}
+	return minute < w.End && w.onDay((t.Weekday()+6)%7)
+}
+
+func (w Window) onDay(d time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, day := range w.Days {
+		if day == d {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy decides when content may be published. Publishing is blocked while
+// Frozen, between FreezeFrom and FreezeUntil (either may be zero for an open
+// end), and outside Windows when any are set. Saving drafts is never
+// blocked.
+type Policy struct {
+	Frozen       bool
+	FreezeFrom   time.Time
+	FreezeUntil  time.Time
+	FreezeReason string
+	Windows      []Window
+	Location     *time.Location
+}
+
+// Check returns a *BlockedError if publishing at now isn't allowed.
+func (p *Policy) Check(now time.Time) error {
+	if p == nil {
+		return nil
+	}
+	if p.frozen(now) {
+		e := &BlockedError{
+			Message: "publishing is frozen",
+			Code:    CodeFrozen,
+			Reason:  p.FreezeReason,
+		}
+		if !p.Frozen && !p.FreezeUntil.IsZero() {
+			e.Message = "publishing is frozen until " + p.FreezeUntil.UTC().Format(time.RFC3339)
+			e.NextAllowedAt = p.FreezeUntil.UTC().Format(time.RFC3339)
+		}
+		return e
+	}
+	if len(p.Windows) == 0 || p.inWindow(now) {
+		return nil
+	}
+	e := &BlockedError{
+		Message: "publishing is only allowed during the publish windows",
+		Code:    CodeOutsideWindow,
+	}
+	if next, ok := p.nextWindow(now); ok {
+		e.NextAllowedAt = next.UTC().Format(time.RFC3339)
+	}
+	return e
+}
+
+func (p *Policy) frozen(now time.Time) bool {
+	if p.Frozen {
+		return true
+	}
+	if p.FreezeFrom.IsZero() && p.FreezeUntil.IsZero() {
+		return false
+	}
+	return (p.FreezeFrom.IsZero() || !now.Before(p.FreezeFrom)) &&
+		(p.FreezeUntil.IsZero() || now.Before(p.FreezeUntil))
+}
+
+func (p *Policy) inWindow(now time.Time) bool {
+	local := now.In(p.location())
+	for _, w := range p.Windows {
+		if w.contains(local) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextWindow returns when the next window opens within a week of now.
+func (p *Policy) nextWindow(now time.Time) (time.Time, bool) {
+	local := now.In(p.location()).Truncate(time.Minute)
+	for t := local.Add(time.Minute); t.Sub(local) <= 7*24*time.Hour; t = t.Add(time.Minute) {
+		if p.inWindow(t) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+func (p *Policy) location() *time.Location {
+	if p.Location == nil {
+		return time.UTC
+	}
+	return p.Location
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ParseWindow builds a window from weekday names (their first three
+// letters suffice) and HH:MM start and end times.
+func ParseWindow(days []string, start, end string) (Window, error) {
+	var w Window
+	for _, d := range days {
+		key := strings.ToLower(strings.TrimSpace(d))
+		if len(key) > 3 {
+			key = key[:3]
+		}
+		day, ok := weekdays[key]
+		if !ok {
+			return Window{}, fmt.Errorf("unknown weekday %q", d)
+		}
+		w.Days = append(w.Days, day)
+	}
+	var err error
+	if w.Start, err = parseClock(start); err != nil {
+		return Window{}, err
+	}
+	if w.End, err = parseClock(end); err != nil {
+		return Window{}, err
+	}
+	if w.Start == w.End {
+		return Window{}, fmt.Errorf("window starts and ends at %s", start)
+	}
+	return w, nil
+}
+
+// parseClock returns the minutes after midnight of an HH:MM time. 24:00
+// ends a window at midnight.
+func parseClock(s string) (int, error) {
+	if strings.TrimSpace(s) == "24:00" {
+		return 24 * 60, nil
+	}
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, want HH:MM", s)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
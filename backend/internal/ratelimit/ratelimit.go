@@ -0,0 +1,102 @@
+// Package ratelimit caps how often visitors may repeat an action within a
+// sliding window. Attempts are counted in the raw rate_limit_hits table so
+// the caps hold across restarts; keys are stored hashed with the salted
+// fingerprint hasher.
+package ratelimit
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"silan-backend/internal/fingerprint"
+	"silan-backend/internal/utils"
+)
+
+// Rule allows Limit attempts per key within Window. Scope names what the
+// key is, e.g. "fingerprint" or "ip". A Limit of 0 disables the rule.
+type Rule struct {
+	Scope  string
+	Limit  int
+	Window time.Duration
+}
+
+// Error is returned for attempts over a rule's limit. It is written to the
+// client as a JSON 429.
+type Error struct {
+	Message           string `json:"message"`
+	Scope             string `json:"scope"`
+	Limit             int    `json:"limit"`
+	WindowSeconds     int    `json:"window_seconds"`
+	RetryAfterSeconds int    `json:"retry_after_seconds"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Store counts attempts in the raw rate_limit_hits table.
+type Store struct {
+	db     *sql.DB
+	driver string
+	hasher *fingerprint.Hasher
+}
+
+func NewStore(db *sql.DB, driver string, hasher *fingerprint.Hasher) *Store {
+	return &Store{db: db, driver: driver, hasher: hasher}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Hit checks the attempt at now of key at action against rule and records
+// it if allowed. Attempts over the limit return an *Error and aren't
+// recorded, so retrying doesn't extend the wait.
+func (s *Store) Hit(ctx context.Context, action, key string, rule Rule, now time.Time) error {
+	if rule.Limit <= 0 || key == "" {
+		return nil
+	}
+	hash := s.hasher.Hash(ctx, action+":"+rule.Scope+":"+key)
+	now = now.UTC()
+
+	since := now.Add(-rule.Window)
+	var count int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM rate_limit_hits WHERE key_hash = ? AND created_at > ?`), hash, since,
+	).Scan(&count)
+	if err != nil {
+		return err
+	}
+	if count >= rule.Limit {
+		// The wait ends when the oldest attempt in the window drops out
+		var oldest time.Time
+		err := s.db.QueryRowContext(ctx, s.rebind(
+			`SELECT created_at FROM rate_limit_hits WHERE key_hash = ? AND created_at > ?
+			ORDER BY created_at LIMIT 1`), hash, since,
+		).Scan(&oldest)
+		if err != nil {
+			return err
+		}
+		return &Error{
+			Message:           "too many attempts, try again later",
+			Scope:             rule.Scope,
+			Limit:             rule.Limit,
+			WindowSeconds:     int(rule.Window.Seconds()),
+			RetryAfterSeconds: max(int(oldest.Add(rule.Window).Sub(now).Round(time.Second).Seconds()), 1),
+		}
+	}
+
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO rate_limit_hits (key_hash, created_at) VALUES (?, ?)`), hash, now)
+	return err
+}
+
+// Purge deletes the attempts made before cutoff.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM rate_limit_hits WHERE created_at < ?`), cutoff.UTC())
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
@@ -0,0 +1,148 @@
+// Package reaction stores emoji reactions on comments, blog posts and
+// projects. Unlike likes a visitor can leave several reactions on the same
+// entity, one per emoji.
+package reaction
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/predicate"
+	entreaction "silan-backend/internal/ent/reaction"
+
+	"github.com/google/uuid"
+)
+
+// Emoji lists the reactions readers can pick from.
+var Emoji = []string{"👍", "❤️", "🎉"}
+
+// Allowed reports whether emoji is one of Emoji.
+func Allowed(emoji string) bool {
+	for _, e := range Emoji {
+		if e == emoji {
+			return true
+		}
+	}
+	return false
+}
+
+// Reactor identifies who reacts. Signed-in visitors are matched by identity;
+// anonymous ones by any of their fingerprint hashes, the first of which is
+// the one stored with new reactions.
+type Reactor struct {
+	IdentityID   string
+	Fingerprints []string
+}
+
+func (r Reactor) empty() bool {
+	return r.IdentityID == "" && len(r.Fingerprints) == 0
+}
+
+// match returns the predicate selecting r's reactions.
+func (r Reactor) match() predicate.Reaction {
+	if r.IdentityID != "" {
+		return entreaction.UserIdentityID(r.IdentityID)
+	}
+	return entreaction.And(entreaction.UserIdentityID(""), entreaction.FingerprintIn(r.Fingerprints...))
+}
+
+// Store persists reactions.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Toggle removes r's emoji reaction on the entity if there is one and adds
+// it otherwise. It reports whether the reaction is set afterwards.
+func (s *Store) Toggle(ctx context.Context, entityType, entityID, emoji string, r Reactor, ip string) (bool, error) {
+	if r.empty() {
+		return false, nil
+	}
+
+	n, err := s.client.Reaction.Delete().
+		Where(
+			entreaction.EntityType(entityType),
+			entreaction.EntityID(entityID),
+			entreaction.Emoji(emoji),
+			r.match(),
+		).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	if n > 0 {
+		return false, nil
+	}
+
+	var fingerprint string
+	if len(r.Fingerprints) > 0 {
+		fingerprint = r.Fingerprints[0]
+	}
+	err = s.client.Reaction.Create().
+		SetID(uuid.New().String()).
+		SetEntityType(entityType).
+		SetEntityID(entityID).
+		SetEmoji(emoji).
+		SetUserIdentityID(r.IdentityID).
+		SetFingerprint(fingerprint).
+		SetIP(ip).
+		SetCreatedAt(time.Now().UTC()).
+		Exec(ctx)
+	return err == nil, err
+}
+
+// Counts returns the number of reactions per emoji for each of ids. Every
+// id is present in the result, with an empty map when nobody reacted.
+func (s *Store) Counts(ctx context.Context, entityType string, ids []string) (map[string]map[string]int, error) {
+	counts := make(map[string]map[string]int, len(ids))
+	for _, id := range ids {
+		counts[id] = map[string]int{}
+	}
+	if len(ids) == 0 {
+		return counts, nil
+	}
+
+	var rows []struct {
+		EntityID string `json:"entity_id"`
+		Emoji    string `json:"emoji"`
+		Count    int    `json:"count"`
+	}
+	err := s.client.Reaction.Query().
+		Where(entreaction.EntityType(entityType), entreaction.EntityIDIn(ids...)).
+		GroupBy(entreaction.FieldEntityID, entreaction.FieldEmoji).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if counts[row.EntityID] != nil {
+			counts[row.EntityID][row.Emoji] = row.Count
+		}
+	}
+	return counts, nil
+}
+
+// Mine returns the emoji r reacted with for each of ids that has any.
+func (s *Store) Mine(ctx context.Context, entityType string, ids []string, r Reactor) (map[string][]string, error) {
+	mine := map[string][]string{}
+	if len(ids) == 0 || r.empty() {
+		return mine, nil
+	}
+
+	reactions, err := s.client.Reaction.Query().
+		Where(entreaction.EntityType(entityType), entreaction.EntityIDIn(ids...), r.match()).
+		Order(ent.Asc(entreaction.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, re := range reactions {
+		mine[re.EntityID] = append(mine[re.EntityID], re.Emoji)
+	}
+	return mine, nil
+}
@@ -0,0 +1,42 @@
+// Package replay remembers the signatures of signed requests until their
+// timestamps leave the tolerance window, so a captured request can't be sent
+// again. Signatures are stored in the database and survive restarts.
+package replay
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/signaturenonce"
+)
+
+// Store keeps seen signatures in the ent signature_nonces table.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Remember records signature until expiresAt and reports false when it was
+// already recorded, which makes the request a replay.
+func (s *Store) Remember(ctx context.Context, signature string, expiresAt time.Time) (bool, error) {
+	err := s.client.SignatureNonce.Create().
+		SetID(signature).
+		SetExpiresAt(expiresAt.UTC()).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Purge deletes signatures that expired before cutoff; their requests are
+// rejected for their timestamps anyway.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int, error) {
+	return s.client.SignatureNonce.Delete().
+		Where(signaturenonce.ExpiresAtLT(cutoff.UTC())).
+		Exec(ctx)
+}
@@ -0,0 +1,240 @@
+// Package report stores visitor reports about public content (abuse,
+// copyright claims, privacy requests) as moderation tickets and notifies
+// the site owner about them.
+package report
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/mail"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// EventReceived is the outbox event written for every new ticket.
+const EventReceived = "report.received"
+
+// Ticket statuses. New tickets are open until an admin resolves them,
+// either acting on the content or dismissing the report.
+const (
+	StatusOpen      = "open"
+	StatusResolved  = "resolved"
+	StatusDismissed = "dismissed"
+)
+
+// ErrNotFound is returned by Resolve for an unknown ticket.
+var ErrNotFound = errors.New("report not found")
+
+// Ticket is one report about a piece of public content. ContentType is one
+// of post, project, idea or image; Category is picked from the fixed list in
+// the api definition.
+type Ticket struct {
+	ID            string
+	ContentType   string
+	ContentID     string
+	ContentTitle  string
+	Category      string
+	Details       string
+	ReporterName  string
+	ReporterEmail string
+	IP            string
+	Fingerprint   string
+	Status        string
+	Resolution    string
+	CreatedAt     time.Time
+	ResolvedAt    *time.Time
+}
+
+// Event is the payload of report.received. It carries the reporter's email
+// so the owner can follow up, e.g. on a copyright notice.
+type Event struct {
+	ID            string `json:"id"`
+	ContentType   string `json:"content_type"`
+	ContentID     string `json:"content_id"`
+	ContentTitle  string `json:"content_title"`
+	Category      string `json:"category"`
+	Details       string `json:"details"`
+	ReporterName  string `json:"reporter_name,omitempty"`
+	ReporterEmail string `json:"reporter_email,omitempty"`
+	CreatedAt     string `json:"created_at"`
+}
+
+// NewEvent builds the event payload for a stored ticket.
+func NewEvent(t *Ticket) Event {
+	return Event{
+		ID:            t.ID,
+		ContentType:   t.ContentType,
+		ContentID:     t.ContentID,
+		ContentTitle:  t.ContentTitle,
+		Category:      t.Category,
+		Details:       t.Details,
+		ReporterName:  t.ReporterName,
+		ReporterEmail: t.ReporterEmail,
+		CreatedAt:     utils.FormatTime(t.CreatedAt),
+	}
+}
+
+// Store persists tickets in the raw content_reports table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Create stores t as an open ticket and writes its report.received event in
+// the same transaction, filling in the ID, status and creation time.
+func (s *Store) Create(ctx context.Context, t *Ticket) error {
+	t.ID = uuid.New().String()
+	t.Status = StatusOpen
+	t.CreatedAt = time.Now().UTC()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, s.rebind(
+		`INSERT INTO content_reports (id, content_type, content_id, content_title, category, details, reporter_name, reporter_email, ip, fingerprint, status, resolution, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, '', ?)`),
+		t.ID, t.ContentType, t.ContentID, t.ContentTitle, t.Category, t.Details, t.ReporterName, t.ReporterEmail, t.IP, t.Fingerprint, t.Status, t.CreatedAt,
+	)
+	if err != nil {
+		return err
+	}
+	if err := outbox.Write(ctx, tx, s.driver, EventReceived, NewEvent(t)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Filter narrows List. Empty fields match everything.
+type Filter struct {
+	Status      string
+	ContentType string
+	ContentID   string
+}
+
+// List returns the tickets matching f, newest first.
+func (s *Store) List(ctx context.Context, f Filter) ([]*Ticket, error) {
+	query := `SELECT id, content_type, content_id, content_title, category, details, reporter_name, reporter_email, ip, fingerprint, status, resolution, created_at, resolved_at
+		FROM content_reports WHERE 1 = 1`
+	var args []any
+	if f.Status != "" {
+		query += ` AND status = ?`
+		args = append(args, f.Status)
+	}
+	if f.ContentType != "" {
+		query += ` AND content_type = ?`
+		args = append(args, f.ContentType)
+	}
+	if f.ContentID != "" {
+		query += ` AND content_id = ?`
+		args = append(args, f.ContentID)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(query+` ORDER BY created_at DESC`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tickets []*Ticket
+	for rows.Next() {
+		var (
+			t          Ticket
+			resolvedAt sql.NullTime
+		)
+		if err := rows.Scan(&t.ID, &t.ContentType, &t.ContentID, &t.ContentTitle, &t.Category, &t.Details,
+			&t.ReporterName, &t.ReporterEmail, &t.IP, &t.Fingerprint, &t.Status, &t.Resolution, &t.CreatedAt, &resolvedAt); err != nil {
+			return nil, err
+		}
+		if resolvedAt.Valid {
+			t.ResolvedAt = &resolvedAt.Time
+		}
+		tickets = append(tickets, &t)
+	}
+	return tickets, rows.Err()
+}
+
+// Resolve sets the status of ticket id with a note on what was done.
+// Reopening a ticket clears its resolution time.
+func (s *Store) Resolve(ctx context.Context, id, status, resolution string) error {
+	var resolvedAt any
+	if status != StatusOpen {
+		resolvedAt = time.Now().UTC()
+	}
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`UPDATE content_reports SET status = ?, resolution = ?, resolved_at = ? WHERE id = ?`),
+		status, strings.TrimSpace(resolution), resolvedAt, id,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Notifier emails new tickets to the site owner.
+type Notifier struct {
+	mailer *mail.Sender
+	to     []string
+}
+
+func NewNotifier(mailer *mail.Sender, to []string) *Notifier {
+	return &Notifier{mailer: mailer, to: to}
+}
+
+// Handle is an outbox handler for report.received. Send failures are only
+// logged so one bad address doesn't replay the event to every handler.
+func (n *Notifier) Handle(ctx context.Context, ev outbox.Event) error {
+	if ev.Type != EventReceived || !n.mailer.Enabled() || len(n.to) == 0 {
+		return nil
+	}
+	var payload Event
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return nil
+	}
+
+	from := "An anonymous visitor"
+	if payload.ReporterEmail != "" {
+		from = fmt.Sprintf("%s <%s>", payload.ReporterName, payload.ReporterEmail)
+	}
+	body := fmt.Sprintf("%s reported the %s %q (%s) as %s.\n\n%s\n",
+		from, payload.ContentType, payload.ContentTitle, payload.ContentID, payload.Category, payload.Details)
+	for _, to := range n.to {
+		err := n.mailer.Send(mail.Message{
+			To:      to,
+			Subject: fmt.Sprintf("New %s report about %s", payload.Category, payload.ContentTitle),
+			Body:    body,
+		})
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to send notification for report %s: %v", payload.ID, err)
+		}
+	}
+	return nil
+}
+
+// Normalize trims the free-text fields of t.
+func Normalize(t *Ticket) {
+	t.Details = strings.TrimSpace(t.Details)
+	t.ReporterName = strings.TrimSpace(t.ReporterName)
+	t.ReporterEmail = strings.TrimSpace(t.ReporterEmail)
+}
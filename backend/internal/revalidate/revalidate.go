@@ -0,0 +1,180 @@
+// Package revalidate tells the frontend host which statically rendered
+// pages to regenerate when content changes, through an on-demand
+// revalidation endpoint such as a Next.js route, or a build hook.
+package revalidate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/outbox"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// DefaultPaths are the page paths of each content type, used for the types
+// without configured paths. {id} and {slug} stand for the changed content.
+var DefaultPaths = map[string][]string{
+	"blog":    {"/", "/blog", "/blog/{id}"},
+	"project": {"/projects", "/projects/{id}"},
+	"idea":    {"/ideas", "/ideas/{id}"},
+}
+
+// Request is the body POSTed to the frontend.
+type Request struct {
+	Event string   `json:"event"`
+	Type  string   `json:"type"`
+	ID    string   `json:"id,omitempty"`
+	Paths []string `json:"paths"`
+}
+
+// Notifier calls the frontend for content events.
+type Notifier struct {
+	client *ent.Client
+	url    string
+	secret string
+	paths  map[string][]string
+	http   *http.Client
+}
+
+// NewNotifier returns a notifier posting to url, which does nothing while
+// url is empty. paths overrides DefaultPaths per content type; secret is
+// sent as a bearer token.
+func NewNotifier(client *ent.Client, url, secret string, paths map[string][]string, timeout time.Duration) *Notifier {
+	merged := make(map[string][]string, len(DefaultPaths)+len(paths))
+	for kind, p := range DefaultPaths {
+		merged[kind] = p
+	}
+	for kind, p := range paths {
+		merged[kind] = p
+	}
+	return &Notifier{
+		client: client,
+		url:    url,
+		secret: secret,
+		paths:  merged,
+		http:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Handle is an outbox handler for content events. A failed call is
+// returned so the event is retried.
+func (n *Notifier) Handle(ctx context.Context, ev outbox.Event) error {
+	if n.url == "" || !outbox.IsContentEvent(ev.Type) {
+		return nil
+	}
+	var payload outbox.ContentEvent
+	if err := json.Unmarshal(ev.Payload, &payload); err != nil {
+		return nil
+	}
+
+	paths := n.affected(ctx, payload)
+	body, err := json.Marshal(Request{Event: ev.Type, Type: payload.Type, ID: payload.ID, Paths: paths})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if n.secret != "" {
+		req.Header.Set("Authorization", "Bearer "+n.secret)
+	}
+
+	resp, err := n.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("revalidate: %w", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("revalidate: unexpected status %d", resp.StatusCode)
+	}
+	logx.WithContext(ctx).Infof("Asked the frontend to revalidate %d path(s) after %s", len(paths), ev.Type)
+	return nil
+}
+
+// affected returns the page paths affected by a content change, sorted. Bulk
+// changes (type "all") affect the paths of every type that don't name a
+// single item. Paths with {slug} are left out when the content is gone.
+func (n *Notifier) affected(ctx context.Context, ev outbox.ContentEvent) []string {
+	seen := map[string]bool{}
+	if ev.Type == "all" || ev.ID == "" {
+		for kind, templates := range n.paths {
+			if ev.Type != "all" && kind != ev.Type {
+				continue
+			}
+			for _, t := range templates {
+				if !strings.Contains(t, "{") {
+					seen[t] = true
+				}
+			}
+		}
+		return sorted(seen)
+	}
+
+	templates := n.paths[ev.Type]
+	var slug string
+	for _, t := range templates {
+		if strings.Contains(t, "{slug}") {
+			var err error
+			if slug, err = n.slug(ctx, ev.Type, ev.ID); err != nil {
+				logx.WithContext(ctx).Infof("No slug to revalidate for %s %s: %v", ev.Type, ev.ID, err)
+			}
+			break
+		}
+	}
+	for _, t := range templates {
+		if strings.Contains(t, "{slug}") && slug == "" {
+			continue
+		}
+		seen[strings.NewReplacer("{id}", ev.ID, "{slug}", slug).Replace(t)] = true
+	}
+	return sorted(seen)
+}
+
+// slug looks up the slug of the content of kind with id.
+func (n *Notifier) slug(ctx context.Context, kind, id string) (string, error) {
+	uid, err := uuid.Parse(id)
+	if err != nil {
+		return "", err
+	}
+	switch kind {
+	case "blog":
+		p, err := n.client.BlogPost.Get(ctx, uid)
+		if err != nil {
+			return "", err
+		}
+		return p.Slug, nil
+	case "project":
+		p, err := n.client.Project.Get(ctx, uid)
+		if err != nil {
+			return "", err
+		}
+		return p.Slug, nil
+	case "idea":
+		i, err := n.client.Idea.Get(ctx, uid)
+		if err != nil {
+			return "", err
+		}
+		return i.Slug, nil
+	}
+	return "", fmt.Errorf("%s has no slug", kind)
+}
+
+func sorted(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for p := range set {
+		out = append(out, p)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,220 @@
+package revision
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Diff operations.
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+	// OpChange marks a line replaced by another; its Words show the
+	// word-level edits.
+	OpChange = "change"
+)
+
+// maxCells bounds the size of the comparison table. Beyond it the differing
+// middle of the documents is reported as deleted and re-inserted instead.
+const maxCells = 4_000_000
+
+// Line is one line of a line-level diff. OldLine and NewLine are 1-based
+// line numbers in each document, 0 where the line does not exist.
+type Line struct {
+	Op      string
+	OldLine int
+	NewLine int
+	Text    string
+	Words   []Segment
+}
+
+// Segment is a run of text within a changed line.
+type Segment struct {
+	Op   string
+	Text string
+}
+
+// Diff compares two Markdown documents line by line. Runs of deleted lines
+// directly followed by the same number of inserted lines are paired up as
+// changes with a word-level diff.
+func Diff(a, b string) []Line {
+	oldLines := splitLines(a)
+	newLines := splitLines(b)
+
+	var out []Line
+	oldNo, newNo := 0, 0
+	var dels, ins []string
+	flush := func() {
+		if len(dels) == len(ins) {
+			for i := range dels {
+				oldNo++
+				newNo++
+				out = append(out, Line{Op: OpChange, OldLine: oldNo, NewLine: newNo, Text: ins[i], Words: diffWords(dels[i], ins[i])})
+			}
+		} else {
+			for _, t := range dels {
+				oldNo++
+				out = append(out, Line{Op: OpDelete, OldLine: oldNo, Text: t})
+			}
+			for _, t := range ins {
+				newNo++
+				out = append(out, Line{Op: OpInsert, NewLine: newNo, Text: t})
+			}
+		}
+		dels, ins = nil, nil
+	}
+
+	for _, e := range edits(oldLines, newLines) {
+		switch e.op {
+		case OpEqual:
+			flush()
+			oldNo++
+			newNo++
+			out = append(out, Line{Op: OpEqual, OldLine: oldNo, NewLine: newNo, Text: e.text})
+		case OpDelete:
+			dels = append(dels, e.text)
+		case OpInsert:
+			ins = append(ins, e.text)
+		}
+	}
+	flush()
+	return out
+}
+
+// Stats counts the lines added and removed by a diff; a changed line
+// counts as both.
+func Stats(lines []Line) (added, removed int) {
+	for _, l := range lines {
+		switch l.Op {
+		case OpInsert:
+			added++
+		case OpDelete:
+			removed++
+		case OpChange:
+			added++
+			removed++
+		}
+	}
+	return added, removed
+}
+
+func diffWords(a, b string) []Segment {
+	var out []Segment
+	for _, e := range edits(splitWords(a), splitWords(b)) {
+		if n := len(out); n > 0 && out[n-1].Op == e.op {
+			out[n-1].Text += e.text
+			continue
+		}
+		out = append(out, Segment{Op: e.op, Text: e.text})
+	}
+	return out
+}
+
+type edit struct {
+	op   string
+	text string
+}
+
+// edits computes a shortest edit script between a and b using the longest
+// common subsequence of the part between their common prefix and suffix.
+func edits(a, b []string) []edit {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var out []edit
+	for _, t := range a[:prefix] {
+		out = append(out, edit{OpEqual, t})
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if (len(ma)+1)*(len(mb)+1) > maxCells {
+		for _, t := range ma {
+			out = append(out, edit{OpDelete, t})
+		}
+		for _, t := range mb {
+			out = append(out, edit{OpInsert, t})
+		}
+	} else {
+		out = append(out, lcsEdits(ma, mb)...)
+	}
+
+	for _, t := range a[len(a)-suffix:] {
+		out = append(out, edit{OpEqual, t})
+	}
+	return out
+}
+
+func lcsEdits(a, b []string) []edit {
+	// lcs[i][j] is the LCS length of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var out []edit
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, edit{OpEqual, a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, edit{OpDelete, a[i]})
+			i++
+		default:
+			out = append(out, edit{OpInsert, b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, edit{OpDelete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		out = append(out, edit{OpInsert, b[j]})
+	}
+	return out
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+}
+
+// splitWords splits s into words and the whitespace between them, so the
+// segments can be joined back into the original line.
+func splitWords(s string) []string {
+	var out []string
+	start, space := 0, false
+	for i, r := range s {
+		if isSpace := unicode.IsSpace(r); i == 0 || isSpace != space {
+			if i > start {
+				out = append(out, s[start:i])
+				start = i
+			}
+			space = isSpace
+		}
+	}
+	if start < len(s) {
+		out = append(out, s[start:])
+	}
+	return out
+}
@@ -0,0 +1,122 @@
+// Package revision keeps snapshots of blog post content so changes made by
+// edits or content syncs can be reviewed later. A revision is recorded in the
+// same transaction as the post write it belongs to.
+package revision
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/utils"
+)
+
+// ErrNotFound is returned for unknown revisions.
+var ErrNotFound = errors.New("revision not found")
+
+// Revision is a stored snapshot of a post. Number counts up from 1 per post.
+type Revision struct {
+	PostID    string
+	Number    int
+	Title     string
+	Content   string
+	Hash      string
+	CreatedAt time.Time
+}
+
+// Store persists revisions of the ent blog posts in the raw post_revisions
+// table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Hash identifies a title and content pair.
+func Hash(title, content string) string {
+	sum := sha256.Sum256([]byte(title + "\x00" + content))
+	return hex.EncodeToString(sum[:])
+}
+
+// Record stores a new revision of the post through q, the transaction that
+// writes the post, unless its title and content match the latest one. It
+// reports whether a revision was added.
+func (s *Store) Record(ctx context.Context, q outbox.Queryer, postID, title, content string) (bool, error) {
+	hash := Hash(title, content)
+
+	var (
+		latestHash string
+		latest     int
+	)
+	rows, err := q.QueryContext(ctx, s.rebind(
+		`SELECT hash, number FROM post_revisions WHERE post_id = ? ORDER BY number DESC LIMIT 1`), postID,
+	)
+	if err != nil {
+		return false, err
+	}
+	if rows.Next() {
+		err = rows.Scan(&latestHash, &latest)
+	}
+	rows.Close()
+	if err == nil {
+		err = rows.Err()
+	}
+	if err != nil {
+		return false, err
+	}
+	if latestHash == hash {
+		return false, nil
+	}
+
+	_, err = q.ExecContext(ctx, s.rebind(
+		`INSERT INTO post_revisions (post_id, number, title, content, hash, created_at) VALUES (?, ?, ?, ?, ?, ?)`),
+		postID, latest+1, title, content, hash, time.Now().UTC(),
+	)
+	return err == nil, err
+}
+
+// List returns the revisions of a post, newest first, without content.
+func (s *Store) List(ctx context.Context, postID string) ([]*Revision, error) {
+	rows, err := s.db.QueryContext(ctx, s.rebind(
+		`SELECT post_id, number, title, hash, created_at FROM post_revisions
+		WHERE post_id = ? ORDER BY number DESC`), postID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Revision
+	for rows.Next() {
+		r := &Revision{}
+		if err := rows.Scan(&r.PostID, &r.Number, &r.Title, &r.Hash, &r.CreatedAt); err != nil {
+			return nil, err
+		}
+		list = append(list, r)
+	}
+	return list, rows.Err()
+}
+
+// Get loads a single revision with its content.
+func (s *Store) Get(ctx context.Context, postID string, number int) (*Revision, error) {
+	r := &Revision{}
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT post_id, number, title, content, hash, created_at FROM post_revisions
+		WHERE post_id = ? AND number = ?`), postID, number,
+	).Scan(&r.PostID, &r.Number, &r.Title, &r.Content, &r.Hash, &r.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return r, err
+}
@@ -0,0 +1,87 @@
+package revision
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	db, err := sql.Open("sqlite3", t.TempDir()+"/test.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	_, err = db.Exec(`CREATE TABLE post_revisions (
+		post_id TEXT NOT NULL,
+		number INTEGER NOT NULL,
+		title TEXT NOT NULL,
+		content TEXT NOT NULL,
+		hash TEXT NOT NULL,
+		created_at DATETIME NOT NULL,
+		PRIMARY KEY (post_id, number)
+	)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewStore(db, "sqlite3")
+}
+
+func TestRecordKeepsEveryWrite(t *testing.T) {
+	s := newTestStore(t)
+	ctx := context.Background()
+	record := func(title, content string, commit bool) bool {
+		t.Helper()
+		tx, err := s.db.BeginTx(ctx, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		added, err := s.Record(ctx, tx, "p1", title, content)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if commit {
+			err = tx.Commit()
+		} else {
+			err = tx.Rollback()
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		return added
+	}
+
+	if !record("Post", "first", true) || !record("Post", "second", true) {
+		t.Fatal("edits not recorded")
+	}
+	if record("Post", "second", true) {
+		t.Fatal("unchanged write recorded")
+	}
+	// A rolled back write leaves no revision
+	record("Post", "discarded", false)
+	if !record("Post", "first", true) {
+		t.Fatal("revert not recorded")
+	}
+
+	list, err := s.List(ctx, "p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 || list[0].Number != 3 || list[2].Number != 1 {
+		t.Fatalf("got %+v", list)
+	}
+	r, err := s.Get(ctx, "p1", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Content != "second" || r.Hash != Hash("Post", "second") {
+		t.Fatalf("got %+v", r)
+	}
+	if _, err := s.Get(ctx, "p1", 4); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("got %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,147 @@
+// Package scheduler runs periodic background jobs. The time of each job's
+// last successful run is stored in the scheduler_runs table, so a job that
+// was due while the process was down runs as soon as it starts again.
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// Job is a named unit of periodic work. Run receives the time of the last
+// successful run (zero on the very first run) and must be idempotent: a run
+// that fails, or dies before it is recorded, is simply repeated.
+type Job struct {
+	Name  string
+	Every time.Duration
+	Run   func(ctx context.Context, lastRun time.Time) error
+}
+
+// Scheduler checks the registered jobs on a fixed tick and runs those that
+// are due.
+type Scheduler struct {
+	db     *sql.DB
+	driver string
+	tick   time.Duration
+
+	mu   sync.Mutex
+	jobs []Job
+	stop chan struct{}
+	done chan struct{}
+}
+
+func New(db *sql.DB, driver string) *Scheduler {
+	return &Scheduler{db: db, driver: driver, tick: 30 * time.Second}
+}
+
+// Register adds a job. Jobs registered after Start are picked up on the
+// next tick.
+func (s *Scheduler) Register(j Job) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs = append(s.jobs, j)
+}
+
+// Start launches the loop, running overdue jobs straight away. It is a
+// no-op if already running.
+func (s *Scheduler) Start() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stop != nil {
+		return
+	}
+	s.stop = make(chan struct{})
+	s.done = make(chan struct{})
+	go s.loop(s.stop, s.done)
+}
+
+// Stop ends the loop and waits for running jobs to finish.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	stop, done := s.stop, s.done
+	s.stop, s.done = nil, nil
+	s.mu.Unlock()
+	if stop == nil {
+		return
+	}
+	close(stop)
+	<-done
+}
+
+func (s *Scheduler) loop(stop, done chan struct{}) {
+	defer close(done)
+	s.RunDue(context.Background())
+
+	ticker := time.NewTicker(s.tick)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			s.RunDue(context.Background())
+		}
+	}
+}
+
+// RunDue runs every job whose interval has passed since its last
+// successful run. Failures are logged and retried on the next tick.
+func (s *Scheduler) RunDue(ctx context.Context) {
+	s.mu.Lock()
+	jobs := append([]Job(nil), s.jobs...)
+	s.mu.Unlock()
+
+	for _, j := range jobs {
+		lastRun, err := s.lastRun(ctx, j.Name)
+		if err != nil {
+			logx.Errorf("scheduler: failed to read last run of %s: %v", j.Name, err)
+			continue
+		}
+		now := time.Now().UTC()
+		if !lastRun.IsZero() && now.Sub(lastRun) < j.Every {
+			continue
+		}
+
+		if err := j.Run(ctx, lastRun); err != nil {
+			logx.Errorf("scheduler: job %s failed: %v", j.Name, err)
+			continue
+		}
+		if err := s.recordRun(ctx, j.Name, now); err != nil {
+			logx.Errorf("scheduler: failed to record run of %s: %v", j.Name, err)
+		}
+	}
+}
+
+func (s *Scheduler) lastRun(ctx context.Context, name string) (time.Time, error) {
+	var t time.Time
+	err := s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT last_run_at FROM scheduler_runs WHERE job = ?`), name,
+	).Scan(&t)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+	return t, err
+}
+
+func (s *Scheduler) recordRun(ctx context.Context, name string, at time.Time) error {
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE scheduler_runs SET last_run_at = ? WHERE job = ?`), at, name,
+	)
+	if err != nil {
+		return err
+	}
+	if n, err := res.RowsAffected(); err != nil || n > 0 {
+		return err
+	}
+	_, err = s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO scheduler_runs (job, last_run_at) VALUES (?, ?)`), name, at,
+	)
+	return err
+}
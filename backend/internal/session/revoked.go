@@ -0,0 +1,40 @@
+package session
+
+import (
+	"context"
+	"time"
+)
+
+// RevokeToken blacklists the access token tokenID until it expires, so it
+// stops working right away even if its session lives on.
+func (s *Store) RevokeToken(ctx context.Context, tokenID string, expiresAt time.Time) error {
+	var query string
+	if s.driver == "mysql" {
+		query = `INSERT IGNORE INTO revoked_tokens (jti, expires_at) VALUES (?, ?)`
+	} else {
+		query = `INSERT INTO revoked_tokens (jti, expires_at) VALUES (?, ?) ON CONFLICT (jti) DO NOTHING`
+	}
+	_, err := s.db.ExecContext(ctx, s.rebind(query), tokenID, expiresAt.UTC())
+	return err
+}
+
+// TokenRevoked reports whether the access token tokenID was blacklisted.
+func (s *Store) TokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	var n int
+	err := s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT COUNT(*) FROM revoked_tokens WHERE jti = ?`), tokenID,
+	).Scan(&n)
+	return n > 0, err
+}
+
+// PurgeRevokedTokens drops blacklist entries of tokens that expired before
+// cutoff; they are rejected for their expiry anyway.
+func (s *Store) PurgeRevokedTokens(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(
+		`DELETE FROM revoked_tokens WHERE expires_at < ?`), cutoff.UTC(),
+	)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
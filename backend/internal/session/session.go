@@ -0,0 +1,114 @@
+// Package session issues the backend's own short-lived tokens for visitors
+// who signed in with an identity provider, so later requests carry a token
+// signed by this server instead of the provider's ID token. Each access token
+// belongs to a stored Session whose refresh token renews it, so a visitor
+// stays signed in past the access token lifetime until the session is
+// revoked.
+package session
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	issuer   = "silan-backend"
+	audience = "session"
+)
+
+// Sign-in methods of sessions that proved the visitor owns their email
+// address. Sessions started by an OAuth provider carry its name instead.
+const (
+	MethodEmailCode = "email_code"
+	MethodGoogle    = "google"
+)
+
+var (
+	// ErrDisabled is returned when no session secret is configured.
+	ErrDisabled = errors.New("sessions are not configured")
+	// ErrInvalid is returned for tokens that are malformed, forged or expired.
+	ErrInvalid = errors.New("invalid or expired session token")
+	// ErrNotFound is returned for sessions that don't exist, have ended or
+	// belong to someone else.
+	ErrNotFound = errors.New("session not found")
+)
+
+// Claims identifies the visitor of a verified access token.
+type Claims struct {
+	IdentityID string
+	// SessionID is empty for tokens issued before sessions were stored
+	SessionID string
+	// TokenID is the jti of the token, used to revoke it on logout
+	TokenID   string
+	ExpiresAt time.Time
+}
+
+// tokenClaims adds the session to the registered claims. Tokens issued
+// before access tokens had their own ID carry the session in jti instead.
+type tokenClaims struct {
+	jwt.RegisteredClaims
+	SessionID string `json:"sid,omitempty"`
+}
+
+// Issue returns an HS256 JWT for the user identity within session
+// sessionID, valid for ttl.
+func Issue(secret, identityID, sessionID string, ttl time.Duration) (string, time.Time, error) {
+	if secret == "" {
+		return "", time.Time{}, ErrDisabled
+	}
+	now := time.Now().UTC()
+	expiresAt := now.Add(ttl)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   identityID,
+			ID:        uuid.New().String(),
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+		SessionID: sessionID,
+	})
+	signed, err := token.SignedString([]byte(secret))
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return signed, expiresAt, nil
+}
+
+// Parse verifies token and returns the identity and session it was issued
+// for. Whether the session is still active and the token not revoked is
+// checked by the caller.
+func Parse(secret, token string) (*Claims, error) {
+	if secret == "" {
+		return nil, ErrDisabled
+	}
+	claims := &tokenClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		if t.Method != jwt.SigningMethodHS256 {
+			return nil, ErrInvalid
+		}
+		return []byte(secret), nil
+	})
+	if err != nil || !parsed.Valid {
+		return nil, ErrInvalid
+	}
+	if claims.Issuer != issuer || !claims.VerifyAudience(audience, true) || claims.Subject == "" {
+		return nil, ErrInvalid
+	}
+	c := &Claims{
+		IdentityID: claims.Subject,
+		SessionID:  claims.SessionID,
+		TokenID:    claims.ID,
+	}
+	if c.SessionID == "" {
+		c.SessionID = claims.ID
+	}
+	if claims.ExpiresAt != nil {
+		c.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return c, nil
+}
@@ -0,0 +1,176 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"silan-backend/internal/ent"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/golang-jwt/jwt/v4"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+const testSecret = "test-secret"
+
+func signClaims(t *testing.T, secret string, method jwt.SigningMethod, c tokenClaims) string {
+	t.Helper()
+	signed, err := jwt.NewWithClaims(method, c).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func validTokenClaims() tokenClaims {
+	now := time.Now()
+	return tokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    issuer,
+			Subject:   "u_1",
+			ID:        "jti-1",
+			Audience:  jwt.ClaimStrings{audience},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(time.Hour)),
+		},
+		SessionID: "s_1",
+	}
+}
+
+func TestParse(t *testing.T) {
+	issued, _, err := Issue(testSecret, "u_1", "s_1", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		token  func() string
+		wantOK bool
+	}{
+		{"issued", func() string { return issued }, true},
+		{"bad signature", func() string {
+			return signClaims(t, "another-secret", jwt.SigningMethodHS256, validTokenClaims())
+		}, false},
+		{"wrong issuer", func() string {
+			c := validTokenClaims()
+			c.Issuer = "someone-else"
+			return signClaims(t, testSecret, jwt.SigningMethodHS256, c)
+		}, false},
+		{"wrong audience", func() string {
+			c := validTokenClaims()
+			c.Audience = jwt.ClaimStrings{"admin"}
+			return signClaims(t, testSecret, jwt.SigningMethodHS256, c)
+		}, false},
+		{"expired", func() string {
+			c := validTokenClaims()
+			c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+			return signClaims(t, testSecret, jwt.SigningMethodHS256, c)
+		}, false},
+		{"other algorithm", func() string {
+			return signClaims(t, testSecret, jwt.SigningMethodHS512, validTokenClaims())
+		}, false},
+		{"no subject", func() string {
+			c := validTokenClaims()
+			c.Subject = ""
+			return signClaims(t, testSecret, jwt.SigningMethodHS256, c)
+		}, false},
+		{"malformed", func() string { return "not.a.token" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := Parse(testSecret, tt.token())
+			if tt.wantOK {
+				if err != nil || claims.IdentityID != "u_1" || claims.SessionID != "s_1" || claims.TokenID == "" {
+					t.Fatalf("got %+v, %v", claims, err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrInvalid) {
+				t.Fatalf("got %v, want ErrInvalid", err)
+			}
+		})
+	}
+
+	if _, err := Parse("", issued); !errors.Is(err, ErrDisabled) {
+		t.Fatalf("no secret: got %v, want ErrDisabled", err)
+	}
+}
+
+// newTestStore returns a store on a fresh sqlite database.
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+	db, err := sql.Open("sqlite3", t.TempDir()+"/test.db?_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	client := ent.NewClient(ent.Driver(entsql.OpenDB("sqlite3", db)))
+	if err := client.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(`CREATE TABLE revoked_tokens (jti TEXT PRIMARY KEY, expires_at DATETIME NOT NULL)`); err != nil {
+		t.Fatal(err)
+	}
+	return NewStore(db, "sqlite3", client)
+}
+
+func TestRevokedToken(t *testing.T) {
+	s := newTestStore(t)
+	ctx := context.Background()
+
+	token, expiresAt, err := Issue(testSecret, "u_1", "s_1", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims, err := Parse(testSecret, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked, err := s.TokenRevoked(ctx, claims.TokenID); err != nil || revoked {
+		t.Fatalf("fresh token: revoked %v, %v", revoked, err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := s.RevokeToken(ctx, claims.TokenID, expiresAt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if revoked, err := s.TokenRevoked(ctx, claims.TokenID); err != nil || !revoked {
+		t.Fatalf("revoked token: revoked %v, %v", revoked, err)
+	}
+
+	if n, err := s.PurgeRevokedTokens(ctx, expiresAt.Add(time.Second)); err != nil || n != 1 {
+		t.Fatalf("purged %d, %v", n, err)
+	}
+}
+
+func TestRefreshRotation(t *testing.T) {
+	s := newTestStore(t)
+	ctx := context.Background()
+
+	sess, first, err := s.Create(ctx, "u_1", MethodGoogle, "ua", "1.2.3.4", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	refreshed, second, err := s.Refresh(ctx, first, time.Hour)
+	if err != nil || refreshed.ID != sess.ID || second == first {
+		t.Fatalf("refresh: %v, %v", refreshed, err)
+	}
+	if _, _, err := s.Refresh(ctx, first, time.Hour); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("reused refresh token: got %v, want ErrInvalid", err)
+	}
+	_, third, err := s.Refresh(ctx, second, time.Hour)
+	if err != nil {
+		t.Fatalf("rotated refresh token: %v", err)
+	}
+
+	if _, err := s.Revoke(ctx, sess.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.ByRefreshToken(ctx, third); !errors.Is(err, ErrInvalid) {
+		t.Fatalf("revoked session: got %v, want ErrInvalid", err)
+	}
+}
@@ -0,0 +1,195 @@
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/predicate"
+	entsession "silan-backend/internal/ent/session"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// Store keeps sessions in the ent sessions table, and the blacklist of
+// revoked access tokens in the raw revoked_tokens table.
+type Store struct {
+	db     *sql.DB
+	driver string
+	client *ent.Client
+}
+
+func NewStore(db *sql.DB, driver string, client *ent.Client) *Store {
+	return &Store{db: db, driver: driver, client: client}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Create starts a session for identityID, signed in with method, and
+// returns it with its refresh token, valid for ttl.
+func (s *Store) Create(ctx context.Context, identityID, method, userAgent, ip string, ttl time.Duration) (*ent.Session, string, error) {
+	token, err := newRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now().UTC()
+	sess, err := s.client.Session.Create().
+		SetID(uuid.New().String()).
+		SetIdentityID(identityID).
+		SetRefreshHash(hashToken(token)).
+		SetMethod(method).
+		SetUserAgent(userAgent).
+		SetIP(ip).
+		SetCreatedAt(now).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return sess, token, nil
+}
+
+// Refresh rotates the refresh token of an active session and extends the
+// session by ttl. The presented token stops working, so a copied token can
+// be used at most once.
+func (s *Store) Refresh(ctx context.Context, refreshToken string, ttl time.Duration) (*ent.Session, string, error) {
+	sess, err := s.ByRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, "", err
+	}
+	token, err := newRefreshToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	now := time.Now().UTC()
+	n, err := s.client.Session.Update().
+		Where(
+			entsession.ID(sess.ID),
+			entsession.RefreshHash(hashToken(refreshToken)),
+			entsession.RevokedAtIsNil(),
+		).
+		SetRefreshHash(hashToken(token)).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(ttl)).
+		Save(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	// A concurrent refresh with the same token already rotated it
+	if n == 0 {
+		return nil, "", ErrInvalid
+	}
+	sess.LastUsedAt = now
+	sess.ExpiresAt = now.Add(ttl)
+	return sess, token, nil
+}
+
+// Get returns the active session id.
+func (s *Store) Get(ctx context.Context, id string) (*ent.Session, error) {
+	sess, err := s.client.Session.Query().Where(entsession.ID(id), active()).Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	return sess, err
+}
+
+// Active reports whether the session exists, is not revoked and has not
+// expired.
+func (s *Store) Active(ctx context.Context, id string) (bool, error) {
+	return s.client.Session.Query().
+		Where(entsession.ID(id), active()).
+		Exist(ctx)
+}
+
+// Revoke ends a single session.
+func (s *Store) Revoke(ctx context.Context, id string) (int64, error) {
+	n, err := s.client.Session.Update().
+		Where(entsession.ID(id), entsession.RevokedAtIsNil()).
+		SetRevokedAt(time.Now().UTC()).
+		Save(ctx)
+	return int64(n), err
+}
+
+// RevokeAll ends every session of a user identity, e.g. to sign out of all
+// devices.
+func (s *Store) RevokeAll(ctx context.Context, identityID string) (int64, error) {
+	n, err := s.client.Session.Update().
+		Where(entsession.IdentityID(identityID), entsession.RevokedAtIsNil()).
+		SetRevokedAt(time.Now().UTC()).
+		Save(ctx)
+	return int64(n), err
+}
+
+// Purge deletes sessions that expired or were revoked before cutoff.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	n, err := s.client.Session.Delete().
+		Where(entsession.Or(entsession.ExpiresAtLT(cutoff), entsession.RevokedAtLT(cutoff))).
+		Exec(ctx)
+	return int64(n), err
+}
+
+// List returns the active sessions of identityID, most recently used first.
+func (s *Store) List(ctx context.Context, identityID string) ([]*ent.Session, error) {
+	return s.client.Session.Query().
+		Where(entsession.IdentityID(identityID), active()).
+		Order(ent.Desc(entsession.FieldLastUsedAt)).
+		All(ctx)
+}
+
+// RevokeOwned ends session id if it belongs to identityID, so visitors can
+// only sign out their own devices.
+func (s *Store) RevokeOwned(ctx context.Context, identityID, id string) error {
+	n, err := s.client.Session.Update().
+		Where(entsession.ID(id), entsession.IdentityID(identityID), entsession.RevokedAtIsNil()).
+		SetRevokedAt(time.Now().UTC()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ByRefreshToken returns the active session holding refreshToken.
+func (s *Store) ByRefreshToken(ctx context.Context, refreshToken string) (*ent.Session, error) {
+	if refreshToken == "" {
+		return nil, ErrInvalid
+	}
+	sess, err := s.client.Session.Query().
+		Where(entsession.RefreshHash(hashToken(refreshToken)), active()).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrInvalid
+	}
+	return sess, err
+}
+
+// active matches sessions that are neither revoked nor expired.
+func active() predicate.Session {
+	return entsession.And(entsession.RevokedAtIsNil(), entsession.ExpiresAtGT(time.Now().UTC()))
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,183 @@
+package shortlink
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"errors"
+	"math/big"
+	"regexp"
+	"time"
+
+	"silan-backend/internal/utils"
+)
+
+var (
+	// ErrNotFound is returned for unknown codes.
+	ErrNotFound = errors.New("short link not found")
+	// ErrCodeTaken is returned when a vanity code is already in use.
+	ErrCodeTaken = errors.New("short link code already exists")
+)
+
+// ClickEvent is the analytics event recorded for every redirect.
+const ClickEvent = "short_link_click"
+
+var codePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{2,64}$`)
+
+const codeAlphabet = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// ValidCode reports whether code can be used as a vanity code.
+func ValidCode(code string) bool {
+	return codePattern.MatchString(code)
+}
+
+// Link maps a short code to its target URL.
+type Link struct {
+	Code       string
+	TargetURL  string
+	Channel    string
+	EntityType string
+	EntityID   string
+	Clicks     int
+	CreatedAt  time.Time
+}
+
+// Store persists short links in the raw short_links table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func randomCode(n int) (string, error) {
+	buf := make([]byte, n)
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := range buf {
+		idx, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		buf[i] = codeAlphabet[idx.Int64()]
+	}
+	return string(buf), nil
+}
+
+// Create stores a link. An empty code gets a random one.
+func (s *Store) Create(ctx context.Context, l *Link) error {
+	if l.Code != "" {
+		if _, err := s.Get(ctx, l.Code); err == nil {
+			return ErrCodeTaken
+		} else if !errors.Is(err, ErrNotFound) {
+			return err
+		}
+	} else {
+		for attempt := 0; ; attempt++ {
+			code, err := randomCode(6 + attempt/3)
+			if err != nil {
+				return err
+			}
+			if _, err := s.Get(ctx, code); errors.Is(err, ErrNotFound) {
+				l.Code = code
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+
+	l.Clicks = 0
+	l.CreatedAt = time.Now().UTC()
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO short_links (code, target_url, channel, entity_type, entity_id, click_count, created_at)
+		VALUES (?, ?, ?, ?, ?, 0, ?)`),
+		l.Code, l.TargetURL, l.Channel, l.EntityType, l.EntityID, l.CreatedAt,
+	)
+	return err
+}
+
+// Restore stores a deleted link again with its click count. It fails with
+// ErrCodeTaken when the code was reused in the meantime.
+func (s *Store) Restore(ctx context.Context, l *Link) error {
+	if _, err := s.Get(ctx, l.Code); err == nil {
+		return ErrCodeTaken
+	} else if !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO short_links (code, target_url, channel, entity_type, entity_id, click_count, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`),
+		l.Code, l.TargetURL, l.Channel, l.EntityType, l.EntityID, l.Clicks, l.CreatedAt,
+	)
+	return err
+}
+
+const linkColumns = `code, target_url, channel, entity_type, entity_id, click_count, created_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanLink(row scanner) (*Link, error) {
+	var (
+		l                             Link
+		channel, entityType, entityID sql.NullString
+	)
+	if err := row.Scan(&l.Code, &l.TargetURL, &channel, &entityType, &entityID, &l.Clicks, &l.CreatedAt); err != nil {
+		return nil, err
+	}
+	l.Channel = channel.String
+	l.EntityType = entityType.String
+	l.EntityID = entityID.String
+	return &l, nil
+}
+
+// Get returns the link for a code.
+func (s *Store) Get(ctx context.Context, code string) (*Link, error) {
+	l, err := scanLink(s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT `+linkColumns+` FROM short_links WHERE code = ?`), code))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return l, err
+}
+
+// List returns all links, newest first.
+func (s *Store) List(ctx context.Context) ([]*Link, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT `+linkColumns+` FROM short_links ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*Link
+	for rows.Next() {
+		l, err := scanLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+	return links, rows.Err()
+}
+
+// Delete removes a link.
+func (s *Store) Delete(ctx context.Context, code string) error {
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM short_links WHERE code = ?`), code)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RecordClick increments the click counter of a link.
+func (s *Store) RecordClick(ctx context.Context, code string) error {
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE short_links SET click_count = click_count + 1 WHERE code = ?`), code)
+	return err
+}
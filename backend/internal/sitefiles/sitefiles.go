@@ -0,0 +1,93 @@
+// Package sitefiles renders the plain-text files crawlers and security
+// researchers look for at the root of the site: robots.txt and
+// /.well-known/security.txt (RFC 9116). Both are built from the site
+// settings on every request; they are tiny.
+package sitefiles
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/config"
+)
+
+// ErrNotConfigured is returned for security.txt while no contact is set,
+// since the file is invalid without one.
+var ErrNotConfigured = errors.New("security.txt is not configured")
+
+// ContentType of both files.
+const ContentType = "text/plain; charset=utf-8"
+
+// AICrawlers are the user agents of crawlers that collect training data for
+// language models, blocked by RobotsConfig.BlockAICrawlers unless the
+// configuration names its own list.
+var AICrawlers = []string{
+	"GPTBot",
+	"ClaudeBot",
+	"anthropic-ai",
+	"Google-Extended",
+	"Applebot-Extended",
+	"CCBot",
+	"PerplexityBot",
+	"Bytespider",
+	"meta-externalagent",
+	"cohere-ai",
+}
+
+// Robots renders robots.txt for the site at siteURL, pointing crawlers at
+// the sitemap served there.
+func Robots(cfg config.RobotsConfig, siteURL string) []byte {
+	var b strings.Builder
+
+	b.WriteString("User-agent: *\n")
+	if len(cfg.Disallow) == 0 {
+		b.WriteString("Disallow:\n")
+	}
+	for _, path := range cfg.Disallow {
+		fmt.Fprintf(&b, "Disallow: %s\n", path)
+	}
+
+	if cfg.BlockAICrawlers {
+		agents := cfg.AICrawlers
+		if len(agents) == 0 {
+			agents = AICrawlers
+		}
+		b.WriteString("\n")
+		for _, agent := range agents {
+			fmt.Fprintf(&b, "User-agent: %s\n", agent)
+		}
+		b.WriteString("Disallow: /\n")
+	}
+
+	fmt.Fprintf(&b, "\nSitemap: %s/sitemap.xml\n", strings.TrimRight(siteURL, "/"))
+	return []byte(b.String())
+}
+
+// SecurityTxt renders security.txt. The Expires field RFC 9116 requires is
+// moved forward on every request, so the file never goes stale.
+func SecurityTxt(cfg config.SecurityTxtConfig, siteURL string, now time.Time) ([]byte, error) {
+	if len(cfg.Contacts) == 0 {
+		return nil, ErrNotConfigured
+	}
+
+	var b strings.Builder
+	for _, contact := range cfg.Contacts {
+		fmt.Fprintf(&b, "Contact: %s\n", contact)
+	}
+	fmt.Fprintf(&b, "Expires: %s\n", now.UTC().AddDate(0, 0, cfg.ExpiresDays).Truncate(time.Second).Format(time.RFC3339))
+	for _, f := range []struct{ name, value string }{
+		{"Encryption", cfg.Encryption},
+		{"Acknowledgments", cfg.Acknowledgments},
+		{"Policy", cfg.Policy},
+		{"Hiring", cfg.Hiring},
+		{"Preferred-Languages", cfg.PreferredLanguages},
+	} {
+		if f.value != "" {
+			fmt.Fprintf(&b, "%s: %s\n", f.name, f.value)
+		}
+	}
+	fmt.Fprintf(&b, "Canonical: %s/.well-known/security.txt\n", strings.TrimRight(siteURL, "/"))
+	return []byte(b.String()), nil
+}
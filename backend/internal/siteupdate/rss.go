@@ -0,0 +1,70 @@
+package siteupdate
+
+import (
+	"encoding/xml"
+	"strings"
+	"time"
+)
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link,omitempty"`
+	Description string  `xml:"description"`
+	Category    string  `xml:"category,omitempty"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RSS renders updates as an RSS 2.0 feed. siteURL is the public website
+// origin, used as the channel link and for updates without their own link.
+func RSS(siteURL string, updates []*Update) ([]byte, error) {
+	siteURL = strings.TrimRight(siteURL, "/")
+	channel := rssChannel{
+		Title:       "What's new",
+		Link:        siteURL,
+		Description: "Changes to the website",
+	}
+	if len(updates) > 0 {
+		channel.LastBuildDate = updates[0].PublishedAt.Format(time.RFC1123Z)
+	}
+
+	for _, u := range updates {
+		link := u.Link
+		if link == "" {
+			link = siteURL
+		}
+		channel.Items = append(channel.Items, rssItem{
+			Title:       u.Title,
+			Link:        link,
+			Description: u.Description,
+			Category:    u.Kind,
+			GUID:        rssGUID{Value: "site-update:" + u.ID},
+			PubDate:     u.PublishedAt.Format(time.RFC1123Z),
+		})
+	}
+
+	out, err := xml.MarshalIndent(rssFeed{Version: "2.0", Channel: channel}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), out...), nil
+}
@@ -0,0 +1,176 @@
+package siteupdate
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown site updates.
+var ErrNotFound = errors.New("site update not found")
+
+// Kinds lists the accepted kinds of site update.
+var Kinds = []string{"feature", "section", "redesign", "improvement", "fix"}
+
+// ValidKind reports whether kind is one of Kinds.
+func ValidKind(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Update is an announcement of a change to the website itself.
+type Update struct {
+	ID          string
+	Title       string
+	Description string
+	Kind        string
+	Link        string
+	PublishedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Data converts the update to its API representation.
+func (u *Update) Data() types.SiteUpdateData {
+	return types.SiteUpdateData{
+		ID:          u.ID,
+		Title:       u.Title,
+		Description: u.Description,
+		Kind:        u.Kind,
+		Link:        u.Link,
+		PublishedAt: utils.FormatTime(u.PublishedAt),
+		CreatedAt:   utils.FormatTime(u.CreatedAt),
+		UpdatedAt:   utils.FormatTime(u.UpdatedAt),
+	}
+}
+
+// Store persists updates in the raw site_updates table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+const updateColumns = `id, title, description, kind, link, published_at, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUpdate(row scanner) (*Update, error) {
+	var (
+		u    Update
+		link sql.NullString
+	)
+	if err := row.Scan(&u.ID, &u.Title, &u.Description, &u.Kind, &link, &u.PublishedAt, &u.CreatedAt, &u.UpdatedAt); err != nil {
+		return nil, err
+	}
+	u.Link = link.String
+	return &u, nil
+}
+
+// List returns the most recent updates, newest first. A limit of 0 returns
+// all of them.
+func (s *Store) List(ctx context.Context, limit int) ([]*Update, error) {
+	query := `SELECT ` + updateColumns + ` FROM site_updates ORDER BY published_at DESC`
+	var args []any
+	if limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, utils.Rebind(s.driver, query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var updates []*Update
+	for rows.Next() {
+		u, err := scanUpdate(rows)
+		if err != nil {
+			return nil, err
+		}
+		updates = append(updates, u)
+	}
+	return updates, rows.Err()
+}
+
+// Get returns an update by ID.
+func (s *Store) Get(ctx context.Context, id string) (*Update, error) {
+	u, err := scanUpdate(s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT `+updateColumns+` FROM site_updates WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return u, err
+}
+
+// Create stores a new update and fills in its ID and timestamps. A zero
+// PublishedAt is set to now.
+func (s *Store) Create(ctx context.Context, u *Update) error {
+	now := time.Now().UTC()
+	u.ID = uuid.New().String()
+	u.CreatedAt = now
+	u.UpdatedAt = now
+	if u.PublishedAt.IsZero() {
+		u.PublishedAt = now
+	}
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO site_updates (id, title, description, kind, link, published_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		u.ID, u.Title, u.Description, u.Kind, u.Link, u.PublishedAt, u.CreatedAt, u.UpdatedAt,
+	)
+	return err
+}
+
+// Restore stores a deleted update again with its original ID and timestamps.
+func (s *Store) Restore(ctx context.Context, u *Update) error {
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO site_updates (id, title, description, kind, link, published_at, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		u.ID, u.Title, u.Description, u.Kind, u.Link, u.PublishedAt, u.CreatedAt, u.UpdatedAt,
+	)
+	return err
+}
+
+// Update saves all editable fields of an update.
+func (s *Store) Update(ctx context.Context, u *Update) error {
+	u.UpdatedAt = time.Now().UTC()
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE site_updates SET title = ?, description = ?, kind = ?, link = ?, published_at = ?, updated_at = ? WHERE id = ?`),
+		u.Title, u.Description, u.Kind, u.Link, u.PublishedAt, u.UpdatedAt, u.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes an update.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM site_updates WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
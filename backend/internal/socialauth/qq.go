@@ -0,0 +1,119 @@
+package socialauth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const qqAPI = "https://graph.qq.com"
+
+// qqInvalidCode is the error of an unknown, used or expired code.
+const qqInvalidCode = 100019
+
+// QQ verifies QQ Connect logins of one website app.
+type QQ struct {
+	appID  string
+	appKey string
+}
+
+func NewQQ(appID, appKey string) *QQ {
+	return &QQ{appID: appID, appKey: appKey}
+}
+
+// Enabled reports whether app credentials are configured.
+func (q *QQ) Enabled() bool {
+	return q != nil && q.appID != "" && q.appKey != ""
+}
+
+type qqError struct {
+	Error            int    `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (e qqError) err() error {
+	switch e.Error {
+	case 0:
+		return nil
+	case qqInvalidCode:
+		return ErrInvalidCode
+	}
+	return fmt.Errorf("qq error %d: %s", e.Error, e.ErrorDescription)
+}
+
+// Exchange redeems an authorization code for the visitor's profile.
+// redirectURI must be the one the code was requested with.
+func (q *QQ) Exchange(ctx context.Context, code, redirectURI string) (*Profile, error) {
+	if !q.Enabled() {
+		return nil, ErrDisabled
+	}
+
+	var token struct {
+		qqError
+		AccessToken string `json:"access_token"`
+	}
+	err := getJSON(ctx, qqAPI+"/oauth2.0/token", url.Values{
+		"grant_type":    {"authorization_code"},
+		"client_id":     {q.appID},
+		"client_secret": {q.appKey},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"fmt":           {"json"},
+	}, &token)
+	if err != nil {
+		return nil, err
+	}
+	if err := token.err(); err != nil {
+		return nil, err
+	}
+
+	var me struct {
+		qqError
+		OpenID  string `json:"openid"`
+		UnionID string `json:"unionid"`
+	}
+	err = getJSON(ctx, qqAPI+"/oauth2.0/me", url.Values{
+		"access_token": {token.AccessToken},
+		"unionid":      {"1"},
+		"fmt":          {"json"},
+	}, &me)
+	if err != nil {
+		return nil, err
+	}
+	if err := me.err(); err != nil {
+		return nil, err
+	}
+	if me.OpenID == "" {
+		return nil, fmt.Errorf("qq returned no openid")
+	}
+
+	var info struct {
+		Ret        int    `json:"ret"`
+		Msg        string `json:"msg"`
+		Nickname   string `json:"nickname"`
+		FigureURL  string `json:"figureurl_qq_2"`
+		FigureURL1 string `json:"figureurl_qq_1"`
+	}
+	err = getJSON(ctx, qqAPI+"/user/get_user_info", url.Values{
+		"access_token":       {token.AccessToken},
+		"oauth_consumer_key": {q.appID},
+		"openid":             {me.OpenID},
+	}, &info)
+	if err != nil {
+		return nil, err
+	}
+	if info.Ret != 0 {
+		return nil, fmt.Errorf("qq error %d: %s", info.Ret, info.Msg)
+	}
+
+	p := &Profile{
+		OpenID:    me.OpenID,
+		UnionID:   me.UnionID,
+		Name:      info.Nickname,
+		AvatarURL: info.FigureURL,
+	}
+	if p.AvatarURL == "" {
+		p.AvatarURL = info.FigureURL1
+	}
+	return p, nil
+}
@@ -0,0 +1,71 @@
+// Package socialauth verifies sign-ins with the OAuth providers used by the
+// site's Chinese-speaking visitors, for whom Google is often unreachable:
+// WeChat website login and QQ Connect. The frontend sends the visitor
+// through the provider's authorization page and posts the returned code
+// here; it is exchanged server-side, where the app secret lives, for the
+// visitor's openid, unionid and public profile.
+package socialauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Provider names, also stored as the provider of user identities.
+const (
+	ProviderWeChat = "wechat"
+	ProviderQQ     = "qq"
+)
+
+// ErrDisabled is returned by providers without app credentials.
+var ErrDisabled = errors.New("this sign-in provider is not configured")
+
+// ErrInvalidCode is returned when the provider rejects the authorization
+// code, usually because it was already used or has expired.
+var ErrInvalidCode = errors.New("invalid or expired authorization code")
+
+// Profile is what a provider tells about the signed-in visitor. UnionID is
+// the same across all apps of one developer account and is only present
+// when the app is bound to one; OpenID is specific to the app.
+type Profile struct {
+	OpenID    string
+	UnionID   string
+	Name      string
+	AvatarURL string
+}
+
+// ExternalID is the stable ID of the visitor: the unionid when known, so
+// that switching or adding apps keeps identities, otherwise the openid.
+func (p *Profile) ExternalID() string {
+	if p.UnionID != "" {
+		return p.UnionID
+	}
+	return p.OpenID
+}
+
+// client is shared by the providers.
+var client = &http.Client{Timeout: 10 * time.Second}
+
+// getJSON fetches endpoint with query and decodes the JSON answer into out.
+// Both providers report errors inside a 200 response, which the caller
+// checks.
+func getJSON(ctx context.Context, endpoint string, query url.Values, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned %s", endpoint, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -0,0 +1,102 @@
+package socialauth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+const wechatAPI = "https://api.weixin.qq.com/sns"
+
+// wechatInvalidCode is the errcode of an unknown, used or expired code.
+const wechatInvalidCode = 40029
+
+// WeChat verifies WeChat website logins (snsapi_login) of one app on the
+// WeChat Open Platform.
+type WeChat struct {
+	appID  string
+	secret string
+}
+
+func NewWeChat(appID, secret string) *WeChat {
+	return &WeChat{appID: appID, secret: secret}
+}
+
+// Enabled reports whether app credentials are configured.
+func (w *WeChat) Enabled() bool {
+	return w != nil && w.appID != "" && w.secret != ""
+}
+
+type wechatError struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+func (e wechatError) err() error {
+	switch e.ErrCode {
+	case 0:
+		return nil
+	case wechatInvalidCode:
+		return ErrInvalidCode
+	}
+	return fmt.Errorf("wechat error %d: %s", e.ErrCode, e.ErrMsg)
+}
+
+// Exchange redeems an authorization code for the visitor's profile.
+func (w *WeChat) Exchange(ctx context.Context, code string) (*Profile, error) {
+	if !w.Enabled() {
+		return nil, ErrDisabled
+	}
+
+	var token struct {
+		wechatError
+		AccessToken string `json:"access_token"`
+		OpenID      string `json:"openid"`
+		UnionID     string `json:"unionid"`
+	}
+	err := getJSON(ctx, wechatAPI+"/oauth2/access_token", url.Values{
+		"appid":      {w.appID},
+		"secret":     {w.secret},
+		"code":       {code},
+		"grant_type": {"authorization_code"},
+	}, &token)
+	if err != nil {
+		return nil, err
+	}
+	if err := token.err(); err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		wechatError
+		OpenID     string `json:"openid"`
+		UnionID    string `json:"unionid"`
+		Nickname   string `json:"nickname"`
+		HeadImgURL string `json:"headimgurl"`
+	}
+	err = getJSON(ctx, wechatAPI+"/userinfo", url.Values{
+		"access_token": {token.AccessToken},
+		"openid":       {token.OpenID},
+		"lang":         {"zh_CN"},
+	}, &info)
+	if err != nil {
+		return nil, err
+	}
+	if err := info.err(); err != nil {
+		return nil, err
+	}
+
+	p := &Profile{
+		OpenID:    token.OpenID,
+		UnionID:   token.UnionID,
+		Name:      info.Nickname,
+		AvatarURL: info.HeadImgURL,
+	}
+	if p.UnionID == "" {
+		p.UnionID = info.UnionID
+	}
+	if p.OpenID == "" {
+		return nil, fmt.Errorf("wechat returned no openid")
+	}
+	return p, nil
+}
@@ -0,0 +1,85 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultAkismetEndpoint = "https://rest.akismet.com/1.1"
+
+// Akismet checks comments with the Akismet comment-check API.
+type Akismet struct {
+	endpoint string
+	key      string
+	blog     string
+	http     *http.Client
+}
+
+// NewAkismet returns a checker for the Akismet-compatible API at endpoint
+// (the Akismet REST API when empty). blog is the site URL the key is
+// registered for.
+func NewAkismet(endpoint, key, blog string, timeout time.Duration) *Akismet {
+	if endpoint == "" {
+		endpoint = defaultAkismetEndpoint
+	}
+	return &Akismet{
+		endpoint: strings.TrimRight(endpoint, "/"),
+		key:      key,
+		blog:     blog,
+		http:     &http.Client{Timeout: timeout},
+	}
+}
+
+// Check scores s as 0 for ham, 0.9 for spam and 1 for spam Akismet says
+// can be discarded unseen.
+func (a *Akismet) Check(ctx context.Context, s Submission) (Verdict, error) {
+	form := url.Values{
+		"api_key":              {a.key},
+		"blog":                 {a.blog},
+		"user_ip":              {s.IP},
+		"user_agent":           {s.UserAgent},
+		"comment_type":         {"comment"},
+		"comment_author":       {s.AuthorName},
+		"comment_author_email": {s.AuthorEmail},
+		"comment_author_url":   {s.AuthorWebsite},
+		"comment_content":      {s.Content},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.endpoint+"/comment-check", strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := a.http.Do(req)
+	if err != nil {
+		return Verdict{}, err
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(res.Body, 1024))
+	if err != nil {
+		return Verdict{}, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return Verdict{}, fmt.Errorf("akismet comment-check returned %s", res.Status)
+	}
+
+	v := Verdict{Provider: ProviderAkismet}
+	switch strings.TrimSpace(string(body)) {
+	case "true":
+		v.Score = 0.9
+		if res.Header.Get("X-akismet-pro-tip") == "discard" {
+			v.Score = 1
+		}
+	case "false":
+	default:
+		// "invalid" and anything else come with a debug header
+		return Verdict{}, fmt.Errorf("akismet comment-check failed: %s %s",
+			strings.TrimSpace(string(body)), res.Header.Get("X-akismet-debug-help"))
+	}
+	return v, nil
+}
@@ -0,0 +1,74 @@
+package spam
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// RecentFunc counts the comments posted since the given time from the
+// submission's IP address or fingerprint.
+type RecentFunc func(ctx context.Context, s Submission, since time.Time) (int, error)
+
+// Weights of the heuristic signals; a blocklisted term or a burst of
+// comments is enough to reach the default hold score of 0.5 on its own.
+const (
+	linkWeight      = 0.2
+	blocklistWeight = 0.6
+	velocityWeight  = 0.5
+)
+
+var linkPattern = regexp.MustCompile(`(?i)https?://|www\.|<a\s`)
+
+// Heuristic scores comments locally by their number of links, blocklisted
+// terms and how many comments the same visitor posted just before.
+type Heuristic struct {
+	// MaxLinks is how many links a comment may carry before every further
+	// one adds to the score
+	MaxLinks int
+	// VelocityLimit comments within VelocityWindow count as a burst; Recent
+	// must be set for this check
+	VelocityLimit  int
+	VelocityWindow time.Duration
+	// Blocklist terms are matched case-insensitively against the content,
+	// author name, email and website
+	Blocklist []string
+	Recent    RecentFunc
+}
+
+func (h *Heuristic) Check(ctx context.Context, s Submission) (Verdict, error) {
+	v := Verdict{Provider: ProviderHeuristic}
+
+	if links := len(linkPattern.FindAllStringIndex(s.Content, -1)); links > h.MaxLinks {
+		v.Score += linkWeight * float64(links-h.MaxLinks)
+		v.Reasons = append(v.Reasons, fmt.Sprintf("%d links", links))
+	}
+
+	text := strings.ToLower(strings.Join([]string{s.Content, s.AuthorName, s.AuthorEmail, s.AuthorWebsite}, "\n"))
+	for _, term := range h.Blocklist {
+		term = strings.ToLower(strings.TrimSpace(term))
+		if term != "" && strings.Contains(text, term) {
+			v.Score += blocklistWeight
+			v.Reasons = append(v.Reasons, fmt.Sprintf("blocklisted term %q", term))
+			break
+		}
+	}
+
+	if h.Recent != nil && h.VelocityLimit > 0 && h.VelocityWindow > 0 && (s.IP != "" || s.Fingerprint != "") {
+		n, err := h.Recent(ctx, s, time.Now().Add(-h.VelocityWindow))
+		if err != nil {
+			return Verdict{}, err
+		}
+		if n >= h.VelocityLimit {
+			v.Score += velocityWeight
+			v.Reasons = append(v.Reasons, fmt.Sprintf("%d comments in %s", n, h.VelocityWindow))
+		}
+	}
+
+	if v.Score > 1 {
+		v.Score = 1
+	}
+	return v, nil
+}
@@ -0,0 +1,143 @@
+// Package spam scores new comments so that likely spam waits for
+// moderation. A Checker either asks an Akismet-compatible service or
+// applies local heuristics (links, posting velocity, a blocklist); the
+// verdict is kept next to the comment for the moderation queue.
+package spam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/spamscore"
+)
+
+// Providers understood by New.
+const (
+	ProviderHeuristic = "heuristic"
+	ProviderAkismet   = "akismet"
+)
+
+// Submission is the comment being checked and who is posting it.
+type Submission struct {
+	EntityType    string
+	AuthorName    string
+	AuthorEmail   string
+	AuthorWebsite string
+	Content       string
+	IP            string
+	UserAgent     string
+	Fingerprint   string
+}
+
+// Verdict is a spam score between 0 (ham) and 1 (certainly spam), with the
+// reasons a heuristic found, if any.
+type Verdict struct {
+	Provider string
+	Score    float64
+	Reasons  []string
+}
+
+// Checker scores a submission.
+type Checker interface {
+	Check(ctx context.Context, s Submission) (Verdict, error)
+}
+
+// Options configures the checker returned by New.
+type Options struct {
+	Provider string
+	// Akismet settings; Endpoint defaults to the Akismet REST API and may
+	// point at any compatible service
+	AkismetKey      string
+	AkismetEndpoint string
+	Blog            string
+	Timeout         time.Duration
+	// Heuristic settings
+	MaxLinks       int
+	VelocityLimit  int
+	VelocityWindow time.Duration
+	Blocklist      []string
+	Recent         RecentFunc
+}
+
+// New returns the checker for o.Provider, or nil when scoring is disabled.
+func New(o Options) (Checker, error) {
+	switch o.Provider {
+	case "", "none":
+		return nil, nil
+	case ProviderHeuristic:
+		return &Heuristic{
+			MaxLinks:       o.MaxLinks,
+			VelocityLimit:  o.VelocityLimit,
+			VelocityWindow: o.VelocityWindow,
+			Blocklist:      o.Blocklist,
+			Recent:         o.Recent,
+		}, nil
+	case ProviderAkismet:
+		if o.AkismetKey == "" {
+			return nil, errors.New("akismet spam checking needs an API key")
+		}
+		return NewAkismet(o.AkismetEndpoint, o.AkismetKey, o.Blog, o.Timeout), nil
+	}
+	return nil, fmt.Errorf("unknown spam provider %q", o.Provider)
+}
+
+// Store keeps the verdict of every scored comment.
+type Store struct {
+	client *ent.Client
+}
+
+func NewStore(client *ent.Client) *Store {
+	return &Store{client: client}
+}
+
+// Save records the verdict for a comment, replacing an earlier one.
+func (s *Store) Save(ctx context.Context, commentID string, v Verdict) error {
+	reasons := strings.Join(v.Reasons, "\n")
+	now := time.Now().UTC()
+	err := s.client.SpamScore.UpdateOneID(commentID).
+		SetProvider(v.Provider).
+		SetScore(v.Score).
+		SetReasons(reasons).
+		SetCreatedAt(now).
+		Exec(ctx)
+	if !ent.IsNotFound(err) {
+		return err
+	}
+	return s.client.SpamScore.Create().
+		SetID(commentID).
+		SetProvider(v.Provider).
+		SetScore(v.Score).
+		SetReasons(reasons).
+		SetCreatedAt(now).
+		Exec(ctx)
+}
+
+// Get returns the verdicts of the given comments that were scored.
+func (s *Store) Get(ctx context.Context, commentIDs []string) (map[string]Verdict, error) {
+	verdicts := make(map[string]Verdict, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return verdicts, nil
+	}
+	scores, err := s.client.SpamScore.Query().Where(spamscore.IDIn(commentIDs...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, sc := range scores {
+		v := Verdict{Provider: sc.Provider, Score: sc.Score}
+		if sc.Reasons != "" {
+			v.Reasons = strings.Split(sc.Reasons, "\n")
+		}
+		verdicts[sc.ID] = v
+	}
+	return verdicts, nil
+}
+
+// Delete forgets the verdict of a deleted comment.
+func (s *Store) Delete(ctx context.Context, commentID string) error {
+	_, err := s.client.SpamScore.Delete().Where(spamscore.ID(commentID)).Exec(ctx)
+	return err
+}
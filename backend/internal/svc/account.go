@@ -0,0 +1,325 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/projectlike"
+	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/session"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ErrEmailNotVerified is returned when claiming comments by email with an
+// identity whose provider hasn't verified its email address.
+var ErrEmailNotVerified = errors.New("sign in with an account that has a verified email address")
+
+// ErrEmailNotProven is returned when claiming comments by email from a
+// session that wasn't started with an email code or Google sign-in.
+var ErrEmailNotProven = errors.New("sign in with an email code or Google to claim comments by email")
+
+// CanonicalIdentity returns the primary identity of a signed-in visitor.
+// The first time a verified identity shares its email with an identity from
+// another sign-in, it is linked to that identity's primary and its comments
+// and likes move there. ident must come from a sign-in that has just been
+// checked: a redeemed email code, an OAuth code exchange or a Google ID
+// token whose signature was verified.
+func (s *ServiceContext) CanonicalIdentity(ctx context.Context, ident *ent.UserIdentity) (*ent.UserIdentity, error) {
+	primaryID, err := s.Accounts.Primary(ctx, ident.ID)
+	if err != nil {
+		return nil, err
+	}
+	if primaryID != ident.ID {
+		return s.DB.UserIdentity.Get(ctx, primaryID)
+	}
+	if !ident.Verified || ident.Email == "" {
+		return ident, nil
+	}
+
+	// Only verified addresses are trusted to belong to the same person
+	oldest, err := s.DB.UserIdentity.Query().
+		Where(
+			useridentity.EmailEqualFold(ident.Email),
+			useridentity.Verified(true),
+			useridentity.IDNEQ(ident.ID),
+		).
+		Order(ent.Asc(useridentity.FieldCreatedAt)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return ident, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if oldest.CreatedAt.After(ident.CreatedAt) {
+		// ident is the oldest and already the primary of the others
+		return ident, nil
+	}
+	primaryID, err = s.Accounts.Primary(ctx, oldest.ID)
+	if err != nil {
+		return nil, err
+	}
+	if primaryID == ident.ID {
+		return ident, nil
+	}
+
+	if err := s.linkIdentity(ctx, ident.ID, primaryID); err != nil {
+		return nil, fmt.Errorf("failed to link identity %s: %w", ident.ID, err)
+	}
+	logx.WithContext(ctx).Infof("Linked identity %s (%s) to %s", ident.ID, ident.Provider, primaryID)
+
+	if primaryID == oldest.ID {
+		return oldest, nil
+	}
+	return s.DB.UserIdentity.Get(ctx, primaryID)
+}
+
+// SyncProviderProfile adds to update, of ident, the display name and
+// avatar the provider gave at sign-in. Fields the user edited on their
+// profile are kept, and the provider's name is stored as
+// provider_display_name instead, so resetting the edited one can bring it
+// back. Empty values are ignored.
+func SyncProviderProfile(update *ent.UserIdentityUpdateOne, ident *ent.UserIdentity, name, avatarURL string) *ent.UserIdentityUpdateOne {
+	if name != "" {
+		if ident.DisplayNameEdited {
+			update.SetProviderDisplayName(name)
+		} else if ident.DisplayName != name {
+			update.SetDisplayName(name)
+		}
+	}
+	if avatarURL != "" && !ident.AvatarURLEdited && ident.AvatarURL != avatarURL {
+		update.SetAvatarURL(avatarURL)
+	}
+	return update
+}
+
+// linkIdentity makes to the primary identity of from and reassigns the
+// comments and likes of from to it, in one transaction. A like both
+// identities gave is kept once.
+func (s *ServiceContext) linkIdentity(ctx context.Context, from, to string) error {
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := moveIdentityRows(ctx, tx, from, to); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.IdentityLink.Create().SetIdentityID(from).SetPrimaryID(to).Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func moveIdentityRows(ctx context.Context, tx *ent.Tx, from, to string) error {
+	if _, err := tx.Comment.Update().
+		Where(comment.UserIdentityIDEQ(from)).
+		SetUserIdentityID(to).
+		Save(ctx); err != nil {
+		return err
+	}
+
+	commentLikes, err := tx.CommentLike.Query().Where(commentlike.UserIdentityIDEQ(from)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, like := range commentLikes {
+		dup, err := tx.CommentLike.Query().
+			Where(commentlike.CommentIDEQ(like.CommentID), commentlike.UserIdentityIDEQ(to)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !dup {
+			if err := tx.CommentLike.UpdateOne(like).SetUserIdentityID(to).Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := tx.CommentLike.DeleteOne(like).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Comment.UpdateOneID(like.CommentID).AddLikesCount(-1).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+
+	projectLikes, err := tx.ProjectLike.Query().Where(projectlike.UserIdentityIDEQ(from)).All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, like := range projectLikes {
+		dup, err := tx.ProjectLike.Query().
+			Where(projectlike.ProjectIDEQ(like.ProjectID), projectlike.UserIdentityIDEQ(to)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !dup {
+			if err := tx.ProjectLike.UpdateOne(like).SetUserIdentityID(to).Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := tx.ProjectLike.DeleteOne(like).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Project.UpdateOneID(like.ProjectID).AddLikeCount(-1).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClaimedActivity counts the anonymous rows ClaimAnonymousActivity moved.
+type ClaimedActivity struct {
+	Comments     int
+	CommentLikes int
+	ProjectLikes int
+}
+
+// ClaimAnonymousActivity reassigns what a visitor did anonymously in the
+// browser with fingerprint to their identity, so their history and delete
+// rights carry over after signing in. Comments also need to carry the
+// identity's verified email, since a fingerprint alone can be shared or
+// guessed; likes have no email and move on the fingerprint alone.
+func (s *ServiceContext) ClaimAnonymousActivity(ctx context.Context, ident *ent.UserIdentity, fingerprint string) (*ClaimedActivity, error) {
+	claimed := &ClaimedActivity{}
+	if fingerprint == "" {
+		return claimed, nil
+	}
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := claimAnonymousRows(ctx, tx, ident, s.Fingerprints.Hashes(ctx, fingerprint), claimed); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return claimed, nil
+}
+
+// ClaimCommentsByEmail reassigns the anonymous comments left with the
+// email of the identity signed in with claims to it, from any browser.
+// Unlike ClaimAnonymousActivity no fingerprint is needed, so the session
+// itself must prove the visitor owns the address: only sessions started
+// with an email code or a verified Google ID token can claim. Other OAuth
+// providers vouch for addresses in their own ways and unverified
+// identities claim nothing.
+func (s *ServiceContext) ClaimCommentsByEmail(ctx context.Context, claims *session.Claims) (int, error) {
+	if claims.SessionID == "" {
+		return 0, ErrEmailNotProven
+	}
+	sess, err := s.Sessions.Get(ctx, claims.SessionID)
+	if err != nil {
+		return 0, err
+	}
+	if sess.Method != session.MethodEmailCode && sess.Method != session.MethodGoogle {
+		return 0, ErrEmailNotProven
+	}
+	ident, err := s.DB.UserIdentity.Get(ctx, claims.IdentityID)
+	if err != nil {
+		return 0, err
+	}
+	if !ident.Verified || ident.Email == "" {
+		return 0, ErrEmailNotVerified
+	}
+	return s.DB.Comment.Update().
+		Where(
+			comment.Or(comment.UserIdentityIDIsNil(), comment.UserIdentityIDEQ("")),
+			comment.AuthorEmailEqualFold(ident.Email),
+		).
+		SetUserIdentityID(ident.ID).
+		Save(ctx)
+}
+
+// claimAnonymousRows moves the rows stored under any of the fingerprint
+// hashes to ident.
+func claimAnonymousRows(ctx context.Context, tx *ent.Tx, ident *ent.UserIdentity, fingerprints []string, claimed *ClaimedActivity) error {
+	if ident.Verified && ident.Email != "" {
+		n, err := tx.Comment.Update().
+			Where(
+				comment.Or(comment.UserIdentityIDIsNil(), comment.UserIdentityIDEQ("")),
+				comment.AuthorEmailEqualFold(ident.Email),
+				fingerprintTagged(fingerprints),
+			).
+			SetUserIdentityID(ident.ID).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		claimed.Comments = n
+	}
+
+	commentLikes, err := tx.CommentLike.Query().
+		Where(
+			commentlike.Or(commentlike.UserIdentityIDIsNil(), commentlike.UserIdentityIDEQ("")),
+			commentlike.FingerprintIn(fingerprints...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, like := range commentLikes {
+		dup, err := tx.CommentLike.Query().
+			Where(commentlike.CommentIDEQ(like.CommentID), commentlike.UserIdentityIDEQ(ident.ID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !dup {
+			if err := tx.CommentLike.UpdateOne(like).SetUserIdentityID(ident.ID).Exec(ctx); err != nil {
+				return err
+			}
+			claimed.CommentLikes++
+			continue
+		}
+		if err := tx.CommentLike.DeleteOne(like).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Comment.UpdateOneID(like.CommentID).AddLikesCount(-1).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+
+	projectLikes, err := tx.ProjectLike.Query().
+		Where(
+			projectlike.Or(projectlike.UserIdentityIDIsNil(), projectlike.UserIdentityIDEQ("")),
+			projectlike.FingerprintIn(fingerprints...),
+		).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, like := range projectLikes {
+		dup, err := tx.ProjectLike.Query().
+			Where(projectlike.ProjectIDEQ(like.ProjectID), projectlike.UserIdentityIDEQ(ident.ID)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if !dup {
+			if err := tx.ProjectLike.UpdateOne(like).SetUserIdentityID(ident.ID).Exec(ctx); err != nil {
+				return err
+			}
+			claimed.ProjectLikes++
+			continue
+		}
+		if err := tx.ProjectLike.DeleteOne(like).Exec(ctx); err != nil {
+			return err
+		}
+		if err := tx.Project.UpdateOneID(like.ProjectID).AddLikeCount(-1).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
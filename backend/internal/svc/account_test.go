@@ -0,0 +1,88 @@
+package svc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestSyncProviderProfileKeepsEditedFields(t *testing.T) {
+	s := newTestContext(t)
+	ctx := context.Background()
+	ident, err := s.DB.UserIdentity.Create().SetID("u_1").SetProvider("google").SetExternalID("1").
+		SetDisplayName("Old").SetAvatarURL("https://example.com/old.png").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ident, err = SyncProviderProfile(ident.Update(), ident, "Google Name", "https://example.com/new.png").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ident.DisplayName != "Google Name" || ident.AvatarURL != "https://example.com/new.png" {
+		t.Fatalf("unedited profile not refreshed: %+v", ident)
+	}
+
+	ident, err = ident.Update().SetDisplayName("Mine").SetDisplayNameEdited(true).
+		SetAvatarURL("https://example.com/mine.png").SetAvatarURLEdited(true).Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ident, err = SyncProviderProfile(ident.Update(), ident, "Renamed", "https://example.com/newer.png").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ident.DisplayName != "Mine" || ident.AvatarURL != "https://example.com/mine.png" || ident.ProviderDisplayName != "Renamed" {
+		t.Fatalf("edited profile overwritten: %+v", ident)
+	}
+}
+
+func TestCanonicalIdentityLinksVerifiedEmail(t *testing.T) {
+	s := newTestContext(t)
+	ctx := context.Background()
+	create := func(id, provider string, createdAt time.Time) {
+		t.Helper()
+		err := s.DB.UserIdentity.Create().SetID(id).SetProvider(provider).SetExternalID(id).
+			SetEmail("a@example.com").SetVerified(true).SetCreatedAt(createdAt).Exec(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	create("u_google", "google", time.Now().Add(-time.Hour))
+	create("u_github", "github", time.Now())
+	c, err := s.DB.Comment.Create().SetEntityType("blog").SetEntityID(uuid.New()).
+		SetAuthorName("A").SetAuthorEmail("a@example.com").SetContent("Hi").
+		SetUserIdentityID("u_github").Save(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	github, err := s.DB.UserIdentity.Get(ctx, "u_github")
+	if err != nil {
+		t.Fatal(err)
+	}
+	primary, err := s.CanonicalIdentity(ctx, github)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if primary.ID != "u_google" {
+		t.Fatalf("got primary %s, want u_google", primary.ID)
+	}
+	if c, err = s.DB.Comment.Get(ctx, c.ID); err != nil || c.UserIdentityID != "u_google" {
+		t.Fatalf("comment not moved: %+v, %v", c, err)
+	}
+	linked, err := s.Accounts.Linked(ctx, "u_google")
+	if err != nil || len(linked) != 1 || linked[0] != "u_github" {
+		t.Fatalf("linked: %v, %v", linked, err)
+	}
+
+	// Deleting the primary deletes the link with it
+	if err := s.DB.UserIdentity.DeleteOneID("u_google").Exec(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if id, err := s.Accounts.Primary(ctx, "u_github"); err != nil || id != "u_github" {
+		t.Fatalf("primary after delete: %s, %v", id, err)
+	}
+}
@@ -0,0 +1,67 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/activity"
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+)
+
+// ListActivity lists the activity log for req. The public feed passes
+// publicOnly; the owner sees every entry, such as comments awaiting
+// moderation. Type is a comma-separated list of event types.
+func (s *ServiceContext) ListActivity(ctx context.Context, req *types.ActivityRequest, publicOnly bool) (*types.ActivityResponse, error) {
+	q := activity.Query{PublicOnly: publicOnly, Limit: req.Limit}
+	if q.Limit <= 0 || q.Limit > 100 {
+		q.Limit = 20
+	}
+	for _, t := range strings.Split(req.Type, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			q.Types = append(q.Types, t)
+		}
+	}
+	var err error
+	if q.Before, err = parseActivityTime("before", req.Before); err != nil {
+		return nil, err
+	}
+	if q.Since, err = parseActivityTime("since", req.Since); err != nil {
+		return nil, err
+	}
+
+	list, err := s.Activity.List(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.ActivityResponse{Events: make([]types.ActivityEvent, 0, len(list))}
+	for _, e := range list {
+		resp.Events = append(resp.Events, types.ActivityEvent{
+			ID:          e.ID,
+			Type:        e.Type,
+			SubjectType: e.SubjectType,
+			SubjectID:   e.SubjectID,
+			ParentType:  e.ParentType,
+			ParentID:    e.ParentID,
+			Title:       e.Title,
+			Summary:     e.Summary,
+			Actor:       e.Actor,
+			Public:      e.Public,
+			CreatedAt:   utils.FormatTime(e.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+func parseActivityTime(field, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s must be an RFC 3339 time", field)
+	}
+	return t, nil
+}
@@ -0,0 +1,63 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"silan-backend/internal/ama"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+)
+
+// ErrAMANotOpen is returned for questions to an AMA outside its window.
+var ErrAMANotOpen = errors.New("this AMA is not taking questions")
+
+// CheckAMAOpen returns the AMA with the given id if it currently takes
+// questions.
+func (s *ServiceContext) CheckAMAOpen(ctx context.Context, id string) (*ama.AMA, error) {
+	a, err := s.AMAs.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status(time.Now()) != ama.StatusOpen {
+		return nil, ErrAMANotOpen
+	}
+	return a, nil
+}
+
+// commentReadOnly reports whether c belongs to a thread that no longer
+// takes changes, which are AMAs outside their window.
+func (s *ServiceContext) commentReadOnly(ctx context.Context, c *ent.Comment) bool {
+	if c.EntityType != ama.EntityType {
+		return false
+	}
+	_, err := s.CheckAMAOpen(ctx, c.EntityID.String())
+	return err != nil
+}
+
+// AMAQuestionCounts returns the number of approved questions of each AMA
+// by AMA ID.
+func (s *ServiceContext) AMAQuestionCounts(ctx context.Context) (map[string]int, error) {
+	var rows []struct {
+		EntityID string `json:"entity_id"`
+		Count    int    `json:"count"`
+	}
+	err := s.DB.Comment.Query().
+		Where(
+			comment.EntityTypeEQ(ama.EntityType),
+			comment.ParentIDIsNil(),
+			comment.IsApproved(true),
+		).
+		GroupBy(comment.FieldEntityID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int, len(rows))
+	for _, r := range rows {
+		counts[r.EntityID] = r.Count
+	}
+	return counts, nil
+}
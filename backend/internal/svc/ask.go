@@ -0,0 +1,35 @@
+package svc
+
+import (
+	"context"
+	"errors"
+)
+
+// AuditAskRateLimited is recorded the first time a subnet exceeds the
+// question cap within a window.
+const AuditAskRateLimited = "ask_rate_limited"
+
+// ErrTooManyQuestions is returned to visitors whose network has used up its
+// question allowance for the current window.
+var ErrTooManyQuestions = errors.New("too many questions from your network, try again later")
+
+// CheckQuestion applies the per-subnet cap to a new "ask my site" question.
+func (s *ServiceContext) CheckQuestion(ctx context.Context, ip, fingerprint string) error {
+	if ip == "" {
+		return nil
+	}
+
+	d := s.AskLimiter.Allow(ip, fingerprint)
+	if d.Flagged {
+		s.Audit(ctx, AuditAskRateLimited, "ask", ip, map[string]any{
+			"subnet":       d.Subnet,
+			"questions":    d.Count,
+			"fingerprints": d.Fingerprints,
+			"limit":        s.Config.Ask.QuestionsPerSubnetHour,
+		})
+	}
+	if !d.Allowed {
+		return ErrTooManyQuestions
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"silan-backend/internal/ban"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AuditLikeRateLimited is recorded the first time a subnet exceeds the
+// anonymous like cap within a window.
+const AuditLikeRateLimited = "like_rate_limited"
+
+// ErrTooManyLikes is returned to anonymous visitors whose network has used up
+// its like allowance for the current window.
+var ErrTooManyLikes = errors.New("too many likes from your network, try again later")
+
+// Audit appends an entry to the audit_log table. Failures are logged rather
+// than returned so that auditing never breaks the request being audited.
+func (s *ServiceContext) Audit(ctx context.Context, action, subject, ip string, detail map[string]any) {
+	var payload string
+	if len(detail) > 0 {
+		b, err := json.Marshal(detail)
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to encode audit detail for %s: %v", action, err)
+		} else {
+			payload = string(b)
+		}
+	}
+
+	_, err := s.RawDB.ExecContext(ctx, s.Rebind(
+		`INSERT INTO audit_log (action, subject, ip, detail, created_at) VALUES (?, ?, ?, ?, ?)`),
+		action, subject, ip, payload, time.Now().UTC(),
+	)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to write audit entry %s: %v", action, err)
+	}
+}
+
+// CheckLike applies the per-subnet cap to a new like from an anonymous
+// visitor. kind and subject identify what was liked for the audit entry;
+// signed-in users are not limited. Banned visitors are rejected either way.
+func (s *ServiceContext) CheckLike(ctx context.Context, kind, subject, ip, fingerprint, userIdentityID string) error {
+	if err := s.CheckBanned(ctx, kind+":"+subject, ban.Actor{
+		IdentityID:  userIdentityID,
+		Fingerprint: fingerprint,
+		IP:          ip,
+	}); err != nil {
+		return err
+	}
+	if userIdentityID != "" || ip == "" {
+		return nil
+	}
+
+	d := s.LikeLimiter.Allow(ip, fingerprint)
+	if d.Flagged {
+		s.Audit(ctx, AuditLikeRateLimited, kind+":"+subject, ip, map[string]any{
+			"subnet":       d.Subnet,
+			"likes":        d.Count,
+			"fingerprints": d.Fingerprints,
+			"limit":        s.Config.Abuse.LikesPerSubnetHour,
+		})
+	}
+	if !d.Allowed {
+		return ErrTooManyLikes
+	}
+	return nil
+}
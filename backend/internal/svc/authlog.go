@@ -0,0 +1,23 @@
+package svc
+
+import (
+	"context"
+
+	"silan-backend/internal/authlog"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// RecordAuthEvent logs an authentication attempt that ended with err, nil
+// on success. Failures to write the log are logged rather than returned so
+// that they never break a sign-in.
+func (s *ServiceContext) RecordAuthEvent(ctx context.Context, e authlog.Event, err error) {
+	e.Outcome = authlog.OutcomeSuccess
+	if err != nil {
+		e.Outcome = authlog.OutcomeFailure
+		e.Reason = err.Error()
+	}
+	if err := s.AuthEvents.Record(ctx, &e); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to record %s auth event: %v", e.Type, err)
+	}
+}
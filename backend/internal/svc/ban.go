@@ -0,0 +1,39 @@
+package svc
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/ban"
+	"silan-backend/internal/fingerprint"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// AuditBannedActor is recorded whenever a banned visitor is turned away.
+const AuditBannedActor = "banned_actor_rejected"
+
+// ErrBanned is returned to visitors matching an active ban.
+var ErrBanned = errors.New("you have been banned from commenting and liking on this site")
+
+// CheckBanned rejects actors matching an active ban. subject names what they
+// tried to write for the audit entry. A failed lookup is logged and lets the
+// request through, so a broken ban list never takes comments down.
+func (s *ServiceContext) CheckBanned(ctx context.Context, subject string, a ban.Actor) error {
+	if a.Fingerprint != "" && a.FingerprintHashes == nil && !fingerprint.IsHashed(a.Fingerprint) {
+		a.FingerprintHashes = s.Fingerprints.Hashes(ctx, a.Fingerprint)
+	}
+	b, err := s.Bans.Match(ctx, a)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to check bans for %s: %v", subject, err)
+		return nil
+	}
+	if b == nil {
+		return nil
+	}
+	s.Audit(ctx, AuditBannedActor, subject, a.IP, map[string]any{
+		"ban_id": b.ID,
+		"kind":   b.Kind,
+	})
+	return ErrBanned
+}
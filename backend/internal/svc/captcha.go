@@ -0,0 +1,30 @@
+package svc
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ErrCaptchaUnavailable is returned when the captcha provider can't be
+// reached; anonymous writes are refused rather than let through unchecked.
+var ErrCaptchaUnavailable = errors.New("captcha verification is unavailable, try again later")
+
+// CheckCaptcha verifies the captcha token of an anonymous write. Signed-in
+// visitors (userIdentityID set) skip it, as does everyone while no captcha
+// is configured.
+func (s *ServiceContext) CheckCaptcha(ctx context.Context, token, ip, userIdentityID string) error {
+	if s.Captcha == nil || userIdentityID != "" {
+		return nil
+	}
+
+	err := s.Captcha.Verify(ctx, token, ip)
+	if err == nil || errors.Is(err, utils.ErrCaptchaRejected) {
+		return err
+	}
+	logx.WithContext(ctx).Errorf("Failed to verify captcha: %v", err)
+	return ErrCaptchaUnavailable
+}
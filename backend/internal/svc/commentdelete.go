@@ -0,0 +1,165 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/tombstone"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// deletedCommentEvent is the comment.deleted payload for c, which leaves out
+// what the author took back.
+func deletedCommentEvent(c *ent.Comment) outbox.CommentEvent {
+	ev := outbox.NewCommentEvent(c)
+	ev.AuthorName = ""
+	ev.Content = ""
+	return ev
+}
+
+// DeleteComment deletes a comment for its author. A comment others replied
+// to is blanked and kept as a tombstone so their replies stay in the
+// thread; otherwise it is removed, together with ancestors that are
+// tombstones left without replies. It reports whether a tombstone was kept.
+func (s *ServiceContext) DeleteComment(ctx context.Context, c *ent.Comment) (bool, error) {
+	hasReplies, err := s.DB.Comment.Query().Where(comment.ParentIDEQ(c.ID)).Exist(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to find replies: %w", err)
+	}
+	if !hasReplies {
+		return false, s.removeComment(ctx, c)
+	}
+
+	if c.DeletedAt != nil {
+		return true, nil
+	}
+
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if err := tombstone.Write(ctx, tx.Client(), c.ID, time.Now().UTC()); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("failed to delete comment %s: %w", c.ID, err)
+	}
+	if err := s.PublishEvent(ctx, tx, outbox.EventCommentDeleted, deletedCommentEvent(c)); err != nil {
+		tx.Rollback()
+		return false, fmt.Errorf("failed to record comment event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// removeComment deletes a comment without replies and then its parent if
+// that is a tombstone with no other replies left.
+func (s *ServiceContext) removeComment(ctx context.Context, c *ent.Comment) error {
+	for c != nil {
+		tx, err := s.DB.Tx(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to start transaction: %w", err)
+		}
+		if err := tx.Comment.DeleteOneID(c.ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to delete comment %s: %w", c.ID, err)
+		}
+		if err := s.PublishEvent(ctx, tx, outbox.EventCommentDeleted, deletedCommentEvent(c)); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to record comment event: %w", err)
+		}
+		if err := tx.Commit(); err != nil {
+			return fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		s.forgetComment(ctx, c.ID)
+
+		c, err = s.emptyTombstone(ctx, c.ParentID)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// emptyTombstone returns the comment with the given id if it is a
+// tombstone without replies, and nil otherwise.
+func (s *ServiceContext) emptyTombstone(ctx context.Context, id uuid.UUID) (*ent.Comment, error) {
+	if id == uuid.Nil {
+		return nil, nil
+	}
+	c, err := s.DB.Comment.Get(ctx, id)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil || c.DeletedAt == nil {
+		return nil, err
+	}
+	hasReplies, err := s.DB.Comment.Query().Where(comment.ParentIDEQ(id)).Exist(ctx)
+	if err != nil || hasReplies {
+		return nil, err
+	}
+	return c, nil
+}
+
+// PurgeComment permanently deletes a comment and all of its replies, for
+// admins. It returns the number of comments removed.
+func (s *ServiceContext) PurgeComment(ctx context.Context, id uuid.UUID) (int, error) {
+	c, err := s.DB.Comment.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	// Collect the thread breadth-first; replies are deleted before their
+	// parents
+	thread := []*ent.Comment{c}
+	for i := 0; i < len(thread); i++ {
+		replies, err := s.DB.Comment.Query().Where(comment.ParentIDEQ(thread[i].ID)).All(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find replies: %w", err)
+		}
+		thread = append(thread, replies...)
+	}
+
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	for i := len(thread) - 1; i >= 0; i-- {
+		if err := tx.Comment.DeleteOneID(thread[i].ID).Exec(ctx); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("failed to delete comment %s: %w", thread[i].ID, err)
+		}
+		if err := s.PublishEvent(ctx, tx, outbox.EventCommentDeleted, deletedCommentEvent(thread[i])); err != nil {
+			tx.Rollback()
+			return 0, fmt.Errorf("failed to record comment event: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	for _, c := range thread {
+		s.forgetComment(ctx, c.ID)
+	}
+
+	// The purged comment may have been the last reply to a tombstone
+	if parent, err := s.emptyTombstone(ctx, c.ParentID); err != nil {
+		return len(thread), err
+	} else if parent != nil {
+		return len(thread), s.removeComment(ctx, parent)
+	}
+	return len(thread), nil
+}
+
+// forgetComment drops what is kept about a removed comment outside the
+// comments table. Failures are logged; the leftovers are never shown.
+func (s *ServiceContext) forgetComment(ctx context.Context, id uuid.UUID) {
+	if err := s.SpamScores.Delete(ctx, id.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to delete spam score of comment %s: %v", id, err)
+	}
+}
@@ -0,0 +1,60 @@
+package svc
+
+import (
+	"context"
+	"testing"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/tombstone"
+
+	"github.com/google/uuid"
+)
+
+func TestDeleteCommentKeepsTombstoneWhileReplied(t *testing.T) {
+	s := newTestContext(t)
+	ctx := context.Background()
+	post := uuid.New()
+	create := func(parent *ent.Comment, name string) *ent.Comment {
+		t.Helper()
+		q := s.DB.Comment.Create().SetEntityType("blog").SetEntityID(post).
+			SetAuthorName(name).SetAuthorEmail(name + "@example.com").SetContent("hello from " + name).
+			SetIPAddress("1.2.3.4").SetIsApproved(true)
+		if parent != nil {
+			q.SetParentID(parent.ID)
+		}
+		c, err := q.Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	parent := create(nil, "alice")
+	reply := create(parent, "bob")
+
+	if kept, err := s.DeleteComment(ctx, parent); err != nil || !kept {
+		t.Fatalf("deleting a replied comment: kept %v, %v", kept, err)
+	}
+	parent, err := s.DB.Comment.Get(ctx, parent.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent.DeletedAt == nil || parent.AuthorName != tombstone.Placeholder || parent.Content != tombstone.Placeholder ||
+		parent.AuthorEmail != "" || parent.IPAddress != "" {
+		t.Fatalf("tombstone not blanked: %+v", parent)
+	}
+	// a tombstone still passes the schema's validators
+	if err := s.DB.Comment.UpdateOne(parent).AddLikesCount(1).Exec(ctx); err != nil {
+		t.Fatalf("updating the tombstone: %v", err)
+	}
+	if kept, err := s.DeleteComment(ctx, parent); err != nil || !kept {
+		t.Fatalf("deleting the tombstone again: kept %v, %v", kept, err)
+	}
+
+	// removing the last reply takes the tombstone with it
+	if kept, err := s.DeleteComment(ctx, reply); err != nil || kept {
+		t.Fatalf("deleting the reply: kept %v, %v", kept, err)
+	}
+	if n, err := s.DB.Comment.Query().Count(ctx); err != nil || n != 0 {
+		t.Fatalf("%d comments left, %v", n, err)
+	}
+}
@@ -0,0 +1,119 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"silan-backend/internal/ban"
+	"silan-backend/internal/commentlang"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/spam"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	// ErrCommentEditForbidden is returned to anyone but a comment's author.
+	ErrCommentEditForbidden = errors.New("forbidden: only the author can edit this comment")
+	// ErrCommentEditWindowClosed is returned once a comment is too old to
+	// be edited, and always while editing is turned off.
+	ErrCommentEditWindowClosed = errors.New("this comment can no longer be edited")
+)
+
+// CommentEdit is an author's change to the content of their comment.
+// IdentityID or Fingerprint identify the author, as for deleting comments.
+type CommentEdit struct {
+	CommentID   uuid.UUID
+	Content     string
+	IdentityID  string
+	Fingerprint string
+	IP          string
+	UserAgent   string
+}
+
+// EditComment replaces the content of a comment for its author within the
+// configured edit window and returns the updated comment with the edit
+// time. The new content is filtered and checked for spam like a new
+// comment and may send the comment back to moderation.
+func (s *ServiceContext) EditComment(ctx context.Context, e CommentEdit) (*ent.Comment, time.Time, error) {
+	if err := s.Legacy.CopyComment(ctx, e.CommentID.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to copy legacy comment %s: %v", e.CommentID, err)
+	}
+	c, err := s.DB.Comment.Get(ctx, e.CommentID)
+	if ent.IsNotFound(err) {
+		return nil, time.Time{}, fmt.Errorf("comment not found")
+	}
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if !s.IsCommentAuthor(ctx, c, e.IdentityID, e.Fingerprint) {
+		return nil, time.Time{}, ErrCommentEditForbidden
+	}
+	window := time.Duration(s.Config.Moderation.EditWindowMinutes) * time.Minute
+	if window <= 0 || time.Since(c.CreatedAt) > window || s.commentReadOnly(ctx, c) {
+		return nil, time.Time{}, ErrCommentEditWindowClosed
+	}
+
+	if err := s.CheckBanned(ctx, c.EntityType+":"+c.EntityID.String(), ban.Actor{
+		IdentityID:  e.IdentityID,
+		Email:       c.AuthorEmail,
+		Fingerprint: e.Fingerprint,
+		IP:          e.IP,
+	}); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	content, filterHeld, err := s.FilterComment(ctx, e.Content)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	e.Content = content
+
+	verdict, held := s.ScoreComment(ctx, spam.Submission{
+		EntityType:    c.EntityType,
+		AuthorName:    c.AuthorName,
+		AuthorEmail:   c.AuthorEmail,
+		AuthorWebsite: c.AuthorWebsite,
+		Content:       e.Content,
+		IP:            e.IP,
+		UserAgent:     e.UserAgent,
+		Fingerprint:   e.Fingerprint,
+	})
+
+	held = held || filterHeld
+
+	editedAt := time.Now().UTC()
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	update := tx.Comment.UpdateOneID(c.ID).
+		SetContent(e.Content).
+		SetLanguage(commentlang.Detect(e.Content)).
+		SetIsEdited(true).
+		SetEditedAt(editedAt).
+		AddEditCount(1)
+	if held {
+		update = update.SetIsApproved(false)
+	}
+	c, err = update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, time.Time{}, err
+	}
+	if err := s.PublishEvent(ctx, tx, outbox.EventCommentEdited, outbox.NewCommentEvent(c)); err != nil {
+		tx.Rollback()
+		return nil, time.Time{}, fmt.Errorf("failed to record comment event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	s.RecordSpamScore(ctx, c.ID.String(), verdict)
+	return c, editedAt, nil
+}
@@ -0,0 +1,127 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"silan-backend/internal/commentlang"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/types"
+
+	"github.com/google/uuid"
+)
+
+// DetectedLanguages returns the language of each of comments, by comment ID.
+// Comments from before languages were stored are detected on the fly.
+func DetectedLanguages(comments []*ent.Comment) map[string]string {
+	langs := make(map[string]string, len(comments))
+	for _, c := range comments {
+		if c.Language != "" {
+			langs[c.ID.String()] = c.Language
+		} else {
+			langs[c.ID.String()] = commentlang.Detect(c.Content)
+		}
+	}
+	return langs
+}
+
+// InCommentLanguage returns a predicate for the comments matching where
+// that belong to threads in lang: the top-level comments written in it and
+// the replies below them, whatever language those are written in.
+func (s *ServiceContext) InCommentLanguage(ctx context.Context, lang string, where ...predicate.Comment) (predicate.Comment, error) {
+	lang = strings.ToLower(strings.TrimSpace(lang))
+	level, err := s.DB.Comment.Query().
+		Where(append([]predicate.Comment{comment.ParentIDIsNil(), comment.LanguageEQ(lang)}, where...)...).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ids []uuid.UUID
+	for depth := 0; len(level) > 0; depth++ {
+		if depth >= maxThreadWalk {
+			return nil, fmt.Errorf("comment thread is too deep")
+		}
+		ids = append(ids, level...)
+		level, err = s.DB.Comment.Query().
+			Where(append([]predicate.Comment{comment.ParentIDIn(level...)}, where...)...).
+			IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return comment.IDIn(ids...), nil
+}
+
+// CommentLanguageThreads splits comments into threads by language. A
+// thread is in the language of its top-level comment, and replies stay in
+// their thread whatever language they are written in; InCommentLanguage
+// picks the threads of one language in the query. With group, the threads
+// are ordered by language, most discussed first, keeping the order of
+// comments within each language, and the languages of comments are
+// counted. Comments are expected in list order. The languages of the
+// comments themselves are returned by comment ID.
+func CommentLanguageThreads(comments []*ent.Comment, group bool) ([]*ent.Comment, map[string]string, []types.CommentLanguageGroup) {
+	langs := DetectedLanguages(comments)
+	byID := make(map[uuid.UUID]*ent.Comment, len(comments))
+	for _, c := range comments {
+		byID[c.ID] = c
+	}
+	// threadLang is the language of the top-level comment above c
+	threadLang := func(c *ent.Comment) string {
+		seen := map[uuid.UUID]bool{c.ID: true}
+		for c.ParentID != uuid.Nil && !seen[c.ParentID] {
+			parent, ok := byID[c.ParentID]
+			if !ok {
+				break
+			}
+			seen[parent.ID] = true
+			c = parent
+		}
+		return langs[c.ID.String()]
+	}
+	threads := make(map[string]string, len(comments))
+	for _, c := range comments {
+		threads[c.ID.String()] = threadLang(c)
+	}
+
+	var groups []types.CommentLanguageGroup
+	if group {
+		index := map[string]int{}
+		for _, c := range comments {
+			lang := threads[c.ID.String()]
+			i, ok := index[lang]
+			if !ok {
+				i = len(groups)
+				index[lang] = i
+				groups = append(groups, types.CommentLanguageGroup{Language: lang})
+			}
+			groups[i].Comments++
+			if c.ParentID == uuid.Nil {
+				groups[i].Threads++
+			}
+		}
+		sort.SliceStable(groups, func(i, j int) bool {
+			if groups[i].Threads != groups[j].Threads {
+				return groups[i].Threads > groups[j].Threads
+			}
+			return groups[i].Language < groups[j].Language
+		})
+	}
+
+	kept := comments
+	if group {
+		rank := make(map[string]int, len(groups))
+		for i, g := range groups {
+			rank[g.Language] = i
+		}
+		kept = append([]*ent.Comment(nil), kept...)
+		sort.SliceStable(kept, func(i, j int) bool {
+			return rank[threads[kept[i].ID.String()]] < rank[threads[kept[j].ID.String()]]
+		})
+	}
+	return kept, langs, groups
+}
@@ -0,0 +1,67 @@
+package svc
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"silan-backend/internal/commentlang"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+
+	"github.com/google/uuid"
+)
+
+func TestInCommentLanguageKeepsThreads(t *testing.T) {
+	s := newTestContext(t)
+	ctx := context.Background()
+	post := uuid.New()
+	create := func(parent *ent.Comment, content string) *ent.Comment {
+		t.Helper()
+		q := s.DB.Comment.Create().SetEntityType("blog").SetEntityID(post).
+			SetAuthorName("A").SetAuthorEmail("a@example.com").
+			SetContent(content).SetLanguage(commentlang.Detect(content)).SetIsApproved(true)
+		if parent != nil {
+			q.SetParentID(parent.ID)
+		}
+		c, err := q.Save(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return c
+	}
+	en := create(nil, "Great post, thanks")
+	zhReply := create(en, "同意，写得很好")
+	enNested := create(zhReply, "Agreed")
+	zh := create(nil, "这篇文章很有帮助")
+	create(zh, "Nice one")
+
+	inLanguage, err := s.InCommentLanguage(ctx, " EN ", comment.EntityIDEQ(post))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids, err := s.DB.Comment.Query().Where(inLanguage).IDs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []uuid.UUID{en.ID, zhReply.ID, enNested.ID}
+	sort.Slice(ids, func(i, j int) bool { return ids[i].String() < ids[j].String() })
+	sort.Slice(want, func(i, j int) bool { return want[i].String() < want[j].String() })
+	if len(ids) != len(want) {
+		t.Fatalf("got %v, want the English thread %v", ids, want)
+	}
+	for i := range ids {
+		if ids[i] != want[i] {
+			t.Fatalf("got %v, want the English thread %v", ids, want)
+		}
+	}
+
+	all, err := s.DB.Comment.Query().Where(comment.EntityIDEQ(post)).Order(CommentOrder("oldest")...).All(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, groups := CommentLanguageThreads(all, true)
+	if len(groups) != 2 || groups[0].Language != "en" || groups[0].Comments != 3 || groups[1].Language != "zh" || groups[1].Comments != 2 {
+		t.Fatalf("groups: %+v", groups)
+	}
+}
@@ -0,0 +1,46 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"silan-backend/internal/ratelimit"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// CheckCommentRate counts a new comment against the per-fingerprint and
+// per-IP caps and returns a *ratelimit.Error once either is used up. The
+// counter table failing lets the comment through, so it never takes
+// comments down.
+func (s *ServiceContext) CheckCommentRate(ctx context.Context, ip, fingerprint string) error {
+	window := time.Duration(s.Config.Abuse.CommentWindowMinutes) * time.Minute
+	if window <= 0 {
+		return nil
+	}
+	if fingerprint != "" {
+		fingerprint = s.Fingerprints.Hash(ctx, fingerprint)
+	}
+
+	now := time.Now()
+	checks := []struct {
+		key  string
+		rule ratelimit.Rule
+	}{
+		{fingerprint, ratelimit.Rule{Scope: "fingerprint", Limit: s.Config.Abuse.CommentsPerFingerprint, Window: window}},
+		{ip, ratelimit.Rule{Scope: "ip", Limit: s.Config.Abuse.CommentsPerIP, Window: window}},
+	}
+	for _, c := range checks {
+		err := s.RateLimits.Hit(ctx, "comment", c.key, c.rule, now)
+		var limitErr *ratelimit.Error
+		if errors.As(err, &limitErr) {
+			limitErr.Message = "too many comments, try again later"
+			return limitErr
+		}
+		if err != nil {
+			logx.WithContext(ctx).Errorf("Failed to check comment rate limit: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,20 @@
+package svc
+
+import (
+	"entgo.io/ent/dialect/sql"
+
+	"silan-backend/internal/ent/comment"
+)
+
+// CommentOrder returns the ordering for a comment list's sort parameter:
+// newest, oldest or most_liked. Anything else keeps the chronological
+// order. Replies are ordered the same way within their thread.
+func CommentOrder(sort string) []comment.OrderOption {
+	switch sort {
+	case "newest":
+		return []comment.OrderOption{comment.ByCreatedAt(sql.OrderDesc()), comment.ByID(sql.OrderDesc())}
+	case "most_liked":
+		return []comment.OrderOption{comment.ByLikesCount(sql.OrderDesc()), comment.ByCreatedAt(), comment.ByID()}
+	}
+	return []comment.OrderOption{comment.ByCreatedAt(), comment.ByID()}
+}
@@ -0,0 +1,103 @@
+package svc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/mail"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// commentCodesPerHour caps verification emails for one comment.
+const commentCodesPerHour = 3
+
+// ErrTooManyVerificationCodes is returned once a comment or network has
+// asked for too many verification codes in the current hour.
+var ErrTooManyVerificationCodes = errors.New("too many verification codes requested, try again later")
+
+// RequestCommentVerification mails a verification code for the comment
+// commentID to its author and returns when the code expires. email must be
+// the address the comment was written with; for any other address, and for
+// comments that are already verified, nothing is sent but the result looks
+// the same, so the endpoint can't be used to guess commenters' addresses.
+// Codes share the sign-in email budget of the IP subnet.
+func (s *ServiceContext) RequestCommentVerification(ctx context.Context, commentID uuid.UUID, email, ip string) (time.Time, error) {
+	if !s.Mailer.Enabled() {
+		return time.Time{}, mail.ErrDisabled
+	}
+	if ip != "" {
+		d := s.EmailLoginLimiter.Allow(ip, "")
+		if d.Flagged {
+			s.Audit(ctx, AuditEmailLoginRateLimited, "comment_verification", ip, map[string]any{
+				"subnet": d.Subnet,
+				"codes":  d.Count,
+				"limit":  s.Config.Auth.EmailCodesPerSubnetHour,
+			})
+		}
+		if !d.Allowed {
+			return time.Time{}, ErrTooManyVerificationCodes
+		}
+	}
+
+	ttl := time.Duration(s.Config.Auth.EmailCodeTTLMinutes) * time.Minute
+	expiresAt := time.Now().UTC().Add(ttl)
+	if err := s.Legacy.CopyComment(ctx, commentID.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to copy legacy comment %s: %v", commentID, err)
+	}
+	c, err := s.DB.Comment.Get(ctx, commentID)
+	if ent.IsNotFound(err) {
+		return time.Time{}, fmt.Errorf("comment not found")
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !strings.EqualFold(strings.TrimSpace(email), c.AuthorEmail) {
+		return expiresAt, nil
+	}
+	if c.EmailVerified {
+		return expiresAt, nil
+	}
+
+	n, err := s.CommentVerifications.CountSince(ctx, c.ID.String(), time.Now().UTC().Add(-time.Hour))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if n >= commentCodesPerHour {
+		return time.Time{}, ErrTooManyVerificationCodes
+	}
+
+	code, expiresAt, err := s.CommentVerifications.Create(ctx, c.ID.String(), c.AuthorEmail, ttl)
+	if err != nil {
+		return time.Time{}, err
+	}
+	err = s.Mailer.Send(mail.Message{
+		To:      c.AuthorEmail,
+		Subject: "Your comment verification code: " + code,
+		Body: fmt.Sprintf("Your code to verify the email address of your comment is %s\n\n"+
+			"It expires in %d minutes. If this wasn't you, ignore this email.\n",
+			code, s.Config.Auth.EmailCodeTTLMinutes),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return expiresAt, nil
+}
+
+// ConfirmCommentVerification checks code against the latest verification
+// request of the comment commentID and marks the comment's address verified.
+func (s *ServiceContext) ConfirmCommentVerification(ctx context.Context, commentID uuid.UUID, code string) error {
+	if err := s.CommentVerifications.Confirm(ctx, commentID.String(), code); err != nil {
+		return err
+	}
+	err := s.DB.Comment.UpdateOneID(commentID).SetEmailVerified(true).Exec(ctx)
+	if ent.IsNotFound(err) {
+		return fmt.Errorf("comment not found")
+	}
+	return err
+}
@@ -0,0 +1,86 @@
+package svc
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
+
+	"github.com/google/uuid"
+)
+
+// CommentDraft is a comment about to be created, as far as duplicate
+// detection needs it.
+type CommentDraft struct {
+	EntityType  string
+	EntityID    uuid.UUID
+	ParentID    *uuid.UUID
+	IdentityID  string
+	Email       string
+	Fingerprint string
+	Content     string
+}
+
+// DuplicateComment returns the comment the same author posted in the same
+// thread within the duplicate window with the same content, or nil. Double
+// clicks and resubmits then return the stored comment instead of creating a
+// repeat. Content is compared by the hash of its normalized form, so case
+// and whitespace changes still count as the same comment.
+func (s *ServiceContext) DuplicateComment(ctx context.Context, d CommentDraft) (*ent.Comment, error) {
+	window := time.Duration(s.Config.Moderation.DuplicateWindowMinutes) * time.Minute
+	if window <= 0 {
+		return nil, nil
+	}
+
+	var author []predicate.Comment
+	if d.IdentityID != "" {
+		author = append(author, comment.UserIdentityIDEQ(d.IdentityID))
+	}
+	if d.Email != "" {
+		author = append(author, comment.AuthorEmailEqualFold(d.Email))
+	}
+	if d.Fingerprint != "" {
+		author = append(author, s.CommentByFingerprint(ctx, d.Fingerprint))
+	}
+	if len(author) == 0 {
+		return nil, nil
+	}
+
+	thread := comment.ParentIDIsNil()
+	if d.ParentID != nil {
+		thread = comment.ParentIDEQ(*d.ParentID)
+	}
+	recent, err := s.DB.Comment.Query().
+		Where(
+			comment.EntityTypeEQ(d.EntityType),
+			comment.EntityIDEQ(d.EntityID),
+			thread,
+			comment.Or(author...),
+			comment.CreatedAtGT(time.Now().Add(-window)),
+		).
+		Order(comment.ByCreatedAt(sql.OrderDesc())).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := contentHash(d.Content)
+	for _, c := range recent {
+		if contentHash(c.Content) == sum {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+// contentHash hashes comment content with case and runs of whitespace
+// folded.
+func contentHash(content string) [sha256.Size]byte {
+	return sha256.Sum256([]byte(strings.ToLower(strings.Join(strings.Fields(content), " "))))
+}
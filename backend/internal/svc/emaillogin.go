@@ -0,0 +1,125 @@
+package svc
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"silan-backend/internal/emaillogin"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/mail"
+
+	"github.com/google/uuid"
+)
+
+// AuditEmailLoginRateLimited is recorded the first time a subnet exceeds
+// the sign-in email cap within a window.
+const AuditEmailLoginRateLimited = "email_login_rate_limited"
+
+// emailCodesPerAddressHour caps sign-in emails to one address, so the form
+// can't be used to flood someone's inbox.
+const emailCodesPerAddressHour = 5
+
+// ErrTooManyLoginCodes is returned once an address or network has asked for
+// too many sign-in codes in the current hour.
+var ErrTooManyLoginCodes = errors.New("too many sign-in codes requested, try again later")
+
+// RequestEmailLogin mails a sign-in code and magic link to email and
+// returns when they expire.
+func (s *ServiceContext) RequestEmailLogin(ctx context.Context, email, ip string) (time.Time, error) {
+	login, code, token, err := s.newEmailCode(ctx, email, ip)
+	if err != nil {
+		return time.Time{}, err
+	}
+	link := strings.TrimRight(s.Config.Site.BaseURL, "/") + "/auth/email?token=" + url.QueryEscape(token)
+	err = s.Mailer.Send(mail.Message{
+		To:      login.Email,
+		Subject: "Your sign-in code: " + code,
+		Body: fmt.Sprintf("Your sign-in code is %s\n\nOr sign in by opening this link:\n%s\n\n"+
+			"The code and link expire in %d minutes. If this wasn't you, ignore this email.\n",
+			code, link, s.Config.Auth.EmailCodeTTLMinutes),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return login.ExpiresAt, nil
+}
+
+// newEmailCode creates a login request for email. Sign-in and data deletion
+// codes share the request limits of an address and network.
+func (s *ServiceContext) newEmailCode(ctx context.Context, email, ip string) (*emaillogin.Login, string, string, error) {
+	if !s.Mailer.Enabled() {
+		return nil, "", "", mail.ErrDisabled
+	}
+	if ip != "" {
+		d := s.EmailLoginLimiter.Allow(ip, "")
+		if d.Flagged {
+			s.Audit(ctx, AuditEmailLoginRateLimited, "email_login", ip, map[string]any{
+				"subnet": d.Subnet,
+				"codes":  d.Count,
+				"limit":  s.Config.Auth.EmailCodesPerSubnetHour,
+			})
+		}
+		if !d.Allowed {
+			return nil, "", "", ErrTooManyLoginCodes
+		}
+	}
+	n, err := s.EmailLogins.CountSince(ctx, email, time.Now().UTC().Add(-time.Hour))
+	if err != nil {
+		return nil, "", "", err
+	}
+	if n >= emailCodesPerAddressHour {
+		return nil, "", "", ErrTooManyLoginCodes
+	}
+
+	ttl := time.Duration(s.Config.Auth.EmailCodeTTLMinutes) * time.Minute
+	return s.EmailLogins.Create(ctx, email, ip, ttl)
+}
+
+// VerifyEmailLogin redeems a sign-in code (with its email) or a magic link
+// token and returns the email identity of the address, created on first
+// sign-in. The identity is linked like any other verified sign-in, so an
+// address that also signs in with Google resolves to one primary identity.
+func (s *ServiceContext) VerifyEmailLogin(ctx context.Context, email, code, token string) (*ent.UserIdentity, error) {
+	var err error
+	if token != "" {
+		email, err = s.EmailLogins.RedeemToken(ctx, token)
+	} else {
+		email, err = s.EmailLogins.RedeemCode(ctx, email, code)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ident, err := s.DB.UserIdentity.Query().
+		Where(useridentity.ProviderEQ("email"), useridentity.ExternalIDEQ(email)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		ident, err = s.DB.UserIdentity.Create().
+			SetID("u_" + strings.ReplaceAll(uuid.New().String(), "-", "")).
+			SetProvider("email").
+			SetExternalID(email).
+			SetEmail(email).
+			SetDisplayName(strings.SplitN(email, "@", 2)[0]).
+			SetAvatarURL(gravatarURL(email)).
+			SetVerified(true).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.CanonicalIdentity(ctx, ident)
+}
+
+// gravatarURL is the avatar of email identities, an identicon for addresses
+// without a Gravatar.
+func gravatarURL(email string) string {
+	sum := md5.Sum([]byte(email))
+	return "https://www.gravatar.com/avatar/" + hex.EncodeToString(sum[:]) + "?d=identicon"
+}
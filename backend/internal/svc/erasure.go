@@ -0,0 +1,436 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"silan-backend/internal/emaillogin"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/authevent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/commentlike"
+	"silan-backend/internal/ent/commentmention"
+	"silan-backend/internal/ent/identitylink"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/ent/projectlike"
+	"silan-backend/internal/ent/projectview"
+	"silan-backend/internal/ent/reaction"
+	entsession "silan-backend/internal/ent/session"
+	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/fingerprint"
+	"silan-backend/internal/mail"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/tombstone"
+	"silan-backend/internal/webhook"
+
+	"github.com/google/uuid"
+)
+
+// ErasedData counts what EraseIdentity and EraseEmail removed.
+type ErasedData struct {
+	// Comments were deleted; AnonymizedComments had replies from others and
+	// were blanked instead, like comments their authors delete
+	Comments           int
+	AnonymizedComments int
+	CommentLikes       int
+	ProjectLikes       int
+	Views              int
+	Reactions          int
+	PollVotes          int
+	Identities         int
+}
+
+// RequestDataDeletion mails a code confirming the deletion of the data of
+// email and returns when it expires. It is redeemed by EraseEmail.
+func (s *ServiceContext) RequestDataDeletion(ctx context.Context, email, ip string) (time.Time, error) {
+	login, code, _, err := s.newEmailCode(ctx, email, ip)
+	if err != nil {
+		return time.Time{}, err
+	}
+	err = s.Mailer.Send(mail.Message{
+		To:      login.Email,
+		Subject: "Confirm deleting your data: " + code,
+		Body: fmt.Sprintf("Someone asked to delete the comments, likes and accounts of this address on %s.\n\n"+
+			"To confirm, enter the code %s\n\n"+
+			"The code expires in %d minutes. If this wasn't you, ignore this email and nothing will be deleted.\n",
+			strings.TrimRight(s.Config.Site.BaseURL, "/"), code, s.Config.Auth.EmailCodeTTLMinutes),
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return login.ExpiresAt, nil
+}
+
+// EraseIdentity deletes the data of a signed-in visitor: that of the
+// identity, of the identities linked to it, and the comments left with
+// their verified email addresses. fingerprint is the visitor's browser, if
+// known, whose reading history goes too.
+func (s *ServiceContext) EraseIdentity(ctx context.Context, identityID, fingerprint string) (*ErasedData, error) {
+	ids, err := s.linkedIdentities(ctx, []string{identityID})
+	if err != nil {
+		return nil, err
+	}
+	idents, err := s.DB.UserIdentity.Query().
+		Where(useridentity.IDIn(ids...), useridentity.Verified(true), useridentity.EmailNEQ("")).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var emails []string
+	for _, ident := range idents {
+		emails = append(emails, emaillogin.Normalize(ident.Email))
+	}
+	return s.eraseData(ctx, ids, emails, fingerprint)
+}
+
+// EraseEmail redeems a code from RequestDataDeletion and deletes the data
+// of its address: the comments left with it, and the identities whose
+// verified email it is, with everything of theirs. fingerprint is as for
+// EraseIdentity.
+func (s *ServiceContext) EraseEmail(ctx context.Context, email, code, fingerprint string) (*ErasedData, error) {
+	email, err := s.EmailLogins.RedeemCode(ctx, email, code)
+	if err != nil {
+		return nil, err
+	}
+	idents, err := s.DB.UserIdentity.Query().
+		Where(useridentity.EmailEqualFold(email), useridentity.Verified(true)).
+		IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.linkedIdentities(ctx, idents)
+	if err != nil {
+		return nil, err
+	}
+	return s.eraseData(ctx, ids, []string{email}, fingerprint)
+}
+
+// linkedIdentities returns ids together with their primary identities and
+// every identity linked to those.
+func (s *ServiceContext) linkedIdentities(ctx context.Context, ids []string) ([]string, error) {
+	seen := map[string]bool{}
+	var all []string
+	for _, id := range ids {
+		primaryID, err := s.Accounts.Primary(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		linked, err := s.Accounts.Linked(ctx, primaryID)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range append([]string{id, primaryID}, linked...) {
+			if !seen[l] {
+				seen[l] = true
+				all = append(all, l)
+			}
+		}
+	}
+	return all, nil
+}
+
+// eraseData deletes, in one transaction, the comments of the identities ids
+// or left with emails, their likes, views, reactions and poll votes, and
+// the identities with their sessions and profiles. The reading history of
+// the browsers they liked, viewed, reacted or voted from, and of
+// fingerprint, goes too. Comments with replies from others stay as
+// tombstones. The sign-in log keeps its entries with the identity, address
+// and client blanked.
+func (s *ServiceContext) eraseData(ctx context.Context, ids, emails []string, fingerprint string) (*ErasedData, error) {
+	erased := &ErasedData{}
+	var who []predicate.Comment
+	if len(ids) > 0 {
+		who = append(who, comment.UserIdentityIDIn(ids...))
+	}
+	for _, e := range emails {
+		who = append(who, comment.AuthorEmailEqualFold(e))
+	}
+	if len(who) == 0 {
+		return erased, nil
+	}
+
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	removed, err := s.eraseRows(ctx, tx, who, ids, emails, s.Fingerprints.Hashes(ctx, fingerprint), erased)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	for _, id := range removed {
+		s.forgetComment(ctx, id)
+	}
+	return erased, nil
+}
+
+// eraseRows does the work of eraseData in tx and returns the comments it
+// removed. readers are fingerprint hashes whose reading history goes.
+func (s *ServiceContext) eraseRows(ctx context.Context, tx *ent.Tx, who []predicate.Comment, ids, emails, readers []string, erased *ErasedData) ([]uuid.UUID, error) {
+	if len(ids) > 0 {
+		fingerprints, err := s.identityFingerprints(ctx, tx, ids)
+		if err != nil {
+			return nil, err
+		}
+		readers = append(readers, fingerprints...)
+	}
+	if err := eraseReaders(ctx, tx, s.Rebind, readers); err != nil {
+		return nil, err
+	}
+
+	comments, err := tx.Comment.Query().Where(comment.Or(who...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Replies go before the comments they reply to, so whether a comment
+	// still has replies is known by the time it is reached
+	sort.Slice(comments, func(i, j int) bool { return comments[i].CreatedAt.After(comments[j].CreatedAt) })
+
+	var removed []uuid.UUID
+	gone := make(map[uuid.UUID]bool, len(comments))
+	now := time.Now().UTC()
+	for _, c := range comments {
+		replies, err := tx.Comment.Query().Where(comment.ParentIDEQ(c.ID)).IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		kept := false
+		for _, r := range replies {
+			if !gone[r] {
+				kept = true
+				break
+			}
+		}
+		if kept {
+			err = tombstone.Write(ctx, tx.Client(), c.ID, now)
+			erased.AnonymizedComments++
+		} else {
+			if _, err = tx.CommentLike.Delete().Where(commentlike.CommentIDEQ(c.ID)).Exec(ctx); err == nil {
+				err = tx.Comment.DeleteOneID(c.ID).Exec(ctx)
+			}
+			removed = append(removed, c.ID)
+			gone[c.ID] = true
+			erased.Comments++
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to delete comment %s: %w", c.ID, err)
+		}
+		if err := s.PublishEvent(ctx, tx, outbox.EventCommentDeleted, deletedCommentEvent(c)); err != nil {
+			return nil, fmt.Errorf("failed to record comment event: %w", err)
+		}
+		if err := s.forgetCommentData(ctx, tx, c.ID); err != nil {
+			return nil, err
+		}
+	}
+	if len(ids) == 0 {
+		return removed, s.eraseEmails(ctx, tx, emails)
+	}
+
+	commentLikes, err := tx.CommentLike.Query().Where(commentlike.UserIdentityIDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, like := range commentLikes {
+		if err := tx.CommentLike.DeleteOne(like).Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err := tx.Comment.UpdateOneID(like.CommentID).AddLikesCount(-1).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		erased.CommentLikes++
+	}
+	projectLikes, err := tx.ProjectLike.Query().Where(projectlike.UserIdentityIDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, like := range projectLikes {
+		if err := tx.ProjectLike.DeleteOne(like).Exec(ctx); err != nil {
+			return nil, err
+		}
+		if err := tx.Project.UpdateOneID(like.ProjectID).AddLikeCount(-1).Exec(ctx); err != nil && !ent.IsNotFound(err) {
+			return nil, err
+		}
+		erased.ProjectLikes++
+	}
+	if erased.Views, err = tx.ProjectView.Delete().Where(projectview.UserIdentityIDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if erased.Reactions, err = tx.Reaction.Delete().Where(reaction.UserIdentityIDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.CommentMention.Delete().Where(commentmention.UserIdentityIDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.Session.Delete().Where(entsession.IdentityIDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	if err := tx.AuthEvent.Update().
+		Where(authevent.IdentityIDIn(ids...)).
+		SetIdentityID("").SetEmail("").SetIP("").SetUserAgent("").
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	in, args := inList(ids)
+	deletes := []struct {
+		query string
+		count *int
+	}{
+		{`DELETE FROM poll_votes WHERE user_identity_id IN ` + in, &erased.PollVotes},
+		{`DELETE FROM analytics_events WHERE user_identity_id IN ` + in, nil},
+	}
+	for _, d := range deletes {
+		res, err := tx.ExecContext(ctx, s.Rebind(d.query), args...)
+		if err != nil {
+			return nil, err
+		}
+		if d.count != nil {
+			if n, err := res.RowsAffected(); err == nil {
+				*d.count = int(n)
+			}
+		}
+	}
+	if _, err := tx.IdentityLink.Delete().
+		Where(identitylink.Or(identitylink.IdentityIDIn(ids...), identitylink.PrimaryIDIn(ids...))).
+		Exec(ctx); err != nil {
+		return nil, err
+	}
+	if erased.Identities, err = tx.UserIdentity.Delete().Where(useridentity.IDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return removed, s.eraseEmails(ctx, tx, emails)
+}
+
+// identityFingerprints returns the hashed fingerprints of the browsers the
+// identities ids liked, viewed, reacted or voted from. Fingerprints stored
+// before hashing are hashed with every kept salt.
+func (s *ServiceContext) identityFingerprints(ctx context.Context, tx *ent.Tx, ids []string) ([]string, error) {
+	var fingerprints []string
+	collect := func(values []string, err error) error {
+		fingerprints = append(fingerprints, values...)
+		return err
+	}
+	err := collect(tx.CommentLike.Query().Where(commentlike.UserIdentityIDIn(ids...)).Select(commentlike.FieldFingerprint).Strings(ctx))
+	if err == nil {
+		err = collect(tx.ProjectLike.Query().Where(projectlike.UserIdentityIDIn(ids...)).Select(projectlike.FieldFingerprint).Strings(ctx))
+	}
+	if err == nil {
+		err = collect(tx.ProjectView.Query().Where(projectview.UserIdentityIDIn(ids...)).Select(projectview.FieldFingerprint).Strings(ctx))
+	}
+	if err == nil {
+		err = collect(tx.Reaction.Query().Where(reaction.UserIdentityIDIn(ids...)).Select(reaction.FieldFingerprint).Strings(ctx))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	in, args := inList(ids)
+	rows, err := tx.QueryContext(ctx, s.Rebind(`SELECT fingerprint FROM poll_votes WHERE user_identity_id IN `+in), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var fp sql.NullString
+		if err := rows.Scan(&fp); err != nil {
+			return nil, err
+		}
+		fingerprints = append(fingerprints, fp.String)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var hashes []string
+	for _, fp := range fingerprints {
+		if fp == "" || seen[fp] {
+			continue
+		}
+		seen[fp] = true
+		if fingerprint.IsHashed(fp) {
+			hashes = append(hashes, fp)
+		} else {
+			hashes = append(hashes, s.Fingerprints.Hashes(ctx, fp)...)
+		}
+	}
+	return hashes, nil
+}
+
+// eraseReaders deletes in tx the reading progress and daily reads stored
+// under the fingerprint hashes readers.
+func eraseReaders(ctx context.Context, tx *ent.Tx, rebind func(string) string, readers []string) error {
+	if len(readers) == 0 {
+		return nil
+	}
+	in, args := inList(readers)
+	for _, query := range []string{
+		`DELETE FROM reading_progress WHERE reader_hash IN ` + in,
+		`DELETE FROM daily_readers WHERE reader_hash IN ` + in,
+	} {
+		if _, err := tx.ExecContext(ctx, rebind(query), args...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// forgetCommentData deletes in tx what is kept about an erased comment
+// outside its row: its mentions, its events and the payloads of their
+// webhook deliveries.
+func (s *ServiceContext) forgetCommentData(ctx context.Context, tx *ent.Tx, id uuid.UUID) error {
+	driver := s.Config.Database.Driver
+	if _, err := tx.CommentMention.Delete().Where(commentmention.CommentID(id.String())).Exec(ctx); err != nil {
+		return err
+	}
+	if err := outbox.ForgetComment(ctx, tx, driver, id.String()); err != nil {
+		return err
+	}
+	return webhook.ForgetComment(ctx, tx, driver, id.String())
+}
+
+// eraseEmails deletes what is kept under the email addresses outside the
+// comments: subscriptions and their queued digests, confirmations, sign-in codes and project
+// inquiries. Content reports are kept for moderation with the reporter's
+// contact details blanked.
+func (s *ServiceContext) eraseEmails(ctx context.Context, tx *ent.Tx, emails []string) error {
+	if len(emails) == 0 {
+		return nil
+	}
+	in, args := inList(emails)
+	for _, query := range []string{
+		`DELETE FROM comment_digest_queue WHERE subscription_id IN
+		(SELECT id FROM comment_subscriptions WHERE LOWER(email) IN ` + in + `)`,
+		`DELETE FROM comment_subscriptions WHERE LOWER(email) IN ` + in,
+		`DELETE FROM comment_verifications WHERE LOWER(email) IN ` + in,
+		`DELETE FROM email_logins WHERE email IN ` + in,
+		`DELETE FROM project_inquiries WHERE LOWER(email) IN ` + in,
+		`UPDATE content_reports SET reporter_name = '', reporter_email = '', ip = '', fingerprint = ''
+		WHERE LOWER(reporter_email) IN ` + in,
+	} {
+		if _, err := tx.ExecContext(ctx, s.Rebind(query), args...); err != nil {
+			return err
+		}
+	}
+	// Events store addresses in lower case
+	return tx.AuthEvent.Update().
+		Where(authevent.EmailIn(emails...)).
+		SetIdentityID("").SetEmail("").SetIP("").SetUserAgent("").
+		Exec(ctx)
+}
+
+// inList returns a parenthesized placeholder list for values.
+func inList(values []string) (string, []any) {
+	args := make([]any, len(values))
+	for i, v := range values {
+		args[i] = v
+	}
+	return `(?` + strings.Repeat(`, ?`, len(values)-1) + `)`, args
+}
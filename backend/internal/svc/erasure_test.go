@@ -0,0 +1,195 @@
+package svc
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"silan-backend/internal/config"
+
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/zeromicro/go-zero/core/conf"
+)
+
+// newTestContext returns a service context on a fresh sqlite database.
+func newTestContext(t *testing.T) *ServiceContext {
+	t.Helper()
+	b, err := os.ReadFile("../../etc/backend-api.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the example config leaves the required auth section commented out
+	b = append(b, "\nAuth:\n  google_client_id: test-client\n  session_secret: test-session-secret\n"...)
+	var c config.Config
+	if err := conf.LoadFromYamlBytes(b, &c); err != nil {
+		t.Fatal(err)
+	}
+	c.Database.Driver = "sqlite3"
+	c.Database.Source = t.TempDir() + "/test.db?_fk=1"
+	s := NewServiceContext(c)
+	if err := s.DB.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// visitor is what is seeded for one visitor.
+type visitor struct {
+	identityID string
+	email      string
+	// likeFP is the browser the visitor liked from, browserFP the one
+	// asking for the erasure
+	likeFP, browserFP string
+}
+
+func seedVisitor(t *testing.T, s *ServiceContext, v visitor) {
+	t.Helper()
+	ctx := context.Background()
+	now := time.Now().UTC()
+	likeHash := s.Fingerprints.Hash(ctx, v.likeFP)
+	browserHash := s.Fingerprints.Hash(ctx, v.browserFP)
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	must(s.DB.UserIdentity.Create().SetID(v.identityID).SetProvider("google").SetExternalID(v.identityID).
+		SetEmail(v.email).SetVerified(true).Exec(ctx))
+	// a second sign-in with the same email, linked to the first
+	must(s.DB.UserIdentity.Create().SetID(v.identityID + "-github").SetProvider("github").SetExternalID(v.identityID).
+		SetEmail(v.email).SetVerified(true).Exec(ctx))
+	must(s.DB.IdentityLink.Create().SetIdentityID(v.identityID + "-github").SetPrimaryID(v.identityID).Exec(ctx))
+	owner, err := s.DB.User.Create().SetUsername("owner-" + v.identityID).SetEmail("owner-" + v.email).
+		SetPasswordHash("x").SetFirstName("O").SetLastName("W").Save(ctx)
+	must(err)
+	project, err := s.DB.Project.Create().SetUserID(owner.ID).SetTitle("P").SetSlug("p-" + v.identityID).Save(ctx)
+	must(err)
+	commentID := uuid.New()
+	must(s.DB.Comment.Create().SetID(commentID).SetEntityType("blog").SetEntityID(uuid.New()).
+		SetAuthorName("A").SetAuthorEmail(v.email).SetContent("hello").SetUserIdentityID(v.identityID).Exec(ctx))
+	must(s.DB.CommentLike.Create().SetCommentID(uuid.New()).SetUserIdentityID(v.identityID).SetFingerprint(likeHash).Exec(ctx))
+	must(s.DB.ProjectLike.Create().SetProjectID(project.ID).SetUserIdentityID(v.identityID).SetFingerprint(likeHash).Exec(ctx))
+	must(s.DB.ProjectView.Create().SetProjectID(project.ID).SetUserIdentityID(v.identityID).SetFingerprint(likeHash).Exec(ctx))
+	must(s.DB.Reaction.Create().SetID(uuid.NewString()).SetEntityType("blog").SetEntityID(uuid.NewString()).
+		SetEmoji("👍").SetUserIdentityID(v.identityID).SetFingerprint(likeHash).Exec(ctx))
+	must(s.DB.CommentMention.Create().SetID(uuid.NewString()).SetCommentID(uuid.NewString()).SetUserIdentityID(v.identityID).Exec(ctx))
+	_, _, err = s.Sessions.Create(ctx, v.identityID, "google", "ua", "1.2.3.4", time.Hour)
+	must(err)
+	must(s.DB.AuthEvent.Create().SetID(uuid.NewString()).SetType("login").SetIdentityID(v.identityID).
+		SetEmail(v.email).SetIP("1.2.3.4").SetOutcome("success").Exec(ctx))
+
+	for _, q := range []struct {
+		query string
+		args  []any
+	}{
+		{`INSERT INTO poll_votes (poll_id, option_id, voter_key, fingerprint, user_identity_id, ip, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			[]any{"p", "o", "user:" + v.identityID, likeHash, v.identityID, "1.2.3.4", now}},
+		{`INSERT INTO analytics_events (name, fingerprint, user_identity_id, created_at) VALUES (?, ?, ?, ?)`,
+			[]any{"view", likeHash, v.identityID, now}},
+		{`INSERT INTO reading_progress (post_id, reader_hash, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+			[]any{"post", likeHash, now, now}},
+		{`INSERT INTO reading_progress (post_id, reader_hash, created_at, updated_at) VALUES (?, ?, ?, ?)`,
+			[]any{"post", browserHash, now, now}},
+		{`INSERT INTO daily_readers (post_id, day, reader_hash) VALUES (?, ?, ?)`,
+			[]any{"post", now.Format("2006-01-02"), likeHash}},
+		{`INSERT INTO daily_readers (post_id, day, reader_hash) VALUES (?, ?, ?)`,
+			[]any{"post", now.Format("2006-01-02"), browserHash}},
+		{`INSERT INTO comment_subscriptions (id, thread_id, entity_type, entity_id, email, token, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			[]any{v.identityID, "t", "blog", "e", v.email, v.identityID, now}},
+		{`INSERT INTO comment_digest_queue (subscription_id, comment_id, queued_at) VALUES (?, ?, ?)`,
+			[]any{v.identityID, commentID.String(), now}},
+		{`INSERT INTO comment_verifications (id, comment_id, email, code_hash, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			[]any{v.identityID, commentID.String(), v.email, "h", now, now}},
+		{`INSERT INTO email_logins (id, email, code_hash, token_hash, created_at, expires_at) VALUES (?, ?, ?, ?, ?, ?)`,
+			[]any{v.identityID, v.email, "h", v.identityID, now, now}},
+		{`INSERT INTO project_inquiries (id, project_id, project_title, name, email, budget, timeline, message, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{v.identityID, "p", "P", "A", v.email, "b", "t", "m", now}},
+		{`INSERT INTO content_reports (id, content_type, content_id, content_title, category, details, reporter_name, reporter_email, created_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			[]any{v.identityID, "comment", "c", "C", "spam", "d", "A", v.email, now}},
+	} {
+		if _, err := s.RawDB.Exec(s.Rebind(q.query), q.args...); err != nil {
+			t.Fatalf("%s: %v", q.query, err)
+		}
+	}
+}
+
+// visitorRows counts, per table, the rows that belong to v.
+func visitorRows(t *testing.T, s *ServiceContext, v visitor) map[string]int {
+	t.Helper()
+	ctx := context.Background()
+	hashes := append(s.Fingerprints.Hashes(ctx, v.likeFP), s.Fingerprints.Hashes(ctx, v.browserFP)...)
+	in, fps := inList(hashes)
+	id, email := []any{v.identityID}, []any{v.email}
+	counts := map[string]int{}
+	for _, q := range []struct {
+		table, where string
+		args         []any
+	}{
+		{"user_identities", `id = ?`, id},
+		{"identity_links", `primary_id = ?`, id},
+		{"comments", `user_identity_id = ? OR author_email = ?`, []any{v.identityID, v.email}},
+		{"comment_likes", `user_identity_id = ?`, id},
+		{"project_likes", `user_identity_id = ?`, id},
+		{"project_views", `user_identity_id = ?`, id},
+		{"reactions", `user_identity_id = ?`, id},
+		{"comment_mentions", `user_identity_id = ?`, id},
+		{"sessions", `identity_id = ?`, id},
+		{"auth_events", `identity_id = ? OR email = ?`, []any{v.identityID, v.email}},
+		{"poll_votes", `user_identity_id = ?`, id},
+		{"analytics_events", `user_identity_id = ?`, id},
+		{"reading_progress", `reader_hash IN ` + in, fps},
+		{"daily_readers", `reader_hash IN ` + in, fps},
+		{"comment_subscriptions", `email = ?`, email},
+		{"comment_digest_queue", `subscription_id = ?`, id},
+		{"comment_verifications", `email = ?`, email},
+		{"email_logins", `email = ?`, email},
+		{"project_inquiries", `email = ?`, email},
+		{"content_reports", `reporter_email = ?`, email},
+	} {
+		var n int
+		if err := s.RawDB.QueryRow(s.Rebind(`SELECT COUNT(*) FROM `+q.table+` WHERE `+q.where), q.args...).Scan(&n); err != nil {
+			t.Fatalf("%s: %v", q.table, err)
+		}
+		counts[q.table] = n
+	}
+	return counts
+}
+
+func TestEraseIdentityEmptiesVisitorTables(t *testing.T) {
+	s := newTestContext(t)
+	ctx := context.Background()
+	erased := visitor{identityID: "u_1", email: "a@example.com", likeFP: "fp-like-1", browserFP: "fp-browser-1"}
+	kept := visitor{identityID: "u_2", email: "b@example.com", likeFP: "fp-like-2", browserFP: "fp-browser-2"}
+	seedVisitor(t, s, erased)
+	seedVisitor(t, s, kept)
+
+	for table, n := range visitorRows(t, s, erased) {
+		if n == 0 {
+			t.Fatalf("%s: nothing seeded", table)
+		}
+	}
+	if _, err := s.EraseIdentity(ctx, erased.identityID, erased.browserFP); err != nil {
+		t.Fatal(err)
+	}
+
+	for table, n := range visitorRows(t, s, erased) {
+		if n != 0 {
+			t.Errorf("%s: %d rows of the erased visitor left", table, n)
+		}
+	}
+	// content reports are kept, blanked, for moderation
+	var reports int
+	s.RawDB.QueryRow(`SELECT COUNT(*) FROM content_reports`).Scan(&reports)
+	if reports != 2 {
+		t.Errorf("content_reports: %d rows, want both kept", reports)
+	}
+	for table, n := range visitorRows(t, s, kept) {
+		if n == 0 {
+			t.Errorf("%s: rows of another visitor were erased", table)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package svc
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"silan-backend/internal/outbox"
+)
+
+// AnalyticsEvent is a custom event recorded into the analytics_events table.
+type AnalyticsEvent struct {
+	Name           string
+	Fingerprint    string
+	UserIdentityID string
+	Path           string
+	Properties     map[string]string
+	IP             string
+	UserAgent      string
+}
+
+// RecordEvent stores a custom analytics event, with the fingerprint hashed.
+// It is shared by the public events endpoint and by modules that emit their
+// own events (experiments, ...).
+func (s *ServiceContext) RecordEvent(ctx context.Context, ev AnalyticsEvent) error {
+	var props string
+	if len(ev.Properties) > 0 {
+		b, err := json.Marshal(ev.Properties)
+		if err != nil {
+			return err
+		}
+		props = string(b)
+	}
+
+	_, err := s.RawDB.ExecContext(ctx, s.Rebind(
+		`INSERT INTO analytics_events (name, fingerprint, user_identity_id, path, properties, ip, user_agent, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		ev.Name, s.Fingerprints.Hash(ctx, ev.Fingerprint), ev.UserIdentityID, ev.Path, props, ev.IP, ev.UserAgent, time.Now().UTC(),
+	)
+	return err
+}
+
+// PublishEvent writes a domain event to events_outbox through ex, which
+// should be the transaction of the write the event describes. The outbox
+// relay delivers it once the transaction has committed. The matching entry
+// of the activity log is written in the same transaction.
+func (s *ServiceContext) PublishEvent(ctx context.Context, ex outbox.Execer, eventType string, data any) error {
+	if err := s.Activity.Record(ctx, ex, eventType, data); err != nil {
+		return err
+	}
+	return outbox.Write(ctx, ex, s.Config.Database.Driver, eventType, data)
+}
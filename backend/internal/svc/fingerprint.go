@@ -0,0 +1,50 @@
+package svc
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
+)
+
+// CommentUserAgent builds the user_agent stored with a comment: the hashed
+// fingerprint as "fp:<hash>" followed by " | <browser>" when known.
+func (s *ServiceContext) CommentUserAgent(ctx context.Context, fingerprint, userAgent string) string {
+	tag := "fp:" + s.Fingerprints.Hash(ctx, fingerprint)
+	if userAgent != "" {
+		tag += " | " + userAgent
+	}
+	return tag
+}
+
+// CommentByFingerprint matches the comments written from the browser with
+// fingerprint under any of the salts still kept.
+func (s *ServiceContext) CommentByFingerprint(ctx context.Context, fingerprint string) predicate.Comment {
+	return fingerprintTagged(s.Fingerprints.Hashes(ctx, fingerprint))
+}
+
+// IsCommentAuthor matches the signed-in identity, or else the browser
+// fingerprint stored with the comment.
+func (s *ServiceContext) IsCommentAuthor(ctx context.Context, c *ent.Comment, identityID, fingerprint string) bool {
+	if identityID != "" && c.UserIdentityID == identityID {
+		return true
+	}
+	for _, hash := range s.Fingerprints.Hashes(ctx, fingerprint) {
+		if c.UserAgent == "fp:"+hash || strings.HasPrefix(c.UserAgent, "fp:"+hash+" | ") {
+			return true
+		}
+	}
+	return false
+}
+
+// fingerprintTagged matches comments whose user_agent carries one of the
+// hashed fingerprints.
+func fingerprintTagged(hashes []string) predicate.Comment {
+	ps := make([]predicate.Comment, 0, 2*len(hashes))
+	for _, hash := range hashes {
+		ps = append(ps, comment.UserAgentEQ("fp:"+hash), comment.UserAgentHasPrefix("fp:"+hash+" | "))
+	}
+	return comment.Or(ps...)
+}
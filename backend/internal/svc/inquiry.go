@@ -0,0 +1,35 @@
+package svc
+
+import (
+	"context"
+	"errors"
+)
+
+// AuditInquiryRateLimited is recorded the first time a subnet exceeds the
+// inquiry cap within a window.
+const AuditInquiryRateLimited = "inquiry_rate_limited"
+
+// ErrTooManyInquiries is returned to visitors whose network has used up its
+// inquiry allowance for the current window.
+var ErrTooManyInquiries = errors.New("too many inquiries from your network, try again later")
+
+// CheckInquiry applies the per-subnet cap to a new project inquiry.
+func (s *ServiceContext) CheckInquiry(ctx context.Context, ip, fingerprint string) error {
+	if ip == "" {
+		return nil
+	}
+
+	d := s.InquiryLimiter.Allow(ip, fingerprint)
+	if d.Flagged {
+		s.Audit(ctx, AuditInquiryRateLimited, "inquiry", ip, map[string]any{
+			"subnet":       d.Subnet,
+			"inquiries":    d.Count,
+			"fingerprints": d.Fingerprints,
+			"limit":        s.Config.Abuse.InquiriesPerSubnetHour,
+		})
+	}
+	if !d.Allowed {
+		return ErrTooManyInquiries
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package svc
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/personalinfo"
+	"silan-backend/internal/ent/sociallink"
+	"silan-backend/internal/mapper"
+	"silan-backend/internal/types"
+)
+
+// OwnerJsonLd returns the schema.org Person of the site owner, built from
+// the primary personal info, for use on its own and as the author of posts
+// and projects.
+func (s *ServiceContext) OwnerJsonLd(ctx context.Context) (types.PersonJsonLd, error) {
+	info, err := s.DB.PersonalInfo.Query().
+		Order(ent.Desc(personalinfo.FieldIsPrimary)).
+		WithUser().
+		First(ctx)
+	if err != nil {
+		return types.PersonJsonLd{}, err
+	}
+
+	links, err := s.DB.SocialLink.Query().
+		Where(sociallink.PersonalInfoID(info.ID)).
+		Order(ent.Asc(sociallink.FieldSortOrder)).
+		All(ctx)
+	if err != nil {
+		return types.PersonJsonLd{}, err
+	}
+	return mapper.PersonJsonLd(info, links, strings.TrimRight(s.Config.Site.BaseURL, "/")), nil
+}
@@ -0,0 +1,56 @@
+package svc
+
+import (
+	"context"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/media"
+	"silan-backend/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// HeroVariants returns the responsive variants of the posts' hero images,
+// keyed by post ID. Variants rendered from an earlier hero image are left
+// out.
+func (s *ServiceContext) HeroVariants(ctx context.Context, posts ...*ent.BlogPost) (map[string][]types.ImageVariant, error) {
+	sources := map[string]string{}
+	for _, p := range posts {
+		if p.FeaturedImageURL != "" {
+			sources[p.ID.String()] = p.FeaturedImageURL
+		}
+	}
+	variants, err := s.ImageVariants.Variants(ctx, media.OwnerPost, sources)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string][]types.ImageVariant, len(variants))
+	for id, vs := range variants {
+		result[id] = ToImageVariants(vs)
+	}
+	return result, nil
+}
+
+// ToImageVariants converts stored variants for responses.
+func ToImageVariants(vs []media.Variant) []types.ImageVariant {
+	out := make([]types.ImageVariant, 0, len(vs))
+	for _, v := range vs {
+		out = append(out, types.ImageVariant{URL: v.URL, Width: v.Width, Format: v.Format})
+	}
+	return out
+}
+
+// AttachAudio fills in the narration audio of a post detail, if it has
+// any. Lookup failures are logged and show none.
+func (s *ServiceContext) AttachAudio(ctx context.Context, data *types.BlogData) {
+	a, err := s.Audio.Get(ctx, media.OwnerPost, data.ID)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to look up audio of post %s: %v", data.ID, err)
+		return
+	}
+	if a != nil {
+		data.AudioURL = a.URL
+		data.AudioType = a.ContentType
+		data.AudioDuration = a.DurationSeconds
+	}
+}
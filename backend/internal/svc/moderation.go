@@ -0,0 +1,174 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/outbox"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// commentKind maps a comment entity type (blog, idea_general, project_...)
+// to the content kind used in the moderation config.
+func commentKind(entityType string) string {
+	switch {
+	case strings.HasPrefix(entityType, "idea"):
+		return "idea"
+	case strings.HasPrefix(entityType, "project"):
+		return "project"
+	}
+	return entityType
+}
+
+// HoldComment reports whether a new comment on entityType has to wait for
+// approval under the first-time commenter rule. Signed-in authors are
+// trusted once their identity has an approved comment; anonymous authors
+// need both their email and their browser fingerprint to appear on approved
+// comments, so typing a known address from a new browser is still held.
+func (s *ServiceContext) HoldComment(ctx context.Context, entityType, identityID, email, fingerprint string) (bool, error) {
+	enabled := false
+	for _, kind := range s.Config.Moderation.HoldFirstComment {
+		if kind == commentKind(entityType) {
+			enabled = true
+			break
+		}
+	}
+	if !enabled {
+		return false, nil
+	}
+
+	if identityID != "" {
+		known, err := s.hasApprovedComment(ctx, comment.UserIdentityIDEQ(identityID))
+		return !known, err
+	}
+	if email == "" || fingerprint == "" {
+		return true, nil
+	}
+
+	knownEmail, err := s.hasApprovedComment(ctx, comment.AuthorEmailEqualFold(email))
+	if err != nil || !knownEmail {
+		return true, err
+	}
+	knownFingerprint, err := s.hasApprovedComment(ctx, s.CommentByFingerprint(ctx, fingerprint))
+	return !knownFingerprint, err
+}
+
+func (s *ServiceContext) hasApprovedComment(ctx context.Context, p predicate.Comment) (bool, error) {
+	return s.DB.Comment.Query().
+		Where(comment.IsApproved(true), p).
+		Exist(ctx)
+}
+
+// CommentLinkTarget returns the comment a moderation link token taking
+// action was signed for.
+func (s *ServiceContext) CommentLinkTarget(token, action string) (uuid.UUID, error) {
+	id, err := commentnotify.VerifyLink([]byte(s.Config.Moderation.Notify.LinkSecret), token, action, time.Now())
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(id)
+}
+
+// CommentLinkPage returns the page confirming the action of a moderation
+// link token.
+func (s *ServiceContext) CommentLinkPage(ctx context.Context, token, action string) ([]byte, error) {
+	id, err := s.CommentLinkTarget(token, action)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Legacy.CopyComment(ctx, id.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to copy legacy comment %s: %v", id, err)
+	}
+	c, err := s.DB.Comment.Query().Where(comment.IDEQ(id), comment.IsApproved(false)).Only(ctx)
+	if ent.IsNotFound(err) {
+		// Approved, rejected or deleted in the meantime
+		c, err = nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return commentnotify.ConfirmPage(action, c, token)
+}
+
+// ApproveComment approves a held comment. Approval is recorded together
+// with its outbox event so that reply notifications go out once the comment
+// becomes visible.
+func (s *ServiceContext) ApproveComment(ctx context.Context, id uuid.UUID) (*ent.Comment, error) {
+	if err := s.Legacy.CopyComment(ctx, id.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to copy legacy comment %s: %v", id, err)
+	}
+
+	tx, err := s.DB.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	c, err := tx.Comment.Query().Where(comment.IDEQ(id), comment.IsApproved(false)).Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	c, err = c.Update().SetIsApproved(true).Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if err := s.PublishEvent(ctx, tx, outbox.EventCommentApproved, outbox.NewCommentEvent(c)); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to record comment event: %w", err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return c, nil
+}
+
+// RejectComment deletes a held comment and its held replies. It returns the
+// comment and how many comments were removed.
+func (s *ServiceContext) RejectComment(ctx context.Context, id uuid.UUID) (*ent.Comment, int, error) {
+	if err := s.Legacy.CopyComment(ctx, id.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to copy legacy comment %s: %v", id, err)
+	}
+
+	c, err := s.DB.Comment.Query().Where(comment.IDEQ(id), comment.IsApproved(false)).Only(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	n, err := s.deleteHeld(ctx, c.ID)
+	return c, n, err
+}
+
+// deleteHeld deletes a held comment and, first, its held replies. Replies
+// only exist while the thread is hidden, so all of them are still held.
+func (s *ServiceContext) deleteHeld(ctx context.Context, id uuid.UUID) (int, error) {
+	replies, err := s.DB.Comment.Query().
+		Where(comment.ParentIDEQ(id), comment.IsApproved(false)).
+		IDs(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find replies: %v", err)
+	}
+
+	deleted := 0
+	for _, reply := range replies {
+		n, err := s.deleteHeld(ctx, reply)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+
+	if err := s.DB.Comment.DeleteOneID(id).Exec(ctx); err != nil {
+		return deleted, fmt.Errorf("failed to delete comment %s: %v", id, err)
+	}
+	if err := s.SpamScores.Delete(ctx, id.String()); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to delete spam score of comment %s: %v", id, err)
+	}
+	return deleted + 1, nil
+}
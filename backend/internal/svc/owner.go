@@ -0,0 +1,65 @@
+package svc
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/useridentity"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// IsOwnerIdentity reports whether identityID belongs to the site owner
+// configured in Owner: it is one of the owner's identity IDs, or a verified
+// identity whose email is one of the owner's addresses. identityID must come
+// from a verified session or ID token. A failed lookup is logged and counts
+// as not the owner.
+func (s *ServiceContext) IsOwnerIdentity(ctx context.Context, identityID string) bool {
+	if identityID == "" {
+		return false
+	}
+	for _, id := range s.Config.Owner.IdentityIDs {
+		if identityID == id {
+			return true
+		}
+	}
+	if len(s.Config.Owner.Emails) == 0 {
+		return false
+	}
+	ident, err := s.DB.UserIdentity.Query().
+		Where(useridentity.ID(identityID), useridentity.Verified(true)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return false
+	}
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to look up identity %s: %v", identityID, err)
+		return false
+	}
+	for _, email := range s.Config.Owner.Emails {
+		if ident.Email != "" && strings.EqualFold(strings.TrimSpace(email), ident.Email) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOwnerComment reports whether c was written by the site owner. The flag
+// is stored with the comment when it is created, see IsOwnerIdentity;
+// comments from before it was stored are matched by the owner's identity
+// IDs. An email address alone never counts, since anyone can type it.
+func (s *ServiceContext) IsOwnerComment(c *ent.Comment) bool {
+	if c.IsAuthor {
+		return true
+	}
+	if c.UserIdentityID == "" {
+		return false
+	}
+	for _, id := range s.Config.Owner.IdentityIDs {
+		if c.UserIdentityID == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,9 @@
+package svc
+
+import "silan-backend/internal/preview"
+
+// CanPreview reports whether token grants access to the unpublished
+// content of the given kind and id.
+func (s *ServiceContext) CanPreview(token, kind, id string) bool {
+	return preview.Allows([]byte(s.Config.Preview.Secret), token, kind, id)
+}
@@ -0,0 +1,48 @@
+package svc
+
+import (
+	"fmt"
+	"time"
+
+	"silan-backend/internal/config"
+	"silan-backend/internal/publishing"
+)
+
+// CheckPublish returns a *publishing.BlockedError while the publishing
+// config doesn't allow content to go live.
+func (s *ServiceContext) CheckPublish() error {
+	return s.PublishPolicy.Check(time.Now())
+}
+
+// newPublishPolicy builds the publishing policy from its config.
+func newPublishPolicy(c config.PublishingConfig) (*publishing.Policy, error) {
+	p := &publishing.Policy{Frozen: c.Freeze, FreezeReason: c.FreezeReason}
+	for field, value := range map[string]string{"freeze_from": c.FreezeFrom, "freeze_until": c.FreezeUntil} {
+		if value == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be an RFC 3339 time", field)
+		}
+		if field == "freeze_from" {
+			p.FreezeFrom = t
+		} else {
+			p.FreezeUntil = t
+		}
+	}
+
+	loc, err := time.LoadLocation(c.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone: %w", err)
+	}
+	p.Location = loc
+	for i, w := range c.Windows {
+		window, err := publishing.ParseWindow(w.Days, w.Start, w.End)
+		if err != nil {
+			return nil, fmt.Errorf("window %d: %w", i+1, err)
+		}
+		p.Windows = append(p.Windows, window)
+	}
+	return p, nil
+}
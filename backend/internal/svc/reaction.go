@@ -0,0 +1,37 @@
+package svc
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+
+	"github.com/google/uuid"
+)
+
+// ErrReactionTargetNotFound is returned for reactions on content that
+// doesn't exist or isn't visible.
+var ErrReactionTargetNotFound = errors.New("content not found")
+
+// CheckReactionTarget makes sure entityID names an approved comment, a
+// published post or a public project, depending on entityType.
+func (s *ServiceContext) CheckReactionTarget(ctx context.Context, entityType, entityID string) error {
+	id, err := uuid.Parse(entityID)
+	if err != nil {
+		return ErrReactionTargetNotFound
+	}
+
+	var visible bool
+	if entityType == "comment" {
+		visible, err = s.DB.Comment.Query().
+			Where(comment.ID(id), comment.IsApproved(true)).
+			Exist(ctx)
+	} else {
+		_, visible, err = s.publicContent(ctx, entityType, id)
+	}
+	if ent.IsNotFound(err) || (err == nil && !visible) {
+		return ErrReactionTargetNotFound
+	}
+	return err
+}
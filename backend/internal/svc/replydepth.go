@@ -0,0 +1,119 @@
+package svc
+
+import (
+	"context"
+	"fmt"
+
+	"silan-backend/internal/ent"
+
+	"github.com/google/uuid"
+)
+
+// maxThreadWalk bounds how many ancestors are followed up a thread, so a
+// damaged parent chain that loops can't hang a request.
+const maxThreadWalk = 1000
+
+// ReplyParent returns the comment a new reply to parent is attached to.
+// Replies nest at most Moderation.MaxReplyDepth levels below a top-level
+// comment; a reply to a comment at that depth is attached next to it, to
+// its ancestor one level up.
+func (s *ServiceContext) ReplyParent(ctx context.Context, parent *ent.Comment) (uuid.UUID, error) {
+	limit := s.Config.Moderation.MaxReplyDepth
+	if limit <= 0 {
+		return parent.ID, nil
+	}
+
+	ancestors, err := s.commentAncestors(ctx, parent)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	// chain holds parent and its ancestors, parent first
+	chain := []uuid.UUID{parent.ID}
+	for _, a := range ancestors {
+		chain = append(chain, a.ID)
+	}
+
+	// The reply is one level below parent, which is len(chain)-1 levels
+	// below the top
+	if len(chain) <= limit {
+		return parent.ID, nil
+	}
+	return chain[len(chain)-limit], nil
+}
+
+// ThreadRoot returns the top-level comment of the thread c is in, which is
+// c itself for top-level comments, and how many levels c is below it.
+func (s *ServiceContext) ThreadRoot(ctx context.Context, c *ent.Comment) (*ent.Comment, int, error) {
+	ancestors, err := s.commentAncestors(ctx, c)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(ancestors) == 0 {
+		return c, 0, nil
+	}
+	return ancestors[len(ancestors)-1], len(ancestors), nil
+}
+
+// commentAncestors returns the ancestors of c, its parent first. A missing
+// ancestor ends the chain there.
+func (s *ServiceContext) commentAncestors(ctx context.Context, c *ent.Comment) ([]*ent.Comment, error) {
+	var ancestors []*ent.Comment
+	for id := c.ParentID; id != uuid.Nil; {
+		if len(ancestors) >= maxThreadWalk {
+			return nil, fmt.Errorf("comment thread is too deep")
+		}
+		a, err := s.DB.Comment.Get(ctx, id)
+		if ent.IsNotFound(err) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, a)
+		id = a.ParentID
+	}
+	return ancestors, nil
+}
+
+// ThreadParents returns the comment each of comments is listed under, by
+// comment ID. That is its parent, except for replies nested deeper than
+// Moderation.MaxReplyDepth, which are listed flat under their ancestor at
+// the deepest level. Top-level comments map to "".
+func (s *ServiceContext) ThreadParents(comments []*ent.Comment) map[string]string {
+	parentOf := make(map[string]string, len(comments))
+	for _, c := range comments {
+		if c.ParentID != uuid.Nil {
+			parentOf[c.ID.String()] = c.ParentID.String()
+		} else {
+			parentOf[c.ID.String()] = ""
+		}
+	}
+
+	limit := s.Config.Moderation.MaxReplyDepth
+	parents := make(map[string]string, len(comments))
+	for _, c := range comments {
+		id := c.ID.String()
+		parents[id] = parentOf[id]
+		if limit <= 0 || parentOf[id] == "" {
+			continue
+		}
+
+		// Ancestors present in comments, parent first; a parent that is
+		// missing, or a loop, ends the walk
+		var ancestors []string
+		seen := map[string]bool{id: true}
+		for p := parentOf[id]; p != "" && !seen[p]; {
+			ancestors = append(ancestors, p)
+			seen[p] = true
+			next, ok := parentOf[p]
+			if !ok {
+				break
+			}
+			p = next
+		}
+		if len(ancestors) > limit {
+			parents[id] = ancestors[len(ancestors)-limit]
+		}
+	}
+	return parents
+}
@@ -0,0 +1,101 @@
+package svc
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/blogpost"
+	"silan-backend/internal/ent/projectimage"
+
+	"github.com/google/uuid"
+)
+
+// AuditReportRateLimited is recorded the first time a subnet exceeds the
+// content report cap within a window.
+const AuditReportRateLimited = "report_rate_limited"
+
+var (
+	// ErrTooManyReports is returned to visitors whose network has used up
+	// its report allowance for the current window.
+	ErrTooManyReports = errors.New("too many reports from your network, try again later")
+	// ErrReportedContentNotFound is returned for reports about content that
+	// doesn't exist or isn't public.
+	ErrReportedContentNotFound = errors.New("content not found")
+)
+
+// CheckReport applies the per-subnet cap to a new content report.
+func (s *ServiceContext) CheckReport(ctx context.Context, ip, fingerprint string) error {
+	if ip == "" {
+		return nil
+	}
+
+	d := s.ReportLimiter.Allow(ip, fingerprint)
+	if d.Flagged {
+		s.Audit(ctx, AuditReportRateLimited, "report", ip, map[string]any{
+			"subnet":       d.Subnet,
+			"reports":      d.Count,
+			"fingerprints": d.Fingerprints,
+			"limit":        s.Config.Abuse.ReportsPerSubnetHour,
+		})
+	}
+	if !d.Allowed {
+		return ErrTooManyReports
+	}
+	return nil
+}
+
+// ReportedContentTitle returns the title of the public post, project, idea
+// or project image a report is about, so tickets stay readable after the
+// content is renamed or taken down.
+func (s *ServiceContext) ReportedContentTitle(ctx context.Context, contentType, contentID string) (string, error) {
+	id, err := uuid.Parse(contentID)
+	if err != nil {
+		return "", ErrReportedContentNotFound
+	}
+
+	title, public, err := s.publicContent(ctx, contentType, id)
+	if ent.IsNotFound(err) || (err == nil && !public) {
+		return "", ErrReportedContentNotFound
+	}
+	return title, err
+}
+
+// publicContent looks up a post, project, idea or project image by id and
+// returns its title and whether it is visible to visitors.
+func (s *ServiceContext) publicContent(ctx context.Context, contentType string, id uuid.UUID) (title string, public bool, err error) {
+	switch contentType {
+	case "post":
+		post, err := s.DB.BlogPost.Get(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+		return post.Title, post.Status == blogpost.StatusPublished, nil
+	case "project":
+		proj, err := s.DB.Project.Get(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+		return proj.Title, proj.IsPublic, nil
+	case "idea":
+		idea, err := s.DB.Idea.Get(ctx, id)
+		if err != nil {
+			return "", false, err
+		}
+		return idea.Title, idea.IsPublic, nil
+	case "image":
+		img, err := s.DB.ProjectImage.Query().
+			Where(projectimage.ID(id)).
+			WithProject().
+			Only(ctx)
+		if err != nil {
+			return "", false, err
+		}
+		proj := img.Edges.Project
+		if proj == nil {
+			return "", false, nil
+		}
+		return proj.Title + ": " + img.ImageURL, proj.IsPublic, nil
+	}
+	return "", false, nil
+}
@@ -0,0 +1,37 @@
+package svc
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// maxSearchTermLength bounds what is stored per query; longer input is cut.
+const maxSearchTermLength = 200
+
+// NormalizeSearchTerm lowercases and collapses whitespace so that "Go  ",
+// "go" and "GO" are reported as the same search.
+func NormalizeSearchTerm(term string) string {
+	term = strings.ToLower(strings.Join(strings.Fields(term), " "))
+	if len(term) > maxSearchTermLength {
+		term = term[:maxSearchTermLength]
+	}
+	return term
+}
+
+// LogSearch records a site-search query and how many results it returned,
+// with the fingerprint hashed. Empty queries (plain filtering) are not
+// recorded.
+func (s *ServiceContext) LogSearch(ctx context.Context, scope, term, fingerprint string, results int) error {
+	term = NormalizeSearchTerm(term)
+	if term == "" {
+		return nil
+	}
+
+	_, err := s.RawDB.ExecContext(ctx, s.Rebind(
+		`INSERT INTO search_queries (scope, term, result_count, fingerprint, created_at)
+		VALUES (?, ?, ?, ?, ?)`),
+		scope, term, results, s.Fingerprints.Hash(ctx, fingerprint), time.Now().UTC(),
+	)
+	return err
+}
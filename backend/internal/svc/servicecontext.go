@@ -1,13 +1,63 @@
 package svc
 
 import (
+	"context"
 	"database/sql"
 	"log"
-	"net/http"
+	"time"
 
+	"silan-backend/internal/abuse"
+	"silan-backend/internal/account"
+	"silan-backend/internal/activity"
+	"silan-backend/internal/ama"
+	"silan-backend/internal/apikey"
+	"silan-backend/internal/ask"
+	"silan-backend/internal/authlog"
+	"silan-backend/internal/availability"
+	"silan-backend/internal/ban"
+	"silan-backend/internal/calendar"
+	"silan-backend/internal/cannedreply"
+	"silan-backend/internal/commentnotify"
+	"silan-backend/internal/commentsub"
+	"silan-backend/internal/commentverify"
 	"silan-backend/internal/config"
+	"silan-backend/internal/coreading"
+	"silan-backend/internal/drafts"
+	"silan-backend/internal/emaillogin"
 	"silan-backend/internal/ent"
+	"silan-backend/internal/faq"
+	"silan-backend/internal/feeds"
+	"silan-backend/internal/fingerprint"
+	"silan-backend/internal/googleauth"
+	"silan-backend/internal/inquiry"
+	"silan-backend/internal/linkcheck"
+	"silan-backend/internal/llm"
+	"silan-backend/internal/mail"
+	"silan-backend/internal/media"
+	"silan-backend/internal/mention"
 	"silan-backend/internal/middleware"
+	"silan-backend/internal/migrate"
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/poll"
+	"silan-backend/internal/publishing"
+	"silan-backend/internal/ratelimit"
+	"silan-backend/internal/reaction"
+	"silan-backend/internal/report"
+	"silan-backend/internal/revalidate"
+	"silan-backend/internal/replay"
+	"silan-backend/internal/revision"
+	"silan-backend/internal/scheduler"
+	"silan-backend/internal/session"
+	"silan-backend/internal/shortlink"
+	"silan-backend/internal/siteupdate"
+	"silan-backend/internal/socialauth"
+	"silan-backend/internal/spam"
+	"silan-backend/internal/swr"
+	"silan-backend/internal/trash"
+	"silan-backend/internal/uses"
+	"silan-backend/internal/utils"
+	"silan-backend/internal/webhook"
+	"silan-backend/internal/wordfilter"
 
 	"github.com/zeromicro/go-zero/rest"
 
@@ -20,8 +70,125 @@ type ServiceContext struct {
 	Config    config.Config
 	Cors      rest.Middleware
 	Analytics rest.Middleware
+	AdminAuth rest.Middleware
+	ApiKey    rest.Middleware
+	Signature rest.Middleware
+	Embed     rest.Middleware
+	Widget    rest.Middleware
 	DB        *ent.Client
 	RawDB     *sql.DB
+	ApiKeys   *apikey.Store
+	Webhooks  *webhook.Dispatcher
+	Outbox    *outbox.Relay
+	// Activity logs normalized domain events for the activity feed, see
+	// PublishEvent
+	Activity  *activity.Store
+	Tools     *uses.Store
+	Links     *shortlink.Store
+	Polls     *poll.Store
+	FAQs      *faq.Store
+	Changelog *siteupdate.Store
+	// Legacy copies blog_comments rows into comments until the table is gone
+	Legacy *migrate.BlogComments
+	// LikeLimiter caps anonymous likes per IP subnet, see CheckLike
+	LikeLimiter *abuse.SubnetLimiter
+	// Feeds caches the sitemap and RSS feeds, rebuilt on content events
+	Feeds *feeds.Cache
+	// Aggregates serves expensive aggregates such as the tag cloud and
+	// dashboard statistics stale-while-revalidate
+	Aggregates *swr.Cache
+	// Scheduler runs background jobs such as scheduled publishing
+	Scheduler  *scheduler.Scheduler
+	Publishing *publishing.Store
+	// PublishPolicy freezes publishing or limits it to windows, see
+	// CheckPublish
+	PublishPolicy *publishing.Policy
+	// Revisions records blog post content on every write for the admin diff
+	// view
+	Revisions *revision.Store
+	// LLM drafts summaries and translations into Drafts for review
+	LLM    *llm.Client
+	Drafts *drafts.Store
+	// Ask indexes public content for visitor questions; AskLimiter caps
+	// questions per IP subnet, see CheckQuestion
+	Ask        *ask.Index
+	AskLimiter *abuse.SubnetLimiter
+	// CommentSubs holds email subscriptions to comment threads; ReplyNotifier
+	// confirms them and mails replies
+	CommentSubs   *commentsub.Store
+	ReplyNotifier *commentsub.Notifier
+	// CommentVerifications holds the email confirmations of anonymous
+	// commenters, see RequestCommentVerification
+	CommentVerifications *commentverify.Store
+	// Bans lists the visitors who may not comment, like or vote, see
+	// CheckBanned
+	Bans *ban.Store
+	// Captcha verifies the captcha of anonymous comments and inquiries; nil
+	// while captchas are not configured, see CheckCaptcha
+	Captcha utils.CaptchaVerifier
+	// Fingerprints hashes browser fingerprints before likes, views and
+	// comments store them
+	Fingerprints *fingerprint.Hasher
+	// Spam scores new comments, nil while scoring is disabled; SpamScores
+	// keeps the verdicts for moderators, see ScoreComment
+	Spam       spam.Checker
+	SpamScores *spam.Store
+	// WordFilter rejects, masks or holds comments with blocked words, see
+	// FilterComment
+	WordFilter *wordfilter.Filter
+	// Sessions holds the signed-in sessions behind session tokens
+	Sessions *session.Store
+	// Google verifies the signature, issuer, audience and expiry of Google
+	// ID tokens
+	Google *googleauth.Verifier
+	// WeChat and QQ verify sign-ins with those providers, see SocialLogin
+	WeChat *socialauth.WeChat
+	QQ     *socialauth.QQ
+	// AuthEvents logs sign-ins, refreshes and logouts, see RecordAuthEvent
+	AuthEvents *authlog.Store
+	// Accounts links identities of one person to a primary identity
+	Accounts *account.Store
+	// Mailer sends notification and sign-in emails; EmailLogins holds the
+	// pending email sign-ins, capped per IP subnet by EmailLoginLimiter
+	Mailer            *mail.Sender
+	EmailLogins       *emaillogin.Store
+	EmailLoginLimiter *abuse.SubnetLimiter
+	// Trash keeps deleted admin entries until they are restored or purged
+	Trash *trash.Store
+	// Media checks uploaded files before they are served; ImageVariants
+	// holds the responsive variants rendered from hero images and Audio the
+	// narrations attached to posts
+	Media         *media.Scanner
+	ImageVariants *media.Store
+	Audio         *media.AudioStore
+	// Inquiries holds "hire me" requests about projects, capped per IP
+	// subnet by InquiryLimiter, see CheckInquiry
+	Inquiries      *inquiry.Store
+	InquiryLimiter *abuse.SubnetLimiter
+	// Availability holds the owner's work status and office hours
+	Availability *availability.Store
+	// Calendar holds the talks, milestones and deadlines of the calendar feed
+	Calendar *calendar.Store
+	// Reports holds abuse and copyright reports about public content as
+	// moderation tickets, capped per IP subnet by ReportLimiter
+	Reports       *report.Store
+	ReportLimiter *abuse.SubnetLimiter
+	// Reactions holds emoji reactions on comments, posts and projects
+	Reactions *reaction.Store
+	// CannedResponses holds the reusable texts of owner replies, see
+	// ReplyAsOwner
+	CannedResponses *cannedreply.Store
+	// AMAs holds the owner's ask-me-anything sessions, whose questions are
+	// comments, see CheckAMAOpen
+	AMAs *ama.Store
+	// Readers counts the distinct readers of each blog post per day
+	Readers *coreading.Store
+	// RateLimits counts comments per fingerprint and IP, see
+	// CheckCommentRate
+	RateLimits *ratelimit.Store
+	// LinkChecks holds the outbound links of published posts and whether
+	// they still work, for the broken links report
+	LinkChecks *linkcheck.Store
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
@@ -138,14 +305,328 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		}
 	}
 
+	// Create the remaining raw tables declared in tables.go
+	ensureRawTables(rawDB, c.Database.Driver)
+	ensureEntTables(client)
 
-	noop := func(next http.HandlerFunc) http.HandlerFunc { return next }
+	apiKeys := apikey.NewStore(rawDB, c.Database.Driver, client)
+	var configuredHooks []*webhook.Subscription
+	for i, h := range c.Webhooks {
+		if err := webhook.ValidateURL(h.URL); err != nil {
+			log.Fatalf("invalid webhook %d: %v", i+1, err)
+		}
+		events := h.Events
+		if len(events) == 0 {
+			events = []string{"*"}
+		}
+		configuredHooks = append(configuredHooks, &webhook.Subscription{
+			ID:          webhook.ConfiguredID(i),
+			URL:         h.URL,
+			Secret:      h.Secret,
+			Description: "config file",
+			EventTypes:  events,
+			Active:      true,
+		})
+	}
+	webhooks := webhook.NewDispatcher(rawDB, c.Database.Driver, configuredHooks...)
+
+	activityLog := activity.NewStore(rawDB, c.Database.Driver)
+
+	// Domain events are drained from events_outbox to the webhook subscribers
+	relay := outbox.NewRelay(rawDB, c.Database.Driver)
+	relay.Register(func(ctx context.Context, ev outbox.Event) error {
+		return webhooks.Publish(ctx, ev.ID, ev.Type, ev.Payload, ev.CreatedAt)
+	})
+
+	changelog := siteupdate.NewStore(rawDB, c.Database.Driver)
+	audio := media.NewAudioStore(rawDB, c.Database.Driver)
+	feedCache := feeds.NewCache(client, changelog, audio, c.Site.BaseURL, c.Feeds.PurgeURL, c.Feeds.PurgeToken)
+	relay.Register(feedCache.Handle)
+	askIndex := ask.NewIndex(client, c.Site.BaseURL)
+	relay.Register(askIndex.Handle)
+	revalidatePaths := map[string][]string{}
+	for _, p := range c.Revalidate.Paths {
+		revalidatePaths[p.Type] = p.Paths
+	}
+	relay.Register(revalidate.NewNotifier(client, c.Revalidate.URL, c.Revalidate.Secret, revalidatePaths,
+		time.Duration(c.Revalidate.TimeoutSeconds)*time.Second).Handle)
+
+	// Links in notification emails point at this API; ShortLinkBase is its
+	// public origin when set
+	apiURL := c.Site.ShortLinkBase
+	if apiURL == "" {
+		apiURL = c.Site.BaseURL
+	}
+	commentSubs := commentsub.NewStore(rawDB, c.Database.Driver)
+	mailer := mail.NewSender(c.Mail.Host, c.Mail.Port, c.Mail.Username, c.Mail.Password, c.Mail.From)
+	replyNotifier := commentsub.NewNotifier(commentSubs, client, mailer, c.Site.BaseURL, apiURL, c.Mail.DigestHours > 0)
+	relay.Register(replyNotifier.Handle)
+	relay.Register(mention.NewNotifier(mention.NewStore(client), client, mailer, c.Site.BaseURL).Handle)
+	relay.Register(inquiry.NewNotifier(mailer, c.Owner.Emails).Handle)
+	relay.Register(report.NewNotifier(mailer, c.Owner.Emails).Handle)
+
+	publisher := publishing.NewStore(rawDB, c.Database.Driver, client, activityLog)
+	publishPolicy, err := newPublishPolicy(c.Publishing)
+	if err != nil {
+		log.Fatalf("invalid publishing config: %v", err)
+	}
+	jobs := scheduler.New(rawDB, c.Database.Driver)
+	if c.Mail.DigestHours > 0 {
+		jobs.Register(scheduler.Job{
+			Name:  "send_comment_digests",
+			Every: time.Duration(c.Mail.DigestHours) * time.Hour,
+			Run:   replyNotifier.SendDigests,
+		})
+	}
+	jobs.Register(scheduler.Job{
+		Name:  "publish_scheduled_posts",
+		Every: time.Minute,
+		Run: func(ctx context.Context, _ time.Time) error {
+			// Due posts wait while publishing is blocked and go out once
+			// it is allowed again
+			if publishPolicy.Check(time.Now()) != nil {
+				return nil
+			}
+			n, err := publisher.PublishDue(ctx)
+			if n > 0 {
+				log.Printf("published %d scheduled post(s)", n)
+			}
+			return err
+		},
+	})
+	revisions := revision.NewStore(rawDB, c.Database.Driver)
+	sessions := session.NewStore(rawDB, c.Database.Driver, client)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_sessions",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			now := time.Now()
+			// Keep ended sessions for a week so logouts can be looked into
+			n, err := sessions.Purge(ctx, now.AddDate(0, 0, -7))
+			if n > 0 {
+				log.Printf("purged %d session(s)", n)
+			}
+			if err != nil {
+				return err
+			}
+			_, err = sessions.PurgeRevokedTokens(ctx, now)
+			return err
+		},
+	})
+	emailLogins := emaillogin.NewStore(rawDB, c.Database.Driver)
+	nonces := replay.NewStore(client)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_signature_nonces",
+		Every: time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			_, err := nonces.Purge(ctx, time.Now())
+			return err
+		},
+	})
+	jobs.Register(scheduler.Job{
+		Name:  "purge_email_logins",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			_, err := emailLogins.Purge(ctx, time.Now().AddDate(0, 0, -1))
+			return err
+		},
+	})
+	captcha, err := utils.NewCaptchaVerifier(c.Captcha.Provider, c.Captcha.Secret, time.Duration(c.Captcha.TimeoutSeconds)*time.Second)
+	if err != nil {
+		log.Fatalf("failed setting up captcha: %v", err)
+	}
+	fingerprints := fingerprint.NewHasher(rawDB, c.Database.Driver, c.Fingerprints.Secret,
+		time.Duration(c.Fingerprints.RotateDays)*24*time.Hour, c.Fingerprints.KeepSalts)
+	jobs.Register(scheduler.Job{
+		Name:  "rotate_fingerprint_salts",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			return fingerprints.Rotate(ctx, time.Now())
+		},
+	})
+	spamChecker, err := spam.New(spam.Options{
+		Provider:        c.Moderation.Spam.Provider,
+		AkismetKey:      c.Moderation.Spam.AkismetKey,
+		AkismetEndpoint: c.Moderation.Spam.AkismetEndpoint,
+		Blog:            c.Site.BaseURL,
+		Timeout:         time.Duration(c.Moderation.Spam.TimeoutSeconds) * time.Second,
+		MaxLinks:        c.Moderation.Spam.MaxLinks,
+		VelocityLimit:   c.Moderation.Spam.VelocityLimit,
+		VelocityWindow:  time.Duration(c.Moderation.Spam.VelocityMinutes) * time.Minute,
+		Blocklist:       c.Moderation.Spam.Blocklist,
+		Recent:          recentComments(client, fingerprints),
+	})
+	if err != nil {
+		log.Fatalf("failed setting up spam checking: %v", err)
+	}
+	wordFilter, err := wordfilter.New(c.Moderation.Filter.Action, c.Moderation.Filter.Words, c.Moderation.Filter.Patterns)
+	if err != nil {
+		log.Fatalf("failed setting up the word filter: %v", err)
+	}
+	authEvents := authlog.NewStore(client)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_auth_events",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			if c.Auth.EventRetentionDays <= 0 {
+				return nil
+			}
+			_, err := authEvents.Purge(ctx, time.Now().AddDate(0, 0, -c.Auth.EventRetentionDays))
+			return err
+		},
+	})
+	commentVerifications := commentverify.NewStore(rawDB, c.Database.Driver)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_comment_verifications",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			_, err := commentVerifications.Purge(ctx, time.Now().AddDate(0, 0, -1))
+			return err
+		},
+	})
+	readers := coreading.NewStore(rawDB, c.Database.Driver)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_daily_readers",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			// Only today's readers are counted
+			_, err := readers.Purge(ctx, time.Now().AddDate(0, 0, -1))
+			return err
+		},
+	})
+	rateLimits := ratelimit.NewStore(rawDB, c.Database.Driver, fingerprints)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_rate_limit_hits",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			// Only attempts within the window count
+			_, err := rateLimits.Purge(ctx, time.Now().Add(-time.Duration(c.Abuse.CommentWindowMinutes)*time.Minute))
+			return err
+		},
+	})
+	linkChecks := linkcheck.NewStore(rawDB, c.Database.Driver, client, c.Site.BaseURL,
+		time.Duration(c.LinkCheck.IntervalHours)*time.Hour, c.LinkCheck.BatchSize,
+		time.Duration(c.LinkCheck.TimeoutSeconds)*time.Second)
+	jobs.Register(scheduler.Job{
+		Name:  "check_outbound_links",
+		Every: time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			if c.LinkCheck.IntervalHours <= 0 {
+				return nil
+			}
+			if _, err := linkChecks.Sync(ctx); err != nil {
+				return err
+			}
+			_, err := linkChecks.CheckDue(ctx, time.Now())
+			return err
+		},
+	})
+	trashBin := trash.NewStore(rawDB, c.Database.Driver)
+	jobs.Register(scheduler.Job{
+		Name:  "purge_trash",
+		Every: 24 * time.Hour,
+		Run: func(ctx context.Context, _ time.Time) error {
+			if c.Trash.RetentionDays <= 0 {
+				return nil
+			}
+			n, err := trashBin.Purge(ctx, time.Now().AddDate(0, 0, -c.Trash.RetentionDays))
+			if n > 0 {
+				log.Printf("purged %d trash item(s)", n)
+			}
+			return err
+		},
+	})
 
-	return &ServiceContext{
+	svcCtx := &ServiceContext{
 		Config:    c,
 		Cors:      middleware.NewCorsMiddleware().Handle,
-		Analytics: noop,
+		Analytics: middleware.NewAnalyticsMiddleware(rawDB, c.Database.Driver).Handle,
+		AdminAuth: middleware.NewAdminAuthMiddleware(c.Admin.Token, c.Admin.APIKeyHashes).Handle,
+		ApiKey:    middleware.NewApiKeyMiddleware(apiKeys, c.Admin.APIKeyHashes).Handle,
+		Signature: middleware.NewSignatureMiddleware(c.Signing.Secret, c.Signing.Optional, c.Signing.ToleranceSeconds, nonces).Handle,
+		Embed:     middleware.NewEmbedMiddleware().Handle,
+		Widget:    middleware.NewWidgetMiddleware().Handle,
 		DB:        client,
 		RawDB:     rawDB,
+		ApiKeys:   apiKeys,
+		Webhooks:  webhooks,
+		Outbox:    relay,
+		Activity:  activityLog,
+		Tools:     uses.NewStore(rawDB, c.Database.Driver),
+		Links:     shortlink.NewStore(rawDB, c.Database.Driver),
+		Polls:     poll.NewStore(rawDB, c.Database.Driver),
+		FAQs:      faq.NewStore(rawDB, c.Database.Driver),
+		Changelog: changelog,
+		Legacy:    migrate.NewBlogComments(rawDB, c.Database.Driver),
+
+		LikeLimiter:   abuse.NewSubnetLimiter(c.Abuse.LikesPerSubnetHour, time.Hour),
+		Feeds:         feedCache,
+		Aggregates:    swr.New(time.Duration(c.Aggregates.RefreshSeconds)*time.Second, c.Aggregates.MaxEntries),
+		Scheduler:     jobs,
+		Publishing:    publisher,
+		PublishPolicy: publishPolicy,
+		Revisions:     revisions,
+		LLM:           llm.New(c.LLM.Provider, c.LLM.BaseURL, c.LLM.Model, c.LLM.APIKey, time.Duration(c.LLM.TimeoutSeconds)*time.Second),
+		Drafts:        drafts.NewStore(rawDB, c.Database.Driver),
+		Ask:           askIndex,
+		AskLimiter:    abuse.NewSubnetLimiter(c.Ask.QuestionsPerSubnetHour, time.Hour),
+
+		CommentSubs:          commentSubs,
+		CommentVerifications: commentVerifications,
+		Bans:                 ban.NewStore(client),
+		Captcha:              captcha,
+		Fingerprints:         fingerprints,
+		Spam:                 spamChecker,
+		SpamScores:           spam.NewStore(client),
+		WordFilter:           wordFilter,
+		ReplyNotifier:        replyNotifier,
+		Sessions:             sessions,
+		AuthEvents:           authEvents,
+		Google:               googleauth.NewVerifier(c.Auth.GoogleClientID),
+		WeChat:               socialauth.NewWeChat(c.Auth.WeChatAppID, c.Auth.WeChatAppSecret),
+		QQ:                   socialauth.NewQQ(c.Auth.QQAppID, c.Auth.QQAppKey),
+		Accounts:             account.NewStore(client),
+
+		Mailer:            mailer,
+		EmailLogins:       emailLogins,
+		EmailLoginLimiter: abuse.NewSubnetLimiter(c.Auth.EmailCodesPerSubnetHour, time.Hour),
+		Trash:             trashBin,
+		Media:             media.NewScanner(c.Media.ClamAVAddress, time.Duration(c.Media.ScanTimeoutSeconds)*time.Second),
+		ImageVariants:     media.NewStore(rawDB, c.Database.Driver),
+		Audio:             audio,
+
+		Inquiries:      inquiry.NewStore(rawDB, c.Database.Driver),
+		InquiryLimiter: abuse.NewSubnetLimiter(c.Abuse.InquiriesPerSubnetHour, time.Hour),
+		Availability:   availability.NewStore(rawDB, c.Database.Driver),
+		Calendar:       calendar.NewStore(rawDB, c.Database.Driver),
+		Reports:        report.NewStore(rawDB, c.Database.Driver),
+		ReportLimiter:  abuse.NewSubnetLimiter(c.Abuse.ReportsPerSubnetHour, time.Hour),
+		Reactions:      reaction.NewStore(client),
+
+		CannedResponses: cannedreply.NewStore(rawDB, c.Database.Driver),
+		AMAs:            ama.NewStore(rawDB, c.Database.Driver),
+		Readers:         readers,
+		RateLimits:      rateLimits,
+		LinkChecks:      linkChecks,
+	}
+
+	// New comments are mailed to the owner in batches, read from the
+	// activity log
+	if c.Moderation.Notify.Enabled {
+		commentNotifier := commentnotify.NewNotifier(activityLog, client, svcCtx.AMAs, mailer, commentnotify.Options{
+			To:          c.Owner.Emails,
+			SiteURL:     c.Site.BaseURL,
+			APIURL:      apiURL,
+			Secret:      c.Moderation.Notify.LinkSecret,
+			LinkTTL:     time.Duration(c.Moderation.Notify.LinkTTLHours) * time.Hour,
+			MaxPerEmail: c.Moderation.Notify.MaxPerEmail,
+			IsOwner:     svcCtx.IsOwnerComment,
+		})
+		jobs.Register(scheduler.Job{
+			Name:  "notify_owner_comments",
+			Every: time.Duration(max(c.Moderation.Notify.BatchMinutes, 1)) * time.Minute,
+			Run:   commentNotifier.Run,
+		})
 	}
+	return svcCtx
 }
@@ -0,0 +1,181 @@
+package svc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/session"
+)
+
+// SessionTokens are returned when a session starts or is refreshed: a
+// short-lived access token sent with requests and the refresh token that
+// renews it.
+type SessionTokens struct {
+	IdentityID       string
+	AccessToken      string
+	AccessExpiresAt  time.Time
+	RefreshToken     string
+	RefreshExpiresAt time.Time
+}
+
+// StartSession stores a new session for a verified user identity that
+// signed in with method, one of the session.Method constants or the name of
+// an OAuth provider.
+func (s *ServiceContext) StartSession(ctx context.Context, identityID, method, userAgent, ip string) (*SessionTokens, error) {
+	if s.Config.Auth.SessionSecret == "" {
+		return nil, session.ErrDisabled
+	}
+	sess, refreshToken, err := s.Sessions.Create(ctx, identityID, method, userAgent, ip, s.refreshTTL())
+	if err != nil {
+		return nil, err
+	}
+	return s.sessionTokens(sess, refreshToken)
+}
+
+// RefreshSession rotates a refresh token and mints a new access token for
+// its session.
+func (s *ServiceContext) RefreshSession(ctx context.Context, refreshToken string) (*SessionTokens, error) {
+	if s.Config.Auth.SessionSecret == "" {
+		return nil, session.ErrDisabled
+	}
+	sess, refreshToken, err := s.Sessions.Refresh(ctx, refreshToken, s.refreshTTL())
+	if err != nil {
+		return nil, err
+	}
+	return s.sessionTokens(sess, refreshToken)
+}
+
+// EndSession revokes the session a refresh or access token belongs to, or
+// every session of its user identity when all is set, and returns that
+// identity with the number of sessions revoked. The access token is also
+// blacklisted itself, which is the only way to end tokens issued before
+// sessions were stored.
+func (s *ServiceContext) EndSession(ctx context.Context, refreshToken, sessionToken string, all bool) (string, int64, error) {
+	var claims *session.Claims
+	if sessionToken != "" {
+		var err error
+		claims, err = s.parseSession(ctx, sessionToken)
+		// A stale access token doesn't stop a logout by refresh token
+		if err != nil && refreshToken == "" {
+			return "", 0, err
+		}
+		if claims != nil && claims.TokenID != "" {
+			if err := s.Sessions.RevokeToken(ctx, claims.TokenID, claims.ExpiresAt); err != nil {
+				return "", 0, err
+			}
+		}
+	}
+
+	var identityID, sessionID string
+	switch {
+	case refreshToken != "":
+		sess, err := s.Sessions.ByRefreshToken(ctx, refreshToken)
+		if err != nil {
+			return "", 0, err
+		}
+		identityID, sessionID = sess.IdentityID, sess.ID
+	case claims != nil:
+		identityID, sessionID = claims.IdentityID, claims.SessionID
+	default:
+		return "", 0, session.ErrInvalid
+	}
+
+	var (
+		revoked int64
+		err     error
+	)
+	switch {
+	case all:
+		revoked, err = s.Sessions.RevokeAll(ctx, identityID)
+	case sessionID != "":
+		revoked, err = s.Sessions.Revoke(ctx, sessionID)
+	}
+	return identityID, revoked, err
+}
+
+// ResolveIdentity returns the user identity a request acts as, from its
+// session token, or "" for anonymous requests. The token must be valid with
+// its session still active; linked identities already resolve to their
+// primary when the session starts. A user_identity_id sent by the client is
+// never trusted on its own, since identity IDs are public on comments.
+func (s *ServiceContext) ResolveIdentity(ctx context.Context, sessionToken string) (string, error) {
+	if sessionToken == "" {
+		return "", nil
+	}
+	claims, err := s.parseSession(ctx, sessionToken)
+	if err != nil {
+		return "", err
+	}
+	return claims.IdentityID, nil
+}
+
+// RequireIdentity returns the user identity of an Authorization header
+// carrying a session token as "Bearer <token>", for endpoints that only
+// serve signed-in visitors.
+func (s *ServiceContext) RequireIdentity(ctx context.Context, authorization string) (string, error) {
+	claims, err := s.RequireSession(ctx, authorization)
+	if err != nil {
+		return "", err
+	}
+	return claims.IdentityID, nil
+}
+
+// RequireSession is RequireIdentity for endpoints that also need the
+// session the token belongs to.
+func (s *ServiceContext) RequireSession(ctx context.Context, authorization string) (*session.Claims, error) {
+	token := strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	if token == "" {
+		return nil, session.ErrInvalid
+	}
+	return s.parseSession(ctx, token)
+}
+
+// parseSession verifies an access token and rejects it once it has been
+// revoked, or its session has been revoked or has expired.
+func (s *ServiceContext) parseSession(ctx context.Context, sessionToken string) (*session.Claims, error) {
+	claims, err := session.Parse(s.Config.Auth.SessionSecret, sessionToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenID != "" {
+		revoked, err := s.Sessions.TokenRevoked(ctx, claims.TokenID)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, session.ErrInvalid
+		}
+	}
+	if claims.SessionID == "" {
+		return claims, nil
+	}
+	active, err := s.Sessions.Active(ctx, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if !active {
+		return nil, session.ErrInvalid
+	}
+	return claims, nil
+}
+
+func (s *ServiceContext) sessionTokens(sess *ent.Session, refreshToken string) (*SessionTokens, error) {
+	ttl := time.Duration(s.Config.Auth.SessionTTLMinutes) * time.Minute
+	accessToken, expiresAt, err := session.Issue(s.Config.Auth.SessionSecret, sess.IdentityID, sess.ID, ttl)
+	if err != nil {
+		return nil, err
+	}
+	return &SessionTokens{
+		IdentityID:       sess.IdentityID,
+		AccessToken:      accessToken,
+		AccessExpiresAt:  expiresAt,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: sess.ExpiresAt,
+	}, nil
+}
+
+func (s *ServiceContext) refreshTTL() time.Duration {
+	return time.Duration(s.Config.Auth.RefreshTTLDays) * 24 * time.Hour
+}
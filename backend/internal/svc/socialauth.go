@@ -0,0 +1,79 @@
+package svc
+
+import (
+	"context"
+	"strings"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/useridentity"
+	"silan-backend/internal/socialauth"
+
+	"github.com/google/uuid"
+)
+
+// defaultNames are shown for visitors whose provider profile has no
+// nickname.
+var defaultNames = map[string]string{
+	socialauth.ProviderWeChat: "WeChat user",
+	socialauth.ProviderQQ:     "QQ user",
+}
+
+// SocialLogin redeems an authorization code of provider (wechat or qq) and
+// returns the identity of the visitor, created on first sign-in. Identities
+// are keyed by unionid when the provider sends one and by openid otherwise;
+// an identity first seen with only its openid moves to the unionid once it
+// is known. redirectURI is only needed by QQ.
+func (s *ServiceContext) SocialLogin(ctx context.Context, provider, code, redirectURI string) (*ent.UserIdentity, error) {
+	var (
+		p   *socialauth.Profile
+		err error
+	)
+	switch provider {
+	case socialauth.ProviderWeChat:
+		p, err = s.WeChat.Exchange(ctx, code)
+	case socialauth.ProviderQQ:
+		p, err = s.QQ.Exchange(ctx, code, redirectURI)
+	default:
+		return nil, socialauth.ErrDisabled
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	externalID := p.ExternalID()
+	name := strings.TrimSpace(p.Name)
+	if name == "" {
+		name = defaultNames[provider]
+	}
+
+	ident, err := s.DB.UserIdentity.Query().
+		Where(
+			useridentity.ProviderEQ(provider),
+			useridentity.ExternalIDIn(externalID, p.OpenID),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		ident, err = s.DB.UserIdentity.Create().
+			SetID("u_" + strings.ReplaceAll(uuid.New().String(), "-", "")).
+			SetProvider(provider).
+			SetExternalID(externalID).
+			SetDisplayName(name).
+			SetAvatarURL(p.AvatarURL).
+			SetVerified(true).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return s.CanonicalIdentity(ctx, ident)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.DB.UserIdentity.UpdateOne(ident).SetExternalID(externalID)
+	ident, err = SyncProviderProfile(update, ident, strings.TrimSpace(p.Name), p.AvatarURL).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.CanonicalIdentity(ctx, ident)
+}
@@ -0,0 +1,58 @@
+package svc
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/comment"
+	"silan-backend/internal/ent/predicate"
+	"silan-backend/internal/fingerprint"
+	"silan-backend/internal/spam"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ScoreComment runs a new comment past the spam checker and reports
+// whether its score means it has to wait for approval. Comments are let
+// through unscored (nil verdict) while scoring is disabled or the checker
+// fails, leaving them to the other moderation rules.
+func (s *ServiceContext) ScoreComment(ctx context.Context, sub spam.Submission) (*spam.Verdict, bool) {
+	if s.Spam == nil {
+		return nil, false
+	}
+	v, err := s.Spam.Check(ctx, sub)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("Failed to check comment for spam: %v", err)
+		return nil, false
+	}
+	return &v, v.Score >= s.Config.Moderation.Spam.HoldScore
+}
+
+// RecordSpamScore keeps the verdict ScoreComment gave a comment once it
+// has been saved.
+func (s *ServiceContext) RecordSpamScore(ctx context.Context, commentID string, v *spam.Verdict) {
+	if v == nil {
+		return
+	}
+	if err := s.SpamScores.Save(ctx, commentID, *v); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to record spam score of comment %s: %v", commentID, err)
+	}
+}
+
+// recentComments counts comments by IP address or fingerprint for the
+// heuristic's velocity check.
+func recentComments(db *ent.Client, fingerprints *fingerprint.Hasher) spam.RecentFunc {
+	return func(ctx context.Context, sub spam.Submission, since time.Time) (int, error) {
+		var who []predicate.Comment
+		if sub.IP != "" {
+			who = append(who, comment.IPAddressEQ(sub.IP))
+		}
+		if sub.Fingerprint != "" {
+			who = append(who, fingerprintTagged(fingerprints.Hashes(ctx, sub.Fingerprint)))
+		}
+		return db.Comment.Query().
+			Where(comment.CreatedAtGTE(since), comment.Or(who...)).
+			Count(ctx)
+	}
+}
@@ -0,0 +1,1481 @@
+package svc
+
+import (
+	"context"
+	"database/sql"
+	"log"
+
+	"silan-backend/internal/ent"
+	"silan-backend/internal/ent/migrate"
+	"silan-backend/internal/utils"
+
+	atlas "ariga.io/atlas/sql/schema"
+	entschema "entgo.io/ent/dialect/sql/schema"
+)
+
+// rawTable describes a table that is managed with plain SQL rather than ent.
+// MySQL indexes are declared inline in the CREATE TABLE statement because it
+// does not support CREATE INDEX IF NOT EXISTS; the indexes slice is applied
+// for sqlite and postgres only.
+type rawTable struct {
+	name     string
+	sqlite   string
+	mysql    string
+	postgres string
+	indexes  []string
+}
+
+var rawTables = []rawTable{
+	{
+		name: "analytics_events",
+		sqlite: `CREATE TABLE IF NOT EXISTS analytics_events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			name TEXT NOT NULL,
+			fingerprint TEXT,
+			user_identity_id TEXT,
+			path TEXT,
+			properties TEXT,
+			ip TEXT,
+			user_agent TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS analytics_events (
+			id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			name VARCHAR(64) NOT NULL,
+			fingerprint VARCHAR(255),
+			user_identity_id VARCHAR(64),
+			path VARCHAR(1024),
+			properties TEXT,
+			ip VARCHAR(64),
+			user_agent VARCHAR(1024),
+			created_at DATETIME NOT NULL,
+			KEY idx_analytics_events_name_created (name, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS analytics_events (
+			id SERIAL PRIMARY KEY,
+			name TEXT NOT NULL,
+			fingerprint TEXT,
+			user_identity_id TEXT,
+			path TEXT,
+			properties TEXT,
+			ip TEXT,
+			user_agent TEXT,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_analytics_events_name_created ON analytics_events (name, created_at)`,
+		},
+	},
+	{
+		name: "api_key_usage",
+		sqlite: `CREATE TABLE IF NOT EXISTS api_key_usage (
+			key_id TEXT NOT NULL,
+			day TEXT NOT NULL,
+			request_count INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (key_id, day)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS api_key_usage (
+			key_id VARCHAR(36) NOT NULL,
+			day CHAR(10) NOT NULL,
+			request_count INT NOT NULL DEFAULT 0,
+			PRIMARY KEY (key_id, day)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS api_key_usage (
+			key_id TEXT NOT NULL,
+			day TEXT NOT NULL,
+			request_count INT NOT NULL DEFAULT 0,
+			PRIMARY KEY (key_id, day)
+		)`,
+	},
+	{
+		name: "webhooks",
+		sqlite: `CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			url TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			description TEXT,
+			event_types TEXT NOT NULL,
+			active INTEGER NOT NULL DEFAULT 1,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS webhooks (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			url VARCHAR(1024) NOT NULL,
+			secret VARCHAR(128) NOT NULL,
+			description VARCHAR(255),
+			event_types VARCHAR(1024) NOT NULL,
+			active TINYINT(1) NOT NULL DEFAULT 1,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS webhooks (
+			id TEXT PRIMARY KEY,
+			url TEXT NOT NULL,
+			secret TEXT NOT NULL,
+			description TEXT,
+			event_types TEXT NOT NULL,
+			active BOOLEAN NOT NULL DEFAULT TRUE,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "webhook_deliveries",
+		sqlite: `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id TEXT PRIMARY KEY,
+			webhook_id TEXT NOT NULL,
+			event_id TEXT,
+			event_type TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			status_code INTEGER NOT NULL DEFAULT 0,
+			error TEXT,
+			success INTEGER NOT NULL DEFAULT 0,
+			attempt INTEGER NOT NULL DEFAULT 1,
+			duration_ms INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			webhook_id VARCHAR(36) NOT NULL,
+			event_id VARCHAR(36),
+			event_type VARCHAR(64) NOT NULL,
+			payload MEDIUMTEXT NOT NULL,
+			status_code INT NOT NULL DEFAULT 0,
+			error VARCHAR(1024),
+			success TINYINT(1) NOT NULL DEFAULT 0,
+			attempt INT NOT NULL DEFAULT 1,
+			duration_ms INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			KEY idx_webhook_deliveries_webhook_created (webhook_id, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS webhook_deliveries (
+			id TEXT PRIMARY KEY,
+			webhook_id TEXT NOT NULL,
+			event_id TEXT,
+			event_type TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			status_code INT NOT NULL DEFAULT 0,
+			error TEXT,
+			success BOOLEAN NOT NULL DEFAULT FALSE,
+			attempt INT NOT NULL DEFAULT 1,
+			duration_ms INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook_created ON webhook_deliveries (webhook_id, created_at)`,
+		},
+	},
+	{
+		name: "events_outbox",
+		sqlite: `CREATE TABLE IF NOT EXISTS events_outbox (
+			id TEXT PRIMARY KEY,
+			event_type TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			last_error TEXT,
+			next_attempt_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			dispatched_at DATETIME
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS events_outbox (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			event_type VARCHAR(64) NOT NULL,
+			payload MEDIUMTEXT NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			last_error VARCHAR(1024),
+			next_attempt_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			dispatched_at DATETIME NULL,
+			KEY idx_events_outbox_pending (dispatched_at, next_attempt_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS events_outbox (
+			id TEXT PRIMARY KEY,
+			event_type TEXT NOT NULL,
+			payload TEXT NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			last_error TEXT,
+			next_attempt_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			dispatched_at TIMESTAMP
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_events_outbox_pending ON events_outbox (dispatched_at, next_attempt_at)`,
+		},
+	},
+	{
+		name: "events",
+		sqlite: `CREATE TABLE IF NOT EXISTS events (
+			id TEXT PRIMARY KEY,
+			event_type TEXT NOT NULL,
+			subject_type TEXT NOT NULL,
+			subject_id TEXT NOT NULL,
+			parent_type TEXT NOT NULL DEFAULT '',
+			parent_id TEXT NOT NULL DEFAULT '',
+			title TEXT NOT NULL DEFAULT '',
+			summary TEXT NOT NULL DEFAULT '',
+			actor TEXT NOT NULL DEFAULT '',
+			public INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS events (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			event_type VARCHAR(64) NOT NULL,
+			subject_type VARCHAR(32) NOT NULL,
+			subject_id VARCHAR(64) NOT NULL,
+			parent_type VARCHAR(32) NOT NULL DEFAULT '',
+			parent_id VARCHAR(64) NOT NULL DEFAULT '',
+			title VARCHAR(255) NOT NULL DEFAULT '',
+			summary VARCHAR(500) NOT NULL DEFAULT '',
+			actor VARCHAR(255) NOT NULL DEFAULT '',
+			public TINYINT(1) NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			KEY idx_events_public_created (public, created_at),
+			KEY idx_events_subject (subject_id),
+			KEY idx_events_parent (parent_id)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS events (
+			id TEXT PRIMARY KEY,
+			event_type TEXT NOT NULL,
+			subject_type TEXT NOT NULL,
+			subject_id TEXT NOT NULL,
+			parent_type TEXT NOT NULL DEFAULT '',
+			parent_id TEXT NOT NULL DEFAULT '',
+			title TEXT NOT NULL DEFAULT '',
+			summary TEXT NOT NULL DEFAULT '',
+			actor TEXT NOT NULL DEFAULT '',
+			public BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_events_public_created ON events (public, created_at)`,
+			`CREATE INDEX IF NOT EXISTS idx_events_subject ON events (subject_id)`,
+			`CREATE INDEX IF NOT EXISTS idx_events_parent ON events (parent_id)`,
+		},
+	},
+	{
+		name: "activity_cursors",
+		sqlite: `CREATE TABLE IF NOT EXISTS activity_cursors (
+			consumer TEXT PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			event_id TEXT NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS activity_cursors (
+			consumer VARCHAR(64) NOT NULL PRIMARY KEY,
+			created_at DATETIME NOT NULL,
+			event_id VARCHAR(36) NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS activity_cursors (
+			consumer TEXT PRIMARY KEY,
+			created_at TIMESTAMP NOT NULL,
+			event_id TEXT NOT NULL
+		)`,
+	},
+	{
+		name: "search_queries",
+		sqlite: `CREATE TABLE IF NOT EXISTS search_queries (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			scope TEXT NOT NULL,
+			term TEXT NOT NULL,
+			result_count INTEGER NOT NULL DEFAULT 0,
+			fingerprint TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS search_queries (
+			id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			scope VARCHAR(32) NOT NULL,
+			term VARCHAR(200) NOT NULL,
+			result_count INT NOT NULL DEFAULT 0,
+			fingerprint VARCHAR(255),
+			created_at DATETIME NOT NULL,
+			KEY idx_search_queries_created (created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS search_queries (
+			id SERIAL PRIMARY KEY,
+			scope TEXT NOT NULL,
+			term TEXT NOT NULL,
+			result_count INT NOT NULL DEFAULT 0,
+			fingerprint TEXT,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_search_queries_created ON search_queries (created_at)`,
+		},
+	},
+	{
+		name: "reading_progress",
+		sqlite: `CREATE TABLE IF NOT EXISTS reading_progress (
+			post_id TEXT NOT NULL,
+			reader_hash TEXT NOT NULL,
+			max_scroll_depth INTEGER NOT NULL DEFAULT 0,
+			dwell_seconds INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (post_id, reader_hash)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS reading_progress (
+			post_id VARCHAR(36) NOT NULL,
+			reader_hash CHAR(64) NOT NULL,
+			max_scroll_depth INT NOT NULL DEFAULT 0,
+			dwell_seconds INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (post_id, reader_hash)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS reading_progress (
+			post_id TEXT NOT NULL,
+			reader_hash TEXT NOT NULL,
+			max_scroll_depth INT NOT NULL DEFAULT 0,
+			dwell_seconds INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (post_id, reader_hash)
+		)`,
+	},
+	{
+		name: "daily_readers",
+		sqlite: `CREATE TABLE IF NOT EXISTS daily_readers (
+			post_id TEXT NOT NULL,
+			day TEXT NOT NULL,
+			reader_hash TEXT NOT NULL,
+			PRIMARY KEY (post_id, day, reader_hash)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS daily_readers (
+			post_id VARCHAR(36) NOT NULL,
+			day CHAR(10) NOT NULL,
+			reader_hash CHAR(64) NOT NULL,
+			PRIMARY KEY (post_id, day, reader_hash)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS daily_readers (
+			post_id TEXT NOT NULL,
+			day TEXT NOT NULL,
+			reader_hash TEXT NOT NULL,
+			PRIMARY KEY (post_id, day, reader_hash)
+		)`,
+	},
+	{
+		name: "post_links",
+		sqlite: `CREATE TABLE IF NOT EXISTS post_links (
+			post_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			PRIMARY KEY (post_id, url)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS post_links (
+			post_id VARCHAR(36) NOT NULL,
+			url VARCHAR(700) NOT NULL,
+			PRIMARY KEY (post_id, url),
+			INDEX idx_post_links_url (url)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS post_links (
+			post_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			PRIMARY KEY (post_id, url)
+		)`,
+	},
+	{
+		name: "link_checks",
+		sqlite: `CREATE TABLE IF NOT EXISTS link_checks (
+			url TEXT PRIMARY KEY,
+			status_code INTEGER NOT NULL DEFAULT 0,
+			error TEXT,
+			failures INTEGER NOT NULL DEFAULT 0,
+			checked_at DATETIME NOT NULL,
+			last_ok_at DATETIME,
+			failing_since DATETIME
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS link_checks (
+			url VARCHAR(700) NOT NULL PRIMARY KEY,
+			status_code INT NOT NULL DEFAULT 0,
+			error VARCHAR(255),
+			failures INT NOT NULL DEFAULT 0,
+			checked_at DATETIME NOT NULL,
+			last_ok_at DATETIME,
+			failing_since DATETIME
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS link_checks (
+			url TEXT PRIMARY KEY,
+			status_code INT NOT NULL DEFAULT 0,
+			error TEXT,
+			failures INT NOT NULL DEFAULT 0,
+			checked_at TIMESTAMP NOT NULL,
+			last_ok_at TIMESTAMP,
+			failing_since TIMESTAMP
+		)`,
+	},
+	{
+		name: "rate_limit_hits",
+		sqlite: `CREATE TABLE IF NOT EXISTS rate_limit_hits (
+			key_hash TEXT NOT NULL,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS rate_limit_hits (
+			key_hash CHAR(64) NOT NULL,
+			created_at DATETIME NOT NULL,
+			INDEX idx_rate_limit_hits_key (key_hash, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS rate_limit_hits (
+			key_hash TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_rate_limit_hits_key ON rate_limit_hits (key_hash, created_at)`,
+		},
+	},
+	{
+		name: "tools",
+		sqlite: `CREATE TABLE IF NOT EXISTS tools (
+			id TEXT PRIMARY KEY,
+			category TEXT NOT NULL,
+			name TEXT NOT NULL,
+			description TEXT,
+			link TEXT,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS tools (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			category VARCHAR(100) NOT NULL,
+			name VARCHAR(200) NOT NULL,
+			description TEXT,
+			link VARCHAR(1024),
+			sort_order INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS tools (
+			id TEXT PRIMARY KEY,
+			category TEXT NOT NULL,
+			name TEXT NOT NULL,
+			description TEXT,
+			link TEXT,
+			sort_order INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "short_links",
+		sqlite: `CREATE TABLE IF NOT EXISTS short_links (
+			code TEXT PRIMARY KEY,
+			target_url TEXT NOT NULL,
+			channel TEXT,
+			entity_type TEXT,
+			entity_id TEXT,
+			click_count INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS short_links (
+			code VARCHAR(64) NOT NULL PRIMARY KEY,
+			target_url VARCHAR(2048) NOT NULL,
+			channel VARCHAR(64),
+			entity_type VARCHAR(32),
+			entity_id VARCHAR(36),
+			click_count INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS short_links (
+			code TEXT PRIMARY KEY,
+			target_url TEXT NOT NULL,
+			channel TEXT,
+			entity_type TEXT,
+			entity_id TEXT,
+			click_count INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "polls",
+		sqlite: `CREATE TABLE IF NOT EXISTS polls (
+			id TEXT PRIMARY KEY,
+			question TEXT NOT NULL,
+			multiple_choice BOOLEAN NOT NULL DEFAULT 0,
+			closes_at DATETIME,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS polls (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			question VARCHAR(500) NOT NULL,
+			multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+			closes_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS polls (
+			id TEXT PRIMARY KEY,
+			question TEXT NOT NULL,
+			multiple_choice BOOLEAN NOT NULL DEFAULT FALSE,
+			closes_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "poll_options",
+		sqlite: `CREATE TABLE IF NOT EXISTS poll_options (
+			id TEXT PRIMARY KEY,
+			poll_id TEXT NOT NULL,
+			text TEXT NOT NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS poll_options (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			poll_id VARCHAR(36) NOT NULL,
+			text VARCHAR(255) NOT NULL,
+			sort_order INT NOT NULL DEFAULT 0,
+			KEY idx_poll_options_poll (poll_id)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS poll_options (
+			id TEXT PRIMARY KEY,
+			poll_id TEXT NOT NULL,
+			text TEXT NOT NULL,
+			sort_order INT NOT NULL DEFAULT 0
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_poll_options_poll ON poll_options (poll_id)`,
+		},
+	},
+	{
+		name: "poll_votes",
+		sqlite: `CREATE TABLE IF NOT EXISTS poll_votes (
+			poll_id TEXT NOT NULL,
+			option_id TEXT NOT NULL,
+			voter_key TEXT NOT NULL,
+			fingerprint TEXT,
+			user_identity_id TEXT,
+			ip TEXT,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (poll_id, voter_key, option_id)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS poll_votes (
+			poll_id VARCHAR(36) NOT NULL,
+			option_id VARCHAR(36) NOT NULL,
+			voter_key VARCHAR(300) NOT NULL,
+			fingerprint VARCHAR(255),
+			user_identity_id VARCHAR(64),
+			ip VARCHAR(64),
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (poll_id, voter_key, option_id),
+			KEY idx_poll_votes_option (option_id)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS poll_votes (
+			poll_id TEXT NOT NULL,
+			option_id TEXT NOT NULL,
+			voter_key TEXT NOT NULL,
+			fingerprint TEXT,
+			user_identity_id TEXT,
+			ip TEXT,
+			created_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (poll_id, voter_key, option_id)
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_poll_votes_option ON poll_votes (option_id)`,
+		},
+	},
+	{
+		name: "faqs",
+		sqlite: `CREATE TABLE IF NOT EXISTS faqs (
+			id TEXT PRIMARY KEY,
+			category TEXT NOT NULL,
+			question TEXT NOT NULL,
+			answer TEXT NOT NULL,
+			sort_order INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS faqs (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			category VARCHAR(100) NOT NULL,
+			question VARCHAR(500) NOT NULL,
+			answer TEXT NOT NULL,
+			sort_order INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS faqs (
+			id TEXT PRIMARY KEY,
+			category TEXT NOT NULL,
+			question TEXT NOT NULL,
+			answer TEXT NOT NULL,
+			sort_order INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "faq_translations",
+		sqlite: `CREATE TABLE IF NOT EXISTS faq_translations (
+			faq_id TEXT NOT NULL,
+			language_code TEXT NOT NULL,
+			question TEXT NOT NULL,
+			answer TEXT NOT NULL,
+			PRIMARY KEY (faq_id, language_code)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS faq_translations (
+			faq_id VARCHAR(36) NOT NULL,
+			language_code VARCHAR(8) NOT NULL,
+			question VARCHAR(500) NOT NULL,
+			answer TEXT NOT NULL,
+			PRIMARY KEY (faq_id, language_code)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS faq_translations (
+			faq_id TEXT NOT NULL,
+			language_code TEXT NOT NULL,
+			question TEXT NOT NULL,
+			answer TEXT NOT NULL,
+			PRIMARY KEY (faq_id, language_code)
+		)`,
+	},
+	{
+		name: "site_updates",
+		sqlite: `CREATE TABLE IF NOT EXISTS site_updates (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			link TEXT,
+			published_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS site_updates (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			title VARCHAR(200) NOT NULL,
+			description TEXT NOT NULL,
+			kind VARCHAR(32) NOT NULL,
+			link VARCHAR(1024),
+			published_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL,
+			KEY idx_site_updates_published (published_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS site_updates (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL,
+			kind TEXT NOT NULL,
+			link TEXT,
+			published_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_site_updates_published ON site_updates (published_at)`,
+		},
+	},
+	{
+		name: "audit_log",
+		sqlite: `CREATE TABLE IF NOT EXISTS audit_log (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			action TEXT NOT NULL,
+			subject TEXT,
+			ip TEXT,
+			detail TEXT,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS audit_log (
+			id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+			action VARCHAR(64) NOT NULL,
+			subject VARCHAR(255),
+			ip VARCHAR(64),
+			detail TEXT,
+			created_at DATETIME NOT NULL,
+			KEY idx_audit_log_action_created (action, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS audit_log (
+			id SERIAL PRIMARY KEY,
+			action TEXT NOT NULL,
+			subject TEXT,
+			ip TEXT,
+			detail TEXT,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_audit_log_action_created ON audit_log (action, created_at)`,
+		},
+	},
+	{
+		name: "scheduler_runs",
+		sqlite: `CREATE TABLE IF NOT EXISTS scheduler_runs (
+			job TEXT PRIMARY KEY,
+			last_run_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS scheduler_runs (
+			job VARCHAR(64) NOT NULL PRIMARY KEY,
+			last_run_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS scheduler_runs (
+			job TEXT PRIMARY KEY,
+			last_run_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "scheduled_posts",
+		sqlite: `CREATE TABLE IF NOT EXISTS scheduled_posts (
+			post_id TEXT PRIMARY KEY,
+			publish_at DATETIME NOT NULL,
+			published_at DATETIME,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS scheduled_posts (
+			post_id VARCHAR(36) NOT NULL PRIMARY KEY,
+			publish_at DATETIME NOT NULL,
+			published_at DATETIME NULL,
+			created_at DATETIME NOT NULL,
+			KEY idx_scheduled_posts_due (published_at, publish_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS scheduled_posts (
+			post_id TEXT PRIMARY KEY,
+			publish_at TIMESTAMP NOT NULL,
+			published_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_scheduled_posts_due ON scheduled_posts (published_at, publish_at)`,
+		},
+	},
+	{
+		name: "post_revisions",
+		sqlite: `CREATE TABLE IF NOT EXISTS post_revisions (
+			post_id TEXT NOT NULL,
+			number INTEGER NOT NULL,
+			title TEXT NOT NULL,
+			content TEXT NOT NULL,
+			hash TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (post_id, number)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS post_revisions (
+			post_id VARCHAR(36) NOT NULL,
+			number INT NOT NULL,
+			title VARCHAR(255) NOT NULL,
+			content LONGTEXT NOT NULL,
+			hash CHAR(64) NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (post_id, number)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS post_revisions (
+			post_id TEXT NOT NULL,
+			number INT NOT NULL,
+			title TEXT NOT NULL,
+			content TEXT NOT NULL,
+			hash TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (post_id, number)
+		)`,
+	},
+	{
+		name: "content_drafts",
+		sqlite: `CREATE TABLE IF NOT EXISTS content_drafts (
+			content_type TEXT NOT NULL,
+			content_id TEXT NOT NULL,
+			field TEXT NOT NULL,
+			language TEXT NOT NULL,
+			text TEXT NOT NULL,
+			model TEXT NOT NULL,
+			edited INTEGER NOT NULL DEFAULT 0,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (content_type, content_id, field, language)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS content_drafts (
+			content_type VARCHAR(16) NOT NULL,
+			content_id VARCHAR(36) NOT NULL,
+			field VARCHAR(32) NOT NULL,
+			language VARCHAR(8) NOT NULL,
+			text TEXT NOT NULL,
+			model VARCHAR(128) NOT NULL,
+			edited TINYINT(1) NOT NULL DEFAULT 0,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (content_type, content_id, field, language)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS content_drafts (
+			content_type TEXT NOT NULL,
+			content_id TEXT NOT NULL,
+			field TEXT NOT NULL,
+			language TEXT NOT NULL,
+			text TEXT NOT NULL,
+			model TEXT NOT NULL,
+			edited BOOLEAN NOT NULL DEFAULT FALSE,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (content_type, content_id, field, language)
+		)`,
+	},
+	{
+		name: "comment_subscriptions",
+		sqlite: `CREATE TABLE IF NOT EXISTS comment_subscriptions (
+			id TEXT PRIMARY KEY,
+			thread_id TEXT NOT NULL,
+			entity_type TEXT NOT NULL,
+			entity_id TEXT NOT NULL,
+			email TEXT NOT NULL,
+			token TEXT NOT NULL UNIQUE,
+			verified INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			verified_at DATETIME,
+			UNIQUE(thread_id, email)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS comment_subscriptions (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			thread_id VARCHAR(36) NOT NULL,
+			entity_type VARCHAR(64) NOT NULL,
+			entity_id VARCHAR(36) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			token VARCHAR(64) NOT NULL,
+			verified TINYINT(1) NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			verified_at DATETIME NULL,
+			UNIQUE KEY uniq_comment_subscriptions_token (token),
+			UNIQUE KEY uniq_comment_subscriptions_thread_email (thread_id, email)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS comment_subscriptions (
+			id TEXT PRIMARY KEY,
+			thread_id TEXT NOT NULL,
+			entity_type TEXT NOT NULL,
+			entity_id TEXT NOT NULL,
+			email TEXT NOT NULL,
+			token TEXT NOT NULL UNIQUE,
+			verified BOOLEAN NOT NULL DEFAULT FALSE,
+			created_at TIMESTAMP NOT NULL,
+			verified_at TIMESTAMP,
+			UNIQUE(thread_id, email)
+		)`,
+	},
+	{
+		name: "comment_digest_queue",
+		sqlite: `CREATE TABLE IF NOT EXISTS comment_digest_queue (
+			subscription_id TEXT NOT NULL,
+			comment_id TEXT NOT NULL,
+			queued_at DATETIME NOT NULL,
+			PRIMARY KEY (subscription_id, comment_id)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS comment_digest_queue (
+			subscription_id VARCHAR(36) NOT NULL,
+			comment_id VARCHAR(36) NOT NULL,
+			queued_at DATETIME NOT NULL,
+			PRIMARY KEY (subscription_id, comment_id)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS comment_digest_queue (
+			subscription_id TEXT NOT NULL,
+			comment_id TEXT NOT NULL,
+			queued_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (subscription_id, comment_id)
+		)`,
+	},
+	{
+		name: "revoked_tokens",
+		sqlite: `CREATE TABLE IF NOT EXISTS revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS revoked_tokens (
+			jti VARCHAR(64) NOT NULL PRIMARY KEY,
+			expires_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS revoked_tokens (
+			jti TEXT PRIMARY KEY,
+			expires_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "email_logins",
+		sqlite: `CREATE TABLE IF NOT EXISTS email_logins (
+			id TEXT PRIMARY KEY,
+			email TEXT NOT NULL,
+			code_hash TEXT NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			ip TEXT,
+			created_at DATETIME NOT NULL,
+			expires_at DATETIME NOT NULL,
+			used_at DATETIME
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS email_logins (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			email VARCHAR(255) NOT NULL,
+			code_hash CHAR(64) NOT NULL,
+			token_hash CHAR(64) NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			ip VARCHAR(64),
+			created_at DATETIME NOT NULL,
+			expires_at DATETIME NOT NULL,
+			used_at DATETIME NULL,
+			UNIQUE KEY uniq_email_logins_token_hash (token_hash),
+			KEY idx_email_logins_email (email, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS email_logins (
+			id TEXT PRIMARY KEY,
+			email TEXT NOT NULL,
+			code_hash TEXT NOT NULL,
+			token_hash TEXT NOT NULL UNIQUE,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			ip TEXT,
+			created_at TIMESTAMP NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			used_at TIMESTAMP
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_email_logins_email ON email_logins (email, created_at)`,
+		},
+	},
+	{
+		name: "trash",
+		sqlite: `CREATE TABLE IF NOT EXISTS trash (
+			id TEXT PRIMARY KEY,
+			kind TEXT NOT NULL,
+			entity_id TEXT NOT NULL,
+			title TEXT,
+			payload TEXT NOT NULL,
+			deleted_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS trash (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			kind VARCHAR(32) NOT NULL,
+			entity_id VARCHAR(64) NOT NULL,
+			title VARCHAR(512),
+			payload MEDIUMTEXT NOT NULL,
+			deleted_at DATETIME NOT NULL,
+			KEY idx_trash_deleted (deleted_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS trash (
+			id TEXT PRIMARY KEY,
+			kind TEXT NOT NULL,
+			entity_id TEXT NOT NULL,
+			title TEXT,
+			payload TEXT NOT NULL,
+			deleted_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_trash_deleted ON trash (deleted_at)`,
+		},
+	},
+	{
+		name: "image_variants",
+		sqlite: `CREATE TABLE IF NOT EXISTS image_variants (
+			owner_type TEXT NOT NULL,
+			owner_id TEXT NOT NULL,
+			source_url TEXT NOT NULL,
+			url TEXT NOT NULL,
+			width INTEGER NOT NULL,
+			format TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (owner_type, owner_id, url)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS image_variants (
+			owner_type VARCHAR(32) NOT NULL,
+			owner_id VARCHAR(64) NOT NULL,
+			source_url VARCHAR(500) NOT NULL,
+			url VARCHAR(500) NOT NULL,
+			width INT NOT NULL,
+			format VARCHAR(16) NOT NULL,
+			created_at DATETIME NOT NULL,
+			PRIMARY KEY (owner_type, owner_id, url)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS image_variants (
+			owner_type TEXT NOT NULL,
+			owner_id TEXT NOT NULL,
+			source_url TEXT NOT NULL,
+			url TEXT NOT NULL,
+			width INT NOT NULL,
+			format TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (owner_type, owner_id, url)
+		)`,
+	},
+	{
+		name: "media_audio",
+		sqlite: `CREATE TABLE IF NOT EXISTS media_audio (
+			owner_type TEXT NOT NULL,
+			owner_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			content_type TEXT NOT NULL,
+			length_bytes INTEGER NOT NULL DEFAULT 0,
+			duration_seconds INTEGER NOT NULL DEFAULT 0,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (owner_type, owner_id)
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS media_audio (
+			owner_type VARCHAR(32) NOT NULL,
+			owner_id VARCHAR(64) NOT NULL,
+			url VARCHAR(500) NOT NULL,
+			content_type VARCHAR(64) NOT NULL,
+			length_bytes BIGINT NOT NULL DEFAULT 0,
+			duration_seconds INT NOT NULL DEFAULT 0,
+			updated_at DATETIME NOT NULL,
+			PRIMARY KEY (owner_type, owner_id)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS media_audio (
+			owner_type TEXT NOT NULL,
+			owner_id TEXT NOT NULL,
+			url TEXT NOT NULL,
+			content_type TEXT NOT NULL,
+			length_bytes BIGINT NOT NULL DEFAULT 0,
+			duration_seconds INT NOT NULL DEFAULT 0,
+			updated_at TIMESTAMP NOT NULL,
+			PRIMARY KEY (owner_type, owner_id)
+		)`,
+	},
+	{
+		name: "project_inquiries",
+		sqlite: `CREATE TABLE IF NOT EXISTS project_inquiries (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			project_title TEXT NOT NULL,
+			name TEXT NOT NULL,
+			email TEXT NOT NULL,
+			company TEXT NOT NULL DEFAULT '',
+			budget TEXT NOT NULL,
+			timeline TEXT NOT NULL,
+			message TEXT NOT NULL,
+			ip TEXT NOT NULL DEFAULT '',
+			fingerprint TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS project_inquiries (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			project_id VARCHAR(36) NOT NULL,
+			project_title VARCHAR(255) NOT NULL,
+			name VARCHAR(100) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			company VARCHAR(100) NOT NULL DEFAULT '',
+			budget VARCHAR(32) NOT NULL,
+			timeline VARCHAR(32) NOT NULL,
+			message TEXT NOT NULL,
+			ip VARCHAR(64) NOT NULL DEFAULT '',
+			fingerprint VARCHAR(255) NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			KEY idx_project_inquiries_project (project_id, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS project_inquiries (
+			id TEXT PRIMARY KEY,
+			project_id TEXT NOT NULL,
+			project_title TEXT NOT NULL,
+			name TEXT NOT NULL,
+			email TEXT NOT NULL,
+			company TEXT NOT NULL DEFAULT '',
+			budget TEXT NOT NULL,
+			timeline TEXT NOT NULL,
+			message TEXT NOT NULL,
+			ip TEXT NOT NULL DEFAULT '',
+			fingerprint TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_project_inquiries_project ON project_inquiries (project_id, created_at)`,
+		},
+	},
+	{
+		name: "availability",
+		sqlite: `CREATE TABLE IF NOT EXISTS availability (
+			id TEXT PRIMARY KEY,
+			status TEXT NOT NULL,
+			message TEXT NOT NULL DEFAULT '',
+			busy_until DATETIME,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS availability (
+			id VARCHAR(16) NOT NULL PRIMARY KEY,
+			status VARCHAR(32) NOT NULL,
+			message VARCHAR(500) NOT NULL DEFAULT '',
+			busy_until DATETIME NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS availability (
+			id TEXT PRIMARY KEY,
+			status TEXT NOT NULL,
+			message TEXT NOT NULL DEFAULT '',
+			busy_until TIMESTAMP,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "office_hour_slots",
+		sqlite: `CREATE TABLE IF NOT EXISTS office_hour_slots (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			starts_at DATETIME NOT NULL,
+			ends_at DATETIME NOT NULL,
+			location TEXT NOT NULL DEFAULT '',
+			booking_url TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS office_hour_slots (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			title VARCHAR(200) NOT NULL,
+			starts_at DATETIME NOT NULL,
+			ends_at DATETIME NOT NULL,
+			location VARCHAR(200) NOT NULL DEFAULT '',
+			booking_url VARCHAR(500) NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			KEY idx_office_hour_slots_ends (ends_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS office_hour_slots (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			starts_at TIMESTAMP NOT NULL,
+			ends_at TIMESTAMP NOT NULL,
+			location TEXT NOT NULL DEFAULT '',
+			booking_url TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_office_hour_slots_ends ON office_hour_slots (ends_at)`,
+		},
+	},
+	{
+		name: "calendar_entries",
+		sqlite: `CREATE TABLE IF NOT EXISTS calendar_entries (
+			id TEXT PRIMARY KEY,
+			kind TEXT NOT NULL,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			location TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL DEFAULT '',
+			starts_at DATETIME NOT NULL,
+			ends_at DATETIME,
+			all_day INTEGER NOT NULL DEFAULT 0,
+			entity_type TEXT NOT NULL DEFAULT '',
+			entity_id TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS calendar_entries (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			kind VARCHAR(16) NOT NULL,
+			title VARCHAR(200) NOT NULL,
+			description TEXT NOT NULL,
+			location VARCHAR(200) NOT NULL DEFAULT '',
+			url VARCHAR(500) NOT NULL DEFAULT '',
+			starts_at DATETIME NOT NULL,
+			ends_at DATETIME NULL,
+			all_day BOOLEAN NOT NULL DEFAULT FALSE,
+			entity_type VARCHAR(16) NOT NULL DEFAULT '',
+			entity_id VARCHAR(36) NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			KEY idx_calendar_entries_starts (starts_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS calendar_entries (
+			id TEXT PRIMARY KEY,
+			kind TEXT NOT NULL,
+			title TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			location TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL DEFAULT '',
+			starts_at TIMESTAMP NOT NULL,
+			ends_at TIMESTAMP,
+			all_day BOOLEAN NOT NULL DEFAULT FALSE,
+			entity_type TEXT NOT NULL DEFAULT '',
+			entity_id TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_calendar_entries_starts ON calendar_entries (starts_at)`,
+		},
+	},
+	{
+		name: "comment_verifications",
+		sqlite: `CREATE TABLE IF NOT EXISTS comment_verifications (
+			id TEXT PRIMARY KEY,
+			comment_id TEXT NOT NULL,
+			email TEXT NOT NULL,
+			code_hash TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			expires_at DATETIME NOT NULL,
+			verified_at DATETIME
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS comment_verifications (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			comment_id VARCHAR(36) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			code_hash CHAR(64) NOT NULL,
+			attempts INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			expires_at DATETIME NOT NULL,
+			verified_at DATETIME NULL,
+			KEY idx_comment_verifications_comment (comment_id, created_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS comment_verifications (
+			id TEXT PRIMARY KEY,
+			comment_id TEXT NOT NULL,
+			email TEXT NOT NULL,
+			code_hash TEXT NOT NULL,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			expires_at TIMESTAMP NOT NULL,
+			verified_at TIMESTAMP
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_comment_verifications_comment ON comment_verifications (comment_id, created_at)`,
+		},
+	},
+	{
+		name: "fingerprint_salts",
+		sqlite: `CREATE TABLE IF NOT EXISTS fingerprint_salts (
+			id TEXT PRIMARY KEY,
+			salt TEXT NOT NULL,
+			created_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS fingerprint_salts (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			salt VARCHAR(64) NOT NULL,
+			created_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS fingerprint_salts (
+			id TEXT PRIMARY KEY,
+			salt TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "content_reports",
+		sqlite: `CREATE TABLE IF NOT EXISTS content_reports (
+			id TEXT PRIMARY KEY,
+			content_type TEXT NOT NULL,
+			content_id TEXT NOT NULL,
+			content_title TEXT NOT NULL,
+			category TEXT NOT NULL,
+			details TEXT NOT NULL,
+			reporter_name TEXT NOT NULL DEFAULT '',
+			reporter_email TEXT NOT NULL DEFAULT '',
+			ip TEXT NOT NULL DEFAULT '',
+			fingerprint TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL DEFAULT 'open',
+			resolution TEXT NOT NULL DEFAULT '',
+			created_at DATETIME NOT NULL,
+			resolved_at DATETIME
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS content_reports (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			content_type VARCHAR(16) NOT NULL,
+			content_id VARCHAR(36) NOT NULL,
+			content_title VARCHAR(255) NOT NULL,
+			category VARCHAR(32) NOT NULL,
+			details TEXT NOT NULL,
+			reporter_name VARCHAR(100) NOT NULL DEFAULT '',
+			reporter_email VARCHAR(255) NOT NULL DEFAULT '',
+			ip VARCHAR(64) NOT NULL DEFAULT '',
+			fingerprint VARCHAR(255) NOT NULL DEFAULT '',
+			status VARCHAR(16) NOT NULL DEFAULT 'open',
+			resolution TEXT NOT NULL,
+			created_at DATETIME NOT NULL,
+			resolved_at DATETIME NULL,
+			KEY idx_content_reports_status (status, created_at),
+			KEY idx_content_reports_content (content_type, content_id)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS content_reports (
+			id TEXT PRIMARY KEY,
+			content_type TEXT NOT NULL,
+			content_id TEXT NOT NULL,
+			content_title TEXT NOT NULL,
+			category TEXT NOT NULL,
+			details TEXT NOT NULL,
+			reporter_name TEXT NOT NULL DEFAULT '',
+			reporter_email TEXT NOT NULL DEFAULT '',
+			ip TEXT NOT NULL DEFAULT '',
+			fingerprint TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL DEFAULT 'open',
+			resolution TEXT NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL,
+			resolved_at TIMESTAMP
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_content_reports_status ON content_reports (status, created_at)`,
+			`CREATE INDEX IF NOT EXISTS idx_content_reports_content ON content_reports (content_type, content_id)`,
+		},
+	},
+	{
+		name: "amas",
+		sqlite: `CREATE TABLE IF NOT EXISTS amas (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			description TEXT,
+			opens_at DATETIME NOT NULL,
+			closes_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS amas (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			title VARCHAR(200) NOT NULL,
+			description TEXT,
+			opens_at DATETIME NOT NULL,
+			closes_at DATETIME NOT NULL,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS amas (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			description TEXT,
+			opens_at TIMESTAMP NOT NULL,
+			closes_at TIMESTAMP NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+	{
+		name: "ama_answers",
+		sqlite: `CREATE TABLE IF NOT EXISTS ama_answers (
+			answer_id TEXT PRIMARY KEY,
+			ama_id TEXT NOT NULL,
+			question_id TEXT NOT NULL,
+			marked_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS ama_answers (
+			answer_id VARCHAR(36) NOT NULL PRIMARY KEY,
+			ama_id VARCHAR(36) NOT NULL,
+			question_id VARCHAR(36) NOT NULL,
+			marked_at DATETIME NOT NULL,
+			KEY idx_ama_answers_ama (ama_id, marked_at)
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS ama_answers (
+			answer_id TEXT PRIMARY KEY,
+			ama_id TEXT NOT NULL,
+			question_id TEXT NOT NULL,
+			marked_at TIMESTAMP NOT NULL
+		)`,
+		indexes: []string{
+			`CREATE INDEX IF NOT EXISTS idx_ama_answers_ama ON ama_answers (ama_id, marked_at)`,
+		},
+	},
+	{
+		name: "canned_responses",
+		sqlite: `CREATE TABLE IF NOT EXISTS canned_responses (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			content TEXT NOT NULL,
+			use_count INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		)`,
+		mysql: `CREATE TABLE IF NOT EXISTS canned_responses (
+			id VARCHAR(36) NOT NULL PRIMARY KEY,
+			title VARCHAR(200) NOT NULL,
+			content TEXT NOT NULL,
+			use_count INT NOT NULL DEFAULT 0,
+			created_at DATETIME NOT NULL,
+			updated_at DATETIME NOT NULL
+		) ENGINE=InnoDB`,
+		postgres: `CREATE TABLE IF NOT EXISTS canned_responses (
+			id TEXT PRIMARY KEY,
+			title TEXT NOT NULL,
+			content TEXT NOT NULL,
+			use_count INT NOT NULL DEFAULT 0,
+			created_at TIMESTAMP NOT NULL,
+			updated_at TIMESTAMP NOT NULL
+		)`,
+	},
+}
+
+// entTables are the ent tables the backend keeps up to date itself.
+var entTables = []*entschema.Table{
+	migrate.APIKeysTable,
+	migrate.AuthEventsTable,
+	migrate.BansTable,
+	migrate.CommentMentionsTable,
+	contentTable(migrate.CommentsTable),
+	migrate.CommentSpamScoresTable,
+	migrate.IdentityLinksTable,
+	migrate.ReactionsTable,
+	migrate.SessionsTable,
+	migrate.SignatureNoncesTable,
+	migrate.UserIdentitiesTable,
+}
+
+// contentTables are the tables in entTables that hold the site's content.
+// They are created by the silan tool; the backend only adds the columns it
+// needs to them.
+var contentTables = map[string]bool{
+	migrate.CommentsTable.Name: true,
+}
+
+// contentTable returns t without its foreign keys, which refer to content
+// tables the backend doesn't manage and are never added anyway.
+func contentTable(t *entschema.Table) *entschema.Table {
+	c := *t
+	c.ForeignKeys = nil
+	return &c
+}
+
+// ensureEntTables creates the ent tables in entTables but contentTables, and
+// adds the columns and indexes ent declares to those that already exist.
+// Nothing else is changed: columns are never altered or dropped, so tables
+// created by hand, by the silan tool or by earlier raw DDL keep working. Failures are logged like those of the
+// raw tables.
+func ensureEntTables(client *ent.Client) {
+	additive := entschema.WithDiffHook(func(next entschema.Differ) entschema.Differ {
+		return entschema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			changes, err := next.Diff(current, desired)
+			if err != nil {
+				return nil, err
+			}
+			var kept []atlas.Change
+			for _, c := range changes {
+				switch c := c.(type) {
+				case *atlas.AddTable:
+					if !contentTables[c.T.Name] {
+						kept = append(kept, c)
+					}
+				case *atlas.ModifyTable:
+					var adds []atlas.Change
+					for _, tc := range c.Changes {
+						switch tc.(type) {
+						case *atlas.AddColumn, *atlas.AddIndex:
+							adds = append(adds, tc)
+						}
+					}
+					if len(adds) > 0 {
+						c.Changes = adds
+						kept = append(kept, c)
+					}
+				}
+			}
+			return kept, nil
+		})
+	})
+	if err := migrate.Create(context.Background(), client.Schema, entTables, additive); err != nil {
+		log.Printf("warning: failed creating ent tables: %v", err)
+	}
+}
+
+// ensureRawTables creates the raw tables (and their indexes) for the driver.
+// Failures are logged rather than fatal, matching the request_logs setup.
+func ensureRawTables(db *sql.DB, driver string) {
+	for _, t := range rawTables {
+		var ddl string
+		switch driver {
+		case "sqlite3":
+			ddl = t.sqlite
+		case "mysql":
+			ddl = t.mysql
+		case "postgres", "postgresql":
+			ddl = t.postgres
+		}
+		if ddl == "" {
+			continue
+		}
+		if _, err := db.Exec(ddl); err != nil {
+			log.Printf("warning: failed creating %s table: %v", t.name, err)
+			continue
+		}
+		if driver == "mysql" {
+			continue
+		}
+		for _, idx := range t.indexes {
+			if _, err := db.Exec(idx); err != nil {
+				log.Printf("warning: failed creating index on %s: %v", t.name, err)
+			}
+		}
+	}
+}
+
+// Rebind rewrites '?' placeholders for the configured driver.
+func (s *ServiceContext) Rebind(query string) string {
+	return utils.Rebind(s.Config.Database.Driver, query)
+}
+
+// IsPostgres reports whether the configured driver is PostgreSQL.
+func (s *ServiceContext) IsPostgres() bool {
+	drv := s.Config.Database.Driver
+	return drv == "postgres" || drv == "postgresql"
+}
@@ -0,0 +1,32 @@
+package svc
+
+import (
+	"context"
+	"errors"
+
+	"silan-backend/internal/wordfilter"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ErrCommentBlocked is returned for comments the word filter rejects.
+var ErrCommentBlocked = errors.New("comment contains blocked words")
+
+// FilterComment runs comment content past the word filter and returns the
+// content to store, masked where the filter masks matches, and whether the
+// comment has to wait for approval. Rejected comments return
+// ErrCommentBlocked.
+func (s *ServiceContext) FilterComment(ctx context.Context, content string) (string, bool, error) {
+	res := s.WordFilter.Check(content)
+	if res.Action == "" {
+		return content, false, nil
+	}
+	logx.WithContext(ctx).Infof("Word filter matched %q in a comment (action: %s)", res.Matches, res.Action)
+	switch res.Action {
+	case wordfilter.ActionReject:
+		return "", false, ErrCommentBlocked
+	case wordfilter.ActionHold:
+		return content, true, nil
+	}
+	return res.Content, false, nil
+}
@@ -0,0 +1,123 @@
+// Package swr caches expensive aggregates (tag clouds, statistics, the
+// content graph) with stale-while-revalidate semantics: once a result has
+// been computed it is always answered from memory, and a result older than
+// the refresh threshold is recomputed in the background while callers keep
+// getting the previous one. Only the first request for a key waits for the
+// computation, and concurrent requests share it.
+package swr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/syncx"
+)
+
+// buildTimeout bounds a computation, which runs detached from the request
+// that triggered it.
+const buildTimeout = 30 * time.Second
+
+// BuildFunc computes the value of a key.
+type BuildFunc func(ctx context.Context) (any, error)
+
+// Cache holds the last computed value of each key. Values are shared
+// between callers and must not be modified.
+type Cache struct {
+	refreshAfter time.Duration
+	maxEntries   int
+	flight       syncx.SingleFlight
+
+	mu      sync.Mutex
+	entries map[string]*entry
+}
+
+type entry struct {
+	value      any
+	builtAt    time.Time
+	refreshing bool
+}
+
+// New returns a cache that refreshes values older than refreshAfter and
+// keeps at most maxEntries keys, dropping the oldest value when full. A
+// cache with refreshAfter <= 0 computes every value on every call.
+func New(refreshAfter time.Duration, maxEntries int) *Cache {
+	return &Cache{
+		refreshAfter: refreshAfter,
+		maxEntries:   maxEntries,
+		flight:       syncx.NewSingleFlight(),
+		entries:      map[string]*entry{},
+	}
+}
+
+// Get returns the value of key, computing it with build when it is not
+// cached yet. A stale value is returned as is and refreshed in the
+// background; a failed refresh is logged and the stale value kept.
+func (c *Cache) Get(ctx context.Context, key string, build BuildFunc) (any, error) {
+	if c.refreshAfter <= 0 {
+		return build(ctx)
+	}
+
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if ok {
+		value := e.value
+		if !e.refreshing && time.Since(e.builtAt) > c.refreshAfter {
+			e.refreshing = true
+			go c.refresh(ctx, key, build)
+		}
+		c.mu.Unlock()
+		return value, nil
+	}
+	c.mu.Unlock()
+
+	return c.flight.Do(key, func() (any, error) {
+		return c.build(ctx, key, build)
+	})
+}
+
+// refresh recomputes a stale key.
+func (c *Cache) refresh(ctx context.Context, key string, build BuildFunc) {
+	if _, err := c.build(ctx, key, build); err != nil {
+		logx.WithContext(ctx).Errorf("Failed to refresh %s: %v", key, err)
+		c.mu.Lock()
+		if e, ok := c.entries[key]; ok {
+			e.refreshing = false
+		}
+		c.mu.Unlock()
+	}
+}
+
+// build computes key detached from ctx's cancellation, so a client going
+// away doesn't waste a computation other requests wait for, and stores it.
+func (c *Cache) build(ctx context.Context, key string, build BuildFunc) (any, error) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), buildTimeout)
+	defer cancel()
+
+	value, err := build(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.entries[key]; !ok && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evictOldest()
+	}
+	c.entries[key] = &entry{value: value, builtAt: time.Now()}
+	return value, nil
+}
+
+func (c *Cache) evictOldest() {
+	var (
+		oldestKey string
+		oldest    time.Time
+	)
+	for key, e := range c.entries {
+		if oldestKey == "" || e.builtAt.Before(oldest) {
+			oldestKey, oldest = key, e.builtAt
+		}
+	}
+	delete(c.entries, oldestKey)
+}
@@ -0,0 +1,33 @@
+// Package tombstone blanks comments that were deleted while others had
+// replied to them. The comment row stays, with its deleted_at set, so the
+// replies keep their place in the thread, and is shown as a "[deleted]"
+// placeholder.
+package tombstone
+
+import (
+	"context"
+	"time"
+
+	"silan-backend/internal/ent"
+
+	"github.com/google/uuid"
+)
+
+// Placeholder replaces the author and content of a deleted comment.
+const Placeholder = "[deleted]"
+
+// Write replaces the author and content of a comment with Placeholder,
+// drops the author's contact details and records when it was deleted.
+// Pass tx.Client() to write it in the transaction of the deletion.
+func Write(ctx context.Context, client *ent.Client, commentID uuid.UUID, at time.Time) error {
+	return client.Comment.UpdateOneID(commentID).
+		SetAuthorName(Placeholder).
+		SetAuthorEmail("").
+		SetContent(Placeholder).
+		ClearAuthorWebsite().
+		ClearIPAddress().
+		ClearUserAgent().
+		ClearUserIdentityID().
+		SetDeletedAt(at).
+		Exec(ctx)
+}
@@ -0,0 +1,153 @@
+// Package trash is the recycle bin of the admin API. Deleted entries are
+// kept as JSON snapshots so they can be restored until the retention period
+// ends and the purge job removes them for good.
+package trash
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown or already purged trash entries.
+var ErrNotFound = errors.New("trash item not found")
+
+// Kinds of deleted entries kept in the trash.
+const (
+	KindTool       = "tool"
+	KindFAQ        = "faq"
+	KindSiteUpdate = "site_update"
+	KindShortLink  = "short_link"
+)
+
+// Item is a deleted entry. EntityID is its ID in its own table and Payload
+// the snapshot it is restored from.
+type Item struct {
+	ID        string
+	Kind      string
+	EntityID  string
+	Title     string
+	Payload   json.RawMessage
+	DeletedAt time.Time
+}
+
+// Store keeps deleted entries in the raw trash table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+func (s *Store) rebind(q string) string {
+	return utils.Rebind(s.driver, q)
+}
+
+// Put stores a snapshot of v, an entry of kind that is about to be deleted.
+func (s *Store) Put(ctx context.Context, kind, entityID, title string, v any) (*Item, error) {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	it := &Item{
+		ID:        uuid.New().String(),
+		Kind:      kind,
+		EntityID:  entityID,
+		Title:     title,
+		Payload:   payload,
+		DeletedAt: time.Now().UTC(),
+	}
+	_, err = s.db.ExecContext(ctx, s.rebind(
+		`INSERT INTO trash (id, kind, entity_id, title, payload, deleted_at) VALUES (?, ?, ?, ?, ?, ?)`),
+		it.ID, it.Kind, it.EntityID, it.Title, string(it.Payload), it.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return it, nil
+}
+
+const itemColumns = `id, kind, entity_id, title, payload, deleted_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanItem(row scanner) (*Item, error) {
+	var (
+		it      Item
+		title   sql.NullString
+		payload string
+	)
+	if err := row.Scan(&it.ID, &it.Kind, &it.EntityID, &title, &payload, &it.DeletedAt); err != nil {
+		return nil, err
+	}
+	it.Title = title.String
+	it.Payload = json.RawMessage(payload)
+	return &it, nil
+}
+
+// List returns the trash, most recently deleted first, optionally limited
+// to one kind.
+func (s *Store) List(ctx context.Context, kind string) ([]*Item, error) {
+	q := `SELECT ` + itemColumns + ` FROM trash`
+	var args []any
+	if kind != "" {
+		q += ` WHERE kind = ?`
+		args = append(args, kind)
+	}
+	rows, err := s.db.QueryContext(ctx, s.rebind(q+` ORDER BY deleted_at DESC`), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var items []*Item
+	for rows.Next() {
+		it, err := scanItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, it)
+	}
+	return items, rows.Err()
+}
+
+// Get returns a trash entry by ID.
+func (s *Store) Get(ctx context.Context, id string) (*Item, error) {
+	it, err := scanItem(s.db.QueryRowContext(ctx, s.rebind(
+		`SELECT `+itemColumns+` FROM trash WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return it, err
+}
+
+// Remove deletes a trash entry, after it was restored or to purge it early.
+func (s *Store) Remove(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM trash WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Purge permanently removes entries deleted before cutoff.
+func (s *Store) Purge(ctx context.Context, cutoff time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, s.rebind(`DELETE FROM trash WHERE deleted_at < ?`), cutoff)
+	if err != nil {
+		return 0, err
+	}
+	return res.RowsAffected()
+}
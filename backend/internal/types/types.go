@@ -3,6 +3,84 @@
 
 package types
 
+type ActivityEvent struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	SubjectType string `json:"subject_type"`
+	SubjectID   string `json:"subject_id"`
+	ParentType  string `json:"parent_type,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Summary     string `json:"summary,omitempty"`
+	Actor       string `json:"actor,omitempty"`
+	Public      bool   `json:"public"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type ActivityRequest struct {
+	Type   string `form:"type,optional"`
+	Before string `form:"before,optional"`
+	Since  string `form:"since,optional"`
+	Limit  int    `form:"limit,default=20"`
+}
+
+type ActivityResponse struct {
+	Events []ActivityEvent `json:"events"`
+}
+
+type AmaAnswerData struct {
+	ID         string `json:"id"`
+	AuthorName string `json:"author_name"`
+	Content    string `json:"content"`
+	CreatedAt  string `json:"created_at"`
+	IsAuthor   bool   `json:"is_author"`
+}
+
+type AmaData struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	Description   string `json:"description,omitempty"`
+	OpensAt       string `json:"opens_at"`
+	ClosesAt      string `json:"closes_at"`
+	Status        string `json:"status"`
+	QuestionCount int    `json:"question_count"`
+}
+
+type AmaListResponse struct {
+	Amas []AmaData `json:"amas"`
+}
+
+type AmaQuestionData struct {
+	ID              string          `json:"id"`
+	AuthorName      string          `json:"author_name"`
+	AuthorAvatarURL string          `json:"author_avatar_url,omitempty"`
+	Content         string          `json:"content"`
+	CreatedAt       string          `json:"created_at"`
+	LikesCount      int             `json:"likes_count"`
+	IsAnswered      bool            `json:"is_answered"`
+	Answers         []AmaAnswerData `json:"answers"`
+}
+
+type AmaRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
+type AmaThreadResponse struct {
+	Ama       AmaData           `json:"ama"`
+	Questions []AmaQuestionData `json:"questions"`
+}
+
+type AnalyticsOptOutStatusRequest struct {
+	Choice            string `json:"choice,optional"`
+	BrowserDoNotTrack bool   `json:"browser_do_not_track,optional"`
+}
+
+type AnalyticsOptOutStatusResponse struct {
+	OptedOut          bool   `json:"opted_out"`
+	Choice            string `json:"choice"`
+	BrowserDoNotTrack bool   `json:"browser_do_not_track"`
+}
+
 type AnnualPlan struct {
 	ID           string        `json:"id"`
 	Year         int           `json:"year"`
@@ -26,6 +104,126 @@ type AnnualPlanRequest struct {
 	Language string `form:"lang,default=en"`
 }
 
+type AnswerAmaQuestionRequest struct {
+	ID         string `path:"id" validate:"uuid"`
+	QuestionID string `path:"question_id" validate:"uuid"`
+	AnswerID   string `json:"answer_id,optional" validate:"uuid"`
+	Content    string `json:"content,optional" validate:"maxbytes=10000"`
+}
+
+type ApiKeyDailyUsage struct {
+	Day   string `json:"day"`
+	Count int    `json:"count"`
+}
+
+type ApiKeyData struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Prefix       string   `json:"prefix"`
+	DailyQuota   int      `json:"daily_quota"`
+	MonthlyQuota int      `json:"monthly_quota"`
+	Scopes       []string `json:"scopes,omitempty"`
+	CreatedAt    string   `json:"created_at"`
+	LastUsedAt   string   `json:"last_used_at,omitempty"`
+	Revoked      bool     `json:"revoked"`
+}
+
+type ApiKeyListResponse struct {
+	Keys []ApiKeyData `json:"keys"`
+}
+
+type ApiKeyUsageRequest struct {
+	ID   string `path:"id"`
+	Days int    `form:"days,default=30"`
+}
+
+type ApiKeyUsageResponse struct {
+	KeyID            string             `json:"key_id"`
+	Name             string             `json:"name"`
+	DailyQuota       int                `json:"daily_quota"`
+	MonthlyQuota     int                `json:"monthly_quota"`
+	UsedToday        int                `json:"used_today"`
+	UsedThisMonth    int                `json:"used_this_month"`
+	RemainingToday   int                `json:"remaining_today"`
+	RemainingMonthly int                `json:"remaining_monthly"`
+	Daily            []ApiKeyDailyUsage `json:"daily"`
+}
+
+type AskRequest struct {
+	Question    string `json:"question" validate:"required,max=500"`
+	Fingerprint string `json:"fingerprint,optional" validate:"max=255"`
+	ClientIP    string `json:"client_ip,optional"`
+}
+
+type AskResponse struct {
+	Answer  string      `json:"answer"`
+	Sources []AskSource `json:"sources"`
+}
+
+type AskSource struct {
+	Index   int    `json:"index"`
+	Type    string `json:"type"`
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	URL     string `json:"url"`
+	Snippet string `json:"snippet"`
+	Cited   bool   `json:"cited"`
+}
+
+type AuditEntry struct {
+	ID        int64  `json:"id"`
+	Action    string `json:"action"`
+	Subject   string `json:"subject,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	CreatedAt string `json:"created_at"`
+}
+
+type AuditLogRequest struct {
+	Action string `form:"action,optional"`
+	Limit  int    `form:"limit,default=50"`
+}
+
+type AuditLogResponse struct {
+	Entries []AuditEntry `json:"entries"`
+}
+
+type AuthEventData struct {
+	ID         string `json:"id"`
+	Type       string `json:"type"`
+	Provider   string `json:"provider"`
+	IdentityID string `json:"identity_id,omitempty"`
+	Email      string `json:"email,omitempty"`
+	IP         string `json:"ip,omitempty"`
+	UserAgent  string `json:"user_agent,omitempty"`
+	Outcome    string `json:"outcome"`
+	Reason     string `json:"reason,omitempty"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type AuthEventListResponse struct {
+	Events []AuthEventData `json:"events"`
+}
+
+type AuthEventsRequest struct {
+	Type       string `form:"type,optional" validate:"oneof=verify login refresh logout"`
+	Outcome    string `form:"outcome,optional" validate:"oneof=success failure"`
+	IdentityID string `form:"identity_id,optional" validate:"max=64"`
+	Email      string `form:"email,optional" validate:"max=255"`
+	IP         string `form:"ip,optional" validate:"max=45"`
+	Owner      bool   `form:"owner,optional"`
+	Since      string `form:"since,optional"`
+	Limit      int    `form:"limit,default=50"`
+}
+
+type AvailabilityData struct {
+	Status    string               `json:"status"`
+	Message   string               `json:"message,omitempty"`
+	BusyUntil string               `json:"busy_until,omitempty"`
+	UpdatedAt string               `json:"updated_at,omitempty"`
+	Slots     []OfficeHourSlotData `json:"slots"`
+}
+
 type Award struct {
 	ID           string `json:"id"`
 	UserID       string `json:"user_id"`
@@ -39,9 +237,27 @@ type Award struct {
 	UpdatedAt    string `json:"updated_at"`
 }
 
+type BanData struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"`
+	Value     string `json:"value"`
+	Reason    string `json:"reason,omitempty"`
+	CreatedAt string `json:"created_at"`
+	ExpiresAt string `json:"expires_at,omitempty"`
+}
+
+type BanListResponse struct {
+	Bans []BanData `json:"bans"`
+}
+
+type BanRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
 type BlogByIdRequest struct {
 	ID       string `path:"id"`
 	Language string `form:"lang,default=en"`
+	Preview  string `form:"preview,optional"`
 }
 
 type BlogCategoriesRequest struct {
@@ -68,17 +284,34 @@ type BlogCommentData struct {
 	UserIdentityID  string            `json:"user_identity_id,optional"`
 	LikesCount      int               `json:"likes_count"`
 	IsLikedByUser   bool              `json:"is_liked_by_user"`
+	IsAuthor        bool              `json:"is_author"`
+	EmailVerified   bool              `json:"email_verified"`
+	IsEdited        bool              `json:"is_edited,omitempty"`
+	EditedAt        string            `json:"edited_at,omitempty"`
+	IsDeleted       bool              `json:"is_deleted,omitempty"`
+	DeletedAt       string            `json:"deleted_at,omitempty"`
+	Pending         bool              `json:"pending,omitempty"`
+	Language        string            `json:"language,omitempty"`
 	Replies         []BlogCommentData `json:"replies,optional"`
 }
 
+type BlogCommentExportRequest struct {
+	ID     string `path:"id"`
+	Format string `form:"format,default=md" validate:"oneof=md json"`
+}
+
 type BlogCommentListRequest struct {
-	ID       string `path:"id"`
-	Language string `form:"lang,default=en"`
+	ID              string `path:"id"`
+	Language        string `form:"lang,default=en"`
+	Sort            string `form:"sort,default=oldest" validate:"oneof=newest oldest most_liked"`
+	CommentLanguage string `form:"comment_lang,optional"`
+	GroupByLanguage bool   `form:"group_by_language,optional"`
 }
 
 type BlogCommentListResponse struct {
-	Comments []BlogCommentData `json:"comments"`
-	Total    int               `json:"total"`
+	Comments  []BlogCommentData      `json:"comments"`
+	Total     int                    `json:"total"`
+	Languages []CommentLanguageGroup `json:"languages,omitempty"`
 }
 
 type BlogContent struct {
@@ -91,32 +324,40 @@ type BlogContent struct {
 }
 
 type BlogData struct {
-	ID                  string        `json:"id"`
-	Title               string        `json:"title"`
-	TitleZh             string        `json:"title_zh,omitempty"`
-	Slug                string        `json:"slug,omitempty"`
-	Author              string        `json:"author"`
-	PublishDate         string        `json:"publish_date"`
-	ReadTime            string        `json:"read_time"`
-	Category            string        `json:"category"`
-	Tags                []string      `json:"tags"`
-	Content             []BlogContent `json:"content"`
-	Likes               int64         `json:"likes"`
-	Views               int64         `json:"views"`
-	Summary             string        `json:"summary"`
-	SummaryZh           string        `json:"summary_zh,omitempty"`
-	Type                string        `json:"type,omitempty"`
-	VideoURL            string        `json:"video_url,omitempty"`
-	VideoDuration       string        `json:"video_duration,omitempty"`
-	VideoThumbnail      string        `json:"video_thumbnail,omitempty"`
-	SeriesID            string        `json:"series_id,omitempty"`
-	SeriesTitle         string        `json:"series_title,omitempty"`
-	SeriesTitleZh       string        `json:"series_title_zh,omitempty"`
-	SeriesDescription   string        `json:"series_description,omitempty"`
-	SeriesDescriptionZh string        `json:"series_description_zh,omitempty"`
-	EpisodeNumber       int           `json:"episode_number,omitempty"`
-	TotalEpisodes       int           `json:"total_episodes,omitempty"`
-	SeriesImage         string        `json:"series_image,omitempty"`
+	ID                  string         `json:"id"`
+	Title               string         `json:"title"`
+	TitleZh             string         `json:"title_zh,omitempty"`
+	Slug                string         `json:"slug,omitempty"`
+	Author              string         `json:"author"`
+	PublishDate         string         `json:"publish_date"`
+	ReadTime            string         `json:"read_time"`
+	Category            string         `json:"category"`
+	Tags                []string       `json:"tags"`
+	Content             []BlogContent  `json:"content"`
+	Likes               int64          `json:"likes"`
+	Views               int64          `json:"views"`
+	Summary             string         `json:"summary"`
+	SummaryZh           string         `json:"summary_zh,omitempty"`
+	Type                string         `json:"type,omitempty"`
+	VideoURL            string         `json:"video_url,omitempty"`
+	VideoDuration       string         `json:"video_duration,omitempty"`
+	VideoThumbnail      string         `json:"video_thumbnail,omitempty"`
+	SeriesID            string         `json:"series_id,omitempty"`
+	SeriesTitle         string         `json:"series_title,omitempty"`
+	SeriesTitleZh       string         `json:"series_title_zh,omitempty"`
+	SeriesDescription   string         `json:"series_description,omitempty"`
+	SeriesDescriptionZh string         `json:"series_description_zh,omitempty"`
+	EpisodeNumber       int            `json:"episode_number,omitempty"`
+	TotalEpisodes       int            `json:"total_episodes,omitempty"`
+	PollIDs             []string       `json:"poll_ids,omitempty"`
+	SeriesImage         string         `json:"series_image,omitempty"`
+	HeroImage           string         `json:"hero_image,omitempty"`
+	HeroVariants        []ImageVariant `json:"hero_variants,omitempty"`
+	AudioURL            string         `json:"audio_url,omitempty"`
+	AudioType           string         `json:"audio_type,omitempty"`
+	AudioDuration       int            `json:"audio_duration,omitempty"`
+	// Distinct readers of the post today (UTC)
+	ReadersToday int `json:"readers_today"`
 }
 
 type BlogListRequest struct {
@@ -140,19 +381,52 @@ type BlogListResponse struct {
 	TotalPages int        `json:"total_pages"`
 }
 
+type BlogPostStatsRequest struct {
+	ID string `path:"id"`
+}
+
+type BlogPostStatsResponse struct {
+	PostID          string  `json:"post_id"`
+	Views           int     `json:"views"`
+	Likes           int     `json:"likes"`
+	Comments        int     `json:"comments"`
+	Readers         int     `json:"readers"`
+	AvgScrollDepth  float64 `json:"avg_scroll_depth"`
+	AvgDwellSeconds float64 `json:"avg_dwell_seconds"`
+	CompletionRate  float64 `json:"completion_rate"`
+}
+
+type BlogPostingJsonLd struct {
+	Context          string       `json:"@context"`
+	Type             string       `json:"@type"`
+	Headline         string       `json:"headline"`
+	Description      string       `json:"description,omitempty"`
+	Image            string       `json:"image,omitempty"`
+	URL              string       `json:"url"`
+	MainEntityOfPage string       `json:"mainEntityOfPage"`
+	DatePublished    string       `json:"datePublished,omitempty"`
+	DateModified     string       `json:"dateModified,omitempty"`
+	Author           PersonJsonLd `json:"author"`
+	ArticleSection   string       `json:"articleSection,omitempty"`
+	Keywords         []string     `json:"keywords,omitempty"`
+	TimeRequired     string       `json:"timeRequired,omitempty"`
+}
+
 type BlogRequest struct {
 	Slug     string `path:"slug"`
 	Language string `form:"lang,default=en"`
+	Preview  string `form:"preview,optional"`
 }
 
 type BlogSearchRequest struct {
-	Query    string `form:"query,optional"`
-	Category string `form:"category,optional"`
-	Tags     string `form:"tags,optional"`
-	Author   string `form:"author,optional"`
-	Language string `form:"lang,default=en"`
-	Page     int    `form:"page,default=1"`
-	Size     int    `form:"size,default=10"`
+	Query       string `form:"query,optional"`
+	Category    string `form:"category,optional"`
+	Tags        string `form:"tags,optional"`
+	Author      string `form:"author,optional"`
+	Language    string `form:"lang,default=en"`
+	Page        int    `form:"page,default=1"`
+	Size        int    `form:"size,default=10"`
+	Fingerprint string `form:"fingerprint,optional"`
 }
 
 type BlogSeries struct {
@@ -183,6 +457,88 @@ type BlogTagsRequest struct {
 	Language string `form:"lang,default=en"`
 }
 
+type BrokenLinkData struct {
+	URL          string           `json:"url"`
+	StatusCode   int              `json:"status_code"`
+	Error        string           `json:"error"`
+	Failures     int              `json:"failures"`
+	CheckedAt    string           `json:"checked_at"`
+	LastOKAt     string           `json:"last_ok_at,omitempty"`
+	FailingSince string           `json:"failing_since,omitempty"`
+	Posts        []BrokenLinkPost `json:"posts"`
+}
+
+type BrokenLinkListResponse struct {
+	Links []BrokenLinkData `json:"links"`
+}
+
+type BrokenLinkPost struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Slug  string `json:"slug"`
+}
+
+type CalendarEntryData struct {
+	ID          string `json:"id"`
+	Kind        string `json:"kind"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Location    string `json:"location,omitempty"`
+	URL         string `json:"url,omitempty"`
+	StartsAt    string `json:"starts_at"`
+	EndsAt      string `json:"ends_at,omitempty"`
+	AllDay      bool   `json:"all_day"`
+	EntityType  string `json:"entity_type,omitempty"`
+	EntityID    string `json:"entity_id,omitempty"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type CalendarEntryListResponse struct {
+	Entries []CalendarEntryData `json:"entries"`
+}
+
+type CalendarEntryRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
+type CannedResponseData struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	UseCount  int    `json:"use_count"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type CannedResponseListResponse struct {
+	Responses []CannedResponseData `json:"responses"`
+}
+
+type CannedResponseRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
+type ClaimActivityRequest struct {
+	Authorization string `header:"Authorization,optional"`
+	Fingerprint   string `json:"fingerprint" validate:"required,max=255"`
+}
+
+type ClaimActivityResponse struct {
+	IdentityID   string `json:"identity_id"`
+	Comments     int    `json:"comments"`
+	CommentLikes int    `json:"comment_likes"`
+	ProjectLikes int    `json:"project_likes"`
+}
+
+type ClaimCommentsRequest struct {
+	Authorization string `header:"Authorization,optional"`
+}
+
+type ClaimCommentsResponse struct {
+	IdentityID string `json:"identity_id"`
+	Comments   int    `json:"comments"`
+}
+
 type Collaborator struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
@@ -191,38 +547,242 @@ type Collaborator struct {
 	Contact     string `json:"contact,omitempty"`
 }
 
+type CommentContextRequest struct {
+	ID   string `path:"id" validate:"uuid"`
+	Sort string `form:"sort,default=oldest" validate:"oneof=newest oldest most_liked"`
+	// Top-level comments per page of the thread
+	Size int `form:"size,default=20" validate:"min=1,max=100"`
+}
+
+type CommentContextResponse struct {
+	ID         string `json:"id"`
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+	EntitySlug string `json:"entity_slug,omitempty"`
+	// Page link with the comment as its fragment
+	URL    string `json:"url"`
+	RootID string `json:"root_id"`
+	// Levels below the top-level comment
+	Depth int `json:"depth"`
+	// Page of the thread holding the root, from 1, and the root's index
+	// among top-level comments, from 0
+	Page     int `json:"page"`
+	Position int `json:"position"`
+}
+
+type CommentLanguageGroup struct {
+	Language string `json:"language"`
+	Threads  int    `json:"threads"`
+	Comments int    `json:"comments"`
+}
+
+type CommentSubscriptionRequest struct {
+	Token string `form:"token" validate:"required,max=64"`
+}
+
+type CommentSubscriptionResponse struct {
+	Status string `json:"status"`
+}
+
+type CommentVerificationRequest struct {
+	ID       string `path:"id" validate:"required,uuid"`
+	Email    string `json:"email" validate:"required,email,max=255"`
+	ClientIP string `json:"client_ip,optional"`
+}
+
+type CommentVerificationResponse struct {
+	Sent      bool   `json:"sent"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type ConfirmCommentVerificationRequest struct {
+	ID   string `path:"id" validate:"required,uuid"`
+	Code string `json:"code" validate:"required,max=12"`
+}
+
+type ConfirmCommentVerificationResponse struct {
+	Verified bool `json:"verified"`
+}
+
 type Contact struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
 }
 
+type ContentChange struct {
+	Entity string `json:"entity"`
+	Key    string `json:"key"`
+	Action string `json:"action"`
+}
+
+type ContentDraftData struct {
+	Type      string `json:"type"`
+	ID        string `json:"id"`
+	Field     string `json:"field"`
+	Language  string `json:"language"`
+	Text      string `json:"text"`
+	Model     string `json:"model"`
+	Edited    bool   `json:"edited"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+type ContentDraftListResponse struct {
+	Drafts []ContentDraftData `json:"drafts"`
+}
+
+type ContentDraftsRequest struct {
+	Type string `form:"type" validate:"required,oneof=blog project idea"`
+	ID   string `form:"id" validate:"required,uuid"`
+}
+
+type ContentGraphEdge struct {
+	Source   string   `json:"source"`
+	Target   string   `json:"target"`
+	Kind     string   `json:"kind"`
+	Weight   int      `json:"weight"`
+	Labels   []string `json:"labels,omitempty"`
+	Relation string   `json:"relation,omitempty"`
+}
+
+type ContentGraphNode struct {
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Slug   string `json:"slug"`
+	Degree int    `json:"degree"`
+}
+
+type ContentGraphRequest struct {
+	MinShared int `form:"min_shared,default=1" validate:"min=1,max=10"`
+}
+
+type ContentGraphResponse struct {
+	Nodes []ContentGraphNode `json:"nodes"`
+	Edges []ContentGraphEdge `json:"edges"`
+}
+
+type ContentMonth struct {
+	Month    string `json:"month"`
+	Posts    int    `json:"posts"`
+	Projects int    `json:"projects"`
+	Ideas    int    `json:"ideas"`
+	Words    int    `json:"words"`
+}
+
+type ContentStatsRequest struct {
+	Months int    `form:"months,default=12" validate:"min=0,max=120"`
+	TZ     string `form:"tz,optional"`
+}
+
+type ContentStatsResponse struct {
+	TotalPosts    int            `json:"total_posts"`
+	TotalProjects int            `json:"total_projects"`
+	TotalIdeas    int            `json:"total_ideas"`
+	TotalWords    int            `json:"total_words"`
+	PostWords     int            `json:"post_words"`
+	ProjectWords  int            `json:"project_words"`
+	IdeaWords     int            `json:"idea_words"`
+	CurrentStreak int            `json:"current_streak"`
+	LongestStreak int            `json:"longest_streak"`
+	Months        []ContentMonth `json:"months"`
+}
+
+type ContentUpsertResponse struct {
+	Type    string          `json:"type"`
+	ID      string          `json:"id"`
+	Slug    string          `json:"slug"`
+	Created bool            `json:"created"`
+	DryRun  bool            `json:"dry_run,omitempty"`
+	Changes []ContentChange `json:"changes"`
+}
+
+type CreateAmaRequest struct {
+	Title       string `json:"title" validate:"required,max=200"`
+	Description string `json:"description,optional" validate:"maxbytes=10000"`
+	OpensAt     string `json:"opens_at" validate:"required"`
+	ClosesAt    string `json:"closes_at" validate:"required"`
+}
+
+type CreateApiKeyRequest struct {
+	Name         string   `json:"name" validate:"required,max=100"`
+	DailyQuota   int      `json:"daily_quota,optional"`
+	MonthlyQuota int      `json:"monthly_quota,optional"`
+	Scopes       []string `json:"scopes,optional"`
+}
+
+type CreateApiKeyResponse struct {
+	Key    ApiKeyData `json:"key"`
+	Secret string     `json:"secret"`
+}
+
+type CreateBanRequest struct {
+	Kind      string `json:"kind" validate:"oneof=identity email fingerprint ip"`
+	Value     string `json:"value" validate:"max=255"`
+	Reason    string `json:"reason,optional" validate:"max=500"`
+	ExpiresAt string `json:"expires_at,optional"`
+}
+
 type CreateBlogCommentRequest struct {
-	ID             string `path:"id"`
-	ParentId       string `json:"parent_id,optional"`
-	AuthorName     string `json:"author_name"`
-	AuthorEmail    string `json:"author_email"`
-	Content        string `json:"content"`
-	Fingerprint    string `json:"fingerprint"`
+	ID             string `path:"id" validate:"uuid"`
+	ParentId       string `json:"parent_id,optional" validate:"uuid"`
+	AuthorName     string `json:"author_name" validate:"max=100"`
+	AuthorEmail    string `json:"author_email" validate:"email,max=255"`
+	Content        string `json:"content" validate:"required,maxbytes=10000"`
+	Fingerprint    string `json:"fingerprint" validate:"max=255"`
+	CaptchaToken   string `json:"captcha_token,optional" validate:"max=4096"`
+	NotifyReplies  bool   `json:"notify_replies,optional"`
+	NotifyComments bool   `json:"notify_comments,optional"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	IdToken        string `json:"id_token,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	Language       string `form:"lang,default=en"`
 }
 
+type CreateCalendarEntryRequest struct {
+	Kind        string `json:"kind" validate:"required,oneof=talk milestone deadline"`
+	Title       string `json:"title" validate:"required,max=200"`
+	Description string `json:"description,optional" validate:"max=2000"`
+	Location    string `json:"location,optional" validate:"max=200"`
+	URL         string `json:"url,optional" validate:"max=500"`
+	StartsAt    string `json:"starts_at" validate:"required"`
+	EndsAt      string `json:"ends_at,optional"`
+	AllDay      bool   `json:"all_day,optional"`
+	EntityType  string `json:"entity_type,optional" validate:"oneof=project idea"`
+	EntityID    string `json:"entity_id,optional" validate:"uuid"`
+}
+
+type CreateCannedResponseRequest struct {
+	Title   string `json:"title" validate:"required,max=200"`
+	Content string `json:"content" validate:"required,maxbytes=10000"`
+}
+
+type CreateFAQRequest struct {
+	Category     string               `json:"category" validate:"required,max=100"`
+	Question     string               `json:"question" validate:"required,max=500"`
+	Answer       string               `json:"answer" validate:"required,maxbytes=20000"`
+	SortOrder    int                  `json:"sort_order,optional"`
+	Translations []FAQTranslationData `json:"translations,optional"`
+}
+
 type CreateIdeaCommentRequest struct {
-	ID             string `path:"id"`
-	ParentId       string `json:"parent_id,optional"`
-	AuthorName     string `json:"author_name,optional"`
-	AuthorEmail    string `json:"author_email,optional"`
-	AuthorWebsite  string `json:"author_website,optional"`
-	Content        string `json:"content"`
+	ID             string `path:"id" validate:"uuid"`
+	ParentId       string `json:"parent_id,optional" validate:"uuid"`
+	AuthorName     string `json:"author_name,optional" validate:"max=100"`
+	AuthorEmail    string `json:"author_email,optional" validate:"email,max=255"`
+	AuthorWebsite  string `json:"author_website,optional" validate:"max=500"`
+	Content        string `json:"content" validate:"required,maxbytes=10000"`
 	Type           string `json:"type"`
 	IsApproved     bool   `json:"is_approved,optional"`
-	Fingerprint    string `json:"fingerprint"`
+	Fingerprint    string `json:"fingerprint" validate:"max=255"`
+	CaptchaToken   string `json:"captcha_token,optional" validate:"max=4096"`
+	NotifyReplies  bool   `json:"notify_replies,optional"`
+	NotifyComments bool   `json:"notify_comments,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	Language       string `form:"lang,default=en"`
 }
 
@@ -241,19 +801,62 @@ type CreateIdeaRequest struct {
 	FundingStatus        string   `json:"funding_status,optional"`
 }
 
+type CreateInquiryRequest struct {
+	ProjectID    string `path:"id" validate:"uuid"`
+	Name         string `json:"name" validate:"required,max=100"`
+	Email        string `json:"email" validate:"required,email,max=255"`
+	Company      string `json:"company,optional" validate:"max=100"`
+	Budget       string `json:"budget" validate:"required,oneof=under_5k 5k_15k 15k_50k over_50k undisclosed"`
+	Timeline     string `json:"timeline" validate:"required,oneof=asap 1_3_months 3_6_months flexible"`
+	Message      string `json:"message" validate:"required,max=5000"`
+	Website      string `json:"website,optional"`
+	Fingerprint  string `json:"fingerprint,optional" validate:"max=255"`
+	CaptchaToken string `json:"captcha_token,optional" validate:"max=4096"`
+	ClientIP     string `json:"client_ip,optional"`
+}
+
+type CreateInquiryResponse struct {
+	Received bool `json:"received"`
+}
+
+type CreateOfficeHourRequest struct {
+	Title      string `json:"title" validate:"required,max=200"`
+	StartsAt   string `json:"starts_at" validate:"required"`
+	EndsAt     string `json:"ends_at" validate:"required"`
+	Location   string `json:"location,optional" validate:"max=200"`
+	BookingURL string `json:"booking_url,optional" validate:"max=500"`
+}
+
+type CreatePollRequest struct {
+	Question       string            `json:"question" validate:"required,max=500"`
+	MultipleChoice bool              `json:"multiple_choice,optional"`
+	ClosesAt       string            `json:"closes_at,optional"`
+	Options        []PollOptionInput `json:"options" validate:"min=2,max=20"`
+}
+
+type CreatePreviewRequest struct {
+	Type       string `json:"type" validate:"required,oneof=blog project"`
+	ID         string `json:"id" validate:"required,uuid"`
+	TTLMinutes int    `json:"ttl_minutes,optional" validate:"min=1,max=43200"`
+}
+
 type CreateProjectCommentRequest struct {
-	ID             string `path:"id"`
-	ParentId       string `json:"parent_id,optional"`
-	AuthorName     string `json:"author_name,optional"`
-	AuthorEmail    string `json:"author_email,optional"`
-	AuthorWebsite  string `json:"author_website,optional"`
-	Content        string `json:"content"`
+	ID             string `path:"id" validate:"uuid"`
+	ParentId       string `json:"parent_id,optional" validate:"uuid"`
+	AuthorName     string `json:"author_name,optional" validate:"max=100"`
+	AuthorEmail    string `json:"author_email,optional" validate:"email,max=255"`
+	AuthorWebsite  string `json:"author_website,optional" validate:"max=500"`
+	Content        string `json:"content" validate:"required,maxbytes=10000"`
 	Type           string `json:"type"`
 	IsApproved     bool   `json:"is_approved,optional"`
-	Fingerprint    string `json:"fingerprint"`
+	Fingerprint    string `json:"fingerprint" validate:"max=255"`
+	CaptchaToken   string `json:"captcha_token,optional" validate:"max=4096"`
+	NotifyReplies  bool   `json:"notify_replies,optional"`
+	NotifyComments bool   `json:"notify_comments,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	Language       string `form:"lang,default=en"`
 }
 
@@ -265,10 +868,65 @@ type CreateProjectRequest struct {
 	AnnualPlan  string   `json:"annual_plan"`
 }
 
+type CreateReportRequest struct {
+	ContentType   string `json:"content_type" validate:"required,oneof=post project idea image"`
+	ContentID     string `json:"content_id" validate:"required,uuid"`
+	Category      string `json:"category" validate:"required,oneof=spam abuse copyright privacy illegal other"`
+	Details       string `json:"details" validate:"required,max=5000"`
+	ReporterName  string `json:"reporter_name,optional" validate:"max=100"`
+	ReporterEmail string `json:"reporter_email,optional" validate:"email,max=255"`
+	Website       string `json:"website,optional"`
+	Fingerprint   string `json:"fingerprint,optional" validate:"max=255"`
+	CaptchaToken  string `json:"captcha_token,optional" validate:"max=4096"`
+	ClientIP      string `json:"client_ip,optional"`
+}
+
+type CreateReportResponse struct {
+	Received bool `json:"received"`
+}
+
+type CreateShortLinkRequest struct {
+	Code       string `json:"code,optional"`
+	TargetURL  string `json:"target_url,optional" validate:"max=2048"`
+	EntityType string `json:"entity_type,optional" validate:"oneof=blog project"`
+	EntityID   string `json:"entity_id,optional" validate:"uuid"`
+	Channel    string `json:"channel,optional" validate:"max=64"`
+}
+
+type CreateSiteUpdateRequest struct {
+	Title       string `json:"title" validate:"required,max=200"`
+	Description string `json:"description" validate:"required,maxbytes=10000"`
+	Kind        string `json:"kind,default=feature" validate:"oneof=feature section redesign improvement fix"`
+	Link        string `json:"link,optional" validate:"max=1024"`
+	PublishedAt string `json:"published_at,optional"`
+}
+
+type CreateToolRequest struct {
+	Category    string `json:"category" validate:"required,max=100"`
+	Name        string `json:"name" validate:"required,max=200"`
+	Description string `json:"description,optional"`
+	Link        string `json:"link,optional" validate:"max=1024"`
+	SortOrder   int    `json:"sort_order,optional"`
+}
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required,max=2048"`
+	Secret      string   `json:"secret,optional"`
+	Description string   `json:"description,optional"`
+	EventTypes  []string `json:"event_types"`
+	Active      bool     `json:"active,default=true"`
+}
+
+type CreateWebhookResponse struct {
+	Webhook WebhookData `json:"webhook"`
+	Secret  string      `json:"secret"`
+}
+
 type DeleteBlogCommentRequest struct {
 	CommentID      string `path:"comment_id"`
 	Fingerprint    string `json:"fingerprint"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	Language       string `form:"lang,default=en"`
@@ -278,22 +936,94 @@ type DeleteIdeaCommentRequest struct {
 	CommentID      string `path:"comment_id"`
 	Fingerprint    string `json:"fingerprint"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 }
 
 type DeleteIdeaRequest struct {
 	ID string `path:"id"`
 }
 
+type DeleteMyDataRequest struct {
+	Authorization string `header:"Authorization,optional"`
+	Email         string `json:"email,optional" validate:"email,max=255"`
+	Code          string `json:"code,optional" validate:"max=16"`
+	// Browser fingerprint, whose reading history is deleted too
+	Fingerprint string `json:"fingerprint,optional" validate:"max=255"`
+}
+
+type DeleteMyDataResponse struct {
+	Comments           int `json:"comments"`
+	AnonymizedComments int `json:"anonymized_comments"`
+	CommentLikes       int `json:"comment_likes"`
+	ProjectLikes       int `json:"project_likes"`
+	Views              int `json:"views"`
+	Reactions          int `json:"reactions"`
+	PollVotes          int `json:"poll_votes"`
+	Identities         int `json:"identities"`
+}
+
 type DeleteProjectCommentRequest struct {
 	CommentID      string `path:"comment_id"`
 	Fingerprint    string `json:"fingerprint"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 }
 
 type DeleteProjectRequest struct {
 	ID string `path:"id"`
 }
 
+type DeviceBreakdownDay struct {
+	Day      string         `json:"day"`
+	Devices  []SegmentCount `json:"devices"`
+	OS       []SegmentCount `json:"os"`
+	Browsers []SegmentCount `json:"browsers"`
+}
+
+type DeviceBreakdownRequest struct {
+	Days int    `form:"days,default=30"`
+	TZ   string `form:"tz,optional"`
+}
+
+type DeviceBreakdownResponse struct {
+	Since    string               `json:"since"`
+	Devices  []SegmentCount       `json:"devices"`
+	OS       []SegmentCount       `json:"os"`
+	Browsers []SegmentCount       `json:"browsers"`
+	Daily    []DeviceBreakdownDay `json:"daily"`
+}
+
+type DiffLine struct {
+	Op      string        `json:"op"`
+	OldLine int           `json:"old_line,omitempty"`
+	NewLine int           `json:"new_line,omitempty"`
+	Text    string        `json:"text"`
+	Words   []DiffSegment `json:"words,omitempty"`
+}
+
+type DiffSegment struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+type EditCommentRequest struct {
+	ID             string `path:"id" validate:"required,uuid"`
+	Content        string `json:"content" validate:"required,maxbytes=10000"`
+	Fingerprint    string `json:"fingerprint,optional" validate:"max=255"`
+	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
+	ClientIP       string `json:"client_ip,optional"`
+	UserAgentFull  string `json:"user_agent_full,optional"`
+}
+
+type EditCommentResponse struct {
+	ID       string `json:"id"`
+	Content  string `json:"content"`
+	IsEdited bool   `json:"is_edited"`
+	EditedAt string `json:"edited_at"`
+	Pending  bool   `json:"pending,omitempty"`
+}
+
 type Education struct {
 	ID                 string   `json:"id"`
 	UserID             string   `json:"user_id"`
@@ -313,6 +1043,73 @@ type Education struct {
 	UpdatedAt          string   `json:"updated_at"`
 }
 
+type EmailCodeRequest struct {
+	Email         string `json:"email" validate:"required,email,max=255"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
+}
+
+type EmailCodeResponse struct {
+	Sent      bool   `json:"sent"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type EmailVerifyRequest struct {
+	Email         string `json:"email,optional" validate:"email,max=255"`
+	Code          string `json:"code,optional" validate:"max=16"`
+	Token         string `json:"token,optional" validate:"max=128"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
+}
+
+type EmbedPostData struct {
+	Title       string `json:"title"`
+	Excerpt     string `json:"excerpt"`
+	URL         string `json:"url"`
+	HeroImage   string `json:"hero_image,omitempty"`
+	PublishedAt string `json:"published_at"`
+}
+
+type EmbedPostsRequest struct {
+	Limit int `form:"limit,default=6" validate:"min=1,max=50"`
+}
+
+type EmbedPostsResponse struct {
+	Posts []EmbedPostData `json:"posts"`
+}
+
+type EmbedProjectData struct {
+	Title        string   `json:"title"`
+	Description  string   `json:"description"`
+	URL          string   `json:"url"`
+	ThumbnailURL string   `json:"thumbnail_url,omitempty"`
+	GithubURL    string   `json:"github_url,omitempty"`
+	DemoURL      string   `json:"demo_url,omitempty"`
+	Technologies []string `json:"technologies"`
+	Year         int      `json:"year"`
+}
+
+type EmbedProjectsRequest struct {
+	Featured bool `form:"featured,optional"`
+	Limit    int  `form:"limit,default=6" validate:"min=1,max=50"`
+}
+
+type EmbedProjectsResponse struct {
+	Projects []EmbedProjectData `json:"projects"`
+}
+
+type EngagementRequest struct {
+	// Bearer session token from Google sign-in
+	Authorization string `header:"Authorization,optional"`
+}
+
+type EngagementResponse struct {
+	IdentityID      string `json:"identity_id"`
+	Comments        int    `json:"comments"`
+	LikesReceived   int    `json:"likes_received"`
+	RepliesReceived int    `json:"replies_received"`
+}
+
 type Experiment struct {
 	ID            string `json:"id"`
 	Title         string `json:"title"`
@@ -327,14 +1124,165 @@ type Experiment struct {
 	DataURL       string `json:"data_url,omitempty"`
 }
 
+type ExperimentAssignment struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant"`
+}
+
+type ExperimentAssignmentRequest struct {
+	Name        string `path:"name"`
+	Fingerprint string `form:"fingerprint,optional"`
+}
+
+type ExperimentAssignmentsRequest struct {
+	Fingerprint string `form:"fingerprint,optional"`
+}
+
+type ExperimentAssignmentsResponse struct {
+	Assignments []ExperimentAssignment `json:"assignments"`
+}
+
+type ExperimentConversionRequest struct {
+	Name           string `path:"name"`
+	Fingerprint    string `json:"fingerprint"`
+	Goal           string `json:"goal,optional"`
+	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
+	ClientIP       string `json:"client_ip,optional"`
+	UserAgentFull  string `json:"user_agent_full,optional"`
+}
+
+type ExperimentConversionResponse struct {
+	Experiment string `json:"experiment"`
+	Variant    string `json:"variant"`
+	Goal       string `json:"goal"`
+	Recorded   bool   `json:"recorded"`
+}
+
+type ExperimentResultsRequest struct {
+	Name string `path:"name"`
+	Goal string `form:"goal,optional"`
+	Days int    `form:"days,default=30"`
+	TZ   string `form:"tz,optional"`
+}
+
+type ExperimentResultsResponse struct {
+	Experiment string                    `json:"experiment"`
+	Since      string                    `json:"since"`
+	Variants   []ExperimentVariantResult `json:"variants"`
+}
+
+type ExperimentVariantResult struct {
+	Variant        string  `json:"variant"`
+	Exposures      int     `json:"exposures"`
+	Conversions    int     `json:"conversions"`
+	ConversionRate float64 `json:"conversion_rate"`
+}
+
+type ExportIdeasRequest struct {
+	Format string `form:"format" validate:"required,oneof=notion org"`
+}
+
+type ExportIdeasResponse struct {
+	Format  string `json:"format"`
+	Count   int    `json:"count"`
+	Content string `json:"content"`
+}
+
+type FAQAdminData struct {
+	ID              string               `json:"id"`
+	Category        string               `json:"category"`
+	Question        string               `json:"question"`
+	Answer          string               `json:"answer"`
+	SortOrder       int                  `json:"sort_order"`
+	Translations    []FAQTranslationData `json:"translations"`
+	HelpfulCount    int                  `json:"helpful_count"`
+	NotHelpfulCount int                  `json:"not_helpful_count"`
+	CreatedAt       string               `json:"created_at"`
+	UpdatedAt       string               `json:"updated_at"`
+}
+
+type FAQAdminListResponse struct {
+	Items []FAQAdminData `json:"items"`
+}
+
+type FAQCategory struct {
+	Name  string    `json:"name"`
+	Items []FAQData `json:"items"`
+}
+
+type FAQData struct {
+	ID        string `json:"id"`
+	Category  string `json:"category"`
+	Question  string `json:"question"`
+	Answer    string `json:"answer"`
+	SortOrder int    `json:"sort_order"`
+}
+
+type FAQFeedbackRequest struct {
+	ID             string `path:"id"`
+	Helpful        bool   `json:"helpful"`
+	Fingerprint    string `json:"fingerprint,optional"`
+	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
+	ClientIP       string `json:"client_ip,optional"`
+	UserAgentFull  string `json:"user_agent_full,optional"`
+}
+
+type FAQFeedbackResponse struct {
+	Recorded bool `json:"recorded"`
+}
+
+type FAQListRequest struct {
+	Category string `form:"category,optional"`
+	Language string `form:"lang,default=en"`
+}
+
+type FAQListResponse struct {
+	Categories []FAQCategory `json:"categories"`
+}
+
+type FAQRequest struct {
+	ID       string `path:"id"`
+	Language string `form:"lang,default=en"`
+}
+
+type FAQTranslationData struct {
+	Language string `json:"language" validate:"required,max=8"`
+	Question string `json:"question" validate:"required,max=500"`
+	Answer   string `json:"answer" validate:"required,maxbytes=20000"`
+}
+
+type FeedData struct {
+	Kind  string `json:"kind"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+type FeedIndexResponse struct {
+	Feeds []FeedData `json:"feeds"`
+}
+
+type FeedRequest struct {
+	Slug string `path:"slug"`
+}
+
 type FeedbackType struct {
 	Type          string `json:"type"`
 	Description   string `json:"description"`
 	DescriptionZh string `json:"description_zh,omitempty"`
 }
 
+type GenerateDraftsRequest struct {
+	Type      string `json:"type" validate:"required,oneof=blog project idea"`
+	ID        string `json:"id" validate:"required,uuid"`
+	Translate bool   `json:"translate,optional"`
+}
+
 type GoogleVerifyRequest struct {
-	IdToken string `json:"id_token"`
+	IdToken       string `json:"id_token"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
 }
 
 type GoogleVerifyResponse struct {
@@ -344,6 +1292,12 @@ type GoogleVerifyResponse struct {
 	AvatarURL string `json:"avatar_url,optional"`
 	Provider  string `json:"provider"`
 	Verified  bool   `json:"verified"`
+	// Session token for later requests; empty while sessions are disabled
+	SessionToken     string `json:"session_token,omitempty"`
+	SessionExpiresAt string `json:"session_expires_at,omitempty"`
+	// Renews the session token through /auth/refresh
+	RefreshToken     string `json:"refresh_token,omitempty"`
+	RefreshExpiresAt string `json:"refresh_expires_at,omitempty"`
 }
 
 type GraphData struct {
@@ -383,18 +1337,30 @@ type IdeaCommentData struct {
 	UserIdentityID  string            `json:"user_identity_id,optional"`
 	LikesCount      int               `json:"likes_count"`
 	IsLikedByUser   bool              `json:"is_liked_by_user"`
+	IsAuthor        bool              `json:"is_author"`
+	EmailVerified   bool              `json:"email_verified"`
+	IsEdited        bool              `json:"is_edited,omitempty"`
+	EditedAt        string            `json:"edited_at,omitempty"`
+	IsDeleted       bool              `json:"is_deleted,omitempty"`
+	DeletedAt       string            `json:"deleted_at,omitempty"`
+	Pending         bool              `json:"pending,omitempty"`
+	Language        string            `json:"language,omitempty"`
 	Replies         []IdeaCommentData `json:"replies,optional"`
 }
 
 type IdeaCommentListRequest struct {
-	ID       string `path:"id"`
-	Type     string `form:"type,default=general"`
-	Language string `form:"lang,default=en"`
+	ID              string `path:"id"`
+	Type            string `form:"type,default=general"`
+	Language        string `form:"lang,default=en"`
+	Sort            string `form:"sort,default=oldest" validate:"oneof=newest oldest most_liked"`
+	CommentLanguage string `form:"comment_lang,optional"`
+	GroupByLanguage bool   `form:"group_by_language,optional"`
 }
 
 type IdeaCommentListResponse struct {
-	Comments []IdeaCommentData `json:"comments"`
-	Total    int               `json:"total"`
+	Comments  []IdeaCommentData      `json:"comments"`
+	Total     int                    `json:"total"`
+	Languages []CommentLanguageGroup `json:"languages,omitempty"`
 }
 
 type IdeaData struct {
@@ -428,6 +1394,17 @@ type IdeaData struct {
 	FundingStatus        string               `json:"funding_status,omitempty"`
 }
 
+type IdeaDetailInput struct {
+	Progress                string  `json:"progress,optional"`
+	Results                 string  `json:"results,optional"`
+	References              string  `json:"references,optional"`
+	EstimatedDurationMonths int     `json:"estimated_duration_months,optional"`
+	RequiredResources       string  `json:"required_resources,optional"`
+	CollaborationNeeded     bool    `json:"collaboration_needed,optional"`
+	FundingRequired         bool    `json:"funding_required,optional"`
+	EstimatedBudget         float64 `json:"estimated_budget,optional"`
+}
+
 type IdeaListRequest struct {
 	Page          int    `form:"page,default=1"`
 	Size          int    `form:"size,default=10"`
@@ -466,23 +1443,95 @@ type IdeaRequest struct {
 }
 
 type IdeaSearchRequest struct {
-	Query    string `form:"query,optional"`
-	Category string `form:"category,optional"`
-	Status   string `form:"status,optional"`
-	Tags     string `form:"tags,optional"`
-	Language string `form:"lang,default=en"`
-	Page     int    `form:"page,default=1"`
-	Size     int    `form:"size,default=10"`
+	Query       string `form:"query,optional"`
+	Category    string `form:"category,optional"`
+	Status      string `form:"status,optional"`
+	Tags        string `form:"tags,optional"`
+	Language    string `form:"lang,default=en"`
+	Page        int    `form:"page,default=1"`
+	Size        int    `form:"size,default=10"`
+	Fingerprint string `form:"fingerprint,optional"`
 }
 
 type IdeaTagsRequest struct {
 	Language string `form:"lang,default=en"`
 }
 
+type IdeaTranslationInput struct {
+	LanguageCode      string `json:"language_code" validate:"required,max=5"`
+	Title             string `json:"title" validate:"required,max=300"`
+	Abstract          string `json:"abstract,optional"`
+	Motivation        string `json:"motivation,optional"`
+	Methodology       string `json:"methodology,optional"`
+	ExpectedOutcome   string `json:"expected_outcome,optional"`
+	RequiredResources string `json:"required_resources,optional"`
+}
+
+type ImageVariant struct {
+	URL    string `json:"url" validate:"required,max=500"`
+	Width  int    `json:"width" validate:"required,min=1,max=10000"`
+	Format string `json:"format" validate:"required,oneof=jpeg png webp avif"`
+}
+
+type ImportIdeasRequest struct {
+	DryRun  bool   `form:"dry_run,optional"`
+	Format  string `json:"format" validate:"required,oneof=notion org"`
+	Content string `json:"content" validate:"required,maxbytes=5242880"`
+}
+
+type ImportIdeasResponse struct {
+	DryRun bool                    `json:"dry_run,omitempty"`
+	Ideas  []ContentUpsertResponse `json:"ideas"`
+}
+
+type InquiryData struct {
+	ID           string `json:"id"`
+	ProjectID    string `json:"project_id"`
+	ProjectTitle string `json:"project_title"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	Company      string `json:"company,omitempty"`
+	Budget       string `json:"budget"`
+	Timeline     string `json:"timeline"`
+	Message      string `json:"message"`
+	IP           string `json:"ip,omitempty"`
+	CreatedAt    string `json:"created_at"`
+}
+
+type InquiryListRequest struct {
+	ProjectID string `form:"project_id,optional" validate:"uuid"`
+}
+
+type InquiryListResponse struct {
+	Inquiries []InquiryData `json:"inquiries"`
+}
+
+type JsonLdRequest struct {
+	Slug string `path:"slug"`
+}
+
+type LanguageCount struct {
+	Language string  `json:"language"`
+	Requests int     `json:"requests"`
+	Visitors int     `json:"visitors"`
+	Share    float64 `json:"share"`
+}
+
+type LanguageStatsRequest struct {
+	Days int    `form:"days,default=30"`
+	TZ   string `form:"tz,optional"`
+}
+
+type LanguageStatsResponse struct {
+	Since    string                 `json:"since"`
+	Sections []SectionLanguageStats `json:"sections"`
+}
+
 type LikeCommentRequest struct {
-	CommentID      string `path:"comment_id"`
-	Fingerprint    string `json:"fingerprint"`
+	CommentID      string `path:"comment_id" validate:"uuid"`
+	Fingerprint    string `json:"fingerprint" validate:"max=255"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	Language       string `form:"lang,default=en"`
@@ -494,17 +1543,175 @@ type LikeCommentResponse struct {
 }
 
 type LikeProjectRequest struct {
-	ProjectID      string `path:"id"`
-	Fingerprint    string `json:"fingerprint"`
+	ProjectID      string `path:"id" validate:"uuid"`
+	Fingerprint    string `json:"fingerprint" validate:"max=255"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	Language       string `form:"lang,default=en"`
 }
 
-type LikeProjectResponse struct {
-	LikesCount    int  `json:"likes_count"`
-	IsLikedByUser bool `json:"is_liked_by_user"`
+type LikeProjectResponse struct {
+	LikesCount    int  `json:"likes_count"`
+	IsLikedByUser bool `json:"is_liked_by_user"`
+}
+
+type LikeStatusRequest struct {
+	CommentIDs     []string `json:"comment_ids,optional" validate:"max=500"`
+	ProjectIDs     []string `json:"project_ids,optional" validate:"max=500"`
+	Fingerprint    string   `json:"fingerprint,optional" validate:"max=255"`
+	UserIdentityId string   `json:"user_identity_id,optional"`
+	SessionToken   string   `json:"session_token,optional"`
+}
+
+type LikeStatusResponse struct {
+	Comments map[string]bool `json:"comments"`
+	Projects map[string]bool `json:"projects"`
+}
+
+type LinkSuggestionData struct {
+	Type       string   `json:"type"`
+	ID         string   `json:"id"`
+	Slug       string   `json:"slug"`
+	Title      string   `json:"title"`
+	URL        string   `json:"url"`
+	Score      float64  `json:"score"`
+	SharedTags []string `json:"shared_tags"`
+	Keywords   []string `json:"keywords"`
+	Anchors    []string `json:"anchors"`
+}
+
+type LinkSuggestionListResponse struct {
+	Suggestions []LinkSuggestionData `json:"suggestions"`
+}
+
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token,optional"`
+	SessionToken string `json:"session_token,optional"`
+	// Revoke every session of the signed-in identity
+	All           bool   `json:"all,optional"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
+}
+
+type LogoutResponse struct {
+	Revoked int64 `json:"revoked"`
+}
+
+type ModerateCommentRequest struct {
+	ID string `path:"id"`
+}
+
+type ModerationLinkRequest struct {
+	Token string `form:"token" validate:"required,max=512"`
+}
+
+type ModerationLinkResponse struct {
+	Status    string `json:"status"`
+	CommentID string `json:"comment_id"`
+}
+
+type MyApiKeyUsageRequest struct {
+	Days int `form:"days,default=30"`
+}
+
+type MyCommentData struct {
+	ID          string `json:"id"`
+	EntityType  string `json:"entity_type"`
+	EntityID    string `json:"entity_id"`
+	EntityTitle string `json:"entity_title"`
+	URL         string `json:"url"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Content     string `json:"content"`
+	CreatedAt   string `json:"created_at"`
+	// published or pending
+	Status     string `json:"status"`
+	IsEdited   bool   `json:"is_edited"`
+	LikesCount int    `json:"likes_count"`
+}
+
+type MyCommentsRequest struct {
+	// Bearer session token; without one the comments written from the
+	// browser with fingerprint are listed
+	Authorization string `header:"Authorization,optional"`
+	Fingerprint   string `form:"fingerprint,optional" validate:"max=255"`
+	// blog, idea, project or ama; empty lists all
+	Kind string `form:"kind,optional"`
+	Page int    `form:"page,default=1"`
+	Size int    `form:"size,default=20"`
+}
+
+type MyCommentsResponse struct {
+	Comments []MyCommentData `json:"comments"`
+	Total    int             `json:"total"`
+	Page     int             `json:"page"`
+	Size     int             `json:"size"`
+}
+
+type OfficeHourRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
+type OfficeHourSlotData struct {
+	ID         string `json:"id"`
+	Title      string `json:"title"`
+	StartsAt   string `json:"starts_at"`
+	EndsAt     string `json:"ends_at"`
+	Location   string `json:"location,omitempty"`
+	BookingURL string `json:"booking_url,omitempty"`
+}
+
+type OwnerReplyRequest struct {
+	ID               string `path:"id" validate:"required,uuid"`
+	Content          string `json:"content,optional" validate:"maxbytes=10000"`
+	CannedResponseID string `json:"canned_response_id,optional" validate:"uuid"`
+}
+
+type OwnerReplyResponse struct {
+	ID             string `json:"id"`
+	EntityType     string `json:"entity_type"`
+	EntityID       string `json:"entity_id"`
+	ParentID       string `json:"parent_id"`
+	AuthorName     string `json:"author_name"`
+	Content        string `json:"content"`
+	CreatedAt      string `json:"created_at"`
+	ParentApproved bool   `json:"parent_approved"`
+}
+
+type PageCount struct {
+	Path  string `json:"path"`
+	Count int    `json:"count"`
+}
+
+type PendingCommentData struct {
+	ID          string   `json:"id"`
+	EntityType  string   `json:"entity_type"`
+	EntityID    string   `json:"entity_id"`
+	ParentID    string   `json:"parent_id,omitempty"`
+	AuthorName  string   `json:"author_name"`
+	AuthorEmail string   `json:"author_email"`
+	Content     string   `json:"content"`
+	IPAddress   string   `json:"ip_address,omitempty"`
+	CreatedAt   string   `json:"created_at"`
+	SpamScore   float64  `json:"spam_score,omitempty"`
+	SpamReasons []string `json:"spam_reasons,omitempty"`
+}
+
+type PendingCommentListResponse struct {
+	Comments []PendingCommentData `json:"comments"`
+}
+
+type PersonJsonLd struct {
+	Context  string   `json:"@context,omitempty"`
+	Type     string   `json:"@type"`
+	Name     string   `json:"name"`
+	JobTitle string   `json:"jobTitle,omitempty"`
+	URL      string   `json:"url,omitempty"`
+	Image    string   `json:"image,omitempty"`
+	Email    string   `json:"email,omitempty"`
+	Address  string   `json:"address,omitempty"`
+	SameAs   []string `json:"sameAs,omitempty"`
 }
 
 type PersonalInfo struct {
@@ -535,6 +1742,114 @@ type PlanProject struct {
 	Description string `json:"description"`
 }
 
+type PollData struct {
+	ID             string           `json:"id"`
+	Question       string           `json:"question"`
+	MultipleChoice bool             `json:"multiple_choice"`
+	ClosesAt       string           `json:"closes_at,omitempty"`
+	IsClosed       bool             `json:"is_closed"`
+	Options        []PollOptionData `json:"options"`
+	TotalVoters    int              `json:"total_voters"`
+	VotedOptionIDs []string         `json:"voted_option_ids,omitempty"`
+	CreatedAt      string           `json:"created_at"`
+	UpdatedAt      string           `json:"updated_at"`
+}
+
+type PollListResponse struct {
+	Polls []PollData `json:"polls"`
+}
+
+type PollOptionData struct {
+	ID    string `json:"id"`
+	Text  string `json:"text"`
+	Votes int    `json:"votes"`
+}
+
+type PollOptionInput struct {
+	ID   string `json:"id,optional" validate:"uuid"`
+	Text string `json:"text" validate:"required,max=255"`
+}
+
+type PollRequest struct {
+	ID             string `path:"id"`
+	Fingerprint    string `form:"fingerprint,optional"`
+	UserIdentityId string `form:"user_identity_id,optional"`
+	SessionToken   string `form:"session_token,optional"`
+}
+
+type PollVoteRequest struct {
+	ID             string   `path:"id"`
+	OptionIDs      []string `json:"option_ids" validate:"required,max=20"`
+	Fingerprint    string   `json:"fingerprint"`
+	UserIdentityId string   `json:"user_identity_id,optional"`
+	SessionToken   string   `json:"session_token,optional"`
+	ClientIP       string   `json:"client_ip,optional"`
+	UserAgentFull  string   `json:"user_agent_full,optional"`
+}
+
+type PostAudioRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
+type PostAudioResponse struct {
+	PostID          string `json:"post_id"`
+	URL             string `json:"url"`
+	ContentType     string `json:"content_type"`
+	Length          int64  `json:"length"`
+	DurationSeconds int    `json:"duration_seconds"`
+}
+
+type PostHeroResponse struct {
+	PostID       string         `json:"post_id"`
+	HeroImage    string         `json:"hero_image"`
+	HeroVariants []ImageVariant `json:"hero_variants"`
+}
+
+type PostRevisionData struct {
+	Number    int    `json:"number"`
+	Title     string `json:"title"`
+	Hash      string `json:"hash"`
+	CreatedAt string `json:"created_at"`
+}
+
+type PostRevisionListResponse struct {
+	PostID    string             `json:"post_id"`
+	Revisions []PostRevisionData `json:"revisions"`
+}
+
+type PostRevisionsRequest struct {
+	ID string `path:"id"`
+}
+
+type PostTranslationInput struct {
+	LanguageCode string `json:"language_code" validate:"required,max=5"`
+	Title        string `json:"title" validate:"required,max=500"`
+	Excerpt      string `json:"excerpt,optional"`
+	Content      string `json:"content" validate:"required"`
+}
+
+type PreviewData struct {
+	Token     string `json:"token"`
+	URL       string `json:"url"`
+	ExpiresAt string `json:"expires_at"`
+}
+
+type ProfileRequest struct {
+	Authorization string `header:"Authorization,optional"`
+}
+
+type ProfileResponse struct {
+	ID          string `json:"id"`
+	Email       string `json:"email"`
+	DisplayName string `json:"display_name"`
+	AvatarURL   string `json:"avatar_url"`
+	Provider    string `json:"provider"`
+	Verified    bool   `json:"verified"`
+	// The display name was set on the profile and is used on comments in
+	// place of the provider's
+	DisplayNameEdited bool `json:"display_name_edited"`
+}
+
 type Project struct {
 	ID          string   `json:"id"`
 	Name        string   `json:"name"`
@@ -560,6 +1875,7 @@ type ProjectBlogRef struct {
 type ProjectByIdRequest struct {
 	ID       string `path:"id"`
 	Language string `form:"lang,default=en"`
+	Preview  string `form:"preview,optional"`
 }
 
 type ProjectCommentData struct {
@@ -574,18 +1890,30 @@ type ProjectCommentData struct {
 	UserIdentityID  string               `json:"user_identity_id,optional"`
 	LikesCount      int                  `json:"likes_count"`
 	IsLikedByUser   bool                 `json:"is_liked_by_user"`
+	IsAuthor        bool                 `json:"is_author"`
+	EmailVerified   bool                 `json:"email_verified"`
+	IsEdited        bool                 `json:"is_edited,omitempty"`
+	EditedAt        string               `json:"edited_at,omitempty"`
+	IsDeleted       bool                 `json:"is_deleted,omitempty"`
+	DeletedAt       string               `json:"deleted_at,omitempty"`
+	Pending         bool                 `json:"pending,omitempty"`
+	Language        string               `json:"language,omitempty"`
 	Replies         []ProjectCommentData `json:"replies,optional"`
 }
 
 type ProjectCommentListRequest struct {
-	ID       string `path:"id"`
-	Type     string `form:"type,default=general"`
-	Language string `form:"lang,default=en"`
+	ID              string `path:"id"`
+	Type            string `form:"type,default=general"`
+	Language        string `form:"lang,default=en"`
+	Sort            string `form:"sort,default=oldest" validate:"oneof=newest oldest most_liked"`
+	CommentLanguage string `form:"comment_lang,optional"`
+	GroupByLanguage bool   `form:"group_by_language,optional"`
 }
 
 type ProjectCommentListResponse struct {
-	Comments []ProjectCommentData `json:"comments"`
-	Total    int                  `json:"total"`
+	Comments  []ProjectCommentData   `json:"comments"`
+	Total     int                    `json:"total"`
+	Languages []CommentLanguageGroup `json:"languages,omitempty"`
 }
 
 type ProjectDetail struct {
@@ -605,9 +1933,31 @@ type ProjectDetail struct {
 	UpdatedAt           string           `json:"updated_at"`
 }
 
+type ProjectDetailInput struct {
+	ProjectDetails string                          `json:"project_details,optional"`
+	QuickStart     string                          `json:"quick_start,optional"`
+	ReleaseNotes   string                          `json:"release_notes,optional"`
+	Dependencies   string                          `json:"dependencies,optional"`
+	License        string                          `json:"license,optional" validate:"max=50"`
+	LicenseText    string                          `json:"license_text,optional"`
+	Version        string                          `json:"version,optional" validate:"max=20"`
+	Translations   []ProjectDetailTranslationInput `json:"translations,optional"`
+}
+
 type ProjectDetailRequest struct {
 	ID       string `path:"id"`
 	Language string `form:"lang,default=en"`
+	Preview  string `form:"preview,optional"`
+}
+
+type ProjectDetailTranslationInput struct {
+	LanguageCode        string `json:"language_code" validate:"required,max=5"`
+	DetailedDescription string `json:"detailed_description,optional"`
+	Goals               string `json:"goals,optional"`
+	Challenges          string `json:"challenges,optional"`
+	Solutions           string `json:"solutions,optional"`
+	LessonsLearned      string `json:"lessons_learned,optional"`
+	FutureEnhancements  string `json:"future_enhancements,optional"`
 }
 
 type ProjectExtended struct {
@@ -675,6 +2025,7 @@ type ProjectMetricsRequest struct {
 	ProjectID      string `path:"id"`
 	Fingerprint    string `form:"fingerprint,optional"`
 	UserIdentityId string `form:"user_identity_id,optional"`
+	SessionToken   string `form:"session_token,optional"`
 	Language       string `form:"lang,default=en"`
 }
 
@@ -687,14 +2038,21 @@ type ProjectMetricsResponse struct {
 type ProjectRequest struct {
 	Slug     string `path:"slug"`
 	Language string `form:"lang,default=en"`
+	Preview  string `form:"preview,optional"`
 }
 
 type ProjectSearchRequest struct {
-	Query    string `form:"query,optional"`
-	Tags     string `form:"tags,optional"`
-	Year     int    `form:"year,optional"`
-	PlanID   string `form:"plan_id,optional"`
-	Language string `form:"lang,default=en"`
+	Query       string `form:"query,optional"`
+	Tags        string `form:"tags,optional"`
+	Year        int    `form:"year,optional"`
+	PlanID      string `form:"plan_id,optional"`
+	Language    string `form:"lang,default=en"`
+	Fingerprint string `form:"fingerprint,optional"`
+}
+
+type ProjectTechnologyInput struct {
+	Name string `json:"name" validate:"required,max=100"`
+	Type string `json:"type,optional" validate:"max=50"`
 }
 
 type ProjectTimeline struct {
@@ -703,6 +2061,13 @@ type ProjectTimeline struct {
 	Duration string `json:"duration"`
 }
 
+type ProjectTranslationInput struct {
+	LanguageCode string `json:"language_code" validate:"required,max=5"`
+	Title        string `json:"title" validate:"required,max=300"`
+	Description  string `json:"description,optional"`
+	ProjectType  string `json:"project_type,optional" validate:"max=50"`
+}
+
 type ProjectsByPlanRequest struct {
 	PlanName string `path:"plan_name"`
 	Language string `form:"lang,default=en"`
@@ -728,6 +2093,49 @@ type Publication struct {
 	UpdatedAt     string `json:"updated_at"`
 }
 
+type ReactionCountsRequest struct {
+	EntityType string `form:"entity_type" validate:"required,oneof=comment post project"`
+	IDs        string `form:"ids" validate:"required,max=4000"`
+}
+
+type ReactionCountsResponse struct {
+	Emoji  []string                  `json:"emoji"`
+	Counts map[string]map[string]int `json:"counts"`
+}
+
+type ReactionStatusRequest struct {
+	EntityType     string   `json:"entity_type" validate:"required,oneof=comment post project"`
+	EntityIDs      []string `json:"entity_ids" validate:"max=500"`
+	Fingerprint    string   `json:"fingerprint,optional" validate:"max=255"`
+	UserIdentityId string   `json:"user_identity_id,optional"`
+	SessionToken   string   `json:"session_token,optional"`
+}
+
+type ReactionStatusResponse struct {
+	Reactions map[string][]string `json:"reactions"`
+}
+
+type ReadingBeaconEntry struct {
+	PostID       string `json:"post_id"`
+	ScrollDepth  int    `json:"scroll_depth"`
+	DwellSeconds int    `json:"dwell_seconds"`
+}
+
+type ReadingBeaconRequest struct {
+	Fingerprint string               `json:"fingerprint"`
+	Entries     []ReadingBeaconEntry `json:"entries"`
+	DoNotTrack  bool                 `json:"do_not_track,optional"`
+}
+
+type ReadingBeaconResponse struct {
+	Recorded int `json:"recorded"`
+}
+
+type RebuildFeedsRequest struct {
+	Type string `json:"type,default=all"`
+	ID   string `json:"id,optional"`
+}
+
 type RecentUpdate struct {
 	ID          string   `json:"id"`
 	UserID      string   `json:"user_id"`
@@ -746,9 +2154,11 @@ type RecordProjectViewRequest struct {
 	ProjectID      string `path:"id"`
 	Fingerprint    string `json:"fingerprint"`
 	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
 	ClientIP       string `json:"client_ip,optional"`
 	UserAgentFull  string `json:"user_agent_full,optional"`
 	Language       string `form:"lang,default=en"`
+	DoNotTrack     bool   `json:"do_not_track,optional"`
 }
 
 type RecordProjectViewResponse struct {
@@ -756,6 +2166,10 @@ type RecordProjectViewResponse struct {
 	ViewRecorded bool `json:"view_recorded"`
 }
 
+type RedeliverWebhookRequest struct {
+	DeliveryID string `path:"delivery_id"`
+}
+
 type Reference struct {
 	ID      string   `json:"id"`
 	Title   string   `json:"title"`
@@ -768,6 +2182,38 @@ type Reference struct {
 	NotesZh string   `json:"notes_zh,omitempty"`
 }
 
+type RefreshSessionRequest struct {
+	RefreshToken  string `json:"refresh_token"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
+}
+
+type ReportData struct {
+	ID            string `json:"id"`
+	ContentType   string `json:"content_type"`
+	ContentID     string `json:"content_id"`
+	ContentTitle  string `json:"content_title"`
+	Category      string `json:"category"`
+	Details       string `json:"details"`
+	ReporterName  string `json:"reporter_name,omitempty"`
+	ReporterEmail string `json:"reporter_email,omitempty"`
+	IP            string `json:"ip,omitempty"`
+	Status        string `json:"status"`
+	Resolution    string `json:"resolution,omitempty"`
+	CreatedAt     string `json:"created_at"`
+	ResolvedAt    string `json:"resolved_at,omitempty"`
+}
+
+type ReportListRequest struct {
+	Status      string `form:"status,optional" validate:"oneof=open resolved dismissed"`
+	ContentType string `form:"content_type,optional" validate:"oneof=post project idea image"`
+	ContentID   string `form:"content_id,optional" validate:"uuid"`
+}
+
+type ReportListResponse struct {
+	Reports []ReportData `json:"reports"`
+}
+
 type ResearchProject struct {
 	ID          string   `json:"id"`
 	UserID      string   `json:"user_id"`
@@ -782,6 +2228,12 @@ type ResearchProject struct {
 	UpdatedAt   string   `json:"updated_at"`
 }
 
+type ResolveReportRequest struct {
+	ID         string `path:"id" validate:"uuid"`
+	Status     string `json:"status" validate:"required,oneof=open resolved dismissed"`
+	Resolution string `json:"resolution,optional" validate:"max=2000"`
+}
+
 type ResumeData struct {
 	PersonalInfo  PersonalInfo      `json:"personal_info"`
 	Education     []Education       `json:"education"`
@@ -797,6 +2249,81 @@ type ResumeRequest struct {
 	Language string `form:"lang,default=en"`
 }
 
+type RevisionDiffRequest struct {
+	ID string `path:"id"`
+	A  int    `path:"a"`
+	B  int    `path:"b"`
+}
+
+type RevisionDiffResponse struct {
+	PostID  string           `json:"post_id"`
+	From    PostRevisionData `json:"from"`
+	To      PostRevisionData `json:"to"`
+	Added   int              `json:"added"`
+	Removed int              `json:"removed"`
+	Lines   []DiffLine       `json:"lines"`
+}
+
+type RevokeSessionRequest struct {
+	// Bearer session token
+	Authorization string `header:"Authorization,optional"`
+	ID            string `path:"id" validate:"required,uuid"`
+}
+
+type SchedulePostRequest struct {
+	ID        string `path:"id"`
+	PublishAt string `json:"publish_at" validate:"required"`
+}
+
+type ScheduledPostData struct {
+	PostID    string `json:"post_id"`
+	Title     string `json:"title"`
+	PublishAt string `json:"publish_at"`
+	CreatedAt string `json:"created_at"`
+}
+
+type ScheduledPostListResponse struct {
+	Posts []ScheduledPostData `json:"posts"`
+}
+
+type ScheduledPostRequest struct {
+	ID string `path:"id"`
+}
+
+type SearchReportRequest struct {
+	Scope string `form:"scope,optional"`
+	Days  int    `form:"days,default=30"`
+	Limit int    `form:"limit,default=20"`
+	TZ    string `form:"tz,optional"`
+}
+
+type SearchReportResponse struct {
+	Since              string           `json:"since"`
+	TotalSearches      int              `json:"total_searches"`
+	ZeroResultSearches int              `json:"zero_result_searches"`
+	TopSearches        []SearchTermStat `json:"top_searches"`
+	ZeroResultTerms    []SearchTermStat `json:"zero_result_terms"`
+}
+
+type SearchTermStat struct {
+	Term       string  `json:"term"`
+	Searches   int     `json:"searches"`
+	Searchers  int     `json:"searchers"`
+	AvgResults float64 `json:"avg_results"`
+}
+
+type SectionLanguageStats struct {
+	Section   string          `json:"section"`
+	Requests  int             `json:"requests"`
+	Languages []LanguageCount `json:"languages"`
+}
+
+type SegmentCount struct {
+	Name     string `json:"name"`
+	Visitors int    `json:"visitors"`
+	Requests int    `json:"requests"`
+}
+
 type SeriesEpisode struct {
 	ID        string `json:"id"`
 	Title     string `json:"title"`
@@ -806,6 +2333,123 @@ type SeriesEpisode struct {
 	Order     int    `json:"order"`
 }
 
+type SessionData struct {
+	ID         string `json:"id"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  string `json:"created_at"`
+	LastSeenAt string `json:"last_seen_at"`
+	ExpiresAt  string `json:"expires_at"`
+	// Whether this is the session making the request
+	Current bool `json:"current"`
+}
+
+type SessionListRequest struct {
+	// Bearer session token
+	Authorization string `header:"Authorization,optional"`
+}
+
+type SessionListResponse struct {
+	Sessions []SessionData `json:"sessions"`
+}
+
+type SessionResponse struct {
+	SessionToken     string `json:"session_token"`
+	SessionExpiresAt string `json:"session_expires_at"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresAt string `json:"refresh_expires_at"`
+}
+
+type SessionStatsRequest struct {
+	Days  int    `form:"days,default=30"`
+	Limit int    `form:"limit,default=10"`
+	TZ    string `form:"tz,optional"`
+}
+
+type SessionStatsResponse struct {
+	Since              string      `json:"since"`
+	Sessions           int         `json:"sessions"`
+	Visitors           int         `json:"visitors"`
+	PageViews          int         `json:"page_views"`
+	BounceRate         float64     `json:"bounce_rate"`
+	PagesPerSession    float64     `json:"pages_per_session"`
+	AvgDurationSeconds float64     `json:"avg_duration_seconds"`
+	EntryPages         []PageCount `json:"entry_pages"`
+	ExitPages          []PageCount `json:"exit_pages"`
+}
+
+type SetAnalyticsOptOutRequest struct {
+	OptOut            bool `json:"opt_out"`
+	BrowserDoNotTrack bool `json:"browser_do_not_track,optional"`
+}
+
+type SetPostAudioRequest struct {
+	ID              string `path:"id" validate:"uuid"`
+	URL             string `json:"url" validate:"required,max=500"`
+	ContentType     string `json:"content_type,optional" validate:"max=64"`
+	Length          int64  `json:"length,optional" validate:"min=0"`
+	DurationSeconds int    `json:"duration_seconds,optional" validate:"min=0"`
+}
+
+type SetPostHeroRequest struct {
+	ID       string         `path:"id" validate:"uuid"`
+	URL      string         `json:"url" validate:"required,max=500"`
+	Variants []ImageVariant `json:"variants,optional"`
+}
+
+type ShortLinkData struct {
+	Code       string `json:"code"`
+	ShortURL   string `json:"short_url"`
+	TargetURL  string `json:"target_url"`
+	Channel    string `json:"channel,omitempty"`
+	EntityType string `json:"entity_type,omitempty"`
+	EntityID   string `json:"entity_id,omitempty"`
+	Clicks     int    `json:"clicks"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type ShortLinkListResponse struct {
+	Links []ShortLinkData `json:"links"`
+}
+
+type ShortLinkRedirectRequest struct {
+	Code          string `path:"code"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
+	Referrer      string `json:"referrer,optional"`
+}
+
+type ShortLinkRedirectResponse struct {
+	TargetURL string `json:"target_url"`
+}
+
+type ShortLinkRequest struct {
+	Code string `path:"code"`
+}
+
+type SiteUpdateData struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Kind        string `json:"kind"`
+	Link        string `json:"link,omitempty"`
+	PublishedAt string `json:"published_at"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type SiteUpdateListRequest struct {
+	Limit int `form:"limit,default=20"`
+}
+
+type SiteUpdateListResponse struct {
+	Updates []SiteUpdateData `json:"updates"`
+}
+
+type SiteUpdateRequest struct {
+	ID string `path:"id"`
+}
+
 type SocialLink struct {
 	ID          string `json:"id"`
 	Platform    string `json:"platform"`
@@ -815,10 +2459,182 @@ type SocialLink struct {
 	SortOrder   int    `json:"sort_order"`
 }
 
+type SocialVerifyRequest struct {
+	Code          string `json:"code" validate:"required,max=512"`
+	RedirectURI   string `json:"redirect_uri,optional" validate:"max=2048"`
+	ClientIP      string `json:"client_ip,optional"`
+	UserAgentFull string `json:"user_agent_full,optional"`
+}
+
+type SoftwareSourceCodeJsonLd struct {
+	Context             string       `json:"@context"`
+	Type                string       `json:"@type"`
+	Name                string       `json:"name"`
+	Description         string       `json:"description,omitempty"`
+	Image               string       `json:"image,omitempty"`
+	URL                 string       `json:"url"`
+	CodeRepository      string       `json:"codeRepository,omitempty"`
+	ProgrammingLanguage []string     `json:"programmingLanguage,omitempty"`
+	License             string       `json:"license,omitempty"`
+	Version             string       `json:"version,omitempty"`
+	DateCreated         string       `json:"dateCreated,omitempty"`
+	DateModified        string       `json:"dateModified,omitempty"`
+	Author              PersonJsonLd `json:"author"`
+}
+
+type SubmitAmaQuestionRequest struct {
+	ID             string `path:"id" validate:"uuid"`
+	AuthorName     string `json:"author_name,optional" validate:"max=100"`
+	AuthorEmail    string `json:"author_email,optional" validate:"email,max=255"`
+	Content        string `json:"content" validate:"required,maxbytes=10000"`
+	Fingerprint    string `json:"fingerprint" validate:"max=255"`
+	CaptchaToken   string `json:"captcha_token,optional" validate:"max=4096"`
+	NotifyReplies  bool   `json:"notify_replies,optional"`
+	UserAgentFull  string `json:"user_agent_full,optional"`
+	ClientIP       string `json:"client_ip,optional"`
+	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
+}
+
+type SubmitAmaQuestionResponse struct {
+	ID        string `json:"id"`
+	Content   string `json:"content"`
+	CreatedAt string `json:"created_at"`
+	Pending   bool   `json:"pending,omitempty"`
+}
+
+type SuggestLinksRequest struct {
+	PostID  string   `json:"post_id,optional"`
+	Title   string   `json:"title,optional"`
+	Content string   `json:"content,optional"`
+	Tags    []string `json:"tags,optional"`
+	Limit   int      `json:"limit,optional" validate:"max=50"`
+}
+
+type TagCloudRequest struct {
+	Type  string `form:"type,optional" validate:"oneof=blog idea project"`
+	Limit int    `form:"limit,optional"`
+}
+
+type TagCloudResponse struct {
+	Tags []TagWeight `json:"tags"`
+}
+
+type TagWeight struct {
+	Name     string  `json:"name"`
+	Count    int     `json:"count"`
+	Weight   float64 `json:"weight"`
+	Blogs    int     `json:"blogs"`
+	Ideas    int     `json:"ideas"`
+	Projects int     `json:"projects"`
+}
+
+type ToggleReactionRequest struct {
+	EntityType     string `json:"entity_type" validate:"required,oneof=comment post project"`
+	EntityID       string `json:"entity_id" validate:"required,uuid"`
+	Emoji          string `json:"emoji" validate:"required,max=16"`
+	Fingerprint    string `json:"fingerprint,optional" validate:"max=255"`
+	UserIdentityId string `json:"user_identity_id,optional"`
+	SessionToken   string `json:"session_token,optional"`
+	ClientIP       string `json:"client_ip,optional"`
+}
+
+type ToggleReactionResponse struct {
+	Reacted bool           `json:"reacted"`
+	Counts  map[string]int `json:"counts"`
+}
+
+type ToolCategory struct {
+	Name  string     `json:"name"`
+	Tools []ToolData `json:"tools"`
+}
+
+type ToolData struct {
+	ID          string `json:"id"`
+	Category    string `json:"category"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Link        string `json:"link,omitempty"`
+	SortOrder   int    `json:"sort_order"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+type ToolListResponse struct {
+	Tools []ToolData `json:"tools"`
+}
+
+type ToolRequest struct {
+	ID string `path:"id"`
+}
+
+type TrackEventRequest struct {
+	Name           string            `json:"name" validate:"required,max=64"`
+	Fingerprint    string            `json:"fingerprint,optional"`
+	UserIdentityId string            `json:"user_identity_id,optional"`
+	SessionToken   string            `json:"session_token,optional"`
+	Path           string            `json:"path,optional" validate:"max=1024"`
+	Properties     map[string]string `json:"properties,optional"`
+	ClientIP       string            `json:"client_ip,optional"`
+	UserAgentFull  string            `json:"user_agent_full,optional"`
+	DoNotTrack     bool              `json:"do_not_track,optional"`
+}
+
+type TrackEventResponse struct {
+	Recorded bool `json:"recorded"`
+}
+
+type TrashItemData struct {
+	ID        string `json:"id"`
+	Kind      string `json:"kind"`
+	EntityID  string `json:"entity_id"`
+	Title     string `json:"title"`
+	DeletedAt string `json:"deleted_at"`
+	PurgeAt   string `json:"purge_at,omitempty"`
+}
+
+type TrashItemRequest struct {
+	ID string `path:"id" validate:"uuid"`
+}
+
+type TrashListRequest struct {
+	Kind string `form:"kind,optional" validate:"oneof=tool faq site_update short_link"`
+}
+
+type TrashListResponse struct {
+	Items []TrashItemData `json:"items"`
+}
+
+type UnmarkAmaAnswerRequest struct {
+	ID       string `path:"id" validate:"uuid"`
+	AnswerID string `path:"answer_id" validate:"uuid"`
+}
+
+type UpdateAmaRequest struct {
+	ID          string `path:"id" validate:"uuid"`
+	Title       string `json:"title" validate:"required,max=200"`
+	Description string `json:"description,optional" validate:"maxbytes=10000"`
+	OpensAt     string `json:"opens_at" validate:"required"`
+	ClosesAt    string `json:"closes_at" validate:"required"`
+}
+
+type UpdateApiKeyQuotaRequest struct {
+	ID           string `path:"id"`
+	DailyQuota   int    `json:"daily_quota"`
+	MonthlyQuota int    `json:"monthly_quota"`
+}
+
+type UpdateAvailabilityRequest struct {
+	Status    string `json:"status" validate:"required,oneof=open_to_work open_to_collab busy unavailable"`
+	Message   string `json:"message,optional" validate:"max=500"`
+	BusyUntil string `json:"busy_until,optional"`
+}
+
 type UpdateBlogLikesRequest struct {
 	ID        string `path:"id"`
 	Increment bool   `json:"increment,default=true"`
 	Language  string `form:"lang,default=en"`
+	ClientIP  string `json:"client_ip,optional"`
 }
 
 type UpdateBlogLikesResponse struct {
@@ -826,8 +2642,34 @@ type UpdateBlogLikesResponse struct {
 }
 
 type UpdateBlogViewsRequest struct {
-	ID       string `path:"id"`
-	Language string `form:"lang,default=en"`
+	ID         string `path:"id"`
+	Language   string `form:"lang,default=en"`
+	DoNotTrack bool   `json:"do_not_track,optional"`
+	// Counts the browser once a day towards readers_today
+	Fingerprint string `json:"fingerprint,optional" validate:"max=255"`
+}
+
+type UpdateCannedResponseRequest struct {
+	ID      string `path:"id" validate:"uuid"`
+	Title   string `json:"title" validate:"required,max=200"`
+	Content string `json:"content" validate:"required,maxbytes=10000"`
+}
+
+type UpdateDraftRequest struct {
+	Type     string `json:"type" validate:"required,oneof=blog project idea"`
+	ID       string `json:"id" validate:"required,uuid"`
+	Field    string `json:"field" validate:"required,oneof=summary excerpt"`
+	Language string `json:"language" validate:"required,oneof=en zh"`
+	Text     string `json:"text" validate:"required,max=5000"`
+}
+
+type UpdateFAQRequest struct {
+	ID           string               `path:"id"`
+	Category     string               `json:"category" validate:"required,max=100"`
+	Question     string               `json:"question" validate:"required,max=500"`
+	Answer       string               `json:"answer" validate:"required,maxbytes=20000"`
+	SortOrder    int                  `json:"sort_order,optional"`
+	Translations []FAQTranslationData `json:"translations,optional"`
 }
 
 type UpdateIdeaRequest struct {
@@ -863,6 +2705,22 @@ type UpdatePersonalInfoRequest struct {
 	SocialLinks   []SocialLink `json:"social_links,optional"`
 }
 
+type UpdatePollRequest struct {
+	ID             string            `path:"id"`
+	Question       string            `json:"question" validate:"required,max=500"`
+	MultipleChoice bool              `json:"multiple_choice,optional"`
+	ClosesAt       string            `json:"closes_at,optional"`
+	Options        []PollOptionInput `json:"options" validate:"min=2,max=20"`
+}
+
+type UpdateProfileRequest struct {
+	Authorization string `header:"Authorization,optional"`
+	DisplayName   string `json:"display_name,optional" validate:"max=100"`
+	AvatarURL     string `json:"avatar_url,optional" validate:"max=500"`
+	// Go back to the provider's display name
+	ResetDisplayName bool `json:"reset_display_name,optional"`
+}
+
 type UpdateProjectRequest struct {
 	ID          string   `path:"id"`
 	Name        string   `json:"name,optional"`
@@ -879,6 +2737,171 @@ type UpdateSeriesProgressRequest struct {
 	Language  string `form:"lang,default=en"`
 }
 
+type UpdateSiteUpdateRequest struct {
+	ID          string `path:"id"`
+	Title       string `json:"title" validate:"required,max=200"`
+	Description string `json:"description" validate:"required,maxbytes=10000"`
+	Kind        string `json:"kind,default=feature" validate:"oneof=feature section redesign improvement fix"`
+	Link        string `json:"link,optional" validate:"max=1024"`
+	PublishedAt string `json:"published_at,optional"`
+}
+
+type UpdateToolRequest struct {
+	ID          string `path:"id"`
+	Category    string `json:"category" validate:"required,max=100"`
+	Name        string `json:"name" validate:"required,max=200"`
+	Description string `json:"description,optional"`
+	Link        string `json:"link,optional" validate:"max=1024"`
+	SortOrder   int    `json:"sort_order,optional"`
+}
+
+type UpdateWebhookRequest struct {
+	ID           string   `path:"id"`
+	URL          string   `json:"url" validate:"required,max=2048"`
+	Description  string   `json:"description,optional"`
+	EventTypes   []string `json:"event_types"`
+	Active       bool     `json:"active"`
+	RotateSecret bool     `json:"rotate_secret,optional"`
+}
+
+type UpdateWebhookResponse struct {
+	Webhook WebhookData `json:"webhook"`
+	Secret  string      `json:"secret,omitempty"`
+}
+
+type UpsertIdeaRequest struct {
+	Slug         string                 `path:"slug" validate:"max=200"`
+	DryRun       bool                   `form:"dry_run,optional"`
+	Title        string                 `json:"title" validate:"required,max=300"`
+	Description  string                 `json:"description,optional"`
+	Abstract     string                 `json:"abstract,optional"`
+	Status       string                 `json:"status,default=draft" validate:"oneof=draft hypothesis experimenting validating published concluded"`
+	IsPublic     bool                   `json:"is_public,optional"`
+	Category     string                 `json:"category,optional" validate:"max=100"`
+	Tags         []string               `json:"tags,optional"`
+	Details      IdeaDetailInput        `json:"details,optional"`
+	Translations []IdeaTranslationInput `json:"translations,optional"`
+}
+
+type UpsertPostRequest struct {
+	Slug               string                 `path:"slug" validate:"max=300"`
+	DryRun             bool                   `form:"dry_run,optional"`
+	Title              string                 `json:"title" validate:"required,max=500"`
+	Excerpt            string                 `json:"excerpt,optional"`
+	Content            string                 `json:"content" validate:"required"`
+	ContentType        string                 `json:"content_type,default=article" validate:"oneof=article vlog episode"`
+	Status             string                 `json:"status,default=draft" validate:"oneof=draft published archived"`
+	IsFeatured         bool                   `json:"is_featured,optional"`
+	FeaturedImageURL   string                 `json:"featured_image_url,optional" validate:"max=500"`
+	ReadingTimeMinutes int                    `json:"reading_time_minutes,optional"`
+	PublishedAt        string                 `json:"published_at,optional"`
+	Tags               []string               `json:"tags,optional"`
+	Translations       []PostTranslationInput `json:"translations,optional"`
+}
+
+type UpsertProjectRequest struct {
+	Slug             string                    `path:"slug" validate:"max=200"`
+	DryRun           bool                      `form:"dry_run,optional"`
+	Title            string                    `json:"title" validate:"required,max=300"`
+	Description      string                    `json:"description,optional"`
+	ProjectType      string                    `json:"project_type,optional" validate:"max=50"`
+	Status           string                    `json:"status,default=active" validate:"oneof=active completed paused cancelled"`
+	StartDate        string                    `json:"start_date,optional"`
+	EndDate          string                    `json:"end_date,optional"`
+	GithubURL        string                    `json:"github_url,optional" validate:"max=500"`
+	DemoURL          string                    `json:"demo_url,optional" validate:"max=500"`
+	DocumentationURL string                    `json:"documentation_url,optional" validate:"max=500"`
+	ThumbnailURL     string                    `json:"thumbnail_url,optional" validate:"max=500"`
+	IsFeatured       bool                      `json:"is_featured,optional"`
+	IsPublic         bool                      `json:"is_public,optional"`
+	SortOrder        int                       `json:"sort_order,optional"`
+	Technologies     []ProjectTechnologyInput  `json:"technologies,optional"`
+	Details          ProjectDetailInput        `json:"details,optional"`
+	Translations     []ProjectTranslationInput `json:"translations,optional"`
+}
+
+type UsesResponse struct {
+	Categories []ToolCategory `json:"categories"`
+}
+
+type WebhookData struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	Description string   `json:"description,omitempty"`
+	EventTypes  []string `json:"event_types"`
+	Active      bool     `json:"active"`
+	CreatedAt   string   `json:"created_at"`
+	UpdatedAt   string   `json:"updated_at"`
+}
+
+type WebhookDeliveriesRequest struct {
+	ID     string `path:"id"`
+	Failed bool   `form:"failed,optional"`
+	Limit  int    `form:"limit,default=50"`
+}
+
+type WebhookDeliveryData struct {
+	ID         string `json:"id"`
+	WebhookID  string `json:"webhook_id"`
+	EventID    string `json:"event_id,omitempty"`
+	EventType  string `json:"event_type"`
+	Payload    string `json:"payload"`
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+	Success    bool   `json:"success"`
+	Attempt    int    `json:"attempt"`
+	DurationMs int    `json:"duration_ms"`
+	CreatedAt  string `json:"created_at"`
+}
+
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryData `json:"deliveries"`
+}
+
+type WebhookListResponse struct {
+	Webhooks []WebhookData `json:"webhooks"`
+}
+
+type WebhookRequest struct {
+	ID string `path:"id"`
+}
+
+type WidgetPostsRequest struct {
+	Limit int    `form:"limit,default=5" validate:"min=1,max=20"`
+	Theme string `form:"theme,default=light" validate:"oneof=light dark"`
+	// Hex colour replacing the theme's accent, e.g. #ff7a00
+	Accent string `form:"accent,optional" validate:"max=7"`
+}
+
+type WidgetPostsResponse struct {
+	SiteURL string          `json:"site_url"`
+	Theme   WidgetTheme     `json:"theme"`
+	Posts   []EmbedPostData `json:"posts"`
+}
+
+type WidgetProjectsRequest struct {
+	Featured bool   `form:"featured,optional"`
+	Limit    int    `form:"limit,default=5" validate:"min=1,max=20"`
+	Theme    string `form:"theme,default=light" validate:"oneof=light dark"`
+	// Hex colour replacing the theme's accent, e.g. #ff7a00
+	Accent string `form:"accent,optional" validate:"max=7"`
+}
+
+type WidgetProjectsResponse struct {
+	SiteURL  string             `json:"site_url"`
+	Theme    WidgetTheme        `json:"theme"`
+	Projects []EmbedProjectData `json:"projects"`
+}
+
+type WidgetTheme struct {
+	Name       string `json:"name"`
+	Background string `json:"background"`
+	Text       string `json:"text"`
+	Muted      string `json:"muted"`
+	Border     string `json:"border"`
+	Accent     string `json:"accent"`
+}
+
 type WorkExperience struct {
 	ID             string   `json:"id"`
 	UserID         string   `json:"user_id"`
@@ -0,0 +1,154 @@
+package uses
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"silan-backend/internal/types"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+)
+
+// ErrNotFound is returned for unknown tools.
+var ErrNotFound = errors.New("tool not found")
+
+// Tool is an entry of the /uses page.
+type Tool struct {
+	ID          string
+	Category    string
+	Name        string
+	Description string
+	Link        string
+	SortOrder   int
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Data converts the tool to its API representation.
+func (t *Tool) Data() types.ToolData {
+	return types.ToolData{
+		ID:          t.ID,
+		Category:    t.Category,
+		Name:        t.Name,
+		Description: t.Description,
+		Link:        t.Link,
+		SortOrder:   t.SortOrder,
+		CreatedAt:   utils.FormatTime(t.CreatedAt),
+		UpdatedAt:   utils.FormatTime(t.UpdatedAt),
+	}
+}
+
+// Store persists tools in the raw tools table.
+type Store struct {
+	db     *sql.DB
+	driver string
+}
+
+func NewStore(db *sql.DB, driver string) *Store {
+	return &Store{db: db, driver: driver}
+}
+
+const toolColumns = `id, category, name, description, link, sort_order, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTool(row scanner) (*Tool, error) {
+	var (
+		t           Tool
+		description sql.NullString
+		link        sql.NullString
+	)
+	if err := row.Scan(&t.ID, &t.Category, &t.Name, &description, &link, &t.SortOrder, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		return nil, err
+	}
+	t.Description = description.String
+	t.Link = link.String
+	return &t, nil
+}
+
+// List returns all tools ordered by category, then sort order and name.
+func (s *Store) List(ctx context.Context) ([]*Tool, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT `+toolColumns+` FROM tools ORDER BY category, sort_order, name`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tools []*Tool
+	for rows.Next() {
+		t, err := scanTool(rows)
+		if err != nil {
+			return nil, err
+		}
+		tools = append(tools, t)
+	}
+	return tools, rows.Err()
+}
+
+// Get returns a tool by ID.
+func (s *Store) Get(ctx context.Context, id string) (*Tool, error) {
+	t, err := scanTool(s.db.QueryRowContext(ctx, utils.Rebind(s.driver,
+		`SELECT `+toolColumns+` FROM tools WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return t, err
+}
+
+// Create stores a new tool and fills in its ID and timestamps.
+func (s *Store) Create(ctx context.Context, t *Tool) error {
+	now := time.Now().UTC()
+	t.ID = uuid.New().String()
+	t.CreatedAt = now
+	t.UpdatedAt = now
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO tools (id, category, name, description, link, sort_order, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		t.ID, t.Category, t.Name, t.Description, t.Link, t.SortOrder, t.CreatedAt, t.UpdatedAt,
+	)
+	return err
+}
+
+// Restore stores a deleted tool again with its original ID and timestamps.
+func (s *Store) Restore(ctx context.Context, t *Tool) error {
+	_, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`INSERT INTO tools (id, category, name, description, link, sort_order, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		t.ID, t.Category, t.Name, t.Description, t.Link, t.SortOrder, t.CreatedAt, t.UpdatedAt,
+	)
+	return err
+}
+
+// Update saves all editable fields of a tool.
+func (s *Store) Update(ctx context.Context, t *Tool) error {
+	t.UpdatedAt = time.Now().UTC()
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver,
+		`UPDATE tools SET category = ?, name = ?, description = ?, link = ?, sort_order = ?, updated_at = ? WHERE id = ?`),
+		t.Category, t.Name, t.Description, t.Link, t.SortOrder, t.UpdatedAt, t.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a tool.
+func (s *Store) Delete(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, utils.Rebind(s.driver, `DELETE FROM tools WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
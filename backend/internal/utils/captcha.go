@@ -0,0 +1,92 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Captcha providers understood by NewCaptchaVerifier.
+const (
+	CaptchaTurnstile = "turnstile"
+	CaptchaHCaptcha  = "hcaptcha"
+)
+
+// ErrCaptchaRejected is returned for missing, invalid or expired captcha
+// tokens.
+var ErrCaptchaRejected = errors.New("captcha verification failed, please try again")
+
+// CaptchaVerifier checks the token a captcha widget gave the visitor.
+// remoteIP is optional and only passed on to the provider.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// NewCaptchaVerifier returns the verifier for provider, or nil while secret
+// is empty so that captchas stay optional.
+func NewCaptchaVerifier(provider, secret string, timeout time.Duration) (CaptchaVerifier, error) {
+	if secret == "" {
+		return nil, nil
+	}
+	v := &siteVerifier{secret: secret, http: &http.Client{Timeout: timeout}}
+	switch provider {
+	case CaptchaTurnstile:
+		v.endpoint = "https://challenges.cloudflare.com/turnstile/v0/siteverify"
+	case CaptchaHCaptcha:
+		v.endpoint = "https://api.hcaptcha.com/siteverify"
+	default:
+		return nil, fmt.Errorf("unknown captcha provider %q", provider)
+	}
+	return v, nil
+}
+
+// siteVerifier speaks the siteverify protocol shared by Turnstile and
+// hCaptcha: a form POST answered with {"success": bool, ...}.
+type siteVerifier struct {
+	endpoint string
+	secret   string
+	http     *http.Client
+}
+
+func (v *siteVerifier) Verify(ctx context.Context, token, remoteIP string) error {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return ErrCaptchaRejected
+	}
+
+	form := url.Values{"secret": {v.secret}, "response": {token}}
+	if remoteIP != "" {
+		form.Set("remoteip", remoteIP)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := v.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("captcha siteverify returned %s", res.Status)
+	}
+
+	var out struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return fmt.Errorf("decode captcha siteverify response: %w", err)
+	}
+	if !out.Success {
+		return ErrCaptchaRejected
+	}
+	return nil
+}
@@ -86,4 +86,53 @@ func GetUserAgent(r *http.Request) string {
 		userAgent = userAgent[:500]
 	}
 	return userAgent
-}
\ No newline at end of file
+}
+
+// AnalyticsChoiceCookie holds the visitor's answer to the consent banner:
+// "out" opts out of analytics, "in" records that they were asked.
+const AnalyticsChoiceCookie = "analytics_choice"
+
+// DoNotTrack reports whether the client asked not to be tracked, via the DNT
+// header, Global Privacy Control (Sec-GPC) or the analytics opt-out cookie.
+func DoNotTrack(r *http.Request) bool {
+	return BrowserDoNotTrack(r) || AnalyticsChoice(r) == "out"
+}
+
+// BrowserDoNotTrack reports whether the browser itself sends DNT or Sec-GPC.
+func BrowserDoNotTrack(r *http.Request) bool {
+	return r.Header.Get("DNT") == "1" || r.Header.Get("Sec-GPC") == "1"
+}
+
+// AnalyticsChoice returns the choice stored in the analytics cookie, "in",
+// "out" or "" when the visitor has not answered.
+func AnalyticsChoice(r *http.Request) string {
+	c, err := r.Cookie(AnalyticsChoiceCookie)
+	if err != nil {
+		return ""
+	}
+	switch c.Value {
+	case "in", "out":
+		return c.Value
+	}
+	return ""
+}
+
+// SetAnalyticsChoice stores the visitor's analytics choice for a year.
+func SetAnalyticsChoice(w http.ResponseWriter, r *http.Request, choice string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     AnalyticsChoiceCookie,
+		Value:    choice,
+		Path:     "/",
+		MaxAge:   365 * 24 * 60 * 60,
+		HttpOnly: true,
+		Secure:   r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// SetSharedCache marks a response that is the same for every visitor as
+// cacheable by CDNs and shared proxies for a short while. Browsers still
+// revalidate, so a visitor sees their own new comment right away.
+func SetSharedCache(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "public, max-age=0, s-maxage=30, stale-while-revalidate=60")
+}
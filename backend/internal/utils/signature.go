@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// SignaturePayload builds the canonical string that is signed for a request:
+// the unix timestamp, method, path and hex SHA-256 of the body, one per line.
+func SignaturePayload(timestamp, method, path string, body []byte) string {
+	sum := sha256.Sum256(body)
+	return strings.Join([]string{timestamp, strings.ToUpper(method), path, hex.EncodeToString(sum[:])}, "\n")
+}
+
+// Sign returns the hex HMAC-SHA256 of the payload.
+func Sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature matches the payload, accepting
+// an optional "sha256=" prefix as sent by most webhook producers.
+func VerifySignature(secret, payload, signature string) bool {
+	signature = strings.TrimPrefix(signature, "sha256=")
+	expected := Sign(secret, payload)
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
@@ -0,0 +1,25 @@
+package utils
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Rebind rewrites '?' placeholders into the bind syntax of the given driver,
+// so raw queries can be written once for every supported database.
+func Rebind(driver, query string) string {
+	if driver != "postgres" && driver != "postgresql" {
+		return query
+	}
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
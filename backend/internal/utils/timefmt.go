@@ -0,0 +1,36 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// FormatTime renders t as an RFC 3339 timestamp in UTC. All timestamps in
+// API responses go through it so clients always get the same, zone-explicit
+// format.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// FormatTimeIn renders t as an RFC 3339 timestamp in loc; a nil loc means UTC.
+func FormatTimeIn(t time.Time, loc *time.Location) string {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return t.In(loc).Format(time.RFC3339)
+}
+
+// LoadTimezone resolves the IANA zone name of a tz query parameter, e.g.
+// "Asia/Singapore". An empty name means UTC.
+func LoadTimezone(name string) (*time.Location, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tz %q", name)
+	}
+	return loc, nil
+}
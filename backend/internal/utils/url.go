@@ -0,0 +1,9 @@
+package utils
+
+import "net/url"
+
+// IsHTTPURL reports whether raw is an absolute http or https URL.
+func IsHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && u.Host != "" && (u.Scheme == "http" || u.Scheme == "https")
+}
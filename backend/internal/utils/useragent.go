@@ -0,0 +1,87 @@
+package utils
+
+import "strings"
+
+// UserAgentInfo is the coarse classification of a User-Agent string used for
+// analytics breakdowns.
+type UserAgentInfo struct {
+	Device  string // desktop, mobile, tablet, bot or unknown
+	OS      string
+	Browser string
+}
+
+var botMarkers = []string{"bot", "crawler", "spider", "slurp", "curl/", "wget/", "python-requests", "go-http-client", "headless", "lighthouse"}
+
+// ParseUserAgent classifies a User-Agent header. It only looks for well-known
+// tokens, which is enough to segment traffic without a UA database.
+func ParseUserAgent(ua string) UserAgentInfo {
+	if strings.TrimSpace(ua) == "" {
+		return UserAgentInfo{Device: "unknown", OS: "Other", Browser: "Other"}
+	}
+	s := strings.ToLower(ua)
+
+	info := UserAgentInfo{OS: parseOS(s), Browser: parseBrowser(s)}
+
+	switch {
+	case containsAny(s, botMarkers):
+		info.Device = "bot"
+		info.Browser = "Bot"
+	case strings.Contains(s, "ipad") || strings.Contains(s, "tablet") ||
+		(strings.Contains(s, "android") && !strings.Contains(s, "mobile")):
+		info.Device = "tablet"
+	case strings.Contains(s, "mobi") || strings.Contains(s, "iphone") || strings.Contains(s, "ipod"):
+		info.Device = "mobile"
+	default:
+		info.Device = "desktop"
+	}
+	return info
+}
+
+func parseOS(s string) string {
+	switch {
+	case strings.Contains(s, "iphone") || strings.Contains(s, "ipad") || strings.Contains(s, "ipod"):
+		return "iOS"
+	case strings.Contains(s, "android"):
+		return "Android"
+	case strings.Contains(s, "cros"):
+		return "ChromeOS"
+	case strings.Contains(s, "windows"):
+		return "Windows"
+	case strings.Contains(s, "mac os x") || strings.Contains(s, "macintosh"):
+		return "macOS"
+	case strings.Contains(s, "linux"):
+		return "Linux"
+	default:
+		return "Other"
+	}
+}
+
+// parseBrowser checks the more specific tokens first: Edge and Opera UAs
+// also contain "chrome", and Chrome UAs also contain "safari".
+func parseBrowser(s string) string {
+	switch {
+	case strings.Contains(s, "edg/") || strings.Contains(s, "edge/") || strings.Contains(s, "edga/") || strings.Contains(s, "edgios/"):
+		return "Edge"
+	case strings.Contains(s, "opr/") || strings.Contains(s, "opera"):
+		return "Opera"
+	case strings.Contains(s, "samsungbrowser"):
+		return "Samsung Internet"
+	case strings.Contains(s, "firefox/") || strings.Contains(s, "fxios/"):
+		return "Firefox"
+	case strings.Contains(s, "chrome/") || strings.Contains(s, "crios/") || strings.Contains(s, "chromium/"):
+		return "Chrome"
+	case strings.Contains(s, "safari/"):
+		return "Safari"
+	default:
+		return "Other"
+	}
+}
+
+func containsAny(s string, subs []string) bool {
+	for _, sub := range subs {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
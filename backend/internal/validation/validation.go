@@ -0,0 +1,266 @@
+// Package validation checks parsed request structs against their validate
+// struct tags, e.g.
+//
+//	AuthorEmail string `json:"author_email" validate:"email,max=255"`
+//
+// Supported rules, separated by commas:
+//
+//	required       the value must not be empty
+//	min=N, max=N   length in characters for strings, number of items for slices,
+//	               the value itself for integers
+//	maxbytes=N     size in bytes for strings (content limits)
+//	email          an e-mail address
+//	uuid           a UUID
+//	oneof=a b c    one of the space separated values
+//
+// Rules other than required are skipped for empty values, so optional fields
+// are only checked when they are set. A zero number counts as set when the
+// client sent it: when the field has a non-zero default, or when it is a
+// query or form parameter present in the request. Slices of structs are
+// validated element by element.
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"silan-backend/internal/publishing"
+	"silan-backend/internal/ratelimit"
+
+	"github.com/google/uuid"
+)
+
+// FieldError describes why a single field was rejected.
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Errors is returned when one or more fields are invalid.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fe.Field+": "+fe.Message)
+	}
+	return "invalid request: " + strings.Join(msgs, "; ")
+}
+
+// Validator plugs Struct into httpx.Parse.
+type Validator struct{}
+
+func (Validator) Validate(r *http.Request, data any) error {
+	return validate(r, data)
+}
+
+// Struct validates v, a struct or pointer to struct. It returns Errors when
+// any field is invalid.
+func Struct(v any) error {
+	return validate(nil, v)
+}
+
+// validate validates v, parsed from r when r is not nil.
+func validate(r *http.Request, v any) error {
+	var errs Errors
+	validateValue(r, reflect.ValueOf(v), "", &errs)
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateValue(r *http.Request, v reflect.Value, prefix string, errs *Errors) {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fv := v.Field(i)
+		name := prefix + fieldName(sf)
+
+		if tag := sf.Tag.Get("validate"); tag != "" {
+			if msg := checkRules(fv, tag, sentZero(r, sf, fv)); msg != "" {
+				*errs = append(*errs, FieldError{Field: name, Message: msg})
+			}
+		}
+
+		switch fv.Kind() {
+		case reflect.Struct:
+			validateValue(nil, fv, name+".", errs)
+		case reflect.Slice:
+			if fv.Type().Elem().Kind() == reflect.Struct {
+				for j := 0; j < fv.Len(); j++ {
+					validateValue(nil, fv.Index(j), fmt.Sprintf("%s[%d].", name, j), errs)
+				}
+			}
+		}
+	}
+}
+
+// fieldName returns the name the client used for the field.
+func fieldName(sf reflect.StructField) string {
+	for _, key := range []string{"json", "form", "path", "header"} {
+		if tag := sf.Tag.Get(key); tag != "" && tag != "-" {
+			if name := strings.Split(tag, ",")[0]; name != "" {
+				return name
+			}
+		}
+	}
+	return sf.Name
+}
+
+// sentZero reports whether fv is a zero number the client sent rather than
+// left out. go-zero fills in defaults for missing fields, so a zero in a
+// field with another default was sent; query and form parameters are looked
+// up in r. Nested fields (r is nil) and JSON fields without a default can't
+// tell and count as left out.
+func sentZero(r *http.Request, sf reflect.StructField, fv reflect.Value) bool {
+	switch fv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+	default:
+		return false
+	}
+	if !fv.IsZero() {
+		return false
+	}
+	for _, key := range []string{"json", "form", "path", "header"} {
+		tag := sf.Tag.Get(key)
+		if tag == "" || tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		for _, opt := range strings.Split(opts, ",") {
+			if def, ok := strings.CutPrefix(opt, "default="); ok {
+				if f, err := strconv.ParseFloat(def, 64); err == nil && f != 0 {
+					return true
+				}
+			}
+		}
+		if key == "form" && r != nil && (r.URL.Query().Has(name) || r.PostForm.Has(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkRules(v reflect.Value, tag string, sent bool) string {
+	empty := v.IsZero() && !sent
+	for _, rule := range strings.Split(tag, ",") {
+		name, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if name == "required" {
+			if empty {
+				return "is required"
+			}
+			continue
+		}
+		if empty {
+			continue
+		}
+		if msg := checkRule(v, name, arg); msg != "" {
+			return msg
+		}
+	}
+	return ""
+}
+
+func checkRule(v reflect.Value, name, arg string) string {
+	switch name {
+	case "min", "max":
+		n, _ := strconv.Atoi(arg)
+		size, unit := length(v)
+		if name == "min" && size < n {
+			return strings.TrimSpace(fmt.Sprintf("must be at least %d %s", n, unit))
+		}
+		if name == "max" && size > n {
+			return strings.TrimSpace(fmt.Sprintf("must be at most %d %s", n, unit))
+		}
+	case "maxbytes":
+		n, _ := strconv.Atoi(arg)
+		if v.Kind() == reflect.String && len(v.String()) > n {
+			return fmt.Sprintf("must be at most %d bytes", n)
+		}
+	case "email":
+		if v.Kind() == reflect.String && !isEmail(v.String()) {
+			return "must be a valid email address"
+		}
+	case "uuid":
+		if v.Kind() == reflect.String {
+			if _, err := uuid.Parse(v.String()); err != nil {
+				return "must be a valid UUID"
+			}
+		}
+	case "oneof":
+		options := strings.Fields(arg)
+		s := fmt.Sprint(v.Interface())
+		for _, o := range options {
+			if s == o {
+				return ""
+			}
+		}
+		return "must be one of " + strings.Join(options, ", ")
+	}
+	return ""
+}
+
+func length(v reflect.Value) (int, string) {
+	switch v.Kind() {
+	case reflect.String:
+		return utf8.RuneCountInString(v.String()), "characters"
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return v.Len(), "items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int()), ""
+	}
+	return 0, ""
+}
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s && strings.Contains(s[strings.LastIndex(s, "@"):], ".")
+}
+
+// ErrorHandler is the httpx error handler. Validation errors become a JSON
+// 400 listing the rejected fields, rate limit errors a JSON 429 and blocked
+// publishes a JSON 409; every other error keeps the default plain-text 400.
+func ErrorHandler(_ context.Context, err error) (int, any) {
+	var fieldErrs Errors
+	if errors.As(err, &fieldErrs) {
+		return http.StatusBadRequest, ErrorResponse{Message: "validation failed", Errors: fieldErrs}
+	}
+	var limitErr *ratelimit.Error
+	if errors.As(err, &limitErr) {
+		return http.StatusTooManyRequests, limitErr
+	}
+	var blockedErr *publishing.BlockedError
+	if errors.As(err, &blockedErr) {
+		return http.StatusConflict, blockedErr
+	}
+	return http.StatusBadRequest, err
+}
+
+// ErrorResponse is the body written for validation errors.
+type ErrorResponse struct {
+	Message string       `json:"message"`
+	Errors  []FieldError `json:"errors"`
+}
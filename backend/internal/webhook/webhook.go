@@ -0,0 +1,472 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"silan-backend/internal/outbox"
+	"silan-backend/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+var (
+	// ErrNotFound is returned for unknown webhooks or deliveries.
+	ErrNotFound = errors.New("webhook not found")
+	// ErrPayloadErased is returned when redelivering a delivery whose
+	// payload was erased with the comment it described.
+	ErrPayloadErased = errors.New("the payload of this delivery was erased")
+)
+
+// EventPing is sent by the ping endpoint to test a subscription. Domain
+// event types are declared in the outbox package; subscriptions may also
+// use "*" or a "comment.*" style prefix.
+const EventPing = "ping"
+
+// Subscription is a registered webhook endpoint.
+type Subscription struct {
+	ID          string
+	URL         string
+	Secret      string
+	Description string
+	EventTypes  []string
+	Active      bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Matches reports whether the subscription wants the event type.
+func (s *Subscription) Matches(eventType string) bool {
+	for _, t := range s.EventTypes {
+		if t == "*" || t == eventType {
+			return true
+		}
+		// "comment.*" style prefixes
+		if strings.HasSuffix(t, ".*") && strings.HasPrefix(eventType, strings.TrimSuffix(t, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+// Delivery is one attempt to POST an event to a subscription.
+type Delivery struct {
+	ID         string
+	WebhookID  string
+	EventID    string
+	EventType  string
+	Payload    string
+	StatusCode int
+	Error      string
+	Success    bool
+	Attempt    int
+	DurationMs int
+	CreatedAt  time.Time
+}
+
+// Envelope is the JSON body sent to subscribers.
+type Envelope struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	CreatedAt string `json:"created_at"`
+	Data      any    `json:"data"`
+}
+
+// Dispatcher manages subscriptions and delivers events to them.
+type Dispatcher struct {
+	db         *sql.DB
+	driver     string
+	client     *http.Client
+	configured []*Subscription
+}
+
+// NewDispatcher returns a dispatcher that delivers events to the
+// subscriptions stored in the webhooks table and to configured, those
+// declared in the config file.
+func NewDispatcher(db *sql.DB, driver string, configured ...*Subscription) *Dispatcher {
+	return &Dispatcher{
+		db:         db,
+		driver:     driver,
+		client:     &http.Client{Timeout: 10 * time.Second},
+		configured: configured,
+	}
+}
+
+// ConfiguredID is the ID of the i-th subscription declared in the config
+// file. Their deliveries are logged under it.
+func ConfiguredID(i int) string {
+	return "config-" + strconv.Itoa(i+1)
+}
+
+func (d *Dispatcher) rebind(query string) string {
+	return utils.Rebind(d.driver, query)
+}
+
+// GenerateSecret returns a random signing secret for new subscriptions.
+func GenerateSecret() (string, error) {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(buf), nil
+}
+
+// ValidateURL checks that a webhook target is an absolute http(s) URL.
+func ValidateURL(raw string) error {
+	if !utils.IsHTTPURL(raw) {
+		return errors.New("url must be an absolute http(s) URL")
+	}
+	return nil
+}
+
+const subscriptionColumns = `id, url, secret, description, event_types, active, created_at, updated_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanSubscription(row scanner) (*Subscription, error) {
+	var (
+		s           Subscription
+		description sql.NullString
+		eventTypes  string
+	)
+	if err := row.Scan(&s.ID, &s.URL, &s.Secret, &description, &eventTypes, &s.Active, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	s.Description = description.String
+	s.EventTypes = splitEventTypes(eventTypes)
+	return &s, nil
+}
+
+func splitEventTypes(raw string) []string {
+	var out []string
+	for _, t := range strings.Split(raw, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// Create registers a new subscription.
+func (d *Dispatcher) Create(ctx context.Context, s *Subscription) error {
+	now := time.Now().UTC()
+	s.ID = uuid.New().String()
+	s.CreatedAt = now
+	s.UpdatedAt = now
+	_, err := d.db.ExecContext(ctx, d.rebind(
+		`INSERT INTO webhooks (id, url, secret, description, event_types, active, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)`),
+		s.ID, s.URL, s.Secret, s.Description, strings.Join(s.EventTypes, ","), s.Active, s.CreatedAt, s.UpdatedAt,
+	)
+	return err
+}
+
+// Update saves URL, description, filters and active flag of a subscription.
+func (d *Dispatcher) Update(ctx context.Context, s *Subscription) error {
+	s.UpdatedAt = time.Now().UTC()
+	res, err := d.db.ExecContext(ctx, d.rebind(
+		`UPDATE webhooks SET url = ?, secret = ?, description = ?, event_types = ?, active = ?, updated_at = ? WHERE id = ?`),
+		s.URL, s.Secret, s.Description, strings.Join(s.EventTypes, ","), s.Active, s.UpdatedAt, s.ID,
+	)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a subscription and its delivery log.
+func (d *Dispatcher) Delete(ctx context.Context, id string) error {
+	res, err := d.db.ExecContext(ctx, d.rebind(`DELETE FROM webhooks WHERE id = ?`), id)
+	if err != nil {
+		return err
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrNotFound
+	}
+	_, err = d.db.ExecContext(ctx, d.rebind(`DELETE FROM webhook_deliveries WHERE webhook_id = ?`), id)
+	return err
+}
+
+// Get returns a subscription by ID, including those declared in the config
+// file.
+func (d *Dispatcher) Get(ctx context.Context, id string) (*Subscription, error) {
+	for _, s := range d.configured {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	s, err := scanSubscription(d.db.QueryRowContext(ctx, d.rebind(
+		`SELECT `+subscriptionColumns+` FROM webhooks WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return s, err
+}
+
+// List returns all stored subscriptions, newest first. Those declared in
+// the config file are left out; they can't be edited.
+func (d *Dispatcher) List(ctx context.Context) ([]*Subscription, error) {
+	rows, err := d.db.QueryContext(ctx, `SELECT `+subscriptionColumns+` FROM webhooks ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subs []*Subscription
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, s)
+	}
+	return subs, rows.Err()
+}
+
+// Publish delivers an event to every active subscription that wants it,
+// configured ones first, and waits for all of them, returning the first
+// delivery error. Domain events reach this through the outbox relay, which
+// retries failed events.
+func (d *Dispatcher) Publish(ctx context.Context, eventID, eventType string, data json.RawMessage, createdAt time.Time) error {
+	stored, err := d.List(ctx)
+	if err != nil {
+		return err
+	}
+	subs := append(append([]*Subscription{}, d.configured...), stored...)
+
+	body, err := json.Marshal(Envelope{
+		ID:        eventID,
+		Type:      eventType,
+		CreatedAt: utils.FormatTime(createdAt.UTC()),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	var firstErr error
+	for _, s := range subs {
+		if !s.Active || !s.Matches(eventType) {
+			continue
+		}
+		if _, err := d.deliver(ctx, s, eventType, body, 1); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// Ping sends a test event to a single subscription.
+func (d *Dispatcher) Ping(ctx context.Context, id string) (*Delivery, error) {
+	s, err := d.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(Envelope{
+		ID:        uuid.New().String(),
+		Type:      EventPing,
+		CreatedAt: utils.FormatTime(time.Now().UTC()),
+		Data:      map[string]string{"webhook_id": s.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+	delivery, _ := d.deliver(ctx, s, EventPing, body, 1)
+	return delivery, nil
+}
+
+// Redeliver re-sends the payload of a previous delivery to its subscription.
+func (d *Dispatcher) Redeliver(ctx context.Context, deliveryID string) (*Delivery, error) {
+	prev, err := d.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if prev.Payload == "" {
+		return nil, ErrPayloadErased
+	}
+	s, err := d.Get(ctx, prev.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, _ := d.deliver(ctx, s, prev.EventType, []byte(prev.Payload), prev.Attempt+1)
+	return delivery, nil
+}
+
+// deliver POSTs a signed payload and records the outcome in the delivery log.
+// The returned error reports a failed delivery; the delivery is still logged.
+func (d *Dispatcher) deliver(ctx context.Context, s *Subscription, eventType string, body []byte, attempt int) (*Delivery, error) {
+	delivery := &Delivery{
+		ID:        uuid.New().String(),
+		WebhookID: s.ID,
+		EventType: eventType,
+		Payload:   string(body),
+		Attempt:   attempt,
+		CreatedAt: time.Now().UTC(),
+	}
+	var envelope Envelope
+	if err := json.Unmarshal(body, &envelope); err == nil {
+		delivery.EventID = envelope.ID
+	}
+
+	sendErr := d.send(ctx, s, eventType, delivery, body)
+	if sendErr != nil {
+		delivery.Error = sendErr.Error()
+	}
+
+	if err := d.saveDelivery(ctx, delivery); err != nil {
+		logx.Errorf("failed to log webhook delivery %s: %v", delivery.ID, err)
+	}
+	return delivery, sendErr
+}
+
+func (d *Dispatcher) send(ctx context.Context, s *Subscription, eventType string, delivery *Delivery, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "silan-backend-webhooks/1.0")
+	req.Header.Set("X-Webhook-Event", eventType)
+	req.Header.Set("X-Webhook-Delivery", delivery.ID)
+	if s.Secret != "" {
+		payload := utils.SignaturePayload(timestamp, http.MethodPost, req.URL.RequestURI(), body)
+		req.Header.Set("X-Signature-Timestamp", timestamp)
+		req.Header.Set("X-Signature", "sha256="+utils.Sign(s.Secret, payload))
+	}
+
+	start := time.Now()
+	res, err := d.client.Do(req)
+	delivery.DurationMs = int(time.Since(start).Milliseconds())
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	delivery.StatusCode = res.StatusCode
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.New("subscriber responded with " + res.Status)
+	}
+	delivery.Success = true
+	return nil
+}
+
+func (d *Dispatcher) saveDelivery(ctx context.Context, dl *Delivery) error {
+	_, err := d.db.ExecContext(ctx, d.rebind(
+		`INSERT INTO webhook_deliveries (id, webhook_id, event_id, event_type, payload, status_code, error, success, attempt, duration_ms, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`),
+		dl.ID, dl.WebhookID, dl.EventID, dl.EventType, dl.Payload, dl.StatusCode, dl.Error, dl.Success, dl.Attempt, dl.DurationMs, dl.CreatedAt,
+	)
+	return err
+}
+
+// ForgetComment blanks through q the logged payloads of the deliveries of a
+// comment's events, which hold what its author wrote. The deliveries stay
+// in the log.
+func ForgetComment(ctx context.Context, q outbox.Queryer, driver, commentID string) error {
+	rows, err := q.QueryContext(ctx, utils.Rebind(driver,
+		`SELECT id, payload FROM webhook_deliveries WHERE event_type LIKE 'comment.%' AND payload LIKE ?`),
+		"%"+commentID+"%",
+	)
+	if err != nil {
+		return err
+	}
+	var ids []string
+	for rows.Next() {
+		var id, payload string
+		if err := rows.Scan(&id, &payload); err != nil {
+			rows.Close()
+			return err
+		}
+		var envelope struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if json.Unmarshal([]byte(payload), &envelope) == nil && outbox.PayloadComment(envelope.Data) == commentID {
+			ids = append(ids, id)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if _, err := q.ExecContext(ctx, utils.Rebind(driver, `UPDATE webhook_deliveries SET payload = '' WHERE id = ?`), id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const deliveryColumns = `id, webhook_id, event_id, event_type, payload, status_code, error, success, attempt, duration_ms, created_at`
+
+func scanDelivery(row scanner) (*Delivery, error) {
+	var (
+		dl      Delivery
+		eventID sql.NullString
+		errText sql.NullString
+	)
+	if err := row.Scan(&dl.ID, &dl.WebhookID, &eventID, &dl.EventType, &dl.Payload, &dl.StatusCode, &errText, &dl.Success, &dl.Attempt, &dl.DurationMs, &dl.CreatedAt); err != nil {
+		return nil, err
+	}
+	dl.EventID = eventID.String
+	dl.Error = errText.String
+	return &dl, nil
+}
+
+// GetDelivery returns a single delivery log entry.
+func (d *Dispatcher) GetDelivery(ctx context.Context, id string) (*Delivery, error) {
+	dl, err := scanDelivery(d.db.QueryRowContext(ctx, d.rebind(
+		`SELECT `+deliveryColumns+` FROM webhook_deliveries WHERE id = ?`), id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return dl, err
+}
+
+// Deliveries returns the most recent deliveries of a subscription.
+func (d *Dispatcher) Deliveries(ctx context.Context, webhookID string, onlyFailed bool, limit int) ([]*Delivery, error) {
+	if limit <= 0 || limit > 200 {
+		limit = 50
+	}
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE webhook_id = ?`
+	if onlyFailed {
+		query += ` AND success = ?`
+	}
+	query += ` ORDER BY created_at DESC LIMIT ` + strconv.Itoa(limit)
+
+	args := []any{webhookID}
+	if onlyFailed {
+		args = append(args, false)
+	}
+	rows, err := d.db.QueryContext(ctx, d.rebind(query), args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []*Delivery
+	for rows.Next() {
+		dl, err := scanDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, dl)
+	}
+	return list, rows.Err()
+}
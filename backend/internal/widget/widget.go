@@ -0,0 +1,77 @@
+// Package widget serves the embeddable portfolio widget: a script that
+// other sites include to render recent projects or posts, and the colour
+// themes it is drawn with.
+//
+// A page embeds it with
+//
+//	<script src="https://api.silan.tech/embed/widget.js"
+//		data-resource="projects" data-theme="dark" data-accent="#ff7a00"></script>
+//
+// and the script fetches /embed/projects or /embed/latest-posts with the
+// same parameters.
+package widget
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strings"
+
+	"silan-backend/internal/types"
+)
+
+// Script is the widget script served at /embed/widget.js.
+//
+//go:embed widget.js
+var Script []byte
+
+// ETag identifies the current Script, so browsers and CDNs revalidate it
+// instead of downloading it again.
+var ETag = func() string {
+	sum := sha256.Sum256(Script)
+	return `"` + hex.EncodeToString(sum[:8]) + `"`
+}()
+
+// ErrAccent is returned for accent colours that aren't hex colours.
+var ErrAccent = errors.New("accent must be a hex colour such as #ff7a00")
+
+var accentRe = regexp.MustCompile(`^#?([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// themes are the base palettes, modelled on GitHub's light and dark modes
+// so widgets fit into READMEs.
+var themes = map[string]types.WidgetTheme{
+	"light": {
+		Name:       "light",
+		Background: "#ffffff",
+		Text:       "#1f2328",
+		Muted:      "#656d76",
+		Border:     "#d0d7de",
+		Accent:     "#0969da",
+	},
+	"dark": {
+		Name:       "dark",
+		Background: "#0d1117",
+		Text:       "#e6edf3",
+		Muted:      "#8b949e",
+		Border:     "#30363d",
+		Accent:     "#2f81f7",
+	},
+}
+
+// Theme returns the palette named name with its accent colour replaced by
+// accent when set. Unknown names fall back to the light theme.
+func Theme(name, accent string) (types.WidgetTheme, error) {
+	theme, ok := themes[name]
+	if !ok {
+		theme = themes["light"]
+	}
+	if accent = strings.TrimSpace(accent); accent != "" {
+		if !accentRe.MatchString(accent) {
+			return types.WidgetTheme{}, ErrAccent
+		}
+		theme.Accent = "#" + strings.ToLower(strings.TrimPrefix(accent, "#"))
+	}
+	return theme, nil
+}
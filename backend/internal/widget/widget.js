@@ -0,0 +1,120 @@
+/*
+ * Portfolio widget. Include with
+ *
+ *   <script src="https://api.silan.tech/embed/widget.js" data-resource="projects"></script>
+ *
+ * Attributes: data-resource (projects or latest-posts), data-limit,
+ * data-theme (light or dark), data-accent (hex colour), data-featured
+ * (projects only) and data-target (a CSS selector to render into instead
+ * of after the script tag).
+ */
+(function () {
+  "use strict";
+
+  var script = document.currentScript;
+  if (!script) {
+    return;
+  }
+  var base = new URL(script.src).origin;
+  var data = script.dataset;
+  var resource = data.resource === "latest-posts" ? "latest-posts" : "projects";
+
+  var params = new URLSearchParams();
+  ["limit", "theme", "accent", "featured"].forEach(function (name) {
+    if (data[name]) {
+      params.set(name, data[name]);
+    }
+  });
+
+  var host = document.createElement("div");
+  var target = data.target ? document.querySelector(data.target) : null;
+  if (target) {
+    target.appendChild(host);
+  } else {
+    script.parentNode.insertBefore(host, script.nextSibling);
+  }
+  var root = host.attachShadow ? host.attachShadow({ mode: "open" }) : host;
+
+  function el(tag, className, text) {
+    var node = document.createElement(tag);
+    if (className) {
+      node.className = className;
+    }
+    if (text) {
+      node.textContent = text;
+    }
+    return node;
+  }
+
+  function link(href, className, text) {
+    var a = el("a", className, text);
+    if (/^https?:\/\//.test(href || "")) {
+      a.href = href;
+    }
+    a.target = "_blank";
+    a.rel = "noopener";
+    return a;
+  }
+
+  function style(t) {
+    return (
+      ".w{font:14px/1.5 -apple-system,BlinkMacSystemFont,'Segoe UI',Helvetica,Arial,sans-serif;" +
+      "background:" + t.background + ";color:" + t.text + ";border:1px solid " + t.border + ";" +
+      "border-radius:8px;padding:12px 16px;max-width:640px}" +
+      ".h{display:flex;justify-content:space-between;align-items:baseline;margin-bottom:4px}" +
+      ".t{font-weight:600}" +
+      "a{color:" + t.accent + ";text-decoration:none}a:hover{text-decoration:underline}" +
+      "ul{list-style:none;margin:0;padding:0}" +
+      "li{padding:8px 0;border-top:1px solid " + t.border + "}li:first-child{border-top:0}" +
+      ".n{font-weight:600}.d{color:" + t.muted + ";margin:2px 0 0}" +
+      ".m{color:" + t.muted + ";font-size:12px;margin-top:2px}"
+    );
+  }
+
+  function render(body) {
+    var wrap = el("div", "w");
+    var css = el("style");
+    css.textContent = style(body.theme);
+    root.appendChild(css);
+
+    var header = el("div", "h");
+    header.appendChild(el("span", "t", resource === "projects" ? "Projects" : "Latest posts"));
+    header.appendChild(link(body.site_url, "", "View all"));
+    wrap.appendChild(header);
+
+    var list = el("ul");
+    var items = resource === "projects" ? body.projects : body.posts;
+    (items || []).forEach(function (item) {
+      var li = el("li");
+      li.appendChild(link(item.url, "n", item.title));
+      var text = resource === "projects" ? item.description : item.excerpt;
+      if (text) {
+        li.appendChild(el("p", "d", text.length > 160 ? text.slice(0, 157) + "..." : text));
+      }
+      var meta = resource === "projects"
+        ? [item.year || "", (item.technologies || []).slice(0, 4).join(" · ")]
+        : [(item.published_at || "").slice(0, 10)];
+      meta = meta.filter(Boolean).join(" — ");
+      if (meta) {
+        li.appendChild(el("div", "m", meta));
+      }
+      list.appendChild(li);
+    });
+    wrap.appendChild(list);
+    root.appendChild(wrap);
+  }
+
+  fetch(base + "/embed/" + resource + "?" + params.toString())
+    .then(function (res) {
+      if (!res.ok) {
+        throw new Error("HTTP " + res.status);
+      }
+      return res.json();
+    })
+    .then(render)
+    .catch(function (err) {
+      if (window.console) {
+        console.warn("portfolio widget: " + err.message);
+      }
+    });
+})();
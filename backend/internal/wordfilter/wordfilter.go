@@ -0,0 +1,150 @@
+// Package wordfilter checks comment content against configured words and
+// patterns. Depending on the configured action a match rejects the comment,
+// masks the matched text or holds the comment for moderation.
+package wordfilter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Actions taken on a match.
+const (
+	ActionReject = "reject"
+	ActionMask   = "mask"
+	ActionHold   = "hold"
+)
+
+// maskRune replaces every letter and digit of masked text.
+const maskRune = '*'
+
+// Filter matches content against its rules. The zero value and a filter
+// without words or patterns match nothing.
+type Filter struct {
+	action string
+	rules  []rule
+}
+
+// rule is a compiled word or pattern. Words only match between characters
+// that aren't letters or digits.
+type rule struct {
+	re    *regexp.Regexp
+	whole bool
+}
+
+// New returns a filter taking action on content containing any of words,
+// matched case-insensitively as whole words, or matching any of patterns,
+// regular expressions matched case-insensitively. Words with Han characters
+// match anywhere, since Chinese has no spaces between words. An empty action
+// holds.
+func New(action string, words, patterns []string) (*Filter, error) {
+	switch action {
+	case "":
+		action = ActionHold
+	case ActionReject, ActionMask, ActionHold:
+	default:
+		return nil, fmt.Errorf("unknown action %q", action)
+	}
+
+	f := &Filter{action: action}
+	for _, w := range words {
+		w = strings.TrimSpace(w)
+		if w == "" {
+			continue
+		}
+		pattern := strings.Join(strings.Fields(regexp.QuoteMeta(w)), `\s+`)
+		f.rules = append(f.rules, rule{
+			re:    regexp.MustCompile(`(?i)` + pattern),
+			whole: strings.IndexFunc(w, func(r rune) bool { return unicode.Is(unicode.Han, r) }) < 0,
+		})
+	}
+	for _, p := range patterns {
+		if strings.TrimSpace(p) == "" {
+			continue
+		}
+		re, err := regexp.Compile(`(?i)` + p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", p, err)
+		}
+		f.rules = append(f.rules, rule{re: re})
+	}
+	return f, nil
+}
+
+// Result is the outcome of checking content. Content is the text to store:
+// the matches masked for ActionMask, the input otherwise. Action is empty
+// when nothing matched.
+type Result struct {
+	Action  string
+	Content string
+	Matches []string
+}
+
+// Check matches content against every rule.
+func (f *Filter) Check(content string) Result {
+	res := Result{Content: content}
+	if f == nil || len(f.rules) == 0 {
+		return res
+	}
+
+	// Spans are collected first so overlapping matches are masked once
+	var spans [][2]int
+	for _, r := range f.rules {
+		for _, m := range r.re.FindAllStringIndex(content, -1) {
+			if m[0] == m[1] || (r.whole && !wordAt(content, m[0], m[1])) {
+				continue
+			}
+			spans = append(spans, [2]int{m[0], m[1]})
+			res.Matches = append(res.Matches, content[m[0]:m[1]])
+		}
+	}
+	if len(spans) == 0 {
+		return res
+	}
+
+	res.Action = f.action
+	if f.action == ActionMask {
+		res.Content = mask(content, spans)
+	}
+	return res
+}
+
+// mask replaces the letters and digits inside spans with maskRune, keeping
+// spaces and punctuation so the text still reads naturally.
+func mask(content string, spans [][2]int) string {
+	var b strings.Builder
+	b.Grow(len(content))
+	for i := 0; i < len(content); {
+		r, size := utf8.DecodeRuneInString(content[i:])
+		if inside(spans, i) && wordRune(r) {
+			b.WriteRune(maskRune)
+		} else {
+			b.WriteString(content[i : i+size])
+		}
+		i += size
+	}
+	return b.String()
+}
+
+// wordAt reports whether content[start:end] isn't part of a longer word.
+func wordAt(content string, start, end int) bool {
+	before, _ := utf8.DecodeLastRuneInString(content[:start])
+	after, _ := utf8.DecodeRuneInString(content[end:])
+	return !wordRune(before) && !wordRune(after)
+}
+
+func wordRune(r rune) bool {
+	return r != utf8.RuneError && (unicode.IsLetter(r) || unicode.IsDigit(r))
+}
+
+func inside(spans [][2]int, i int) bool {
+	for _, s := range spans {
+		if i >= s[0] && i < s[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,5 @@
 import type { BlogData } from '../../components/BlogStack/types/blog';
-import { get, post, formatLanguage, del } from '../utils';
+import { get, post, formatLanguage, del, getSessionToken } from '../utils';
 import { type PaginationRequest, type SearchRequest } from '../config';
 import { processRawContent } from '../../utils/markdownParser';
 
@@ -377,6 +377,7 @@ export const createBlogComment = async (
     author_email: authorEmail,
     content,
     fingerprint,
+    session_token: getSessionToken(),
     lang: formatLanguage(language)
   });
   return res;
@@ -407,6 +408,7 @@ export const likeComment = async (
 
   if (fingerprint) data.fingerprint = fingerprint;
   if (userIdentityId) data.user_identity_id = userIdentityId;
+  data.session_token = getSessionToken();
 
   const res = await post<LikeCommentResponse>(`/api/v1/blog/comments/${commentId}/like`, data);
   return res;
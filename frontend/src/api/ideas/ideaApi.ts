@@ -1,5 +1,5 @@
 import type { IdeaData } from '../../types';
-import { get, post, del, formatLanguage } from '../utils';
+import { get, post, del, formatLanguage, getSessionToken } from '../utils';
 import { type PaginationRequest, type SearchRequest, type ListResponse } from '../config';
 
 // Backend API request/response types
@@ -218,9 +218,10 @@ export const createIdeaComment = async (
   if (options?.authorEmail && options.authorEmail.trim()) body.author_email = options.authorEmail.trim();
   if (options?.userIdentityId && options.userIdentityId.trim()) body.user_identity_id = options.userIdentityId.trim();
   if (options?.parentId && options.parentId.trim()) body.parent_id = options.parentId.trim();
+  body.session_token = getSessionToken();
 
-  // Align with backend model: if no user_identity_id provided, backend requires author_name and author_email
-  if (!body.user_identity_id) {
+  // Align with backend model: without a session, backend requires author_name and author_email
+  if (!body.session_token) {
     if (!body.author_name || typeof body.author_name !== 'string' || !body.author_name.trim()) {
       body.author_name = 'Anonymous';
     }
@@ -246,6 +247,7 @@ export const likeIdeaComment = async (
   const data: any = { lang: formatLanguage(language) };
   if (fingerprint) data.fingerprint = fingerprint;
   if (userIdentityId) data.user_identity_id = userIdentityId;
+  data.session_token = getSessionToken();
   const res = await post<LikeCommentResponse>(`/api/v1/ideas/comments/${commentId}/like`, data);
   return res;
 };
@@ -257,5 +259,6 @@ export const deleteIdeaComment = async (
   await del(`/api/v1/ideas/comments/${commentId}`, {
     fingerprint: payload.fingerprint,
     user_identity_id: payload.userIdentityId || '',
+    session_token: getSessionToken(),
   });
 };
\ No newline at end of file
@@ -2,6 +2,7 @@
 export * from './home/resumeApi';
 export * from './projects/projectApi';
 export * from './ideas/ideaApi';
+export * from './likes/likeApi';
 // Avoid type name collisions across APIs by namespacing comment-like types
 export * as BlogAPI from './blog/blogApi';
 export * as ProjectAPI from './projects/projectApi';
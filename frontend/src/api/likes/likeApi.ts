@@ -0,0 +1,59 @@
+import { post, getSessionToken } from '../utils';
+
+// ----- Like status API -----
+// Comment lists are cached and shared between visitors, so they never say
+// which comments the visitor liked. That comes from the like status
+// endpoint, which matches likes by session token or fingerprint.
+export interface LikeStatusResponse {
+  comments: Record<string, boolean>;
+  projects: Record<string, boolean>;
+}
+
+export const getLikeStatus = async (
+  ids: { commentIds?: string[]; projectIds?: string[] },
+  fingerprint?: string
+): Promise<LikeStatusResponse> => {
+  const data: any = {
+    comment_ids: ids.commentIds ?? [],
+    project_ids: ids.projectIds ?? [],
+    session_token: getSessionToken(),
+  };
+  if (fingerprint) data.fingerprint = fingerprint;
+
+  const res = await post<LikeStatusResponse>('/api/v1/likes/status', data);
+  return { comments: res?.comments ?? {}, projects: res?.projects ?? {} };
+};
+
+interface LikeableComment {
+  id: string;
+  is_liked_by_user: boolean;
+  replies?: LikeableComment[];
+}
+
+// commentIds lists the IDs of comments and all of their replies.
+export const commentIds = (comments: LikeableComment[]): string[] =>
+  comments.flatMap((c) => [c.id, ...commentIds(c.replies ?? [])]);
+
+// Sets is_liked_by_user on comments and their replies from the like status
+// endpoint. The comments are returned unchanged when it can't be reached.
+export const withLikeStatus = async <T extends LikeableComment>(
+  comments: T[],
+  fingerprint?: string
+): Promise<T[]> => {
+  const ids = commentIds(comments);
+  if (ids.length === 0) return comments;
+  let liked: Record<string, boolean>;
+  try {
+    liked = (await getLikeStatus({ commentIds: ids.slice(0, 500) }, fingerprint)).comments;
+  } catch (error) {
+    console.warn('Failed to load like status:', error);
+    return comments;
+  }
+  const apply = (list: T[]): T[] =>
+    list.map((c) => ({
+      ...c,
+      is_liked_by_user: Boolean(liked[c.id]),
+      ...(c.replies ? { replies: apply(c.replies as T[]) } : {}),
+    }));
+  return apply(comments);
+};
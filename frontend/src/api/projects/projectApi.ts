@@ -7,7 +7,7 @@ import type {
   ProjectDetail,
   ProjectBlogReference
 } from '../../types/api';
-import { get, post, del, formatLanguage } from '../utils';
+import { get, post, del, formatLanguage, getSessionToken } from '../utils';
 import { type PaginationRequest, type SearchRequest, type ListResponse } from '../config';
 
 // Backend API request/response types
@@ -475,9 +475,10 @@ export const createProjectComment = async (
   if (options?.authorEmail && options.authorEmail.trim()) body.author_email = options.authorEmail.trim();
   if (options?.userIdentityId && options.userIdentityId.trim()) body.user_identity_id = options.userIdentityId.trim();
   if (options?.parentId && options.parentId.trim()) body.parent_id = options.parentId.trim();
+  body.session_token = getSessionToken();
 
-  // Align with backend model: if no user_identity_id provided, backend requires author_name and author_email
-  if (!body.user_identity_id) {
+  // Align with backend model: without a session, backend requires author_name and author_email
+  if (!body.session_token) {
     if (!body.author_name || typeof body.author_name !== 'string' || !body.author_name.trim()) {
       body.author_name = 'Anonymous';
     }
@@ -504,6 +505,7 @@ export const likeProjectComment = async (
   const data: any = { lang: formatLanguage(language) };
   if (fingerprint) data.fingerprint = fingerprint;
   if (userIdentityId) data.user_identity_id = userIdentityId;
+  data.session_token = getSessionToken();
   const res = await post<LikeProjectCommentResponse>(`/api/v1/projects/comments/${commentId}/like`, data);
   return res;
 };
@@ -518,6 +520,7 @@ export const deleteProjectComment = async (
   await del(`/api/v1/projects/comments/${commentId}`, {
     fingerprint: payload.fingerprint,
     user_identity_id: payload.userIdentityId || '',
+    session_token: getSessionToken(),
   });
 };
 
@@ -730,6 +733,7 @@ export const likeProject = async (
   };
 
   if (options.userIdentityId) body.user_identity_id = options.userIdentityId;
+  body.session_token = getSessionToken();
   if (options.clientIP) body.client_ip = options.clientIP;
   if (options.userAgent) body.user_agent_full = options.userAgent;
 
@@ -756,6 +760,7 @@ export const recordProjectView = async (
   };
 
   if (options.userIdentityId) body.user_identity_id = options.userIdentityId;
+  body.session_token = getSessionToken();
   if (options.clientIP) body.client_ip = options.clientIP;
   if (options.userAgent) body.user_agent_full = options.userAgent;
 
@@ -781,6 +786,7 @@ export const getProjectMetrics = async (
 
   if (options.fingerprint) params.fingerprint = options.fingerprint;
   if (options.userIdentityId) params.user_identity_id = options.userIdentityId;
+  params.session_token = getSessionToken();
 
   const response = await get<ProjectMetricsResponse>(`/api/v1/projects/${projectId}/metrics`, params);
   return response;
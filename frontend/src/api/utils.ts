@@ -133,6 +133,19 @@ export const put = <T = any>(endpoint: string, data?: any): Promise<T> => {
   });
 };
 
+// Session token of the signed-in visitor, returned by Google sign-in. The
+// backend only acts for a signed-in visitor with it; a bare user_identity_id
+// is ignored.
+export const getSessionToken = (): string | undefined => {
+  try {
+    const raw = localStorage.getItem('auth_user');
+    const token = raw ? JSON.parse(raw)?.session_token : undefined;
+    return typeof token === 'string' && token ? token : undefined;
+  } catch {
+    return undefined;
+  }
+};
+
 // Language formatting helper
 export const formatLanguage = (lang: Language): string => {
   return lang === 'zh' ? 'zh' : 'en';
@@ -4,6 +4,7 @@ import { LikeOutlined, LikeFilled, MessageOutlined, DeleteOutlined, UserOutlined
 import { useLanguage } from '../../LanguageContext';
 import { useTheme } from '../../ThemeContext';
 import { getClientFingerprint } from '../../../utils/fingerprint';
+import { withLikeStatus } from '../../../api/likes/likeApi';
 
 import { GoogleLogin, CredentialResponse } from '@react-oauth/google';
 
@@ -78,28 +79,20 @@ const BlogComments: React.FC<BlogCommentsProps> = ({ postId, postSlug }) => {
       const pid = await resolvePostId();
       if (!pid) throw new Error('missing post id');
 
-      // Build query parameters
+      // The list is shared between visitors; which comments this visitor
+      // liked is looked up separately
       const params = new URLSearchParams({ lang: language });
 
-      // Add fingerprint for like status
-      try {
-        const fingerprint = await getClientFingerprint();
-        if (fingerprint) {
-          params.append('fingerprint', fingerprint);
-        }
-      } catch (error) {
-        console.warn('Failed to get fingerprint:', error);
-      }
-
-      // Add user identity if logged in
-      if (currentUser?.id) {
-        params.append('user_identity_id', currentUser.id);
-      }
-
       const response = await fetch(`/api/v1/blog/posts/${pid}/comments?${params.toString()}`);
       if (response.ok) {
         const data = await response.json();
-        setComments(data.comments || []);
+        let fingerprint: string | undefined;
+        try {
+          fingerprint = await getClientFingerprint();
+        } catch (error) {
+          console.warn('Failed to get fingerprint:', error);
+        }
+        setComments(await withLikeStatus<Comment>(data.comments || [], fingerprint));
         setTotal(data.total || 0);
       } else {
         const t = await response.text();
@@ -23,6 +23,7 @@ import {
   type ProjectCommentData,
   type ProjectIssueRecord
 } from '../../api/projects/projectApi';
+import { withLikeStatus } from '../../api/likes/likeApi';
 import { GoogleLogin, CredentialResponse } from '@react-oauth/google';
 
 const { TextArea } = Input;
@@ -109,7 +110,7 @@ const ProjectIssueDiscussion: React.FC<ProjectIssueDiscussionProps> = ({
 
       if (thread) {
         setIssueDetails(projectIssueFromComment(thread));
-        setComments(thread.replies ?? []);
+        setComments(await withLikeStatus(thread.replies ?? [], fingerprint));
       } else {
         setIssueDetails(null);
         setComments([]);
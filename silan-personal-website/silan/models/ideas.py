@@ -173,6 +173,11 @@ class Comment(Base, TimestampMixin):
     user_agent: Mapped[Optional[str]] = mapped_column(String(500))
     user_identity_id: Mapped[Optional[str]] = mapped_column(String, ForeignKey("user_identities.id"))
     likes_count: Mapped[int] = mapped_column(Integer, default=0)
+    is_edited: Mapped[bool] = mapped_column(Boolean, default=False)
+    edited_at: Mapped[Optional[datetime]] = mapped_column(DateTime)  # last edit by the author
+    edit_count: Mapped[int] = mapped_column(Integer, default=0)
+    is_author: Mapped[bool] = mapped_column(Boolean, default=False)  # written by the site owner
+    email_verified: Mapped[bool] = mapped_column(Boolean, default=False)  # confirmed with an emailed code
 
     # Relationships
     parent: Mapped[Optional["Comment"]] = relationship("Comment", remote_side="Comment.id", back_populates="replies")